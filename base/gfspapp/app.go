@@ -2,11 +2,13 @@ package gfspapp
 
 import (
 	"context"
+	"sync"
 	"syscall"
 
 	"google.golang.org/grpc"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
 	corelifecycle "github.com/bnb-chain/greenfield-storage-provider/core/lifecycle"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -53,12 +55,19 @@ type GfSpBaseApp struct {
 	uploader      module.Uploader
 	metrics       module.Modular
 	pprof         module.Modular
+	tracing       module.Modular
 	probeSvr      module.Modular
 
 	appCtx    context.Context
 	appCancel context.CancelFunc
 	services  []corelifecycle.Service
 
+	reloadMux    sync.Mutex
+	configLoader ConfigLoader
+	startConfig  *gfspconfig.GfSpConfig
+	liveConfig   *gfspconfig.GfSpConfig
+	reloadables  []ReloadableModular
+
 	uploadSpeed    int64
 	downloadSpeed  int64
 	replicateSpeed int64
@@ -160,6 +169,15 @@ func (g *GfSpBaseApp) GfSpDB() spdb.SPDB {
 	return g.gfSpDB
 }
 
+// GfSpDBWithContext returns the sp db client whose statements are traced as the children of the span in
+// ctx, it returns the client as is if the client does not support binding a context.
+func (g *GfSpBaseApp) GfSpDBWithContext(ctx context.Context) spdb.SPDB {
+	if db, ok := g.gfSpDB.(spdb.ContextSPDB); ok {
+		return db.WithContext(ctx)
+	}
+	return g.gfSpDB
+}
+
 // SetGfSpDB sets spdb
 func (g *GfSpBaseApp) SetGfSpDB(db spdb.SPDB) {
 	g.gfSpDB = db
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"os"
 	"os/signal"
+	"syscall"
 
 	corelifecycle "github.com/bnb-chain/greenfield-storage-provider/core/lifecycle"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
@@ -58,6 +59,11 @@ func (g *GfSpBaseApp) signals(sigs ...os.Signal) {
 		case <-g.appCtx.Done():
 			return
 		case sig := <-sigCh:
+			// SIGHUP reloads the configuration instead of stopping the app.
+			if sig == syscall.SIGHUP {
+				g.reloadOnSignal()
+				continue
+			}
 			for _, j := range sigs {
 				if j == sig {
 					g.appCancel()
@@ -68,6 +74,17 @@ func (g *GfSpBaseApp) signals(sigs ...os.Signal) {
 	}
 }
 
+func (g *GfSpBaseApp) reloadOnSignal() {
+	result, err := g.ReloadConfig(g.appCtx, false)
+	if err != nil {
+		log.Errorw("failed to reload config on signal", "error", err)
+		return
+	}
+	if len(result.RestartRequired) != 0 {
+		log.Warnw("config changes require restarting to take effect", "restart_required", result.RestartRequired)
+	}
+}
+
 // Wait blocks until context is done.
 func (g *GfSpBaseApp) Wait(ctx context.Context) {
 	<-g.appCtx.Done()
@@ -20,6 +20,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/pprof"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/probe"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
 	"github.com/bnb-chain/greenfield-storage-provider/store/config"
 	psclient "github.com/bnb-chain/greenfield-storage-provider/store/piecestore/client"
@@ -458,7 +459,17 @@ func DefaultGfSpTQueueOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error
 		cfg.Customize.NewStrategyTQueueFunc = gfsptqueue.NewGfSpTQueue
 	}
 	if cfg.Customize.NewStrategyTQueueWithLimitFunc == nil {
-		cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpTQueueWithLimit
+		if cfg.Manager.PersistentTaskQueueDir != "" {
+			if err := os.MkdirAll(cfg.Manager.PersistentTaskQueueDir, 0750); err != nil {
+				log.Errorw("failed to create persistent task queue dir", "dir", cfg.Manager.PersistentTaskQueueDir,
+					"error", err)
+				return err
+			}
+			cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpPersistentTQueueWithLimitFunc(
+				cfg.Manager.PersistentTaskQueueDir, cfg.Manager.PersistentTaskQueueSyncWrite)
+		} else {
+			cfg.Customize.NewStrategyTQueueWithLimitFunc = gfsptqueue.NewGfSpTQueueWithLimit
+		}
 	}
 	if cfg.Customize.NewVirtualGroupManagerFunc == nil {
 		cfg.Customize.NewVirtualGroupManagerFunc = gfspvgmgr.NewVirtualGroupManager
@@ -530,6 +541,9 @@ func DefaultGfSpModuleOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error
 			return err
 		}
 		app.RegisterServices(module)
+		if reloadable, ok := module.(ReloadableModular); ok {
+			app.RegisterReloadable(reloadable)
+		}
 		switch module.Name() {
 		case coremodule.ApprovalModularName:
 			app.approver = module.(coremodule.Approver)
@@ -602,6 +616,21 @@ func DefaultGfSpProbeOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error
 	return nil
 }
 
+func DefaultGfSpTracingOption(app *GfSpBaseApp, cfg *gfspconfig.GfSpConfig) error {
+	if !cfg.Trace.Enable {
+		app.tracing = &coremodule.NullModular{}
+		return nil
+	}
+	t, err := tracing.NewTracing(cfg.Trace, cfg.AppID)
+	if err != nil {
+		log.Errorw("failed to new tracing", "error", err)
+		return err
+	}
+	app.tracing = t
+	app.RegisterServices(app.tracing)
+	return nil
+}
+
 var gfspBaseAppDefaultOptions = []Option{
 	DefaultStaticOption,
 	DefaultGfSpClientOption,
@@ -616,6 +645,7 @@ var gfspBaseAppDefaultOptions = []Option{
 	DefaultGfSpMetricOption,
 	DefaultGfSpPProfOption,
 	DefaultGfSpProbeOption,
+	DefaultGfSpTracingOption,
 }
 
 func NewGfSpBaseApp(cfg *gfspconfig.GfSpConfig, opts ...gfspconfig.Option) (*GfSpBaseApp, error) {
@@ -625,9 +655,16 @@ func NewGfSpBaseApp(cfg *gfspconfig.GfSpConfig, opts ...gfspconfig.Option) (*GfS
 	if err := cfg.Apply(opts...); err != nil {
 		return nil, err
 	}
-	app := &GfSpBaseApp{}
+	// the default options fill the default values into the config, keeps the raw one to compare
+	// with the reloaded config.
+	startConfig, err := cfg.Clone()
+	if err != nil {
+		log.Errorw("failed to clone config", "error", err)
+		return nil, err
+	}
+	app := &GfSpBaseApp{startConfig: startConfig, liveConfig: startConfig}
 	for _, opt := range gfspBaseAppDefaultOptions {
-		err := opt(app, cfg)
+		err = opt(app, cfg)
 		if err != nil {
 			log.Errorw("failed to apply base app opt", "error", err)
 			return nil, err
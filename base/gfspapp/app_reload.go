@@ -0,0 +1,135 @@
+package gfspapp
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+var (
+	ErrConfigReloadUnsupported = gfsperrors.Register(BaseCodeSpace, http.StatusNotImplemented, 991201, "config reload is not supported")
+)
+
+func ErrInvalidConfigWithDetail(detail string) *gfsperrors.GfSpError {
+	return gfsperrors.Register(BaseCodeSpace, http.StatusBadRequest, 991202, detail)
+}
+
+// ReloadableModular is the interface to the modular that supports updating part of its configuration
+// without restarting.
+type ReloadableModular interface {
+	coremodule.Modular
+	// ReloadableConfigs returns the config paths that the modular can apply at runtime, e.g.
+	// "Parallel.GlobalSealObjectParallel"; a path also covers all the fields under it.
+	ReloadableConfigs() []string
+	// ReloadConfig validates the new configuration and returns the function to apply it, the
+	// returned function must not fail. If any modular rejects the new configuration, none of
+	// the returned functions are called.
+	ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error)
+}
+
+// ConfigLoader loads the latest configuration from the config source, e.g. the config file.
+type ConfigLoader func() (*gfspconfig.GfSpConfig, error)
+
+// ConfigReloadResult is the result of the configuration reloading.
+type ConfigReloadResult struct {
+	// Applied is the changed config paths that are applied without restarting.
+	Applied []string
+	// RestartRequired is the changed config paths since the startup that only take effect after restarting.
+	RestartRequired []string
+}
+
+// SetConfigLoader sets the config loader that is used to reload the configuration.
+func (g *GfSpBaseApp) SetConfigLoader(loader ConfigLoader) {
+	g.reloadMux.Lock()
+	defer g.reloadMux.Unlock()
+	g.configLoader = loader
+}
+
+// RegisterReloadable registers the modular whose configuration can be updated at runtime.
+func (g *GfSpBaseApp) RegisterReloadable(modular ReloadableModular) {
+	g.reloadMux.Lock()
+	defer g.reloadMux.Unlock()
+	g.reloadables = append(g.reloadables, modular)
+}
+
+// ReloadConfig loads the configuration by the config loader and applies the changed reloadable configs
+// to the modules. The new configuration is validated by all the modules before applying, the invalid one
+// is rejected as a whole and the running configuration keeps unchanged. If dryRun is true, only reports
+// the changes without applying them.
+func (g *GfSpBaseApp) ReloadConfig(ctx context.Context, dryRun bool) (*ConfigReloadResult, error) {
+	g.reloadMux.Lock()
+	defer g.reloadMux.Unlock()
+	if g.configLoader == nil || g.startConfig == nil || g.liveConfig == nil {
+		return nil, ErrConfigReloadUnsupported
+	}
+	cfg, err := g.configLoader()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to load config", "error", err)
+		return nil, ErrInvalidConfigWithDetail("failed to load config, error: " + err.Error())
+	}
+	live, err := cfg.Clone()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to clone config", "error", err)
+		return nil, ErrInvalidConfigWithDetail("failed to clone config, error: " + err.Error())
+	}
+
+	var reloadable []string
+	for _, modular := range g.reloadables {
+		reloadable = append(reloadable, modular.ReloadableConfigs()...)
+	}
+	result := &ConfigReloadResult{}
+	for _, path := range g.liveConfig.Diff(live) {
+		if matchConfigPath(reloadable, path) {
+			result.Applied = append(result.Applied, path)
+		}
+	}
+	for _, path := range g.startConfig.Diff(live) {
+		if !matchConfigPath(reloadable, path) {
+			result.RestartRequired = append(result.RestartRequired, path)
+		}
+	}
+	sort.Strings(result.Applied)
+	sort.Strings(result.RestartRequired)
+
+	// every modular gets its own copy, the modular may fill the default values into it.
+	applies := make([]func(), 0, len(g.reloadables))
+	for _, modular := range g.reloadables {
+		modularCfg, cloneErr := live.Clone()
+		if cloneErr != nil {
+			return nil, ErrInvalidConfigWithDetail("failed to clone config, error: " + cloneErr.Error())
+		}
+		apply, reloadErr := modular.ReloadConfig(modularCfg)
+		if reloadErr != nil {
+			log.CtxErrorw(ctx, "failed to reload config", "module", modular.Name(), "error", reloadErr)
+			return nil, ErrInvalidConfigWithDetail("invalid config for " + modular.Name() + ", error: " + reloadErr.Error())
+		}
+		applies = append(applies, apply)
+	}
+	if dryRun {
+		log.CtxInfow(ctx, "succeed to check config", "applied", result.Applied, "restart_required", result.RestartRequired)
+		return result, nil
+	}
+	for _, apply := range applies {
+		if apply != nil {
+			apply()
+		}
+	}
+	g.liveConfig = live
+	log.CtxInfow(ctx, "succeed to reload config", "applied", result.Applied, "restart_required", result.RestartRequired)
+	return result, nil
+}
+
+func matchConfigPath(prefixes []string, path string) bool {
+	for _, prefix := range prefixes {
+		if path == prefix || strings.HasPrefix(path, prefix+".") {
+			return true
+		}
+	}
+	return false
+}
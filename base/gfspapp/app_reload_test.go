@@ -0,0 +1,111 @@
+package gfspapp
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/core/module"
+)
+
+type mockReloadable struct {
+	module.Modular
+	paths   []string
+	err     error
+	applied *gfspconfig.GfSpConfig
+}
+
+func (m *mockReloadable) Name() string { return "mock" }
+
+func (m *mockReloadable) ReloadableConfigs() []string { return m.paths }
+
+func (m *mockReloadable) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if m.err != nil {
+		return nil, m.err
+	}
+	return func() { m.applied = cfg }, nil
+}
+
+func setupReloadApp(t *testing.T, loaded *gfspconfig.GfSpConfig, loadErr error) (*GfSpBaseApp, *mockReloadable) {
+	start := &gfspconfig.GfSpConfig{GRPCAddress: "localhost:9333"}
+	start.Parallel.GlobalSealObjectParallel = 10
+	start.Executor.MaxExecuteNumber = 5
+	g := &GfSpBaseApp{startConfig: start, liveConfig: start}
+	g.SetConfigLoader(func() (*gfspconfig.GfSpConfig, error) { return loaded, loadErr })
+	m := &mockReloadable{paths: []string{"Parallel", "Executor.MaxExecuteNumber"}}
+	g.RegisterReloadable(m)
+	return g, m
+}
+
+func TestGfSpBaseApp_ReloadConfigSuccess(t *testing.T) {
+	loaded := &gfspconfig.GfSpConfig{GRPCAddress: "localhost:9334"}
+	loaded.Parallel.GlobalSealObjectParallel = 20
+	loaded.Executor.MaxExecuteNumber = 5
+	g, m := setupReloadApp(t, loaded, nil)
+
+	result, err := g.ReloadConfig(context.TODO(), false)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Parallel.GlobalSealObjectParallel"}, result.Applied)
+	assert.Equal(t, []string{"GRPCAddress"}, result.RestartRequired)
+	assert.Equal(t, 20, m.applied.Parallel.GlobalSealObjectParallel)
+
+	// the applied changes are not reported again, the restart required ones are kept.
+	result, err = g.ReloadConfig(context.TODO(), false)
+	assert.Nil(t, err)
+	assert.Empty(t, result.Applied)
+	assert.Equal(t, []string{"GRPCAddress"}, result.RestartRequired)
+}
+
+func TestGfSpBaseApp_ReloadConfigDryRun(t *testing.T) {
+	loaded := &gfspconfig.GfSpConfig{}
+	loaded.Parallel.GlobalSealObjectParallel = 20
+	g, m := setupReloadApp(t, loaded, nil)
+
+	result, err := g.ReloadConfig(context.TODO(), true)
+	assert.Nil(t, err)
+	assert.Equal(t, []string{"Executor.MaxExecuteNumber", "Parallel.GlobalSealObjectParallel"}, result.Applied)
+	assert.Nil(t, m.applied)
+	assert.Equal(t, 10, g.liveConfig.Parallel.GlobalSealObjectParallel)
+}
+
+func TestGfSpBaseApp_ReloadConfigFailure(t *testing.T) {
+	t.Run("unsupported", func(t *testing.T) {
+		g := &GfSpBaseApp{}
+		_, err := g.ReloadConfig(context.TODO(), false)
+		assert.Equal(t, ErrConfigReloadUnsupported, err)
+	})
+	t.Run("failed to load config", func(t *testing.T) {
+		g, _ := setupReloadApp(t, nil, errors.New("mock error"))
+		_, err := g.ReloadConfig(context.TODO(), false)
+		assert.Contains(t, err.Error(), "mock error")
+	})
+	t.Run("invalid config", func(t *testing.T) {
+		loaded := &gfspconfig.GfSpConfig{}
+		loaded.Parallel.GlobalSealObjectParallel = -1
+		g, m := setupReloadApp(t, loaded, nil)
+		m.err = errors.New("invalid parallel")
+		_, err := g.ReloadConfig(context.TODO(), false)
+		assert.Contains(t, err.Error(), "invalid parallel")
+		assert.Nil(t, m.applied)
+		assert.Equal(t, 10, g.liveConfig.Parallel.GlobalSealObjectParallel)
+	})
+}
+
+func TestGfSpBaseApp_GfSpReloadConfig(t *testing.T) {
+	loaded := &gfspconfig.GfSpConfig{}
+	loaded.Executor.MaxExecuteNumber = 6
+	g, _ := setupReloadApp(t, loaded, nil)
+	resp, err := g.GfSpReloadConfig(context.TODO(), &gfspserver.GfSpReloadConfigRequest{DryRun: true})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetErr())
+	assert.Equal(t, []string{"Executor.MaxExecuteNumber", "Parallel.GlobalSealObjectParallel"}, resp.GetApplied())
+
+	g = &GfSpBaseApp{}
+	resp, err = g.GfSpReloadConfig(context.TODO(), &gfspserver.GfSpReloadConfigRequest{})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrConfigReloadUnsupported.GetInnerCode(), resp.GetErr().GetInnerCode())
+}
@@ -119,6 +119,23 @@ func TestGfSpBaseApp_SetGfSpDB(t *testing.T) {
 	g.SetGfSpDB(corespdb.NewMockSPDB(ctrl))
 }
 
+func TestGfSpBaseApp_GfSpDBWithContext(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := corespdb.NewMockSPDB(ctrl)
+	g.SetGfSpDB(m)
+	assert.Equal(t, m, g.GfSpDBWithContext(context.Background()))
+
+	bound := corespdb.NewMockSPDB(ctrl)
+	c := corespdb.NewMockContextSPDB(ctrl)
+	c.EXPECT().WithContext(gomock.Any()).Return(bound).Times(1)
+	g.SetGfSpDB(struct {
+		*corespdb.MockSPDB
+		*corespdb.MockContextSPDB
+	}{m, c})
+	assert.Equal(t, bound, g.GfSpDBWithContext(context.Background()))
+}
+
 func TestGfSpBaseApp_GfBsDB(t *testing.T) {
 	g := setup(t)
 	result := g.GfBsDB()
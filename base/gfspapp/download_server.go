@@ -3,6 +3,7 @@ package gfspapp
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"time"
 
@@ -22,46 +23,63 @@ var (
 
 var _ gfspserver.GfSpDownloadServiceServer = &GfSpBaseApp{}
 
-func (g *GfSpBaseApp) GfSpDownloadObject(ctx context.Context, req *gfspserver.GfSpDownloadObjectRequest) (
-	*gfspserver.GfSpDownloadObjectResponse, error) {
+func (g *GfSpBaseApp) GfSpDownloadObject(req *gfspserver.GfSpDownloadObjectRequest,
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectServer) error {
 	downloadObjectTask := req.GetDownloadObjectTask()
 	if downloadObjectTask == nil {
 		log.Error("failed to download object due to task pointer dangling")
-		return &gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadTaskDangling}, nil
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadTaskDangling})
 	}
-	ctx = log.WithValue(ctx, log.CtxKeyTask, downloadObjectTask.Key().String())
+	ctx := log.WithValue(stream.Context(), log.CtxKeyTask, downloadObjectTask.Key().String())
 	span, err := g.downloader.ReserveResource(ctx, downloadObjectTask.EstimateLimit().ScopeStat())
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to reserve download object resource", "error", err)
-		return &gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadExhaustResource}, nil
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadExhaustResource})
 	}
 	defer span.Done()
-	data, err := g.OnDownloadObjectTask(ctx, downloadObjectTask)
-	log.CtxDebugw(ctx, "finished to download object", "len", len(data), "error", err)
-	return &gfspserver.GfSpDownloadObjectResponse{
-		Err:  gfsperrors.MakeGfSpError(err),
-		Data: data,
-	}, nil
+	w := &downloadObjectStreamWriter{stream: stream}
+	err = g.OnDownloadObjectTask(ctx, downloadObjectTask, w)
+	log.CtxDebugw(ctx, "finished to download object", "len", w.size, "error", err)
+	if err != nil && !errors.Is(err, w.err) {
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: gfsperrors.MakeGfSpError(err)})
+	}
+	return err
+}
+
+// downloadObjectStreamWriter sends the object data written by the downloader to the stream.
+type downloadObjectStreamWriter struct {
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectServer
+	size   int
+	err    error
 }
 
-func (g *GfSpBaseApp) OnDownloadObjectTask(ctx context.Context, downloadObjectTask task.DownloadObjectTask) ([]byte, error) {
+func (w *downloadObjectStreamWriter) Write(p []byte) (int, error) {
+	if w.err = w.stream.Send(&gfspserver.GfSpDownloadObjectResponse{Data: p}); w.err != nil {
+		return 0, w.err
+	}
+	w.size += len(p)
+	return len(p), nil
+}
+
+func (g *GfSpBaseApp) OnDownloadObjectTask(ctx context.Context, downloadObjectTask task.DownloadObjectTask, w io.Writer) error {
 	if downloadObjectTask == nil || downloadObjectTask.GetObjectInfo() == nil {
 		log.CtxError(ctx, "failed to download object due to task pointer dangling")
-		return nil, ErrDownloadTaskDangling
+		return ErrDownloadTaskDangling
 	}
 	err := g.downloader.PreDownloadObject(ctx, downloadObjectTask)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to pre download object", "task_info", downloadObjectTask.Info(), "error", err)
-		return nil, err
+		return err
 	}
-	data, err := g.downloader.HandleDownloadObjectTask(ctx, downloadObjectTask)
+	err = g.downloader.HandleDownloadObjectTask(ctx, downloadObjectTask, w)
+	// settle the pre download object even if failed to download, e.g. refund the quota of the unsent data
+	g.downloader.PostDownloadObject(ctx, downloadObjectTask)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to download object", "error", err)
-		return nil, err
+		return err
 	}
-	g.downloader.PostDownloadObject(ctx, downloadObjectTask)
 	log.CtxDebugw(ctx, "succeed to download object")
-	return data, nil
+	return nil
 }
 
 func (g *GfSpBaseApp) GfSpDownloadPiece(ctx context.Context, req *gfspserver.GfSpDownloadPieceRequest) (
@@ -113,11 +131,12 @@ func (g *GfSpBaseApp) OnDownloadPieceTask(ctx context.Context, downloadPieceTask
 		return nil, err
 	}
 	data, err = g.downloader.HandleDownloadPieceTask(ctx, downloadPieceTask)
+	// settle the pre download piece even if failed to download, e.g. refund the quota of the unsent data
+	g.downloader.PostDownloadPiece(ctx, downloadPieceTask)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to download piece", "error", err)
 		return nil, err
 	}
-	g.downloader.PostDownloadPiece(ctx, downloadPieceTask)
 	log.CtxDebugw(ctx, "succeed to download piece")
 	return data, nil
 }
@@ -243,3 +262,13 @@ func (g *GfSpBaseApp) GfSpDeductQuotaForBucketMigrate(ctx context.Context, deduc
 		Err: gfsperrors.MakeGfSpError(nil),
 	}, nil
 }
+
+// gRPCDownloadObjectStream for mock use
+// Note: gRPCDownloadObjectStream interface is forbidden to be used in non-UT code
+//
+// nolint:unused
+//
+//go:generate mockgen -source=./download_server.go -destination=./download_server_mock.go -package=gfspapp
+type gRPCDownloadObjectStream interface {
+	gfspserver.GfSpDownloadService_GfSpDownloadObjectServer
+}
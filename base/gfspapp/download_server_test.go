@@ -1,7 +1,9 @@
 package gfspapp
 
 import (
+	"bytes"
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -11,6 +13,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/module"
 	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
 )
 
 func TestGfSpBaseApp_GfSpDownloadObjectSuccess(t *testing.T) {
@@ -22,17 +25,31 @@ func TestGfSpBaseApp_GfSpDownloadObjectSuccess(t *testing.T) {
 	m1.EXPECT().Done().AnyTimes()
 	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(m1, nil).Times(1)
 	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any()).Return([]byte("mockData"), nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.DownloadObjectTask, w io.Writer) error {
+			_, err := w.Write([]byte("mock"))
+			assert.Nil(t, err)
+			_, err = w.Write([]byte("Data"))
+			return err
+		}).Times(1)
 	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
+	var data []byte
+	m2 := NewMockgRPCDownloadObjectStream(ctrl)
+	m2.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	m2.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *gfspserver.GfSpDownloadObjectResponse) error {
+		assert.Nil(t, resp.GetErr())
+		data = append(data, resp.GetData()...)
+		return nil
+	}).Times(2)
 	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
 		Task: &gfsptask.GfSpTask{
 			Address: "mockAddress",
 		},
 		ObjectInfo: mockObjectInfo,
 	}}
-	result, err := g.GfSpDownloadObject(context.TODO(), req)
+	err := g.GfSpDownloadObject(req, m2)
 	assert.Nil(t, err)
-	assert.Equal(t, []byte("mockData"), result.GetData())
+	assert.Equal(t, []byte("mockData"), data)
 }
 
 func TestGfSpBaseApp_GfSpDownloadObjectFailure1(t *testing.T) {
@@ -41,9 +58,10 @@ func TestGfSpBaseApp_GfSpDownloadObjectFailure1(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := module.NewMockDownloader(ctrl)
 	g.downloader = m
-	result, err := g.GfSpDownloadObject(context.TODO(), nil)
+	m1 := NewMockgRPCDownloadObjectStream(ctrl)
+	m1.EXPECT().Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadTaskDangling}).Return(nil).Times(1)
+	err := g.GfSpDownloadObject(nil, m1)
 	assert.Nil(t, err)
-	assert.Equal(t, ErrDownloadTaskDangling, result.GetErr())
 }
 
 func TestGfSpBaseApp_GfSpDownloadObjectFailure2(t *testing.T) {
@@ -52,18 +70,81 @@ func TestGfSpBaseApp_GfSpDownloadObjectFailure2(t *testing.T) {
 	ctrl := gomock.NewController(t)
 	m := module.NewMockDownloader(ctrl)
 	g.downloader = m
+	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	m1 := NewMockgRPCDownloadObjectStream(ctrl)
+	m1.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	m1.EXPECT().Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrDownloadExhaustResource}).Return(nil).Times(1)
+	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
+		Task: &gfsptask.GfSpTask{
+			Address: "mockAddress",
+		},
+		ObjectInfo: mockObjectInfo,
+	}}
+	err := g.GfSpDownloadObject(req, m1)
+	assert.Nil(t, err)
+}
+
+func TestGfSpBaseApp_GfSpDownloadObjectFailure3(t *testing.T) {
+	t.Log("Failure case description: failed to download object after sending data")
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockDownloader(ctrl)
+	g.downloader = m
 	m1 := rcmgr.NewMockResourceScopeSpan(ctrl)
 	m1.EXPECT().Done().AnyTimes()
-	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(m1, nil).Times(1)
+	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.DownloadObjectTask, w io.Writer) error {
+			_, err := w.Write([]byte("mockData"))
+			assert.Nil(t, err)
+			return mockErr
+		}).Times(1)
+	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
+	m2 := NewMockgRPCDownloadObjectStream(ctrl)
+	m2.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	m2.EXPECT().Send(&gfspserver.GfSpDownloadObjectResponse{Data: []byte("mockData")}).Return(nil).Times(1)
+	m2.EXPECT().Send(gomock.Any()).DoAndReturn(func(resp *gfspserver.GfSpDownloadObjectResponse) error {
+		assert.NotNil(t, resp.GetErr())
+		return nil
+	}).Times(1)
 	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
 		Task: &gfsptask.GfSpTask{
 			Address: "mockAddress",
 		},
 		ObjectInfo: mockObjectInfo,
 	}}
-	result, err := g.GfSpDownloadObject(context.TODO(), req)
+	err := g.GfSpDownloadObject(req, m2)
 	assert.Nil(t, err)
-	assert.Equal(t, ErrDownloadExhaustResource, result.GetErr())
+}
+
+func TestGfSpBaseApp_GfSpDownloadObjectFailure4(t *testing.T) {
+	t.Log("Failure case description: failed to send data")
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockDownloader(ctrl)
+	g.downloader = m
+	m1 := rcmgr.NewMockResourceScopeSpan(ctrl)
+	m1.EXPECT().Done().AnyTimes()
+	m.EXPECT().ReserveResource(gomock.Any(), gomock.Any()).Return(m1, nil).Times(1)
+	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.DownloadObjectTask, w io.Writer) error {
+			_, err := w.Write([]byte("mockData"))
+			return err
+		}).Times(1)
+	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
+	m2 := NewMockgRPCDownloadObjectStream(ctrl)
+	m2.EXPECT().Context().Return(context.TODO()).AnyTimes()
+	m2.EXPECT().Send(gomock.Any()).Return(mockErr).Times(1)
+	req := &gfspserver.GfSpDownloadObjectRequest{DownloadObjectTask: &gfsptask.GfSpDownloadObjectTask{
+		Task: &gfsptask.GfSpTask{
+			Address: "mockAddress",
+		},
+		ObjectInfo: mockObjectInfo,
+	}}
+	err := g.GfSpDownloadObject(req, m2)
+	assert.Equal(t, mockErr, err)
 }
 
 func TestGfSpBaseApp_OnDownloadObjectTaskSuccess(t *testing.T) {
@@ -72,7 +153,11 @@ func TestGfSpBaseApp_OnDownloadObjectTaskSuccess(t *testing.T) {
 	m := module.NewMockDownloader(ctrl)
 	g.downloader = m
 	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any()).Return([]byte("mockData"), nil).Times(1)
+	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.DownloadObjectTask, w io.Writer) error {
+			_, err := w.Write([]byte("mockData"))
+			return err
+		}).Times(1)
 	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
 	downloadTask := &gfsptask.GfSpDownloadObjectTask{
 		Task: &gfsptask.GfSpTask{
@@ -80,17 +165,17 @@ func TestGfSpBaseApp_OnDownloadObjectTaskSuccess(t *testing.T) {
 		},
 		ObjectInfo: mockObjectInfo,
 	}
-	result, err := g.OnDownloadObjectTask(context.TODO(), downloadTask)
+	buf := &bytes.Buffer{}
+	err := g.OnDownloadObjectTask(context.TODO(), downloadTask, buf)
 	assert.Nil(t, err)
-	assert.Equal(t, []byte("mockData"), result)
+	assert.Equal(t, []byte("mockData"), buf.Bytes())
 }
 
 func TestGfSpBaseApp_OnDownloadObjectTaskFailure1(t *testing.T) {
 	t.Log("Failure case description: download object task pointer dangling")
 	g := setup(t)
-	result, err := g.OnDownloadObjectTask(context.TODO(), nil)
+	err := g.OnDownloadObjectTask(context.TODO(), nil, &bytes.Buffer{})
 	assert.Equal(t, ErrDownloadTaskDangling, err)
-	assert.Nil(t, result)
 }
 
 func TestGfSpBaseApp_OnDownloadObjectTaskFailure2(t *testing.T) {
@@ -106,9 +191,8 @@ func TestGfSpBaseApp_OnDownloadObjectTaskFailure2(t *testing.T) {
 		},
 		ObjectInfo: mockObjectInfo,
 	}
-	result, err := g.OnDownloadObjectTask(context.TODO(), downloadTask)
+	err := g.OnDownloadObjectTask(context.TODO(), downloadTask, &bytes.Buffer{})
 	assert.Equal(t, mockErr, err)
-	assert.Nil(t, result)
 }
 
 func TestGfSpBaseApp_OnDownloadObjectTaskFailure3(t *testing.T) {
@@ -118,16 +202,16 @@ func TestGfSpBaseApp_OnDownloadObjectTaskFailure3(t *testing.T) {
 	m := module.NewMockDownloader(ctrl)
 	g.downloader = m
 	m.EXPECT().PreDownloadObject(gomock.Any(), gomock.Any()).Return(nil).Times(1)
-	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	m.EXPECT().HandleDownloadObjectTask(gomock.Any(), gomock.Any(), gomock.Any()).Return(mockErr).Times(1)
+	m.EXPECT().PostDownloadObject(gomock.Any(), gomock.Any()).Return().Times(1)
 	downloadTask := &gfsptask.GfSpDownloadObjectTask{
 		Task: &gfsptask.GfSpTask{
 			Address: "mockAddress",
 		},
 		ObjectInfo: mockObjectInfo,
 	}
-	result, err := g.OnDownloadObjectTask(context.TODO(), downloadTask)
+	err := g.OnDownloadObjectTask(context.TODO(), downloadTask, &bytes.Buffer{})
 	assert.Equal(t, mockErr, err)
-	assert.Nil(t, result)
 }
 
 func TestGfSpBaseApp_GfSpDownloadPieceSuccess(t *testing.T) {
@@ -214,6 +298,7 @@ func TestGfSpBaseApp_OnDownloadPieceTaskFailure3(t *testing.T) {
 	g.downloader = m
 	m.EXPECT().PreDownloadPiece(gomock.Any(), gomock.Any()).Return(nil).Times(1)
 	m.EXPECT().HandleDownloadPieceTask(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+	m.EXPECT().PostDownloadPiece(gomock.Any(), gomock.Any()).Return().Times(1)
 	downloadTask := &gfsptask.GfSpDownloadPieceTask{
 		Task: &gfsptask.GfSpTask{
 			Address: "mockAddress",
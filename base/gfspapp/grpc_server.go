@@ -26,6 +26,7 @@ func DefaultGrpcServerOptions() []grpc.ServerOption {
 
 func (g *GfSpBaseApp) newRPCServer(options ...grpc.ServerOption) {
 	options = append(options, DefaultGrpcServerOptions()...)
+	options = append(options, utilgrpc.GetTracingServerInterceptor()...)
 	if g.EnableMetrics() {
 		options = append(options, utilgrpc.GetDefaultServerInterceptor()...)
 	}
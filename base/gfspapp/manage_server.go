@@ -93,13 +93,22 @@ func (g *GfSpBaseApp) GfSpAskTask(ctx context.Context, req *gfspserver.GfSpAskTa
 		return &gfspserver.GfSpAskTaskResponse{Err: ErrNoTaskMatchLimit}, nil
 	}
 	ctx = log.WithValue(ctx, log.CtxKeyTask, gfspTask.Key().String())
-	resp := &gfspserver.GfSpAskTaskResponse{}
-
 	defer func() {
 		metrics.ReqCounter.WithLabelValues(ManagerSuccessDispatchTask).Inc()
 		metrics.ReqTime.WithLabelValues(ManagerSuccessDispatchTask).Observe(time.Since(startTime).Seconds())
 	}()
 
+	resp, err := makeAskTaskResponse(ctx, gfspTask, startTime)
+	if err != nil {
+		return &gfspserver.GfSpAskTaskResponse{Err: gfsperrors.MakeGfSpError(err)}, nil
+	}
+	log.CtxDebugw(ctx, "succeed to response ask task")
+	return resp, nil
+}
+
+// makeAskTaskResponse wraps the dispatched task into the ask task response.
+func makeAskTaskResponse(ctx context.Context, gfspTask coretask.Task, startTime time.Time) (*gfspserver.GfSpAskTaskResponse, error) {
+	resp := &gfspserver.GfSpAskTaskResponse{}
 	switch t := gfspTask.(type) {
 	case *gfsptask.GfSpReplicatePieceTask:
 		t.AppendLog(fmt.Sprintf("manager-dispatch-replicate-task-retry:%d", t.GetRetry()))
@@ -178,9 +187,8 @@ func (g *GfSpBaseApp) GfSpAskTask(ctx context.Context, req *gfspserver.GfSpAskTa
 		}
 	default:
 		log.CtxErrorw(ctx, "[BUG] Unsupported task type to dispatch")
-		return &gfspserver.GfSpAskTaskResponse{Err: ErrUnsupportedTaskType}, nil
+		return nil, ErrUnsupportedTaskType
 	}
-	log.CtxDebugw(ctx, "succeed to response ask task")
 	return resp, nil
 }
 
@@ -364,6 +372,7 @@ func (g *GfSpBaseApp) GfSpQueryTasksStats(ctx context.Context, _ *gfspserver.GfS
 		MigrateGvgCount:      uint32(migrateGVGCount),
 		RecoveryProcessCount: uint32(recoveryCount),
 		RecoveryFailedList:   recoveryFailedList,
+		Scheduler:            g.manager.QueryTaskSchedulerStats(ctx),
 	}
 	return &gfspserver.GfSpQueryTasksStatsResponse{
 		Stats: stats,
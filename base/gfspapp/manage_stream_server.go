@@ -0,0 +1,180 @@
+package gfspapp
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+// DispatchTaskStreamInterval defines the interval of offering the tasks to the streaming executor that
+// has free slots.
+var DispatchTaskStreamInterval = 200 * time.Millisecond
+
+var ErrNoExecutorID = gfsperrors.Register(BaseCodeSpace, http.StatusBadRequest, 990604, "executor id is required to dispatch task stream")
+
+// dispatchStreamSeq numbers the dispatch task streams to make up their session ids.
+var dispatchStreamSeq uint64
+
+// makeDispatchStreamSession returns the session id of a dispatch task stream, the tasks are leased to the
+// session instead of the executor, so the leases of a reconnected executor survive closing its broken stream.
+func makeDispatchStreamSession(executorID string) string {
+	return fmt.Sprintf("%s#%d", executorID, atomic.AddUint64(&dispatchStreamSeq, 1))
+}
+
+// GfSpDispatchTaskStream leases the tasks that fit the capacity advertised by the executor over the stream.
+// The executor sends its status on connecting, after finishing or rejecting a task, and on every heartbeat,
+// the leases held by the stream session are revoked once the stream is broken.
+func (g *GfSpBaseApp) GfSpDispatchTaskStream(stream gfspserver.GfSpManageService_GfSpDispatchTaskStreamServer) error {
+	ctx := stream.Context()
+	status, err := stream.Recv()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to receive executor status", "error", err)
+		return err
+	}
+	executorID := status.GetExecutorId()
+	if executorID == "" {
+		log.CtxError(ctx, "failed to dispatch task stream due to empty executor id")
+		return ErrNoExecutorID
+	}
+	sessionID := makeDispatchStreamSession(executorID)
+	log.CtxInfow(ctx, "executor connects to dispatch task stream", "executor_id", executorID, "session_id", sessionID)
+	defer func() {
+		g.manager.RevokeTaskLeases(context.Background(), sessionID, nil)
+		log.CtxInfow(ctx, "executor disconnects from dispatch task stream", "executor_id", executorID,
+			"session_id", sessionID)
+	}()
+
+	statusCh := make(chan *gfspserver.GfSpExecutorStatus)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			recv, recvErr := stream.Recv()
+			if recvErr != nil {
+				errCh <- recvErr
+				return
+			}
+			select {
+			case statusCh <- recv:
+			case <-ctx.Done():
+				return
+			}
+		}
+	}()
+
+	ticker := time.NewTicker(DispatchTaskStreamInterval)
+	defer ticker.Stop()
+	var (
+		capacity  *gfsplimit.GfSpLimit
+		freeSlots int32
+	)
+	for {
+		if status != nil {
+			if err = g.onExecutorStatus(ctx, stream, sessionID, status); err != nil {
+				return err
+			}
+			capacity, freeSlots = status.GetCapacity(), status.GetFreeSlots()
+			status = nil
+		}
+		if capacity == nil {
+			freeSlots = 0
+		}
+		for freeSlots > 0 {
+			leased, leaseErr := g.leaseTask(ctx, stream, sessionID, capacity)
+			if leaseErr != nil {
+				return leaseErr
+			}
+			if !leased {
+				break
+			}
+			freeSlots--
+		}
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errCh:
+			log.CtxInfow(ctx, "dispatch task stream is closed", "session_id", sessionID, "error", err)
+			return nil
+		case status = <-statusCh:
+		case <-ticker.C:
+		}
+	}
+}
+
+// onExecutorStatus applies the leases reported by the executor, and acks the heartbeat with the revoked leases.
+func (g *GfSpBaseApp) onExecutorStatus(ctx context.Context, stream gfspserver.GfSpManageService_GfSpDispatchTaskStreamServer,
+	sessionID string, status *gfspserver.GfSpExecutorStatus) error {
+	if len(status.GetReleasedLeaseIds()) != 0 {
+		g.manager.ReleaseTaskLeases(ctx, sessionID, status.GetReleasedLeaseIds())
+	}
+	if len(status.GetRejectedLeaseIds()) != 0 {
+		g.manager.RejectTaskLeases(ctx, sessionID, status.GetRejectedLeaseIds())
+	}
+	if len(status.GetActiveLeaseIds()) == 0 {
+		return nil
+	}
+	expireTime, revoked := g.manager.RenewTaskLeases(ctx, sessionID, status.GetActiveLeaseIds())
+	if err := stream.Send(&gfspserver.GfSpTaskLease{
+		ExpireTime:        expireTime,
+		HeartbeatInterval: heartbeatInterval(expireTime),
+		RevokedLeaseIds:   revoked,
+	}); err != nil {
+		log.CtxErrorw(ctx, "failed to ack executor heartbeat", "session_id", sessionID, "error", err)
+		return err
+	}
+	return nil
+}
+
+// leaseTask leases a task that fits the capacity to the executor and deducts the task limit from the capacity,
+// it returns false if there is no such task.
+func (g *GfSpBaseApp) leaseTask(ctx context.Context, stream gfspserver.GfSpManageService_GfSpDispatchTaskStreamServer,
+	sessionID string, capacity *gfsplimit.GfSpLimit) (bool, error) {
+	startTime := time.Now()
+	gfspTask, leaseID, expireTime, err := g.manager.LeaseTask(ctx, sessionID, capacity)
+	if err != nil {
+		metrics.ReqCounter.WithLabelValues(ManagerFailureDispatchTask).Inc()
+		metrics.ReqTime.WithLabelValues(ManagerFailureDispatchTask).Observe(time.Since(startTime).Seconds())
+		log.CtxErrorw(ctx, "failed to lease task", "session_id", sessionID, "error", err)
+		return false, nil
+	}
+	if gfspTask == nil {
+		return false, nil
+	}
+	ctx = log.WithValue(ctx, log.CtxKeyTask, gfspTask.Key().String())
+	resp, err := makeAskTaskResponse(ctx, gfspTask, startTime)
+	if err != nil {
+		g.manager.RejectTaskLeases(ctx, sessionID, []string{leaseID})
+		return false, nil
+	}
+	if err = stream.Send(&gfspserver.GfSpTaskLease{
+		LeaseId:           leaseID,
+		ExpireTime:        expireTime,
+		HeartbeatInterval: heartbeatInterval(expireTime),
+		Task:              resp,
+	}); err != nil {
+		log.CtxErrorw(ctx, "failed to send task lease", "session_id", sessionID, "lease_id", leaseID, "error", err)
+		return false, err
+	}
+	capacity.Sub(gfspTask.EstimateLimit())
+	metrics.ReqCounter.WithLabelValues(ManagerSuccessDispatchTask).Inc()
+	metrics.ReqTime.WithLabelValues(ManagerSuccessDispatchTask).Observe(time.Since(startTime).Seconds())
+	log.CtxDebugw(ctx, "succeed to lease task", "session_id", sessionID, "lease_id", leaseID, "info", gfspTask.Info())
+	return true, nil
+}
+
+// heartbeatInterval returns the seconds between two heartbeats of the executor, so that the executor
+// renews a lease three times before it expires.
+func heartbeatInterval(expireTime int64) int64 {
+	interval := (expireTime - time.Now().Unix()) / 3
+	if interval < 1 {
+		interval = 1
+	}
+	return interval
+}
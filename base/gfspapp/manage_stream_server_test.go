@@ -0,0 +1,151 @@
+package gfspapp
+
+import (
+	"context"
+	"io"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+type mockDispatchTaskStream struct {
+	gfspserver.GfSpManageService_GfSpDispatchTaskStreamServer
+	ctx    context.Context
+	recvCh chan *gfspserver.GfSpExecutorStatus
+	mu     sync.Mutex
+	sent   []*gfspserver.GfSpTaskLease
+}
+
+func newMockDispatchTaskStream() *mockDispatchTaskStream {
+	return &mockDispatchTaskStream{ctx: context.Background(), recvCh: make(chan *gfspserver.GfSpExecutorStatus, 4)}
+}
+
+func (s *mockDispatchTaskStream) Context() context.Context { return s.ctx }
+
+func (s *mockDispatchTaskStream) Recv() (*gfspserver.GfSpExecutorStatus, error) {
+	status, ok := <-s.recvCh
+	if !ok {
+		return nil, io.EOF
+	}
+	return status, nil
+}
+
+func (s *mockDispatchTaskStream) Send(lease *gfspserver.GfSpTaskLease) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sent = append(s.sent, lease)
+	return nil
+}
+
+func (s *mockDispatchTaskStream) sentLeases() []*gfspserver.GfSpTaskLease {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return append([]*gfspserver.GfSpTaskLease{}, s.sent...)
+}
+
+func TestGfSpBaseApp_GfSpDispatchTaskStreamNoExecutorID(t *testing.T) {
+	g := setup(t)
+	stream := newMockDispatchTaskStream()
+	stream.recvCh <- &gfspserver.GfSpExecutorStatus{}
+	err := g.GfSpDispatchTaskStream(stream)
+	assert.Equal(t, ErrNoExecutorID, err)
+}
+
+func TestGfSpBaseApp_GfSpDispatchTaskStream(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockManager(ctrl)
+	g.manager = m
+	atomic.StoreUint64(&dispatchStreamSeq, 0)
+	sessionID := "executor-1#1"
+
+	gcTask := &gfsptask.GfSpGCObjectTask{Task: &gfsptask.GfSpTask{}}
+	var leased bool
+	m.EXPECT().LeaseTask(gomock.Any(), sessionID, gomock.Any()).DoAndReturn(
+		func(ctx context.Context, sessionID string, limit rcmgr.Limit) (coretask.Task, string, int64, error) {
+			if leased {
+				return nil, "", 0, nil
+			}
+			leased = true
+			assert.Equal(t, int64(100), limit.GetMemoryLimit())
+			return gcTask, "lease-1", time.Now().Unix() + 30, nil
+		}).AnyTimes()
+	m.EXPECT().RenewTaskLeases(gomock.Any(), sessionID, []string{"lease-1", "lease-0"}).Return(
+		time.Now().Unix()+30, []string{"lease-0"}).Times(1)
+	m.EXPECT().ReleaseTaskLeases(gomock.Any(), sessionID, []string{"lease-1"}).Times(1)
+	m.EXPECT().RejectTaskLeases(gomock.Any(), sessionID, []string{"lease-2"}).Times(1)
+	m.EXPECT().RevokeTaskLeases(gomock.Any(), sessionID, nil).Times(1)
+
+	stream := newMockDispatchTaskStream()
+	stream.recvCh <- &gfspserver.GfSpExecutorStatus{
+		ExecutorId: "executor-1",
+		Capacity:   &gfsplimit.GfSpLimit{Memory: 100, Tasks: 10, TasksLowPriority: 10},
+		FreeSlots:  2,
+	}
+	done := make(chan error)
+	go func() {
+		done <- g.GfSpDispatchTaskStream(stream)
+	}()
+	assert.Eventually(t, func() bool { return len(stream.sentLeases()) == 1 }, 5*time.Second, time.Millisecond)
+	lease := stream.sentLeases()[0]
+	assert.Equal(t, "lease-1", lease.GetLeaseId())
+	assert.Equal(t, gcTask, lease.GetTask().GetGcObjectTask())
+	assert.LessOrEqual(t, lease.GetHeartbeatInterval(), int64(10))
+
+	stream.recvCh <- &gfspserver.GfSpExecutorStatus{
+		ExecutorId:       "executor-1",
+		Capacity:         &gfsplimit.GfSpLimit{Memory: 100},
+		FreeSlots:        1,
+		ActiveLeaseIds:   []string{"lease-1", "lease-0"},
+		ReleasedLeaseIds: []string{"lease-1"},
+		RejectedLeaseIds: []string{"lease-2"},
+	}
+	assert.Eventually(t, func() bool { return len(stream.sentLeases()) == 2 }, 5*time.Second, time.Millisecond)
+	assert.Equal(t, []string{"lease-0"}, stream.sentLeases()[1].GetRevokedLeaseIds())
+
+	close(stream.recvCh)
+	assert.Nil(t, <-done)
+}
+
+func TestGfSpBaseApp_GfSpDispatchTaskStreamReconnect(t *testing.T) {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	m := module.NewMockManager(ctrl)
+	g.manager = m
+	atomic.StoreUint64(&dispatchStreamSeq, 0)
+
+	serve := func(stream *mockDispatchTaskStream) chan error {
+		stream.recvCh <- &gfspserver.GfSpExecutorStatus{ExecutorId: "executor-1"}
+		done := make(chan error)
+		go func() {
+			done <- g.GfSpDispatchTaskStream(stream)
+		}()
+		return done
+	}
+	brokenStream := newMockDispatchTaskStream()
+	brokenDone := serve(brokenStream)
+	assert.Eventually(t, func() bool { return atomic.LoadUint64(&dispatchStreamSeq) == 1 }, 5*time.Second, time.Millisecond)
+	newStream := newMockDispatchTaskStream()
+	newDone := serve(newStream)
+	assert.Eventually(t, func() bool { return atomic.LoadUint64(&dispatchStreamSeq) == 2 }, 5*time.Second, time.Millisecond)
+
+	// closing the broken stream only revokes its own leases
+	m.EXPECT().RevokeTaskLeases(gomock.Any(), "executor-1#1", nil).Times(1)
+	close(brokenStream.recvCh)
+	assert.Nil(t, <-brokenDone)
+
+	m.EXPECT().RevokeTaskLeases(gomock.Any(), "executor-1#2", nil).Times(1)
+	close(newStream.recvCh)
+	assert.Nil(t, <-newDone)
+}
@@ -62,3 +62,15 @@ func (g *GfSpBaseApp) GfSpQuerySpExit(ctx context.Context, req *gfspserver.GfSpQ
 	res, err := g.manager.QuerySpExit(ctx)
 	return res, err
 }
+
+func (g *GfSpBaseApp) GfSpReloadConfig(ctx context.Context, req *gfspserver.GfSpReloadConfigRequest) (
+	*gfspserver.GfSpReloadConfigResponse, error) {
+	result, err := g.ReloadConfig(ctx, req.GetDryRun())
+	if err != nil {
+		return &gfspserver.GfSpReloadConfigResponse{Err: gfsperrors.MakeGfSpError(err)}, nil
+	}
+	return &gfspserver.GfSpReloadConfigResponse{
+		Applied:         result.Applied,
+		RestartRequired: result.RestartRequired,
+	}, nil
+}
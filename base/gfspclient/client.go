@@ -12,6 +12,7 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	utilgrpc "github.com/bnb-chain/greenfield-storage-provider/util/grpc"
 )
 
@@ -81,6 +82,7 @@ func NewGfSpClient(approverEndpoint, managerEndpoint, downloaderEndpoint, receiv
 
 func (s *GfSpClient) Connection(ctx context.Context, address string, opts ...grpc.DialOption) (*grpc.ClientConn, error) {
 	options := append(DefaultClientOptions(), opts...)
+	options = append(options, utilgrpc.GetTracingClientInterceptor()...)
 	if s.metrics {
 		options = append(options, utilgrpc.GetDefaultClientInterceptor()...)
 	}
@@ -164,11 +166,11 @@ func (s *GfSpClient) HTTPClient(ctx context.Context) *http.Client {
 	defer s.mux.Unlock()
 	if s.httpClient == nil {
 		s.httpClient = &http.Client{
-			Transport: &http.Transport{
+			Transport: tracing.NewTransport(&http.Transport{
 				MaxIdleConns:    HTTPMaxIdleConns,
 				IdleConnTimeout: HTTPIdleConnTimout,
 				TLSClientConfig: &tls.Config{MinVersion: tls.VersionTLS12},
-			}}
+			})}
 	}
 	return s.httpClient
 }
@@ -2,6 +2,8 @@ package gfspclient
 
 import (
 	"context"
+	"errors"
+	"io"
 	"time"
 
 	"google.golang.org/grpc"
@@ -13,26 +15,99 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
 )
 
+// GetObject streams the data of the download object task from the downloader. The first response is received
+// before returning, so the errors of checking the task are returned by GetObject, and the errors happened
+// during streaming are returned by reading the returned reader, which must be closed by the caller.
 func (s *GfSpClient) GetObject(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask, opts ...grpc.DialOption) (
-	[]byte, error) {
+	io.ReadCloser, error) {
 	conn, connErr := s.Connection(ctx, s.downloaderEndpoint, opts...)
 	if connErr != nil {
 		log.CtxErrorw(ctx, "client failed to connect downloader", "error", connErr)
 		return nil, ErrRPCUnknownWithDetail("client failed to connect downloader, error: ", connErr)
 	}
-	defer conn.Close()
 	req := &gfspserver.GfSpDownloadObjectRequest{
 		DownloadObjectTask: downloadObjectTask.(*gfsptask.GfSpDownloadObjectTask),
 	}
-	resp, err := gfspserver.NewGfSpDownloadServiceClient(conn).GfSpDownloadObject(ctx, req)
+	ctx, cancel := context.WithCancel(ctx)
+	stream, err := gfspserver.NewGfSpDownloadServiceClient(conn).GfSpDownloadObject(ctx, req)
 	if err != nil {
+		cancel()
+		_ = conn.Close()
 		log.CtxErrorw(ctx, "client failed to download object", "error", err)
 		return nil, ErrRPCUnknownWithDetail("client failed to download object, error: ", err)
 	}
-	if resp.GetErr() != nil {
-		return nil, resp.GetErr()
+	r := &objectStreamReader{stream: stream, conn: conn, cancel: cancel}
+	if err = r.recv(); err != nil && !errors.Is(err, io.EOF) {
+		_ = r.Close()
+		return nil, err
 	}
-	return resp.GetData(), nil
+	return r, nil
+}
+
+// objectStreamReader reads the object data from the download object stream.
+type objectStreamReader struct {
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectClient
+	conn   *grpc.ClientConn
+	cancel context.CancelFunc
+	data   []byte
+	err    error
+}
+
+// recv receives the next data of the stream, the error is kept and returned by the following calls.
+func (r *objectStreamReader) recv() error {
+	if r.err != nil {
+		return r.err
+	}
+	resp, err := r.stream.Recv()
+	switch {
+	case errors.Is(err, io.EOF):
+		r.err = io.EOF
+	case err != nil:
+		log.CtxErrorw(r.stream.Context(), "client failed to receive object data", "error", err)
+		r.err = ErrRPCUnknownWithDetail("client failed to download object, error: ", err)
+	case resp.GetErr() != nil:
+		r.err = resp.GetErr()
+	default:
+		r.data = resp.GetData()
+	}
+	return r.err
+}
+
+func (r *objectStreamReader) Read(p []byte) (int, error) {
+	for len(r.data) == 0 {
+		if err := r.recv(); err != nil {
+			return 0, err
+		}
+	}
+	n := copy(p, r.data)
+	r.data = r.data[n:]
+	return n, nil
+}
+
+// WriteTo writes the received data to w without copying it to the intermediate buffers.
+func (r *objectStreamReader) WriteTo(w io.Writer) (int64, error) {
+	var total int64
+	for {
+		if len(r.data) > 0 {
+			n, err := w.Write(r.data)
+			total += int64(n)
+			r.data = r.data[n:]
+			if err != nil {
+				return total, err
+			}
+		}
+		if err := r.recv(); err != nil {
+			if errors.Is(err, io.EOF) {
+				return total, nil
+			}
+			return total, err
+		}
+	}
+}
+
+func (r *objectStreamReader) Close() error {
+	r.cancel()
+	return r.conn.Close()
 }
 
 func (s *GfSpClient) GetPiece(ctx context.Context, downloadPieceTask coretask.DownloadPieceTask, opts ...grpc.DialOption) (
@@ -2,6 +2,7 @@ package gfspclient
 
 import (
 	"context"
+	"io"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -55,14 +56,17 @@ func TestGfSpClient_GetObject(t *testing.T) {
 		t.Run(tt.name, func(t *testing.T) {
 			s := mockBufClient()
 			ctx := context.Background()
-			result, err := s.GetObject(ctx, tt.task, grpc.WithContextDialer(bufDialer),
+			reader, err := s.GetObject(ctx, tt.task, grpc.WithContextDialer(bufDialer),
 				grpc.WithTransportCredentials(insecure.NewCredentials()))
 			if tt.wantedIsErr {
 				assert.Contains(t, err.Error(), tt.wantedErr.Error())
-				assert.Nil(t, result)
+				assert.Nil(t, reader)
 			} else {
+				assert.Nil(t, err)
+				result, err := io.ReadAll(reader)
 				assert.Nil(t, err)
 				assert.Equal(t, tt.wantedResult, result)
+				assert.Nil(t, reader.Close())
 			}
 		})
 	}
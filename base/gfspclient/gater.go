@@ -14,6 +14,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
@@ -74,7 +75,7 @@ const (
 
 func (s *GfSpClient) ReplicatePieceToSecondary(ctx context.Context, endpoint string, receive coretask.ReceivePieceTask,
 	data []byte) error {
-	req, err := http.NewRequest(http.MethodPut, endpoint+ReplicateObjectPiecePath, bytes.NewReader(data))
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodPut, endpoint+ReplicateObjectPiecePath, bytes.NewReader(data))
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", endpoint, "error", err)
 		return err
@@ -100,7 +101,7 @@ func (s *GfSpClient) ReplicatePieceToSecondary(ctx context.Context, endpoint str
 }
 
 func (s *GfSpClient) GetPieceFromECChunks(ctx context.Context, endpoint string, task coretask.RecoveryPieceTask) (io.ReadCloser, error) {
-	req, err := http.NewRequest(http.MethodGet, endpoint+RecoveryObjectPiecePath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, endpoint+RecoveryObjectPiecePath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", endpoint, "error", err)
 		return nil, err
@@ -129,7 +130,7 @@ func (s *GfSpClient) GetPieceFromECChunks(ctx context.Context, endpoint string,
 
 func (s *GfSpClient) DoneReplicatePieceToSecondary(ctx context.Context, endpoint string,
 	receive coretask.ReceivePieceTask) ([]byte, error) {
-	req, err := http.NewRequest(http.MethodPut, endpoint+ReplicateObjectPiecePath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodPut, endpoint+ReplicateObjectPiecePath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", endpoint, "error", err)
 		return nil, err
@@ -160,7 +161,7 @@ func (s *GfSpClient) DoneReplicatePieceToSecondary(ctx context.Context, endpoint
 
 func (s *GfSpClient) MigratePiece(ctx context.Context, gvgTask *gfsptask.GfSpMigrateGVGTask, pieceTask *gfsptask.GfSpMigratePieceTask) ([]byte, error) {
 	endpoint := pieceTask.GetSrcSpEndpoint()
-	req, err := http.NewRequest(http.MethodGet, fmt.Sprintf("%s%s", endpoint, MigratePiecePath), nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, fmt.Sprintf("%s%s", endpoint, MigratePiecePath), nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", endpoint, "error", err)
 		return nil, err
@@ -199,7 +200,7 @@ func (s *GfSpClient) MigratePiece(ctx context.Context, gvgTask *gfsptask.GfSpMig
 
 // NotifyDestSPMigrateSwapOut is used to notify dest sp start migrate swap out task.
 func (s *GfSpClient) NotifyDestSPMigrateSwapOut(ctx context.Context, destEndpoint string, swapOut *virtualgrouptypes.MsgSwapOut) error {
-	req, err := http.NewRequest(http.MethodPost, destEndpoint+NotifyMigrateSwapOutTaskPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodPost, destEndpoint+NotifyMigrateSwapOutTaskPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", destEndpoint, "error", err)
 		return err
@@ -224,7 +225,7 @@ func (s *GfSpClient) NotifyDestSPMigrateSwapOut(ctx context.Context, destEndpoin
 
 // QueryLatestBucketQuota is used to query src sp bucket quota before send CompleteMigrateBucket Tx
 func (s *GfSpClient) QueryLatestBucketQuota(ctx context.Context, endpoint string, queryMsg *gfsptask.GfSpBucketMigrationInfo) (gfsptask.GfSpBucketQuotaInfo, error) {
-	req, err := http.NewRequest(http.MethodGet, endpoint+MigrateQueryBucketQuotaPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, endpoint+MigrateQueryBucketQuotaPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", endpoint, "error", err)
 		return gfsptask.GfSpBucketQuotaInfo{}, err
@@ -261,7 +262,7 @@ func (s *GfSpClient) QueryLatestBucketQuota(ctx context.Context, endpoint string
 
 // PreMigrateBucket is used to notify src sp and deduct bucket quota before send dest sp migrate gvg task
 func (s *GfSpClient) PreMigrateBucket(ctx context.Context, srcSPEndpoint string, preMsg *gfsptask.GfSpBucketMigrationInfo) (gfsptask.GfSpBucketQuotaInfo, error) {
-	req, err := http.NewRequest(http.MethodGet, srcSPEndpoint+PreMigrateBucketPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, srcSPEndpoint+PreMigrateBucketPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", srcSPEndpoint, "error", err)
 		return gfsptask.GfSpBucketQuotaInfo{}, err
@@ -299,7 +300,7 @@ func (s *GfSpClient) PreMigrateBucket(ctx context.Context, srcSPEndpoint string,
 // PostMigrateBucket is used to notify src sp the completion of bucket migrate before dest sp send CompleteMigrateBucket Tx
 func (s *GfSpClient) PostMigrateBucket(ctx context.Context, srcSPEndpoint string, postMsg *gfsptask.GfSpBucketMigrationInfo) (gfsptask.GfSpBucketQuotaInfo, error) {
 	bucketID := postMsg.GetBucketId()
-	req, err := http.NewRequest(http.MethodGet, srcSPEndpoint+PostMigrateBucketPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, srcSPEndpoint+PostMigrateBucketPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", srcSPEndpoint, "error", err)
 		return gfsptask.GfSpBucketQuotaInfo{}, err
@@ -337,7 +338,7 @@ func (s *GfSpClient) PostMigrateBucket(ctx context.Context, srcSPEndpoint string
 
 // QuerySPHasEnoughQuotaForMigrateBucket is used to query src sp bucket quota at approval phase
 func (s *GfSpClient) QuerySPHasEnoughQuotaForMigrateBucket(ctx context.Context, srcSPEndpoint string, queryMsg *gfsptask.GfSpBucketMigrationInfo) error {
-	req, err := http.NewRequest(http.MethodGet, srcSPEndpoint+MigrateQueryBucketQuotaHasEnoughQuotaPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, srcSPEndpoint+MigrateQueryBucketQuotaHasEnoughQuotaPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "endpoint", srcSPEndpoint, "error", err)
 		return err
@@ -382,7 +383,7 @@ func (s *GfSpClient) QuerySPHasEnoughQuotaForMigrateBucket(ctx context.Context,
 
 func (s *GfSpClient) GetSecondarySPMigrationBucketApproval(ctx context.Context, secondarySPEndpoint string,
 	signDoc *storagetypes.SecondarySpMigrationBucketSignDoc) ([]byte, error) {
-	req, err := http.NewRequest(http.MethodGet, secondarySPEndpoint+SecondarySPMigrationBucketApprovalPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, secondarySPEndpoint+SecondarySPMigrationBucketApprovalPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "secondary_sp_endpoint", secondarySPEndpoint, "error", err)
 		return nil, err
@@ -412,7 +413,7 @@ func (s *GfSpClient) GetSecondarySPMigrationBucketApproval(ctx context.Context,
 
 func (s *GfSpClient) GetSwapOutApproval(ctx context.Context, destSPEndpoint string, swapOutApproval *virtualgrouptypes.MsgSwapOut) (
 	*virtualgrouptypes.MsgSwapOut, error) {
-	req, err := http.NewRequest(http.MethodGet, destSPEndpoint+SwapOutApprovalPath, nil)
+	req, err := http.NewRequestWithContext(tracing.DetachedContext(ctx), http.MethodGet, destSPEndpoint+SwapOutApprovalPath, nil)
 	if err != nil {
 		log.CtxErrorw(ctx, "client failed to connect to gateway", "dest_sp_endpoint", destSPEndpoint, "error", err)
 		return nil, err
@@ -241,14 +241,20 @@ func (mockAuthenticatorServer) VerifyGNFD1EddsaSignature(ctx context.Context, re
 
 type mockDownloaderServer struct{}
 
-func (mockDownloaderServer) GfSpDownloadObject(ctx context.Context, req *gfspserver.GfSpDownloadObjectRequest) (
-	*gfspserver.GfSpDownloadObjectResponse, error) {
+func (mockDownloaderServer) GfSpDownloadObject(req *gfspserver.GfSpDownloadObjectRequest,
+	stream gfspserver.GfSpDownloadService_GfSpDownloadObjectServer) error {
 	if req.GetDownloadObjectTask().GetObjectInfo().GetObjectName() == mockObjectName1 {
-		return nil, mockRPCErr
+		return mockRPCErr
 	} else if req.GetDownloadObjectTask().GetObjectInfo().GetObjectName() == mockObjectName2 {
-		return &gfspserver.GfSpDownloadObjectResponse{Err: ErrExceptionsStream}, nil
+		return stream.Send(&gfspserver.GfSpDownloadObjectResponse{Err: ErrExceptionsStream})
 	} else {
-		return &gfspserver.GfSpDownloadObjectResponse{Data: []byte(mockBufNet)}, nil
+		// the data is sent in several responses
+		for _, data := range []string{mockBufNet[:2], mockBufNet[2:]} {
+			if err := stream.Send(&gfspserver.GfSpDownloadObjectResponse{Data: []byte(data)}); err != nil {
+				return err
+			}
+		}
+		return nil
 	}
 }
 
@@ -364,6 +370,23 @@ func (mockManagerServer) GfSpAskTask(ctx context.Context, req *gfspserver.GfSpAs
 	}
 }
 
+func (mockManagerServer) GfSpDispatchTaskStream(stream gfspserver.GfSpManageService_GfSpDispatchTaskStreamServer) error {
+	for {
+		status, err := stream.Recv()
+		if err != nil {
+			return nil
+		}
+		if err = stream.Send(&gfspserver.GfSpTaskLease{
+			LeaseId: status.GetExecutorId(),
+			Task: &gfspserver.GfSpAskTaskResponse{Response: &gfspserver.GfSpAskTaskResponse_GcObjectTask{
+				GcObjectTask: &gfsptask.GfSpGCObjectTask{},
+			}},
+		}); err != nil {
+			return err
+		}
+	}
+}
+
 func (mockManagerServer) GfSpReportTask(ctx context.Context, req *gfspserver.GfSpReportTaskRequest) (
 	*gfspserver.GfSpReportTaskResponse, error) {
 	if req.GetUploadObjectTask().GetObjectInfo().GetObjectName() == mockObjectName1 {
@@ -530,6 +553,27 @@ func (mockQueryServer) GfSpQuerySpExit(ctx context.Context, req *gfspserver.GfSp
 	return nil, nil
 }
 
+func (mockQueryServer) GfSpReloadConfig(ctx context.Context, req *gfspserver.GfSpReloadConfigRequest) (
+	*gfspserver.GfSpReloadConfigResponse, error) {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		log.Println("failed to get metadata")
+	}
+	if v, ok := md["bufnet"]; ok {
+		for _, j := range v {
+			if j == mockObjectName1 {
+				return nil, mockRPCErr
+			} else if j == mockObjectName2 {
+				return &gfspserver.GfSpReloadConfigResponse{Err: ErrExceptionsStream}, nil
+			} else {
+				return &gfspserver.GfSpReloadConfigResponse{Applied: []string{"Parallel.GlobalSealObjectParallel"},
+					RestartRequired: []string{"GRPCAddress"}}, nil
+			}
+		}
+	}
+	return nil, nil
+}
+
 type mockReceiverServer struct{}
 
 func (mockReceiverServer) GfSpReplicatePiece(ctx context.Context, req *gfspserver.GfSpReplicatePieceRequest) (
@@ -63,7 +63,7 @@ type AuthenticatorAPI interface {
 
 // DownloaderAPI for mock use
 type DownloaderAPI interface {
-	GetObject(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask, opts ...grpc.DialOption) ([]byte, error)
+	GetObject(ctx context.Context, downloadObjectTask coretask.DownloadObjectTask, opts ...grpc.DialOption) (io.ReadCloser, error)
 	GetPiece(ctx context.Context, downloadPieceTask coretask.DownloadPieceTask, opts ...grpc.DialOption) ([]byte, error)
 	GetChallengeInfo(ctx context.Context, challengePieceTask coretask.ChallengePieceTask, opts ...grpc.DialOption) ([]byte, [][]byte, []byte, error)
 	RecoupQuota(ctx context.Context, bucketID, extraQuota uint64, yearMonth string, opts ...grpc.DialOption) error
@@ -90,6 +90,7 @@ type ManagerAPI interface {
 	CreateUploadObject(ctx context.Context, task coretask.UploadObjectTask) error
 	CreateResumableUploadObject(ctx context.Context, task coretask.ResumableUploadObjectTask) error
 	AskTask(ctx context.Context, limit corercmgr.Limit) (coretask.Task, error)
+	DispatchTaskStream(ctx context.Context) (gfspserver.GfSpManageService_GfSpDispatchTaskStreamClient, error)
 	ReportTask(ctx context.Context, report coretask.Task) error
 	PickVirtualGroupFamilyID(ctx context.Context, task coretask.ApprovalCreateBucketTask) (uint32, error)
 	NotifyMigrateSwapOut(ctx context.Context, swapOut *virtualgrouptypes.MsgSwapOut) error
@@ -106,6 +107,7 @@ type MetadataAPI interface {
 	GetUserBuckets(ctx context.Context, account string, includeRemoved bool, opts ...grpc.DialOption) ([]*types.VGFInfoBucket, error)
 	ListObjectsByBucketName(ctx context.Context, bucketName string, accountID string, maxKeys uint64, startAfter string, continuationToken string, delimiter string, prefix string, includeRemoved bool,
 		opts ...grpc.DialOption) (objects []*types.Object, keyCount, maxKeysRe uint64, isTruncated bool, nextContinuationToken, name, prefixRe, delimiterRe string, commonPrefixes []string, continuationTokenRe string, err error)
+	ListObjectsByBucketNameWithOptions(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest, opts ...grpc.DialOption) (*types.GfSpListObjectsByBucketNameResponse, error)
 	GetBucketByBucketName(ctx context.Context, bucketName string, includePrivate bool, opts ...grpc.DialOption) (*types.Bucket, error)
 	GetBucketByBucketID(ctx context.Context, bucketID int64, includePrivate bool, opts ...grpc.DialOption) (*types.Bucket, error)
 	ListExpiredBucketsBySp(ctx context.Context, createAt int64, primarySpID uint32, limit int64, opts ...grpc.DialOption) ([]*types.Bucket, error)
@@ -157,6 +159,11 @@ type MetadataAPI interface {
 	GetBucketSize(ctx context.Context, bucketID uint64, opts ...grpc.DialOption) (string, error)
 	GetBucketInfoByBucketName(ctx context.Context, bucketName string, opts ...grpc.DialOption) (*types.Bucket, error)
 	GetBsDBInfo(ctx context.Context, blockHeight uint64, opts ...grpc.DialOption) (*types.GfSpGetBsDBInfoResponse, error)
+	ListUsageRollup(ctx context.Context, req *types.GfSpListUsageRollupRequest, opts ...grpc.DialOption) ([]*types.UsageRollup, error)
+	CreateNotificationSubscription(ctx context.Context, subscription *types.NotificationSubscription, secret string, opts ...grpc.DialOption) (uint64, error)
+	ListNotificationSubscriptions(ctx context.Context, bucketName string, opts ...grpc.DialOption) ([]*types.NotificationSubscription, error)
+	DeleteNotificationSubscription(ctx context.Context, bucketName string, subscriptionID uint64, opts ...grpc.DialOption) error
+	ListNotificationEvents(ctx context.Context, req *types.GfSpListNotificationEventsRequest, opts ...grpc.DialOption) ([]*types.NotificationEvent, uint64, error)
 }
 
 // P2PAPI for mock use
@@ -170,6 +177,7 @@ type QueryAPI interface {
 	QueryTasks(ctx context.Context, endpoint string, subKey string, opts ...grpc.DialOption) ([]string, error)
 	QueryBucketMigrate(ctx context.Context, endpoint string, opts ...grpc.DialOption) (string, error)
 	QuerySPExit(ctx context.Context, endpoint string, opts ...grpc.DialOption) (string, error)
+	ReloadConfig(ctx context.Context, endpoint string, dryRun bool, opts ...grpc.DialOption) ([]string, []string, error)
 }
 
 // ReceiverAPI for mock use
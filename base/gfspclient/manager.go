@@ -73,6 +73,27 @@ func (s *GfSpClient) AskTask(ctx context.Context, limit corercmgr.Limit) (coreta
 	if resp.GetErr() != nil {
 		return nil, resp.GetErr()
 	}
+	return TaskFromAskTaskResponse(resp)
+}
+
+// DispatchTaskStream opens the stream that receives the task leases from the manager, the executor advertises
+// its capacity and renews the leases over it.
+func (s *GfSpClient) DispatchTaskStream(ctx context.Context) (gfspserver.GfSpManageService_GfSpDispatchTaskStreamClient, error) {
+	conn, connErr := s.ManagerConn(ctx)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect manager", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect manager, error: ", connErr)
+	}
+	stream, err := gfspserver.NewGfSpManageServiceClient(conn).GfSpDispatchTaskStream(ctx)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to open dispatch task stream", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to open dispatch task stream, error: ", err)
+	}
+	return stream, nil
+}
+
+// TaskFromAskTaskResponse returns the task carried by the ask task response.
+func TaskFromAskTaskResponse(resp *gfspserver.GfSpAskTaskResponse) (coretask.Task, error) {
 	switch t := resp.GetResponse().(type) {
 	case *gfspserver.GfSpAskTaskResponse_ReplicatePieceTask:
 		return t.ReplicatePieceTask, nil
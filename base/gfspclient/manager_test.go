@@ -7,6 +7,7 @@ import (
 	"github.com/stretchr/testify/assert"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
@@ -243,6 +244,34 @@ func TestGfSpClient_AskTaskFailure(t *testing.T) {
 	assert.Nil(t, result)
 }
 
+func TestGfSpClient_DispatchTaskStream(t *testing.T) {
+	ctx := context.Background()
+	s := setup(t, ctx)
+	defer s.Close()
+	stream, err := s.DispatchTaskStream(ctx)
+	assert.Nil(t, err)
+	err = stream.Send(&gfspserver.GfSpExecutorStatus{ExecutorId: "mockExecutor", FreeSlots: 1})
+	assert.Nil(t, err)
+	lease, err := stream.Recv()
+	assert.Nil(t, err)
+	assert.Equal(t, "mockExecutor", lease.GetLeaseId())
+	task, err := TaskFromAskTaskResponse(lease.GetTask())
+	assert.Nil(t, err)
+	assert.Equal(t, &gfsptask.GfSpGCObjectTask{}, task)
+	assert.Nil(t, stream.CloseSend())
+}
+
+func TestGfSpClient_DispatchTaskStreamFailure(t *testing.T) {
+	t.Log("Failure case description: client failed to connect manager")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := mockBufClient()
+	defer s.Close()
+	cancel()
+	stream, err := s.DispatchTaskStream(ctx)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+	assert.Nil(t, stream)
+}
+
 func TestGfSpClient_ReportTask(t *testing.T) {
 	cases := []struct {
 		name        string
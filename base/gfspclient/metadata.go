@@ -104,6 +104,23 @@ func (s *GfSpClient) ListObjectsByBucketName(ctx context.Context, bucketName str
 		resp.GetName(), resp.GetPrefix(), resp.GetDelimiter(), resp.GetCommonPrefixes(), resp.GetContinuationToken(), nil
 }
 
+// ListObjectsByBucketNameWithOptions list objects info by a bucket name with the filters, the sort order or the summary mode
+func (s *GfSpClient) ListObjectsByBucketNameWithOptions(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest,
+	opts ...grpc.DialOption) (*types.GfSpListObjectsByBucketNameResponse, error) {
+	conn, err := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if err != nil {
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", err)
+	}
+	defer conn.Close()
+
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListObjectsByBucketName(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to send list objects by bucket name rpc", "error", err)
+		return nil, ErrRPCUnknownWithDetail("failed to send list objects by bucket name rpc, error: ", err)
+	}
+	return resp, nil
+}
+
 // GetBucketByBucketName get bucket info by a bucket name
 func (s *GfSpClient) GetBucketByBucketName(ctx context.Context, bucketName string, includePrivate bool,
 	opts ...grpc.DialOption) (*types.Bucket, error) {
@@ -1151,3 +1168,104 @@ func (s *GfSpClient) GetBsDBInfo(ctx context.Context, blockHeight uint64, opts .
 	}
 	return resp, nil
 }
+
+func (s *GfSpClient) ListUsageRollup(ctx context.Context, req *types.GfSpListUsageRollupRequest, opts ...grpc.DialOption) ([]*types.UsageRollup, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListUsageRollup(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to list usage rollup", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to list usage rollup, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return nil, resp.GetErr()
+	}
+	return resp.GetRollups(), nil
+}
+
+func (s *GfSpClient) CreateNotificationSubscription(ctx context.Context, subscription *types.NotificationSubscription, secret string, opts ...grpc.DialOption) (uint64, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return 0, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpCreateNotificationSubscriptionRequest{
+		Subscription: subscription,
+		Secret:       secret,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpCreateNotificationSubscription(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to create notification subscription", "error", err)
+		return 0, ErrRPCUnknownWithDetail("client failed to create notification subscription, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return 0, resp.GetErr()
+	}
+	return resp.GetId(), nil
+}
+
+func (s *GfSpClient) ListNotificationSubscriptions(ctx context.Context, bucketName string, opts ...grpc.DialOption) ([]*types.NotificationSubscription, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpListNotificationSubscriptionsRequest{
+		BucketName: bucketName,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListNotificationSubscriptions(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to list notification subscriptions", "error", err)
+		return nil, ErrRPCUnknownWithDetail("client failed to list notification subscriptions, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return nil, resp.GetErr()
+	}
+	return resp.GetSubscriptions(), nil
+}
+
+func (s *GfSpClient) DeleteNotificationSubscription(ctx context.Context, bucketName string, subscriptionID uint64, opts ...grpc.DialOption) error {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &types.GfSpDeleteNotificationSubscriptionRequest{
+		BucketName: bucketName,
+		Id:         subscriptionID,
+	}
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpDeleteNotificationSubscription(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to delete notification subscription", "error", err)
+		return ErrRPCUnknownWithDetail("client failed to delete notification subscription, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return resp.GetErr()
+	}
+	return nil
+}
+
+func (s *GfSpClient) ListNotificationEvents(ctx context.Context, req *types.GfSpListNotificationEventsRequest, opts ...grpc.DialOption) ([]*types.NotificationEvent, uint64, error) {
+	conn, connErr := s.Connection(ctx, s.metadataEndpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect metadata", "error", connErr)
+		return nil, 0, ErrRPCUnknownWithDetail("client failed to connect metadata, error: ", connErr)
+	}
+	defer conn.Close()
+	resp, err := types.NewGfSpMetadataServiceClient(conn).GfSpListNotificationEvents(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to list notification events", "error", err)
+		return nil, 0, ErrRPCUnknownWithDetail("client failed to list notification events, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return nil, 0, resp.GetErr()
+	}
+	return resp.GetEvents(), resp.GetLastId(), nil
+}
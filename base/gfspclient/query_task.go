@@ -76,3 +76,25 @@ func (s *GfSpClient) QuerySPExit(ctx context.Context, endpoint string, opts ...g
 	}
 	return string(jsonData), nil
 }
+
+// ReloadConfig asks the gfsp server to reload its configuration, returns the config paths that are applied
+// and the ones that require restarting to take effect. If dryRun is true, the server only reports the changes.
+func (s *GfSpClient) ReloadConfig(ctx context.Context, endpoint string, dryRun bool, opts ...grpc.DialOption) (
+	[]string, []string, error) {
+	conn, connErr := s.Connection(ctx, endpoint, opts...)
+	if connErr != nil {
+		log.CtxErrorw(ctx, "client failed to connect gfsp server", "error", connErr)
+		return nil, nil, ErrRPCUnknownWithDetail("client failed to connect gfsp server, error: ", connErr)
+	}
+	defer conn.Close()
+	req := &gfspserver.GfSpReloadConfigRequest{DryRun: dryRun}
+	resp, err := gfspserver.NewGfSpQueryTaskServiceClient(conn).GfSpReloadConfig(ctx, req)
+	if err != nil {
+		log.CtxErrorw(ctx, "client failed to reload config", "error", err)
+		return nil, nil, ErrRPCUnknownWithDetail("client failed to reload config, error: ", err)
+	}
+	if resp.GetErr() != nil {
+		return nil, nil, resp.GetErr()
+	}
+	return resp.GetApplied(), resp.GetRestartRequired(), nil
+}
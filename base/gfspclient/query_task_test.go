@@ -172,3 +172,58 @@ func TestGfSpClient_QuerySPExitFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), context.Canceled.Error())
 	assert.Empty(t, result)
 }
+
+func TestGfSpClient_ReloadConfig(t *testing.T) {
+	cases := []struct {
+		name        string
+		value       string
+		wantedIsErr bool
+		wantedErr   error
+	}{
+		{
+			name:        "success",
+			value:       mockObjectName3,
+			wantedIsErr: false,
+		},
+		{
+			name:        "mock rpc error",
+			value:       mockObjectName1,
+			wantedIsErr: true,
+			wantedErr:   mockRPCErr,
+		},
+		{
+			name:        "mock response returns error",
+			value:       mockObjectName2,
+			wantedIsErr: true,
+			wantedErr:   ErrExceptionsStream,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			s := mockBufClient()
+			md := metadata.Pairs(mockBufNet, tt.value)
+			ctx1 := metadata.NewOutgoingContext(context.Background(), md)
+			applied, restartRequired, err := s.ReloadConfig(ctx1, mockAddress, true, grpc.WithContextDialer(bufDialer),
+				grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if tt.wantedIsErr {
+				assert.Contains(t, err.Error(), tt.wantedErr.Error())
+				assert.Empty(t, applied)
+				assert.Empty(t, restartRequired)
+			} else {
+				assert.Nil(t, err)
+				assert.Equal(t, []string{"Parallel.GlobalSealObjectParallel"}, applied)
+				assert.Equal(t, []string{"GRPCAddress"}, restartRequired)
+			}
+		})
+	}
+}
+
+func TestGfSpClient_ReloadConfigFailure(t *testing.T) {
+	t.Log("Failure case description: client failed to connect gfsp server")
+	ctx, cancel := context.WithCancel(context.Background())
+	s := mockBufClient()
+	defer s.Close()
+	cancel()
+	_, _, err := s.ReloadConfig(ctx, mockAddress, false)
+	assert.Contains(t, err.Error(), context.Canceled.Error())
+}
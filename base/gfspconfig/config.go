@@ -11,6 +11,7 @@ import (
 	coretaskqueue "github.com/bnb-chain/greenfield-storage-provider/core/taskqueue"
 	"github.com/bnb-chain/greenfield-storage-provider/core/vgmgr"
 	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	storeconfig "github.com/bnb-chain/greenfield-storage-provider/store/config"
 	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
 )
@@ -50,13 +51,16 @@ type GfSpConfig struct {
 	Bucket         BucketConfig
 	Gateway        GatewayConfig
 	Executor       ExecutorConfig
+	Downloader     DownloaderConfig
 	P2P            P2PConfig
 	Parallel       ParallelConfig
 	Task           TaskConfig
 	Monitor        MonitorConfig
+	Trace          tracing.Config
 	Rcmgr          RcmgrConfig `comment:"optional"`
 	Log            LogConfig
 	BlockSyncer    BlockSyncerConfig
+	Metadata       MetadataConfig
 	APIRateLimiter mwhttp.RateLimiterConfig
 	Manager        ManagerConfig
 	GC             GCConfig
@@ -99,16 +103,40 @@ type ChainConfig struct {
 	CreateGlobalVirtualGroupFeeAmount uint64   `comment:"optional"`
 	CompleteMigrateBucketGasLimit     uint64   `comment:"optional"`
 	CompleteMigrateBucketFeeAmount    uint64   `comment:"optional"`
+	// TxBatchSize is the max number of msgs that the signer broadcasts in one tx, e.g. seals several
+	// objects in one tx, 1 disables the batching.
+	TxBatchSize uint `comment:"optional"`
+	// TxBatchWindowMillisecond is the time that the signer waits for more msgs to fill a batch, 0 means
+	// only the msgs already waiting are batched.
+	TxBatchWindowMillisecond uint `comment:"optional"`
+	// TxResubmitTimeoutSecond is the timeout after that the signer regards the uncommitted tx as dropped
+	// and resubmits it.
+	TxResubmitTimeoutSecond uint `comment:"optional"`
+	// TxMaxResubmit is the max number of resubmitting a dropped tx.
+	TxMaxResubmit uint `comment:"optional"`
 }
 
 type SpAccountConfig struct {
 	SpOperatorAddress  string `comment:"required"`
-	OperatorPrivateKey string `comment:"required"`
+	OperatorPrivateKey string `comment:"optional"`
 	FundingPrivateKey  string `comment:"optional"`
-	SealPrivateKey     string `comment:"required"`
-	ApprovalPrivateKey string `comment:"required"`
-	GcPrivateKey       string `comment:"required"`
-	BlsPrivateKey      string `comment:"required"`
+	SealPrivateKey     string `comment:"optional"`
+	ApprovalPrivateKey string `comment:"optional"`
+	GcPrivateKey       string `comment:"optional"`
+	BlsPrivateKey      string `comment:"optional"`
+	// KeyProvider is the provider of the signing keys, supports config(default), env, keystore and remote,
+	// the private keys above are only required by the config provider
+	KeyProvider            string `comment:"optional"`
+	KeystoreDir            string `comment:"optional"`
+	KeystorePassphraseFile string `comment:"optional"`
+	RemoteSignerAddress    string `comment:"optional"`
+	// RemoteSignerCAFile, RemoteSignerCertFile and RemoteSignerKeyFile are the CA that verifies the remote
+	// signer and the client certificate that the remote signer authenticates, they are required by the
+	// remote provider unless RemoteSignerInsecure is set, which is only for the local development.
+	RemoteSignerCAFile   string `comment:"optional"`
+	RemoteSignerCertFile string `comment:"optional"`
+	RemoteSignerKeyFile  string `comment:"optional"`
+	RemoteSignerInsecure bool   `comment:"optional"`
 }
 
 type EndpointConfig struct {
@@ -138,6 +166,15 @@ type BucketConfig struct {
 type GatewayConfig struct {
 	DomainName  string `comment:"required"`
 	HTTPAddress string `comment:"required"`
+	// S3DomainName enables the S3-compatible api facade on the domain, e.g. s3.gnfd-sp.example.com, both
+	// the path style and the virtual hosted style requests are served
+	S3DomainName string `comment:"optional"`
+	// S3CredentialSecret derives the S3 secret access keys of the accounts, it is required by the S3
+	// facade, rotating it revokes all the issued S3 credentials
+	S3CredentialSecret string `comment:"optional"`
+	// S3Region is the region that the S3 clients should sign the requests with, any region is accepted if
+	// it is empty
+	S3Region string `comment:"optional"`
 }
 
 type ExecutorConfig struct {
@@ -154,6 +191,9 @@ type ExecutorConfig struct {
 	BucketTrafficKeepTimeDay        uint64  `comment:"optional"`
 	ReadRecordKeepTimeDay           uint64  `comment:"optional"`
 	ReadRecordDeleteLimit           uint64  `comment:"optional"`
+	// EnableStreamDispatch is used to receive the task leases from the manager over a stream that advertises
+	// the capacity of the executor, instead of polling the manager for the tasks.
+	EnableStreamDispatch bool `comment:"optional"`
 }
 
 type P2PConfig struct {
@@ -214,6 +254,7 @@ type ParallelConfig struct {
 	GlobalGCStaleVersionObjectParallel int `comment:"optional"`
 
 	UploadObjectParallelPerNode         int   `comment:"optional"`
+	UploadPieceParallelPerObject        int   `comment:"optional"`
 	ReceivePieceParallelPerNode         int   `comment:"optional"`
 	DownloadObjectParallelPerNode       int   `comment:"optional"`
 	ChallengePieceParallelPerNode       int   `comment:"optional"`
@@ -274,6 +315,10 @@ type BlockSyncerConfig struct {
 	DataMonitor            bool             `comment:"optional"`
 	DataStatisticsDuration int64            `comment:"optinal"`
 	ChainDataStorage       ChainDataStorage `comment:"optional"`
+	Checkpoint             Checkpoint       `comment:"optional"`
+	// MaxInFlightBlocks defines the number of the blocks that are prefetched ahead of the processed height
+	// concurrently, it defaults to four times of the workers
+	MaxInFlightBlocks uint64 `comment:"optional"`
 }
 
 type ChainDataStorage struct {
@@ -281,10 +326,46 @@ type ChainDataStorage struct {
 	MaximumStorageCount uint64 `comment:"required"`
 }
 
+// Checkpoint defines the periodic checkpoints of the block syncer tables, a checkpoint copies all the tables
+// from a consistent snapshot into a separate schema in the background without blocking the syncing, the
+// checkpoint is skipped if the previous one is still in progress. The checkpoints are used by the
+// blocksyncer.rebuild command.
+type Checkpoint struct {
+	EnableCheckpoint bool `comment:"optional"`
+	// IntervalBlocks defines the block interval of taking the checkpoints
+	IntervalBlocks uint64 `comment:"optional"`
+	// MaximumCheckpointCount defines the number of the latest checkpoints to keep
+	MaximumCheckpointCount uint64 `comment:"optional"`
+}
+
 type MetadataConfig struct {
 	// IsMasterDB is used to determine if the master database (BsDBConfig) is currently being used.
 	IsMasterDB                 bool  `comment:"required"`
 	BsDBSwitchCheckIntervalSec int64 `comment:"optional"`
+	// EnableMetering is used to enable rolling up the daily usage of the buckets that the SP serves as primary SP.
+	EnableMetering bool `comment:"optional"`
+	// MeteringIntervalSec defines the interval of checking whether there are finished days to be rolled up.
+	MeteringIntervalSec int64 `comment:"optional"`
+	// EnableNotification is used to enable delivering the notification events to the webhooks of the subscriptions,
+	// the delivery is at-least-once and should be enabled on only one metadata instance.
+	EnableNotification bool `comment:"optional"`
+	// NotificationMaxAttempts defines the max attempts of delivering a notification event to a webhook.
+	NotificationMaxAttempts uint32 `comment:"optional"`
+	// NotificationTimeoutSec defines the timeout of a webhook request in seconds.
+	NotificationTimeoutSec int64 `comment:"optional"`
+	// NotificationEventRetentionSec defines the seconds of keeping the notification events in BSDB, the events that
+	// have not been delivered to all the subscriptions are kept longer.
+	NotificationEventRetentionSec int64 `comment:"optional"`
+	// NotificationAllowPrivateWebhook is used to allow the webhooks resolved to loopback, private or link-local addresses.
+	NotificationAllowPrivateWebhook bool `comment:"optional"`
+	// EnableCache is used to enable the read-through cache of the buckets, objects, group members and policies that
+	// the permission checks query, the cache_invalidation module of the block syncer invalidates the changed entries,
+	// so the metadata service does not start if the module is not in the BlockSyncer.Modules.
+	EnableCache bool `comment:"optional"`
+	// CacheSize defines the max number of the entries in the cache.
+	CacheSize int `comment:"optional"`
+	// CacheTTLSec defines the seconds after which a cache entry expires.
+	CacheTTLSec int64 `comment:"optional"`
 }
 
 type ManagerConfig struct {
@@ -307,6 +388,70 @@ type ManagerConfig struct {
 
 	// EnableBucketMigrateCache is used to enable bucket migrate's bucket cache.
 	EnableBucketMigrateCache bool `comment:"optional"`
+
+	// PersistentTaskQueueDir is the directory of the persistent task queue logs, if it is set, the queues
+	// with limit are persisted to it, and the queued tasks survive restarting.
+	PersistentTaskQueueDir string `comment:"optional"`
+	// PersistentTaskQueueSyncWrite is used to fsync the persistent task queue log on every write.
+	PersistentTaskQueueSyncWrite bool `comment:"optional"`
+
+	// EnableScrubber is used to enable the background scrubber, it continuously re-reads the pieces of the
+	// GVGs that the SP serves, verifies them against the integrity meta and recovers the corrupt ones.
+	EnableScrubber bool `comment:"optional"`
+	// ScrubIntervalSecond is the interval between two scrub batches of one GVG.
+	ScrubIntervalSecond uint64 `comment:"optional"`
+	// ScrubBatchSize is the object number listed from one GVG in one scrub batch.
+	ScrubBatchSize uint32 `comment:"optional"`
+	// ScrubBandwidthLimitBytes is the max bytes per second read from the piece store by the scrubber.
+	ScrubBandwidthLimitBytes uint64 `comment:"optional"`
+	// ScrubSampleRate is the percentage of objects in a batch to be verified, 100(default) means a full sweep.
+	ScrubSampleRate uint32 `comment:"optional"`
+
+	// TaskLeaseTTLSecond is the seconds after which a task leased to a streaming executor is revoked if the
+	// executor does not renew the lease, the executor renews its leases every third of it.
+	TaskLeaseTTLSecond int64 `comment:"optional"`
+
+	// TaskScheduler is the policy of picking the task to dispatch among the task queues.
+	TaskScheduler TaskSchedulerConfig `comment:"optional"`
+}
+
+type TaskSchedulerConfig struct {
+	// Policy supports priority(default) that picks the task randomly by the task priority, and wfq that picks
+	// the task by the weighted fair queuing across the task types.
+	Policy string `comment:"optional"`
+	// TaskTypes are the weights and the min guaranteed shares of the task types for wfq, the task types that
+	// are not configured use the default ones.
+	TaskTypes []TaskTypeSchedulerConfig `comment:"optional"`
+	// AgingSecond is the seconds after which the weight of a task type that is not dispatched is doubled for
+	// wfq, 0 means the default, and a negative value disables aging.
+	AgingSecond int64 `comment:"optional"`
+	// WindowSize is the number of the latest dispatched tasks that the shares are computed over.
+	WindowSize uint32 `comment:"optional"`
+}
+
+type TaskTypeSchedulerConfig struct {
+	// TaskType is the name of the task type, such as ReplicatePieceTask, SealObjectTask and RecoverPieceTask.
+	TaskType string `comment:"optional"`
+	// Weight is the relative share of the task type when all the task types have tasks to dispatch.
+	Weight uint32 `comment:"optional"`
+	// MinSharePercent is the share that is guaranteed to the task type as long as it has tasks to dispatch.
+	MinSharePercent uint32 `comment:"optional"`
+}
+
+type DownloaderConfig struct {
+	// PieceDiskCacheDir is the directory of the on-disk piece cache tier, the whole segment pieces are
+	// cached in it and the range reads are served from them, it is disabled if the dir is empty.
+	PieceDiskCacheDir string `comment:"optional"`
+	// PieceDiskCacheSizeMB is the capacity of the on-disk piece cache tier.
+	PieceDiskCacheSizeMB uint64 `comment:"optional"`
+	// PieceDiskCachePolicy is the eviction policy of the on-disk piece cache tier, supports lru(default) and arc.
+	PieceDiskCachePolicy string `comment:"optional"`
+	// PrefetchSegments is the max number of segment pieces that are fetched in parallel ahead of the
+	// one being sent when downloading an object.
+	PrefetchSegments int `comment:"optional"`
+	// ReadQuotaReservationTimeout is the seconds after which the read quota reserved by an unfinished download
+	// is refunded, it should be longer than the time of downloading the largest object.
+	ReadQuotaReservationTimeout int64 `comment:"optional"`
 }
 
 type QuotaConfig struct {
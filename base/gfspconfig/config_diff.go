@@ -0,0 +1,65 @@
+package gfspconfig
+
+import (
+	"reflect"
+
+	"github.com/pelletier/go-toml/v2"
+)
+
+// Clone returns a deep copy of the GfSp configuration, the customized implements are not copied.
+func (cfg *GfSpConfig) Clone() (*GfSpConfig, error) {
+	shallow := *cfg
+	shallow.Customize = nil
+	bz, err := toml.Marshal(&shallow)
+	if err != nil {
+		return nil, err
+	}
+	clone := &GfSpConfig{}
+	if err = toml.Unmarshal(bz, clone); err != nil {
+		return nil, err
+	}
+	return clone, nil
+}
+
+// Diff returns the paths of the fields that differ between the configurations, e.g.
+// "Parallel.GlobalSealObjectParallel". The slices and maps are compared as a whole, the empty and the
+// nil ones are regarded as equal, and the customized implements are skipped.
+func (cfg *GfSpConfig) Diff(other *GfSpConfig) []string {
+	shallow, otherShallow := *cfg, *other
+	shallow.Customize, otherShallow.Customize = nil, nil
+	return diffValue("", reflect.ValueOf(shallow), reflect.ValueOf(otherShallow), nil)
+}
+
+func diffValue(path string, a, b reflect.Value, paths []string) []string {
+	switch a.Kind() {
+	case reflect.Ptr:
+		if a.IsNil() || b.IsNil() {
+			if a.IsNil() != b.IsNil() {
+				paths = append(paths, path)
+			}
+			return paths
+		}
+		return diffValue(path, a.Elem(), b.Elem(), paths)
+	case reflect.Struct:
+		for i := 0; i < a.NumField(); i++ {
+			field := a.Type().Field(i)
+			if !field.IsExported() {
+				continue
+			}
+			fieldPath := field.Name
+			if path != "" {
+				fieldPath = path + "." + field.Name
+			}
+			paths = diffValue(fieldPath, a.Field(i), b.Field(i), paths)
+		}
+		return paths
+	case reflect.Slice, reflect.Map:
+		if a.Len() == 0 && b.Len() == 0 {
+			return paths
+		}
+	}
+	if !reflect.DeepEqual(a.Interface(), b.Interface()) {
+		paths = append(paths, path)
+	}
+	return paths
+}
@@ -0,0 +1,35 @@
+package gfspconfig
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestGfSpConfig_Clone(t *testing.T) {
+	cfg := &GfSpConfig{
+		AppID:     "mock",
+		Server:    []string{"uploader"},
+		Customize: &Customize{},
+		Parallel:  ParallelConfig{GlobalSealObjectParallel: 10},
+	}
+	clone, err := cfg.Clone()
+	assert.Nil(t, err)
+	assert.Nil(t, clone.Customize)
+	assert.NotNil(t, cfg.Customize)
+	assert.Empty(t, cfg.Diff(clone))
+
+	clone.Server[0] = "downloader"
+	assert.Equal(t, "uploader", cfg.Server[0])
+}
+
+func TestGfSpConfig_Diff(t *testing.T) {
+	cfg := &GfSpConfig{Customize: &Customize{}}
+	other := &GfSpConfig{Server: []string{}}
+	assert.Empty(t, cfg.Diff(other))
+
+	other.Server = []string{"uploader"}
+	other.Parallel.GlobalSealObjectParallel = 10
+	other.Executor.MaxExecuteNumber = 5
+	assert.Equal(t, []string{"Server", "Executor.MaxExecuteNumber", "Parallel.GlobalSealObjectParallel"}, cfg.Diff(other))
+}
@@ -1,7 +1,6 @@
 package gfsptqueue
 
 import (
-	"sort"
 	"sync"
 	"time"
 
@@ -10,7 +9,6 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/core/taskqueue"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
-	"github.com/bnb-chain/greenfield-storage-provider/util/maps"
 )
 
 var _ taskqueue.TQueueWithLimit = &GfSpTQueueWithLimit{}
@@ -19,7 +17,7 @@ var _ taskqueue.TQueueOnStrategyWithLimit = &GfSpTQueueWithLimit{}
 type GfSpTQueueWithLimit struct {
 	name    string
 	current int64
-	tasks   map[coretask.TKey]coretask.Task
+	tasks   taskIndex
 	cap     int
 	mux     sync.RWMutex
 
@@ -29,9 +27,8 @@ type GfSpTQueueWithLimit struct {
 
 func NewGfSpTQueueWithLimit(name string, cap int) taskqueue.TQueueOnStrategyWithLimit {
 	return &GfSpTQueueWithLimit{
-		name:  name,
-		cap:   cap,
-		tasks: make(map[coretask.TKey]coretask.Task),
+		name: name,
+		cap:  cap,
 	}
 }
 
@@ -39,14 +36,24 @@ func NewGfSpTQueueWithLimit(name string, cap int) taskqueue.TQueueOnStrategyWith
 func (t *GfSpTQueueWithLimit) Len() int {
 	t.mux.RLock()
 	defer t.mux.RUnlock()
-	return len(t.tasks)
+	return t.tasks.len()
 }
 
 // Cap returns the capacity of queue.
 func (t *GfSpTQueueWithLimit) Cap() int {
+	t.mux.RLock()
+	defer t.mux.RUnlock()
 	return t.cap
 }
 
+// SetCap sets the capacity of queue, the tasks beyond the new capacity are kept until they are popped.
+func (t *GfSpTQueueWithLimit) SetCap(cap int) {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	t.cap = cap
+	metrics.QueueCapGauge.WithLabelValues(t.name).Set(float64(t.cap))
+}
+
 // Has returns an indicator whether the task in queue.
 func (t *GfSpTQueueWithLimit) Has(key coretask.TKey) bool {
 	// maybe gc task, need RWLock, not RLock
@@ -97,7 +104,7 @@ func (t *GfSpTQueueWithLimit) PopByKey(key coretask.TKey) coretask.Task {
 	if !t.has(key) {
 		return nil
 	}
-	task, ok := t.tasks[key]
+	task, ok := t.tasks.get(key)
 	if !ok {
 		return nil
 	}
@@ -118,41 +125,42 @@ func (t *GfSpTQueueWithLimit) Push(task coretask.Task) error {
 	}
 	if t.exceed() {
 		if t.gcFunc == nil {
-			log.Warnw("queue exceed", "queue", t.name, "cap", t.cap, "len", len(t.tasks))
+			log.Warnw("queue exceed", "queue", t.name, "cap", t.cap, "len", t.tasks.len())
 			return ErrTaskQueueExceed
 		}
-		clear := false
-		keys := maps.SortKeys(t.tasks)
-		for _, key := range keys {
-			if t.gcFunc(t.tasks[key]) {
-				t.delete(t.tasks[key])
-				clear = true
+		// the expired tasks are usually the earliest created ones, retire in the order of index
+		var retired coretask.Task
+		t.tasks.ascend(func(task coretask.Task) bool {
+			if t.gcFunc(task) {
+				retired = task
 				// only retire one task
-				break
+				return false
 			}
-		}
-		if !clear {
-			log.Warnw("queue exceed", "queue", t.name, "cap", t.cap, "len", len(t.tasks))
+			return true
+		})
+		if retired == nil {
+			log.Warnw("queue exceed", "queue", t.name, "cap", t.cap, "len", t.tasks.len())
 			return ErrTaskQueueExceed
 		}
+		t.delete(retired)
 	}
 	t.add(task)
 	return nil
 }
 
 func (t *GfSpTQueueWithLimit) exceed() bool {
-	return len(t.tasks) >= t.cap
+	return t.tasks.len() >= t.cap
 }
 
 func (t *GfSpTQueueWithLimit) add(task coretask.Task) {
 	defer func() {
-		metrics.QueueSizeGauge.WithLabelValues(t.name).Set(float64(len(t.tasks)))
+		metrics.QueueSizeGauge.WithLabelValues(t.name).Set(float64(t.tasks.len()))
 		metrics.QueueCapGauge.WithLabelValues(t.name).Set(float64(t.cap))
 	}()
 	if task == nil || t.has(task.Key()) {
 		return
 	}
-	t.tasks[task.Key()] = task
+	t.tasks.add(task)
 }
 
 func (t *GfSpTQueueWithLimit) delete(task coretask.Task) {
@@ -160,65 +168,58 @@ func (t *GfSpTQueueWithLimit) delete(task coretask.Task) {
 		return
 	}
 	defer func() {
-		metrics.QueueSizeGauge.WithLabelValues(t.name).Set(float64(len(t.tasks)))
+		metrics.QueueSizeGauge.WithLabelValues(t.name).Set(float64(t.tasks.len()))
 		metrics.QueueCapGauge.WithLabelValues(t.name).Set(float64(t.cap))
 		metrics.TaskInQueueTime.WithLabelValues(t.name).Observe(
 			time.Since(time.Unix(task.GetCreateTime(), 0)).Seconds())
 	}()
-	delete(t.tasks, task.Key())
+	t.tasks.delete(task.Key())
 }
 
 func (t *GfSpTQueueWithLimit) has(key coretask.TKey) bool {
-	task, ok := t.tasks[key]
+	task, ok := t.tasks.get(key)
 	if ok && t.gcFunc != nil {
 		if t.gcFunc(task) {
-			delete(t.tasks, task.Key())
+			t.tasks.delete(key)
 			return false
 		}
 	}
 	return ok
 }
 
+// topByLimit returns the first task that is not retired, fits the limit and passes the filter in the
+// order of priority, the tasks of the same priority are picked round-robin by create time from the
+// cursor of the last picked task, so the load spreads over the tasks. The retired tasks visited are
+// deleted.
 func (t *GfSpTQueueWithLimit) topByLimit(limit corercmgr.Limit) coretask.Task {
-	if len(t.tasks) == 0 {
+	if t.tasks.len() == 0 {
 		return nil
 	}
-	var backupTasks []coretask.Task
-	var gcTasks []coretask.Task
-	defer func() {
-		for _, task := range gcTasks {
-			delete(t.tasks, task.Key())
+	var (
+		topTask coretask.Task
+		gcTasks []coretask.Task
+	)
+	t.tasks.ascendFrom(t.current, func(task coretask.Task) bool {
+		if t.gcFunc != nil && t.gcFunc(task) {
+			gcTasks = append(gcTasks, task)
+			return true
 		}
-	}()
-
-	for _, task := range t.tasks {
-		if t.gcFunc != nil {
-			if t.gcFunc(task) {
-				gcTasks = append(gcTasks, task)
-				continue
-			}
+		if !limit.NotLess(task.EstimateLimit()) {
+			return true
 		}
-		if limit.NotLess(task.EstimateLimit()) {
-			if t.filterFunc != nil && !t.filterFunc(task) {
-				continue
-			}
-			backupTasks = append(backupTasks, task)
+		if t.filterFunc != nil && !t.filterFunc(task) {
+			return true
 		}
-	}
-	if len(backupTasks) == 0 {
-		return nil
-	}
-	sort.Slice(backupTasks, func(i, j int) bool {
-		return backupTasks[i].GetCreateTime() < backupTasks[j].GetCreateTime()
+		topTask = task
+		return false
 	})
-	index := sort.Search(len(backupTasks), func(i int) bool { return backupTasks[i].GetCreateTime() > t.current })
-	if index == len(backupTasks) {
-		index = 0
+	for _, task := range gcTasks {
+		t.tasks.delete(task.Key())
 	}
-	if backupTasks[index] != nil {
-		t.current = backupTasks[index].GetCreateTime()
+	if topTask != nil {
+		t.current = topTask.GetCreateTime()
 	}
-	return backupTasks[index]
+	return topTask
 }
 
 // SetFilterTaskStrategy sets the callback func to filter task for popping or topping.
@@ -240,7 +241,8 @@ func (t *GfSpTQueueWithLimit) SetRetireTaskStrategy(retire func(coretask.Task) b
 func (t *GfSpTQueueWithLimit) ScanTask(scan func(coretask.Task)) {
 	t.mux.RLock()
 	defer t.mux.RUnlock()
-	for _, task := range t.tasks {
+	t.tasks.ascend(func(task coretask.Task) bool {
 		scan(task)
-	}
+		return true
+	})
 }
@@ -1,6 +1,7 @@
 package gfsptqueue
 
 import (
+	"fmt"
 	"testing"
 
 	sdkmath "cosmossdk.io/math"
@@ -27,6 +28,33 @@ func TestGfSpTQueueWithLimit_Cap(t *testing.T) {
 	assert.Equal(t, 1, result)
 }
 
+func TestGfSpTQueueWithLimit_SetCap(t *testing.T) {
+	queue := NewGfSpTQueueWithLimit("mock", 1)
+	task1 := &gfsptask.GfSpReplicatePieceTask{
+		ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: "task_1"},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{CreateTime: 1},
+	}
+	task2 := &gfsptask.GfSpReplicatePieceTask{
+		ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: "task_2"},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{CreateTime: 1},
+	}
+	err := queue.Push(task1)
+	assert.Nil(t, err)
+	queue.SetCap(2)
+	assert.Equal(t, 2, queue.Cap())
+	err = queue.Push(task2)
+	assert.Nil(t, err)
+
+	t.Log("Case description: the tasks beyond the new capacity are kept")
+	queue.SetCap(1)
+	assert.Equal(t, 2, queue.Len())
+	queue.PopByKey(task1.Key())
+	err = queue.Push(task1)
+	assert.Equal(t, ErrTaskQueueExceed, err)
+}
+
 func TestGfSpTQueueWithLimit_Has(t *testing.T) {
 	queue := NewGfSpTQueueWithLimit("mock", 1)
 	result := queue.Has("test")
@@ -266,3 +294,130 @@ func TestGfSpTQueueWithLimit_ScanTask(t *testing.T) {
 	assert.Nil(t, err)
 	queue.ScanTask(func(task coretask.Task) { log.Info(task) })
 }
+
+func TestGfSpTQueueWithLimit_PopByPriorityAndCreateTime(t *testing.T) {
+	queue := NewGfSpTQueueWithLimit("mock", 4)
+	newTask := func(name string, priority coretask.TPriority, createTime int64) *gfsptask.GfSpReplicatePieceTask {
+		return &gfsptask.GfSpReplicatePieceTask{
+			ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: name},
+			StorageParams: &storagetypes.Params{},
+			Task:          &gfsptask.GfSpTask{CreateTime: createTime, TaskPriority: int32(priority)},
+		}
+	}
+	require.NoError(t, queue.Push(newTask("task_1", coretask.UnSchedulingPriority, 1)))
+	require.NoError(t, queue.Push(newTask("task_2", coretask.MaxTaskPriority, 3)))
+	require.NoError(t, queue.Push(newTask("task_3", coretask.MaxTaskPriority, 2)))
+	require.NoError(t, queue.Push(newTask("task_4", coretask.DefaultLargerTaskPriority, 0)))
+
+	var names []string
+	for task := queue.PopByLimit(&corercmgr.Unlimited{}); task != nil; task = queue.PopByLimit(&corercmgr.Unlimited{}) {
+		names = append(names, task.(*gfsptask.GfSpReplicatePieceTask).GetObjectInfo().GetObjectName())
+	}
+	assert.Equal(t, []string{"task_3", "task_2", "task_4", "task_1"}, names)
+}
+
+func newBenchmarkReplicateTasks(n int) []coretask.Task {
+	tasks := make([]coretask.Task, n)
+	for i := 0; i < n; i++ {
+		tasks[i] = &gfsptask.GfSpReplicatePieceTask{
+			ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: fmt.Sprintf("task_%d", i), Id: sdkmath.NewUint(uint64(i))},
+			StorageParams: &storagetypes.Params{},
+			Task:          &gfsptask.GfSpTask{CreateTime: int64(i), TaskPriority: int32(i % 3)},
+		}
+	}
+	return tasks
+}
+
+func BenchmarkGfSpTQueueWithLimit_Push(b *testing.B) {
+	tasks := newBenchmarkReplicateTasks(100000)
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		b.StopTimer()
+		queue := NewGfSpTQueueWithLimit("bench", len(tasks))
+		b.StartTimer()
+		for _, task := range tasks {
+			_ = queue.Push(task)
+		}
+	}
+}
+
+func BenchmarkGfSpTQueueWithLimit_PopByLimitAndPush(b *testing.B) {
+	tasks := newBenchmarkReplicateTasks(100000)
+	queue := NewGfSpTQueueWithLimit("bench", len(tasks))
+	for _, task := range tasks {
+		_ = queue.Push(task)
+	}
+	limit := &corercmgr.Unlimited{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		task := queue.PopByLimit(limit)
+		_ = queue.Push(task)
+	}
+}
+
+func BenchmarkGfSpTQueueWithLimit_PopByLimitWithFilter(b *testing.B) {
+	tasks := newBenchmarkReplicateTasks(100000)
+	queue := NewGfSpTQueueWithLimit("bench", len(tasks))
+	for _, task := range tasks {
+		_ = queue.Push(task)
+	}
+	// only one of ten tasks can be dispatched
+	queue.SetFilterTaskStrategy(func(task coretask.Task) bool { return task.GetCreateTime()%10 == 0 })
+	limit := &corercmgr.Unlimited{}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		task := queue.PopByLimit(limit)
+		_ = queue.Push(task)
+	}
+}
+
+func BenchmarkGfSpTQueueWithLimit_PushRetire(b *testing.B) {
+	tasks := newBenchmarkReplicateTasks(100000)
+	queue := NewGfSpTQueueWithLimit("bench", len(tasks))
+	for _, task := range tasks {
+		// the tasks in the same queue have the same priority of task type
+		task.SetPriority(coretask.DefaultLargerTaskPriority)
+		_ = queue.Push(task)
+	}
+	// the tasks created earlier than 1000 are expired
+	queue.SetRetireTaskStrategy(func(task coretask.Task) bool { return task.GetCreateTime() < 1000 })
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		task := &gfsptask.GfSpReplicatePieceTask{
+			ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: fmt.Sprintf("new_task_%d", i), Id: sdkmath.NewUint(uint64(i))},
+			StorageParams: &storagetypes.Params{},
+			Task:          &gfsptask.GfSpTask{CreateTime: int64(len(tasks) + i), TaskPriority: int32(coretask.DefaultLargerTaskPriority)},
+		}
+		_ = queue.Push(task)
+	}
+}
+
+func TestGfSpTQueueWithLimit_TopByLimitRoundRobin(t *testing.T) {
+	queue := NewGfSpTQueueWithLimit("mock", 10)
+	for i := int64(1); i <= 3; i++ {
+		require.NoError(t, queue.Push(&gfsptask.GfSpReplicatePieceTask{
+			ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: fmt.Sprintf("task_%d", i), Id: sdkmath.NewUint(uint64(i))},
+			StorageParams: &storagetypes.Params{},
+			Task:          &gfsptask.GfSpTask{CreateTime: i},
+		}))
+	}
+
+	t.Log("Case description: the tasks of the same priority rotate from the last picked task")
+	var createTimes []int64
+	for i := 0; i < 4; i++ {
+		createTimes = append(createTimes, queue.TopByLimit(&corercmgr.Unlimited{}).GetCreateTime())
+	}
+	assert.Equal(t, []int64{1, 2, 3, 1}, createTimes)
+
+	t.Log("Case description: the higher priority task is still picked first")
+	high := &gfsptask.GfSpReplicatePieceTask{
+		ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: "task_high", Id: sdkmath.NewUint(4)},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{CreateTime: 4},
+	}
+	high.SetPriority(coretask.MaxTaskPriority)
+	require.NoError(t, queue.Push(high))
+	assert.Equal(t, high.Key(), queue.PopByLimit(&corercmgr.Unlimited{}).Key())
+	assert.Equal(t, int64(1), queue.PopByLimit(&corercmgr.Unlimited{}).GetCreateTime())
+	assert.Equal(t, int64(2), queue.PopByLimit(&corercmgr.Unlimited{}).GetCreateTime())
+}
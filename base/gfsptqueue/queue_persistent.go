@@ -0,0 +1,478 @@
+package gfsptqueue
+
+import (
+	"bufio"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"hash/crc32"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/core/taskqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// PersistentTQueueLogSuffix defines the file suffix of the persistent task queue log.
+	PersistentTQueueLogSuffix = ".tqlog"
+	// DefaultPersistentTQueueCompactThreshold defines the default number of log records that can be
+	// appended on top of the live tasks before the log is compacted.
+	DefaultPersistentTQueueCompactThreshold = 4096
+
+	recordOpPut byte = 1
+	recordOpDel byte = 2
+
+	// recordHeaderSize is the size of [payload length uint32][payload crc32 uint32].
+	recordHeaderSize = 8
+	// maxRecordSize protects the replay from allocating huge buffers on a corrupted length field.
+	maxRecordSize = 64 * 1024 * 1024
+)
+
+var (
+	ErrUnsupportedPersistentTask = errors.New("unsupported task type for persistent queue")
+	ErrTaskRemoved               = gfsperrors.Register(TaskQueue, http.StatusBadRequest, 970003, "task has been removed")
+	errCorruptedRecord           = errors.New("corrupted persistent queue record")
+)
+
+var _ taskqueue.TQueueWithLimit = &GfSpPersistentTQueueWithLimit{}
+var _ taskqueue.TQueueOnStrategyWithLimit = &GfSpPersistentTQueueWithLimit{}
+
+// GfSpPersistentTQueueWithLimit is a crash-safe task queue with limit. The tasks are kept in an embedded
+// GfSpTQueueWithLimit for scheduling, and every mutation is appended to a local log file, so the queue
+// can be rebuilt with the same tasks, retry counts and priorities after restarting.
+//
+// Popping by limit only removes the task from the memory queue, the task is still kept in the log until
+// it is popped by key or retired by the retire strategy, because the manager re-pushes the dispatched
+// tasks and a crash between popping and re-pushing must not lose them. The strategy funcs can not be
+// persisted, they are applied to the reloaded tasks once the owner sets them again at startup.
+//
+// A task that is popped by limit and then popped by key before it is pushed back has been finished, so
+// pushing back the same popped task is rejected, otherwise the finished task would be brought back.
+type GfSpPersistentTQueueWithLimit struct {
+	name  string
+	queue *GfSpTQueueWithLimit
+	mux   sync.Mutex
+
+	path      string
+	file      *os.File
+	writer    *bufio.Writer
+	syncWrite bool
+
+	// tasks holds all durable tasks, including the popped but not finished tasks.
+	tasks map[coretask.TKey]coretask.Task
+	// popped holds the tasks popped by limit and not pushed back yet, removed holds the popped tasks
+	// that are popped by key before they are pushed back.
+	popped           map[coretask.TKey]coretask.Task
+	removed          map[coretask.TKey]coretask.Task
+	appended         int
+	compactThreshold int
+}
+
+// NewGfSpPersistentTQueueWithLimitFunc returns the new func of the persistent task queue with limit,
+// the queue logs are stored in the dir, one file per queue name.
+func NewGfSpPersistentTQueueWithLimitFunc(dir string, syncWrite bool) taskqueue.NewTQueueOnStrategyWithLimit {
+	return func(name string, cap int) taskqueue.TQueueOnStrategyWithLimit {
+		queue, err := NewGfSpPersistentTQueueWithLimit(dir, name, cap, syncWrite)
+		if err != nil {
+			log.Errorw("failed to new persistent task queue, fall back to memory task queue", "queue", name,
+				"dir", dir, "error", err)
+			return NewGfSpTQueueWithLimit(name, cap)
+		}
+		return queue
+	}
+}
+
+// NewGfSpPersistentTQueueWithLimit opens the queue log in the dir, replays it and compacts it.
+func NewGfSpPersistentTQueueWithLimit(dir string, name string, cap int, syncWrite bool) (
+	*GfSpPersistentTQueueWithLimit, error) {
+	if err := os.MkdirAll(dir, 0750); err != nil {
+		return nil, err
+	}
+	t := &GfSpPersistentTQueueWithLimit{
+		name:             name,
+		queue:            NewGfSpTQueueWithLimit(name, cap).(*GfSpTQueueWithLimit),
+		path:             filepath.Join(dir, queueLogFileName(name)),
+		syncWrite:        syncWrite,
+		tasks:            make(map[coretask.TKey]coretask.Task),
+		popped:           make(map[coretask.TKey]coretask.Task),
+		removed:          make(map[coretask.TKey]coretask.Task),
+		compactThreshold: DefaultPersistentTQueueCompactThreshold,
+	}
+	if err := t.replay(); err != nil {
+		return nil, err
+	}
+	for key, task := range t.tasks {
+		if err := t.queue.Push(task); err != nil {
+			log.Warnw("failed to reload task to persistent queue, drop it", "queue", name,
+				"task_key", key, "error", err)
+			delete(t.tasks, key)
+		}
+	}
+	if err := t.compact(); err != nil {
+		return nil, err
+	}
+	log.Infow("succeed to load persistent task queue", "queue", name, "path", t.path, "tasks", len(t.tasks))
+	return t, nil
+}
+
+// Len returns the length of queue.
+func (t *GfSpPersistentTQueueWithLimit) Len() int {
+	return t.queue.Len()
+}
+
+// Cap returns the capacity of queue.
+func (t *GfSpPersistentTQueueWithLimit) Cap() int {
+	return t.queue.Cap()
+}
+
+// SetCap sets the capacity of queue, the tasks beyond the new capacity are kept until they are popped.
+func (t *GfSpPersistentTQueueWithLimit) SetCap(cap int) {
+	t.queue.SetCap(cap)
+}
+
+// Has returns an indicator whether the task in queue.
+func (t *GfSpPersistentTQueueWithLimit) Has(key coretask.TKey) bool {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.queue.Has(key)
+}
+
+// TopByLimit returns the top task that the LimitEstimate less than the param in the queue.
+func (t *GfSpPersistentTQueueWithLimit) TopByLimit(limit corercmgr.Limit) coretask.Task {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	return t.queue.TopByLimit(limit)
+}
+
+// PopByLimit pops and returns the top task that the LimitEstimate less than the param in the queue.
+// The task is still kept in the log until it is popped by key or retired.
+func (t *GfSpPersistentTQueueWithLimit) PopByLimit(limit corercmgr.Limit) coretask.Task {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	task := t.queue.PopByLimit(limit)
+	if task != nil {
+		t.popped[task.Key()] = task
+	}
+	return task
+}
+
+// PopByKey pops the task by the task key, if the task does not exist , returns nil.
+func (t *GfSpPersistentTQueueWithLimit) PopByKey(key coretask.TKey) coretask.Task {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	task := t.queue.PopByKey(key)
+	if task == nil {
+		task = t.tasks[key]
+	}
+	if popped, ok := t.popped[key]; ok {
+		delete(t.popped, key)
+		t.removed[key] = popped
+	}
+	if err := t.remove(key); err != nil {
+		log.Errorw("failed to remove task", "queue", t.name, "task_key", key, "error", err)
+	}
+	return task
+}
+
+// Push pushes the task in queue tail, if the queue len greater the capacity, returns error.
+func (t *GfSpPersistentTQueueWithLimit) Push(task coretask.Task) error {
+	t.mux.Lock()
+	defer t.mux.Unlock()
+	key := task.Key()
+	if removed, ok := t.removed[key]; ok {
+		delete(t.removed, key)
+		if removed == task {
+			log.Warnw("reject to push back the removed task", "queue", t.name, "task_key", key)
+			return ErrTaskRemoved
+		}
+	}
+	if err := t.queue.Push(task); err != nil {
+		return err
+	}
+	delete(t.popped, key)
+	if err := t.appendPut(task); err != nil {
+		log.Errorw("failed to persist task", "queue", t.name, "task_key", task.Key(), "error", err)
+		t.queue.PopByKey(task.Key())
+		return err
+	}
+	t.tasks[task.Key()] = task
+	t.maybeCompact()
+	return nil
+}
+
+// SetFilterTaskStrategy sets the callback func to filter task for popping or topping.
+func (t *GfSpPersistentTQueueWithLimit) SetFilterTaskStrategy(filter func(coretask.Task) bool) {
+	t.queue.SetFilterTaskStrategy(filter)
+}
+
+// SetRetireTaskStrategy sets the callback func to retire task, when the queue is full, it will be
+// called to retire tasks. The retired tasks are removed from the log as well.
+func (t *GfSpPersistentTQueueWithLimit) SetRetireTaskStrategy(retire func(coretask.Task) bool) {
+	if retire == nil {
+		t.queue.SetRetireTaskStrategy(nil)
+		return
+	}
+	// the retire func is always called by the memory queue while t.mux is held by the caller.
+	t.queue.SetRetireTaskStrategy(func(task coretask.Task) bool {
+		if !retire(task) {
+			return false
+		}
+		if err := t.remove(task.Key()); err != nil {
+			log.Errorw("failed to remove retired task", "queue", t.name, "task_key", task.Key(), "error", err)
+		}
+		return true
+	})
+}
+
+// ScanTask scans all tasks, and call the func one by one task.
+func (t *GfSpPersistentTQueueWithLimit) ScanTask(scan func(coretask.Task)) {
+	t.queue.ScanTask(scan)
+}
+
+// remove deletes the task from the log. If the deletion record can not be appended, the log is compacted
+// without the task instead, so the removed task is not reloaded after restarting.
+func (t *GfSpPersistentTQueueWithLimit) remove(key coretask.TKey) error {
+	if _, ok := t.tasks[key]; !ok {
+		return nil
+	}
+	delete(t.tasks, key)
+	if err := t.appendDel(key); err != nil {
+		if compactErr := t.compact(); compactErr != nil {
+			return fmt.Errorf("failed to persist task deletion: %v, failed to compact: %w", err, compactErr)
+		}
+		return nil
+	}
+	t.maybeCompact()
+	return nil
+}
+
+func (t *GfSpPersistentTQueueWithLimit) appendPut(task coretask.Task) error {
+	body, err := marshalTask(task)
+	if err != nil {
+		return err
+	}
+	payload := make([]byte, 0, 1+4+len(body))
+	payload = append(payload, recordOpPut)
+	payload = binary.BigEndian.AppendUint32(payload, uint32(task.Type()))
+	payload = append(payload, body...)
+	return t.appendRecord(payload)
+}
+
+func (t *GfSpPersistentTQueueWithLimit) appendDel(key coretask.TKey) error {
+	payload := make([]byte, 0, 1+len(key))
+	payload = append(payload, recordOpDel)
+	payload = append(payload, key...)
+	return t.appendRecord(payload)
+}
+
+func (t *GfSpPersistentTQueueWithLimit) appendRecord(payload []byte) error {
+	if err := writeRecord(t.writer, payload); err != nil {
+		return err
+	}
+	if err := t.writer.Flush(); err != nil {
+		return err
+	}
+	if t.syncWrite {
+		if err := t.file.Sync(); err != nil {
+			return err
+		}
+	}
+	t.appended++
+	return nil
+}
+
+func (t *GfSpPersistentTQueueWithLimit) maybeCompact() {
+	if t.appended < len(t.tasks)+t.compactThreshold {
+		return
+	}
+	if err := t.compact(); err != nil {
+		log.Errorw("failed to compact persistent task queue", "queue", t.name, "error", err)
+	}
+}
+
+// replay rebuilds the durable tasks from the log, a torn record at the tail caused by a crash is
+// truncated by the following compaction.
+func (t *GfSpPersistentTQueueWithLimit) replay() error {
+	file, err := os.Open(t.path)
+	if os.IsNotExist(err) {
+		return nil
+	}
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	for {
+		payload, err := readRecord(reader)
+		if err == io.EOF {
+			return nil
+		}
+		if err != nil {
+			log.Warnw("stop replaying persistent task queue at broken record", "queue", t.name, "error", err)
+			return nil
+		}
+		switch payload[0] {
+		case recordOpPut:
+			if len(payload) < 5 {
+				log.Warnw("skip broken put record", "queue", t.name)
+				continue
+			}
+			task, err := unmarshalTask(coretask.TType(binary.BigEndian.Uint32(payload[1:5])), payload[5:])
+			if err != nil {
+				log.Warnw("skip undecodable task record", "queue", t.name, "error", err)
+				continue
+			}
+			t.tasks[task.Key()] = task
+		case recordOpDel:
+			delete(t.tasks, coretask.TKey(payload[1:]))
+		default:
+			log.Warnw("skip unknown record", "queue", t.name, "op", payload[0])
+		}
+	}
+}
+
+// compact rewrites the log with the live tasks only, and switches the appending to the new log.
+func (t *GfSpPersistentTQueueWithLimit) compact() error {
+	tmpPath := t.path + ".tmp"
+	tmp, err := os.OpenFile(tmpPath, os.O_CREATE|os.O_TRUNC|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	writer := bufio.NewWriter(tmp)
+	for _, task := range t.tasks {
+		body, err := marshalTask(task)
+		if err != nil {
+			log.Warnw("skip unmarshalable task in compaction", "queue", t.name, "task_key", task.Key(), "error", err)
+			continue
+		}
+		payload := make([]byte, 0, 1+4+len(body))
+		payload = append(payload, recordOpPut)
+		payload = binary.BigEndian.AppendUint32(payload, uint32(task.Type()))
+		payload = append(payload, body...)
+		if err = writeRecord(writer, payload); err != nil {
+			tmp.Close()
+			return err
+		}
+	}
+	if err = writer.Flush(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	if err = os.Rename(tmpPath, t.path); err != nil {
+		return err
+	}
+	if t.file != nil {
+		t.file.Close()
+	}
+	t.file, err = os.OpenFile(t.path, os.O_APPEND|os.O_WRONLY, 0640)
+	if err != nil {
+		return err
+	}
+	t.writer = bufio.NewWriter(t.file)
+	t.appended = 0
+	return nil
+}
+
+func writeRecord(w io.Writer, payload []byte) error {
+	var header [recordHeaderSize]byte
+	binary.BigEndian.PutUint32(header[0:4], uint32(len(payload)))
+	binary.BigEndian.PutUint32(header[4:8], crc32.ChecksumIEEE(payload))
+	if _, err := w.Write(header[:]); err != nil {
+		return err
+	}
+	_, err := w.Write(payload)
+	return err
+}
+
+func readRecord(r io.Reader) ([]byte, error) {
+	var header [recordHeaderSize]byte
+	if _, err := io.ReadFull(r, header[:]); err != nil {
+		if err == io.ErrUnexpectedEOF {
+			return nil, errCorruptedRecord
+		}
+		return nil, err
+	}
+	size := binary.BigEndian.Uint32(header[0:4])
+	if size == 0 || size > maxRecordSize {
+		return nil, errCorruptedRecord
+	}
+	payload := make([]byte, size)
+	if _, err := io.ReadFull(r, payload); err != nil {
+		return nil, errCorruptedRecord
+	}
+	if crc32.ChecksumIEEE(payload) != binary.BigEndian.Uint32(header[4:8]) {
+		return nil, errCorruptedRecord
+	}
+	return payload, nil
+}
+
+func queueLogFileName(name string) string {
+	return strings.NewReplacer("/", "_", string(filepath.Separator), "_").Replace(name) + PersistentTQueueLogSuffix
+}
+
+type marshaler interface {
+	Marshal() ([]byte, error)
+}
+
+type unmarshaler interface {
+	coretask.Task
+	Unmarshal([]byte) error
+}
+
+func marshalTask(task coretask.Task) ([]byte, error) {
+	m, ok := task.(marshaler)
+	if !ok {
+		return nil, ErrUnsupportedPersistentTask
+	}
+	return m.Marshal()
+}
+
+func unmarshalTask(taskType coretask.TType, body []byte) (coretask.Task, error) {
+	var task unmarshaler
+	switch taskType {
+	case coretask.TypeTaskUpload:
+		task = &gfsptask.GfSpUploadObjectTask{}
+	case coretask.TypeTaskReplicatePiece:
+		task = &gfsptask.GfSpReplicatePieceTask{}
+	case coretask.TypeTaskSealObject:
+		task = &gfsptask.GfSpSealObjectTask{}
+	case coretask.TypeTaskReceivePiece:
+		task = &gfsptask.GfSpReceivePieceTask{}
+	case coretask.TypeTaskGCObject:
+		task = &gfsptask.GfSpGCObjectTask{}
+	case coretask.TypeTaskGCZombiePiece:
+		task = &gfsptask.GfSpGCZombiePieceTask{}
+	case coretask.TypeTaskGCMeta:
+		task = &gfsptask.GfSpGCMetaTask{}
+	case coretask.TypeTaskGCBucketMigration:
+		task = &gfsptask.GfSpGCBucketMigrationTask{}
+	case coretask.TypeTaskGCStaleVersionObject:
+		task = &gfsptask.GfSpGCStaleVersionObjectTask{}
+	case coretask.TypeTaskRecoverPiece:
+		task = &gfsptask.GfSpRecoverPieceTask{}
+	case coretask.TypeTaskMigrateGVG:
+		task = &gfsptask.GfSpMigrateGVGTask{}
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedPersistentTask, coretask.TaskTypeName(taskType))
+	}
+	if err := task.Unmarshal(body); err != nil {
+		return nil, err
+	}
+	return task, nil
+}
@@ -0,0 +1,141 @@
+package gfsptqueue
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func mockPersistentRecoveryTask(name string, id uint64, createTime int64) *gfsptask.GfSpRecoverPieceTask {
+	return &gfsptask.GfSpRecoverPieceTask{
+		ObjectInfo:    &storagetypes.ObjectInfo{ObjectName: name, Id: sdkmath.NewUint(id)},
+		StorageParams: &storagetypes.Params{},
+		Task:          &gfsptask.GfSpTask{CreateTime: createTime, MaxRetry: 5},
+	}
+}
+
+func TestGfSpPersistentTQueueWithLimit_ReloadTasks(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, true)
+	require.NoError(t, err)
+
+	task1 := mockPersistentRecoveryTask("task_1", 1, 1)
+	task2 := mockPersistentRecoveryTask("task_2", 2, 2)
+	task2.SetPriority(coretask.MaxTaskPriority)
+	require.NoError(t, queue.Push(task1))
+	require.NoError(t, queue.Push(task2))
+
+	// dispatch task2 and re-push it with the increased retry
+	task := queue.PopByKey(task1.Key())
+	assert.NotNil(t, task)
+	task = queue.PopByLimit(&corercmgr.Unlimited{})
+	require.NotNil(t, task)
+	task.IncRetry()
+	require.NoError(t, queue.Push(task))
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, true)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+	assert.False(t, reloaded.Has(task1.Key()))
+	task = reloaded.PopByKey(task2.Key())
+	require.NotNil(t, task)
+	assert.Equal(t, int64(1), task.GetRetry())
+	assert.Equal(t, coretask.MaxTaskPriority, task.GetPriority())
+}
+
+func TestGfSpPersistentTQueueWithLimit_KeepPoppedTask(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	require.NoError(t, queue.Push(mockPersistentRecoveryTask("task_1", 1, 1)))
+	assert.NotNil(t, queue.PopByLimit(&corercmgr.Unlimited{}))
+	assert.Equal(t, 0, queue.Len())
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+}
+
+func TestGfSpPersistentTQueueWithLimit_RetireTask(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 1, false)
+	require.NoError(t, err)
+	task1 := mockPersistentRecoveryTask("task_1", 1, 1)
+	require.NoError(t, queue.Push(task1))
+	queue.SetRetireTaskStrategy(func(task coretask.Task) bool { return task.GetCreateTime() == 1 })
+	require.NoError(t, queue.Push(mockPersistentRecoveryTask("task_2", 2, 2)))
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 1, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+	assert.False(t, reloaded.Has(task1.Key()))
+}
+
+func TestGfSpPersistentTQueueWithLimit_TornRecord(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	require.NoError(t, queue.Push(mockPersistentRecoveryTask("task_1", 1, 1)))
+
+	file, err := os.OpenFile(filepath.Join(dir, "mock"+PersistentTQueueLogSuffix), os.O_APPEND|os.O_WRONLY, 0640)
+	require.NoError(t, err)
+	_, err = file.Write([]byte{0, 0, 1, 0, 1})
+	require.NoError(t, err)
+	require.NoError(t, file.Close())
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+	require.NoError(t, reloaded.Push(mockPersistentRecoveryTask("task_2", 2, 2)))
+	assert.Equal(t, 2, reloaded.Len())
+}
+
+func TestGfSpPersistentTQueueWithLimit_RejectRemovedPoppedTask(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	task1 := mockPersistentRecoveryTask("task_1", 1, 1)
+	require.NoError(t, queue.Push(task1))
+
+	// the dispatched task is finished before the manager pushes it back
+	task := queue.PopByLimit(&corercmgr.Unlimited{})
+	require.NotNil(t, task)
+	assert.NotNil(t, queue.PopByKey(task1.Key()))
+	assert.Equal(t, ErrTaskRemoved, queue.Push(task))
+	assert.False(t, queue.Has(task1.Key()))
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 0, reloaded.Len())
+
+	// the new task with the same key is accepted
+	require.NoError(t, queue.Push(mockPersistentRecoveryTask("task_1", 1, 1)))
+	assert.True(t, queue.Has(task1.Key()))
+}
+
+func TestGfSpPersistentTQueueWithLimit_RemoveWithBrokenLog(t *testing.T) {
+	dir := t.TempDir()
+	queue, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	task1 := mockPersistentRecoveryTask("task_1", 1, 1)
+	require.NoError(t, queue.Push(task1))
+	require.NoError(t, queue.Push(mockPersistentRecoveryTask("task_2", 2, 2)))
+
+	// the deletion record can not be appended to the closed log, the log is compacted instead
+	require.NoError(t, queue.file.Close())
+	assert.NotNil(t, queue.PopByKey(task1.Key()))
+
+	reloaded, err := NewGfSpPersistentTQueueWithLimit(dir, "mock", 10, false)
+	require.NoError(t, err)
+	assert.Equal(t, 1, reloaded.Len())
+	assert.False(t, reloaded.Has(task1.Key()))
+}
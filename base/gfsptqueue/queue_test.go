@@ -26,6 +26,35 @@ func TestGfSpTQueue_Cap(t *testing.T) {
 	assert.Equal(t, 1, result)
 }
 
+func TestGfSpTQueue_SetCap(t *testing.T) {
+	queue := NewGfSpTQueue("mock", 1)
+	approvalTask1 := &gfsptask.GfSpCreateObjectApprovalTask{
+		CreateObjectInfo: &storagetypes.MsgCreateObject{
+			ObjectName:        "mockObjectName1",
+			PrimarySpApproval: &common.Approval{ExpiredHeight: 99},
+		},
+	}
+	approvalTask2 := &gfsptask.GfSpCreateObjectApprovalTask{
+		CreateObjectInfo: &storagetypes.MsgCreateObject{
+			ObjectName:        "mockObjectName2",
+			PrimarySpApproval: &common.Approval{ExpiredHeight: 99},
+		},
+	}
+	err := queue.Push(approvalTask1)
+	assert.Nil(t, err)
+	queue.SetCap(2)
+	assert.Equal(t, 2, queue.Cap())
+	err = queue.Push(approvalTask2)
+	assert.Nil(t, err)
+
+	t.Log("Case description: the tasks beyond the new capacity are kept")
+	queue.SetCap(1)
+	assert.Equal(t, 2, queue.Len())
+	queue.PopByKey(approvalTask1.Key())
+	err = queue.Push(approvalTask1)
+	assert.Equal(t, ErrTaskQueueExceed, err)
+}
+
 func TestGfSpTQueue_Has(t *testing.T) {
 	queue := NewGfSpTQueue("mock", 1)
 	result := queue.Has("mock")
@@ -268,3 +297,18 @@ func TestGfSpTQueue_ScanTask(t *testing.T) {
 	assert.Nil(t, err)
 	queue.ScanTask(func(task coretask.Task) { log.Info(task) })
 }
+
+func BenchmarkGfSpTQueue_PopAndPush(b *testing.B) {
+	queue := NewGfSpTQueue("bench", 100000)
+	for i := 0; i < 100000; i++ {
+		_ = queue.Push(&gfsptask.GfSpCreateObjectApprovalTask{
+			Task:             &gfsptask.GfSpTask{CreateTime: int64(i), TaskPriority: int32(i % 3)},
+			CreateObjectInfo: &storagetypes.MsgCreateObject{ObjectName: fmt.Sprintf("object_%d", i)},
+		})
+	}
+	b.ResetTimer()
+	for i := 0; i < b.N; i++ {
+		task := queue.Pop()
+		_ = queue.Push(task)
+	}
+}
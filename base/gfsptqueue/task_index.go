@@ -0,0 +1,128 @@
+package gfsptqueue
+
+import (
+	"math"
+
+	"github.com/google/btree"
+
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+// taskIndexDegree defines the degree of the b-tree that orders the tasks.
+const taskIndexDegree = 32
+
+// indexItem is the ordering snapshot of a task in the index, the priority and create time are
+// copied at pushing, so the task can not be lost in the index if they are changed in queue.
+type indexItem struct {
+	key        coretask.TKey
+	priority   coretask.TPriority
+	createTime int64
+	task       coretask.Task
+}
+
+// lessIndexItem orders the tasks by higher priority first, then by earlier create time, the key
+// is used to break the tie.
+func lessIndexItem(a, b *indexItem) bool {
+	if a.priority != b.priority {
+		return a.priority > b.priority
+	}
+	if a.createTime != b.createTime {
+		return a.createTime < b.createTime
+	}
+	return a.key < b.key
+}
+
+// taskIndex keeps the tasks of queue in the order of priority and create time, it supports
+// O(log n) adding, deleting and getting the first task. The zero value is an empty index, and
+// it is not thread safe, the queue should protect it.
+type taskIndex struct {
+	tree  *btree.BTreeG[*indexItem]
+	items map[coretask.TKey]*indexItem
+}
+
+func (i *taskIndex) len() int {
+	return len(i.items)
+}
+
+func (i *taskIndex) get(key coretask.TKey) (coretask.Task, bool) {
+	item, ok := i.items[key]
+	if !ok {
+		return nil, false
+	}
+	return item.task, true
+}
+
+func (i *taskIndex) add(task coretask.Task) {
+	if i.tree == nil {
+		i.tree = btree.NewG[*indexItem](taskIndexDegree, lessIndexItem)
+		i.items = make(map[coretask.TKey]*indexItem)
+	}
+	i.delete(task.Key())
+	item := &indexItem{
+		key:        task.Key(),
+		priority:   task.GetPriority(),
+		createTime: task.GetCreateTime(),
+		task:       task,
+	}
+	i.items[item.key] = item
+	i.tree.ReplaceOrInsert(item)
+}
+
+func (i *taskIndex) delete(key coretask.TKey) {
+	item, ok := i.items[key]
+	if !ok {
+		return
+	}
+	delete(i.items, key)
+	i.tree.Delete(item)
+}
+
+// ascend calls the iterator in the order of the index until it returns false, the index must
+// not be changed in the iterator.
+func (i *taskIndex) ascend(iterator func(coretask.Task) bool) {
+	if i.tree == nil {
+		return
+	}
+	i.tree.Ascend(func(item *indexItem) bool {
+		return iterator(item.task)
+	})
+}
+
+// ascendFrom calls the iterator in the order of the index until it returns false, but the tasks of the
+// same priority are visited round-robin from the first task created after the cursor, the tasks created
+// not after the cursor are visited after the others of the same priority. The index must not be changed
+// in the iterator.
+func (i *taskIndex) ascendFrom(cursor int64, iterator func(coretask.Task) bool) {
+	if i.tree == nil {
+		return
+	}
+	first, ok := i.tree.Min()
+	for ok {
+		priority := first.priority
+		stop := false
+		visit := func(item *indexItem) bool {
+			if !iterator(item.task) {
+				stop = true
+				return false
+			}
+			return true
+		}
+		i.tree.AscendGreaterOrEqual(&indexItem{priority: priority, createTime: cursor + 1}, func(item *indexItem) bool {
+			return item.priority == priority && visit(item)
+		})
+		if stop {
+			return
+		}
+		i.tree.AscendGreaterOrEqual(&indexItem{priority: priority, createTime: math.MinInt64}, func(item *indexItem) bool {
+			return item.priority == priority && item.createTime <= cursor && visit(item)
+		})
+		if stop || priority == 0 {
+			return
+		}
+		ok = false
+		i.tree.AscendGreaterOrEqual(&indexItem{priority: priority - 1, createTime: math.MinInt64}, func(item *indexItem) bool {
+			first, ok = item, true
+			return false
+		})
+	}
+}
@@ -0,0 +1,228 @@
+package gfspvgmgr
+
+import (
+	"math"
+	"sort"
+	"strconv"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/core/vgmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// SPReputationDecayHalfLife is the period after which the weight of the recorded outcomes is halved, so the
+	// reputation of a SP without new outcomes decays toward neutral.
+	SPReputationDecayHalfLife = 10 * time.Minute
+	// SPReputationPriorRequests is the number of the neutral requests that the recorded outcomes are blended
+	// with, so a few failed requests do not ruin the reputation of a SP.
+	SPReputationPriorRequests = 10
+	// MinSPReputationScore is the lowest score, the SP with the lowest score is still picked occasionally
+	// so that its recovery can be observed.
+	MinSPReputationScore = 0.05
+	// SPReputationScoreTier is the score step within which the SPs are treated as equal when ordering the
+	// candidate secondary SPs, so the new GVGs are not concentrated on the SP with the highest score.
+	SPReputationScoreTier = 0.1
+
+	// minSPReputationRequests is the decayed requests below which the outcomes of a SP are dropped.
+	minSPReputationRequests = 0.01
+)
+
+// spOutcomeStats accumulates the outcomes of the requests to a SP, all the values are decayed by the time
+// since they are recorded.
+type spOutcomeStats struct {
+	requests float64
+	failures float64
+	// latencyRequests and latencyCost are the succeeded requests without payload and their seconds.
+	latencyRequests float64
+	latencyCost     float64
+	// payloadSize and payloadCost are the transferred bytes and their seconds.
+	payloadSize float64
+	payloadCost float64
+	updateTime  time.Time
+}
+
+func decayFactor(elapsed time.Duration) float64 {
+	if elapsed <= 0 {
+		return 1
+	}
+	return math.Pow(0.5, float64(elapsed)/float64(SPReputationDecayHalfLife))
+}
+
+func (s *spOutcomeStats) decay(now time.Time) {
+	factor := decayFactor(now.Sub(s.updateTime))
+	s.requests *= factor
+	s.failures *= factor
+	s.latencyRequests *= factor
+	s.latencyCost *= factor
+	s.payloadSize *= factor
+	s.payloadCost *= factor
+	s.updateTime = now
+}
+
+func (s *spOutcomeStats) record(outcome *task.SPOutcome, now time.Time) {
+	s.decay(now)
+	failures := outcome.Failures
+	if failures > outcome.Requests {
+		failures = outcome.Requests
+	}
+	s.requests += float64(outcome.Requests)
+	s.failures += float64(failures)
+	succeeded := outcome.Requests - failures
+	if succeeded == 0 || outcome.Cost <= 0 {
+		return
+	}
+	if outcome.Size > 0 {
+		s.payloadSize += float64(outcome.Size)
+		s.payloadCost += outcome.Cost.Seconds()
+	} else {
+		s.latencyRequests += float64(succeeded)
+		s.latencyCost += outcome.Cost.Seconds()
+	}
+}
+
+func (s *spOutcomeStats) latency() float64 {
+	if s.latencyRequests == 0 {
+		return 0
+	}
+	return s.latencyCost / s.latencyRequests
+}
+
+func (s *spOutcomeStats) throughput() float64 {
+	if s.payloadCost == 0 {
+		return 0
+	}
+	return s.payloadSize / s.payloadCost
+}
+
+// SPReputationTracker scores the SPs from the outcomes of replicating pieces to, fetching pieces for recovery
+// from and asking approvals from them. The score is the success ratio of the requests, penalized by the
+// latency above the median latency and the throughput below the median throughput of all the SPs, it is
+// blended with the neutral score by the recent requests, so it decays toward neutral without new outcomes.
+type SPReputationTracker struct {
+	mutex sync.Mutex
+	stats map[uint32]*spOutcomeStats
+	now   func() time.Time
+}
+
+func NewSPReputationTracker() *SPReputationTracker {
+	return &SPReputationTracker{stats: make(map[uint32]*spOutcomeStats), now: time.Now}
+}
+
+// RecordSPOutcome records the outcome of the requests to the SP.
+func (t *SPReputationTracker) RecordSPOutcome(spID uint32, outcome *task.SPOutcome) {
+	if outcome == nil || outcome.Requests == 0 {
+		return
+	}
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	now := t.now()
+	stats, ok := t.stats[spID]
+	if !ok {
+		stats = &spOutcomeStats{updateTime: now}
+		t.stats[spID] = stats
+	}
+	stats.record(outcome, now)
+	for id, s := range t.stats {
+		if s.requests*decayFactor(now.Sub(s.updateTime)) < minSPReputationRequests {
+			delete(t.stats, id)
+			metrics.SPReputationScoreGauge.DeleteLabelValues(strconv.Itoa(int(id)))
+		}
+	}
+}
+
+// Reputations returns the reputations of the SPs that have recent outcomes in the order of SP id.
+func (t *SPReputationTracker) Reputations() []*vgmgr.SPReputation {
+	t.mutex.Lock()
+	defer t.mutex.Unlock()
+	var (
+		now         = t.now()
+		latencies   = make([]float64, 0, len(t.stats))
+		throughputs = make([]float64, 0, len(t.stats))
+	)
+	for _, s := range t.stats {
+		if latency := s.latency(); latency > 0 {
+			latencies = append(latencies, latency)
+		}
+		if throughput := s.throughput(); throughput > 0 {
+			throughputs = append(throughputs, throughput)
+		}
+	}
+	medianLatency, medianThroughput := median(latencies), median(throughputs)
+
+	reputations := make([]*vgmgr.SPReputation, 0, len(t.stats))
+	for spID, s := range t.stats {
+		reputation := &vgmgr.SPReputation{
+			SPID:       spID,
+			ErrorRate:  s.failures / s.requests,
+			Latency:    time.Duration(s.latency() * float64(time.Second)),
+			Throughput: s.throughput(),
+		}
+		raw := 1 - reputation.ErrorRate
+		if latency := s.latency(); latency > medianLatency {
+			raw *= medianLatency / latency
+		}
+		if throughput := s.throughput(); throughput > 0 && throughput < medianThroughput {
+			raw *= throughput / medianThroughput
+		}
+		requests := s.requests * decayFactor(now.Sub(s.updateTime))
+		reputation.Score = 1 - (1-raw)*requests/(requests+SPReputationPriorRequests)
+		if reputation.Score < MinSPReputationScore {
+			reputation.Score = MinSPReputationScore
+		}
+		reputations = append(reputations, reputation)
+	}
+	sort.Slice(reputations, func(i, j int) bool { return reputations[i].SPID < reputations[j].SPID })
+	return reputations
+}
+
+// Scores returns the scores of the SPs that have recent outcomes, the other SPs are scored 1.
+func (t *SPReputationTracker) Scores() map[uint32]float64 {
+	scores := make(map[uint32]float64)
+	for _, reputation := range t.Reputations() {
+		scores[reputation.SPID] = reputation.Score
+	}
+	return scores
+}
+
+func median(values []float64) float64 {
+	if len(values) == 0 {
+		return 0
+	}
+	sort.Float64s(values)
+	mid := len(values) / 2
+	if len(values)%2 == 0 {
+		return (values[mid-1] + values[mid]) / 2
+	}
+	return values[mid]
+}
+
+func spReputationScore(spID uint32, scores map[uint32]float64) float64 {
+	if score, ok := scores[spID]; ok {
+		return score
+	}
+	return 1
+}
+
+// gvgReputationScore returns the lowest score of the secondary SPs of the gvg, the slowest secondary SP
+// stalls replicating every object in the gvg.
+func gvgReputationScore(gvg *vgmgr.GlobalVirtualGroupMeta, scores map[uint32]float64) float64 {
+	score := float64(1)
+	for _, spID := range gvg.SecondarySPIDs {
+		if spScore := spReputationScore(spID, scores); spScore < score {
+			score = spScore
+		}
+	}
+	return score
+}
+
+// sortSPsByReputation sorts the SPs by the tier of the scores in descending order, and the SPs in the same
+// tier keep their order.
+func sortSPsByReputation(spIDs []uint32, scores map[uint32]float64) {
+	tier := func(spID uint32) float64 {
+		return math.Round(spReputationScore(spID, scores) / SPReputationScoreTier)
+	}
+	sort.SliceStable(spIDs, func(i, j int) bool { return tier(spIDs[i]) > tier(spIDs[j]) })
+}
@@ -0,0 +1,116 @@
+package gfspvgmgr
+
+import (
+	"testing"
+	"time"
+
+	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/core/vgmgr"
+)
+
+func newTestSPReputationTracker() (*SPReputationTracker, *time.Time) {
+	tracker := NewSPReputationTracker()
+	clock := time.Unix(0, 0)
+	tracker.now = func() time.Time { return clock }
+	return tracker, &clock
+}
+
+func TestSPReputationTracker_Reputations(t *testing.T) {
+	tracker, _ := newTestSPReputationTracker()
+	for spID := uint32(1); spID <= 4; spID++ {
+		throughputCost := 10 * time.Second
+		if spID == 3 {
+			// the sp 3 transfers the payload at a quarter of the median throughput
+			throughputCost = 40 * time.Second
+		}
+		failures := uint32(0)
+		if spID == 2 {
+			failures = 50
+		}
+		tracker.RecordSPOutcome(spID, &task.SPOutcome{Type: task.SPOutcomeReplicate, Requests: 100, Failures: failures,
+			Size: 1000, Cost: throughputCost})
+		approvalCost := 100 * time.Millisecond
+		if spID == 4 {
+			// the sp 4 approves at twice of the median latency
+			approvalCost = 200 * time.Millisecond
+		}
+		tracker.RecordSPOutcome(spID, &task.SPOutcome{Type: task.SPOutcomeApproval, Requests: 1, Cost: approvalCost})
+	}
+	tracker.RecordSPOutcome(5, &task.SPOutcome{Type: task.SPOutcomeApproval})
+
+	reputations := tracker.Reputations()
+	assert.Equal(t, 4, len(reputations))
+	assert.Equal(t, uint32(1), reputations[0].SPID)
+	assert.Equal(t, float64(1), reputations[0].Score)
+	assert.Equal(t, float64(100), reputations[0].Throughput)
+	assert.Equal(t, 100*time.Millisecond, reputations[0].Latency)
+	assert.InDelta(t, 0.5, reputations[1].ErrorRate, 0.01)
+	assert.InDelta(t, 1-0.5*101/111, reputations[1].Score, 0.01)
+	assert.InDelta(t, 1-0.75*101/111, reputations[2].Score, 0.01)
+	assert.InDelta(t, 1-0.5*101/111, reputations[3].Score, 0.01)
+}
+
+func TestSPReputationTracker_Decay(t *testing.T) {
+	tracker, clock := newTestSPReputationTracker()
+	tracker.RecordSPOutcome(1, &task.SPOutcome{Type: task.SPOutcomeRecoveryFetch, Requests: 100, Failures: 100})
+	assert.InDelta(t, 1-float64(100)/110, tracker.Scores()[1], 0.01)
+
+	// the reputation decays toward neutral without new outcomes
+	*clock = clock.Add(10 * SPReputationDecayHalfLife)
+	assert.Less(t, 0.9, tracker.Scores()[1])
+
+	// the sp without recent outcomes is dropped
+	*clock = clock.Add(10 * SPReputationDecayHalfLife)
+	tracker.RecordSPOutcome(2, &task.SPOutcome{Type: task.SPOutcomeReplicate, Requests: 1, Size: 1, Cost: time.Second})
+	assert.Equal(t, map[uint32]float64{2: 1}, tracker.Scores())
+}
+
+func Test_gvgReputationScore(t *testing.T) {
+	scores := map[uint32]float64{1: 0.9, 2: 0.2}
+	assert.Equal(t, 0.2, gvgReputationScore(&vgmgr.GlobalVirtualGroupMeta{SecondarySPIDs: []uint32{1, 2, 3}}, scores))
+	assert.Equal(t, float64(1), gvgReputationScore(&vgmgr.GlobalVirtualGroupMeta{SecondarySPIDs: []uint32{3}}, scores))
+
+	picker := &FreeStorageSizeWeightPicker{freeStorageSizeWeightMap: map[uint32]float64{1: 0.5}}
+	picker.scaleWeight(1, 0.2)
+	picker.scaleWeight(2, 0.2)
+	assert.Equal(t, map[uint32]float64{1: 0.1}, picker.freeStorageSizeWeightMap)
+}
+
+func Test_generateVirtualGroupMetaByReputation(t *testing.T) {
+	sm := &spManager{selfSP: &sptypes.StorageProvider{Id: 1}}
+	for spID := uint32(2); spID <= 5; spID++ {
+		sm.otherSPs = append(sm.otherSPs, &sptypes.StorageProvider{Id: spID, Status: sptypes.STATUS_IN_SERVICE})
+	}
+	ctrl := gomock.NewController(t)
+	policy := vgmgr.NewMockGenerateGVGSecondarySPsPolicy(ctrl)
+	// the sps in the same score tier keep their order
+	gomock.InOrder(
+		policy.EXPECT().AddCandidateSP(uint32(3)),
+		policy.EXPECT().AddCandidateSP(uint32(5)),
+		policy.EXPECT().AddCandidateSP(uint32(4)),
+		policy.EXPECT().AddCandidateSP(uint32(2)),
+	)
+	policy.EXPECT().GenerateGVGSecondarySPs().Return([]uint32{3, 5}, nil)
+	meta, err := sm.generateVirtualGroupMeta(policy, nil, nil, nil, map[uint32]float64{2: 0.3, 4: 0.6, 5: 0.98})
+	assert.Nil(t, err)
+	assert.Equal(t, []uint32{3, 5}, meta.SecondarySPIDs)
+}
+
+func TestVirtualGroupManager_ReportSPOutcomes(t *testing.T) {
+	vgm := &virtualGroupManager{
+		spManager:    &spManager{otherSPs: []*sptypes.StorageProvider{{Id: 2, Endpoint: "sp2"}}},
+		spReputation: NewSPReputationTracker(),
+	}
+	vgm.ReportSPOutcomes([]*task.SPOutcome{
+		{Endpoint: "sp2", Type: task.SPOutcomeApproval, Requests: 1, Failures: 1},
+		{Endpoint: "unknown", Type: task.SPOutcomeApproval, Requests: 1, Failures: 1},
+	})
+	reputations := vgm.QuerySPReputations()
+	assert.Equal(t, 1, len(reputations))
+	assert.Equal(t, uint32(2), reputations[0].SPID)
+	assert.Equal(t, float64(1), reputations[0].ErrorRate)
+}
@@ -18,6 +18,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/core/vgmgr"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
@@ -80,6 +81,13 @@ func (picker *FreeStorageSizeWeightPicker) addGlobalVirtualGroup(gvg *vgmgr.Glob
 	picker.freeStorageSizeWeightMap[gvg.ID] = float64(gvg.StakingStorageSize-gvg.UsedStorageSize) / float64(gvg.StakingStorageSize)
 }
 
+// scaleWeight scales the weight of the index that has been added by the factor, e.g. the reputation score.
+func (picker *FreeStorageSizeWeightPicker) scaleWeight(index uint32, factor float64) {
+	if weight, ok := picker.freeStorageSizeWeightMap[index]; ok {
+		picker.freeStorageSizeWeightMap[index] = weight * factor
+	}
+}
+
 func (picker *FreeStorageSizeWeightPicker) pickIndex() (uint32, error) {
 	var (
 		sumWeight     float64
@@ -126,7 +134,9 @@ func (vgfm *virtualGroupFamilyManager) pickVirtualGroupFamily(filter *vgmgr.Pick
 	return vgfm.vgfIDToVgf[familyID], nil
 }
 
-func (vgfm *virtualGroupFamilyManager) pickGlobalVirtualGroup(vgfID uint32, filter, excludeGVGsFilter vgmgr.ExcludeFilter, healthChecker *HealthChecker) (*vgmgr.GlobalVirtualGroupMeta, error) {
+// pickGlobalVirtualGroup picks a gvg weighted by its free storage size and the reputation scores of its secondary sps.
+func (vgfm *virtualGroupFamilyManager) pickGlobalVirtualGroup(vgfID uint32, filter, excludeGVGsFilter vgmgr.ExcludeFilter, healthChecker *HealthChecker,
+	spScores map[uint32]float64) (*vgmgr.GlobalVirtualGroupMeta, error) {
 	var (
 		picker               FreeStorageSizeWeightPicker
 		globalVirtualGroupID uint32
@@ -147,6 +157,7 @@ func (vgfm *virtualGroupFamilyManager) pickGlobalVirtualGroup(vgfID uint32, filt
 			continue
 		}
 		picker.addGlobalVirtualGroup(g)
+		picker.scaleWeight(g.ID, gvgReputationScore(g, spScores))
 	}
 
 	if globalVirtualGroupID, err = picker.pickIndex(); err != nil {
@@ -193,7 +204,10 @@ type spManager struct {
 	otherSPs []*sptypes.StorageProvider
 }
 
-func (sm *spManager) generateVirtualGroupMeta(genPolicy vgmgr.GenerateGVGSecondarySPsPolicy, filter, excludeSPsFilter vgmgr.ExcludeFilter, healthChecker *HealthChecker) (*vgmgr.GlobalVirtualGroupMeta, error) {
+// generateVirtualGroupMeta adds the candidate sps to the policy in the order of their reputation score tiers.
+func (sm *spManager) generateVirtualGroupMeta(genPolicy vgmgr.GenerateGVGSecondarySPsPolicy, filter, excludeSPsFilter vgmgr.ExcludeFilter, healthChecker *HealthChecker,
+	spScores map[uint32]float64) (*vgmgr.GlobalVirtualGroupMeta, error) {
+	candidateSPIDs := make([]uint32, 0, len(sm.otherSPs))
 	for _, sp := range sm.otherSPs {
 		if !sp.IsInService() {
 			continue
@@ -207,7 +221,11 @@ func (sm *spManager) generateVirtualGroupMeta(genPolicy vgmgr.GenerateGVGSeconda
 		if healthChecker != nil && !healthChecker.isSPHealthy(sp.GetId()) {
 			continue
 		}
-		genPolicy.AddCandidateSP(sp.GetId())
+		candidateSPIDs = append(candidateSPIDs, sp.GetId())
+	}
+	sortSPsByReputation(candidateSPIDs, spScores)
+	for _, spID := range candidateSPIDs {
+		genPolicy.AddCandidateSP(spID)
 	}
 	secondarySPIDs, err := genPolicy.GenerateGVGSecondarySPs()
 	if err != nil {
@@ -259,6 +277,7 @@ type virtualGroupManager struct {
 	vgfManager          *virtualGroupFamilyManager
 	freezeSPPool        *FreezeSPPool
 	healthChecker       *HealthChecker
+	spReputation        *SPReputationTracker
 	gvgGCMap            sync.Map // Keep track of empty GVG and the time for GC. Once a GVG is detected empty, it will be put into gvgGCMap, and delete it if it is still empty after 1 day
 }
 
@@ -276,6 +295,7 @@ func NewVirtualGroupManager(selfOperatorAddress string, chainClient consensus.Co
 		gfspClient:          gfspClient,
 		freezeSPPool:        NewFreezeSPPool(),
 		healthChecker:       healthChecker,
+		spReputation:        NewSPReputationTracker(),
 		gvgGCMap:            sync.Map{},
 	}
 	vgm.refreshGVGMeta(true)
@@ -444,7 +464,8 @@ func (vgm *virtualGroupManager) PickVirtualGroupFamily(filterByGvgList *vgmgr.Pi
 func (vgm *virtualGroupManager) PickGlobalVirtualGroup(vgfID uint32, excludeGVGsFilter vgmgr.ExcludeFilter) (*vgmgr.GlobalVirtualGroupMeta, error) {
 	vgm.mutex.RLock()
 	defer vgm.mutex.RUnlock()
-	return vgm.vgfManager.pickGlobalVirtualGroup(vgfID, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeGVGsInFamily(vgfID)), excludeGVGsFilter, vgm.healthChecker)
+	return vgm.vgfManager.pickGlobalVirtualGroup(vgfID, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeGVGsInFamily(vgfID)), excludeGVGsFilter, vgm.healthChecker,
+		vgm.spReputation.Scores())
 }
 
 // PickGlobalVirtualGroupForBucketMigrate picks a global virtual group(If failed to pick,
@@ -464,7 +485,8 @@ func (vgm *virtualGroupManager) PickGlobalVirtualGroupForBucketMigrate(filter vg
 func (vgm *virtualGroupManager) PickMigrateDestGlobalVirtualGroup(vgfID uint32, excludeGVGsFilter vgmgr.ExcludeFilter) (*vgmgr.GlobalVirtualGroupMeta, error) {
 	vgm.mutex.RLock()
 	defer vgm.mutex.RUnlock()
-	return vgm.vgfManager.pickGlobalVirtualGroup(vgfID, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeGVGsInFamily(vgfID)), excludeGVGsFilter, vgm.healthChecker)
+	return vgm.vgfManager.pickGlobalVirtualGroup(vgfID, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeGVGsInFamily(vgfID)), excludeGVGsFilter, vgm.healthChecker,
+		vgm.spReputation.Scores())
 }
 
 // ForceRefreshMeta is used to query metadata service and refresh the virtual group manager meta.
@@ -481,7 +503,8 @@ func (vgm *virtualGroupManager) ForceRefreshMeta() error {
 func (vgm *virtualGroupManager) GenerateGlobalVirtualGroupMeta(genPolicy vgmgr.GenerateGVGSecondarySPsPolicy, excludeSPsFilter vgmgr.ExcludeFilter) (*vgmgr.GlobalVirtualGroupMeta, error) {
 	vgm.mutex.RLock()
 	defer vgm.mutex.RUnlock()
-	return vgm.spManager.generateVirtualGroupMeta(genPolicy, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeSPIDs()), excludeSPsFilter, vgm.healthChecker)
+	return vgm.spManager.generateVirtualGroupMeta(genPolicy, vgmgr.NewExcludeIDFilter(vgm.freezeSPPool.GetFreezeSPIDs()), excludeSPsFilter, vgm.healthChecker,
+		vgm.spReputation.Scores())
 }
 
 // PickSPByFilter is used to pick sp by filter check.
@@ -533,6 +556,38 @@ func (vgm *virtualGroupManager) ReleaseAllSP() {
 	vgm.freezeSPPool.ReleaseAllSP()
 }
 
+// ReportSPOutcomes records the outcomes of the requests to other SPs for their reputations, the outcomes of the
+// endpoints which are not known as other SPs are ignored.
+func (vgm *virtualGroupManager) ReportSPOutcomes(outcomes []*task.SPOutcome) {
+	if len(outcomes) == 0 {
+		return
+	}
+	spIDs := make(map[string]uint32)
+	vgm.mutex.RLock()
+	if vgm.spManager != nil {
+		for _, sp := range vgm.spManager.otherSPs {
+			spIDs[sp.GetEndpoint()] = sp.GetId()
+		}
+	}
+	vgm.mutex.RUnlock()
+	for _, outcome := range outcomes {
+		spID, ok := spIDs[outcome.Endpoint]
+		if !ok {
+			log.Debugw("skip the outcome of unknown sp", "endpoint", outcome.Endpoint)
+			continue
+		}
+		vgm.spReputation.RecordSPOutcome(spID, outcome)
+	}
+	for _, reputation := range vgm.spReputation.Reputations() {
+		metrics.SPReputationScoreGauge.WithLabelValues(strconv.Itoa(int(reputation.SPID))).Set(reputation.Score)
+	}
+}
+
+// QuerySPReputations returns the reputations of the SPs that have reported outcomes.
+func (vgm *virtualGroupManager) QuerySPReputations() []*vgmgr.SPReputation {
+	return vgm.spReputation.Reputations()
+}
+
 // releaseSPAndGVGLoop runs periodically to release SP from the freeze pool
 func (vgm *virtualGroupManager) releaseSPAndGVGLoop() {
 	ticker := time.NewTicker(ReleaseSPJobInterval)
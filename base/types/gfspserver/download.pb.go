@@ -565,50 +565,50 @@ func init() {
 }
 
 var fileDescriptor_4e9c5d8fc8df4b20 = []byte{
-	// 679 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x4f, 0xdb, 0x4a,
-	0x14, 0x8d, 0x1f, 0x79, 0x4f, 0x2f, 0x03, 0xef, 0x49, 0x0c, 0x54, 0x4a, 0x0d, 0x35, 0xc1, 0xea,
-	0x07, 0x6a, 0x85, 0x4d, 0xc3, 0xba, 0x5d, 0xd0, 0x16, 0xca, 0x02, 0x95, 0x9a, 0xae, 0x90, 0x50,
-	0x3a, 0xf6, 0xdc, 0xd8, 0x6e, 0x12, 0x8f, 0x99, 0x19, 0xa7, 0xd0, 0x76, 0xd3, 0x55, 0xb7, 0xfd,
-	0x0d, 0xdd, 0x76, 0xd7, 0x5f, 0xd1, 0x25, 0xcb, 0x2e, 0x2b, 0xf8, 0x23, 0x95, 0xc7, 0xf9, 0x22,
-	0x31, 0x09, 0x20, 0x36, 0x89, 0x75, 0xe6, 0xde, 0x73, 0xee, 0xb9, 0x9e, 0x7b, 0x13, 0x74, 0xd7,
-	0x25, 0x02, 0x6c, 0x79, 0x1c, 0x83, 0xb0, 0xfd, 0xba, 0x88, 0x05, 0xf0, 0x36, 0x70, 0x9b, 0xb2,
-	0xf7, 0x51, 0x93, 0x11, 0x6a, 0xc5, 0x9c, 0x49, 0x86, 0x6f, 0xa5, 0x51, 0x96, 0x8a, 0xb2, 0xfa,
-	0x51, 0xfa, 0xf2, 0x50, 0x32, 0x70, 0xce, 0xb8, 0xb0, 0xd5, 0x57, 0x96, 0xa9, 0x1b, 0x43, 0x21,
-	0x92, 0x88, 0x86, 0x9d, 0x7e, 0x64, 0xe7, 0xe6, 0x07, 0x74, 0x7b, 0xab, 0xbe, 0x17, 0x3f, 0xef,
-	0xe8, 0xbd, 0x72, 0xdf, 0x81, 0x27, 0x1d, 0x38, 0x4c, 0x40, 0x48, 0x7c, 0x80, 0xe6, 0xbb, 0x85,
-	0xd4, 0x98, 0x3a, 0xa9, 0xa5, 0xa9, 0x65, 0xad, 0xa2, 0xad, 0x4c, 0x57, 0x1f, 0x59, 0x43, 0x55,
-	0x29, 0xda, 0x51, 0xb6, 0x37, 0x44, 0x34, 0x1c, 0x4c, 0x47, 0x30, 0x93, 0x22, 0x3d, 0x4f, 0x5b,
-	0xc4, 0x2c, 0x12, 0x80, 0xab, 0x68, 0x0a, 0x38, 0xef, 0x68, 0x55, 0x86, 0xb5, 0x32, 0xab, 0x4a,
-	0xed, 0x45, 0xfa, 0xe8, 0xa4, 0xc1, 0x18, 0xa3, 0x22, 0x25, 0x92, 0x94, 0xff, 0xaa, 0x68, 0x2b,
-	0x33, 0x8e, 0x7a, 0x36, 0xdb, 0xa8, 0x3c, 0xa8, 0xb2, 0x1b, 0x82, 0x07, 0x5d, 0x83, 0xfb, 0x68,
-	0xae, 0x67, 0x30, 0x4e, 0x0f, 0x06, 0xfd, 0x3d, 0x9c, 0xe8, 0x4f, 0x71, 0x29, 0x7b, 0xb3, 0x74,
-	0x18, 0x32, 0xbd, 0xf3, 0x9d, 0xed, 0xe8, 0xde, 0xb0, 0xb9, 0x4f, 0x68, 0x21, 0x8d, 0xda, 0x02,
-	0xf9, 0x2c, 0x20, 0xcd, 0x26, 0x44, 0x3e, 0x6c, 0x47, 0x75, 0x36, 0xf0, 0x02, 0xbd, 0x2e, 0x3e,
-	0x6a, 0xf0, 0xe2, 0x17, 0xd8, 0x23, 0xeb, 0x3b, 0xc4, 0xde, 0x08, 0x66, 0x7e, 0xd7, 0xd0, 0x62,
-	0xbe, 0xfc, 0xcd, 0xda, 0xc4, 0xf7, 0xd0, 0xff, 0x61, 0x24, 0xc1, 0xe7, 0xa1, 0x3c, 0xae, 0x05,
-	0x44, 0x04, 0xe5, 0x29, 0x75, 0xfa, 0x5f, 0x0f, 0x7d, 0x49, 0x44, 0x80, 0x17, 0x51, 0xc9, 0x0b,
-	0xc0, 0x6b, 0x88, 0xa4, 0x25, 0xca, 0xc5, 0xca, 0xd4, 0xca, 0x8c, 0xd3, 0x07, 0xcc, 0xa3, 0xec,
-	0x85, 0x38, 0x10, 0xb6, 0xdc, 0x84, 0x0b, 0x78, 0x9d, 0x30, 0x49, 0xba, 0x9d, 0x5a, 0x40, 0x25,
-	0x37, 0xf1, 0x1a, 0x20, 0x6b, 0x21, 0x55, 0xf5, 0x16, 0x9d, 0x7f, 0x33, 0x60, 0x9b, 0xe2, 0x25,
-	0x34, 0x0d, 0x47, 0x92, 0x93, 0xda, 0x61, 0x9a, 0xa2, 0x2a, 0x2b, 0x3a, 0x48, 0x41, 0x8a, 0x04,
-	0xdf, 0x41, 0xe8, 0x18, 0x08, 0xaf, 0xb5, 0x58, 0x24, 0xb3, 0xda, 0x4a, 0x4e, 0x29, 0x45, 0x76,
-	0x52, 0xc0, 0xdc, 0xcd, 0x2e, 0xfa, 0xb0, 0xf2, 0xf5, 0x9b, 0x64, 0x7e, 0xd1, 0xd0, 0x7d, 0x75,
-	0xbb, 0x80, 0x26, 0x9e, 0x54, 0x7c, 0x9b, 0x8c, 0x6f, 0xa8, 0x82, 0x77, 0x42, 0x9f, 0x13, 0x09,
-	0x97, 0x72, 0xb6, 0x8c, 0x66, 0xa8, 0xa2, 0x38, 0x67, 0x6d, 0x9a, 0xf6, 0x69, 0x27, 0x79, 0x3b,
-	0x40, 0x0f, 0x26, 0x16, 0x72, 0x7d, 0xa3, 0xd5, 0x1f, 0x7f, 0xa3, 0xb9, 0xc1, 0x31, 0xda, 0x03,
-	0xde, 0x0e, 0x3d, 0xc0, 0x1f, 0x11, 0x1e, 0xdd, 0x1d, 0x78, 0xcd, 0xca, 0x5d, 0x94, 0xd6, 0x85,
-	0x2b, 0x4e, 0x7f, 0x7c, 0x85, 0x8c, 0xcc, 0x86, 0x59, 0xc0, 0x47, 0x68, 0x76, 0x64, 0xb4, 0xb1,
-	0x7d, 0x09, 0xa6, 0xc1, 0xe5, 0xa3, 0xaf, 0x5d, 0x3e, 0xa1, 0xa7, 0xfc, 0x59, 0x43, 0xf3, 0x79,
-	0x13, 0x87, 0xab, 0x63, 0xc8, 0x2e, 0xd8, 0x0e, 0xfa, 0xfa, 0x95, 0x72, 0x7a, 0x35, 0x74, 0x5a,
-	0x7f, 0xfe, 0x36, 0x8f, 0x6d, 0x7d, 0xee, 0xc8, 0x8d, 0x6d, 0x7d, 0xfe, 0xa8, 0x98, 0x05, 0xfc,
-	0x4d, 0x43, 0x4b, 0x13, 0xee, 0x1b, 0x7e, 0x32, 0xae, 0xb1, 0x13, 0x07, 0x46, 0x7f, 0x7a, 0xdd,
-	0xf4, 0x6e, 0x91, 0x1b, 0x6f, 0x7f, 0x9e, 0x1a, 0xda, 0xc9, 0xa9, 0xa1, 0xfd, 0x3e, 0x35, 0xb4,
-	0xaf, 0x67, 0x46, 0xe1, 0xe4, 0xcc, 0x28, 0xfc, 0x3a, 0x33, 0x0a, 0xfb, 0x9b, 0x7e, 0x28, 0x83,
-	0xc4, 0xb5, 0x3c, 0xd6, 0xb2, 0xdd, 0xc8, 0x5d, 0xf5, 0x02, 0x12, 0x46, 0xb6, 0xcf, 0x01, 0xa2,
-	0x7a, 0x08, 0x4d, 0xba, 0x2a, 0x24, 0xe3, 0xc4, 0x87, 0xd5, 0x98, 0xb3, 0x76, 0x48, 0x81, 0xdb,
-	0xb9, 0xff, 0x0f, 0xdc, 0x7f, 0xd4, 0xaf, 0xf7, 0xfa, 0x9f, 0x00, 0x00, 0x00, 0xff, 0xff, 0x0f,
-	0x14, 0xb3, 0xfa, 0x3f, 0x08, 0x00, 0x00,
+	// 675 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xac, 0x56, 0x4d, 0x53, 0xd3, 0x5c,
+	0x14, 0x6e, 0x5e, 0xfa, 0x3a, 0xf6, 0x82, 0xce, 0x70, 0xc1, 0x99, 0x1a, 0x30, 0x94, 0x8c, 0x1f,
+	0x8c, 0x0e, 0x09, 0x94, 0xb5, 0x2e, 0x50, 0x41, 0x16, 0x8c, 0x18, 0x5c, 0x31, 0xc3, 0xd4, 0x9b,
+	0xe4, 0x34, 0x89, 0x6d, 0x73, 0xc3, 0xbd, 0x37, 0x15, 0xfc, 0x58, 0xb8, 0x72, 0xeb, 0x6f, 0x70,
+	0xeb, 0xd6, 0x1f, 0xe1, 0x92, 0xa5, 0x4b, 0x07, 0xfe, 0x88, 0x93, 0x9b, 0x7e, 0xd1, 0x04, 0x0a,
+	0x1d, 0x36, 0x6d, 0xe6, 0xb9, 0xe7, 0x3c, 0xcf, 0x79, 0x4e, 0xee, 0x39, 0x2d, 0xba, 0x6f, 0x13,
+	0x0e, 0xa6, 0x38, 0x8a, 0x80, 0x9b, 0x5e, 0x9d, 0x47, 0x1c, 0x58, 0x1b, 0x98, 0xe9, 0xd2, 0x0f,
+	0x61, 0x93, 0x12, 0xd7, 0x88, 0x18, 0x15, 0x14, 0xdf, 0x49, 0xa2, 0x0c, 0x19, 0x65, 0xf4, 0xa3,
+	0xd4, 0xc5, 0xa1, 0x64, 0x60, 0x8c, 0x32, 0x6e, 0xca, 0xaf, 0x34, 0x53, 0xd5, 0x86, 0x42, 0x04,
+	0xe1, 0x0d, 0x33, 0xf9, 0x48, 0xcf, 0xf5, 0x8f, 0xe8, 0xee, 0x66, 0x7d, 0x37, 0x7a, 0xd1, 0xd1,
+	0x7b, 0x6d, 0xbf, 0x07, 0x47, 0x58, 0x70, 0x10, 0x03, 0x17, 0x78, 0x1f, 0xcd, 0x76, 0x0b, 0xa9,
+	0x51, 0x79, 0x52, 0x4b, 0x52, 0xcb, 0x4a, 0x45, 0x59, 0x9a, 0xac, 0x3e, 0x31, 0x86, 0xaa, 0x92,
+	0xb4, 0x59, 0xb6, 0xb7, 0x84, 0x37, 0x2c, 0xec, 0x66, 0x30, 0xdd, 0x45, 0x6a, 0x9e, 0x36, 0x8f,
+	0x68, 0xc8, 0x01, 0x57, 0xd1, 0x04, 0x30, 0xd6, 0xd1, 0xaa, 0x0c, 0x6b, 0xa5, 0x56, 0xa5, 0xda,
+	0xcb, 0xe4, 0xd1, 0x4a, 0x82, 0x31, 0x46, 0x45, 0x97, 0x08, 0x52, 0xfe, 0xaf, 0xa2, 0x2c, 0x4d,
+	0x59, 0xf2, 0x59, 0x6f, 0xa3, 0xf2, 0xa0, 0xca, 0x4e, 0x00, 0x0e, 0x74, 0x0d, 0xee, 0xa1, 0x99,
+	0x9e, 0xc1, 0x28, 0x39, 0x18, 0xf4, 0xf7, 0x78, 0xa4, 0x3f, 0xc9, 0x25, 0xed, 0x4d, 0xbb, 0xc3,
+	0x90, 0xee, 0x9c, 0xed, 0x6c, 0x47, 0xf7, 0x9a, 0xcd, 0x7d, 0x46, 0x73, 0x49, 0xd4, 0x26, 0x88,
+	0xe7, 0x3e, 0x69, 0x36, 0x21, 0xf4, 0x60, 0x2b, 0xac, 0xd3, 0x81, 0x17, 0xe8, 0x74, 0xf1, 0xac,
+	0xc1, 0xf3, 0x5f, 0x60, 0x8f, 0xac, 0xef, 0x10, 0x3b, 0x19, 0x4c, 0xff, 0xa9, 0xa0, 0xf9, 0x7c,
+	0xf9, 0xeb, 0xb5, 0x89, 0x1f, 0xa0, 0xdb, 0x41, 0x28, 0xc0, 0x63, 0x81, 0x38, 0xaa, 0xf9, 0x84,
+	0xfb, 0xe5, 0x09, 0x79, 0x7a, 0xab, 0x87, 0xbe, 0x22, 0xdc, 0xc7, 0xf3, 0xa8, 0xe4, 0xf8, 0xe0,
+	0x34, 0x78, 0xdc, 0xe2, 0xe5, 0x62, 0x65, 0x62, 0x69, 0xca, 0xea, 0x03, 0xfa, 0x61, 0xfa, 0x42,
+	0x2c, 0x08, 0x5a, 0x76, 0xcc, 0x38, 0xbc, 0x89, 0xa9, 0x20, 0xdd, 0x4e, 0xcd, 0xa1, 0x92, 0x1d,
+	0x3b, 0x0d, 0x10, 0xb5, 0xc0, 0x95, 0xf5, 0x16, 0xad, 0x9b, 0x29, 0xb0, 0xe5, 0xe2, 0x05, 0x34,
+	0x09, 0x87, 0x82, 0x91, 0xda, 0x41, 0x4c, 0x3b, 0x95, 0x15, 0x2d, 0x24, 0x21, 0x49, 0x82, 0xef,
+	0x21, 0x74, 0x04, 0x84, 0xd5, 0x5a, 0x34, 0x14, 0x69, 0x6d, 0x25, 0xab, 0x94, 0x20, 0xdb, 0x09,
+	0xa0, 0xef, 0x20, 0x35, 0x4f, 0x79, 0xfc, 0x26, 0xe9, 0xdf, 0x14, 0xf4, 0x50, 0xde, 0x2e, 0x70,
+	0x63, 0x47, 0x48, 0xbe, 0x0d, 0xca, 0xd6, 0x65, 0xc1, 0xdb, 0x81, 0xc7, 0x88, 0x80, 0x4b, 0x39,
+	0x5b, 0x44, 0x53, 0xae, 0xa4, 0x38, 0x63, 0x6d, 0xd2, 0xed, 0xd3, 0x8e, 0xf2, 0xb6, 0x8f, 0x1e,
+	0x8d, 0x2c, 0x64, 0x7c, 0xa3, 0xd5, 0x5f, 0xff, 0xa3, 0x99, 0xc1, 0x31, 0xda, 0x05, 0xd6, 0x0e,
+	0x1c, 0xc0, 0x5f, 0x10, 0xce, 0xee, 0x0e, 0xbc, 0x62, 0xe4, 0x2e, 0x4a, 0xe3, 0xdc, 0x15, 0xa7,
+	0xae, 0x5e, 0x21, 0x23, 0xb5, 0xa1, 0x17, 0x56, 0x14, 0x7c, 0x88, 0xa6, 0x33, 0xc3, 0x8d, 0xcd,
+	0x4b, 0x70, 0x0d, 0xae, 0x1f, 0x75, 0xe5, 0xf2, 0x09, 0x5d, 0x6d, 0xfc, 0x55, 0x41, 0xb3, 0x79,
+	0x33, 0x87, 0xab, 0x17, 0x90, 0x9d, 0xb3, 0x1f, 0xd4, 0xb5, 0x2b, 0xe5, 0xf4, 0x6a, 0xf8, 0x84,
+	0x70, 0xf6, 0x3e, 0x5f, 0xd8, 0xfc, 0xdc, 0xa1, 0x53, 0x57, 0xaf, 0x90, 0xd1, 0x13, 0xff, 0xa1,
+	0xa0, 0x85, 0x11, 0x37, 0x0e, 0x3f, 0xbd, 0xa8, 0xb1, 0x23, 0x47, 0x46, 0x7d, 0x36, 0x6e, 0x7a,
+	0xb7, 0xc8, 0xf5, 0x77, 0xbf, 0x4f, 0x34, 0xe5, 0xf8, 0x44, 0x53, 0xfe, 0x9e, 0x68, 0xca, 0xf7,
+	0x53, 0xad, 0x70, 0x7c, 0xaa, 0x15, 0xfe, 0x9c, 0x6a, 0x85, 0xbd, 0x0d, 0x2f, 0x10, 0x7e, 0x6c,
+	0x1b, 0x0e, 0x6d, 0x99, 0x76, 0x68, 0x2f, 0x3b, 0x3e, 0x09, 0x42, 0xd3, 0x63, 0x00, 0x61, 0x3d,
+	0x80, 0xa6, 0xbb, 0xcc, 0x05, 0x65, 0xc4, 0x83, 0xe5, 0x88, 0xd1, 0x76, 0xe0, 0x02, 0x33, 0x73,
+	0xff, 0x21, 0xd8, 0x37, 0xe4, 0xef, 0xf7, 0xda, 0xbf, 0x01, 0x00, 0x5d, 0xc7, 0x5f, 0x5e, 0x41,
+	0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -623,7 +623,7 @@ const _ = grpc.SupportPackageIsVersion4
 //
 // For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
 type GfSpDownloadServiceClient interface {
-	GfSpDownloadObject(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (*GfSpDownloadObjectResponse, error)
+	GfSpDownloadObject(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (GfSpDownloadService_GfSpDownloadObjectClient, error)
 	GfSpDownloadPiece(ctx context.Context, in *GfSpDownloadPieceRequest, opts ...grpc.CallOption) (*GfSpDownloadPieceResponse, error)
 	GfSpGetChallengeInfo(ctx context.Context, in *GfSpGetChallengeInfoRequest, opts ...grpc.CallOption) (*GfSpGetChallengeInfoResponse, error)
 	GfSpReimburseQuota(ctx context.Context, in *GfSpReimburseQuotaRequest, opts ...grpc.CallOption) (*GfSpReimburseQuotaResponse, error)
@@ -638,13 +638,36 @@ func NewGfSpDownloadServiceClient(cc grpc1.ClientConn) GfSpDownloadServiceClient
 	return &gfSpDownloadServiceClient{cc}
 }
 
-func (c *gfSpDownloadServiceClient) GfSpDownloadObject(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (*GfSpDownloadObjectResponse, error) {
-	out := new(GfSpDownloadObjectResponse)
-	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpDownloadService/GfSpDownloadObject", in, out, opts...)
+func (c *gfSpDownloadServiceClient) GfSpDownloadObject(ctx context.Context, in *GfSpDownloadObjectRequest, opts ...grpc.CallOption) (GfSpDownloadService_GfSpDownloadObjectClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GfSpDownloadService_serviceDesc.Streams[0], "/base.types.gfspserver.GfSpDownloadService/GfSpDownloadObject", opts...)
 	if err != nil {
 		return nil, err
 	}
-	return out, nil
+	x := &gfSpDownloadServiceGfSpDownloadObjectClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type GfSpDownloadService_GfSpDownloadObjectClient interface {
+	Recv() (*GfSpDownloadObjectResponse, error)
+	grpc.ClientStream
+}
+
+type gfSpDownloadServiceGfSpDownloadObjectClient struct {
+	grpc.ClientStream
+}
+
+func (x *gfSpDownloadServiceGfSpDownloadObjectClient) Recv() (*GfSpDownloadObjectResponse, error) {
+	m := new(GfSpDownloadObjectResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
 }
 
 func (c *gfSpDownloadServiceClient) GfSpDownloadPiece(ctx context.Context, in *GfSpDownloadPieceRequest, opts ...grpc.CallOption) (*GfSpDownloadPieceResponse, error) {
@@ -685,7 +708,7 @@ func (c *gfSpDownloadServiceClient) GfSpDeductQuotaForBucketMigrate(ctx context.
 
 // GfSpDownloadServiceServer is the server API for GfSpDownloadService service.
 type GfSpDownloadServiceServer interface {
-	GfSpDownloadObject(context.Context, *GfSpDownloadObjectRequest) (*GfSpDownloadObjectResponse, error)
+	GfSpDownloadObject(*GfSpDownloadObjectRequest, GfSpDownloadService_GfSpDownloadObjectServer) error
 	GfSpDownloadPiece(context.Context, *GfSpDownloadPieceRequest) (*GfSpDownloadPieceResponse, error)
 	GfSpGetChallengeInfo(context.Context, *GfSpGetChallengeInfoRequest) (*GfSpGetChallengeInfoResponse, error)
 	GfSpReimburseQuota(context.Context, *GfSpReimburseQuotaRequest) (*GfSpReimburseQuotaResponse, error)
@@ -696,8 +719,8 @@ type GfSpDownloadServiceServer interface {
 type UnimplementedGfSpDownloadServiceServer struct {
 }
 
-func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadObject(ctx context.Context, req *GfSpDownloadObjectRequest) (*GfSpDownloadObjectResponse, error) {
-	return nil, status.Errorf(codes.Unimplemented, "method GfSpDownloadObject not implemented")
+func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadObject(req *GfSpDownloadObjectRequest, srv GfSpDownloadService_GfSpDownloadObjectServer) error {
+	return status.Errorf(codes.Unimplemented, "method GfSpDownloadObject not implemented")
 }
 func (*UnimplementedGfSpDownloadServiceServer) GfSpDownloadPiece(ctx context.Context, req *GfSpDownloadPieceRequest) (*GfSpDownloadPieceResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpDownloadPiece not implemented")
@@ -716,22 +739,25 @@ func RegisterGfSpDownloadServiceServer(s grpc1.Server, srv GfSpDownloadServiceSe
 	s.RegisterService(&_GfSpDownloadService_serviceDesc, srv)
 }
 
-func _GfSpDownloadService_GfSpDownloadObject_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GfSpDownloadObjectRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GfSpDownloadServiceServer).GfSpDownloadObject(ctx, in)
+func _GfSpDownloadService_GfSpDownloadObject_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(GfSpDownloadObjectRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
 	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
-		FullMethod: "/base.types.gfspserver.GfSpDownloadService/GfSpDownloadObject",
-	}
-	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
-		return srv.(GfSpDownloadServiceServer).GfSpDownloadObject(ctx, req.(*GfSpDownloadObjectRequest))
-	}
-	return interceptor(ctx, in, info, handler)
+	return srv.(GfSpDownloadServiceServer).GfSpDownloadObject(m, &gfSpDownloadServiceGfSpDownloadObjectServer{stream})
+}
+
+type GfSpDownloadService_GfSpDownloadObjectServer interface {
+	Send(*GfSpDownloadObjectResponse) error
+	grpc.ServerStream
+}
+
+type gfSpDownloadServiceGfSpDownloadObjectServer struct {
+	grpc.ServerStream
+}
+
+func (x *gfSpDownloadServiceGfSpDownloadObjectServer) Send(m *GfSpDownloadObjectResponse) error {
+	return x.ServerStream.SendMsg(m)
 }
 
 func _GfSpDownloadService_GfSpDownloadPiece_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
@@ -810,10 +836,6 @@ var _GfSpDownloadService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "base.types.gfspserver.GfSpDownloadService",
 	HandlerType: (*GfSpDownloadServiceServer)(nil),
 	Methods: []grpc.MethodDesc{
-		{
-			MethodName: "GfSpDownloadObject",
-			Handler:    _GfSpDownloadService_GfSpDownloadObject_Handler,
-		},
 		{
 			MethodName: "GfSpDownloadPiece",
 			Handler:    _GfSpDownloadService_GfSpDownloadPiece_Handler,
@@ -831,7 +853,13 @@ var _GfSpDownloadService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _GfSpDownloadService_GfSpDeductQuotaForBucketMigrate_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GfSpDownloadObject",
+			Handler:       _GfSpDownloadService_GfSpDownloadObject_Handler,
+			ServerStreams: true,
+		},
+	},
 	Metadata: "base/types/gfspserver/download.proto",
 }
 
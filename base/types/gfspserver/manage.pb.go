@@ -5,6 +5,7 @@ package gfspserver
 
 import (
 	context "context"
+	encoding_binary "encoding/binary"
 	fmt "fmt"
 	gfsperrors "github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
 	gfsplimit "github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
@@ -401,6 +402,187 @@ func (*GfSpAskTaskResponse) XXX_OneofWrappers() []interface{} {
 	}
 }
 
+// GfSpExecutorStatus is sent by the executor over the dispatch stream, the first one registers the executor,
+// and the following ones advertise the capacity and renew the leases as heartbeats.
+type GfSpExecutorStatus struct {
+	// executor_id identifies the executor, the leases are bound to it and are revoked when the stream breaks
+	ExecutorId string `protobuf:"bytes,1,opt,name=executor_id,json=executorId,proto3" json:"executor_id,omitempty"`
+	// capacity is the remaining resource of the executor, the manager only offers the tasks below it
+	Capacity *gfsplimit.GfSpLimit `protobuf:"bytes,2,opt,name=capacity,proto3" json:"capacity,omitempty"`
+	// free_slots is the number of the tasks that the executor can accept now
+	FreeSlots int32 `protobuf:"varint,3,opt,name=free_slots,json=freeSlots,proto3" json:"free_slots,omitempty"`
+	// active_lease_ids are the leases of the running tasks, they are renewed by the status
+	ActiveLeaseIds []string `protobuf:"bytes,4,rep,name=active_lease_ids,json=activeLeaseIds,proto3" json:"active_lease_ids,omitempty"`
+	// released_lease_ids are the leases of the finished tasks that have been reported
+	ReleasedLeaseIds []string `protobuf:"bytes,5,rep,name=released_lease_ids,json=releasedLeaseIds,proto3" json:"released_lease_ids,omitempty"`
+	// rejected_lease_ids are the leases of the tasks that the executor fails to run, the tasks are dispatched again
+	RejectedLeaseIds []string `protobuf:"bytes,6,rep,name=rejected_lease_ids,json=rejectedLeaseIds,proto3" json:"rejected_lease_ids,omitempty"`
+}
+
+func (m *GfSpExecutorStatus) Reset()         { *m = GfSpExecutorStatus{} }
+func (m *GfSpExecutorStatus) String() string { return proto.CompactTextString(m) }
+func (*GfSpExecutorStatus) ProtoMessage()    {}
+func (*GfSpExecutorStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{4}
+}
+func (m *GfSpExecutorStatus) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpExecutorStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpExecutorStatus.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpExecutorStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpExecutorStatus.Merge(m, src)
+}
+func (m *GfSpExecutorStatus) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpExecutorStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpExecutorStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpExecutorStatus proto.InternalMessageInfo
+
+func (m *GfSpExecutorStatus) GetExecutorId() string {
+	if m != nil {
+		return m.ExecutorId
+	}
+	return ""
+}
+
+func (m *GfSpExecutorStatus) GetCapacity() *gfsplimit.GfSpLimit {
+	if m != nil {
+		return m.Capacity
+	}
+	return nil
+}
+
+func (m *GfSpExecutorStatus) GetFreeSlots() int32 {
+	if m != nil {
+		return m.FreeSlots
+	}
+	return 0
+}
+
+func (m *GfSpExecutorStatus) GetActiveLeaseIds() []string {
+	if m != nil {
+		return m.ActiveLeaseIds
+	}
+	return nil
+}
+
+func (m *GfSpExecutorStatus) GetReleasedLeaseIds() []string {
+	if m != nil {
+		return m.ReleasedLeaseIds
+	}
+	return nil
+}
+
+func (m *GfSpExecutorStatus) GetRejectedLeaseIds() []string {
+	if m != nil {
+		return m.RejectedLeaseIds
+	}
+	return nil
+}
+
+// GfSpTaskLease is sent by the manager over the dispatch stream, it carries either a leased task or the leases
+// that are revoked.
+type GfSpTaskLease struct {
+	Err     *gfsperrors.GfSpError `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	LeaseId string                `protobuf:"bytes,2,opt,name=lease_id,json=leaseId,proto3" json:"lease_id,omitempty"`
+	// expire_time is the unix time that the lease expires at if it is not renewed
+	ExpireTime int64 `protobuf:"varint,3,opt,name=expire_time,json=expireTime,proto3" json:"expire_time,omitempty"`
+	// heartbeat_interval is the interval in seconds that the executor should renew the leases in
+	HeartbeatInterval int64                `protobuf:"varint,4,opt,name=heartbeat_interval,json=heartbeatInterval,proto3" json:"heartbeat_interval,omitempty"`
+	Task              *GfSpAskTaskResponse `protobuf:"bytes,5,opt,name=task,proto3" json:"task,omitempty"`
+	// revoked_lease_ids are the leases that the executor does not hold any more, the tasks may be dispatched again
+	RevokedLeaseIds []string `protobuf:"bytes,6,rep,name=revoked_lease_ids,json=revokedLeaseIds,proto3" json:"revoked_lease_ids,omitempty"`
+}
+
+func (m *GfSpTaskLease) Reset()         { *m = GfSpTaskLease{} }
+func (m *GfSpTaskLease) String() string { return proto.CompactTextString(m) }
+func (*GfSpTaskLease) ProtoMessage()    {}
+func (*GfSpTaskLease) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{5}
+}
+func (m *GfSpTaskLease) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpTaskLease) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpTaskLease.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpTaskLease) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpTaskLease.Merge(m, src)
+}
+func (m *GfSpTaskLease) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpTaskLease) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpTaskLease.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpTaskLease proto.InternalMessageInfo
+
+func (m *GfSpTaskLease) GetErr() *gfsperrors.GfSpError {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *GfSpTaskLease) GetLeaseId() string {
+	if m != nil {
+		return m.LeaseId
+	}
+	return ""
+}
+
+func (m *GfSpTaskLease) GetExpireTime() int64 {
+	if m != nil {
+		return m.ExpireTime
+	}
+	return 0
+}
+
+func (m *GfSpTaskLease) GetHeartbeatInterval() int64 {
+	if m != nil {
+		return m.HeartbeatInterval
+	}
+	return 0
+}
+
+func (m *GfSpTaskLease) GetTask() *GfSpAskTaskResponse {
+	if m != nil {
+		return m.Task
+	}
+	return nil
+}
+
+func (m *GfSpTaskLease) GetRevokedLeaseIds() []string {
+	if m != nil {
+		return m.RevokedLeaseIds
+	}
+	return nil
+}
+
 type GfSpReportTaskRequest struct {
 	// Types that are valid to be assigned to Request:
 	//	*GfSpReportTaskRequest_UploadObjectTask
@@ -424,7 +606,7 @@ func (m *GfSpReportTaskRequest) Reset()         { *m = GfSpReportTaskRequest{} }
 func (m *GfSpReportTaskRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpReportTaskRequest) ProtoMessage()    {}
 func (*GfSpReportTaskRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{4}
+	return fileDescriptor_7801aa704e62bc53, []int{6}
 }
 func (m *GfSpReportTaskRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -650,7 +832,7 @@ func (m *GfSpReportTaskResponse) Reset()         { *m = GfSpReportTaskResponse{}
 func (m *GfSpReportTaskResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpReportTaskResponse) ProtoMessage()    {}
 func (*GfSpReportTaskResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{5}
+	return fileDescriptor_7801aa704e62bc53, []int{7}
 }
 func (m *GfSpReportTaskResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -694,7 +876,7 @@ func (m *GfSpPickVirtualGroupFamilyRequest) Reset()         { *m = GfSpPickVirtu
 func (m *GfSpPickVirtualGroupFamilyRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpPickVirtualGroupFamilyRequest) ProtoMessage()    {}
 func (*GfSpPickVirtualGroupFamilyRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{6}
+	return fileDescriptor_7801aa704e62bc53, []int{8}
 }
 func (m *GfSpPickVirtualGroupFamilyRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -739,7 +921,7 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Reset()         { *m = GfSpPickVirt
 func (m *GfSpPickVirtualGroupFamilyResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpPickVirtualGroupFamilyResponse) ProtoMessage()    {}
 func (*GfSpPickVirtualGroupFamilyResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{7}
+	return fileDescriptor_7801aa704e62bc53, []int{9}
 }
 func (m *GfSpPickVirtualGroupFamilyResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -790,7 +972,7 @@ func (m *GfSpNotifyMigrateSwapOutRequest) Reset()         { *m = GfSpNotifyMigra
 func (m *GfSpNotifyMigrateSwapOutRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyMigrateSwapOutRequest) ProtoMessage()    {}
 func (*GfSpNotifyMigrateSwapOutRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{8}
+	return fileDescriptor_7801aa704e62bc53, []int{10}
 }
 func (m *GfSpNotifyMigrateSwapOutRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -834,7 +1016,7 @@ func (m *GfSpNotifyMigrateSwapOutResponse) Reset()         { *m = GfSpNotifyMigr
 func (m *GfSpNotifyMigrateSwapOutResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyMigrateSwapOutResponse) ProtoMessage()    {}
 func (*GfSpNotifyMigrateSwapOutResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{9}
+	return fileDescriptor_7801aa704e62bc53, []int{11}
 }
 func (m *GfSpNotifyMigrateSwapOutResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -879,7 +1061,7 @@ func (m *GfSpNotifyPreMigrateBucketRequest) Reset()         { *m = GfSpNotifyPre
 func (m *GfSpNotifyPreMigrateBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPreMigrateBucketRequest) ProtoMessage()    {}
 func (*GfSpNotifyPreMigrateBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{10}
+	return fileDescriptor_7801aa704e62bc53, []int{12}
 }
 func (m *GfSpNotifyPreMigrateBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -924,7 +1106,7 @@ func (m *GfSpNotifyPreMigrateBucketResponse) Reset()         { *m = GfSpNotifyPr
 func (m *GfSpNotifyPreMigrateBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPreMigrateBucketResponse) ProtoMessage()    {}
 func (*GfSpNotifyPreMigrateBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{11}
+	return fileDescriptor_7801aa704e62bc53, []int{13}
 }
 func (m *GfSpNotifyPreMigrateBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -977,7 +1159,7 @@ func (m *GfSpNotifyPostMigrateBucketRequest) Reset()         { *m = GfSpNotifyPo
 func (m *GfSpNotifyPostMigrateBucketRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPostMigrateBucketRequest) ProtoMessage()    {}
 func (*GfSpNotifyPostMigrateBucketRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{12}
+	return fileDescriptor_7801aa704e62bc53, []int{14}
 }
 func (m *GfSpNotifyPostMigrateBucketRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1029,7 +1211,7 @@ func (m *GfSpNotifyPostMigrateBucketResponse) Reset()         { *m = GfSpNotifyP
 func (m *GfSpNotifyPostMigrateBucketResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpNotifyPostMigrateBucketResponse) ProtoMessage()    {}
 func (*GfSpNotifyPostMigrateBucketResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{13}
+	return fileDescriptor_7801aa704e62bc53, []int{15}
 }
 func (m *GfSpNotifyPostMigrateBucketResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1079,7 +1261,7 @@ func (m *GfSpQueryTasksStatsRequest) Reset()         { *m = GfSpQueryTasksStatsR
 func (m *GfSpQueryTasksStatsRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryTasksStatsRequest) ProtoMessage()    {}
 func (*GfSpQueryTasksStatsRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{14}
+	return fileDescriptor_7801aa704e62bc53, []int{16}
 }
 func (m *GfSpQueryTasksStatsRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1116,7 +1298,7 @@ func (m *GfSpQueryTasksStatsResponse) Reset()         { *m = GfSpQueryTasksStats
 func (m *GfSpQueryTasksStatsResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryTasksStatsResponse) ProtoMessage()    {}
 func (*GfSpQueryTasksStatsResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{15}
+	return fileDescriptor_7801aa704e62bc53, []int{17}
 }
 func (m *GfSpQueryTasksStatsResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1153,21 +1335,22 @@ func (m *GfSpQueryTasksStatsResponse) GetStats() *TasksStats {
 }
 
 type TasksStats struct {
-	UploadCount          uint32   `protobuf:"varint,1,opt,name=upload_count,json=uploadCount,proto3" json:"upload_count,omitempty"`
-	ReplicateCount       uint32   `protobuf:"varint,2,opt,name=replicate_count,json=replicateCount,proto3" json:"replicate_count,omitempty"`
-	SealCount            uint32   `protobuf:"varint,3,opt,name=seal_count,json=sealCount,proto3" json:"seal_count,omitempty"`
-	ResumableUploadCount uint32   `protobuf:"varint,4,opt,name=resumable_upload_count,json=resumableUploadCount,proto3" json:"resumable_upload_count,omitempty"`
-	MaxUploading         uint32   `protobuf:"varint,5,opt,name=max_uploading,json=maxUploading,proto3" json:"max_uploading,omitempty"`
-	MigrateGvgCount      uint32   `protobuf:"varint,6,opt,name=migrate_gvg_count,json=migrateGvgCount,proto3" json:"migrate_gvg_count,omitempty"`
-	RecoveryProcessCount uint32   `protobuf:"varint,7,opt,name=recovery_process_count,json=recoveryProcessCount,proto3" json:"recovery_process_count,omitempty"`
-	RecoveryFailedList   []string `protobuf:"bytes,8,rep,name=recovery_failed_list,json=recoveryFailedList,proto3" json:"recovery_failed_list,omitempty"`
+	UploadCount          uint32              `protobuf:"varint,1,opt,name=upload_count,json=uploadCount,proto3" json:"upload_count,omitempty"`
+	ReplicateCount       uint32              `protobuf:"varint,2,opt,name=replicate_count,json=replicateCount,proto3" json:"replicate_count,omitempty"`
+	SealCount            uint32              `protobuf:"varint,3,opt,name=seal_count,json=sealCount,proto3" json:"seal_count,omitempty"`
+	ResumableUploadCount uint32              `protobuf:"varint,4,opt,name=resumable_upload_count,json=resumableUploadCount,proto3" json:"resumable_upload_count,omitempty"`
+	MaxUploading         uint32              `protobuf:"varint,5,opt,name=max_uploading,json=maxUploading,proto3" json:"max_uploading,omitempty"`
+	MigrateGvgCount      uint32              `protobuf:"varint,6,opt,name=migrate_gvg_count,json=migrateGvgCount,proto3" json:"migrate_gvg_count,omitempty"`
+	RecoveryProcessCount uint32              `protobuf:"varint,7,opt,name=recovery_process_count,json=recoveryProcessCount,proto3" json:"recovery_process_count,omitempty"`
+	RecoveryFailedList   []string            `protobuf:"bytes,8,rep,name=recovery_failed_list,json=recoveryFailedList,proto3" json:"recovery_failed_list,omitempty"`
+	Scheduler            *TaskSchedulerStats `protobuf:"bytes,9,opt,name=scheduler,proto3" json:"scheduler,omitempty"`
 }
 
 func (m *TasksStats) Reset()         { *m = TasksStats{} }
 func (m *TasksStats) String() string { return proto.CompactTextString(m) }
 func (*TasksStats) ProtoMessage()    {}
 func (*TasksStats) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{16}
+	return fileDescriptor_7801aa704e62bc53, []int{18}
 }
 func (m *TasksStats) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1252,6 +1435,151 @@ func (m *TasksStats) GetRecoveryFailedList() []string {
 	return nil
 }
 
+func (m *TasksStats) GetScheduler() *TaskSchedulerStats {
+	if m != nil {
+		return m.Scheduler
+	}
+	return nil
+}
+
+// TaskSchedulerStats defines the policy of the manager picking the task to dispatch among the task queues,
+// and the dispatch shares of the task types over the latest dispatched tasks.
+type TaskSchedulerStats struct {
+	Policy     string           `protobuf:"bytes,1,opt,name=policy,proto3" json:"policy,omitempty"`
+	WindowSize uint32           `protobuf:"varint,2,opt,name=window_size,json=windowSize,proto3" json:"window_size,omitempty"`
+	Shares     []*TaskTypeShare `protobuf:"bytes,3,rep,name=shares,proto3" json:"shares,omitempty"`
+}
+
+func (m *TaskSchedulerStats) Reset()         { *m = TaskSchedulerStats{} }
+func (m *TaskSchedulerStats) String() string { return proto.CompactTextString(m) }
+func (*TaskSchedulerStats) ProtoMessage()    {}
+func (*TaskSchedulerStats) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{19}
+}
+func (m *TaskSchedulerStats) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskSchedulerStats) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskSchedulerStats.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskSchedulerStats) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskSchedulerStats.Merge(m, src)
+}
+func (m *TaskSchedulerStats) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskSchedulerStats) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskSchedulerStats.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskSchedulerStats proto.InternalMessageInfo
+
+func (m *TaskSchedulerStats) GetPolicy() string {
+	if m != nil {
+		return m.Policy
+	}
+	return ""
+}
+
+func (m *TaskSchedulerStats) GetWindowSize() uint32 {
+	if m != nil {
+		return m.WindowSize
+	}
+	return 0
+}
+
+func (m *TaskSchedulerStats) GetShares() []*TaskTypeShare {
+	if m != nil {
+		return m.Shares
+	}
+	return nil
+}
+
+type TaskTypeShare struct {
+	TaskType        string  `protobuf:"bytes,1,opt,name=task_type,json=taskType,proto3" json:"task_type,omitempty"`
+	Weight          uint32  `protobuf:"varint,2,opt,name=weight,proto3" json:"weight,omitempty"`
+	MinSharePercent uint32  `protobuf:"varint,3,opt,name=min_share_percent,json=minSharePercent,proto3" json:"min_share_percent,omitempty"`
+	Dispatched      uint32  `protobuf:"varint,4,opt,name=dispatched,proto3" json:"dispatched,omitempty"`
+	SharePercent    float64 `protobuf:"fixed64,5,opt,name=share_percent,json=sharePercent,proto3" json:"share_percent,omitempty"`
+}
+
+func (m *TaskTypeShare) Reset()         { *m = TaskTypeShare{} }
+func (m *TaskTypeShare) String() string { return proto.CompactTextString(m) }
+func (*TaskTypeShare) ProtoMessage()    {}
+func (*TaskTypeShare) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7801aa704e62bc53, []int{20}
+}
+func (m *TaskTypeShare) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *TaskTypeShare) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_TaskTypeShare.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *TaskTypeShare) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_TaskTypeShare.Merge(m, src)
+}
+func (m *TaskTypeShare) XXX_Size() int {
+	return m.Size()
+}
+func (m *TaskTypeShare) XXX_DiscardUnknown() {
+	xxx_messageInfo_TaskTypeShare.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_TaskTypeShare proto.InternalMessageInfo
+
+func (m *TaskTypeShare) GetTaskType() string {
+	if m != nil {
+		return m.TaskType
+	}
+	return ""
+}
+
+func (m *TaskTypeShare) GetWeight() uint32 {
+	if m != nil {
+		return m.Weight
+	}
+	return 0
+}
+
+func (m *TaskTypeShare) GetMinSharePercent() uint32 {
+	if m != nil {
+		return m.MinSharePercent
+	}
+	return 0
+}
+
+func (m *TaskTypeShare) GetDispatched() uint32 {
+	if m != nil {
+		return m.Dispatched
+	}
+	return 0
+}
+
+func (m *TaskTypeShare) GetSharePercent() float64 {
+	if m != nil {
+		return m.SharePercent
+	}
+	return 0
+}
+
 type GfSpQueryBucketMigrationProgressRequest struct {
 	BucketId uint64 `protobuf:"varint,1,opt,name=bucket_id,json=bucketId,proto3" json:"bucket_id,omitempty"`
 }
@@ -1262,7 +1590,7 @@ func (m *GfSpQueryBucketMigrationProgressRequest) Reset() {
 func (m *GfSpQueryBucketMigrationProgressRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryBucketMigrationProgressRequest) ProtoMessage()    {}
 func (*GfSpQueryBucketMigrationProgressRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{17}
+	return fileDescriptor_7801aa704e62bc53, []int{21}
 }
 func (m *GfSpQueryBucketMigrationProgressRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1308,7 +1636,7 @@ func (m *GfSpQueryBucketMigrationProgressResponse) Reset() {
 func (m *GfSpQueryBucketMigrationProgressResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryBucketMigrationProgressResponse) ProtoMessage()    {}
 func (*GfSpQueryBucketMigrationProgressResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{18}
+	return fileDescriptor_7801aa704e62bc53, []int{22}
 }
 func (m *GfSpQueryBucketMigrationProgressResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1362,7 +1690,7 @@ func (m *MigrateBucketProgressMeta) Reset()         { *m = MigrateBucketProgress
 func (m *MigrateBucketProgressMeta) String() string { return proto.CompactTextString(m) }
 func (*MigrateBucketProgressMeta) ProtoMessage()    {}
 func (*MigrateBucketProgressMeta) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{19}
+	return fileDescriptor_7801aa704e62bc53, []int{23}
 }
 func (m *MigrateBucketProgressMeta) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1475,7 +1803,7 @@ func (m *GfSpResetRecoveryFailedListRequest) Reset()         { *m = GfSpResetRec
 func (m *GfSpResetRecoveryFailedListRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpResetRecoveryFailedListRequest) ProtoMessage()    {}
 func (*GfSpResetRecoveryFailedListRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{20}
+	return fileDescriptor_7801aa704e62bc53, []int{24}
 }
 func (m *GfSpResetRecoveryFailedListRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1512,7 +1840,7 @@ func (m *GfSpResetRecoveryFailedListResponse) Reset()         { *m = GfSpResetRe
 func (m *GfSpResetRecoveryFailedListResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpResetRecoveryFailedListResponse) ProtoMessage()    {}
 func (*GfSpResetRecoveryFailedListResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{21}
+	return fileDescriptor_7801aa704e62bc53, []int{25}
 }
 func (m *GfSpResetRecoveryFailedListResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1560,7 +1888,7 @@ func (m *GfSpTriggerRecoverForSuccessorSPRequest) Reset() {
 func (m *GfSpTriggerRecoverForSuccessorSPRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpTriggerRecoverForSuccessorSPRequest) ProtoMessage()    {}
 func (*GfSpTriggerRecoverForSuccessorSPRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{22}
+	return fileDescriptor_7801aa704e62bc53, []int{26}
 }
 func (m *GfSpTriggerRecoverForSuccessorSPRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1620,7 +1948,7 @@ func (m *GfSpTriggerRecoverForSuccessorSPResponse) Reset() {
 func (m *GfSpTriggerRecoverForSuccessorSPResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpTriggerRecoverForSuccessorSPResponse) ProtoMessage()    {}
 func (*GfSpTriggerRecoverForSuccessorSPResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{23}
+	return fileDescriptor_7801aa704e62bc53, []int{27}
 }
 func (m *GfSpTriggerRecoverForSuccessorSPResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1665,7 +1993,7 @@ func (m *GfSpQueryRecoverProcessRequest) Reset()         { *m = GfSpQueryRecover
 func (m *GfSpQueryRecoverProcessRequest) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryRecoverProcessRequest) ProtoMessage()    {}
 func (*GfSpQueryRecoverProcessRequest) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{24}
+	return fileDescriptor_7801aa704e62bc53, []int{28}
 }
 func (m *GfSpQueryRecoverProcessRequest) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1719,7 +2047,7 @@ func (m *FailedRecoverObject) Reset()         { *m = FailedRecoverObject{} }
 func (m *FailedRecoverObject) String() string { return proto.CompactTextString(m) }
 func (*FailedRecoverObject) ProtoMessage()    {}
 func (*FailedRecoverObject) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{25}
+	return fileDescriptor_7801aa704e62bc53, []int{29}
 }
 func (m *FailedRecoverObject) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1792,7 +2120,7 @@ func (m *RecoverProcess) Reset()         { *m = RecoverProcess{} }
 func (m *RecoverProcess) String() string { return proto.CompactTextString(m) }
 func (*RecoverProcess) ProtoMessage()    {}
 func (*RecoverProcess) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{26}
+	return fileDescriptor_7801aa704e62bc53, []int{30}
 }
 func (m *RecoverProcess) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1894,7 +2222,7 @@ func (m *GfSpQueryRecoverProcessResponse) Reset()         { *m = GfSpQueryRecove
 func (m *GfSpQueryRecoverProcessResponse) String() string { return proto.CompactTextString(m) }
 func (*GfSpQueryRecoverProcessResponse) ProtoMessage()    {}
 func (*GfSpQueryRecoverProcessResponse) Descriptor() ([]byte, []int) {
-	return fileDescriptor_7801aa704e62bc53, []int{27}
+	return fileDescriptor_7801aa704e62bc53, []int{31}
 }
 func (m *GfSpQueryRecoverProcessResponse) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1949,6 +2277,8 @@ func init() {
 	proto.RegisterType((*GfSpBeginTaskResponse)(nil), "base.types.gfspserver.GfSpBeginTaskResponse")
 	proto.RegisterType((*GfSpAskTaskRequest)(nil), "base.types.gfspserver.GfSpAskTaskRequest")
 	proto.RegisterType((*GfSpAskTaskResponse)(nil), "base.types.gfspserver.GfSpAskTaskResponse")
+	proto.RegisterType((*GfSpExecutorStatus)(nil), "base.types.gfspserver.GfSpExecutorStatus")
+	proto.RegisterType((*GfSpTaskLease)(nil), "base.types.gfspserver.GfSpTaskLease")
 	proto.RegisterType((*GfSpReportTaskRequest)(nil), "base.types.gfspserver.GfSpReportTaskRequest")
 	proto.RegisterType((*GfSpReportTaskResponse)(nil), "base.types.gfspserver.GfSpReportTaskResponse")
 	proto.RegisterType((*GfSpPickVirtualGroupFamilyRequest)(nil), "base.types.gfspserver.GfSpPickVirtualGroupFamilyRequest")
@@ -1962,6 +2292,8 @@ func init() {
 	proto.RegisterType((*GfSpQueryTasksStatsRequest)(nil), "base.types.gfspserver.GfSpQueryTasksStatsRequest")
 	proto.RegisterType((*GfSpQueryTasksStatsResponse)(nil), "base.types.gfspserver.GfSpQueryTasksStatsResponse")
 	proto.RegisterType((*TasksStats)(nil), "base.types.gfspserver.TasksStats")
+	proto.RegisterType((*TaskSchedulerStats)(nil), "base.types.gfspserver.TaskSchedulerStats")
+	proto.RegisterType((*TaskTypeShare)(nil), "base.types.gfspserver.TaskTypeShare")
 	proto.RegisterType((*GfSpQueryBucketMigrationProgressRequest)(nil), "base.types.gfspserver.GfSpQueryBucketMigrationProgressRequest")
 	proto.RegisterType((*GfSpQueryBucketMigrationProgressResponse)(nil), "base.types.gfspserver.GfSpQueryBucketMigrationProgressResponse")
 	proto.RegisterType((*MigrateBucketProgressMeta)(nil), "base.types.gfspserver.MigrateBucketProgressMeta")
@@ -1980,141 +2312,165 @@ func init() {
 }
 
 var fileDescriptor_7801aa704e62bc53 = []byte{
-	// 2137 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0x5f, 0x6f, 0x1b, 0x59,
-	0x15, 0xcf, 0x6c, 0xec, 0x24, 0x3e, 0x4e, 0xdc, 0xe4, 0xe6, 0x4f, 0x53, 0xb7, 0xa4, 0xe9, 0x84,
-	0xaa, 0x69, 0xb7, 0xb1, 0x77, 0x03, 0xdb, 0xdd, 0xf2, 0x50, 0x48, 0xba, 0xc4, 0x1b, 0xd1, 0x74,
-	0xb3, 0x93, 0x6e, 0x10, 0x2b, 0xb1, 0xb3, 0xe3, 0x99, 0xeb, 0xc9, 0x90, 0xf1, 0xcc, 0xec, 0xbd,
-	0x33, 0x6e, 0xc2, 0x03, 0xe2, 0x19, 0x09, 0x09, 0x09, 0x81, 0xe0, 0x05, 0x09, 0x21, 0x90, 0xf8,
-	0x0c, 0x7c, 0x00, 0x78, 0x42, 0xfb, 0xc8, 0x23, 0x6a, 0x25, 0x5e, 0x10, 0x4f, 0x7c, 0x01, 0x74,
-	0xff, 0x8c, 0x3d, 0x63, 0x7b, 0xc6, 0xf9, 0x43, 0x16, 0xf1, 0x92, 0xda, 0xe7, 0x9e, 0x73, 0xee,
-	0x6f, 0xce, 0x3d, 0xe7, 0xdc, 0xdf, 0x19, 0x17, 0xd4, 0xa6, 0x41, 0x71, 0x3d, 0x3c, 0x0d, 0x30,
-	0xad, 0xdb, 0x2d, 0x1a, 0x50, 0x4c, 0x3a, 0x98, 0xd4, 0xdb, 0x86, 0x67, 0xd8, 0xb8, 0x16, 0x10,
-	0x3f, 0xf4, 0xd1, 0x22, 0xd3, 0xa9, 0x71, 0x9d, 0x5a, 0x4f, 0xa7, 0x7a, 0xa7, 0xcf, 0x14, 0x13,
-	0xe2, 0x13, 0x5a, 0xe7, 0xff, 0x08, 0xcb, 0xea, 0x6a, 0x9f, 0x8a, 0xeb, 0xb4, 0x9d, 0xb0, 0xce,
-	0xff, 0x4a, 0x8d, 0x95, 0x3e, 0x8d, 0xd0, 0xa0, 0xc7, 0x75, 0xf6, 0x27, 0xf6, 0x60, 0x13, 0x8c,
-	0xbd, 0x96, 0x83, 0x5d, 0xab, 0xde, 0x71, 0x48, 0x18, 0x19, 0xae, 0x4d, 0xfc, 0x28, 0xa8, 0x87,
-	0x27, 0x42, 0x43, 0xfd, 0xb7, 0x02, 0x0b, 0x8d, 0xd6, 0x41, 0xb0, 0x8d, 0x6d, 0xc7, 0x7b, 0x61,
-	0xd0, 0x63, 0x0d, 0x7f, 0x1e, 0x61, 0x1a, 0xa2, 0xef, 0x01, 0x8a, 0x02, 0xd7, 0x37, 0x2c, 0xdd,
-	0x6f, 0xfe, 0x00, 0x9b, 0xa1, 0xce, 0xdc, 0x2e, 0x2b, 0xab, 0xca, 0x7a, 0x79, 0xf3, 0x7e, 0xad,
-	0xef, 0x99, 0xf8, 0x96, 0xcc, 0xcd, 0xc7, 0xdc, 0xe4, 0x43, 0x6e, 0xc1, 0xbc, 0x7d, 0x30, 0xa6,
-	0xcd, 0x46, 0x7d, 0x32, 0x14, 0xc1, 0x2d, 0x82, 0x69, 0xd4, 0x36, 0x9a, 0x2e, 0xd6, 0x87, 0x6c,
-	0xf2, 0x06, 0xdf, 0x64, 0x33, 0x73, 0x13, 0x2d, 0x36, 0x1e, 0xb2, 0xdb, 0x0d, 0x92, 0xb5, 0xb8,
-	0x5d, 0x82, 0x49, 0x22, 0x1e, 0x4e, 0xfd, 0x0e, 0x2c, 0xf6, 0x3d, 0x34, 0x0d, 0x7c, 0x8f, 0x62,
-	0xb4, 0x09, 0xe3, 0x98, 0x10, 0xf9, 0x98, 0xab, 0xfd, 0x08, 0xc4, 0x19, 0x71, 0x0c, 0xdf, 0x66,
-	0x1f, 0x35, 0xa6, 0xac, 0xbe, 0x00, 0xc4, 0x24, 0x5b, 0xf4, 0x38, 0x19, 0xbf, 0x27, 0x00, 0x9e,
-	0x6f, 0x61, 0x9d, 0x1f, 0x97, 0x74, 0x78, 0xbb, 0xdf, 0xa1, 0x38, 0x4b, 0x66, 0xfd, 0x8c, 0x7d,
-	0xd2, 0x4a, 0xcc, 0x84, 0x7f, 0x54, 0xff, 0x35, 0x09, 0xf3, 0x29, 0xb7, 0x17, 0x47, 0x88, 0x74,
-	0x58, 0x20, 0x38, 0x70, 0x1d, 0xd3, 0x08, 0xb1, 0x1e, 0x38, 0xd8, 0xc4, 0xc9, 0x40, 0xbf, 0x99,
-	0x13, 0x68, 0x69, 0xb4, 0xcf, 0x6c, 0x64, 0x84, 0x11, 0x19, 0x90, 0xa2, 0x03, 0x98, 0xa5, 0xd8,
-	0x70, 0x53, 0xa7, 0x38, 0xce, 0x9d, 0xdf, 0xcb, 0x74, 0x7e, 0x80, 0x0d, 0x37, 0x75, 0x74, 0x15,
-	0x9a, 0x92, 0xb0, 0x0c, 0x24, 0xd8, 0xc4, 0x4e, 0x27, 0x85, 0xb9, 0x30, 0x22, 0x03, 0x35, 0x61,
-	0x92, 0x44, 0x3c, 0x4b, 0xfa, 0x64, 0x68, 0x0f, 0x2a, 0xb6, 0x99, 0x42, 0x5b, 0xe4, 0x6e, 0xef,
-	0x66, 0xba, 0x6d, 0x3c, 0x4d, 0x61, 0x9d, 0xb6, 0xcd, 0x04, 0xd2, 0xef, 0xc3, 0x82, 0x6d, 0xea,
-	0x3f, 0xf4, 0xdb, 0x4d, 0x27, 0x85, 0x75, 0x82, 0x3b, 0x7d, 0x90, 0xe3, 0xf4, 0x13, 0x6e, 0x93,
-	0x04, 0x3b, 0x67, 0x9b, 0x7d, 0x42, 0xd4, 0x80, 0x69, 0xdb, 0xd4, 0xdb, 0x38, 0x34, 0x84, 0xdb,
-	0x49, 0xee, 0x76, 0x2d, 0xc7, 0xed, 0x1e, 0x0e, 0x0d, 0xe9, 0x0f, 0x6c, 0x33, 0xfe, 0x26, 0x23,
-	0xea, 0x77, 0x30, 0x49, 0xa2, 0x9c, 0x1a, 0x1d, 0x51, 0x66, 0xd2, 0x1f, 0xd1, 0x94, 0x8c, 0x65,
-	0x40, 0xdb, 0xb1, 0x09, 0x4b, 0x30, 0xbb, 0x63, 0x0b, 0xc7, 0xa5, 0x11, 0x19, 0xb0, 0x27, 0x0c,
-	0x1a, 0x87, 0x8d, 0x38, 0x03, 0xa4, 0x8b, 0x46, 0xc7, 0xe6, 0x4e, 0x1d, 0x58, 0xb6, 0x4d, 0xbd,
-	0x19, 0x99, 0xc7, 0x38, 0xd4, 0xc5, 0x9a, 0xe3, 0x7b, 0xc2, 0x39, 0x70, 0xe7, 0xb5, 0x9c, 0x20,
-	0x6c, 0x73, 0xbb, 0xbd, 0xd8, 0x4c, 0xee, 0xb1, 0x68, 0x9b, 0x43, 0x16, 0x10, 0x85, 0x5b, 0xb6,
-	0xa9, 0xd3, 0xd0, 0x70, 0xb1, 0xde, 0xc1, 0x84, 0xb2, 0x7d, 0x92, 0xf9, 0x51, 0xe6, 0xdb, 0xbd,
-	0x9d, 0xb3, 0xdd, 0x01, 0xb3, 0x3d, 0x14, 0xa6, 0xa9, 0x5c, 0x59, 0xb6, 0xcd, 0xe1, 0x6b, 0xdb,
-	0x00, 0x53, 0x44, 0xd6, 0xb5, 0xfa, 0x57, 0x10, 0x3d, 0x49, 0xc3, 0x81, 0x4f, 0xc2, 0x2f, 0xa9,
-	0x13, 0xff, 0x7f, 0x36, 0x86, 0xc1, 0xea, 0x2d, 0x5c, 0x45, 0xf5, 0x16, 0xaf, 0xa6, 0x7a, 0x27,
-	0x2e, 0x5a, 0xbd, 0x3a, 0x2c, 0x58, 0xfe, 0x4b, 0x6f, 0x20, 0x13, 0x26, 0x47, 0x1c, 0xd6, 0xfb,
-	0xd2, 0x28, 0x15, 0x02, 0x64, 0x0d, 0x48, 0xd9, 0x06, 0xe6, 0x91, 0xe1, 0xba, 0xd8, 0xb3, 0xf1,
-	0x60, 0x83, 0xc8, 0xde, 0xe0, 0x69, 0x6c, 0x94, 0xca, 0x06, 0x73, 0x40, 0x9a, 0xd1, 0xd1, 0x4b,
-	0xff, 0x8d, 0x8e, 0x3e, 0x8a, 0x53, 0xc0, 0x95, 0x70, 0x8a, 0x8c, 0x8e, 0x5a, 0xbe, 0xaa, 0x8e,
-	0x3a, 0x7d, 0x95, 0x1d, 0x75, 0xe6, 0xcb, 0xed, 0xa8, 0x95, 0xab, 0xe8, 0xa8, 0x09, 0x8e, 0xf7,
-	0x0c, 0x96, 0xfa, 0xfb, 0xe9, 0x25, 0x48, 0xde, 0x2f, 0x14, 0xb8, 0xc3, 0x44, 0xfb, 0x8e, 0x79,
-	0x7c, 0x28, 0x98, 0x74, 0x83, 0x31, 0xe9, 0x1d, 0xa3, 0xed, 0xb8, 0xa7, 0x71, 0xab, 0x0e, 0xe0,
-	0xa6, 0x49, 0x30, 0x3b, 0x32, 0x19, 0x62, 0x23, 0x08, 0x88, 0xdf, 0x31, 0xdc, 0x64, 0xcf, 0xce,
-	0x7e, 0xe4, 0xa7, 0xdc, 0x56, 0x04, 0x73, 0x4b, 0x5a, 0x72, 0xe4, 0xcb, 0x66, 0xc6, 0x8a, 0xea,
-	0x83, 0x9a, 0x07, 0xeb, 0x12, 0xa4, 0x71, 0x11, 0x26, 0x3a, 0x76, 0x4b, 0x77, 0x2c, 0x7e, 0x1b,
-	0xcc, 0x68, 0xc5, 0x8e, 0xdd, 0xda, 0xb5, 0x54, 0x03, 0x6e, 0x33, 0xc5, 0xe7, 0x7e, 0xe8, 0xb4,
-	0x4e, 0x65, 0xbe, 0x1d, 0xbc, 0x34, 0x82, 0x0f, 0xa3, 0xb0, 0x47, 0x7d, 0xa7, 0xe8, 0x4b, 0x23,
-	0xd0, 0xfd, 0x28, 0x26, 0xbe, 0x6b, 0xb5, 0xde, 0x20, 0x52, 0x4b, 0x0e, 0x22, 0xb5, 0x3d, 0x6a,
-	0xc7, 0xd6, 0x93, 0x54, 0x7c, 0x50, 0x0f, 0x61, 0x35, 0x7b, 0x8b, 0x4b, 0x9c, 0xe1, 0xb7, 0xc4,
-	0x11, 0x0a, 0xbf, 0xfb, 0x04, 0x4b, 0xd7, 0x22, 0xaa, 0x31, 0xf8, 0x9b, 0x50, 0x92, 0x67, 0xe7,
-	0x58, 0xdc, 0x7d, 0x41, 0x9b, 0x12, 0x82, 0x5d, 0x4b, 0xfd, 0x95, 0x22, 0xc2, 0x9d, 0xe5, 0xe2,
-	0x12, 0xe1, 0x7e, 0x02, 0xc5, 0xcf, 0x23, 0x3f, 0x34, 0xe4, 0xdd, 0xbb, 0x9e, 0x99, 0x24, 0x62,
-	0xaf, 0x8f, 0x98, 0xee, 0xae, 0xd7, 0xf2, 0x35, 0x61, 0xa6, 0xfe, 0x2e, 0x0d, 0xcd, 0xa7, 0xe1,
-	0xb9, 0x1f, 0x0f, 0x7d, 0x06, 0x8b, 0x03, 0xad, 0xc1, 0xf1, 0x5a, 0xbe, 0xc4, 0xf4, 0x70, 0x04,
-	0xa6, 0x6e, 0xfd, 0x73, 0x5c, 0xf3, 0xcd, 0x41, 0xa1, 0xfa, 0x6b, 0x05, 0xd6, 0x72, 0x51, 0xfe,
-	0x0f, 0x23, 0x78, 0x0b, 0xaa, 0x6c, 0xf5, 0xa3, 0x08, 0x93, 0x53, 0x56, 0x5b, 0xf4, 0x20, 0x34,
-	0x42, 0x2a, 0x03, 0xa7, 0x1e, 0xc2, 0xcd, 0xa1, 0xab, 0x12, 0xf0, 0xbb, 0x50, 0xa4, 0x4c, 0x20,
-	0x21, 0xdf, 0xa9, 0x0d, 0x9d, 0xfa, 0x6b, 0x09, 0x4b, 0xa1, 0xaf, 0xfe, 0xe3, 0x0d, 0x80, 0x9e,
-	0x14, 0xdd, 0x81, 0x69, 0x79, 0x7b, 0x99, 0x7e, 0xe4, 0x89, 0xfa, 0x99, 0xd1, 0xca, 0x42, 0xf6,
-	0x94, 0x89, 0xd0, 0x3d, 0xb8, 0xd6, 0x23, 0x6d, 0x42, 0x4b, 0x54, 0x68, 0xa5, 0x2b, 0x16, 0x8a,
-	0x5f, 0x01, 0xe0, 0xe4, 0x4b, 0xe8, 0x8c, 0x73, 0x9d, 0x12, 0x93, 0x88, 0xe5, 0xaf, 0xc3, 0xd2,
-	0xc0, 0x95, 0x29, 0x54, 0x0b, 0x5c, 0x75, 0xa1, 0xef, 0xda, 0x13, 0x56, 0x6b, 0x30, 0xd3, 0x36,
-	0x4e, 0xa4, 0xbe, 0xe3, 0xd9, 0x9c, 0x26, 0xcd, 0x68, 0xd3, 0x6d, 0xe3, 0xe4, 0xe3, 0x58, 0x86,
-	0x1e, 0xc0, 0x5c, 0xf2, 0xee, 0x12, 0x5e, 0x27, 0xb8, 0xe2, 0xb5, 0xde, 0x8d, 0x94, 0x80, 0xc1,
-	0xef, 0xbe, 0x53, 0x3d, 0x20, 0xbe, 0x89, 0x29, 0x95, 0x06, 0x93, 0x31, 0x0c, 0xb1, 0xba, 0x2f,
-	0x16, 0x85, 0xd5, 0x5b, 0xd0, 0x95, 0xeb, 0x2d, 0xc3, 0x71, 0xb1, 0xa5, 0xbb, 0x0e, 0x0d, 0x97,
-	0xa7, 0x56, 0xc7, 0xd7, 0x4b, 0x5a, 0x7c, 0x29, 0x9f, 0xee, 0xf0, 0xa5, 0x67, 0x0e, 0x0d, 0xd5,
-	0x1d, 0xb8, 0xd7, 0x3d, 0xc0, 0xbe, 0x7c, 0xdd, 0x27, 0xbe, 0x4d, 0x30, 0xa5, 0x67, 0xea, 0x01,
-	0x27, 0xb0, 0x3e, 0xda, 0x8f, 0xcc, 0x8a, 0x67, 0x30, 0x15, 0x48, 0x99, 0x4c, 0x8c, 0xb7, 0x32,
-	0x12, 0x23, 0x55, 0x06, 0xb1, 0x1f, 0x46, 0xfd, 0xb4, 0xae, 0x07, 0xf5, 0x9f, 0xe3, 0x70, 0x23,
-	0x53, 0x2f, 0xbf, 0xb2, 0x1f, 0xc1, 0x75, 0x1a, 0x35, 0xa9, 0x49, 0x9c, 0x26, 0xb6, 0xf4, 0xa6,
-	0xeb, 0x9b, 0xc7, 0xfa, 0x11, 0x76, 0xec, 0x23, 0x91, 0x3b, 0x05, 0x6d, 0xb1, 0xb7, 0xbc, 0xcd,
-	0x56, 0x3f, 0xe0, 0x8b, 0xfc, 0xb4, 0xe5, 0x41, 0xb2, 0x74, 0xc5, 0x32, 0x8b, 0xa6, 0xa5, 0x90,
-	0xe5, 0x2c, 0x46, 0x2a, 0xcc, 0x84, 0x7e, 0x68, 0xb8, 0xfc, 0xac, 0xbd, 0xa8, 0x2d, 0xf3, 0xa7,
-	0xcc, 0x85, 0x8d, 0x8e, 0xfd, 0x3c, 0x6a, 0xa3, 0xc7, 0x70, 0x43, 0xda, 0x58, 0x7a, 0xcb, 0xf1,
-	0x1c, 0x7a, 0x84, 0xad, 0xae, 0xbe, 0x48, 0xa1, 0xa5, 0x58, 0x61, 0x47, 0xae, 0x4b, 0xd3, 0x0d,
-	0x98, 0xb7, 0xcd, 0x41, 0x23, 0x91, 0x4e, 0xb3, 0xb6, 0xd9, 0xa7, 0xfe, 0x10, 0x50, 0x40, 0xb0,
-	0x6e, 0x61, 0x2b, 0x32, 0xd9, 0x6e, 0xa2, 0x27, 0x4c, 0xf2, 0xa7, 0x9c, 0x0d, 0x08, 0x7e, 0x5f,
-	0x2e, 0xf0, 0xfa, 0x67, 0xf5, 0xc6, 0x72, 0x25, 0x0a, 0xa4, 0xde, 0x14, 0xd7, 0x2b, 0x0b, 0x99,
-	0x50, 0xb9, 0x0f, 0x73, 0xae, 0x41, 0x43, 0xbd, 0x37, 0x73, 0x38, 0x16, 0x27, 0xad, 0x05, 0xad,
-	0xc2, 0x16, 0x1a, 0x72, 0x98, 0xd8, 0xb5, 0xd0, 0x1a, 0x54, 0x62, 0x55, 0x06, 0xd3, 0xb1, 0x38,
-	0xef, 0x2c, 0x68, 0x65, 0xa1, 0xd7, 0xe8, 0xd8, 0xbb, 0x16, 0xba, 0x0b, 0x95, 0x6e, 0x28, 0x9a,
-	0xa7, 0x21, 0xa6, 0x9c, 0x2f, 0x16, 0xb4, 0x38, 0xd2, 0xd6, 0x36, 0x13, 0xaa, 0x5f, 0x15, 0xfd,
-	0x5c, 0xc3, 0x94, 0xf5, 0xc5, 0xfe, 0x74, 0x8e, 0xdb, 0xd2, 0x77, 0x45, 0x3f, 0xcd, 0xd4, 0x92,
-	0x89, 0x98, 0x55, 0x2e, 0x4a, 0x66, 0xb9, 0xfc, 0x58, 0x11, 0xf5, 0xf2, 0x82, 0x38, 0xb6, 0x8d,
-	0x89, 0xf4, 0xbd, 0xe3, 0x93, 0x83, 0xc8, 0x64, 0x65, 0xe8, 0x93, 0x83, 0xfd, 0xb8, 0x5e, 0x7a,
-	0x54, 0x41, 0x49, 0x50, 0x05, 0x26, 0x96, 0x51, 0x90, 0x0c, 0xc2, 0xe6, 0xcf, 0x9f, 0xea, 0x5f,
-	0x8e, 0x67, 0xe1, 0x13, 0x9e, 0x55, 0xc5, 0x44, 0xff, 0xda, 0x65, 0x52, 0xf5, 0x53, 0x51, 0x69,
-	0xf9, 0x08, 0x2e, 0xc1, 0x07, 0x9e, 0xc3, 0x4a, 0xb7, 0x92, 0x63, 0x4a, 0x2e, 0x7a, 0xcc, 0x85,
-	0x1e, 0x4c, 0xfd, 0x83, 0x02, 0xf3, 0x22, 0x82, 0xd2, 0x9b, 0xc8, 0x0b, 0x56, 0x99, 0xbd, 0xc4,
-	0x91, 0x95, 0xe9, 0xc7, 0x29, 0xb3, 0x0e, 0xb3, 0x92, 0x10, 0xe9, 0x9c, 0x11, 0xf5, 0xbc, 0x56,
-	0x3a, 0x09, 0x42, 0xb7, 0x6b, 0xa1, 0xfb, 0x30, 0x4b, 0xb0, 0x15, 0x79, 0x96, 0xe1, 0x99, 0xa7,
-	0xa9, 0xc0, 0x5d, 0xeb, 0xc9, 0x79, 0xe4, 0x58, 0xe7, 0x27, 0x38, 0x24, 0xa7, 0x7a, 0xe8, 0xb4,
-	0x31, 0x2f, 0xc7, 0xa2, 0x56, 0xe2, 0x92, 0x17, 0x4e, 0x1b, 0xab, 0x7f, 0x1c, 0x87, 0x4a, 0xfa,
-	0x81, 0x87, 0xc2, 0x50, 0x86, 0xc2, 0x78, 0x07, 0xae, 0xa7, 0x35, 0x5b, 0x9c, 0x6b, 0xf6, 0x70,
-	0x2f, 0x74, 0x06, 0x88, 0xe8, 0xf9, 0xd0, 0xdf, 0x86, 0x32, 0x0d, 0x0d, 0x12, 0xea, 0x46, 0x2b,
-	0xc4, 0x84, 0xc3, 0x2f, 0x68, 0xc0, 0x45, 0x5b, 0x4c, 0x82, 0x16, 0xa0, 0x28, 0x5e, 0xab, 0x16,
-	0xf9, 0x92, 0xf8, 0x82, 0x96, 0x60, 0x82, 0xf5, 0xa8, 0x88, 0xf2, 0xd6, 0x50, 0xd4, 0xe4, 0x37,
-	0x56, 0xe2, 0x32, 0xfc, 0xbd, 0x6b, 0xa5, 0xa0, 0x95, 0x85, 0x4c, 0xdc, 0x26, 0x8f, 0xe1, 0x86,
-	0xac, 0x8a, 0x78, 0x42, 0xe1, 0xfd, 0x4c, 0xe8, 0x8b, 0x96, 0xb0, 0x24, 0x14, 0xe4, 0xac, 0xc1,
-	0x96, 0x85, 0xe9, 0xa7, 0xb0, 0x18, 0x4f, 0x80, 0x29, 0x17, 0xcb, 0xa5, 0xd5, 0xf1, 0x61, 0xaf,
-	0x0f, 0x64, 0xbf, 0x1f, 0x92, 0x27, 0xda, 0xbc, 0x74, 0xb4, 0x93, 0xd8, 0x49, 0xfd, 0xb3, 0x22,
-	0x08, 0xf7, 0xd0, 0x2c, 0xbd, 0x04, 0x5b, 0xd2, 0x60, 0xae, 0x3b, 0xb9, 0x0a, 0x77, 0x98, 0x2e,
-	0xbf, 0xc1, 0x31, 0xdf, 0xcd, 0xc0, 0xdc, 0xb7, 0x7b, 0x77, 0x64, 0x8d, 0xcd, 0xd1, 0x2d, 0x28,
-	0xe1, 0x13, 0x6c, 0x46, 0x21, 0xe3, 0x05, 0xec, 0x70, 0xa7, 0xb4, 0x9e, 0x60, 0xf3, 0x4f, 0x33,
-	0x30, 0xc7, 0x87, 0x54, 0xfe, 0xeb, 0xc8, 0x01, 0x26, 0x1d, 0xc7, 0xc4, 0xc8, 0x85, 0x99, 0xd4,
-	0xab, 0x78, 0xf4, 0x66, 0xc6, 0xee, 0xc3, 0x7e, 0xa5, 0xa8, 0x3e, 0x3c, 0x9b, 0xb2, 0x7c, 0xc7,
-	0x36, 0x86, 0x5a, 0x50, 0x4e, 0xbc, 0x54, 0x47, 0xf7, 0x73, 0xcc, 0xd3, 0xef, 0xf3, 0xab, 0x0f,
-	0xce, 0xa2, 0xda, 0xdd, 0xc7, 0x87, 0x4a, 0x7a, 0xf8, 0x44, 0x79, 0x48, 0x07, 0xde, 0xf9, 0x55,
-	0x37, 0xce, 0xa8, 0xdd, 0xdd, 0xf0, 0xe7, 0x8a, 0x60, 0xaf, 0xc3, 0x07, 0x41, 0xf4, 0x5e, 0x8e,
-	0xbf, 0xdc, 0x91, 0xb6, 0xfa, 0xf8, 0x02, 0x96, 0x5d, 0x54, 0x3f, 0x55, 0x60, 0x39, 0x6b, 0x94,
-	0x43, 0x8f, 0x72, 0x3c, 0xe7, 0x8c, 0x97, 0xd5, 0x77, 0xcf, 0x6d, 0xd7, 0xc5, 0xf3, 0x23, 0xf1,
-	0x9b, 0x4a, 0x1f, 0x89, 0x47, 0x6f, 0xe7, 0x78, 0x1c, 0x3e, 0x0e, 0x54, 0x37, 0xcf, 0x63, 0xd2,
-	0xdd, 0xff, 0xf7, 0x8a, 0x18, 0x6d, 0xf3, 0xc8, 0x23, 0x7a, 0x32, 0xca, 0x75, 0x3e, 0x7b, 0xad,
-	0x7e, 0xf3, 0xc2, 0xf6, 0x5d, 0x9c, 0xbf, 0x55, 0xc4, 0xd5, 0x3b, 0x7c, 0xce, 0xdd, 0xf2, 0x2c,
-	0x41, 0xa2, 0x04, 0x3f, 0x7a, 0x6f, 0xe4, 0x79, 0x64, 0xcc, 0xda, 0xb9, 0xb9, 0x95, 0x3f, 0x62,
-	0xab, 0x63, 0xe8, 0x37, 0x59, 0xa3, 0xe4, 0x96, 0xc7, 0xfb, 0x6a, 0x4c, 0xdf, 0xce, 0xb0, 0x49,
-	0xc6, 0xb0, 0x5c, 0xfd, 0xc6, 0x45, 0x4c, 0xbb, 0x00, 0x7f, 0xa9, 0x88, 0x91, 0x31, 0x83, 0x9b,
-	0xe5, 0x02, 0xcb, 0x67, 0x7d, 0xb9, 0xc0, 0x46, 0x50, 0xc1, 0x44, 0x16, 0xe6, 0x11, 0xab, 0xdc,
-	0x2c, 0x3c, 0x03, 0x27, 0xcc, 0xcd, 0xc2, 0xb3, 0x30, 0x3a, 0x75, 0x0c, 0xfd, 0x44, 0x81, 0xeb,
-	0x19, 0x57, 0x1f, 0x7a, 0x67, 0x54, 0x92, 0x0f, 0x25, 0x74, 0xd5, 0x47, 0xe7, 0x35, 0x8b, 0xc1,
-	0x6c, 0x7f, 0xf6, 0x97, 0x57, 0x2b, 0xca, 0x17, 0xaf, 0x56, 0x94, 0xbf, 0xbf, 0x5a, 0x51, 0x7e,
-	0xf6, 0x7a, 0x65, 0xec, 0x8b, 0xd7, 0x2b, 0x63, 0x7f, 0x7b, 0xbd, 0x32, 0xf6, 0xc9, 0x8e, 0xed,
-	0x84, 0x47, 0x51, 0xb3, 0x66, 0xfa, 0xed, 0x7a, 0xd3, 0x6b, 0x6e, 0x98, 0x47, 0x86, 0xe3, 0xd5,
-	0x7b, 0x2f, 0xbc, 0x36, 0x68, 0xe8, 0x13, 0xc3, 0xc6, 0x1b, 0x01, 0xf1, 0x3b, 0x8e, 0x85, 0x49,
-	0x7d, 0xe8, 0xff, 0x1a, 0x68, 0x4e, 0xf0, 0x5f, 0xe4, 0xbf, 0xf6, 0x9f, 0x00, 0x00, 0x00, 0xff,
-	0xff, 0xe1, 0xb9, 0x29, 0xdc, 0x55, 0x20, 0x00, 0x00,
+	// 2521 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xd4, 0x5a, 0xcd, 0x6f, 0xdc, 0xc6,
+	0x15, 0x17, 0xbd, 0xab, 0x8f, 0x7d, 0x2b, 0xad, 0xe5, 0x91, 0x64, 0xcb, 0x6b, 0x47, 0x96, 0x69,
+	0x07, 0x96, 0x1d, 0x4b, 0x72, 0xd4, 0xc6, 0x89, 0xd3, 0xc2, 0xa9, 0x65, 0x47, 0x8a, 0x50, 0xdb,
+	0x51, 0xb8, 0x8e, 0x8b, 0x06, 0x68, 0x18, 0x2e, 0x39, 0x4b, 0xb1, 0xe2, 0x92, 0xcc, 0x0c, 0xb9,
+	0xd2, 0xfa, 0x50, 0xf4, 0xdc, 0xa2, 0x40, 0x81, 0xa2, 0x9f, 0x87, 0x02, 0x45, 0xd1, 0x02, 0x05,
+	0x0a, 0xf4, 0xd4, 0xbf, 0xa1, 0x3d, 0x15, 0x39, 0xf6, 0x58, 0xd8, 0xc7, 0xa2, 0xa7, 0xfe, 0x03,
+	0xc5, 0x7c, 0x90, 0x4b, 0xee, 0x2e, 0xa9, 0xaf, 0x2a, 0x45, 0x2f, 0xf6, 0xee, 0x9b, 0xdf, 0x7b,
+	0xf3, 0xe6, 0xcd, 0xfb, 0x9a, 0xb7, 0x02, 0xb5, 0x69, 0x50, 0xbc, 0x1a, 0x76, 0x03, 0x4c, 0x57,
+	0xed, 0x16, 0x0d, 0x28, 0x26, 0x1d, 0x4c, 0x56, 0xdb, 0x86, 0x67, 0xd8, 0x78, 0x25, 0x20, 0x7e,
+	0xe8, 0xa3, 0x39, 0x86, 0x59, 0xe1, 0x98, 0x95, 0x1e, 0xa6, 0x7e, 0xb5, 0x8f, 0x15, 0x13, 0xe2,
+	0x13, 0xba, 0xca, 0xff, 0x13, 0x9c, 0xf5, 0xc5, 0x3e, 0x88, 0xeb, 0xb4, 0x9d, 0x70, 0x95, 0xff,
+	0x2b, 0x11, 0x0b, 0x7d, 0x88, 0xd0, 0xa0, 0xbb, 0xab, 0xec, 0x9f, 0x58, 0x82, 0x4d, 0x30, 0xf6,
+	0x5a, 0x0e, 0x76, 0xad, 0xd5, 0x8e, 0x43, 0xc2, 0xc8, 0x70, 0x6d, 0xe2, 0x47, 0xc1, 0x6a, 0xb8,
+	0x2f, 0x10, 0xea, 0xbf, 0x15, 0x98, 0xdd, 0x6c, 0x35, 0x82, 0x75, 0x6c, 0x3b, 0xde, 0x33, 0x83,
+	0xee, 0x6a, 0xf8, 0xf3, 0x08, 0xd3, 0x10, 0x7d, 0x1b, 0x50, 0x14, 0xb8, 0xbe, 0x61, 0xe9, 0x7e,
+	0xf3, 0xbb, 0xd8, 0x0c, 0x75, 0x26, 0x76, 0x5e, 0x59, 0x54, 0x96, 0xaa, 0x6b, 0x37, 0x57, 0xfa,
+	0xce, 0xc4, 0xb7, 0x64, 0x62, 0x3e, 0xe6, 0x2c, 0x1f, 0x72, 0x0e, 0x26, 0xed, 0x83, 0x11, 0x6d,
+	0x3a, 0xea, 0xa3, 0xa1, 0x08, 0x2e, 0x13, 0x4c, 0xa3, 0xb6, 0xd1, 0x74, 0xb1, 0x3e, 0x64, 0x93,
+	0x33, 0x7c, 0x93, 0xb5, 0xdc, 0x4d, 0xb4, 0x98, 0x79, 0xc8, 0x6e, 0x17, 0x49, 0xde, 0xe2, 0x7a,
+	0x05, 0xc6, 0x89, 0x38, 0x9c, 0xfa, 0x4d, 0x98, 0xeb, 0x3b, 0x34, 0x0d, 0x7c, 0x8f, 0x62, 0xb4,
+	0x06, 0x25, 0x4c, 0x88, 0x3c, 0xe6, 0x62, 0xbf, 0x06, 0xe2, 0x8e, 0xb8, 0x0e, 0xef, 0xb3, 0x8f,
+	0x1a, 0x03, 0xab, 0xcf, 0x00, 0x31, 0xca, 0x03, 0xba, 0x9b, 0xb6, 0xdf, 0x7d, 0x00, 0xcf, 0xb7,
+	0xb0, 0xce, 0xaf, 0x4b, 0x0a, 0xbc, 0xd2, 0x2f, 0x50, 0xdc, 0x25, 0xe3, 0x7e, 0xcc, 0x3e, 0x69,
+	0x15, 0xc6, 0xc2, 0x3f, 0xaa, 0xff, 0x1a, 0x87, 0x99, 0x8c, 0xd8, 0xe3, 0x6b, 0x88, 0x74, 0x98,
+	0x25, 0x38, 0x70, 0x1d, 0xd3, 0x08, 0xb1, 0x1e, 0x38, 0xd8, 0xc4, 0x69, 0x43, 0xbf, 0x51, 0x60,
+	0x68, 0xc9, 0xb4, 0xcd, 0x78, 0xa4, 0x85, 0x11, 0x19, 0xa0, 0xa2, 0x06, 0x4c, 0x53, 0x6c, 0xb8,
+	0x99, 0x5b, 0x2c, 0x71, 0xe1, 0x37, 0x72, 0x85, 0x37, 0xb0, 0xe1, 0x66, 0xae, 0xae, 0x46, 0x33,
+	0x14, 0xe6, 0x81, 0x04, 0x9b, 0xd8, 0xe9, 0x64, 0x74, 0x2e, 0x1f, 0xe0, 0x81, 0x9a, 0x60, 0x49,
+	0x6b, 0x3c, 0x4d, 0xfa, 0x68, 0xe8, 0x09, 0xd4, 0x6c, 0x33, 0xa3, 0xed, 0x28, 0x17, 0xfb, 0x7a,
+	0xae, 0xd8, 0xcd, 0x87, 0x19, 0x5d, 0x27, 0x6d, 0x33, 0xa5, 0xe9, 0x77, 0x60, 0xd6, 0x36, 0xf5,
+	0x17, 0x7e, 0xbb, 0xe9, 0x64, 0x74, 0x1d, 0xe3, 0x42, 0x6f, 0x15, 0x08, 0xfd, 0x84, 0xf3, 0xa4,
+	0x95, 0x3d, 0x67, 0x9b, 0x7d, 0x44, 0xb4, 0x09, 0x93, 0xb6, 0xa9, 0xb7, 0x71, 0x68, 0x08, 0xb1,
+	0xe3, 0x5c, 0xec, 0xb5, 0x02, 0xb1, 0x4f, 0x70, 0x68, 0x48, 0x79, 0x60, 0x9b, 0xf1, 0x37, 0x69,
+	0x51, 0xbf, 0x83, 0x49, 0x5a, 0xcb, 0x89, 0x83, 0x2d, 0xca, 0x58, 0xfa, 0x2d, 0x9a, 0xa1, 0x31,
+	0x0f, 0x68, 0x3b, 0x36, 0x61, 0x0e, 0x66, 0x77, 0x6c, 0x21, 0xb8, 0x72, 0x80, 0x07, 0x3c, 0x11,
+	0x0c, 0x9b, 0xcf, 0x37, 0x63, 0x0f, 0x90, 0x22, 0x36, 0x3b, 0x36, 0x17, 0xea, 0xc0, 0xbc, 0x6d,
+	0xea, 0xcd, 0xc8, 0xdc, 0xc5, 0xa1, 0x2e, 0xd6, 0x1c, 0xdf, 0x13, 0xc2, 0x81, 0x0b, 0x5f, 0x29,
+	0x30, 0xc2, 0x3a, 0xe7, 0x7b, 0x12, 0xb3, 0xc9, 0x3d, 0xe6, 0x6c, 0x73, 0xc8, 0x02, 0xa2, 0x70,
+	0xd9, 0x36, 0x75, 0x1a, 0x1a, 0x2e, 0xd6, 0x3b, 0x98, 0x50, 0xb6, 0x4f, 0xda, 0x3f, 0xaa, 0x7c,
+	0xbb, 0x37, 0x0b, 0xb6, 0x6b, 0x30, 0xde, 0xe7, 0x82, 0x35, 0xe3, 0x2b, 0xf3, 0xb6, 0x39, 0x7c,
+	0x6d, 0x1d, 0x60, 0x82, 0xc8, 0xb8, 0x56, 0x7f, 0x7e, 0x46, 0xa4, 0x91, 0xf7, 0xf7, 0xb1, 0x19,
+	0x85, 0x3e, 0x69, 0x84, 0x46, 0x18, 0x51, 0x74, 0x05, 0xaa, 0x58, 0x52, 0x74, 0xc7, 0xe2, 0x61,
+	0x5f, 0xd1, 0x20, 0x26, 0x6d, 0x59, 0xe8, 0x6b, 0x30, 0x61, 0x1a, 0x81, 0x61, 0x3a, 0x61, 0x77,
+	0xfe, 0xcc, 0xe1, 0xb2, 0x4c, 0xc2, 0x80, 0x5e, 0x03, 0x68, 0x11, 0x8c, 0x75, 0xea, 0xfa, 0x21,
+	0xe5, 0x11, 0x3b, 0xaa, 0x55, 0x18, 0xa5, 0xc1, 0x08, 0x68, 0x09, 0xa6, 0x0d, 0x33, 0x64, 0x01,
+	0xe8, 0x62, 0x83, 0x62, 0xdd, 0xb1, 0xe8, 0x7c, 0x79, 0xb1, 0xb4, 0x54, 0xd1, 0x6a, 0x82, 0xfe,
+	0x98, 0x91, 0xb7, 0x2c, 0x8a, 0x6e, 0x33, 0xcf, 0xe2, 0x20, 0x2b, 0x85, 0x1d, 0xe5, 0xd8, 0xe9,
+	0x78, 0x25, 0x8b, 0x66, 0x56, 0xc8, 0xa0, 0xc7, 0x62, 0xb4, 0x58, 0x89, 0xd1, 0xea, 0xaf, 0xce,
+	0xc0, 0x14, 0x53, 0x9e, 0x99, 0x8c, 0x13, 0x8f, 0x95, 0x03, 0x2f, 0xc2, 0x44, 0xbc, 0x15, 0xb7,
+	0x53, 0x45, 0x1b, 0x77, 0xc5, 0x0e, 0xc2, 0xc6, 0x81, 0x43, 0xb0, 0x1e, 0x3a, 0x6d, 0xcc, 0xcd,
+	0x50, 0xd2, 0x40, 0x90, 0x9e, 0x39, 0x6d, 0x8c, 0x96, 0x01, 0xed, 0x60, 0x83, 0x84, 0x4d, 0x6c,
+	0x84, 0xba, 0xe3, 0x85, 0x98, 0x74, 0x0c, 0x97, 0x67, 0xa2, 0x92, 0x76, 0x2e, 0x59, 0xd9, 0x92,
+	0x0b, 0xe8, 0x3e, 0x94, 0x53, 0x39, 0xe5, 0xd6, 0xca, 0xd0, 0x06, 0x60, 0x65, 0x48, 0x72, 0xd7,
+	0x38, 0x1f, 0xba, 0x05, 0xe7, 0x08, 0xee, 0xf8, 0xbb, 0x43, 0xac, 0x73, 0x56, 0x2e, 0x24, 0xc6,
+	0xf9, 0x1b, 0x88, 0x52, 0xa6, 0xe1, 0xc0, 0x27, 0xe1, 0x97, 0x54, 0xc0, 0xff, 0x3f, 0xeb, 0xc9,
+	0x60, 0xd2, 0x2f, 0x9f, 0x46, 0xd2, 0x1f, 0x3d, 0x9d, 0xa4, 0x3f, 0x76, 0xdc, 0xa4, 0xaf, 0xc3,
+	0xac, 0xe5, 0xef, 0x79, 0x03, 0x9e, 0x30, 0x7e, 0xc0, 0x65, 0x3d, 0x92, 0x4c, 0x19, 0x13, 0x20,
+	0x6b, 0x80, 0xca, 0x36, 0x30, 0x77, 0x0c, 0xd7, 0xc5, 0x9e, 0x8d, 0x07, 0xeb, 0x4a, 0xfe, 0x06,
+	0x0f, 0x63, 0xa6, 0x8c, 0x37, 0x98, 0x03, 0xd4, 0x9c, 0x46, 0xa0, 0xf2, 0xdf, 0x68, 0x04, 0x0e,
+	0x6a, 0x45, 0xe1, 0x54, 0x5a, 0xd1, 0x9c, 0x42, 0x5c, 0x3d, 0xad, 0x42, 0x3c, 0x79, 0x9a, 0x85,
+	0x78, 0xea, 0xcb, 0x2d, 0xc4, 0xb5, 0xd3, 0x28, 0xc4, 0xa9, 0xa7, 0xc1, 0x63, 0x38, 0xdf, 0x9f,
+	0x4f, 0x4f, 0xf0, 0x36, 0xf8, 0xa9, 0x02, 0x57, 0x19, 0x69, 0xdb, 0x31, 0x77, 0x9f, 0x8b, 0x07,
+	0xd8, 0x26, 0x7b, 0x80, 0x6d, 0x18, 0x6d, 0xc7, 0xed, 0xc6, 0xa9, 0x3a, 0x80, 0x4b, 0x26, 0xc1,
+	0xec, 0xca, 0xa4, 0x89, 0x8d, 0x20, 0x20, 0x7e, 0xc7, 0x70, 0xd3, 0x39, 0x3b, 0xff, 0xc8, 0x0f,
+	0x39, 0xaf, 0x30, 0xe6, 0x03, 0xc9, 0xc9, 0x35, 0x9f, 0x37, 0x73, 0x56, 0x54, 0x1f, 0xd4, 0x22,
+	0xb5, 0x4e, 0xf0, 0xd6, 0x98, 0x83, 0xb1, 0x8e, 0xdd, 0x8a, 0xab, 0xec, 0x94, 0x36, 0xda, 0xb1,
+	0x5b, 0x5b, 0x96, 0x6a, 0xc0, 0x15, 0x06, 0x7c, 0xea, 0x87, 0x4e, 0xab, 0x2b, 0xfd, 0xad, 0xb1,
+	0x67, 0x04, 0x1f, 0x46, 0x61, 0xef, 0xc5, 0x34, 0x41, 0xf7, 0x8c, 0x40, 0xf7, 0xa3, 0xf8, 0xbd,
+	0x74, 0x6d, 0xa5, 0xf7, 0x7e, 0x5d, 0x49, 0xbf, 0x5f, 0x57, 0x9e, 0x50, 0x3b, 0xe6, 0x1e, 0xa7,
+	0xe2, 0x83, 0xfa, 0x1c, 0x16, 0xf3, 0xb7, 0x38, 0xc1, 0x1d, 0x7e, 0x03, 0xae, 0xf6, 0xe4, 0x6e,
+	0x13, 0x2c, 0x45, 0x0b, 0xab, 0xc6, 0xca, 0x5f, 0x82, 0x8a, 0xbc, 0x3b, 0xd9, 0xa5, 0x95, 0xb5,
+	0x09, 0x41, 0xd8, 0xb2, 0xd4, 0x5f, 0x28, 0xa0, 0x16, 0x89, 0x38, 0x81, 0xb9, 0xef, 0xc3, 0xe8,
+	0xe7, 0x91, 0x1f, 0x1a, 0xb2, 0xf6, 0x2e, 0xe5, 0x3a, 0x89, 0xd8, 0xeb, 0x23, 0x86, 0xdd, 0xf2,
+	0x5a, 0xbe, 0x26, 0xd8, 0xd4, 0xdf, 0x66, 0x55, 0xf3, 0x69, 0x78, 0xe4, 0xe3, 0xa1, 0xcf, 0x60,
+	0x6e, 0x20, 0x35, 0x38, 0x5e, 0xcb, 0x97, 0x3a, 0xdd, 0x3e, 0x40, 0xa7, 0x24, 0xfe, 0xb9, 0x5e,
+	0x33, 0xcd, 0x41, 0xa2, 0xfa, 0x4b, 0x05, 0xae, 0x15, 0x6a, 0xf9, 0x3f, 0xb4, 0xe0, 0x65, 0xa8,
+	0xb3, 0xd5, 0x8f, 0x22, 0x4c, 0xba, 0x2c, 0xb6, 0x28, 0xeb, 0xdc, 0xa9, 0x34, 0x9c, 0xfa, 0x1c,
+	0x2e, 0x0d, 0x5d, 0x95, 0x0a, 0xbf, 0x0d, 0xa3, 0x94, 0x11, 0xa4, 0xca, 0x57, 0x73, 0x7a, 0xc5,
+	0x14, 0xa7, 0xc0, 0xab, 0x7f, 0x2a, 0x01, 0xf4, 0xa8, 0xe8, 0x2a, 0x4c, 0xca, 0xea, 0x65, 0xfa,
+	0x91, 0x27, 0xe2, 0x67, 0x4a, 0xab, 0x0a, 0xda, 0x43, 0x46, 0x42, 0x37, 0xe0, 0x6c, 0xaf, 0x69,
+	0x13, 0x28, 0x11, 0xa1, 0xb5, 0x84, 0x2c, 0x80, 0xaf, 0x01, 0xf0, 0xe6, 0x4b, 0x60, 0x4a, 0x1c,
+	0x53, 0x61, 0x14, 0xb1, 0xfc, 0x55, 0x38, 0x3f, 0x50, 0x32, 0x05, 0xb4, 0xcc, 0xa1, 0xb3, 0x7d,
+	0x65, 0x4f, 0x70, 0x5d, 0x83, 0xa9, 0xb6, 0xb1, 0x2f, 0xf1, 0x8e, 0x67, 0xf3, 0x36, 0x69, 0x4a,
+	0x9b, 0x6c, 0x1b, 0xfb, 0x1f, 0xc7, 0x34, 0xd6, 0xf8, 0xa6, 0x6b, 0x97, 0x90, 0x3a, 0xc6, 0x81,
+	0x67, 0x7b, 0x15, 0x29, 0xa5, 0x06, 0xaf, 0x7d, 0x5d, 0x3d, 0x20, 0xbe, 0x89, 0x29, 0x95, 0x0c,
+	0xe3, 0xb1, 0x1a, 0x62, 0x75, 0x5b, 0x2c, 0x0a, 0xae, 0x3b, 0x90, 0xd0, 0xf5, 0x96, 0xe1, 0xb8,
+	0xac, 0xc5, 0x76, 0x68, 0x38, 0x3f, 0xc1, 0xbb, 0xeb, 0xb8, 0x28, 0x77, 0x37, 0xf8, 0xd2, 0x63,
+	0x87, 0x86, 0x68, 0x13, 0x2a, 0xd4, 0xdc, 0xc1, 0x56, 0xe4, 0x62, 0x92, 0xd7, 0x73, 0xa4, 0x6e,
+	0xa9, 0x11, 0x63, 0xc5, 0x6d, 0xf5, 0x78, 0xd5, 0x1f, 0x2a, 0x80, 0x06, 0x11, 0xe8, 0x3c, 0x8c,
+	0x05, 0xbe, 0xeb, 0x98, 0x5d, 0xf9, 0xb6, 0x93, 0xdf, 0xd8, 0xa3, 0x64, 0xcf, 0xf1, 0x2c, 0x7f,
+	0x4f, 0xa7, 0xce, 0x0b, 0x2c, 0xaf, 0x0a, 0x04, 0xa9, 0xe1, 0xbc, 0xc0, 0xe8, 0xeb, 0x30, 0x46,
+	0x77, 0x0c, 0x82, 0xd9, 0xbb, 0xad, 0xb4, 0x54, 0x5d, 0xbb, 0x5e, 0xa0, 0xd5, 0xb3, 0x6e, 0x80,
+	0x1b, 0x0c, 0xac, 0x49, 0x1e, 0xf5, 0xcf, 0x0a, 0x4c, 0x65, 0x56, 0x58, 0x88, 0x33, 0x57, 0xd7,
+	0x99, 0x00, 0xa9, 0xcb, 0x44, 0x28, 0x11, 0x4c, 0xcb, 0x3d, 0xec, 0xd8, 0x3b, 0xb1, 0xcf, 0xc8,
+	0x6f, 0xe2, 0xc6, 0x3c, 0x9d, 0x0b, 0xd5, 0x03, 0x4c, 0x4c, 0x9c, 0xb8, 0xcc, 0xd9, 0xb6, 0xe3,
+	0x71, 0xc9, 0xdb, 0x82, 0x8c, 0x16, 0x00, 0x2c, 0x87, 0x06, 0x46, 0xc8, 0x0c, 0x20, 0x9d, 0x25,
+	0x45, 0x61, 0x2e, 0x92, 0x95, 0xc3, 0x5c, 0x44, 0xd1, 0x26, 0x69, 0x4a, 0x88, 0xba, 0x01, 0x37,
+	0x92, 0x78, 0xea, 0x4b, 0x1f, 0xdb, 0xc4, 0xb7, 0x09, 0xa6, 0xf4, 0x50, 0x29, 0x79, 0x1f, 0x96,
+	0x0e, 0x96, 0x23, 0x83, 0xf4, 0x31, 0x4c, 0x04, 0x92, 0x26, 0xe3, 0xf4, 0x4e, 0x8e, 0xad, 0x33,
+	0x59, 0x29, 0x96, 0xc3, 0x3a, 0x71, 0x2d, 0x91, 0xa0, 0xfe, 0xb3, 0x04, 0x17, 0x73, 0x71, 0xc5,
+	0x89, 0xf6, 0x2e, 0x5c, 0xa0, 0x51, 0x93, 0x9a, 0xc4, 0x69, 0x62, 0x4b, 0x6f, 0xba, 0xbe, 0xb9,
+	0xab, 0xef, 0xf4, 0xae, 0xa5, 0xac, 0xcd, 0xf5, 0x96, 0xd7, 0xd9, 0xea, 0x07, 0xe2, 0x96, 0x58,
+	0xf0, 0xc9, 0xb8, 0xa2, 0xa1, 0x11, 0x62, 0x79, 0x43, 0x93, 0x92, 0xc8, 0x1c, 0x11, 0x23, 0x15,
+	0xa6, 0x42, 0x3f, 0x34, 0x5c, 0x1e, 0x7a, 0x5e, 0xd4, 0x96, 0x37, 0x54, 0xe5, 0xc4, 0xcd, 0x8e,
+	0xfd, 0x34, 0x6a, 0xa3, 0x7b, 0x70, 0x51, 0xf2, 0x58, 0x7a, 0xcb, 0xf1, 0x1c, 0xba, 0x83, 0xad,
+	0x04, 0x2f, 0x22, 0xfa, 0x7c, 0x0c, 0xd8, 0x90, 0xeb, 0x92, 0x75, 0x19, 0x66, 0x6c, 0x73, 0x90,
+	0x49, 0x44, 0xf7, 0xb4, 0x6d, 0xf6, 0xc1, 0x6f, 0x03, 0x0a, 0x08, 0xd6, 0x2d, 0x6c, 0x45, 0x7c,
+	0x4c, 0x20, 0x52, 0xf4, 0x38, 0x3f, 0xe5, 0x74, 0x40, 0xf0, 0x23, 0xb9, 0xc0, 0xd3, 0x31, 0x4b,
+	0x7f, 0x2c, 0x74, 0xa3, 0x40, 0xe2, 0x26, 0x38, 0xae, 0x2a, 0x68, 0x02, 0x72, 0x13, 0xce, 0xb9,
+	0x06, 0x0d, 0xf5, 0xde, 0x13, 0xd0, 0xb1, 0x78, 0x3c, 0x97, 0xb5, 0x1a, 0x5b, 0xd8, 0x94, 0x6f,
+	0xbb, 0x2d, 0xe6, 0x88, 0xb5, 0x18, 0xca, 0xd4, 0x74, 0x2c, 0xfe, 0x0c, 0x28, 0x6b, 0x55, 0x81,
+	0xdb, 0xec, 0xd8, 0x5b, 0x16, 0x7a, 0x1d, 0x6a, 0x89, 0x29, 0x9a, 0xdd, 0x10, 0x53, 0xde, 0xbe,
+	0x97, 0xb5, 0xd8, 0xd2, 0xd6, 0x3a, 0x23, 0xaa, 0xd7, 0x45, 0x79, 0xd5, 0x30, 0xc5, 0xa1, 0x36,
+	0x90, 0x5d, 0xe2, 0x2a, 0xf1, 0x2d, 0xb8, 0x56, 0x88, 0x92, 0x8e, 0x98, 0x97, 0xbd, 0x94, 0xbc,
+	0xec, 0xa5, 0x7e, 0x5f, 0x11, 0xf1, 0xf2, 0x8c, 0x38, 0xb6, 0x8d, 0x89, 0x94, 0xbd, 0xe1, 0x93,
+	0x46, 0x64, 0x9a, 0x98, 0x52, 0x9f, 0x34, 0xb6, 0xe3, 0x78, 0xe9, 0x75, 0x6e, 0x4a, 0xaa, 0x73,
+	0x63, 0x64, 0x69, 0x05, 0xd9, 0xd0, 0xd9, 0xfc, 0xfc, 0x99, 0x72, 0xe2, 0x78, 0x16, 0xde, 0x97,
+	0xf3, 0xa3, 0x5e, 0x39, 0xd9, 0x62, 0x54, 0xf5, 0x53, 0x58, 0x3a, 0x58, 0x83, 0x13, 0xb4, 0x67,
+	0x4f, 0x61, 0x21, 0x89, 0xe4, 0xf8, 0x85, 0x24, 0x52, 0xfe, 0xb1, 0x0e, 0xa6, 0xfe, 0x5e, 0x81,
+	0x19, 0x61, 0x41, 0x29, 0x4d, 0xf8, 0x05, 0x8b, 0xcc, 0x9e, 0xe3, 0xc8, 0xc8, 0xf4, 0x63, 0x97,
+	0x59, 0x82, 0x69, 0xd9, 0x9f, 0xea, 0xbc, 0x41, 0xed, 0x49, 0xad, 0x75, 0x52, 0xfd, 0xf5, 0x96,
+	0x85, 0x6e, 0xc2, 0x34, 0xc1, 0x56, 0xe4, 0x59, 0x86, 0x67, 0x76, 0x33, 0x86, 0x3b, 0xdb, 0xa3,
+	0x73, 0xcb, 0xb1, 0x42, 0x4c, 0x70, 0x48, 0xba, 0x62, 0x2c, 0x55, 0x16, 0xd3, 0x39, 0x4e, 0x61,
+	0x53, 0x29, 0xf5, 0x0f, 0x25, 0xa8, 0x65, 0x0f, 0x3c, 0x54, 0x0d, 0x65, 0xa8, 0x1a, 0x6f, 0xc1,
+	0x85, 0x2c, 0xb2, 0xc5, 0x5b, 0xff, 0x9e, 0xde, 0xb3, 0x9d, 0x81, 0x77, 0xc1, 0xd1, 0xb4, 0xbf,
+	0x02, 0x55, 0x1a, 0x1a, 0x24, 0xd4, 0x8d, 0x56, 0x88, 0x09, 0x57, 0xbf, 0xac, 0x01, 0x27, 0x3d,
+	0x60, 0x14, 0x34, 0x0b, 0xa3, 0xe2, 0xc7, 0x91, 0x51, 0xbe, 0x24, 0xbe, 0xb0, 0x4a, 0x43, 0xf9,
+	0xe8, 0x93, 0xa7, 0x86, 0x51, 0x4d, 0x7e, 0x63, 0x21, 0x2e, 0xcd, 0xdf, 0xab, 0xf2, 0x65, 0xad,
+	0x2a, 0x68, 0xa2, 0xb8, 0xdf, 0x83, 0x8b, 0x32, 0x2a, 0x24, 0x52, 0xe4, 0x33, 0x81, 0x17, 0x29,
+	0xe1, 0xbc, 0x00, 0xc8, 0xa7, 0x1f, 0x5b, 0x16, 0xac, 0x9f, 0xc2, 0x5c, 0xfc, 0x20, 0xcf, 0x88,
+	0x98, 0xaf, 0x2c, 0x96, 0x86, 0x4d, 0x73, 0x64, 0xbe, 0x1f, 0xe2, 0x27, 0xda, 0x8c, 0x14, 0xb4,
+	0x91, 0xda, 0x49, 0xfd, 0x8b, 0x02, 0x57, 0x72, 0xbd, 0xf4, 0x04, 0xcd, 0xab, 0xc6, 0x46, 0x85,
+	0x42, 0x6f, 0xd9, 0x04, 0x61, 0x3a, 0x7f, 0x66, 0xb1, 0x34, 0x6c, 0xac, 0x25, 0x75, 0xee, 0xdb,
+	0x7d, 0x9a, 0x64, 0xbe, 0x63, 0x8a, 0x2e, 0x43, 0x45, 0xcc, 0x97, 0x59, 0x9b, 0xc6, 0x2e, 0x77,
+	0x42, 0xeb, 0x11, 0xd6, 0xfe, 0x58, 0x83, 0x73, 0x7c, 0x66, 0xc0, 0x7f, 0xe3, 0x6c, 0x60, 0xd2,
+	0x71, 0x4c, 0x8c, 0x5c, 0x98, 0xca, 0xfc, 0xa0, 0x86, 0xde, 0x28, 0x98, 0x7a, 0xf6, 0xff, 0xd6,
+	0x58, 0xbf, 0x7d, 0x38, 0xb0, 0x9c, 0x94, 0x8f, 0xa0, 0x16, 0x54, 0x53, 0xd3, 0x53, 0x74, 0xf3,
+	0x30, 0x13, 0x56, 0xb1, 0xd3, 0x11, 0x86, 0xb1, 0xea, 0x08, 0xf2, 0xc5, 0x2c, 0xe0, 0x91, 0xec,
+	0x51, 0xd8, 0x6a, 0x23, 0x24, 0xd8, 0x68, 0x17, 0x6e, 0x99, 0x9d, 0xe0, 0xd7, 0xaf, 0x17, 0x40,
+	0x93, 0x91, 0xb6, 0x3a, 0xb2, 0xa4, 0xdc, 0x51, 0x90, 0x0f, 0xb5, 0xec, 0xf0, 0x01, 0x15, 0x99,
+	0x66, 0x60, 0xe6, 0x5b, 0x5f, 0x3e, 0x24, 0x3a, 0x39, 0xe1, 0x4f, 0x14, 0xa8, 0xe7, 0x0f, 0x02,
+	0xd0, 0x3b, 0x05, 0xf2, 0x0a, 0x47, 0x1a, 0xf5, 0x7b, 0xc7, 0xe0, 0x4c, 0xb4, 0xfa, 0x91, 0x02,
+	0xf3, 0x79, 0x4f, 0x79, 0x74, 0xb7, 0x40, 0x72, 0xc1, 0x78, 0xa1, 0xfe, 0xf6, 0x91, 0xf9, 0x12,
+	0x7d, 0xbe, 0x07, 0x33, 0x49, 0xf0, 0xa6, 0x1e, 0x5d, 0x6f, 0x16, 0x48, 0x1c, 0xfe, 0x1c, 0xac,
+	0xaf, 0x1d, 0x85, 0x25, 0xd9, 0xff, 0x77, 0x0a, 0x2c, 0x26, 0x88, 0x9c, 0x6e, 0x15, 0xdd, 0x3f,
+	0x48, 0x74, 0x71, 0xbb, 0x5c, 0x7f, 0xef, 0xd8, 0xfc, 0x89, 0x9e, 0xbf, 0x51, 0x60, 0xa9, 0x67,
+	0xce, 0xfe, 0x39, 0xc7, 0x03, 0xcf, 0x12, 0x5d, 0x9b, 0x68, 0xc8, 0xde, 0x39, 0xf0, 0x3e, 0x72,
+	0x66, 0x2d, 0xf5, 0x7b, 0xc7, 0xe0, 0x4c, 0x74, 0xfc, 0x75, 0xde, 0x28, 0xe1, 0x81, 0x67, 0x69,
+	0xa9, 0x7e, 0xf1, 0x10, 0x9b, 0xe4, 0x0c, 0x4b, 0xea, 0xef, 0x1e, 0x87, 0x35, 0x51, 0xf0, 0x67,
+	0x0a, 0x5c, 0x2a, 0x68, 0x06, 0x0b, 0x15, 0x2b, 0x6e, 0x33, 0xeb, 0xef, 0x1e, 0x87, 0x75, 0xc0,
+	0x0b, 0x8b, 0x3a, 0xb9, 0x42, 0x2f, 0x3c, 0x44, 0x13, 0x5a, 0x7f, 0xef, 0xd8, 0xfc, 0x89, 0x9e,
+	0x3f, 0x50, 0xe0, 0x42, 0x4e, 0xad, 0x45, 0x6f, 0x1d, 0xe4, 0xe4, 0x43, 0x3b, 0xc8, 0xfa, 0xdd,
+	0xa3, 0xb2, 0xc5, 0xca, 0xac, 0x7f, 0xf6, 0xd7, 0x97, 0x0b, 0xca, 0x17, 0x2f, 0x17, 0x94, 0x7f,
+	0xbc, 0x5c, 0x50, 0x7e, 0xfc, 0x6a, 0x61, 0xe4, 0x8b, 0x57, 0x0b, 0x23, 0x7f, 0x7f, 0xb5, 0x30,
+	0xf2, 0xc9, 0x86, 0xed, 0x84, 0x3b, 0x51, 0x73, 0xc5, 0xf4, 0xdb, 0xab, 0x4d, 0xaf, 0xb9, 0x6c,
+	0xee, 0x18, 0x8e, 0xb7, 0xda, 0x1b, 0x78, 0x2e, 0xd3, 0xd0, 0x27, 0x86, 0x8d, 0x97, 0xd9, 0xd8,
+	0xd6, 0xb1, 0x30, 0x59, 0x1d, 0xfa, 0xc7, 0x46, 0xcd, 0x31, 0xfe, 0x87, 0x3c, 0x5f, 0xf9, 0xcf,
+	0x00, 0x9d, 0xd5, 0x80, 0x16, 0x8c, 0x24, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -2131,6 +2487,7 @@ const _ = grpc.SupportPackageIsVersion4
 type GfSpManageServiceClient interface {
 	GfSpBeginTask(ctx context.Context, in *GfSpBeginTaskRequest, opts ...grpc.CallOption) (*GfSpBeginTaskResponse, error)
 	GfSpAskTask(ctx context.Context, in *GfSpAskTaskRequest, opts ...grpc.CallOption) (*GfSpAskTaskResponse, error)
+	GfSpDispatchTaskStream(ctx context.Context, opts ...grpc.CallOption) (GfSpManageService_GfSpDispatchTaskStreamClient, error)
 	GfSpReportTask(ctx context.Context, in *GfSpReportTaskRequest, opts ...grpc.CallOption) (*GfSpReportTaskResponse, error)
 	GfSpPickVirtualGroupFamily(ctx context.Context, in *GfSpPickVirtualGroupFamilyRequest, opts ...grpc.CallOption) (*GfSpPickVirtualGroupFamilyResponse, error)
 	GfSpNotifyMigrateSwapOut(ctx context.Context, in *GfSpNotifyMigrateSwapOutRequest, opts ...grpc.CallOption) (*GfSpNotifyMigrateSwapOutResponse, error)
@@ -2169,6 +2526,37 @@ func (c *gfSpManageServiceClient) GfSpAskTask(ctx context.Context, in *GfSpAskTa
 	return out, nil
 }
 
+func (c *gfSpManageServiceClient) GfSpDispatchTaskStream(ctx context.Context, opts ...grpc.CallOption) (GfSpManageService_GfSpDispatchTaskStreamClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_GfSpManageService_serviceDesc.Streams[0], "/base.types.gfspserver.GfSpManageService/GfSpDispatchTaskStream", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &gfSpManageServiceGfSpDispatchTaskStreamClient{stream}
+	return x, nil
+}
+
+type GfSpManageService_GfSpDispatchTaskStreamClient interface {
+	Send(*GfSpExecutorStatus) error
+	Recv() (*GfSpTaskLease, error)
+	grpc.ClientStream
+}
+
+type gfSpManageServiceGfSpDispatchTaskStreamClient struct {
+	grpc.ClientStream
+}
+
+func (x *gfSpManageServiceGfSpDispatchTaskStreamClient) Send(m *GfSpExecutorStatus) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *gfSpManageServiceGfSpDispatchTaskStreamClient) Recv() (*GfSpTaskLease, error) {
+	m := new(GfSpTaskLease)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
 func (c *gfSpManageServiceClient) GfSpReportTask(ctx context.Context, in *GfSpReportTaskRequest, opts ...grpc.CallOption) (*GfSpReportTaskResponse, error) {
 	out := new(GfSpReportTaskResponse)
 	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpManageService/GfSpReportTask", in, out, opts...)
@@ -2263,6 +2651,7 @@ func (c *gfSpManageServiceClient) GfSpQueryRecoverProcess(ctx context.Context, i
 type GfSpManageServiceServer interface {
 	GfSpBeginTask(context.Context, *GfSpBeginTaskRequest) (*GfSpBeginTaskResponse, error)
 	GfSpAskTask(context.Context, *GfSpAskTaskRequest) (*GfSpAskTaskResponse, error)
+	GfSpDispatchTaskStream(GfSpManageService_GfSpDispatchTaskStreamServer) error
 	GfSpReportTask(context.Context, *GfSpReportTaskRequest) (*GfSpReportTaskResponse, error)
 	GfSpPickVirtualGroupFamily(context.Context, *GfSpPickVirtualGroupFamilyRequest) (*GfSpPickVirtualGroupFamilyResponse, error)
 	GfSpNotifyMigrateSwapOut(context.Context, *GfSpNotifyMigrateSwapOutRequest) (*GfSpNotifyMigrateSwapOutResponse, error)
@@ -2285,6 +2674,9 @@ func (*UnimplementedGfSpManageServiceServer) GfSpBeginTask(ctx context.Context,
 func (*UnimplementedGfSpManageServiceServer) GfSpAskTask(ctx context.Context, req *GfSpAskTaskRequest) (*GfSpAskTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpAskTask not implemented")
 }
+func (*UnimplementedGfSpManageServiceServer) GfSpDispatchTaskStream(srv GfSpManageService_GfSpDispatchTaskStreamServer) error {
+	return status.Errorf(codes.Unimplemented, "method GfSpDispatchTaskStream not implemented")
+}
 func (*UnimplementedGfSpManageServiceServer) GfSpReportTask(ctx context.Context, req *GfSpReportTaskRequest) (*GfSpReportTaskResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpReportTask not implemented")
 }
@@ -2356,16 +2748,42 @@ func _GfSpManageService_GfSpAskTask_Handler(srv interface{}, ctx context.Context
 	return interceptor(ctx, in, info, handler)
 }
 
-func _GfSpManageService_GfSpReportTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
-	in := new(GfSpReportTaskRequest)
-	if err := dec(in); err != nil {
-		return nil, err
-	}
-	if interceptor == nil {
-		return srv.(GfSpManageServiceServer).GfSpReportTask(ctx, in)
-	}
-	info := &grpc.UnaryServerInfo{
-		Server:     srv,
+func _GfSpManageService_GfSpDispatchTaskStream_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(GfSpManageServiceServer).GfSpDispatchTaskStream(&gfSpManageServiceGfSpDispatchTaskStreamServer{stream})
+}
+
+type GfSpManageService_GfSpDispatchTaskStreamServer interface {
+	Send(*GfSpTaskLease) error
+	Recv() (*GfSpExecutorStatus, error)
+	grpc.ServerStream
+}
+
+type gfSpManageServiceGfSpDispatchTaskStreamServer struct {
+	grpc.ServerStream
+}
+
+func (x *gfSpManageServiceGfSpDispatchTaskStreamServer) Send(m *GfSpTaskLease) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *gfSpManageServiceGfSpDispatchTaskStreamServer) Recv() (*GfSpExecutorStatus, error) {
+	m := new(GfSpExecutorStatus)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+func _GfSpManageService_GfSpReportTask_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpReportTaskRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpManageServiceServer).GfSpReportTask(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
 		FullMethod: "/base.types.gfspserver.GfSpManageService/GfSpReportTask",
 	}
 	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
@@ -2589,7 +3007,14 @@ var _GfSpManageService_serviceDesc = grpc.ServiceDesc{
 			Handler:    _GfSpManageService_GfSpQueryRecoverProcess_Handler,
 		},
 	},
-	Streams:  []grpc.StreamDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "GfSpDispatchTaskStream",
+			Handler:       _GfSpManageService_GfSpDispatchTaskStream_Handler,
+			ServerStreams: true,
+			ClientStreams: true,
+		},
+	},
 	Metadata: "base/types/gfspserver/manage.proto",
 }
 
@@ -2991,6 +3416,153 @@ func (m *GfSpAskTaskResponse_GcStaleVersionObjectTask) MarshalToSizedBuffer(dAtA
 	}
 	return len(dAtA) - i, nil
 }
+func (m *GfSpExecutorStatus) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpExecutorStatus) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpExecutorStatus) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RejectedLeaseIds) > 0 {
+		for iNdEx := len(m.RejectedLeaseIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RejectedLeaseIds[iNdEx])
+			copy(dAtA[i:], m.RejectedLeaseIds[iNdEx])
+			i = encodeVarintManage(dAtA, i, uint64(len(m.RejectedLeaseIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if len(m.ReleasedLeaseIds) > 0 {
+		for iNdEx := len(m.ReleasedLeaseIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ReleasedLeaseIds[iNdEx])
+			copy(dAtA[i:], m.ReleasedLeaseIds[iNdEx])
+			i = encodeVarintManage(dAtA, i, uint64(len(m.ReleasedLeaseIds[iNdEx])))
+			i--
+			dAtA[i] = 0x2a
+		}
+	}
+	if len(m.ActiveLeaseIds) > 0 {
+		for iNdEx := len(m.ActiveLeaseIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.ActiveLeaseIds[iNdEx])
+			copy(dAtA[i:], m.ActiveLeaseIds[iNdEx])
+			i = encodeVarintManage(dAtA, i, uint64(len(m.ActiveLeaseIds[iNdEx])))
+			i--
+			dAtA[i] = 0x22
+		}
+	}
+	if m.FreeSlots != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.FreeSlots))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Capacity != nil {
+		{
+			size, err := m.Capacity.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.ExecutorId) > 0 {
+		i -= len(m.ExecutorId)
+		copy(dAtA[i:], m.ExecutorId)
+		i = encodeVarintManage(dAtA, i, uint64(len(m.ExecutorId)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpTaskLease) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpTaskLease) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpTaskLease) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RevokedLeaseIds) > 0 {
+		for iNdEx := len(m.RevokedLeaseIds) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RevokedLeaseIds[iNdEx])
+			copy(dAtA[i:], m.RevokedLeaseIds[iNdEx])
+			i = encodeVarintManage(dAtA, i, uint64(len(m.RevokedLeaseIds[iNdEx])))
+			i--
+			dAtA[i] = 0x32
+		}
+	}
+	if m.Task != nil {
+		{
+			size, err := m.Task.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x2a
+	}
+	if m.HeartbeatInterval != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.HeartbeatInterval))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.ExpireTime != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.ExpireTime))
+		i--
+		dAtA[i] = 0x18
+	}
+	if len(m.LeaseId) > 0 {
+		i -= len(m.LeaseId)
+		copy(dAtA[i:], m.LeaseId)
+		i = encodeVarintManage(dAtA, i, uint64(len(m.LeaseId)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GfSpReportTaskRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -3737,6 +4309,18 @@ func (m *TasksStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if m.Scheduler != nil {
+		{
+			size, err := m.Scheduler.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintManage(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0x4a
+	}
 	if len(m.RecoveryFailedList) > 0 {
 		for iNdEx := len(m.RecoveryFailedList) - 1; iNdEx >= 0; iNdEx-- {
 			i -= len(m.RecoveryFailedList[iNdEx])
@@ -3784,6 +4368,106 @@ func (m *TasksStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *TaskSchedulerStats) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskSchedulerStats) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskSchedulerStats) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Shares) > 0 {
+		for iNdEx := len(m.Shares) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.Shares[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintManage(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if m.WindowSize != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.WindowSize))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Policy) > 0 {
+		i -= len(m.Policy)
+		copy(dAtA[i:], m.Policy)
+		i = encodeVarintManage(dAtA, i, uint64(len(m.Policy)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *TaskTypeShare) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *TaskTypeShare) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *TaskTypeShare) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.SharePercent != 0 {
+		i -= 8
+		encoding_binary.LittleEndian.PutUint64(dAtA[i:], uint64(math.Float64bits(float64(m.SharePercent))))
+		i--
+		dAtA[i] = 0x29
+	}
+	if m.Dispatched != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.Dispatched))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.MinSharePercent != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.MinSharePercent))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Weight != 0 {
+		i = encodeVarintManage(dAtA, i, uint64(m.Weight))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.TaskType) > 0 {
+		i -= len(m.TaskType)
+		copy(dAtA[i:], m.TaskType)
+		i = encodeVarintManage(dAtA, i, uint64(len(m.TaskType)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GfSpQueryBucketMigrationProgressRequest) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4474,6 +5158,77 @@ func (m *GfSpAskTaskResponse_GcStaleVersionObjectTask) Size() (n int) {
 	}
 	return n
 }
+func (m *GfSpExecutorStatus) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.ExecutorId)
+	if l > 0 {
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.Capacity != nil {
+		l = m.Capacity.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.FreeSlots != 0 {
+		n += 1 + sovManage(uint64(m.FreeSlots))
+	}
+	if len(m.ActiveLeaseIds) > 0 {
+		for _, s := range m.ActiveLeaseIds {
+			l = len(s)
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	if len(m.ReleasedLeaseIds) > 0 {
+		for _, s := range m.ReleasedLeaseIds {
+			l = len(s)
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	if len(m.RejectedLeaseIds) > 0 {
+		for _, s := range m.RejectedLeaseIds {
+			l = len(s)
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GfSpTaskLease) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
+	l = len(m.LeaseId)
+	if l > 0 {
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.ExpireTime != 0 {
+		n += 1 + sovManage(uint64(m.ExpireTime))
+	}
+	if m.HeartbeatInterval != 0 {
+		n += 1 + sovManage(uint64(m.HeartbeatInterval))
+	}
+	if m.Task != nil {
+		l = m.Task.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if len(m.RevokedLeaseIds) > 0 {
+		for _, s := range m.RevokedLeaseIds {
+			l = len(s)
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	return n
+}
+
 func (m *GfSpReportTaskRequest) Size() (n int) {
 	if m == nil {
 		return 0
@@ -4839,17 +5594,68 @@ func (m *TasksStats) Size() (n int) {
 			n += 1 + l + sovManage(uint64(l))
 		}
 	}
+	if m.Scheduler != nil {
+		l = m.Scheduler.Size()
+		n += 1 + l + sovManage(uint64(l))
+	}
 	return n
 }
 
-func (m *GfSpQueryBucketMigrationProgressRequest) Size() (n int) {
+func (m *TaskSchedulerStats) Size() (n int) {
 	if m == nil {
 		return 0
 	}
 	var l int
 	_ = l
-	if m.BucketId != 0 {
-		n += 1 + sovManage(uint64(m.BucketId))
+	l = len(m.Policy)
+	if l > 0 {
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.WindowSize != 0 {
+		n += 1 + sovManage(uint64(m.WindowSize))
+	}
+	if len(m.Shares) > 0 {
+		for _, e := range m.Shares {
+			l = e.Size()
+			n += 1 + l + sovManage(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *TaskTypeShare) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.TaskType)
+	if l > 0 {
+		n += 1 + l + sovManage(uint64(l))
+	}
+	if m.Weight != 0 {
+		n += 1 + sovManage(uint64(m.Weight))
+	}
+	if m.MinSharePercent != 0 {
+		n += 1 + sovManage(uint64(m.MinSharePercent))
+	}
+	if m.Dispatched != 0 {
+		n += 1 + sovManage(uint64(m.Dispatched))
+	}
+	if m.SharePercent != 0 {
+		n += 9
+	}
+	return n
+}
+
+func (m *GfSpQueryBucketMigrationProgressRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.BucketId != 0 {
+		n += 1 + sovManage(uint64(m.BucketId))
 	}
 	return n
 }
@@ -5795,7 +6601,7 @@ func (m *GfSpAskTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
+func (m *GfSpExecutorStatus) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -5818,17 +6624,17 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpReportTaskRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpExecutorStatus: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpReportTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpExecutorStatus: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadObjectTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ExecutorId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -5838,30 +6644,27 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpUploadObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_UploadObjectTask{v}
+			m.ExecutorId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
 		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatePieceTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Capacity", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -5888,17 +6691,18 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpReplicatePieceTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Capacity == nil {
+				m.Capacity = &gfsplimit.GfSpLimit{}
+			}
+			if err := m.Capacity.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_ReplicatePieceTask{v}
 			iNdEx = postIndex
 		case 3:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealObjectTask", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field FreeSlots", wireType)
 			}
-			var msglen int
+			m.FreeSlots = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -5908,32 +6712,16 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.FreeSlots |= int32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &gfsptask.GfSpSealObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_SealObjectTask{v}
-			iNdEx = postIndex
 		case 4:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcObjectTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ActiveLeaseIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -5943,32 +6731,29 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpGCObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_GcObjectTask{v}
+			m.ActiveLeaseIds = append(m.ActiveLeaseIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcZombiePieceTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field ReleasedLeaseIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -5978,32 +6763,29 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpGCZombiePieceTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_GcZombiePieceTask{v}
+			m.ReleasedLeaseIds = append(m.ReleasedLeaseIds, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
 		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcMetaTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RejectedLeaseIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6013,30 +6795,77 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpGCMetaTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.RejectedLeaseIds = append(m.RejectedLeaseIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_GcMetaTask{v}
-			iNdEx = postIndex
-		case 7:
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpTaskLease) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpTaskLease: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpTaskLease: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field DownloadObjectTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6063,17 +6892,18 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpDownloadObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_DownloadObjectTask{v}
 			iNdEx = postIndex
-		case 8:
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePieceTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field LeaseId", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6083,32 +6913,29 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpChallengePieceTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_ChallengePieceTask{v}
+			m.LeaseId = string(dAtA[iNdEx:postIndex])
 			iNdEx = postIndex
-		case 9:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReceivePieceTask", wireType)
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ExpireTime", wireType)
 			}
-			var msglen int
+			m.ExpireTime = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6118,32 +6945,16 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ExpireTime |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &gfsptask.GfSpReceivePieceTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_ReceivePieceTask{v}
-			iNdEx = postIndex
-		case 10:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumableUploadObjectTask", wireType)
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field HeartbeatInterval", wireType)
 			}
-			var msglen int
+			m.HeartbeatInterval = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6153,30 +6964,14 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.HeartbeatInterval |= int64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			v := &gfsptask.GfSpResumableUploadObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			m.Request = &GfSpReportTaskRequest_ResumableUploadObjectTask{v}
-			iNdEx = postIndex
-		case 11:
+		case 5:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoverPieceTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Task", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6203,17 +6998,18 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpRecoverPieceTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Task == nil {
+				m.Task = &GfSpAskTaskResponse{}
+			}
+			if err := m.Task.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_RecoverPieceTask{v}
 			iNdEx = postIndex
-		case 12:
+		case 6:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateGvgTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RevokedLeaseIds", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6223,13 +7019,480 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RevokedLeaseIds = append(m.RevokedLeaseIds, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpReportTaskRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpReportTaskRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpUploadObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_UploadObjectTask{v}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicatePieceTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpReplicatePieceTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_ReplicatePieceTask{v}
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpSealObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_SealObjectTask{v}
+			iNdEx = postIndex
+		case 4:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpGCObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_GcObjectTask{v}
+			iNdEx = postIndex
+		case 5:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcZombiePieceTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpGCZombiePieceTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_GcZombiePieceTask{v}
+			iNdEx = postIndex
+		case 6:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcMetaTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpGCMetaTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_GcMetaTask{v}
+			iNdEx = postIndex
+		case 7:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DownloadObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpDownloadObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_DownloadObjectTask{v}
+			iNdEx = postIndex
+		case 8:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ChallengePieceTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpChallengePieceTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_ChallengePieceTask{v}
+			iNdEx = postIndex
+		case 9:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReceivePieceTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpReceivePieceTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_ReceivePieceTask{v}
+			iNdEx = postIndex
+		case 10:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumableUploadObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpResumableUploadObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_ResumableUploadObjectTask{v}
+			iNdEx = postIndex
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoverPieceTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpRecoverPieceTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_RecoverPieceTask{v}
+			iNdEx = postIndex
+		case 12:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateGvgTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
 			}
 			postIndex := iNdEx + msglen
 			if postIndex < 0 {
@@ -6246,7 +7509,213 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			iNdEx = postIndex
 		case 13:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcBucketMigrationTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field GcBucketMigrationTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpGCBucketMigrationTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_GcBucketMigrationTask{v}
+			iNdEx = postIndex
+		case 14:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field GcStaleVersionObjectTask", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			v := &gfsptask.GfSpGCStaleVersionObjectTask{}
+			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			m.Request = &GfSpReportTaskRequest_GcStaleVersionObjectTask{v}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpReportTaskResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpReportTaskResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpReportTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpPickVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketApprovalTask", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6273,15 +7742,66 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpGCBucketMigrationTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.CreateBucketApprovalTask == nil {
+				m.CreateBucketApprovalTask = &gfsptask.GfSpCreateBucketApprovalTask{}
+			}
+			if err := m.CreateBucketApprovalTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_GcBucketMigrationTask{v}
 			iNdEx = postIndex
-		case 14:
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field GcStaleVersionObjectTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6308,12 +7828,32 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			v := &gfsptask.GfSpGCStaleVersionObjectTask{}
-			if err := v.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
-			m.Request = &GfSpReportTaskRequest_GcStaleVersionObjectTask{v}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field VgfId", wireType)
+			}
+			m.VgfId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.VgfId |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManage(dAtA[iNdEx:])
@@ -6335,7 +7875,7 @@ func (m *GfSpReportTaskRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpReportTaskResponse) Unmarshal(dAtA []byte) error {
+func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6358,15 +7898,15 @@ func (m *GfSpReportTaskResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpReportTaskResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpReportTaskResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field SwapOut", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6393,10 +7933,10 @@ func (m *GfSpReportTaskResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Err == nil {
-				m.Err = &gfsperrors.GfSpError{}
+			if m.SwapOut == nil {
+				m.SwapOut = &types.MsgSwapOut{}
 			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.SwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6421,7 +7961,7 @@ func (m *GfSpReportTaskResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpPickVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
+func (m *GfSpNotifyMigrateSwapOutResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6444,15 +7984,15 @@ func (m *GfSpPickVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field CreateBucketApprovalTask", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6479,10 +8019,10 @@ func (m *GfSpPickVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.CreateBucketApprovalTask == nil {
-				m.CreateBucketApprovalTask = &gfsptask.GfSpCreateBucketApprovalTask{}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
 			}
-			if err := m.CreateBucketApprovalTask.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6507,7 +8047,7 @@ func (m *GfSpPickVirtualGroupFamilyRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
+func (m *GfSpNotifyPreMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6530,10 +8070,79 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpPickVirtualGroupFamilyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			m.BucketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		default:
+			iNdEx = preIndex
+			skippy, err := skipManage(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthManage
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpNotifyPreMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowManage
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6573,10 +8182,10 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 			}
 			iNdEx = postIndex
 		case 2:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field VgfId", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
 			}
-			m.VgfId = 0
+			var msglen int
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -6586,11 +8195,28 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.VgfId |= uint32(b&0x7F) << shift
+				msglen |= int(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &gfsptask.GfSpBucketQuotaInfo{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManage(dAtA[iNdEx:])
@@ -6612,7 +8238,7 @@ func (m *GfSpPickVirtualGroupFamilyResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
+func (m *GfSpNotifyPostMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6635,15 +8261,34 @@ func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+			}
+			m.BucketId = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.BucketId |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 2:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SwapOut", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field BucketMigrationInfo", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6670,10 +8315,10 @@ func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.SwapOut == nil {
-				m.SwapOut = &types.MsgSwapOut{}
+			if m.BucketMigrationInfo == nil {
+				m.BucketMigrationInfo = &gfsptask.GfSpBucketMigrationInfo{}
 			}
-			if err := m.SwapOut.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.BucketMigrationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6698,7 +8343,7 @@ func (m *GfSpNotifyMigrateSwapOutRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpNotifyMigrateSwapOutResponse) Unmarshal(dAtA []byte) error {
+func (m *GfSpNotifyPostMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6721,10 +8366,10 @@ func (m *GfSpNotifyMigrateSwapOutResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyMigrateSwapOutResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
@@ -6763,6 +8408,42 @@ func (m *GfSpNotifyMigrateSwapOutResponse) Unmarshal(dAtA []byte) error {
 				return err
 			}
 			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Quota == nil {
+				m.Quota = &gfsptask.GfSpBucketQuotaInfo{}
+			}
+			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManage(dAtA[iNdEx:])
@@ -6784,7 +8465,7 @@ func (m *GfSpNotifyMigrateSwapOutResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpNotifyPreMigrateBucketRequest) Unmarshal(dAtA []byte) error {
+func (m *GfSpQueryTasksStatsRequest) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6807,31 +8488,12 @@ func (m *GfSpNotifyPreMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpQueryTasksStatsRequest: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpQueryTasksStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
-			}
-			m.BucketId = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.BucketId |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManage(dAtA[iNdEx:])
@@ -6853,7 +8515,7 @@ func (m *GfSpNotifyPreMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpNotifyPreMigrateBucketResponse) Unmarshal(dAtA []byte) error {
+func (m *GfSpQueryTasksStatsResponse) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6876,51 +8538,15 @@ func (m *GfSpNotifyPreMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketResponse: wiretype end group for non-group")
+			return fmt.Errorf("proto: GfSpQueryTasksStatsResponse: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyPreMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: GfSpQueryTasksStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
-			}
-			var msglen int
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				msglen |= int(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
-			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
-			}
-			if m.Err == nil {
-				m.Err = &gfsperrors.GfSpError{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
-			iNdEx = postIndex
-		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -6947,10 +8573,10 @@ func (m *GfSpNotifyPreMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quota == nil {
-				m.Quota = &gfsptask.GfSpBucketQuotaInfo{}
+			if m.Stats == nil {
+				m.Stats = &TasksStats{}
 			}
-			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -6975,7 +8601,7 @@ func (m *GfSpNotifyPreMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpNotifyPostMigrateBucketRequest) Unmarshal(dAtA []byte) error {
+func (m *TasksStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -6998,17 +8624,17 @@ func (m *GfSpNotifyPostMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TasksStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TasksStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketId", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field UploadCount", wireType)
 			}
-			m.BucketId = 0
+			m.UploadCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7018,16 +8644,16 @@ func (m *GfSpNotifyPostMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.BucketId |= uint64(b&0x7F) << shift
+				m.UploadCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 2:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field BucketMigrationInfo", wireType)
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateCount", wireType)
 			}
-			var msglen int
+			m.ReplicateCount = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7037,83 +8663,111 @@ func (m *GfSpNotifyPostMigrateBucketRequest) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				m.ReplicateCount |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
-				return ErrInvalidLengthManage
-			}
-			postIndex := iNdEx + msglen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SealCount", wireType)
 			}
-			if postIndex > l {
-				return io.ErrUnexpectedEOF
+			m.SealCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.SealCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if m.BucketMigrationInfo == nil {
-				m.BucketMigrationInfo = &gfsptask.GfSpBucketMigrationInfo{}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field ResumableUploadCount", wireType)
 			}
-			if err := m.BucketMigrationInfo.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
+			m.ResumableUploadCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.ResumableUploadCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			iNdEx = postIndex
-		default:
-			iNdEx = preIndex
-			skippy, err := skipManage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MaxUploading", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthManage
+			m.MaxUploading = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MaxUploading |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field MigrateGvgCount", wireType)
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GfSpNotifyPostMigrateBucketResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowManage
+			m.MigrateGvgCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.MigrateGvgCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if iNdEx >= l {
-				return io.ErrUnexpectedEOF
+		case 7:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryProcessCount", wireType)
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.RecoveryProcessCount = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.RecoveryProcessCount |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpNotifyPostMigrateBucketResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+		case 8:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryFailedList", wireType)
 			}
-			var msglen int
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7123,31 +8777,27 @@ func (m *GfSpNotifyPostMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				msglen |= int(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
-			if msglen < 0 {
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
 				return ErrInvalidLengthManage
 			}
-			postIndex := iNdEx + msglen
+			postIndex := iNdEx + intStringLen
 			if postIndex < 0 {
 				return ErrInvalidLengthManage
 			}
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Err == nil {
-				m.Err = &gfsperrors.GfSpError{}
-			}
-			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
-				return err
-			}
+			m.RecoveryFailedList = append(m.RecoveryFailedList, string(dAtA[iNdEx:postIndex]))
 			iNdEx = postIndex
-		case 2:
+		case 9:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Quota", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Scheduler", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7174,10 +8824,10 @@ func (m *GfSpNotifyPostMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Quota == nil {
-				m.Quota = &gfsptask.GfSpBucketQuotaInfo{}
+			if m.Scheduler == nil {
+				m.Scheduler = &TaskSchedulerStats{}
 			}
-			if err := m.Quota.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			if err := m.Scheduler.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7202,7 +8852,7 @@ func (m *GfSpNotifyPostMigrateBucketResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *GfSpQueryTasksStatsRequest) Unmarshal(dAtA []byte) error {
+func (m *TaskSchedulerStats) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7225,65 +8875,66 @@ func (m *GfSpQueryTasksStatsRequest) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpQueryTasksStatsRequest: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskSchedulerStats: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpQueryTasksStatsRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskSchedulerStats: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
-		default:
-			iNdEx = preIndex
-			skippy, err := skipManage(dAtA[iNdEx:])
-			if err != nil {
-				return err
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Policy", wireType)
 			}
-			if (skippy < 0) || (iNdEx+skippy) < 0 {
-				return ErrInvalidLengthManage
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
 			}
-			if (iNdEx + skippy) > l {
-				return io.ErrUnexpectedEOF
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManage
 			}
-			iNdEx += skippy
-		}
-	}
-
-	if iNdEx > l {
-		return io.ErrUnexpectedEOF
-	}
-	return nil
-}
-func (m *GfSpQueryTasksStatsResponse) Unmarshal(dAtA []byte) error {
-	l := len(dAtA)
-	iNdEx := 0
-	for iNdEx < l {
-		preIndex := iNdEx
-		var wire uint64
-		for shift := uint(0); ; shift += 7 {
-			if shift >= 64 {
-				return ErrIntOverflowManage
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
 			}
-			if iNdEx >= l {
+			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			b := dAtA[iNdEx]
-			iNdEx++
-			wire |= uint64(b&0x7F) << shift
-			if b < 0x80 {
-				break
+			m.Policy = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field WindowSize", wireType)
 			}
-		}
-		fieldNum := int32(wire >> 3)
-		wireType := int(wire & 0x7)
-		if wireType == 4 {
-			return fmt.Errorf("proto: GfSpQueryTasksStatsResponse: wiretype end group for non-group")
-		}
-		if fieldNum <= 0 {
-			return fmt.Errorf("proto: GfSpQueryTasksStatsResponse: illegal tag %d (wire type %d)", fieldNum, wire)
-		}
-		switch fieldNum {
-		case 1:
+			m.WindowSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowManage
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.WindowSize |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
 			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field Stats", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Shares", wireType)
 			}
 			var msglen int
 			for shift := uint(0); ; shift += 7 {
@@ -7310,10 +8961,8 @@ func (m *GfSpQueryTasksStatsResponse) Unmarshal(dAtA []byte) error {
 			if postIndex > l {
 				return io.ErrUnexpectedEOF
 			}
-			if m.Stats == nil {
-				m.Stats = &TasksStats{}
-			}
-			if err := m.Stats.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+			m.Shares = append(m.Shares, &TaskTypeShare{})
+			if err := m.Shares[len(m.Shares)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
 				return err
 			}
 			iNdEx = postIndex
@@ -7338,7 +8987,7 @@ func (m *GfSpQueryTasksStatsResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
-func (m *TasksStats) Unmarshal(dAtA []byte) error {
+func (m *TaskTypeShare) Unmarshal(dAtA []byte) error {
 	l := len(dAtA)
 	iNdEx := 0
 	for iNdEx < l {
@@ -7361,17 +9010,17 @@ func (m *TasksStats) Unmarshal(dAtA []byte) error {
 		fieldNum := int32(wire >> 3)
 		wireType := int(wire & 0x7)
 		if wireType == 4 {
-			return fmt.Errorf("proto: TasksStats: wiretype end group for non-group")
+			return fmt.Errorf("proto: TaskTypeShare: wiretype end group for non-group")
 		}
 		if fieldNum <= 0 {
-			return fmt.Errorf("proto: TasksStats: illegal tag %d (wire type %d)", fieldNum, wire)
+			return fmt.Errorf("proto: TaskTypeShare: illegal tag %d (wire type %d)", fieldNum, wire)
 		}
 		switch fieldNum {
 		case 1:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field UploadCount", wireType)
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field TaskType", wireType)
 			}
-			m.UploadCount = 0
+			var stringLen uint64
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7381,16 +9030,29 @@ func (m *TasksStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.UploadCount |= uint32(b&0x7F) << shift
+				stringLen |= uint64(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthManage
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthManage
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.TaskType = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
 		case 2:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ReplicateCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Weight", wireType)
 			}
-			m.ReplicateCount = 0
+			m.Weight = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7400,16 +9062,16 @@ func (m *TasksStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ReplicateCount |= uint32(b&0x7F) << shift
+				m.Weight |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 3:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field SealCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field MinSharePercent", wireType)
 			}
-			m.SealCount = 0
+			m.MinSharePercent = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7419,16 +9081,16 @@ func (m *TasksStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.SealCount |= uint32(b&0x7F) << shift
+				m.MinSharePercent |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 4:
 			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field ResumableUploadCount", wireType)
+				return fmt.Errorf("proto: wrong wireType = %d for field Dispatched", wireType)
 			}
-			m.ResumableUploadCount = 0
+			m.Dispatched = 0
 			for shift := uint(0); ; shift += 7 {
 				if shift >= 64 {
 					return ErrIntOverflowManage
@@ -7438,100 +9100,22 @@ func (m *TasksStats) Unmarshal(dAtA []byte) error {
 				}
 				b := dAtA[iNdEx]
 				iNdEx++
-				m.ResumableUploadCount |= uint32(b&0x7F) << shift
+				m.Dispatched |= uint32(b&0x7F) << shift
 				if b < 0x80 {
 					break
 				}
 			}
 		case 5:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MaxUploading", wireType)
-			}
-			m.MaxUploading = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MaxUploading |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 6:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field MigrateGvgCount", wireType)
-			}
-			m.MigrateGvgCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.MigrateGvgCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 7:
-			if wireType != 0 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryProcessCount", wireType)
-			}
-			m.RecoveryProcessCount = 0
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				m.RecoveryProcessCount |= uint32(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-		case 8:
-			if wireType != 2 {
-				return fmt.Errorf("proto: wrong wireType = %d for field RecoveryFailedList", wireType)
-			}
-			var stringLen uint64
-			for shift := uint(0); ; shift += 7 {
-				if shift >= 64 {
-					return ErrIntOverflowManage
-				}
-				if iNdEx >= l {
-					return io.ErrUnexpectedEOF
-				}
-				b := dAtA[iNdEx]
-				iNdEx++
-				stringLen |= uint64(b&0x7F) << shift
-				if b < 0x80 {
-					break
-				}
-			}
-			intStringLen := int(stringLen)
-			if intStringLen < 0 {
-				return ErrInvalidLengthManage
+			if wireType != 1 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SharePercent", wireType)
 			}
-			postIndex := iNdEx + intStringLen
-			if postIndex < 0 {
-				return ErrInvalidLengthManage
-			}
-			if postIndex > l {
+			var v uint64
+			if (iNdEx + 8) > l {
 				return io.ErrUnexpectedEOF
 			}
-			m.RecoveryFailedList = append(m.RecoveryFailedList, string(dAtA[iNdEx:postIndex]))
-			iNdEx = postIndex
+			v = uint64(encoding_binary.LittleEndian.Uint64(dAtA[iNdEx:]))
+			iNdEx += 8
+			m.SharePercent = float64(math.Float64frombits(v))
 		default:
 			iNdEx = preIndex
 			skippy, err := skipManage(dAtA[iNdEx:])
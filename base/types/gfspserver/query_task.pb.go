@@ -544,6 +544,110 @@ func (m *GfSpQuerySpExitResponse) GetSelfSpId() uint32 {
 	return 0
 }
 
+type GfSpReloadConfigRequest struct {
+	DryRun bool `protobuf:"varint,1,opt,name=dry_run,json=dryRun,proto3" json:"dry_run,omitempty"`
+}
+
+func (m *GfSpReloadConfigRequest) Reset()         { *m = GfSpReloadConfigRequest{} }
+func (m *GfSpReloadConfigRequest) String() string { return proto.CompactTextString(m) }
+func (*GfSpReloadConfigRequest) ProtoMessage()    {}
+func (*GfSpReloadConfigRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e509f6e3771557, []int{9}
+}
+func (m *GfSpReloadConfigRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpReloadConfigRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpReloadConfigRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpReloadConfigRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpReloadConfigRequest.Merge(m, src)
+}
+func (m *GfSpReloadConfigRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpReloadConfigRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpReloadConfigRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpReloadConfigRequest proto.InternalMessageInfo
+
+func (m *GfSpReloadConfigRequest) GetDryRun() bool {
+	if m != nil {
+		return m.DryRun
+	}
+	return false
+}
+
+type GfSpReloadConfigResponse struct {
+	Err             *gfsperrors.GfSpError `protobuf:"bytes,1,opt,name=err,proto3" json:"err,omitempty"`
+	Applied         []string              `protobuf:"bytes,2,rep,name=applied,proto3" json:"applied,omitempty"`
+	RestartRequired []string              `protobuf:"bytes,3,rep,name=restart_required,json=restartRequired,proto3" json:"restart_required,omitempty"`
+}
+
+func (m *GfSpReloadConfigResponse) Reset()         { *m = GfSpReloadConfigResponse{} }
+func (m *GfSpReloadConfigResponse) String() string { return proto.CompactTextString(m) }
+func (*GfSpReloadConfigResponse) ProtoMessage()    {}
+func (*GfSpReloadConfigResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_35e509f6e3771557, []int{10}
+}
+func (m *GfSpReloadConfigResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpReloadConfigResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpReloadConfigResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpReloadConfigResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpReloadConfigResponse.Merge(m, src)
+}
+func (m *GfSpReloadConfigResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpReloadConfigResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpReloadConfigResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpReloadConfigResponse proto.InternalMessageInfo
+
+func (m *GfSpReloadConfigResponse) GetErr() *gfsperrors.GfSpError {
+	if m != nil {
+		return m.Err
+	}
+	return nil
+}
+
+func (m *GfSpReloadConfigResponse) GetApplied() []string {
+	if m != nil {
+		return m.Applied
+	}
+	return nil
+}
+
+func (m *GfSpReloadConfigResponse) GetRestartRequired() []string {
+	if m != nil {
+		return m.RestartRequired
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*GfSpQueryTasksRequest)(nil), "base.types.gfspserver.GfSpQueryTasksRequest")
 	proto.RegisterType((*GfSpQueryTasksResponse)(nil), "base.types.gfspserver.GfSpQueryTasksResponse")
@@ -554,6 +658,8 @@ func init() {
 	proto.RegisterType((*GfSpQuerySpExitRequest)(nil), "base.types.gfspserver.GfSpQuerySpExitRequest")
 	proto.RegisterType((*SwapOutUnit)(nil), "base.types.gfspserver.SwapOutUnit")
 	proto.RegisterType((*GfSpQuerySpExitResponse)(nil), "base.types.gfspserver.GfSpQuerySpExitResponse")
+	proto.RegisterType((*GfSpReloadConfigRequest)(nil), "base.types.gfspserver.GfSpReloadConfigRequest")
+	proto.RegisterType((*GfSpReloadConfigResponse)(nil), "base.types.gfspserver.GfSpReloadConfigResponse")
 }
 
 func init() {
@@ -561,55 +667,61 @@ func init() {
 }
 
 var fileDescriptor_35e509f6e3771557 = []byte{
-	// 767 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x55, 0x4d, 0x6f, 0xf3, 0x44,
-	0x10, 0x8e, 0x93, 0xb4, 0x24, 0x1b, 0xf2, 0xbe, 0x7a, 0x97, 0xbe, 0xad, 0x15, 0xc0, 0x35, 0x96,
-	0xa8, 0x72, 0x20, 0x8e, 0x14, 0xe8, 0x81, 0x1b, 0xaa, 0xda, 0x46, 0x51, 0x05, 0x15, 0x36, 0x70,
-	0xe0, 0x62, 0xfc, 0x31, 0x76, 0x97, 0x24, 0xb6, 0xbb, 0xbb, 0x4e, 0x49, 0xef, 0x88, 0x2b, 0x7f,
-	0x80, 0x1f, 0xc1, 0x6f, 0xe0, 0xc2, 0xb1, 0x47, 0x8e, 0xa8, 0xfd, 0x0d, 0x1c, 0xb8, 0xa1, 0x5d,
-	0x3b, 0x69, 0x9c, 0x7e, 0xa2, 0x9e, 0x92, 0x9d, 0x79, 0x76, 0xe6, 0x99, 0x67, 0x66, 0xc7, 0x68,
-	0xcf, 0x73, 0x19, 0xf4, 0xf9, 0x3c, 0x05, 0xd6, 0x8f, 0x42, 0x96, 0x32, 0xa0, 0x33, 0xa0, 0xfd,
-	0xf3, 0x0c, 0xe8, 0xdc, 0xe1, 0x2e, 0x1b, 0x9b, 0x29, 0x4d, 0x78, 0x82, 0xdf, 0x0a, 0x9c, 0x29,
-	0x71, 0xe6, 0x2d, 0xae, 0xf3, 0xd1, 0xda, 0x75, 0xa0, 0x34, 0xa1, 0xac, 0x2f, 0x7f, 0xf2, 0x9b,
-	0xc6, 0xe7, 0xe8, 0xed, 0x30, 0xb4, 0xd3, 0xaf, 0x45, 0xc4, 0x6f, 0x5c, 0x36, 0x66, 0x16, 0x9c,
-	0x67, 0xc0, 0x38, 0xd6, 0xd1, 0xbb, 0x22, 0x81, 0xc3, 0x32, 0xcf, 0x19, 0xc3, 0x5c, 0x55, 0x74,
-	0xa5, 0xdb, 0xb4, 0x90, 0xb0, 0xd9, 0x99, 0x77, 0x02, 0x73, 0x83, 0xa0, 0xed, 0xf5, 0xab, 0x2c,
-	0x4d, 0x62, 0x06, 0x78, 0x80, 0x6a, 0x40, 0xa9, 0xbc, 0xd2, 0x1a, 0xe8, 0xe6, 0x1a, 0xb9, 0x9c,
-	0x85, 0x29, 0xee, 0x1e, 0x89, 0xbf, 0x96, 0x00, 0xe3, 0xf7, 0x51, 0x53, 0xe6, 0x23, 0x71, 0x98,
-	0xa8, 0x55, 0xbd, 0xd6, 0x6d, 0x5a, 0x0d, 0x61, 0x18, 0xc5, 0x61, 0x62, 0xec, 0xa2, 0x0f, 0x97,
-	0xa9, 0x0e, 0x32, 0x7f, 0x0c, 0xfc, 0x4b, 0x12, 0x51, 0x97, 0x43, 0xc1, 0xd6, 0xf8, 0x47, 0x41,
-	0x6f, 0x04, 0xa2, 0xe4, 0xc4, 0xbb, 0xa8, 0xe5, 0x49, 0x83, 0x13, 0xbb, 0x53, 0x58, 0x94, 0x90,
-	0x9b, 0xbe, 0x72, 0xa7, 0x20, 0x92, 0x16, 0x00, 0x12, 0xa8, 0x55, 0x5d, 0xe9, 0xd6, 0xad, 0x46,
-	0x6e, 0x18, 0x05, 0xb8, 0x83, 0x1a, 0x21, 0x89, 0x09, 0x3b, 0x83, 0x40, 0xad, 0xe9, 0x4a, 0xb7,
-	0x6d, 0x2d, 0xcf, 0xf8, 0x0b, 0xd4, 0x88, 0x66, 0x91, 0x6c, 0x81, 0x5a, 0xd7, 0x6b, 0xdd, 0xd6,
-	0xe0, 0x63, 0xf3, 0xde, 0x1e, 0xc8, 0x32, 0x0b, 0x3e, 0xc3, 0xef, 0x86, 0xd6, 0x3b, 0xd1, 0x2c,
-	0x12, 0x62, 0xe1, 0x2d, 0xb4, 0xc1, 0xb8, 0xcb, 0x41, 0xdd, 0x90, 0xa1, 0xf3, 0x03, 0x36, 0xd1,
-	0x7b, 0xd3, 0x1c, 0x1c, 0x38, 0xde, 0x9c, 0x03, 0x73, 0x18, 0xb9, 0x04, 0x75, 0x53, 0x52, 0x7b,
-	0xb3, 0x70, 0x1d, 0x08, 0x8f, 0x4d, 0x2e, 0xc1, 0xf8, 0x4d, 0x41, 0xaf, 0xca, 0x19, 0xb0, 0x86,
-	0x5a, 0x01, 0x30, 0xee, 0x08, 0x7e, 0x24, 0x90, 0x45, 0xb7, 0xad, 0xa6, 0x30, 0x0d, 0x67, 0xd1,
-	0x28, 0xc0, 0x1f, 0x20, 0xc4, 0xa8, 0xbf, 0x70, 0x57, 0xf3, 0xc2, 0x18, 0xf5, 0x73, 0xef, 0x3e,
-	0xda, 0x99, 0xb8, 0x8c, 0x3b, 0x4b, 0x16, 0x89, 0xf7, 0x23, 0xf8, 0x52, 0x9f, 0x9a, 0x24, 0xb1,
-	0x25, 0xdc, 0x45, 0xba, 0xe0, 0x54, 0x3a, 0x47, 0x01, 0xde, 0x46, 0x9b, 0xa2, 0x80, 0x8c, 0xa9,
-	0x75, 0x5d, 0xe9, 0x6e, 0x58, 0xc5, 0xc9, 0xf8, 0x43, 0x41, 0xda, 0x43, 0x9d, 0x7b, 0xc1, 0xb0,
-	0x9c, 0xa2, 0x57, 0x45, 0xdf, 0x0a, 0x9e, 0x72, 0x62, 0x5a, 0x83, 0xee, 0x23, 0x4d, 0x28, 0x67,
-	0x6f, 0x7b, 0xa5, 0x49, 0x11, 0xa2, 0xc0, 0x24, 0x74, 0x58, 0xba, 0xa8, 0x54, 0x88, 0x02, 0x93,
-	0xd0, 0x4e, 0x47, 0x81, 0xa1, 0xae, 0x4c, 0xba, 0x9d, 0x1e, 0xfd, 0x44, 0xf8, 0x62, 0xee, 0x7e,
-	0x57, 0x50, 0xcb, 0xbe, 0x70, 0xd3, 0xd3, 0x8c, 0x7f, 0x1b, 0x13, 0xf9, 0x6a, 0xd8, 0x85, 0x9b,
-	0x3a, 0x49, 0xc6, 0x57, 0x5f, 0x0d, 0xcb, 0x21, 0x27, 0x30, 0x5f, 0x51, 0xaa, 0xba, 0xaa, 0x14,
-	0xde, 0x43, 0xaf, 0x59, 0xe6, 0xfb, 0xc0, 0x58, 0x42, 0x4b, 0x34, 0xda, 0x4b, 0xb3, 0xe0, 0xf2,
-	0xf2, 0xc9, 0x33, 0xfe, 0x55, 0xd0, 0xce, 0x9d, 0x72, 0x5e, 0xd0, 0x8c, 0xc3, 0x95, 0x9a, 0x19,
-	0xf5, 0x8b, 0x56, 0x18, 0x0f, 0xb0, 0x5a, 0x51, 0x6b, 0xa9, 0x8b, 0x4d, 0x7d, 0x7c, 0x8c, 0xda,
-	0xcb, 0x28, 0x62, 0x58, 0xd5, 0xda, 0xb3, 0xc3, 0xb4, 0x8a, 0x30, 0x87, 0x62, 0x6f, 0x95, 0x3b,
-	0x59, 0x2f, 0x77, 0x72, 0xf0, 0x73, 0x0d, 0x6d, 0x95, 0x96, 0x96, 0x0d, 0x74, 0x46, 0x7c, 0xc0,
-	0x49, 0xfe, 0x8e, 0x6e, 0x97, 0x19, 0xfe, 0xe4, 0x11, 0x59, 0xef, 0xac, 0xcb, 0x4e, 0xef, 0x99,
-	0xe8, 0x5c, 0x67, 0xa3, 0x82, 0x7f, 0x51, 0x56, 0x86, 0xaa, 0xbc, 0xb6, 0x3e, 0x7b, 0x2a, 0xd6,
-	0x7d, 0x2b, 0xb0, 0xb3, 0xff, 0x3f, 0x6f, 0x2d, 0x99, 0x50, 0xf4, 0x7a, 0x6d, 0x1c, 0xf0, 0x93,
-	0xd5, 0x94, 0x5e, 0x41, 0xc7, 0x7c, 0x2e, 0x7c, 0x91, 0xf3, 0xe0, 0x87, 0x3f, 0xaf, 0x35, 0xe5,
-	0xea, 0x5a, 0x53, 0xfe, 0xbe, 0xd6, 0x94, 0x5f, 0x6f, 0xb4, 0xca, 0xd5, 0x8d, 0x56, 0xf9, 0xeb,
-	0x46, 0xab, 0x7c, 0x7f, 0x1c, 0x11, 0x7e, 0x96, 0x79, 0xa6, 0x9f, 0x4c, 0xfb, 0x5e, 0xec, 0xf5,
-	0xfc, 0x33, 0x97, 0xc4, 0xfd, 0x88, 0x02, 0xc4, 0x21, 0x81, 0x49, 0xd0, 0x63, 0x3c, 0xa1, 0x6e,
-	0x04, 0xbd, 0x94, 0x26, 0x33, 0x12, 0x00, 0xed, 0xdf, 0xfb, 0x8d, 0xf4, 0x36, 0xe5, 0xf7, 0xed,
-	0xd3, 0xff, 0x02, 0x00, 0x00, 0xff, 0xff, 0x9e, 0x29, 0x2b, 0xef, 0x43, 0x07, 0x00, 0x00,
+	// 858 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xa4, 0x56, 0x4f, 0x6f, 0xe3, 0x54,
+	0x10, 0xaf, 0x9b, 0xfe, 0x49, 0x27, 0xa4, 0xdd, 0x7d, 0x74, 0xb7, 0x56, 0x80, 0x6c, 0xb0, 0xc4,
+	0x2a, 0x48, 0xd4, 0x91, 0x02, 0x7b, 0xe0, 0x86, 0xca, 0xee, 0x56, 0xd1, 0x0a, 0x2a, 0x1c, 0xe0,
+	0xc0, 0xc5, 0xd8, 0x7e, 0x63, 0xf7, 0xd1, 0xd4, 0x76, 0xdf, 0x7b, 0xce, 0x92, 0xfd, 0x02, 0x5c,
+	0x91, 0x38, 0xf3, 0x21, 0xf8, 0x0c, 0x5c, 0x38, 0xee, 0x91, 0x23, 0x6a, 0x3f, 0x03, 0x07, 0x24,
+	0x0e, 0xe8, 0x3d, 0xff, 0xc1, 0x4e, 0xbb, 0xdd, 0xae, 0x7a, 0x4a, 0x66, 0xe6, 0x37, 0x33, 0xbf,
+	0xf9, 0xf3, 0x46, 0x86, 0x87, 0xbe, 0x27, 0x70, 0x24, 0x17, 0x29, 0x8a, 0x51, 0x14, 0x8a, 0x54,
+	0x20, 0x9f, 0x23, 0x1f, 0x9d, 0x65, 0xc8, 0x17, 0xae, 0xf4, 0xc4, 0x89, 0x9d, 0xf2, 0x44, 0x26,
+	0xe4, 0x9e, 0xc2, 0xd9, 0x1a, 0x67, 0xff, 0x8f, 0xeb, 0xbd, 0xbf, 0xe4, 0x8e, 0x9c, 0x27, 0x5c,
+	0x8c, 0xf4, 0x4f, 0xee, 0x69, 0x7d, 0x0a, 0xf7, 0x0e, 0xc3, 0x69, 0xfa, 0x95, 0x8a, 0xf8, 0xb5,
+	0x27, 0x4e, 0x84, 0x83, 0x67, 0x19, 0x0a, 0x49, 0x06, 0xf0, 0x96, 0x4a, 0xe0, 0x8a, 0xcc, 0x77,
+	0x4f, 0x70, 0x61, 0x1a, 0x03, 0x63, 0xb8, 0xe5, 0x80, 0xd2, 0x4d, 0x33, 0xff, 0x19, 0x2e, 0x2c,
+	0x06, 0xf7, 0x97, 0x5d, 0x45, 0x9a, 0xc4, 0x02, 0xc9, 0x18, 0x5a, 0xc8, 0xb9, 0x76, 0xe9, 0x8c,
+	0x07, 0xf6, 0x12, 0xb9, 0x9c, 0x85, 0xad, 0x7c, 0x9f, 0xa8, 0xbf, 0x8e, 0x02, 0x93, 0x77, 0x60,
+	0x4b, 0xe7, 0x63, 0x71, 0x98, 0x98, 0xab, 0x83, 0xd6, 0x70, 0xcb, 0x69, 0x2b, 0xc5, 0x24, 0x0e,
+	0x13, 0xeb, 0x01, 0xbc, 0x57, 0xa5, 0x3a, 0xc8, 0x82, 0x13, 0x94, 0x5f, 0xb0, 0x88, 0x7b, 0x12,
+	0x0b, 0xb6, 0xd6, 0xdf, 0x06, 0xdc, 0x55, 0x88, 0x86, 0x91, 0x3c, 0x80, 0x8e, 0xaf, 0x15, 0x6e,
+	0xec, 0x9d, 0x62, 0x59, 0x42, 0xae, 0xfa, 0xd2, 0x3b, 0x45, 0x95, 0xb4, 0x00, 0x30, 0x6a, 0xae,
+	0x0e, 0x8c, 0xe1, 0x9a, 0xd3, 0xce, 0x15, 0x13, 0x4a, 0x7a, 0xd0, 0x0e, 0x59, 0xcc, 0xc4, 0x31,
+	0x52, 0xb3, 0x35, 0x30, 0x86, 0x5d, 0xa7, 0x92, 0xc9, 0x67, 0xd0, 0x8e, 0xe6, 0x91, 0x1e, 0x81,
+	0xb9, 0x36, 0x68, 0x0d, 0x3b, 0xe3, 0x0f, 0xec, 0x2b, 0x67, 0xa0, 0xcb, 0x2c, 0xf8, 0x1c, 0x7e,
+	0x7b, 0xe8, 0x6c, 0x46, 0xf3, 0x48, 0x35, 0x8b, 0xec, 0xc2, 0xba, 0x90, 0x9e, 0x44, 0x73, 0x5d,
+	0x87, 0xce, 0x05, 0x62, 0xc3, 0xdb, 0xa7, 0x39, 0x98, 0xba, 0xfe, 0x42, 0xa2, 0x70, 0x05, 0x7b,
+	0x81, 0xe6, 0x86, 0xa6, 0x76, 0xb7, 0x34, 0x1d, 0x28, 0xcb, 0x94, 0xbd, 0x40, 0xeb, 0x57, 0x03,
+	0xb6, 0x9b, 0x19, 0x48, 0x1f, 0x3a, 0x14, 0x85, 0x74, 0x15, 0x3f, 0x46, 0x75, 0xd1, 0x5d, 0x67,
+	0x4b, 0xa9, 0x0e, 0xe7, 0xd1, 0x84, 0x92, 0x77, 0x01, 0x04, 0x0f, 0x4a, 0xf3, 0x6a, 0x5e, 0x98,
+	0xe0, 0x41, 0x6e, 0x7d, 0x04, 0x7b, 0x33, 0x4f, 0x48, 0xb7, 0x62, 0x91, 0xf8, 0x3f, 0x60, 0xa0,
+	0xfb, 0xd3, 0xd2, 0x24, 0x76, 0x95, 0xb9, 0x48, 0x47, 0x8f, 0xb4, 0x71, 0x42, 0xc9, 0x7d, 0xd8,
+	0x50, 0x05, 0x64, 0xc2, 0x5c, 0x1b, 0x18, 0xc3, 0x75, 0xa7, 0x90, 0xac, 0xdf, 0x0d, 0xe8, 0xbf,
+	0x6a, 0x72, 0xb7, 0x58, 0x96, 0x23, 0xd8, 0x2e, 0xe6, 0x56, 0xf0, 0xd4, 0x1b, 0xd3, 0x19, 0x0f,
+	0xaf, 0x19, 0x42, 0x33, 0x7b, 0xd7, 0xaf, 0x8b, 0xba, 0x29, 0x38, 0x0b, 0x5d, 0x91, 0x96, 0x95,
+	0xaa, 0xa6, 0xe0, 0x2c, 0x9c, 0xa6, 0x13, 0x6a, 0x99, 0xb5, 0x4d, 0x9f, 0xa6, 0x4f, 0x7e, 0x64,
+	0xb2, 0xdc, 0xbb, 0xdf, 0x0c, 0xe8, 0x4c, 0x9f, 0x7b, 0xe9, 0x51, 0x26, 0xbf, 0x89, 0x99, 0x7e,
+	0x35, 0xe2, 0xb9, 0x97, 0xba, 0x49, 0x26, 0xeb, 0xaf, 0x46, 0xe4, 0x90, 0x67, 0xb8, 0xa8, 0x75,
+	0x6a, 0xb5, 0xde, 0x29, 0xf2, 0x10, 0x76, 0x44, 0x16, 0x04, 0x28, 0x44, 0xc2, 0x1b, 0x34, 0xba,
+	0x95, 0x5a, 0x71, 0xb9, 0xfd, 0xe6, 0x59, 0xff, 0x18, 0xb0, 0x77, 0xa9, 0x9c, 0x5b, 0x0c, 0xe3,
+	0x71, 0xad, 0x66, 0xc1, 0x83, 0x62, 0x14, 0xd6, 0x2b, 0x58, 0xd5, 0xba, 0x55, 0xf5, 0x65, 0xca,
+	0x03, 0xf2, 0x14, 0xba, 0x55, 0x14, 0xb5, 0xac, 0x66, 0xeb, 0xc6, 0x61, 0x3a, 0x45, 0x98, 0xc7,
+	0xea, 0x6e, 0x35, 0x27, 0xb9, 0xb6, 0x34, 0xc9, 0x71, 0x5e, 0xba, 0x83, 0xb3, 0xc4, 0xa3, 0x9f,
+	0x27, 0x71, 0xc8, 0xa2, 0xf2, 0xe0, 0xed, 0xc1, 0x26, 0xe5, 0x0b, 0x97, 0x67, 0xb1, 0x2e, 0xbf,
+	0xed, 0x6c, 0x50, 0xbe, 0x70, 0xb2, 0xd8, 0xfa, 0xc5, 0x00, 0xf3, 0xb2, 0xd3, 0x2d, 0x1a, 0x66,
+	0xc2, 0xa6, 0x97, 0xa6, 0x33, 0x86, 0xb4, 0x38, 0x74, 0xa5, 0x48, 0x3e, 0x84, 0x3b, 0x1c, 0x85,
+	0xf4, 0xb8, 0x74, 0x39, 0x9e, 0x65, 0x8c, 0xeb, 0xd3, 0xa3, 0x20, 0x3b, 0x85, 0xde, 0x29, 0xd4,
+	0xe3, 0x7f, 0x5b, 0xb0, 0xdb, 0x38, 0xbf, 0x53, 0xe4, 0x73, 0x16, 0x20, 0x49, 0x60, 0xbb, 0xa1,
+	0x17, 0xe4, 0xa3, 0x6b, 0x16, 0xe4, 0xd2, 0xe1, 0xef, 0xed, 0xdf, 0x10, 0x9d, 0x37, 0xc0, 0x5a,
+	0x21, 0x3f, 0x19, 0xb5, 0xe7, 0xd1, 0x3c, 0xc0, 0x9f, 0xbc, 0x2e, 0xd6, 0x55, 0xc7, 0xbc, 0xf7,
+	0xe8, 0x0d, 0xbd, 0x2a, 0x26, 0x1c, 0x76, 0x96, 0x16, 0x9b, 0xbc, 0xb6, 0x9a, 0xc6, 0x7b, 0xee,
+	0xd9, 0x37, 0x85, 0x57, 0x39, 0x33, 0xb8, 0xb3, 0xbc, 0x1c, 0xe4, 0xba, 0x28, 0x57, 0xac, 0x5e,
+	0x6f, 0x74, 0x63, 0x7c, 0x99, 0xf6, 0xe0, 0xfb, 0x3f, 0xce, 0xfb, 0xc6, 0xcb, 0xf3, 0xbe, 0xf1,
+	0xd7, 0x79, 0xdf, 0xf8, 0xf9, 0xa2, 0xbf, 0xf2, 0xf2, 0xa2, 0xbf, 0xf2, 0xe7, 0x45, 0x7f, 0xe5,
+	0xbb, 0xa7, 0x11, 0x93, 0xc7, 0x99, 0x6f, 0x07, 0xc9, 0xe9, 0xc8, 0x8f, 0xfd, 0xfd, 0xe0, 0xd8,
+	0x63, 0xf1, 0x28, 0xe2, 0x88, 0x71, 0xc8, 0x70, 0x46, 0xf7, 0x85, 0x4c, 0xb8, 0x17, 0xe1, 0x7e,
+	0xca, 0x93, 0x39, 0xa3, 0xc8, 0x47, 0x57, 0x7e, 0x64, 0xf8, 0x1b, 0xfa, 0x03, 0xe1, 0xe3, 0xff,
+	0x06, 0x00, 0x8f, 0x6b, 0xa9, 0xf0, 0x84, 0x08, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -627,6 +739,7 @@ type GfSpQueryTaskServiceClient interface {
 	GfSpQueryTasks(ctx context.Context, in *GfSpQueryTasksRequest, opts ...grpc.CallOption) (*GfSpQueryTasksResponse, error)
 	GfSpQueryBucketMigrate(ctx context.Context, in *GfSpQueryBucketMigrateRequest, opts ...grpc.CallOption) (*GfSpQueryBucketMigrateResponse, error)
 	GfSpQuerySpExit(ctx context.Context, in *GfSpQuerySpExitRequest, opts ...grpc.CallOption) (*GfSpQuerySpExitResponse, error)
+	GfSpReloadConfig(ctx context.Context, in *GfSpReloadConfigRequest, opts ...grpc.CallOption) (*GfSpReloadConfigResponse, error)
 }
 
 type gfSpQueryTaskServiceClient struct {
@@ -664,11 +777,21 @@ func (c *gfSpQueryTaskServiceClient) GfSpQuerySpExit(ctx context.Context, in *Gf
 	return out, nil
 }
 
+func (c *gfSpQueryTaskServiceClient) GfSpReloadConfig(ctx context.Context, in *GfSpReloadConfigRequest, opts ...grpc.CallOption) (*GfSpReloadConfigResponse, error) {
+	out := new(GfSpReloadConfigResponse)
+	err := c.cc.Invoke(ctx, "/base.types.gfspserver.GfSpQueryTaskService/GfSpReloadConfig", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // GfSpQueryTaskServiceServer is the server API for GfSpQueryTaskService service.
 type GfSpQueryTaskServiceServer interface {
 	GfSpQueryTasks(context.Context, *GfSpQueryTasksRequest) (*GfSpQueryTasksResponse, error)
 	GfSpQueryBucketMigrate(context.Context, *GfSpQueryBucketMigrateRequest) (*GfSpQueryBucketMigrateResponse, error)
 	GfSpQuerySpExit(context.Context, *GfSpQuerySpExitRequest) (*GfSpQuerySpExitResponse, error)
+	GfSpReloadConfig(context.Context, *GfSpReloadConfigRequest) (*GfSpReloadConfigResponse, error)
 }
 
 // UnimplementedGfSpQueryTaskServiceServer can be embedded to have forward compatible implementations.
@@ -684,6 +807,9 @@ func (*UnimplementedGfSpQueryTaskServiceServer) GfSpQueryBucketMigrate(ctx conte
 func (*UnimplementedGfSpQueryTaskServiceServer) GfSpQuerySpExit(ctx context.Context, req *GfSpQuerySpExitRequest) (*GfSpQuerySpExitResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method GfSpQuerySpExit not implemented")
 }
+func (*UnimplementedGfSpQueryTaskServiceServer) GfSpReloadConfig(ctx context.Context, req *GfSpReloadConfigRequest) (*GfSpReloadConfigResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GfSpReloadConfig not implemented")
+}
 
 func RegisterGfSpQueryTaskServiceServer(s grpc1.Server, srv GfSpQueryTaskServiceServer) {
 	s.RegisterService(&_GfSpQueryTaskService_serviceDesc, srv)
@@ -743,6 +869,24 @@ func _GfSpQueryTaskService_GfSpQuerySpExit_Handler(srv interface{}, ctx context.
 	return interceptor(ctx, in, info, handler)
 }
 
+func _GfSpQueryTaskService_GfSpReloadConfig_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GfSpReloadConfigRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(GfSpQueryTaskServiceServer).GfSpReloadConfig(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/base.types.gfspserver.GfSpQueryTaskService/GfSpReloadConfig",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(GfSpQueryTaskServiceServer).GfSpReloadConfig(ctx, req.(*GfSpReloadConfigRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _GfSpQueryTaskService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "base.types.gfspserver.GfSpQueryTaskService",
 	HandlerType: (*GfSpQueryTaskServiceServer)(nil),
@@ -759,6 +903,10 @@ var _GfSpQueryTaskService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "GfSpQuerySpExit",
 			Handler:    _GfSpQueryTaskService_GfSpQuerySpExit_Handler,
 		},
+		{
+			MethodName: "GfSpReloadConfig",
+			Handler:    _GfSpQueryTaskService_GfSpReloadConfig_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "base/types/gfspserver/query_task.proto",
@@ -1167,6 +1315,92 @@ func (m *GfSpQuerySpExitResponse) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	return len(dAtA) - i, nil
 }
 
+func (m *GfSpReloadConfigRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpReloadConfigRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpReloadConfigRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.DryRun {
+		i--
+		if m.DryRun {
+			dAtA[i] = 1
+		} else {
+			dAtA[i] = 0
+		}
+		i--
+		dAtA[i] = 0x8
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *GfSpReloadConfigResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpReloadConfigResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpReloadConfigResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.RestartRequired) > 0 {
+		for iNdEx := len(m.RestartRequired) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.RestartRequired[iNdEx])
+			copy(dAtA[i:], m.RestartRequired[iNdEx])
+			i = encodeVarintQueryTask(dAtA, i, uint64(len(m.RestartRequired[iNdEx])))
+			i--
+			dAtA[i] = 0x1a
+		}
+	}
+	if len(m.Applied) > 0 {
+		for iNdEx := len(m.Applied) - 1; iNdEx >= 0; iNdEx-- {
+			i -= len(m.Applied[iNdEx])
+			copy(dAtA[i:], m.Applied[iNdEx])
+			i = encodeVarintQueryTask(dAtA, i, uint64(len(m.Applied[iNdEx])))
+			i--
+			dAtA[i] = 0x12
+		}
+	}
+	if m.Err != nil {
+		{
+			size, err := m.Err.MarshalToSizedBuffer(dAtA[:i])
+			if err != nil {
+				return 0, err
+			}
+			i -= size
+			i = encodeVarintQueryTask(dAtA, i, uint64(size))
+		}
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func encodeVarintQueryTask(dAtA []byte, offset int, v uint64) int {
 	offset -= sovQueryTask(v)
 	base := offset
@@ -1355,6 +1589,43 @@ func (m *GfSpQuerySpExitResponse) Size() (n int) {
 	return n
 }
 
+func (m *GfSpReloadConfigRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.DryRun {
+		n += 2
+	}
+	return n
+}
+
+func (m *GfSpReloadConfigResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	if m.Err != nil {
+		l = m.Err.Size()
+		n += 1 + l + sovQueryTask(uint64(l))
+	}
+	if len(m.Applied) > 0 {
+		for _, s := range m.Applied {
+			l = len(s)
+			n += 1 + l + sovQueryTask(uint64(l))
+		}
+	}
+	if len(m.RestartRequired) > 0 {
+		for _, s := range m.RestartRequired {
+			l = len(s)
+			n += 1 + l + sovQueryTask(uint64(l))
+		}
+	}
+	return n
+}
+
 func sovQueryTask(x uint64) (n int) {
 	return (math_bits.Len64(x|1) + 6) / 7
 }
@@ -2445,6 +2716,227 @@ func (m *GfSpQuerySpExitResponse) Unmarshal(dAtA []byte) error {
 	}
 	return nil
 }
+func (m *GfSpReloadConfigRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpReloadConfigRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpReloadConfigRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field DryRun", wireType)
+			}
+			var v int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				v |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			m.DryRun = bool(v != 0)
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+
+func (m *GfSpReloadConfigResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowQueryTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpReloadConfigResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpReloadConfigResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Err", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			if m.Err == nil {
+				m.Err = &gfsperrors.GfSpError{}
+			}
+			if err := m.Err.Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Applied", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Applied = append(m.Applied, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		case 3:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field RestartRequired", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowQueryTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.RestartRequired = append(m.RestartRequired, string(dAtA[iNdEx:postIndex]))
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipQueryTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthQueryTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
 func skipQueryTask(dAtA []byte) (n int, err error) {
 	l := len(dAtA)
 	iNdEx := 0
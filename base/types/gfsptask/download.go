@@ -123,7 +123,13 @@ func (m *GfSpDownloadObjectTask) SetPriority(priority coretask.TPriority) {
 }
 
 func (m *GfSpDownloadObjectTask) EstimateLimit() corercmgr.Limit {
-	l := &gfsplimit.GfSpLimit{Memory: m.GetSize()}
+	// the data is streamed segment by segment, only the segment being sent is estimated, the segments
+	// fetched ahead are reserved by the downloader on demand
+	memory := m.GetSize()
+	if segmentSize := int64(m.GetStorageParams().GetMaxSegmentSize()); segmentSize > 0 && segmentSize < memory {
+		memory = segmentSize
+	}
+	l := &gfsplimit.GfSpLimit{Memory: memory}
 	l.Add(LimitEstimateByPriority(m.GetPriority()))
 	return l
 }
@@ -198,6 +198,14 @@ func (m *GfSpRecoverPieceTask) GetGVGID() uint32 {
 	return m.GvgId
 }
 
+func (m *GfSpRecoverPieceTask) GetSPOutcomes() []*coretask.SPOutcome {
+	return toSPOutcomes(m.GetSpOutcomes())
+}
+
+func (m *GfSpRecoverPieceTask) SetSPOutcomes(outcomes []*coretask.SPOutcome) {
+	m.SpOutcomes = fromSPOutcomes(outcomes)
+}
+
 func (m *GfSpRecoverPieceTask) GetSignBytes() []byte {
 	fakeMsg := &GfSpRecoverPieceTask{
 		ObjectInfo:    m.GetObjectInfo(),
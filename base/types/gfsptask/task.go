@@ -67,7 +67,7 @@ func (m *GfSpTask) Expired() bool {
 }
 
 func (m *GfSpTask) GetPriority() coretask.TPriority {
-	return coretask.TPriority(m.TaskPriority)
+	return coretask.TPriority(m.GetTaskPriority())
 }
 
 func (m *GfSpTask) SetPriority(priority coretask.TPriority) {
@@ -117,6 +117,44 @@ func LimitEstimateByPriority(priority coretask.TPriority) rcmgr.Limit {
 	return &gfsplimit.GfSpLimit{Tasks: 1, TasksMediumPriority: 1}
 }
 
+// fromSPOutcomes converts the SP outcomes to the proto messages that are reported along with the task.
+func fromSPOutcomes(outcomes []*coretask.SPOutcome) []*GfSpSPOutcome {
+	if len(outcomes) == 0 {
+		return nil
+	}
+	spOutcomes := make([]*GfSpSPOutcome, 0, len(outcomes))
+	for _, outcome := range outcomes {
+		spOutcomes = append(spOutcomes, &GfSpSPOutcome{
+			Endpoint:    outcome.Endpoint,
+			Type:        int32(outcome.Type),
+			Requests:    outcome.Requests,
+			Failures:    outcome.Failures,
+			PayloadSize: outcome.Size,
+			CostMs:      outcome.Cost.Milliseconds(),
+		})
+	}
+	return spOutcomes
+}
+
+// toSPOutcomes converts the proto messages reported along with the task to the SP outcomes.
+func toSPOutcomes(spOutcomes []*GfSpSPOutcome) []*coretask.SPOutcome {
+	if len(spOutcomes) == 0 {
+		return nil
+	}
+	outcomes := make([]*coretask.SPOutcome, 0, len(spOutcomes))
+	for _, spOutcome := range spOutcomes {
+		outcomes = append(outcomes, &coretask.SPOutcome{
+			Endpoint: spOutcome.GetEndpoint(),
+			Type:     coretask.SPOutcomeType(spOutcome.GetType()),
+			Requests: spOutcome.GetRequests(),
+			Failures: spOutcome.GetFailures(),
+			Size:     spOutcome.GetPayloadSize(),
+			Cost:     time.Duration(spOutcome.GetCostMs()) * time.Millisecond,
+		})
+	}
+	return outcomes
+}
+
 func RegisterCodec(cdc *codec.LegacyAmino) {
 	cdc.RegisterConcrete(&GfSpReplicatePieceApprovalTask{}, "p2p/ReplicatePieceApprovalTask", nil)
 	cdc.RegisterConcrete(&GfSpReceivePieceTask{}, "secondary/ReceivePieceTask", nil)
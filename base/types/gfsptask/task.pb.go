@@ -677,6 +677,7 @@ type GfSpReplicatePieceTask struct {
 	SecondaryEndpoints   []string          `protobuf:"bytes,8,rep,name=secondary_endpoints,json=secondaryEndpoints,proto3" json:"secondary_endpoints,omitempty"`
 	NotAvailableSpIdx    int32             `protobuf:"varint,9,opt,name=not_available_sp_idx,json=notAvailableSpIdx,proto3" json:"not_available_sp_idx,omitempty"`
 	IsAgentUploadTask    bool              `protobuf:"varint,10,opt,name=is_agent_upload_task,json=isAgentUploadTask,proto3" json:"is_agent_upload_task,omitempty"`
+	SpOutcomes           []*GfSpSPOutcome  `protobuf:"bytes,11,rep,name=sp_outcomes,json=spOutcomes,proto3" json:"sp_outcomes,omitempty"`
 }
 
 func (m *GfSpReplicatePieceTask) Reset()         { *m = GfSpReplicatePieceTask{} }
@@ -782,6 +783,13 @@ func (m *GfSpReplicatePieceTask) GetIsAgentUploadTask() bool {
 	return false
 }
 
+func (m *GfSpReplicatePieceTask) GetSpOutcomes() []*GfSpSPOutcome {
+	if m != nil {
+		return m.SpOutcomes
+	}
+	return nil
+}
+
 type GfSpRecoverPieceTask struct {
 	Task          *GfSpTask         `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	ObjectInfo    *types.ObjectInfo `protobuf:"bytes,2,opt,name=object_info,json=objectInfo,proto3" json:"object_info,omitempty"`
@@ -793,6 +801,7 @@ type GfSpRecoverPieceTask struct {
 	Recovered     bool              `protobuf:"varint,8,opt,name=recovered,proto3" json:"recovered,omitempty"`
 	BySuccessorSp bool              `protobuf:"varint,9,opt,name=by_successor_sp,json=bySuccessorSp,proto3" json:"by_successor_sp,omitempty"`
 	GvgId         uint32            `protobuf:"varint,10,opt,name=gvg_id,json=gvgId,proto3" json:"gvg_id,omitempty"`
+	SpOutcomes    []*GfSpSPOutcome  `protobuf:"bytes,11,rep,name=sp_outcomes,json=spOutcomes,proto3" json:"sp_outcomes,omitempty"`
 }
 
 func (m *GfSpRecoverPieceTask) Reset()         { *m = GfSpRecoverPieceTask{} }
@@ -898,6 +907,99 @@ func (m *GfSpRecoverPieceTask) GetGvgId() uint32 {
 	return 0
 }
 
+func (m *GfSpRecoverPieceTask) GetSpOutcomes() []*GfSpSPOutcome {
+	if m != nil {
+		return m.SpOutcomes
+	}
+	return nil
+}
+
+// GfSpSPOutcome records the outcome of the requests of one type to another SP while executing
+// a task, it is used to score the SP reputation.
+type GfSpSPOutcome struct {
+	Endpoint    string `protobuf:"bytes,1,opt,name=endpoint,proto3" json:"endpoint,omitempty"`
+	Type        int32  `protobuf:"varint,2,opt,name=type,proto3" json:"type,omitempty"`
+	Requests    uint32 `protobuf:"varint,3,opt,name=requests,proto3" json:"requests,omitempty"`
+	Failures    uint32 `protobuf:"varint,4,opt,name=failures,proto3" json:"failures,omitempty"`
+	PayloadSize uint64 `protobuf:"varint,5,opt,name=payload_size,json=payloadSize,proto3" json:"payload_size,omitempty"`
+	CostMs      int64  `protobuf:"varint,6,opt,name=cost_ms,json=costMs,proto3" json:"cost_ms,omitempty"`
+}
+
+func (m *GfSpSPOutcome) Reset()         { *m = GfSpSPOutcome{} }
+func (m *GfSpSPOutcome) String() string { return proto.CompactTextString(m) }
+func (*GfSpSPOutcome) ProtoMessage()    {}
+func (*GfSpSPOutcome) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0d22df708e229306, []int{10}
+}
+func (m *GfSpSPOutcome) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *GfSpSPOutcome) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_GfSpSPOutcome.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *GfSpSPOutcome) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GfSpSPOutcome.Merge(m, src)
+}
+func (m *GfSpSPOutcome) XXX_Size() int {
+	return m.Size()
+}
+func (m *GfSpSPOutcome) XXX_DiscardUnknown() {
+	xxx_messageInfo_GfSpSPOutcome.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GfSpSPOutcome proto.InternalMessageInfo
+
+func (m *GfSpSPOutcome) GetEndpoint() string {
+	if m != nil {
+		return m.Endpoint
+	}
+	return ""
+}
+
+func (m *GfSpSPOutcome) GetType() int32 {
+	if m != nil {
+		return m.Type
+	}
+	return 0
+}
+
+func (m *GfSpSPOutcome) GetRequests() uint32 {
+	if m != nil {
+		return m.Requests
+	}
+	return 0
+}
+
+func (m *GfSpSPOutcome) GetFailures() uint32 {
+	if m != nil {
+		return m.Failures
+	}
+	return 0
+}
+
+func (m *GfSpSPOutcome) GetPayloadSize() uint64 {
+	if m != nil {
+		return m.PayloadSize
+	}
+	return 0
+}
+
+func (m *GfSpSPOutcome) GetCostMs() int64 {
+	if m != nil {
+		return m.CostMs
+	}
+	return 0
+}
+
 type GfSpReceivePieceTask struct {
 	Task                 *GfSpTask         `protobuf:"bytes,1,opt,name=task,proto3" json:"task,omitempty"`
 	ObjectInfo           *types.ObjectInfo `protobuf:"bytes,2,opt,name=object_info,json=objectInfo,proto3" json:"object_info,omitempty"`
@@ -918,7 +1020,7 @@ func (m *GfSpReceivePieceTask) Reset()         { *m = GfSpReceivePieceTask{} }
 func (m *GfSpReceivePieceTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpReceivePieceTask) ProtoMessage()    {}
 func (*GfSpReceivePieceTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{10}
+	return fileDescriptor_0d22df708e229306, []int{11}
 }
 func (m *GfSpReceivePieceTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1053,7 +1155,7 @@ func (m *GfSpSealObjectTask) Reset()         { *m = GfSpSealObjectTask{} }
 func (m *GfSpSealObjectTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpSealObjectTask) ProtoMessage()    {}
 func (*GfSpSealObjectTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{11}
+	return fileDescriptor_0d22df708e229306, []int{12}
 }
 func (m *GfSpSealObjectTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1151,7 +1253,7 @@ func (m *GfSpDownloadObjectTask) Reset()         { *m = GfSpDownloadObjectTask{}
 func (m *GfSpDownloadObjectTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpDownloadObjectTask) ProtoMessage()    {}
 func (*GfSpDownloadObjectTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{12}
+	return fileDescriptor_0d22df708e229306, []int{13}
 }
 func (m *GfSpDownloadObjectTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1238,7 +1340,7 @@ func (m *GfSpDownloadPieceTask) Reset()         { *m = GfSpDownloadPieceTask{} }
 func (m *GfSpDownloadPieceTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpDownloadPieceTask) ProtoMessage()    {}
 func (*GfSpDownloadPieceTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{13}
+	return fileDescriptor_0d22df708e229306, []int{14}
 }
 func (m *GfSpDownloadPieceTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1346,7 +1448,7 @@ func (m *GfSpChallengePieceTask) Reset()         { *m = GfSpChallengePieceTask{}
 func (m *GfSpChallengePieceTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpChallengePieceTask) ProtoMessage()    {}
 func (*GfSpChallengePieceTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{14}
+	return fileDescriptor_0d22df708e229306, []int{15}
 }
 func (m *GfSpChallengePieceTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1451,7 +1553,7 @@ func (m *GfSpGCObjectTask) Reset()         { *m = GfSpGCObjectTask{} }
 func (m *GfSpGCObjectTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpGCObjectTask) ProtoMessage()    {}
 func (*GfSpGCObjectTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{15}
+	return fileDescriptor_0d22df708e229306, []int{16}
 }
 func (m *GfSpGCObjectTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1534,7 +1636,7 @@ func (m *GfSpGCZombiePieceTask) Reset()         { *m = GfSpGCZombiePieceTask{} }
 func (m *GfSpGCZombiePieceTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpGCZombiePieceTask) ProtoMessage()    {}
 func (*GfSpGCZombiePieceTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{16}
+	return fileDescriptor_0d22df708e229306, []int{17}
 }
 func (m *GfSpGCZombiePieceTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1612,7 +1714,7 @@ func (m *GfSpGCStaleVersionObjectTask) Reset()         { *m = GfSpGCStaleVersion
 func (m *GfSpGCStaleVersionObjectTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpGCStaleVersionObjectTask) ProtoMessage()    {}
 func (*GfSpGCStaleVersionObjectTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{17}
+	return fileDescriptor_0d22df708e229306, []int{18}
 }
 func (m *GfSpGCStaleVersionObjectTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1700,7 +1802,7 @@ func (m *GfSpGCMetaTask) Reset()         { *m = GfSpGCMetaTask{} }
 func (m *GfSpGCMetaTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpGCMetaTask) ProtoMessage()    {}
 func (*GfSpGCMetaTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{18}
+	return fileDescriptor_0d22df708e229306, []int{19}
 }
 func (m *GfSpGCMetaTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1768,7 +1870,7 @@ func (m *GfSpMigrateGVGTask) Reset()         { *m = GfSpMigrateGVGTask{} }
 func (m *GfSpMigrateGVGTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpMigrateGVGTask) ProtoMessage()    {}
 func (*GfSpMigrateGVGTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{19}
+	return fileDescriptor_0d22df708e229306, []int{20}
 }
 func (m *GfSpMigrateGVGTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1888,7 +1990,7 @@ func (m *GfSpMigratePieceTask) Reset()         { *m = GfSpMigratePieceTask{} }
 func (m *GfSpMigratePieceTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpMigratePieceTask) ProtoMessage()    {}
 func (*GfSpMigratePieceTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{20}
+	return fileDescriptor_0d22df708e229306, []int{21}
 }
 func (m *GfSpMigratePieceTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -1981,7 +2083,7 @@ func (m *GfSpGCBucketMigrationTask) Reset()         { *m = GfSpGCBucketMigration
 func (m *GfSpGCBucketMigrationTask) String() string { return proto.CompactTextString(m) }
 func (*GfSpGCBucketMigrationTask) ProtoMessage()    {}
 func (*GfSpGCBucketMigrationTask) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{21}
+	return fileDescriptor_0d22df708e229306, []int{22}
 }
 func (m *GfSpGCBucketMigrationTask) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2075,7 +2177,7 @@ func (m *GfSpBucketMigrationInfo) Reset()         { *m = GfSpBucketMigrationInfo
 func (m *GfSpBucketMigrationInfo) String() string { return proto.CompactTextString(m) }
 func (*GfSpBucketMigrationInfo) ProtoMessage()    {}
 func (*GfSpBucketMigrationInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{22}
+	return fileDescriptor_0d22df708e229306, []int{23}
 }
 func (m *GfSpBucketMigrationInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2177,7 +2279,7 @@ func (m *GfSpBucketQuotaInfo) Reset()         { *m = GfSpBucketQuotaInfo{} }
 func (m *GfSpBucketQuotaInfo) String() string { return proto.CompactTextString(m) }
 func (*GfSpBucketQuotaInfo) ProtoMessage()    {}
 func (*GfSpBucketQuotaInfo) Descriptor() ([]byte, []int) {
-	return fileDescriptor_0d22df708e229306, []int{23}
+	return fileDescriptor_0d22df708e229306, []int{24}
 }
 func (m *GfSpBucketQuotaInfo) XXX_Unmarshal(b []byte) error {
 	return m.Unmarshal(b)
@@ -2287,6 +2389,7 @@ func init() {
 	proto.RegisterType((*GfSpResumableUploadObjectTask)(nil), "base.types.gfsptask.GfSpResumableUploadObjectTask")
 	proto.RegisterType((*GfSpReplicatePieceTask)(nil), "base.types.gfsptask.GfSpReplicatePieceTask")
 	proto.RegisterType((*GfSpRecoverPieceTask)(nil), "base.types.gfsptask.GfSpRecoverPieceTask")
+	proto.RegisterType((*GfSpSPOutcome)(nil), "base.types.gfsptask.GfSpSPOutcome")
 	proto.RegisterType((*GfSpReceivePieceTask)(nil), "base.types.gfsptask.GfSpReceivePieceTask")
 	proto.RegisterType((*GfSpSealObjectTask)(nil), "base.types.gfsptask.GfSpSealObjectTask")
 	proto.RegisterType((*GfSpDownloadObjectTask)(nil), "base.types.gfsptask.GfSpDownloadObjectTask")
@@ -2306,156 +2409,162 @@ func init() {
 func init() { proto.RegisterFile("base/types/gfsptask/task.proto", fileDescriptor_0d22df708e229306) }
 
 var fileDescriptor_0d22df708e229306 = []byte{
-	// 2370 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0x4b, 0x8f, 0x1c, 0x49,
-	0x11, 0xde, 0x9e, 0x7e, 0x47, 0x4f, 0xcf, 0xa3, 0xa6, 0x6d, 0xb7, 0x5f, 0xe3, 0x71, 0xcf, 0xda,
-	0x1a, 0xc3, 0x4e, 0xcf, 0xae, 0x57, 0x16, 0x47, 0x6b, 0x1e, 0xeb, 0x5e, 0x8b, 0xf5, 0x63, 0xab,
-	0x8d, 0x0f, 0x7b, 0xa0, 0x94, 0x5d, 0x95, 0x5d, 0x5d, 0x4c, 0x75, 0x55, 0x91, 0x59, 0xd5, 0x9e,
-	0xf6, 0x95, 0x03, 0x57, 0x84, 0xc4, 0x15, 0xae, 0x08, 0x71, 0x41, 0x20, 0x6e, 0x48, 0x48, 0x48,
-	0xab, 0x15, 0xe2, 0xb0, 0x47, 0x4e, 0x08, 0xd9, 0x27, 0xfe, 0x05, 0xca, 0xc8, 0xac, 0x57, 0xbb,
-	0x67, 0x18, 0xaf, 0x07, 0xb0, 0x11, 0x17, 0xbb, 0x33, 0x22, 0xb2, 0x2a, 0x22, 0xbe, 0x88, 0x2f,
-	0x1f, 0x35, 0xb0, 0x3e, 0x20, 0x9c, 0xee, 0x84, 0xd3, 0x80, 0xf2, 0x1d, 0x7b, 0xc8, 0x83, 0x90,
-	0xf0, 0xc3, 0x1d, 0xf1, 0x4f, 0x37, 0x60, 0x7e, 0xe8, 0x6b, 0x6b, 0x42, 0xdf, 0x45, 0x7d, 0x37,
-	0xd6, 0x5f, 0xba, 0x3e, 0x33, 0x89, 0x32, 0xe6, 0x33, 0xbe, 0x83, 0xff, 0xc9, 0x79, 0x97, 0x2e,
-	0xda, 0x8c, 0x52, 0x6f, 0xe8, 0x50, 0xd7, 0xda, 0xe1, 0x81, 0xb4, 0x55, 0xaa, 0x6b, 0x59, 0x55,
-	0xe8, 0x33, 0x62, 0xd3, 0x9d, 0x80, 0x30, 0x32, 0x8e, 0x0d, 0x2e, 0xcf, 0x31, 0x08, 0x8f, 0x94,
-	0x72, 0x7d, 0x9e, 0x32, 0xf3, 0xf4, 0xcd, 0x8c, 0x7e, 0xe2, 0xb0, 0x30, 0x22, 0xae, 0xcd, 0xfc,
-	0x28, 0xe7, 0x42, 0xe7, 0x4f, 0x0b, 0x50, 0xeb, 0x0d, 0xfb, 0xc1, 0x13, 0xc2, 0x0f, 0xb5, 0x36,
-	0x54, 0x89, 0x65, 0x31, 0xca, 0x79, 0xbb, 0xb0, 0x51, 0xd8, 0xaa, 0xeb, 0xf1, 0x50, 0xbb, 0x06,
-	0x0d, 0x93, 0x51, 0x12, 0x52, 0x23, 0x74, 0xc6, 0xb4, 0xbd, 0xb0, 0x51, 0xd8, 0x2a, 0xea, 0x20,
-	0x45, 0x4f, 0x9c, 0x31, 0x15, 0x06, 0x51, 0x60, 0x25, 0x06, 0x45, 0x69, 0x20, 0x45, 0x68, 0xd0,
-	0x86, 0xaa, 0xd0, 0xf8, 0x51, 0xd8, 0x2e, 0xa1, 0x32, 0x1e, 0x6a, 0x9b, 0xd0, 0x14, 0xb9, 0x34,
-	0x02, 0xe6, 0xf8, 0xcc, 0x09, 0xa7, 0xed, 0xf2, 0x46, 0x61, 0xab, 0xac, 0x2f, 0x0a, 0xe1, 0x63,
-	0x25, 0xd3, 0x5a, 0x50, 0x66, 0x34, 0x64, 0xd3, 0x76, 0x05, 0x27, 0xcb, 0x81, 0x76, 0x19, 0xea,
-	0x63, 0x72, 0x64, 0x48, 0x4d, 0x15, 0x35, 0xb5, 0x31, 0x39, 0xd2, 0x51, 0x79, 0x1d, 0x16, 0x23,
-	0x4e, 0x99, 0x11, 0x87, 0x54, 0xc3, 0x90, 0x1a, 0x42, 0xb6, 0xab, 0xc2, 0xd2, 0xa0, 0xe4, 0xfa,
-	0x36, 0x6f, 0xd7, 0x51, 0x85, 0xbf, 0xb5, 0xdb, 0x50, 0xa4, 0x8c, 0xb5, 0x61, 0xa3, 0xb0, 0xd5,
-	0xb8, 0xbd, 0xd1, 0x9d, 0x41, 0x5d, 0x02, 0xdc, 0x15, 0x29, 0xfb, 0x44, 0xfc, 0xd4, 0x85, 0x71,
-	0xe7, 0xcb, 0x02, 0x5c, 0x11, 0xa2, 0x7d, 0x4c, 0xc8, 0x5e, 0x64, 0x1e, 0xd2, 0x70, 0x37, 0x08,
-	0x98, 0x3f, 0x21, 0x2e, 0x66, 0xf6, 0x23, 0x28, 0x89, 0x70, 0x30, 0xad, 0x8d, 0xdb, 0x57, 0xbb,
-	0x73, 0x6a, 0xa9, 0x1b, 0xc3, 0xa0, 0xa3, 0xa9, 0xf6, 0x39, 0x68, 0x2a, 0xe5, 0x03, 0x7c, 0x9e,
-	0xe1, 0x78, 0x43, 0x1f, 0x33, 0xdf, 0xb8, 0xbd, 0xd9, 0x4d, 0xb1, 0xed, 0x2a, 0xec, 0xbb, 0x0f,
-	0xb8, 0x9d, 0x7d, 0xbf, 0xbe, 0x62, 0x66, 0x46, 0xf7, 0xbd, 0xa1, 0xaf, 0x6d, 0x40, 0x63, 0xe8,
-	0x78, 0x36, 0x65, 0x01, 0x73, 0xbc, 0x10, 0x41, 0x5a, 0xd4, 0xb3, 0xa2, 0xce, 0x2f, 0x0b, 0x70,
-	0x55, 0xf8, 0xf1, 0xc0, 0xb1, 0xd9, 0x99, 0x45, 0xf2, 0x04, 0xd6, 0xc6, 0xf2, 0x79, 0x73, 0x42,
-	0x79, 0xff, 0x98, 0x50, 0x72, 0x1e, 0xe8, 0xab, 0xe3, 0xec, 0x50, 0x04, 0x33, 0x93, 0xf3, 0x47,
-	0x83, 0x1f, 0x50, 0xf3, 0x0c, 0x73, 0xee, 0xe3, 0xf3, 0x4e, 0x9f, 0x73, 0xf9, 0xfe, 0x38, 0xe7,
-	0x72, 0x74, 0xca, 0x9c, 0xff, 0xad, 0x00, 0xef, 0x0b, 0x3f, 0x0e, 0xa8, 0x4b, 0x6d, 0x12, 0xd2,
-	0xb3, 0x0c, 0x88, 0xc0, 0x79, 0x4b, 0x3d, 0xd6, 0xc8, 0x45, 0xa6, 0x82, 0xfa, 0xf6, 0x31, 0x41,
-	0xcd, 0xf3, 0x45, 0x6f, 0x59, 0x73, 0xa4, 0xa7, 0x08, 0xf0, 0x0f, 0x25, 0x58, 0x17, 0x7e, 0xe9,
-	0x34, 0x70, 0x1d, 0x93, 0x84, 0xf4, 0xb1, 0x43, 0x4d, 0xfa, 0xa6, 0xa1, 0xdd, 0x85, 0xc6, 0xab,
-	0x20, 0xad, 0xcf, 0x8b, 0x27, 0x45, 0x43, 0x07, 0x3f, 0x45, 0x66, 0x17, 0x96, 0x94, 0x85, 0x21,
-	0x49, 0x17, 0x7d, 0x6f, 0xdc, 0xbe, 0x34, 0xef, 0x19, 0x8f, 0xd1, 0x42, 0x6f, 0xaa, 0xb1, 0x1c,
-	0x6a, 0x77, 0xe0, 0x82, 0x60, 0x2e, 0x1e, 0x18, 0x7e, 0x40, 0x19, 0x09, 0xfd, 0x94, 0x6d, 0x4a,
-	0x48, 0x29, 0x2d, 0xc2, 0x0f, 0xfb, 0xc1, 0x23, 0xa5, 0x8c, 0x69, 0x67, 0x13, 0x9a, 0x38, 0xcd,
-	0xb1, 0x3d, 0x12, 0x46, 0x8c, 0x22, 0xe3, 0x2d, 0xea, 0x8b, 0xc2, 0x38, 0x96, 0x69, 0x1f, 0x42,
-	0x8b, 0x60, 0x8a, 0xa8, 0x25, 0x5e, 0x40, 0x3d, 0x2b, 0xf0, 0x45, 0x82, 0x2b, 0xf8, 0x60, 0x2d,
-	0xd6, 0xf5, 0x83, 0x4f, 0x94, 0x46, 0xbb, 0x0b, 0x57, 0xb2, 0x33, 0x5e, 0x71, 0xa9, 0x8a, 0x33,
-	0x2f, 0xa6, 0x33, 0x67, 0xfd, 0xda, 0x06, 0x2d, 0x7d, 0x40, 0xe2, 0x5c, 0x0d, 0x9d, 0x5b, 0x4d,
-	0xa6, 0x25, 0x1e, 0xce, 0xbc, 0x8f, 0x28, 0x40, 0x93, 0xf7, 0xd5, 0x67, 0xdf, 0x17, 0x43, 0x1e,
-	0xbf, 0xef, 0x06, 0x2c, 0xd1, 0xa3, 0xc0, 0x61, 0xd4, 0x32, 0x46, 0xd4, 0xb1, 0x47, 0x21, 0xb2,
-	0x6e, 0x49, 0x6f, 0x2a, 0xe9, 0xa7, 0x28, 0xec, 0xfc, 0x7a, 0x01, 0x5a, 0x02, 0xfc, 0xef, 0x05,
-	0xae, 0x4f, 0x2c, 0x89, 0xe6, 0x37, 0xad, 0x9a, 0x3b, 0x70, 0x41, 0xad, 0x85, 0x06, 0x2e, 0x86,
-	0xc6, 0x90, 0x8c, 0x1d, 0x77, 0x6a, 0x38, 0x16, 0x56, 0x50, 0x53, 0x6f, 0x29, 0x75, 0x4f, 0x68,
-	0xef, 0xa1, 0xf2, 0xbe, 0x35, 0x5b, 0x6c, 0xc5, 0x33, 0x28, 0xb6, 0xd2, 0xeb, 0x16, 0xdb, 0x4d,
-	0x58, 0x76, 0xb8, 0x41, 0x6c, 0xea, 0x85, 0x46, 0x84, 0xa9, 0xc0, 0xba, 0xa9, 0xe9, 0x4d, 0x87,
-	0xef, 0x0a, 0xa9, 0xcc, 0x4f, 0xe7, 0x47, 0x45, 0xc9, 0xe1, 0x3a, 0xe5, 0xd1, 0x98, 0x0c, 0x5c,
-	0x7a, 0x16, 0x79, 0x7b, 0x1b, 0xba, 0xed, 0x3c, 0x54, 0xfc, 0xe1, 0x90, 0x53, 0xb9, 0x83, 0x28,
-	0xe9, 0x6a, 0x24, 0xe4, 0x2e, 0xf5, 0xec, 0x70, 0x84, 0xf9, 0x28, 0xe9, 0x6a, 0xa4, 0x5d, 0x81,
-	0xba, 0xe9, 0x8f, 0x03, 0x97, 0x86, 0xd4, 0xc2, 0xb6, 0xa9, 0xe9, 0xa9, 0xe0, 0xa4, 0x4a, 0xa8,
-	0x9e, 0x50, 0x09, 0x73, 0x50, 0xa8, 0xcd, 0x43, 0xe1, 0x67, 0x25, 0x38, 0xff, 0x2a, 0xe9, 0xbd,
-	0xcb, 0xe9, 0xdf, 0x81, 0x35, 0x4e, 0x4d, 0xdf, 0xb3, 0x08, 0x9b, 0xc6, 0x3d, 0x4e, 0x45, 0x1d,
-	0x17, 0x05, 0x1f, 0x25, 0xaa, 0xdd, 0x58, 0xa3, 0x7d, 0x04, 0xad, 0x74, 0x42, 0xc2, 0x27, 0xbc,
-	0x5d, 0xde, 0x28, 0x6e, 0x2d, 0xea, 0xe9, 0xc3, 0x12, 0x46, 0x41, 0x88, 0x39, 0x25, 0x6e, 0x82,
-	0x97, 0x1a, 0x09, 0xb0, 0x6c, 0xd7, 0x1f, 0x10, 0xd7, 0xc8, 0x63, 0x96, 0x82, 0x25, 0xd5, 0x4f,
-	0x33, 0x90, 0xdd, 0xb7, 0xf2, 0x2e, 0xc7, 0x0c, 0x2a, 0x76, 0x82, 0x79, 0x97, 0x63, 0x06, 0x15,
-	0x31, 0xb6, 0x3c, 0x3f, 0x34, 0xc8, 0x84, 0x38, 0xae, 0x68, 0x1d, 0xc1, 0x6b, 0x8e, 0x75, 0x84,
-	0x54, 0x56, 0xd6, 0x57, 0x3d, 0x3f, 0xdc, 0x8d, 0x55, 0xfd, 0xe0, 0xbe, 0x75, 0x24, 0x26, 0xcc,
-	0x94, 0x83, 0x81, 0xd8, 0x02, 0xba, 0xbf, 0x9a, 0xab, 0x09, 0x81, 0x67, 0xe7, 0x17, 0x45, 0x49,
-	0x66, 0x3a, 0x35, 0xfd, 0x09, 0x65, 0xef, 0x7c, 0x55, 0x5c, 0x83, 0x06, 0xa7, 0xf6, 0x58, 0xc4,
-	0x2f, 0x12, 0x55, 0x42, 0x34, 0x40, 0x89, 0x44, 0x86, 0xce, 0x41, 0x85, 0x9a, 0xa8, 0x93, 0xfb,
-	0xfa, 0x32, 0x35, 0x85, 0xf8, 0x2a, 0x40, 0x20, 0x62, 0x37, 0xb8, 0xf3, 0x9c, 0x22, 0xda, 0x25,
-	0xbd, 0x8e, 0x92, 0xbe, 0xf3, 0x9c, 0x8a, 0xde, 0x4d, 0x57, 0xa0, 0x2a, 0xae, 0x40, 0xa9, 0x40,
-	0x68, 0x99, 0xcc, 0x1f, 0x8d, 0xdb, 0x2f, 0x15, 0x88, 0x16, 0x1d, 0x4c, 0x0d, 0x1e, 0x99, 0x26,
-	0xe5, 0xdc, 0x67, 0x06, 0x0f, 0x10, 0xbf, 0x9a, 0xde, 0x1c, 0x4c, 0xfb, 0xb1, 0xb4, 0x1f, 0x08,
-	0xcf, 0xec, 0x89, 0x2d, 0x6a, 0x08, 0xd0, 0xeb, 0xb2, 0x3d, 0xb1, 0xef, 0x5b, 0x9d, 0x3f, 0x96,
-	0x12, 0x84, 0xa8, 0x33, 0xa1, 0xff, 0xfb, 0x08, 0xdd, 0x80, 0x25, 0x46, 0xad, 0xc8, 0xb3, 0x88,
-	0x67, 0x4e, 0x33, 0x48, 0x35, 0x53, 0xe9, 0x7c, 0xc4, 0x8a, 0x59, 0xc4, 0x6e, 0xc0, 0x92, 0x54,
-	0x9b, 0x23, 0x6a, 0x1e, 0xf2, 0x68, 0xac, 0x60, 0x6b, 0xa2, 0x74, 0x5f, 0x09, 0xf3, 0xc0, 0xd6,
-	0x66, 0x81, 0x4d, 0xfb, 0xbf, 0x9e, 0xeb, 0xff, 0x4b, 0x50, 0x1b, 0x3a, 0x9e, 0xc3, 0x47, 0xd4,
-	0x52, 0xad, 0x95, 0x8c, 0x4f, 0xe2, 0x86, 0xc6, 0x09, 0xdc, 0x70, 0x0b, 0x56, 0xd4, 0x69, 0x44,
-	0x9e, 0x2d, 0x1c, 0xdf, 0x6b, 0x2f, 0xe2, 0xa3, 0x97, 0xa5, 0xfc, 0x41, 0x2c, 0x3e, 0xb6, 0xc9,
-	0x9b, 0xc7, 0x35, 0xf9, 0x57, 0x45, 0xd0, 0x44, 0x25, 0xf4, 0x29, 0x71, 0xdf, 0xfd, 0x75, 0xf7,
-	0x3f, 0x41, 0xfc, 0x27, 0x80, 0x58, 0x79, 0x7d, 0x82, 0xaf, 0x9e, 0x44, 0xf0, 0x73, 0xa1, 0xac,
-	0x1d, 0x07, 0xe5, 0xef, 0x17, 0xe4, 0x3a, 0x7e, 0xe0, 0x3f, 0xf3, 0xde, 0x82, 0x6d, 0xd4, 0x5d,
-	0x68, 0x64, 0xcf, 0xd0, 0x27, 0x6c, 0x44, 0xd3, 0xa3, 0xb2, 0x0e, 0x83, 0xf4, 0x0e, 0xe0, 0x0c,
-	0x36, 0xa2, 0x2b, 0x50, 0x74, 0xfd, 0x67, 0x48, 0x12, 0x45, 0x5d, 0xfc, 0xd4, 0x34, 0x28, 0x8d,
-	0x1c, 0x7b, 0xa4, 0x48, 0x01, 0x7f, 0x77, 0x7e, 0x57, 0x84, 0x73, 0xd9, 0xc4, 0xfd, 0x77, 0x79,
-	0xf4, 0x6d, 0xc8, 0xdb, 0x75, 0x58, 0xa4, 0x1e, 0xee, 0x2a, 0x90, 0x22, 0xd5, 0xee, 0xbd, 0x21,
-	0x65, 0x48, 0x90, 0x82, 0x63, 0x43, 0x3f, 0x24, 0x6e, 0x6e, 0x55, 0x44, 0x09, 0x72, 0xec, 0x65,
-	0x90, 0x84, 0x6b, 0x1c, 0xd2, 0xa9, 0x3a, 0xce, 0xd5, 0x50, 0xf0, 0x5d, 0x8a, 0xf7, 0x5d, 0x52,
-	0xa9, 0x36, 0xc9, 0x35, 0x9c, 0xdd, 0x40, 0xd9, 0x23, 0xb9, 0x53, 0x4e, 0x4c, 0xd4, 0x7e, 0xb9,
-	0x9e, 0x31, 0xf9, 0x0c, 0x45, 0x9d, 0x2f, 0x8b, 0xb2, 0xde, 0xf7, 0x47, 0xc4, 0x15, 0x56, 0xf4,
-	0xff, 0xb8, 0xcd, 0x2c, 0xa0, 0xe5, 0x53, 0x2c, 0xa0, 0x95, 0x79, 0x0b, 0xe8, 0x0d, 0x58, 0x72,
-	0xbc, 0x90, 0xda, 0xcc, 0x09, 0xa7, 0xc6, 0x88, 0xf0, 0x51, 0xbc, 0x42, 0x26, 0xd2, 0x4f, 0x09,
-	0x1f, 0xa5, 0xeb, 0x2c, 0x9a, 0xd4, 0x90, 0x33, 0x25, 0xec, 0xa8, 0xbe, 0x09, 0xcb, 0x52, 0x6d,
-	0x91, 0x90, 0xc8, 0x3a, 0xa9, 0x63, 0xdb, 0xc9, 0x85, 0xf6, 0x80, 0x84, 0x44, 0xd4, 0x4a, 0xe7,
-	0xe7, 0x0b, 0xb0, 0x22, 0xd0, 0xe8, 0xed, 0xbf, 0x19, 0x65, 0x7d, 0x00, 0x1a, 0x0f, 0x09, 0x0b,
-	0x8d, 0x81, 0xeb, 0x9b, 0x87, 0x86, 0x17, 0x8d, 0x07, 0x94, 0x21, 0x92, 0x25, 0x7d, 0x05, 0x35,
-	0x7b, 0x42, 0xf1, 0x10, 0xe5, 0xda, 0x16, 0xac, 0x50, 0xcf, 0xca, 0xdb, 0x16, 0xd1, 0x76, 0x89,
-	0x7a, 0x56, 0xd6, 0xf2, 0x43, 0x68, 0x99, 0x11, 0x63, 0x22, 0xab, 0x39, 0x6b, 0x79, 0xb6, 0xd3,
-	0x94, 0x2e, 0x3b, 0xe3, 0x63, 0x38, 0xef, 0x12, 0x1e, 0x1a, 0x16, 0xc5, 0x13, 0x5c, 0x72, 0x47,
-	0x67, 0xa9, 0x73, 0xdf, 0x9a, 0xd0, 0x1e, 0x48, 0xa5, 0x2a, 0x27, 0x4b, 0x6b, 0x43, 0x95, 0x45,
-	0x9e, 0xe7, 0x78, 0xb6, 0x3a, 0x52, 0xc4, 0xc3, 0xce, 0x5f, 0x0a, 0x92, 0xa0, 0x7a, 0xfb, 0x5f,
-	0xf8, 0xe3, 0x81, 0xf3, 0x66, 0x85, 0x9e, 0x79, 0xcd, 0x42, 0xee, 0x35, 0x02, 0x2f, 0x99, 0xbf,
-	0xd4, 0x5d, 0x99, 0x90, 0x26, 0x8a, 0x13, 0x47, 0x3b, 0xd0, 0x14, 0x99, 0x4b, 0xad, 0x64, 0x22,
-	0x1a, 0xd4, 0x4b, 0x83, 0xc9, 0x6e, 0x83, 0xca, 0xf9, 0x6d, 0x50, 0xe7, 0xb7, 0x0b, 0xf2, 0x3e,
-	0xb4, 0xb7, 0xdf, 0x0f, 0x89, 0x4b, 0x9f, 0x52, 0xc6, 0x1d, 0xdf, 0x7b, 0x33, 0xec, 0x2f, 0x43,
-	0x3d, 0xf5, 0x47, 0x42, 0x5e, 0xf3, 0x63, 0x67, 0x6e, 0xc1, 0x4a, 0xb6, 0xea, 0x3d, 0x8b, 0x1e,
-	0x61, 0x64, 0x65, 0x7d, 0x39, 0x53, 0xf7, 0x42, 0x2c, 0xb2, 0x33, 0x91, 0xfe, 0xc4, 0x97, 0xff,
-	0x6a, 0xa8, 0x6d, 0x83, 0x96, 0xf6, 0x44, 0xb2, 0x73, 0x94, 0xf7, 0x61, 0xab, 0x89, 0x26, 0xd9,
-	0x3d, 0x76, 0x61, 0x2d, 0xbf, 0xc9, 0x34, 0x5c, 0x87, 0x87, 0xed, 0x0a, 0x36, 0xc9, 0x6a, 0x6e,
-	0xa7, 0xf9, 0x99, 0xc3, 0x43, 0xd1, 0xba, 0x2a, 0x00, 0x6c, 0x94, 0x2a, 0x86, 0xa0, 0xf8, 0x05,
-	0xbb, 0xe4, 0xc7, 0x05, 0x58, 0x92, 0x59, 0x7b, 0x40, 0x43, 0xf2, 0x4d, 0xf3, 0x74, 0x0d, 0x1a,
-	0x71, 0x2d, 0x8b, 0xee, 0x97, 0x99, 0x02, 0x25, 0x12, 0xad, 0x7f, 0x1d, 0x16, 0x65, 0xd5, 0x1a,
-	0xa6, 0x1f, 0xa9, 0x5b, 0xd2, 0x92, 0xde, 0x90, 0xb2, 0x7d, 0x21, 0xea, 0xfc, 0xb4, 0x24, 0xf7,
-	0x8c, 0xea, 0xe2, 0xbb, 0xf7, 0xb4, 0xf7, 0x06, 0xa8, 0xc5, 0x9c, 0x99, 0xa0, 0xa6, 0x18, 0xd1,
-	0xd2, 0x0e, 0xa0, 0xca, 0x99, 0x69, 0xd8, 0x13, 0x5b, 0x91, 0x69, 0xee, 0x0a, 0x38, 0xfb, 0x9d,
-	0xa8, 0xdb, 0x7b, 0x65, 0xc7, 0xa5, 0x57, 0x38, 0x33, 0x7b, 0x13, 0x5b, 0xbb, 0x07, 0x35, 0x8b,
-	0xf2, 0x10, 0x1f, 0x53, 0x7a, 0xfd, 0xc7, 0x54, 0xc5, 0x64, 0xf1, 0x9c, 0x53, 0x1e, 0x3d, 0xee,
-	0x80, 0x78, 0xb1, 0x38, 0xc8, 0x55, 0xe6, 0x2c, 0x00, 0x41, 0xb7, 0xaf, 0xf8, 0x9a, 0xf9, 0x13,
-	0xc7, 0xa2, 0x4c, 0x2f, 0x73, 0x66, 0xf6, 0x03, 0xb1, 0xa9, 0x44, 0xc2, 0x50, 0x1f, 0x0f, 0xb2,
-	0xcd, 0x25, 0x2b, 0xa1, 0x25, 0xd4, 0x2a, 0xe1, 0xf3, 0xbb, 0xac, 0x36, 0x73, 0xd8, 0xb8, 0x06,
-	0x0d, 0x79, 0x39, 0x29, 0xbf, 0x73, 0x49, 0xe6, 0x05, 0x29, 0xc2, 0xef, 0x5c, 0xb9, 0xf3, 0x0d,
-	0xcc, 0x9e, 0x6f, 0xba, 0xc9, 0xa7, 0x10, 0xcb, 0x18, 0x4c, 0x43, 0xca, 0x65, 0x5d, 0x36, 0xd0,
-	0x9b, 0xf8, 0x23, 0x87, 0xb5, 0x27, 0x34, 0x58, 0x9e, 0xff, 0x50, 0x57, 0x9f, 0xca, 0xc7, 0x77,
-	0xfe, 0x2c, 0x2a, 0xc8, 0x10, 0x81, 0x4c, 0xef, 0xb3, 0xe5, 0x45, 0x79, 0x13, 0x11, 0x4b, 0xae,
-	0xb2, 0xcf, 0x6a, 0xc9, 0xfd, 0x16, 0xac, 0x3a, 0xdc, 0xc8, 0x9d, 0xf3, 0x24, 0x0b, 0xd4, 0xf4,
-	0x65, 0x87, 0xef, 0x65, 0xce, 0x79, 0xb4, 0xf3, 0xab, 0x05, 0xb8, 0x28, 0xa9, 0x60, 0x2f, 0x7f,
-	0xfe, 0xfb, 0xb7, 0xf4, 0xe1, 0x2d, 0x58, 0xc5, 0xda, 0xb4, 0xcd, 0x57, 0x16, 0x86, 0x25, 0xa1,
-	0xe8, 0x99, 0x49, 0x3d, 0x6e, 0xc2, 0x52, 0x6c, 0xaa, 0xee, 0x2b, 0xd4, 0xd2, 0x20, 0xed, 0x7a,
-	0x13, 0xfb, 0xe4, 0xa5, 0x41, 0x2c, 0x2d, 0x72, 0x57, 0x29, 0xa6, 0x7b, 0xd1, 0x58, 0x6d, 0x2c,
-	0x1b, 0x28, 0xec, 0x4d, 0xec, 0x87, 0xd1, 0x58, 0xdb, 0x86, 0x35, 0xdb, 0x34, 0xe2, 0x29, 0x89,
-	0xa5, 0xec, 0x93, 0x15, 0xdb, 0xbc, 0xa7, 0x34, 0xd2, 0xbc, 0xf3, 0x9b, 0x05, 0xb8, 0x20, 0xc2,
-	0x9d, 0x49, 0x15, 0xd6, 0x49, 0x2e, 0xee, 0xc2, 0x4c, 0xdc, 0x59, 0x3f, 0x17, 0x66, 0xfc, 0x3c,
-	0xa6, 0x3b, 0x8a, 0xc7, 0x74, 0x87, 0xf6, 0x1d, 0x40, 0x22, 0x11, 0xbc, 0x50, 0x3a, 0x15, 0x2f,
-	0x54, 0x84, 0x39, 0x12, 0x43, 0xcc, 0x27, 0xe5, 0xd7, 0xe1, 0x93, 0x99, 0xe6, 0xaf, 0x9c, 0xdc,
-	0xfc, 0xb3, 0xb7, 0x56, 0x9d, 0x3f, 0x17, 0x61, 0x2d, 0xcd, 0xd9, 0xe7, 0x91, 0x1f, 0x92, 0x7f,
-	0x9d, 0xaf, 0x16, 0x94, 0xc7, 0xbe, 0x17, 0x8e, 0x30, 0x59, 0x75, 0x5d, 0x0e, 0x84, 0x27, 0x6a,
-	0x8a, 0x47, 0xd4, 0xe7, 0xf6, 0x7a, 0xbc, 0xed, 0x7d, 0x48, 0xc6, 0x54, 0xec, 0xda, 0x18, 0x25,
-	0x96, 0x61, 0xfa, 0x1e, 0x8f, 0xc6, 0xf8, 0x3d, 0xe7, 0x39, 0x55, 0x75, 0xb3, 0x22, 0x34, 0xfb,
-	0x4a, 0xa1, 0x12, 0xd9, 0x1e, 0x32, 0x4a, 0x8d, 0x1f, 0x0a, 0x9f, 0x66, 0xe6, 0xc8, 0xbd, 0xd5,
-	0x39, 0xa1, 0x47, 0x97, 0x73, 0x13, 0x6f, 0xc2, 0x72, 0x66, 0x62, 0xe6, 0xd0, 0xd2, 0x4c, 0xec,
-	0xd1, 0xee, 0x03, 0xd0, 0xcc, 0x11, 0x61, 0x36, 0xb5, 0xb2, 0xa6, 0xaa, 0xb8, 0x94, 0x26, 0xb5,
-	0xde, 0x84, 0x26, 0x71, 0x5d, 0xff, 0x59, 0xd2, 0xb1, 0x92, 0x85, 0x17, 0x51, 0xa8, 0xda, 0x55,
-	0x90, 0x3b, 0xe6, 0xc2, 0x9d, 0x1a, 0xb3, 0x2e, 0xc8, 0x63, 0x4d, 0x4b, 0xa9, 0xef, 0xe5, 0x3c,
-	0xb9, 0x07, 0x1b, 0x73, 0xa6, 0xe5, 0x43, 0x96, 0x5f, 0xa1, 0xae, 0xcc, 0xce, 0xcf, 0x46, 0xbe,
-	0xf7, 0xfd, 0xaf, 0x5e, 0xac, 0x17, 0xbe, 0x7e, 0xb1, 0x5e, 0xf8, 0xfb, 0x8b, 0xf5, 0xc2, 0x4f,
-	0x5e, 0xae, 0xbf, 0xf7, 0xf5, 0xcb, 0xf5, 0xf7, 0xfe, 0xfa, 0x72, 0xfd, 0xbd, 0x2f, 0x0e, 0x6c,
-	0x27, 0x1c, 0x45, 0x83, 0xae, 0xe9, 0x8f, 0x77, 0x06, 0xde, 0x60, 0xdb, 0x1c, 0x11, 0xc7, 0xdb,
-	0x49, 0x0b, 0x6c, 0x5b, 0x51, 0xe2, 0x76, 0xa0, 0xca, 0x6b, 0x67, 0xce, 0x5f, 0x9e, 0x0c, 0x2a,
-	0xf8, 0xf7, 0x19, 0x1f, 0xff, 0x33, 0x00, 0x00, 0xff, 0xff, 0x11, 0xcd, 0x60, 0x46, 0x97, 0x22,
-	0x00, 0x00,
+	// 2475 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xec, 0x5a, 0xcd, 0x6f, 0x1b, 0xc7,
+	0x15, 0x0f, 0xc5, 0x0f, 0x91, 0x8f, 0xa2, 0x3e, 0x56, 0xb4, 0x4d, 0x7f, 0xc9, 0x32, 0x1d, 0x1b,
+	0x72, 0x1b, 0x53, 0x89, 0x02, 0xa3, 0x47, 0x43, 0x1f, 0x31, 0x63, 0x34, 0xfe, 0xc8, 0xd2, 0xf5,
+	0x21, 0x87, 0x2e, 0x86, 0xbb, 0xa3, 0xe5, 0x56, 0xcb, 0xdd, 0xcd, 0xce, 0x92, 0x16, 0x73, 0xed,
+	0xa1, 0xd7, 0xa2, 0x7f, 0x40, 0xcf, 0x45, 0xd1, 0x4b, 0x91, 0xa2, 0xb7, 0x02, 0x05, 0x0a, 0x04,
+	0x41, 0xd1, 0x43, 0x8e, 0x45, 0x0f, 0x45, 0x61, 0xf7, 0xd2, 0xff, 0xa2, 0x78, 0x6f, 0x66, 0xbf,
+	0x28, 0x4a, 0x95, 0x63, 0xb5, 0xb5, 0x8b, 0x5e, 0x6c, 0xce, 0x7b, 0x6f, 0x66, 0xdf, 0xe7, 0x6f,
+	0xde, 0xcc, 0x08, 0xd6, 0xfa, 0x4c, 0xf0, 0xcd, 0x68, 0x12, 0x70, 0xb1, 0x69, 0xef, 0x8b, 0x20,
+	0x62, 0xe2, 0x60, 0x13, 0xff, 0xe9, 0x04, 0xa1, 0x1f, 0xf9, 0xda, 0x2a, 0xf2, 0x3b, 0xc4, 0xef,
+	0xc4, 0xfc, 0x4b, 0xd7, 0xa7, 0x26, 0xf1, 0x30, 0xf4, 0x43, 0xb1, 0x49, 0xff, 0xc9, 0x79, 0x97,
+	0x2e, 0xda, 0x21, 0xe7, 0xde, 0xbe, 0xc3, 0x5d, 0x6b, 0x53, 0x04, 0x52, 0x56, 0xb1, 0xae, 0x65,
+	0x59, 0x91, 0x1f, 0x32, 0x9b, 0x6f, 0x06, 0x2c, 0x64, 0xc3, 0x58, 0xe0, 0xf2, 0x0c, 0x81, 0xe8,
+	0x50, 0x31, 0xd7, 0x66, 0x31, 0x33, 0xab, 0xdf, 0xc8, 0xf0, 0xc7, 0x4e, 0x18, 0x8d, 0x98, 0x6b,
+	0x87, 0xfe, 0x28, 0xa7, 0x42, 0xfb, 0x0f, 0x73, 0x50, 0xed, 0xee, 0xf7, 0x82, 0xa7, 0x4c, 0x1c,
+	0x68, 0x2d, 0x98, 0x67, 0x96, 0x15, 0x72, 0x21, 0x5a, 0x85, 0xf5, 0xc2, 0x46, 0x4d, 0x8f, 0x87,
+	0xda, 0x35, 0xa8, 0x9b, 0x21, 0x67, 0x11, 0x37, 0x22, 0x67, 0xc8, 0x5b, 0x73, 0xeb, 0x85, 0x8d,
+	0xa2, 0x0e, 0x92, 0xf4, 0xd4, 0x19, 0x72, 0x14, 0x18, 0x05, 0x56, 0x22, 0x50, 0x94, 0x02, 0x92,
+	0x44, 0x02, 0x2d, 0x98, 0x47, 0x8e, 0x3f, 0x8a, 0x5a, 0x25, 0x62, 0xc6, 0x43, 0xed, 0x06, 0x34,
+	0xd0, 0x97, 0x46, 0x10, 0x3a, 0x7e, 0xe8, 0x44, 0x93, 0x56, 0x79, 0xbd, 0xb0, 0x51, 0xd6, 0x17,
+	0x90, 0xf8, 0x44, 0xd1, 0xb4, 0x26, 0x94, 0x43, 0x1e, 0x85, 0x93, 0x56, 0x85, 0x26, 0xcb, 0x81,
+	0x76, 0x19, 0x6a, 0x43, 0x76, 0x68, 0x48, 0xce, 0x3c, 0x71, 0xaa, 0x43, 0x76, 0xa8, 0x13, 0xf3,
+	0x3a, 0x2c, 0x8c, 0x04, 0x0f, 0x8d, 0xd8, 0xa4, 0x2a, 0x99, 0x54, 0x47, 0xda, 0xb6, 0x32, 0x4b,
+	0x83, 0x92, 0xeb, 0xdb, 0xa2, 0x55, 0x23, 0x16, 0xfd, 0xd6, 0xb6, 0xa0, 0xc8, 0xc3, 0xb0, 0x05,
+	0xeb, 0x85, 0x8d, 0xfa, 0xd6, 0x7a, 0x67, 0x2a, 0xea, 0x32, 0xc0, 0x1d, 0x74, 0xd9, 0x47, 0xf8,
+	0x53, 0x47, 0xe1, 0xf6, 0x57, 0x05, 0xb8, 0x82, 0xa4, 0x5d, 0x72, 0xc8, 0xce, 0xc8, 0x3c, 0xe0,
+	0xd1, 0x76, 0x10, 0x84, 0xfe, 0x98, 0xb9, 0xe4, 0xd9, 0x0f, 0xa0, 0x84, 0xe6, 0x90, 0x5b, 0xeb,
+	0x5b, 0x57, 0x3b, 0x33, 0x72, 0xa9, 0x13, 0x87, 0x41, 0x27, 0x51, 0xed, 0x53, 0xd0, 0x94, 0xcb,
+	0xfb, 0xb4, 0x9e, 0xe1, 0x78, 0xfb, 0x3e, 0x79, 0xbe, 0xbe, 0x75, 0xa3, 0x93, 0xc6, 0xb6, 0xa3,
+	0x62, 0xdf, 0x79, 0x28, 0xec, 0xec, 0xf7, 0xf5, 0x65, 0x33, 0x33, 0x7a, 0xe0, 0xed, 0xfb, 0xda,
+	0x3a, 0xd4, 0xf7, 0x1d, 0xcf, 0xe6, 0x61, 0x10, 0x3a, 0x5e, 0x44, 0x41, 0x5a, 0xd0, 0xb3, 0xa4,
+	0xf6, 0x2f, 0x0a, 0x70, 0x15, 0xf5, 0x78, 0xe8, 0xd8, 0xe1, 0x99, 0x59, 0xf2, 0x14, 0x56, 0x87,
+	0x72, 0xbd, 0x19, 0xa6, 0xbc, 0x7b, 0x8c, 0x29, 0x39, 0x0d, 0xf4, 0x95, 0x61, 0x76, 0x88, 0xc6,
+	0x4c, 0xf9, 0xfc, 0x71, 0xff, 0x47, 0xdc, 0x3c, 0x43, 0x9f, 0xfb, 0xb4, 0xde, 0xe9, 0x7d, 0x2e,
+	0xbf, 0x1f, 0xfb, 0x5c, 0x8e, 0x4e, 0xe9, 0xf3, 0xbf, 0x16, 0xe0, 0x5d, 0xd4, 0x63, 0x8f, 0xbb,
+	0xdc, 0x66, 0x11, 0x3f, 0x4b, 0x83, 0x18, 0x9c, 0xb7, 0xd4, 0xb2, 0x46, 0xce, 0x32, 0x65, 0xd4,
+	0x77, 0x8f, 0x31, 0x6a, 0x96, 0x2e, 0x7a, 0xd3, 0x9a, 0x41, 0x3d, 0x85, 0x81, 0xbf, 0x2b, 0xc1,
+	0x1a, 0xea, 0xa5, 0xf3, 0xc0, 0x75, 0x4c, 0x16, 0xf1, 0x27, 0x0e, 0x37, 0xf9, 0xeb, 0x9a, 0x76,
+	0x0f, 0xea, 0x47, 0x83, 0xb4, 0x36, 0xcb, 0x9e, 0x34, 0x1a, 0x3a, 0xf8, 0x69, 0x64, 0xb6, 0x61,
+	0x51, 0x49, 0x18, 0x12, 0x74, 0x49, 0xf7, 0xfa, 0xd6, 0xa5, 0x59, 0x6b, 0x3c, 0x21, 0x09, 0xbd,
+	0xa1, 0xc6, 0x72, 0xa8, 0xdd, 0x85, 0x0b, 0x88, 0x5c, 0x22, 0x30, 0xfc, 0x80, 0x87, 0x2c, 0xf2,
+	0x53, 0xb4, 0x29, 0x11, 0xa4, 0x34, 0x99, 0x38, 0xe8, 0x05, 0x8f, 0x15, 0x33, 0x86, 0x9d, 0x1b,
+	0xd0, 0xa0, 0x69, 0x8e, 0xed, 0xb1, 0x68, 0x14, 0x72, 0x42, 0xbc, 0x05, 0x7d, 0x01, 0x85, 0x63,
+	0x9a, 0xf6, 0x3e, 0x34, 0x19, 0xb9, 0x88, 0x5b, 0xf8, 0x01, 0xee, 0x59, 0x81, 0x8f, 0x0e, 0xae,
+	0xd0, 0xc2, 0x5a, 0xcc, 0xeb, 0x05, 0x1f, 0x29, 0x8e, 0x76, 0x0f, 0xae, 0x64, 0x67, 0x1c, 0x51,
+	0x69, 0x9e, 0x66, 0x5e, 0x4c, 0x67, 0x4e, 0xeb, 0x75, 0x07, 0xb4, 0x74, 0x81, 0x44, 0xb9, 0x2a,
+	0x29, 0xb7, 0x92, 0x4c, 0x4b, 0x34, 0x9c, 0xfa, 0x1e, 0x53, 0x01, 0x4d, 0xbe, 0x57, 0x9b, 0xfe,
+	0x5e, 0x1c, 0xf2, 0xf8, 0x7b, 0x37, 0x61, 0x91, 0x1f, 0x06, 0x4e, 0xc8, 0x2d, 0x63, 0xc0, 0x1d,
+	0x7b, 0x10, 0x11, 0xea, 0x96, 0xf4, 0x86, 0xa2, 0x7e, 0x4c, 0xc4, 0xf6, 0xaf, 0xe6, 0xa0, 0x89,
+	0xc1, 0xff, 0x41, 0xe0, 0xfa, 0xcc, 0x92, 0xd1, 0xfc, 0xb6, 0x59, 0x73, 0x17, 0x2e, 0xa8, 0xbd,
+	0xd0, 0xa0, 0xcd, 0xd0, 0xd8, 0x67, 0x43, 0xc7, 0x9d, 0x18, 0x8e, 0x45, 0x19, 0xd4, 0xd0, 0x9b,
+	0x8a, 0xdd, 0x45, 0xee, 0x7d, 0x62, 0x3e, 0xb0, 0xa6, 0x93, 0xad, 0x78, 0x06, 0xc9, 0x56, 0x7a,
+	0xd5, 0x64, 0xbb, 0x05, 0x4b, 0x8e, 0x30, 0x98, 0xcd, 0xbd, 0xc8, 0x18, 0x91, 0x2b, 0x28, 0x6f,
+	0xaa, 0x7a, 0xc3, 0x11, 0xdb, 0x48, 0x95, 0xfe, 0x69, 0xff, 0xb8, 0x28, 0x31, 0x5c, 0xe7, 0x62,
+	0x34, 0x64, 0x7d, 0x97, 0x9f, 0x85, 0xdf, 0xde, 0x84, 0x6a, 0x3b, 0x0f, 0x15, 0x7f, 0x7f, 0x5f,
+	0x70, 0xd9, 0x41, 0x94, 0x74, 0x35, 0x42, 0xba, 0xcb, 0x3d, 0x3b, 0x1a, 0x90, 0x3f, 0x4a, 0xba,
+	0x1a, 0x69, 0x57, 0xa0, 0x66, 0xfa, 0xc3, 0xc0, 0xe5, 0x11, 0xb7, 0xa8, 0x6c, 0xaa, 0x7a, 0x4a,
+	0x38, 0x29, 0x13, 0xe6, 0x4f, 0xc8, 0x84, 0x19, 0x51, 0xa8, 0xce, 0x8a, 0xc2, 0x5f, 0x4a, 0x70,
+	0xfe, 0x28, 0xe8, 0xbd, 0xcd, 0xee, 0xdf, 0x84, 0x55, 0xc1, 0x4d, 0xdf, 0xb3, 0x58, 0x38, 0x89,
+	0x6b, 0x9c, 0x63, 0x1e, 0x17, 0x11, 0x8f, 0x12, 0xd6, 0x76, 0xcc, 0xd1, 0x3e, 0x80, 0x66, 0x3a,
+	0x21, 0xc1, 0x13, 0xd1, 0x2a, 0xaf, 0x17, 0x37, 0x16, 0xf4, 0x74, 0xb1, 0x04, 0x51, 0x28, 0xc4,
+	0x82, 0x33, 0x37, 0x89, 0x97, 0x1a, 0x61, 0xb0, 0x6c, 0xd7, 0xef, 0x33, 0xd7, 0xc8, 0xc7, 0x2c,
+	0x0d, 0x96, 0x64, 0x3f, 0xcb, 0x84, 0xec, 0x81, 0x95, 0x57, 0x39, 0x46, 0x50, 0xec, 0x04, 0xf3,
+	0x2a, 0xc7, 0x08, 0x8a, 0x36, 0x36, 0x3d, 0x3f, 0x32, 0xd8, 0x98, 0x39, 0x2e, 0x96, 0x0e, 0xe2,
+	0x9a, 0x63, 0x1d, 0x12, 0x94, 0x95, 0xf5, 0x15, 0xcf, 0x8f, 0xb6, 0x63, 0x56, 0x2f, 0x78, 0x60,
+	0x1d, 0xe2, 0x84, 0xa9, 0x74, 0x30, 0x28, 0xb6, 0x40, 0xea, 0xaf, 0xe4, 0x72, 0x82, 0x82, 0xbf,
+	0x0b, 0x75, 0xc4, 0xe6, 0x51, 0x64, 0xfa, 0x43, 0x2e, 0x5a, 0xf5, 0xf5, 0xe2, 0x46, 0x7d, 0xab,
+	0x7d, 0x6c, 0x0e, 0xf4, 0x9e, 0x3c, 0x96, 0xa2, 0x3a, 0x88, 0x40, 0xfd, 0x14, 0xed, 0xbf, 0x17,
+	0x25, 0x22, 0xea, 0xdc, 0xf4, 0xc7, 0x3c, 0x7c, 0xeb, 0x53, 0xeb, 0x1a, 0xd4, 0x05, 0xb7, 0x87,
+	0xe8, 0x44, 0xf4, 0x76, 0x89, 0x42, 0x0a, 0x8a, 0x84, 0x6e, 0x3e, 0x07, 0x15, 0x6e, 0x12, 0x4f,
+	0x1e, 0x0e, 0xca, 0xdc, 0x44, 0xf2, 0x55, 0x80, 0x00, 0x6d, 0x37, 0x84, 0xf3, 0x05, 0xa7, 0x94,
+	0x29, 0xe9, 0x35, 0xa2, 0xf4, 0x9c, 0x2f, 0x38, 0x02, 0x40, 0xba, 0x8d, 0xcd, 0xd3, 0x36, 0x96,
+	0x12, 0x90, 0x1b, 0x4a, 0xff, 0xf1, 0xb8, 0x86, 0x53, 0x02, 0xd6, 0x79, 0x7f, 0x62, 0x88, 0x91,
+	0x69, 0x72, 0x21, 0xfc, 0xd0, 0x10, 0x01, 0x25, 0x41, 0x55, 0x6f, 0xf4, 0x27, 0xbd, 0x98, 0xda,
+	0x0b, 0x50, 0x33, 0x7b, 0x6c, 0x63, 0x22, 0x02, 0x69, 0x5d, 0xb6, 0xc7, 0xf6, 0x03, 0xeb, 0x6c,
+	0xc2, 0xfc, 0x65, 0x01, 0x1a, 0x39, 0xae, 0x76, 0x09, 0xaa, 0x49, 0x23, 0x20, 0x8f, 0x68, 0xc9,
+	0x18, 0x0f, 0x33, 0xb8, 0x32, 0x45, 0xb0, 0xac, 0xd3, 0x6f, 0x94, 0x0f, 0xf9, 0xe7, 0x23, 0x2e,
+	0x22, 0x19, 0x95, 0x86, 0x9e, 0x8c, 0x91, 0xb7, 0xcf, 0x1c, 0x97, 0x4a, 0x52, 0x7a, 0x3c, 0x19,
+	0xe3, 0xd9, 0x29, 0x60, 0x13, 0x4a, 0x67, 0x72, 0xad, 0x04, 0xd6, 0xba, 0xa2, 0x91, 0x73, 0x2f,
+	0xc0, 0xbc, 0xe9, 0x8b, 0xc8, 0x18, 0x0a, 0x75, 0x26, 0xab, 0xe0, 0xf0, 0xa1, 0x68, 0xff, 0xbe,
+	0x94, 0x24, 0x27, 0x77, 0xc6, 0xfc, 0x7f, 0x3f, 0x39, 0x6f, 0xc2, 0x62, 0xc8, 0xad, 0x91, 0x67,
+	0x31, 0xcf, 0x9c, 0x64, 0x92, 0xb4, 0x91, 0x52, 0x67, 0x27, 0x6b, 0x31, 0x9b, 0xac, 0x37, 0x61,
+	0x51, 0xb2, 0xcd, 0x01, 0x37, 0x0f, 0xc4, 0x68, 0xa8, 0x32, 0xb6, 0x41, 0xd4, 0x5d, 0x45, 0xcc,
+	0xe7, 0x74, 0x75, 0x3a, 0xa7, 0x53, 0xfc, 0xac, 0xe5, 0xf0, 0x13, 0x63, 0xed, 0x78, 0x8e, 0x18,
+	0x70, 0x4b, 0x41, 0x53, 0x32, 0x3e, 0x09, 0x5b, 0xeb, 0x27, 0x60, 0xeb, 0x6d, 0x58, 0x56, 0xa7,
+	0x39, 0x79, 0x36, 0x73, 0x7c, 0xaf, 0xb5, 0x40, 0x4b, 0x2f, 0x49, 0xfa, 0xc3, 0x98, 0x7c, 0x2c,
+	0x48, 0x36, 0x8e, 0x01, 0xc9, 0xf6, 0xd7, 0x45, 0xd0, 0x28, 0xf1, 0x39, 0x73, 0xdf, 0xfe, 0xbe,
+	0xe5, 0x3f, 0xb1, 0x71, 0x9e, 0x10, 0xc4, 0xca, 0xab, 0x6f, 0x90, 0xf3, 0x27, 0x6d, 0x90, 0x33,
+	0x43, 0x59, 0x3d, 0x2e, 0x94, 0xbf, 0x9d, 0x93, 0x7d, 0xd0, 0x9e, 0xff, 0xdc, 0x7b, 0x03, 0xda,
+	0xd0, 0x7b, 0x50, 0xcf, 0xde, 0x41, 0x9c, 0xd0, 0xc8, 0xa7, 0x57, 0x0d, 0x3a, 0xf4, 0x93, 0xdf,
+	0x67, 0xd1, 0xc8, 0x2f, 0x43, 0xd1, 0xf5, 0x9f, 0x13, 0x48, 0x14, 0x75, 0xfc, 0x89, 0xd0, 0x3d,
+	0x70, 0xec, 0x81, 0x02, 0x05, 0xfa, 0xdd, 0xfe, 0x4d, 0x11, 0xce, 0x65, 0x1d, 0xf7, 0xdf, 0xc5,
+	0xd1, 0x37, 0xc1, 0x6f, 0xd7, 0x61, 0x81, 0x7b, 0xd4, 0x95, 0x11, 0x44, 0xaa, 0xd3, 0x4f, 0x5d,
+	0xd2, 0x08, 0x20, 0x11, 0x63, 0x23, 0x3f, 0x62, 0x6e, 0xae, 0x21, 0x20, 0x0a, 0x61, 0xec, 0x65,
+	0x90, 0x80, 0x6b, 0x1c, 0xf0, 0x89, 0x3a, 0x0e, 0x57, 0x89, 0xf0, 0x7d, 0x4e, 0xf7, 0x85, 0x92,
+	0xa9, 0x0e, 0x19, 0x55, 0xb5, 0xe7, 0x21, 0xed, 0x31, 0x91, 0x52, 0x11, 0x75, 0xde, 0xa8, 0x65,
+	0x44, 0x3e, 0x21, 0x52, 0xfb, 0xab, 0xa2, 0xcc, 0xf7, 0xdd, 0x01, 0x73, 0x51, 0x8a, 0xff, 0x3f,
+	0x6e, 0x53, 0x1b, 0x68, 0xf9, 0x14, 0x1b, 0x68, 0x65, 0xd6, 0x06, 0x7a, 0x13, 0x16, 0x1d, 0x2f,
+	0xe2, 0x36, 0x5e, 0x08, 0x1b, 0x03, 0x26, 0x06, 0xf1, 0x0e, 0x99, 0x50, 0x3f, 0x66, 0x62, 0x90,
+	0xee, 0xb3, 0x24, 0x52, 0x25, 0xcc, 0x94, 0x61, 0x27, 0xf6, 0x2d, 0x58, 0x92, 0x6c, 0x8b, 0x45,
+	0x4c, 0xe6, 0x49, 0x8d, 0xca, 0x4e, 0x6e, 0xb4, 0x7b, 0x2c, 0x62, 0x98, 0x2b, 0xed, 0x9f, 0xcf,
+	0xc1, 0x32, 0x46, 0xa3, 0xbb, 0xfb, 0x7a, 0x90, 0xf5, 0x1e, 0x68, 0x22, 0x62, 0x61, 0x64, 0xf4,
+	0x5d, 0xdf, 0x3c, 0x30, 0xbc, 0xd1, 0xb0, 0xcf, 0x43, 0x8a, 0x64, 0x49, 0x5f, 0x26, 0xce, 0x0e,
+	0x32, 0x1e, 0x11, 0x5d, 0xdb, 0x80, 0x65, 0xee, 0x59, 0x79, 0xd9, 0x22, 0xc9, 0x2e, 0x72, 0xcf,
+	0xca, 0x4a, 0xbe, 0x0f, 0x4d, 0x73, 0x14, 0x86, 0xe8, 0xd5, 0x9c, 0xb4, 0x3c, 0x1b, 0x6b, 0x8a,
+	0x97, 0x9d, 0xf1, 0x21, 0x9c, 0x77, 0x99, 0x88, 0x0c, 0xbc, 0xc6, 0x8b, 0xb8, 0x95, 0xdc, 0x71,
+	0x5a, 0xaa, 0xbd, 0x5b, 0x45, 0xee, 0x9e, 0x64, 0xaa, 0x74, 0xb2, 0xf0, 0xde, 0x3e, 0x1c, 0x79,
+	0x9e, 0xe3, 0xd9, 0xea, 0x48, 0x16, 0x0f, 0xdb, 0x7f, 0x2a, 0x48, 0x80, 0xea, 0xee, 0x7e, 0xe6,
+	0x0f, 0xfb, 0xce, 0xeb, 0x25, 0x7a, 0xe6, 0x33, 0x73, 0xb9, 0xcf, 0x60, 0xbc, 0xa4, 0xff, 0x52,
+	0x75, 0xa5, 0x43, 0x1a, 0x44, 0x4e, 0x14, 0x6d, 0x43, 0x03, 0x3d, 0x97, 0x4a, 0x49, 0x47, 0xd4,
+	0xb9, 0x97, 0x1a, 0x93, 0x6d, 0x83, 0xca, 0xf9, 0x36, 0xa8, 0xfd, 0xe5, 0x9c, 0xbc, 0x4f, 0xee,
+	0xee, 0xf6, 0x22, 0xe6, 0xf2, 0x67, 0x3c, 0x14, 0x8e, 0xef, 0xbd, 0x5e, 0xec, 0x2f, 0x43, 0x2d,
+	0xd5, 0x47, 0x86, 0xbc, 0xea, 0xc7, 0xca, 0xdc, 0x86, 0xe5, 0x6c, 0xd6, 0x7b, 0x16, 0x3f, 0x24,
+	0xcb, 0xca, 0xfa, 0x52, 0x26, 0xef, 0x91, 0x8c, 0xde, 0x19, 0x4b, 0x7d, 0xe2, 0xc7, 0x13, 0x35,
+	0xc4, 0x2b, 0xbb, 0xb4, 0x26, 0x92, 0xce, 0x51, 0xde, 0x27, 0xae, 0x24, 0x9c, 0xa4, 0x7b, 0xec,
+	0xc0, 0x6a, 0xbe, 0xc9, 0x34, 0x5c, 0x47, 0xe0, 0x9d, 0x22, 0x16, 0xc9, 0x4a, 0xae, 0xd3, 0xfc,
+	0xc4, 0x11, 0x11, 0x96, 0xae, 0x32, 0x80, 0x0a, 0x65, 0x9e, 0x4c, 0x50, 0xf8, 0x42, 0x55, 0xf2,
+	0x93, 0x02, 0x2c, 0x4a, 0xaf, 0x3d, 0xe4, 0x11, 0xfb, 0xb6, 0x7e, 0xc2, 0xe7, 0x25, 0x95, 0xcb,
+	0x58, 0xfd, 0xd2, 0x53, 0xa0, 0x48, 0x58, 0xfa, 0xd7, 0x61, 0x41, 0x66, 0xad, 0x61, 0xfa, 0x23,
+	0x75, 0xcb, 0x5c, 0xd2, 0xeb, 0x92, 0xb6, 0x8b, 0xa4, 0xf6, 0xcf, 0x4a, 0xb2, 0x67, 0x54, 0x0f,
+	0x07, 0xdd, 0x67, 0xdd, 0xd7, 0x88, 0x5a, 0x8c, 0x99, 0x49, 0xd4, 0x14, 0x22, 0x5a, 0xda, 0x1e,
+	0xcc, 0x8b, 0xd0, 0x34, 0xec, 0xb1, 0xdd, 0x2a, 0x1e, 0xbd, 0x42, 0xcf, 0xbe, 0xb3, 0x75, 0xba,
+	0x47, 0x3a, 0x2e, 0xbd, 0x22, 0x42, 0xb3, 0x3b, 0xb6, 0xb5, 0xfb, 0x50, 0xb5, 0xb8, 0x88, 0x68,
+	0x99, 0xd2, 0xab, 0x2f, 0x33, 0x8f, 0x93, 0x71, 0x9d, 0x53, 0x1e, 0x3d, 0xee, 0x02, 0x7e, 0x18,
+	0xcf, 0xb0, 0x95, 0x19, 0x1b, 0x40, 0xd0, 0xe9, 0x29, 0xbc, 0x0e, 0xfd, 0xb1, 0x63, 0xf1, 0x50,
+	0x2f, 0x8b, 0xd0, 0xec, 0x05, 0xd8, 0x54, 0x12, 0x60, 0xa8, 0xc7, 0x97, 0x6c, 0x71, 0xc9, 0x4c,
+	0x68, 0x22, 0x5b, 0x39, 0x7c, 0x76, 0x95, 0x55, 0xa7, 0x0e, 0x1b, 0xd7, 0xa0, 0x2e, 0x2f, 0x77,
+	0xe5, 0x3b, 0xa1, 0x44, 0x5e, 0x90, 0x24, 0x7a, 0x27, 0xcc, 0x9d, 0x6f, 0x60, 0xfa, 0x7c, 0xd3,
+	0x49, 0x9e, 0x92, 0x2c, 0xa3, 0x3f, 0x89, 0xb8, 0x90, 0x79, 0x59, 0x27, 0x6d, 0xe2, 0x47, 0x22,
+	0x6b, 0x07, 0x39, 0x94, 0x9e, 0xff, 0x50, 0x57, 0xc7, 0x4a, 0xc7, 0xb7, 0xfe, 0x2c, 0x8a, 0x60,
+	0x48, 0x81, 0x4c, 0xdf, 0x03, 0xe4, 0x43, 0x43, 0x83, 0x22, 0x96, 0x3c, 0x05, 0x9c, 0xd5, 0x96,
+	0xfb, 0x1d, 0x58, 0x71, 0x84, 0x91, 0x3b, 0xe7, 0x49, 0x14, 0xa8, 0xea, 0x4b, 0x8e, 0xd8, 0xc9,
+	0x9c, 0xf3, 0x78, 0xfb, 0x97, 0x73, 0x70, 0x51, 0x42, 0xc1, 0x4e, 0xfe, 0xfc, 0xf7, 0x6f, 0xa9,
+	0xc3, 0xdb, 0xb0, 0x42, 0xb9, 0x69, 0x9b, 0x47, 0x36, 0x86, 0x45, 0x64, 0x74, 0xcd, 0x24, 0x1f,
+	0x6f, 0xc0, 0x62, 0x2c, 0xaa, 0xae, 0x6a, 0xd4, 0xd6, 0x20, 0xe5, 0xba, 0x63, 0x7b, 0x2a, 0x69,
+	0xa7, 0xb6, 0x06, 0xdc, 0x5a, 0x64, 0x57, 0x89, 0xd3, 0xbd, 0xd1, 0x50, 0x35, 0x96, 0x75, 0x22,
+	0x76, 0xc7, 0xf6, 0xa3, 0xd1, 0x50, 0xbb, 0x03, 0xab, 0xb6, 0x69, 0xc4, 0x53, 0x12, 0x49, 0x59,
+	0x27, 0xcb, 0xb6, 0x79, 0x5f, 0x71, 0xa4, 0x78, 0xfb, 0xd7, 0x73, 0x70, 0x01, 0xcd, 0x9d, 0x72,
+	0x15, 0xe5, 0x49, 0xce, 0xee, 0xc2, 0x94, 0xdd, 0x59, 0x3d, 0xe7, 0xa6, 0xf4, 0x3c, 0xa6, 0x3a,
+	0x8a, 0xc7, 0x54, 0x87, 0xf6, 0x3d, 0x20, 0x20, 0x41, 0x5c, 0x28, 0x9d, 0x0a, 0x17, 0x2a, 0x28,
+	0xde, 0x0b, 0x32, 0x78, 0x52, 0x7e, 0x15, 0x3c, 0x99, 0x2a, 0xfe, 0xca, 0xc9, 0xc5, 0x3f, 0x7d,
+	0x61, 0xd7, 0xfe, 0x63, 0x11, 0x56, 0x53, 0x9f, 0x7d, 0x3a, 0xf2, 0x23, 0xf6, 0xaf, 0xfd, 0xd5,
+	0x84, 0xf2, 0xd0, 0xf7, 0xa2, 0x01, 0x39, 0xab, 0xa6, 0xcb, 0x01, 0x6a, 0xa2, 0xa6, 0x78, 0x4c,
+	0xfd, 0xb9, 0x42, 0x2d, 0x6e, 0x7b, 0x1f, 0xb1, 0x21, 0xc7, 0xae, 0x2d, 0xe4, 0xcc, 0x32, 0x4c,
+	0xdf, 0x13, 0xa3, 0x21, 0x57, 0xd7, 0x60, 0x32, 0x6f, 0x96, 0x91, 0xb3, 0xab, 0x18, 0xca, 0x91,
+	0xad, 0xfd, 0x90, 0x73, 0xe3, 0x73, 0xd4, 0x69, 0x6a, 0x8e, 0xec, 0xad, 0xce, 0x21, 0x9f, 0x54,
+	0xce, 0x4d, 0xbc, 0x05, 0x4b, 0x99, 0x89, 0x99, 0x43, 0x4b, 0x23, 0x91, 0x27, 0xb9, 0xf7, 0x40,
+	0x33, 0x07, 0x2c, 0xb4, 0xb9, 0x95, 0x15, 0x55, 0xc9, 0xa5, 0x38, 0xa9, 0x34, 0xbe, 0x2f, 0xba,
+	0xae, 0xff, 0x3c, 0xa9, 0x58, 0x89, 0xc2, 0x0b, 0x44, 0x54, 0xe5, 0x8a, 0xe0, 0x4e, 0xbe, 0x70,
+	0x27, 0xc6, 0xb4, 0x0a, 0xf2, 0x58, 0xd3, 0x54, 0xec, 0xfb, 0x39, 0x4d, 0xee, 0xc3, 0xfa, 0x8c,
+	0x69, 0x79, 0x93, 0xe5, 0x2b, 0xde, 0x95, 0xe9, 0xf9, 0x59, 0xcb, 0x77, 0x7e, 0xf8, 0xf5, 0x8b,
+	0xb5, 0xc2, 0x37, 0x2f, 0xd6, 0x0a, 0x7f, 0x7b, 0xb1, 0x56, 0xf8, 0xe9, 0xcb, 0xb5, 0x77, 0xbe,
+	0x79, 0xb9, 0xf6, 0xce, 0x9f, 0x5f, 0xae, 0xbd, 0xf3, 0xd9, 0x9e, 0xed, 0x44, 0x83, 0x51, 0xbf,
+	0x63, 0xfa, 0xc3, 0xcd, 0xbe, 0xd7, 0xbf, 0x63, 0x0e, 0x98, 0xe3, 0x6d, 0xa6, 0x09, 0x76, 0x47,
+	0x41, 0xe2, 0x9d, 0x40, 0xa5, 0xd7, 0xe6, 0x8c, 0xbf, 0xdc, 0xe9, 0x57, 0xe8, 0xef, 0x5b, 0x3e,
+	0xfc, 0xe7, 0x00, 0x9b, 0xfb, 0x7c, 0x7f, 0xd7, 0x23, 0x00, 0x00,
 }
 
 func (m *GfSpTask) Marshal() (dAtA []byte, err error) {
@@ -3047,6 +3156,20 @@ func (m *GfSpReplicatePieceTask) MarshalToSizedBuffer(dAtA []byte) (int, error)
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpOutcomes) > 0 {
+		for iNdEx := len(m.SpOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.IsAgentUploadTask {
 		i--
 		if m.IsAgentUploadTask {
@@ -3163,6 +3286,20 @@ func (m *GfSpRecoverPieceTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	_ = i
 	var l int
 	_ = l
+	if len(m.SpOutcomes) > 0 {
+		for iNdEx := len(m.SpOutcomes) - 1; iNdEx >= 0; iNdEx-- {
+			{
+				size, err := m.SpOutcomes[iNdEx].MarshalToSizedBuffer(dAtA[:i])
+				if err != nil {
+					return 0, err
+				}
+				i -= size
+				i = encodeVarintTask(dAtA, i, uint64(size))
+			}
+			i--
+			dAtA[i] = 0x5a
+		}
+	}
 	if m.GvgId != 0 {
 		i = encodeVarintTask(dAtA, i, uint64(m.GvgId))
 		i--
@@ -3249,6 +3386,61 @@ func (m *GfSpRecoverPieceTask) MarshalToSizedBuffer(dAtA []byte) (int, error) {
 	return len(dAtA) - i, nil
 }
 
+func (m *GfSpSPOutcome) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *GfSpSPOutcome) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *GfSpSPOutcome) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if m.CostMs != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.CostMs))
+		i--
+		dAtA[i] = 0x30
+	}
+	if m.PayloadSize != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.PayloadSize))
+		i--
+		dAtA[i] = 0x28
+	}
+	if m.Failures != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Failures))
+		i--
+		dAtA[i] = 0x20
+	}
+	if m.Requests != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Requests))
+		i--
+		dAtA[i] = 0x18
+	}
+	if m.Type != 0 {
+		i = encodeVarintTask(dAtA, i, uint64(m.Type))
+		i--
+		dAtA[i] = 0x10
+	}
+	if len(m.Endpoint) > 0 {
+		i -= len(m.Endpoint)
+		copy(dAtA[i:], m.Endpoint)
+		i = encodeVarintTask(dAtA, i, uint64(len(m.Endpoint)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
 func (m *GfSpReceivePieceTask) Marshal() (dAtA []byte, err error) {
 	size := m.Size()
 	dAtA = make([]byte, size)
@@ -4738,6 +4930,12 @@ func (m *GfSpReplicatePieceTask) Size() (n int) {
 	if m.IsAgentUploadTask {
 		n += 2
 	}
+	if len(m.SpOutcomes) > 0 {
+		for _, e := range m.SpOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
 	return n
 }
 
@@ -4781,6 +4979,40 @@ func (m *GfSpRecoverPieceTask) Size() (n int) {
 	if m.GvgId != 0 {
 		n += 1 + sovTask(uint64(m.GvgId))
 	}
+	if len(m.SpOutcomes) > 0 {
+		for _, e := range m.SpOutcomes {
+			l = e.Size()
+			n += 1 + l + sovTask(uint64(l))
+		}
+	}
+	return n
+}
+
+func (m *GfSpSPOutcome) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Endpoint)
+	if l > 0 {
+		n += 1 + l + sovTask(uint64(l))
+	}
+	if m.Type != 0 {
+		n += 1 + sovTask(uint64(m.Type))
+	}
+	if m.Requests != 0 {
+		n += 1 + sovTask(uint64(m.Requests))
+	}
+	if m.Failures != 0 {
+		n += 1 + sovTask(uint64(m.Failures))
+	}
+	if m.PayloadSize != 0 {
+		n += 1 + sovTask(uint64(m.PayloadSize))
+	}
+	if m.CostMs != 0 {
+		n += 1 + sovTask(uint64(m.CostMs))
+	}
 	return n
 }
 
@@ -7319,6 +7551,40 @@ func (m *GfSpReplicatePieceTask) Unmarshal(dAtA []byte) error {
 				}
 			}
 			m.IsAgentUploadTask = bool(v != 0)
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOutcomes = append(m.SpOutcomes, &GfSpSPOutcome{})
+			if err := m.SpOutcomes[len(m.SpOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
@@ -7627,6 +7893,217 @@ func (m *GfSpRecoverPieceTask) Unmarshal(dAtA []byte) error {
 					break
 				}
 			}
+		case 11:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field SpOutcomes", wireType)
+			}
+			var msglen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				msglen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if msglen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + msglen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.SpOutcomes = append(m.SpOutcomes, &GfSpSPOutcome{})
+			if err := m.SpOutcomes[len(m.SpOutcomes)-1].Unmarshal(dAtA[iNdEx:postIndex]); err != nil {
+				return err
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipTask(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthTask
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *GfSpSPOutcome) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowTask
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: GfSpSPOutcome: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: GfSpSPOutcome: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Endpoint", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthTask
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthTask
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Endpoint = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Type", wireType)
+			}
+			m.Type = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Type |= int32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 3:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Requests", wireType)
+			}
+			m.Requests = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Requests |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 4:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Failures", wireType)
+			}
+			m.Failures = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.Failures |= uint32(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 5:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PayloadSize", wireType)
+			}
+			m.PayloadSize = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.PayloadSize |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+		case 6:
+			if wireType != 0 {
+				return fmt.Errorf("proto: wrong wireType = %d for field CostMs", wireType)
+			}
+			m.CostMs = 0
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowTask
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				m.CostMs |= int64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
 		default:
 			iNdEx = preIndex
 			skippy, err := skipTask(dAtA[iNdEx:])
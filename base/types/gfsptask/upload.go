@@ -440,6 +440,14 @@ func (m *GfSpReplicatePieceTask) SetNotAvailableSpIdx(idx int32) {
 	atomic.CompareAndSwapInt32(&m.NotAvailableSpIdx, -1, idx)
 }
 
+func (m *GfSpReplicatePieceTask) GetSPOutcomes() []*coretask.SPOutcome {
+	return toSPOutcomes(m.GetSpOutcomes())
+}
+
+func (m *GfSpReplicatePieceTask) SetSPOutcomes(outcomes []*coretask.SPOutcome) {
+	m.SpOutcomes = fromSPOutcomes(outcomes)
+}
+
 func (m *GfSpReplicatePieceTask) EstimateLimit() corercmgr.Limit {
 	l := &gfsplimit.GfSpLimit{}
 	if m.GetObjectInfo().GetRedundancyType() == storagetypes.REDUNDANCY_REPLICA_TYPE {
@@ -2,6 +2,7 @@ package gfsptask
 
 import (
 	"testing"
+	"time"
 
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
 	"github.com/stretchr/testify/assert"
@@ -957,6 +958,19 @@ func TestGfSpReplicatePieceTask_SetNotAvailableSpIdx(t *testing.T) {
 	m.SetNotAvailableSpIdx(1)
 }
 
+func TestGfSpReplicatePieceTask_SetSPOutcomes(t *testing.T) {
+	m := &GfSpReplicatePieceTask{
+		Task:          &GfSpTask{},
+		ObjectInfo:    mockObjectInfo,
+		StorageParams: mockStorageParams,
+	}
+	assert.Nil(t, m.GetSPOutcomes())
+	outcomes := []*coretask.SPOutcome{{Endpoint: "sp1", Type: coretask.SPOutcomeApproval, Requests: 2, Failures: 1,
+		Size: 1024, Cost: 3 * time.Second}}
+	m.SetSPOutcomes(outcomes)
+	assert.Equal(t, outcomes, m.GetSPOutcomes())
+}
+
 func TestGfSpReplicatePieceTask_SetSealed(t *testing.T) {
 	m := &GfSpReplicatePieceTask{
 		Task:          &GfSpTask{},
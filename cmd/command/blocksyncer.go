@@ -0,0 +1,165 @@
+package command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"os"
+	"time"
+
+	"github.com/urfave/cli/v2"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/maintenance"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+const blockSyncerCommands = "BLOCK SYNCER COMMANDS"
+
+var fromHeightFlag = &cli.Int64Flag{
+	Name:     "from.height",
+	Aliases:  []string{"from-height"},
+	Usage:    "The first block height that is not trusted, the blocks are replayed from the latest valid checkpoint below it",
+	Required: true,
+}
+
+var toHeightFlag = &cli.Int64Flag{
+	Name:  "to.height",
+	Usage: "The last block height to replay, defaults to the current epoch height of BSDB",
+}
+
+var noSwapFlag = &cli.BoolFlag{
+	Name:  "no.swap",
+	Usage: "Leave the rebuilt shadow schema for inspection without swapping it in",
+}
+
+var bucketSamplesFlag = &cli.IntFlag{
+	Name:  "bucket.samples",
+	Usage: "The number of the bucket rows to sample",
+	Value: 100,
+}
+
+var objectSamplesFlag = &cli.IntFlag{
+	Name:  "object.samples",
+	Usage: "The number of the object rows to sample",
+	Value: 1000,
+}
+
+var recheckDelayFlag = &cli.Int64Flag{
+	Name:  "recheck.delay",
+	Usage: "The seconds to wait before rechecking the mismatched rows, 0 means no recheck",
+	Value: 30,
+}
+
+var BlockSyncerRebuildCmd = &cli.Command{
+	Action: CW.rebuildBlockSyncerAction,
+	Name:   "blocksyncer.rebuild",
+	Usage:  "Rebuild BSDB by replaying the blocks from a height",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		fromHeightFlag,
+		toHeightFlag,
+		noSwapFlag,
+	},
+	Category: blockSyncerCommands,
+	Description: `The blocksyncer.rebuild command restores the latest checkpoint below the from height whose block ` +
+		`hash matches the chain into the shadow schema, replays the blocks up to the to height into it, and swaps ` +
+		`the shadow tables into BSDB in a single RENAME TABLE statement. The block syncer must be stopped before ` +
+		`swapping, the replaced tables are kept in the <database>_retired_<timestamp> schema.`,
+}
+
+var BlockSyncerVerifyCmd = &cli.Command{
+	Action: CW.verifyBlockSyncerAction,
+	Name:   "blocksyncer.verify",
+	Usage:  "Verify the sampled bucket and object rows of BSDB against the chain",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		bucketSamplesFlag,
+		objectSamplesFlag,
+		recheckDelayFlag,
+	},
+	Category: blockSyncerCommands,
+	Description: `The blocksyncer.verify command samples the bucket and object rows of BSDB, compares them with ` +
+		`the bucket and object info on chain, and outputs the mismatched fields as json. The mismatched rows are ` +
+		`rechecked after the delay since the chain may be ahead of BSDB.`,
+}
+
+var BlockSyncerIndexCmd = &cli.Command{
+	Action: CW.indexBlockSyncerAction,
+	Name:   "blocksyncer.index",
+	Usage:  "Create the indexes of the object tables of BSDB that serve the filters and the sort orders of listing objects",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+	},
+	Category: blockSyncerCommands,
+	Description: `The blocksyncer.index command creates the missing indexes of listing objects on all the object ` +
+		`shard tables of BSDB. The indexes are built online by ALGORITHM=INPLACE, LOCK=NONE, so it can run while ` +
+		`the block syncer and the metadata service are serving, and it can be rerun to continue if it is interrupted.`,
+}
+
+func (w *CMDWrapper) rebuildBlockSyncerAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	if len(cfg.Chain.ChainAddress) == 0 {
+		return fmt.Errorf("chain address is not configured")
+	}
+	if err = blocksyncer.Rebuild(context.Background(), cfg, &blocksyncer.RebuildOptions{
+		FromHeight: ctx.Int64(fromHeightFlag.Name),
+		ToHeight:   ctx.Int64(toHeightFlag.Name),
+		NoSwap:     ctx.Bool(noSwapFlag.Name),
+	}); err != nil {
+		return err
+	}
+	fmt.Println("succeed to rebuild BSDB")
+	return nil
+}
+
+func (w *CMDWrapper) verifyBlockSyncerAction(ctx *cli.Context) error {
+	if err := w.initChainAPI(ctx); err != nil {
+		return err
+	}
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := gorm.Open(mysql.Open(blocksyncer.MakeBsDBDSN(cfg, cfg.BsDB.Database)), &gorm.Config{})
+	if err != nil {
+		return err
+	}
+	verifier := maintenance.NewVerifier(db, w.chainAPI, time.Duration(ctx.Int64(recheckDelayFlag.Name))*time.Second)
+	report, err := verifier.Verify(context.Background(), ctx.Int(bucketSamplesFlag.Name), ctx.Int(objectSamplesFlag.Name))
+	if err != nil {
+		return err
+	}
+	encoder := json.NewEncoder(os.Stdout)
+	encoder.SetIndent("", "  ")
+	if err = encoder.Encode(report); err != nil {
+		return err
+	}
+	if len(report.Mismatches) > 0 {
+		return fmt.Errorf("found %d mismatched fields", len(report.Mismatches))
+	}
+	return nil
+}
+
+func (w *CMDWrapper) indexBlockSyncerAction(ctx *cli.Context) error {
+	cfg, err := utils.MakeConfig(ctx)
+	if err != nil {
+		return err
+	}
+	db, err := gorm.Open(mysql.Open(blocksyncer.MakeBsDBDSN(cfg, cfg.BsDB.Database)), &gorm.Config{})
+	if err != nil {
+		return err
+	}
+	created, err := maintenance.CreateObjectIndexes(context.Background(), db, bsdb.ObjectListIndexes)
+	if err != nil {
+		return err
+	}
+	fmt.Printf("succeed to create %d object indexes\n", created)
+	return nil
+}
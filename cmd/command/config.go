@@ -1,12 +1,17 @@
 package command
 
 import (
+	"context"
+	"fmt"
 	"os"
 
 	"github.com/pelletier/go-toml/v2"
 	"github.com/urfave/cli/v2"
 
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 )
 
 const (
@@ -22,6 +27,27 @@ var ConfigDumpCmd = &cli.Command{
 	Description: `The config.dump command writes default configuration values to ./config.toml file for editing.`,
 }
 
+var dryRunFlag = &cli.BoolFlag{
+	Name:  "dry.run",
+	Usage: "Only show the config changes without applying them",
+	Value: false,
+}
+
+// ConfigReloadCmd is used to reload the configuration of the running storage provider.
+var ConfigReloadCmd = &cli.Command{
+	Action:   CW.reloadConfigAction,
+	Name:     "config.reload",
+	Usage:    "Reload the configuration of the running storage provider",
+	Category: "CONFIG COMMANDS",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		endpointFlag,
+		dryRunFlag,
+	},
+	Description: `The config.reload command asks the running storage provider to reload its config file, the changed
+configs that support reloading take effect at once, the others are reported and take effect after restarting.`,
+}
+
 // dumpConfigAction is the dump.config command action.
 func dumpConfigAction(ctx *cli.Context) error {
 	bz, err := toml.Marshal(&gfspconfig.GfSpConfig{})
@@ -33,3 +59,29 @@ func dumpConfigAction(ctx *cli.Context) error {
 	}
 	return nil
 }
+
+// reloadConfigAction is the config.reload command action.
+func (w *CMDWrapper) reloadConfigAction(ctx *cli.Context) error {
+	w.initEmptyGRPCAPI()
+	endpoint := gfspapp.DefaultGRPCAddress
+	if ctx.IsSet(utils.ConfigFileFlag.Name) {
+		cfg := &gfspconfig.GfSpConfig{}
+		err := utils.LoadConfig(ctx.String(utils.ConfigFileFlag.Name), cfg)
+		if err != nil {
+			log.Errorw("failed to load config file", "error", err)
+			return err
+		}
+		endpoint = cfg.GRPCAddress
+	}
+	if ctx.IsSet(endpointFlag.Name) {
+		endpoint = ctx.String(endpointFlag.Name)
+	}
+	applied, restartRequired, err := w.grpcAPI.ReloadConfig(context.Background(), endpoint, ctx.Bool(dryRunFlag.Name))
+	if err != nil {
+		fmt.Printf("failed to reload config, endpoint:%v, error:%v\n", endpoint, err)
+		return err
+	}
+	fmt.Printf("applied configs: %v\n", applied)
+	fmt.Printf("configs require restarting: %v\n", restartRequired)
+	return nil
+}
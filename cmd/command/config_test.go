@@ -1,11 +1,15 @@
 package command
 
 import (
+	"fmt"
 	"os"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
 	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
 )
 
 func TestConfigDumpCmd(t *testing.T) {
@@ -15,3 +19,32 @@ func TestConfigDumpCmd(t *testing.T) {
 	assert.Equal(t, nil, err)
 	os.Remove(DefaultConfigFile)
 }
+
+func TestConfigReloadCmd(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	mockGRPCAPI := gfspclient.NewMockGfSpClientAPI(ctrl)
+	CW.grpcAPI = mockGRPCAPI
+	o1 := mockGRPCAPI.EXPECT().ReloadConfig(gomock.Any(), "localhost:9333", true).Return(nil, nil,
+		fmt.Errorf("failed to reload config"))
+	o2 := mockGRPCAPI.EXPECT().ReloadConfig(gomock.Any(), "localhost:9333", false).Return(
+		[]string{"Parallel.GlobalSealObjectParallel"}, []string{"GRPCAddress"}, nil)
+	gomock.InOrder(o1, o2)
+
+	app := cli.NewApp()
+	app.Commands = []*cli.Command{
+		ConfigReloadCmd,
+	}
+
+	// failed due to config file is not found
+	err := app.Run([]string{"./gnfd-sp", "config.reload", "--config", "not_exist_config"})
+	assert.NotNil(t, err)
+
+	// failed due to reload config error
+	err = app.Run([]string{"./gnfd-sp", "config.reload", "--endpoint", "localhost:9333", "--dry.run"})
+	assert.NotNil(t, err)
+
+	// succeed
+	err = app.Run([]string{"./gnfd-sp", "config.reload", "--endpoint", "localhost:9333"})
+	assert.Nil(t, err)
+}
@@ -0,0 +1,202 @@
+package command
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer"
+)
+
+var keystoreDirFlag = &cli.StringFlag{
+	Name:     "dir",
+	Usage:    "The directory of the keystore files",
+	Required: true,
+}
+
+var keystoreScopeFlag = &cli.StringSliceFlag{
+	Name:  "scope",
+	Usage: "The sign types of the keystores, supports operator, seal, approval, gc and bls",
+	Value: cli.NewStringSlice("operator", "seal", "approval", "gc", "bls"),
+}
+
+var keystoreKeyFileFlag = &cli.StringFlag{
+	Name: "key.file",
+	Usage: "The file of the hex private key, only used with a single scope, the private key is read from " +
+		"the SIGNER_<SCOPE>_PRIV_KEY env variable or its _FILE/_FD variants if it is not set",
+}
+
+var keystorePassphraseFileFlag = &cli.StringFlag{
+	Name: "passphrase.file",
+	Usage: "The file of the keystore passphrase, the passphrase is read from the SIGNER_KEYSTORE_PASSPHRASE env " +
+		"variable or its _FILE/_FD variants if it is not set",
+}
+
+var keystoreNewPassphraseFileFlag = &cli.StringFlag{
+	Name:  "new.passphrase.file",
+	Usage: "The file of the new keystore passphrase, the current passphrase is kept if it is not set",
+}
+
+var keystoreLightKDFFlag = &cli.BoolFlag{
+	Name:  "lightkdf",
+	Usage: "Reduce the scrypt memory and CPU requirements at the expense of security",
+}
+
+var KeystoreCreateCmd = &cli.Command{
+	Action: keystoreCreateAction,
+	Name:   "keystore.create",
+	Usage:  "Create the passphrase encrypted keystore files of the SP signing keys",
+	Flags: []cli.Flag{
+		keystoreDirFlag,
+		keystoreScopeFlag,
+		keystoreKeyFileFlag,
+		keystorePassphraseFileFlag,
+		keystoreLightKDFFlag,
+	},
+	Category: "KEYSTORE COMMANDS",
+	Description: `The keystore.create command encrypts the SP signing private keys with the passphrase by ` +
+		`scrypt and AES-GCM, and writes them to '<dir>/<scope>.json' files, the signer loads them if the ` +
+		`SpAccount.KeyProvider is 'keystore'. The existing keystore files are not overwritten.`,
+}
+
+var KeystoreRotateCmd = &cli.Command{
+	Action: keystoreRotateAction,
+	Name:   "keystore.rotate",
+	Usage:  "Rotate the passphrase or the private key of the SP keystore files",
+	Flags: []cli.Flag{
+		keystoreDirFlag,
+		keystoreScopeFlag,
+		keystoreKeyFileFlag,
+		keystorePassphraseFileFlag,
+		keystoreNewPassphraseFileFlag,
+		keystoreLightKDFFlag,
+	},
+	Category: "KEYSTORE COMMANDS",
+	Description: `The keystore.rotate command decrypts the keystore files with the current passphrase, and ` +
+		`re-encrypts them with the new passphrase and the new private key if they are given. The previous ` +
+		`keystore file is backed up as '<dir>/<scope>.json.<unix time>.bak'.`,
+}
+
+func keystoreCreateAction(ctx *cli.Context) error {
+	scopes, err := keystoreScopes(ctx)
+	if err != nil {
+		return err
+	}
+	passphrase, err := signer.ReadKeystorePassphrase(ctx.String(keystorePassphraseFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	if passphrase == "" {
+		return signer.ErrMissingPassphrase
+	}
+	scryptN, scryptP := keystoreScryptParams(ctx)
+	for _, scope := range scopes {
+		path := signer.KeystoreFilePath(ctx.String(keystoreDirFlag.Name), scope)
+		if _, err = os.Stat(path); err == nil {
+			return fmt.Errorf("keystore %s already exists, use keystore.rotate to replace it", path)
+		}
+		privKey, err := keystorePrivKey(ctx, scope)
+		if err != nil {
+			return err
+		}
+		if privKey == "" {
+			return fmt.Errorf("%w: %s", signer.ErrMissingPrivateKey, scope)
+		}
+		ks, err := signer.EncryptKey(scope, privKey, passphrase, scryptN, scryptP)
+		if err != nil {
+			return err
+		}
+		if err = signer.WriteKeystoreFile(path, ks); err != nil {
+			return err
+		}
+		fmt.Printf("create %s keystore %s successfully, address: %s\n", scope, path, ks.Address)
+	}
+	return nil
+}
+
+func keystoreRotateAction(ctx *cli.Context) error {
+	scopes, err := keystoreScopes(ctx)
+	if err != nil {
+		return err
+	}
+	passphrase, err := signer.ReadKeystorePassphrase(ctx.String(keystorePassphraseFileFlag.Name))
+	if err != nil {
+		return err
+	}
+	newPassphrase := passphrase
+	if ctx.IsSet(keystoreNewPassphraseFileFlag.Name) {
+		if newPassphrase, err = signer.ReadKeystorePassphrase(ctx.String(keystoreNewPassphraseFileFlag.Name)); err != nil {
+			return err
+		}
+	}
+	if newPassphrase == "" {
+		return signer.ErrMissingPassphrase
+	}
+	scryptN, scryptP := keystoreScryptParams(ctx)
+	for _, scope := range scopes {
+		path := signer.KeystoreFilePath(ctx.String(keystoreDirFlag.Name), scope)
+		old, err := signer.ReadKeystoreFile(path)
+		if err != nil {
+			return err
+		}
+		privKey, err := signer.DecryptKey(old, scope, passphrase)
+		if err != nil {
+			return fmt.Errorf("failed to decrypt %s: %w", path, err)
+		}
+		if ctx.IsSet(keystoreKeyFileFlag.Name) {
+			if privKey, err = keystorePrivKey(ctx, scope); err != nil {
+				return err
+			}
+		}
+		ks, err := signer.EncryptKey(scope, privKey, newPassphrase, scryptN, scryptP)
+		if err != nil {
+			return err
+		}
+		backup := fmt.Sprintf("%s.%d.bak", path, time.Now().Unix())
+		if err = signer.WriteKeystoreFile(backup, old); err != nil {
+			return err
+		}
+		if err = signer.WriteKeystoreFile(path, ks); err != nil {
+			return err
+		}
+		fmt.Printf("rotate %s keystore %s successfully, address: %s -> %s, backup: %s\n",
+			scope, path, old.Address, ks.Address, backup)
+	}
+	return nil
+}
+
+func keystoreScopes(ctx *cli.Context) ([]signer.SignType, error) {
+	var scopes []signer.SignType
+	for _, val := range ctx.StringSlice(keystoreScopeFlag.Name) {
+		scope := signer.SignType(strings.TrimSpace(val))
+		if _, ok := signer.SpPrivKeyEnvs[scope]; !ok {
+			return nil, fmt.Errorf("unsupported keystore scope: %s", val)
+		}
+		scopes = append(scopes, scope)
+	}
+	if ctx.IsSet(keystoreKeyFileFlag.Name) && len(scopes) != 1 {
+		return nil, fmt.Errorf("--%s must be used with a single --%s", keystoreKeyFileFlag.Name, keystoreScopeFlag.Name)
+	}
+	return scopes, nil
+}
+
+func keystorePrivKey(ctx *cli.Context, scope signer.SignType) (string, error) {
+	if ctx.IsSet(keystoreKeyFileFlag.Name) {
+		data, err := os.ReadFile(ctx.String(keystoreKeyFileFlag.Name))
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimSpace(string(data)), nil
+	}
+	return signer.LookupEnvSecret(signer.SpPrivKeyEnvs[scope])
+}
+
+func keystoreScryptParams(ctx *cli.Context) (int, int) {
+	if ctx.Bool(keystoreLightKDFFlag.Name) {
+		return signer.LightScryptN, signer.LightScryptP
+	}
+	return signer.StandardScryptN, signer.StandardScryptP
+}
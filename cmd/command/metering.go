@@ -0,0 +1,501 @@
+package command
+
+import (
+	"context"
+	"encoding/csv"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/urfave/cli/v2"
+
+	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/parquet"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+)
+
+const (
+	meteringCommands = "METERING COMMANDS"
+
+	// meteringDayLayout defines the layout of the days of the usage rollups.
+	meteringDayLayout = "2006-01-02"
+	// meteringMonthLayout defines the layout of the months of the usage statements.
+	meteringMonthLayout = "2006-01"
+	// meteringPageSize defines the page size of listing the usage rollups from db.
+	meteringPageSize = 1000
+
+	// csvFormat is the csv format of the exported usage rollups.
+	csvFormat = "csv"
+	// parquetFormat is the parquet format of the exported usage rollups.
+	parquetFormat = "parquet"
+)
+
+// usageRollupColumns is the columns of the exported usage rollups.
+var usageRollupColumns = []parquet.Column{
+	{Name: "day", Type: parquet.StringColumn},
+	{Name: "bucket_id", Type: parquet.Uint64Column},
+	{Name: "bucket_name", Type: parquet.StringColumn},
+	{Name: "payment_address", Type: parquet.StringColumn},
+	{Name: "read_count", Type: parquet.Uint64Column},
+	{Name: "read_size", Type: parquet.Uint64Column},
+	{Name: "stored_size", Type: parquet.Uint64Column},
+}
+
+var startDayFlag = &cli.StringFlag{
+	Name:  "start.day",
+	Usage: "The first day(UTC, yyyy-mm-dd) of the usage rollups, inclusive",
+}
+
+var endDayFlag = &cli.StringFlag{
+	Name:  "end.day",
+	Usage: "The last day(UTC, yyyy-mm-dd) of the usage rollups, inclusive",
+}
+
+var dayFlag = &cli.StringFlag{
+	Name:  "day",
+	Usage: "The day(UTC, yyyy-mm-dd) of the usage rollups to reconcile, defaults to the latest rolled up day",
+}
+
+var monthFlag = &cli.StringFlag{
+	Name:     "month",
+	Usage:    "The month(UTC, yyyy-mm) of the usage statement",
+	Required: true,
+}
+
+var paymentAccountFlag = &cli.StringFlag{
+	Name:  "payment.account",
+	Usage: "The payment account address of the buckets",
+}
+
+var requiredPaymentAccountFlag = &cli.StringFlag{
+	Name:     "payment.account",
+	Usage:    "The payment account address of the buckets",
+	Required: true,
+}
+
+var bucketIDFlag = &cli.Uint64Flag{
+	Name:  "bucket.id",
+	Usage: "The ID of the bucket",
+}
+
+var outputFlag = &cli.StringFlag{
+	Name:  "output",
+	Usage: "The file path that the result is written to, defaults to stdout",
+}
+
+var formatFlag = &cli.StringFlag{
+	Name:  "format",
+	Usage: "The format of the exported usage rollups, csv or parquet",
+	Value: csvFormat,
+}
+
+var MeteringExportCmd = &cli.Command{
+	Action: CW.exportUsageAction,
+	Name:   "metering.export",
+	Usage:  "Export the daily usage rollups of the buckets as csv or parquet",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		startDayFlag,
+		endDayFlag,
+		paymentAccountFlag,
+		bucketIDFlag,
+		formatFlag,
+		outputFlag,
+	},
+	Category: meteringCommands,
+	Description: `The metering.export command reads the daily usage rollups of the buckets from SP DB, and writes ` +
+		`them as csv or parquet by --format, one row per bucket and day with the read count, read bytes and ` +
+		`stored bytes. The parquet file has the same columns as the csv, the counts and the sizes are uint64.`,
+}
+
+var MeteringStatementCmd = &cli.Command{
+	Action: CW.generateUsageStatementAction,
+	Name:   "metering.statement",
+	Usage:  "Generate the signed monthly usage statement of a payment account",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		requiredPaymentAccountFlag,
+		monthFlag,
+		outputFlag,
+	},
+	Category: meteringCommands,
+	Description: `The metering.statement command aggregates the daily usage rollups of the buckets paid by the ` +
+		`payment account in the month, and signs the keccak256 digest of the statement by the SP operator key, ` +
+		`the statement is output as json with the digest, the signer address and the signature.`,
+}
+
+var MeteringReconcileCmd = &cli.Command{
+	Action: CW.reconcileUsageAction,
+	Name:   "metering.reconcile",
+	Usage:  "Reconcile the usage rollups against the on-chain payment streams",
+	Flags: []cli.Flag{
+		utils.ConfigFileFlag,
+		dayFlag,
+		paymentAccountFlag,
+	},
+	Category: meteringCommands,
+	Description: `The metering.reconcile command compares the usage rollups of a day with the buckets and the ` +
+		`stream records of the payment accounts from metadata service, and reports the buckets whose payment ` +
+		`account or stored size differ, and the frozen payment accounts that still have usage. The stored size ` +
+		`on chain is the current one, so only the latest day is expected to match.`,
+}
+
+// UsageStatement is the monthly usage of the buckets paid by a payment account.
+type UsageStatement struct {
+	SpOperatorAddress string         `json:"sp_operator_address"`
+	PaymentAddress    string         `json:"payment_address"`
+	Month             string         `json:"month"`
+	Buckets           []*BucketUsage `json:"buckets"`
+	TotalReadCount    uint64         `json:"total_read_count"`
+	TotalReadSize     uint64         `json:"total_read_size"`
+	// TotalStoredByteDays is the sum of the daily stored sizes of the buckets.
+	TotalStoredByteDays uint64 `json:"total_stored_byte_days"`
+	GenerateTime        int64  `json:"generate_time"`
+}
+
+// BucketUsage is the usage of a bucket in the statement.
+type BucketUsage struct {
+	BucketID       uint64 `json:"bucket_id"`
+	BucketName     string `json:"bucket_name"`
+	Days           uint64 `json:"days"`
+	ReadCount      uint64 `json:"read_count"`
+	ReadSize       uint64 `json:"read_size"`
+	StoredByteDays uint64 `json:"stored_byte_days"`
+}
+
+// SignedUsageStatement is the usage statement signed by the SP operator key.
+type SignedUsageStatement struct {
+	Statement *UsageStatement `json:"statement"`
+	// Digest is the hex keccak256 digest of the json encoded statement.
+	Digest    string `json:"digest"`
+	Signer    string `json:"signer"`
+	Signature string `json:"signature"`
+}
+
+func (w *CMDWrapper) exportUsageAction(ctx *cli.Context) error {
+	if err := w.init(ctx); err != nil {
+		return err
+	}
+	filter, err := makeUsageRollupFilter(ctx.String(startDayFlag.Name), ctx.String(endDayFlag.Name))
+	if err != nil {
+		return err
+	}
+	filter.PaymentAddress = ctx.String(paymentAccountFlag.Name)
+	filter.BucketID = ctx.Uint64(bucketIDFlag.Name)
+	format := ctx.String(formatFlag.Name)
+	if format != csvFormat && format != parquetFormat {
+		return fmt.Errorf("unsupported format %s, it should be %s or %s", format, csvFormat, parquetFormat)
+	}
+
+	out, closeFunc, err := makeOutput(ctx.String(outputFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+	if format == parquetFormat {
+		return w.exportUsageParquet(filter, out)
+	}
+	return w.exportUsageCSV(filter, out)
+}
+
+func (w *CMDWrapper) exportUsageCSV(filter *spdb.UsageRollupFilter, out io.Writer) error {
+	writer := csv.NewWriter(out)
+	header := make([]string, len(usageRollupColumns))
+	for i, column := range usageRollupColumns {
+		header[i] = column.Name
+	}
+	if err := writer.Write(header); err != nil {
+		return err
+	}
+	err := w.rangeUsageRollups(filter, func(rollup *spdb.UsageRollup) error {
+		return writer.Write([]string{
+			rollup.Day,
+			strconv.FormatUint(rollup.BucketID, 10),
+			rollup.BucketName,
+			rollup.PaymentAddress,
+			strconv.FormatUint(rollup.ReadCount, 10),
+			strconv.FormatUint(rollup.ReadSize, 10),
+			strconv.FormatUint(rollup.StoredSize, 10),
+		})
+	})
+	if err != nil {
+		return err
+	}
+	writer.Flush()
+	return writer.Error()
+}
+
+func (w *CMDWrapper) exportUsageParquet(filter *spdb.UsageRollupFilter, out io.Writer) error {
+	writer := parquet.NewWriter(out, usageRollupColumns)
+	err := w.rangeUsageRollups(filter, func(rollup *spdb.UsageRollup) error {
+		return writer.Write([]interface{}{
+			rollup.Day,
+			rollup.BucketID,
+			rollup.BucketName,
+			rollup.PaymentAddress,
+			rollup.ReadCount,
+			rollup.ReadSize,
+			rollup.StoredSize,
+		})
+	})
+	if err != nil {
+		return err
+	}
+	return writer.Close()
+}
+
+func (w *CMDWrapper) generateUsageStatementAction(ctx *cli.Context) error {
+	if err := w.init(ctx); err != nil {
+		return err
+	}
+	month, err := time.Parse(meteringMonthLayout, ctx.String(monthFlag.Name))
+	if err != nil {
+		return fmt.Errorf("invalid month, error: %v", err)
+	}
+	statement, err := w.makeUsageStatement(ctx.String(requiredPaymentAccountFlag.Name), month)
+	if err != nil {
+		return err
+	}
+	provider, err := signer.NewKeyProvider(&w.config.SpAccount)
+	if err != nil {
+		return err
+	}
+	km, err := provider.KeyManager(signer.SignOperator)
+	if err != nil {
+		return err
+	}
+	signed, err := signUsageStatement(statement, km.Sign, km.GetAddr().String())
+	if err != nil {
+		return err
+	}
+
+	out, closeFunc, err := makeOutput(ctx.String(outputFlag.Name))
+	if err != nil {
+		return err
+	}
+	defer closeFunc()
+	encoder := json.NewEncoder(out)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(signed)
+}
+
+func (w *CMDWrapper) reconcileUsageAction(ctx *cli.Context) error {
+	if err := w.init(ctx); err != nil {
+		return err
+	}
+	day := ctx.String(dayFlag.Name)
+	if day == "" {
+		latest, err := w.spDBAPI.GetLatestUsageRollupDay()
+		if err != nil {
+			return err
+		}
+		if latest == "" {
+			fmt.Println("no usage rollup to reconcile")
+			return nil
+		}
+		day = latest
+	}
+	filter, err := makeUsageRollupFilter(day, day)
+	if err != nil {
+		return err
+	}
+	filter.PaymentAddress = ctx.String(paymentAccountFlag.Name)
+
+	rollupsByPayment := make(map[string][]*spdb.UsageRollup)
+	if err = w.rangeUsageRollups(filter, func(rollup *spdb.UsageRollup) error {
+		rollupsByPayment[rollup.PaymentAddress] = append(rollupsByPayment[rollup.PaymentAddress], rollup)
+		return nil
+	}); err != nil {
+		return err
+	}
+	payments := make([]string, 0, len(rollupsByPayment))
+	for payment := range rollupsByPayment {
+		payments = append(payments, payment)
+	}
+	sort.Strings(payments)
+
+	mismatches := 0
+	for _, payment := range payments {
+		results, reconcileErr := w.reconcilePaymentAccount(payment, rollupsByPayment[payment])
+		if reconcileErr != nil {
+			return reconcileErr
+		}
+		for _, result := range results {
+			fmt.Println(result)
+		}
+		mismatches += len(results)
+	}
+	fmt.Printf("reconciled %d payment accounts of day %s, %d mismatches\n", len(payments), day, mismatches)
+	return nil
+}
+
+// reconcilePaymentAccount returns the mismatches between the usage rollups of the payment account and the
+// on-chain payment stream.
+func (w *CMDWrapper) reconcilePaymentAccount(payment string, rollups []*spdb.UsageRollup) ([]string, error) {
+	var results []string
+	if payment == "" {
+		for _, rollup := range rollups {
+			results = append(results, fmt.Sprintf("bucket %d(%s): unknown payment account",
+				rollup.BucketID, rollup.BucketName))
+		}
+		return results, nil
+	}
+	buckets, err := w.grpcAPI.ListPaymentAccountStreams(context.Background(), payment)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list payment account streams, payment: %s, error: %v", payment, err)
+	}
+	chainBuckets := make(map[uint64]string, len(buckets))
+	for _, bucket := range buckets {
+		if bucket.GetBucketInfo() == nil || bucket.GetRemoved() {
+			continue
+		}
+		chainBuckets[bucket.GetBucketInfo().Id.Uint64()] = bucket.GetStorageSize()
+	}
+
+	var used bool
+	for _, rollup := range rollups {
+		if rollup.ReadCount > 0 || rollup.StoredSize > 0 {
+			used = true
+		}
+		storageSize, ok := chainBuckets[rollup.BucketID]
+		if !ok {
+			if rollup.StoredSize > 0 {
+				results = append(results, fmt.Sprintf("bucket %d(%s): not paid by payment account %s on chain",
+					rollup.BucketID, rollup.BucketName, payment))
+			}
+			continue
+		}
+		if storageSize != strconv.FormatUint(rollup.StoredSize, 10) {
+			results = append(results, fmt.Sprintf("bucket %d(%s): stored size %d differs from %s on chain",
+				rollup.BucketID, rollup.BucketName, rollup.StoredSize, storageSize))
+		}
+	}
+	if !used || len(chainBuckets) == 0 {
+		return results, nil
+	}
+	for bucketID := range chainBuckets {
+		streamRecord, queryErr := w.grpcAPI.GetPaymentByBucketID(context.Background(), int64(bucketID), true)
+		if queryErr != nil {
+			return nil, fmt.Errorf("failed to get payment stream record, bucket_id: %d, error: %v", bucketID, queryErr)
+		}
+		if streamRecord != nil && streamRecord.GetStatus() == paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN {
+			results = append(results, fmt.Sprintf("payment account %s: frozen on chain but has usage", payment))
+		}
+		break
+	}
+	return results, nil
+}
+
+// makeUsageStatement aggregates the usage rollups of the payment account in the month.
+func (w *CMDWrapper) makeUsageStatement(payment string, month time.Time) (*UsageStatement, error) {
+	filter := &spdb.UsageRollupFilter{
+		StartDay:       month.Format(meteringDayLayout),
+		EndDay:         month.AddDate(0, 1, -1).Format(meteringDayLayout),
+		PaymentAddress: payment,
+	}
+	statement := &UsageStatement{
+		SpOperatorAddress: w.config.SpAccount.SpOperatorAddress,
+		PaymentAddress:    payment,
+		Month:             month.Format(meteringMonthLayout),
+		Buckets:           make([]*BucketUsage, 0),
+		GenerateTime:      time.Now().Unix(),
+	}
+	bucketUsages := make(map[uint64]*BucketUsage)
+	err := w.rangeUsageRollups(filter, func(rollup *spdb.UsageRollup) error {
+		usage, ok := bucketUsages[rollup.BucketID]
+		if !ok {
+			usage = &BucketUsage{BucketID: rollup.BucketID}
+			bucketUsages[rollup.BucketID] = usage
+			statement.Buckets = append(statement.Buckets, usage)
+		}
+		usage.BucketName = rollup.BucketName
+		usage.Days++
+		usage.ReadCount += rollup.ReadCount
+		usage.ReadSize += rollup.ReadSize
+		usage.StoredByteDays += rollup.StoredSize
+		statement.TotalReadCount += rollup.ReadCount
+		statement.TotalReadSize += rollup.ReadSize
+		statement.TotalStoredByteDays += rollup.StoredSize
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(statement.Buckets, func(i, j int) bool {
+		return statement.Buckets[i].BucketID < statement.Buckets[j].BucketID
+	})
+	return statement, nil
+}
+
+// rangeUsageRollups calls f with the usage rollups matching the filter page by page.
+func (w *CMDWrapper) rangeUsageRollups(filter *spdb.UsageRollupFilter, f func(rollup *spdb.UsageRollup) error) error {
+	for offset := 0; ; offset += meteringPageSize {
+		rollups, err := w.spDBAPI.ListUsageRollups(filter, offset, meteringPageSize)
+		if err != nil {
+			return fmt.Errorf("failed to list usage rollups, error: %v", err)
+		}
+		for _, rollup := range rollups {
+			if err = f(rollup); err != nil {
+				return err
+			}
+		}
+		if len(rollups) < meteringPageSize {
+			return nil
+		}
+	}
+}
+
+// signUsageStatement signs the keccak256 digest of the json encoded statement.
+func signUsageStatement(statement *UsageStatement, sign func(msg []byte) ([]byte, error), signerAddr string) (
+	*SignedUsageStatement, error) {
+	data, err := json.Marshal(statement)
+	if err != nil {
+		return nil, err
+	}
+	digest := crypto.Keccak256(data)
+	signature, err := sign(digest)
+	if err != nil {
+		return nil, fmt.Errorf("failed to sign usage statement, error: %v", err)
+	}
+	return &SignedUsageStatement{
+		Statement: statement,
+		Digest:    hex.EncodeToString(digest),
+		Signer:    signerAddr,
+		Signature: hex.EncodeToString(signature),
+	}, nil
+}
+
+func makeUsageRollupFilter(startDay, endDay string) (*spdb.UsageRollupFilter, error) {
+	for _, day := range []string{startDay, endDay} {
+		if day == "" {
+			continue
+		}
+		if _, err := time.Parse(meteringDayLayout, day); err != nil {
+			return nil, fmt.Errorf("invalid day %s, error: %v", day, err)
+		}
+	}
+	if startDay != "" && endDay != "" && strings.Compare(startDay, endDay) > 0 {
+		return nil, fmt.Errorf("start day %s is after end day %s", startDay, endDay)
+	}
+	return &spdb.UsageRollupFilter{StartDay: startDay, EndDay: endDay}, nil
+}
+
+func makeOutput(path string) (io.Writer, func(), error) {
+	if path == "" {
+		return os.Stdout, func() {}, nil
+	}
+	f, err := os.Create(path)
+	if err != nil {
+		return nil, nil, err
+	}
+	return f, func() { _ = f.Close() }, nil
+}
@@ -0,0 +1,184 @@
+package command
+
+import (
+	"encoding/hex"
+	"encoding/json"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/ethereum/go-ethereum/crypto"
+	"github.com/stretchr/testify/assert"
+	"github.com/urfave/cli/v2"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	paymenttypes "github.com/bnb-chain/greenfield/x/payment/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const mockMeteringPrivKey = "e3ac46e277677f0f103774019d03bd89c7b4b5ecc554b2650bd5d5127992c20c"
+
+func TestMeteringExport(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	CW.config = &gfspconfig.GfSpConfig{}
+	CW.grpcAPI = gfspclient.NewMockGfSpClientAPI(ctrl)
+	mockDBAPI := spdb.NewMockSPDB(ctrl)
+	CW.spDBAPI = mockDBAPI
+	mockDBAPI.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-08-01", EndDay: "2023-08-31",
+		PaymentAddress: "0x01"}, 0, meteringPageSize).Return([]*spdb.UsageRollup{
+		{BucketID: 1, Day: "2023-08-01", BucketName: "mock-bucket", PaymentAddress: "0x01", ReadCount: 2,
+			ReadSize: 200, StoredSize: 1000},
+	}, nil).Times(1)
+
+	output := filepath.Join(t.TempDir(), "usage.csv")
+	app := cli.NewApp()
+	app.Commands = []*cli.Command{
+		MeteringExportCmd,
+	}
+	err := app.Run([]string{"./gnfd-sp", "metering.export", "--start.day", "2023-08-01", "--end.day", "2023-08-31",
+		"--payment.account", "0x01", "--output", output})
+	assert.Nil(t, err)
+	data, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Equal(t, "day,bucket_id,bucket_name,payment_address,read_count,read_size,stored_size\n"+
+		"2023-08-01,1,mock-bucket,0x01,2,200,1000\n", string(data))
+
+	mockDBAPI.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-08-01", EndDay: "2023-08-31",
+		BucketID: 1}, 0, meteringPageSize).Return([]*spdb.UsageRollup{
+		{BucketID: 1, Day: "2023-08-01", BucketName: "mock-bucket", PaymentAddress: "0x01", ReadCount: 2,
+			ReadSize: 200, StoredSize: 1000},
+	}, nil).Times(1)
+	output = filepath.Join(t.TempDir(), "usage.parquet")
+	err = app.Run([]string{"./gnfd-sp", "metering.export", "--start.day", "2023-08-01", "--end.day", "2023-08-31",
+		"--bucket.id", "1", "--format", "parquet", "--output", output})
+	assert.Nil(t, err)
+	data, err = os.ReadFile(output)
+	assert.Nil(t, err)
+	assert.Equal(t, "PAR1", string(data[:4]))
+	assert.Equal(t, "PAR1", string(data[len(data)-4:]))
+	assert.Contains(t, string(data), "\x0a\x00\x00\x002023-08-01")
+	assert.Contains(t, string(data), "stored_size")
+
+	// failed due to unsupported format
+	err = app.Run([]string{"./gnfd-sp", "metering.export", "--format", "json"})
+	assert.NotNil(t, err)
+	// failed due to invalid day
+	err = app.Run([]string{"./gnfd-sp", "metering.export", "--start.day", "2023-08-32"})
+	assert.NotNil(t, err)
+	// failed due to start day is after end day
+	err = app.Run([]string{"./gnfd-sp", "metering.export", "--start.day", "2023-08-02", "--end.day", "2023-08-01"})
+	assert.NotNil(t, err)
+}
+
+func TestMeteringStatement(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	CW.config = &gfspconfig.GfSpConfig{SpAccount: gfspconfig.SpAccountConfig{
+		SpOperatorAddress:  "0x01",
+		OperatorPrivateKey: mockMeteringPrivKey,
+	}}
+	CW.grpcAPI = gfspclient.NewMockGfSpClientAPI(ctrl)
+	mockDBAPI := spdb.NewMockSPDB(ctrl)
+	CW.spDBAPI = mockDBAPI
+	mockDBAPI.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-02-01", EndDay: "2023-02-28",
+		PaymentAddress: "0x02"}, 0, meteringPageSize).Return([]*spdb.UsageRollup{
+		{BucketID: 2, Day: "2023-02-01", BucketName: "mock-bucket-2", ReadCount: 1, ReadSize: 10, StoredSize: 100},
+		{BucketID: 1, Day: "2023-02-01", BucketName: "mock-bucket-1", StoredSize: 1000},
+		{BucketID: 2, Day: "2023-02-02", BucketName: "mock-bucket-2", ReadCount: 2, ReadSize: 20, StoredSize: 200},
+	}, nil).Times(1)
+
+	output := filepath.Join(t.TempDir(), "statement.json")
+	app := cli.NewApp()
+	app.Commands = []*cli.Command{
+		MeteringStatementCmd,
+	}
+	err := app.Run([]string{"./gnfd-sp", "metering.statement", "--payment.account", "0x02", "--month", "2023-02",
+		"--output", output})
+	assert.Nil(t, err)
+	data, err := os.ReadFile(output)
+	assert.Nil(t, err)
+	signed := &SignedUsageStatement{}
+	assert.Nil(t, json.Unmarshal(data, signed))
+	assert.Equal(t, "2023-02", signed.Statement.Month)
+	assert.Equal(t, uint64(3), signed.Statement.TotalReadCount)
+	assert.Equal(t, uint64(30), signed.Statement.TotalReadSize)
+	assert.Equal(t, uint64(1300), signed.Statement.TotalStoredByteDays)
+	assert.Equal(t, []*BucketUsage{
+		{BucketID: 1, BucketName: "mock-bucket-1", Days: 1, StoredByteDays: 1000},
+		{BucketID: 2, BucketName: "mock-bucket-2", Days: 2, ReadCount: 3, ReadSize: 30, StoredByteDays: 300},
+	}, signed.Statement.Buckets)
+
+	// the signature is recovered to the operator address
+	statement, _ := json.Marshal(signed.Statement)
+	digest := crypto.Keccak256(statement)
+	assert.Equal(t, hex.EncodeToString(digest), signed.Digest)
+	signature, err := hex.DecodeString(signed.Signature)
+	assert.Nil(t, err)
+	pubKey, err := crypto.SigToPub(digest, signature)
+	assert.Nil(t, err)
+	privKey, _ := crypto.HexToECDSA(mockMeteringPrivKey)
+	assert.Equal(t, crypto.PubkeyToAddress(privKey.PublicKey), crypto.PubkeyToAddress(*pubKey))
+
+	// failed due to invalid month
+	err = app.Run([]string{"./gnfd-sp", "metering.statement", "--payment.account", "0x02", "--month", "2023-13"})
+	assert.NotNil(t, err)
+}
+
+func TestMeteringReconcile(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	CW.config = &gfspconfig.GfSpConfig{}
+	mockGRPCAPI := gfspclient.NewMockGfSpClientAPI(ctrl)
+	CW.grpcAPI = mockGRPCAPI
+	mockDBAPI := spdb.NewMockSPDB(ctrl)
+	CW.spDBAPI = mockDBAPI
+	mockDBAPI.EXPECT().GetLatestUsageRollupDay().Return("2023-08-01", nil).Times(1)
+	mockDBAPI.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-08-01", EndDay: "2023-08-01"}, 0,
+		meteringPageSize).Return([]*spdb.UsageRollup{
+		{BucketID: 1, Day: "2023-08-01", PaymentAddress: "0x01", StoredSize: 1000},
+		{BucketID: 2, Day: "2023-08-01", PaymentAddress: "0x01", ReadCount: 1, StoredSize: 100},
+		{BucketID: 3, Day: "2023-08-01", PaymentAddress: "0x01", StoredSize: 10},
+	}, nil).Times(1)
+	mockGRPCAPI.EXPECT().ListPaymentAccountStreams(gomock.Any(), "0x01").Return([]*types.Bucket{
+		{BucketInfo: &storagetypes.BucketInfo{Id: sdkmath.NewUint(1)}, StorageSize: "1000"},
+		{BucketInfo: &storagetypes.BucketInfo{Id: sdkmath.NewUint(2)}, StorageSize: "200"},
+	}, nil).Times(1)
+	mockGRPCAPI.EXPECT().GetPaymentByBucketID(gomock.Any(), gomock.Any(), true).Return(&paymenttypes.StreamRecord{
+		Status: paymenttypes.STREAM_ACCOUNT_STATUS_FROZEN}, nil).Times(1)
+
+	results, err := CW.reconcilePaymentAccount("0x01", []*spdb.UsageRollup{
+		{BucketID: 1, Day: "2023-08-01", PaymentAddress: "0x01", StoredSize: 1000},
+		{BucketID: 2, Day: "2023-08-01", PaymentAddress: "0x01", ReadCount: 1, StoredSize: 100},
+		{BucketID: 3, Day: "2023-08-01", PaymentAddress: "0x01", StoredSize: 10},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(results))
+
+	mockGRPCAPI.EXPECT().ListPaymentAccountStreams(gomock.Any(), "0x01").Return(nil, nil).Times(1)
+	app := cli.NewApp()
+	app.Commands = []*cli.Command{
+		MeteringReconcileCmd,
+	}
+	err = app.Run([]string{"./gnfd-sp", "metering.reconcile"})
+	assert.Nil(t, err)
+}
+
+func TestMakeUsageStatementMonthRange(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	defer ctrl.Finish()
+	CW.config = &gfspconfig.GfSpConfig{}
+	mockDBAPI := spdb.NewMockSPDB(ctrl)
+	CW.spDBAPI = mockDBAPI
+	mockDBAPI.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-12-01", EndDay: "2023-12-31",
+		PaymentAddress: "0x01"}, 0, meteringPageSize).Return(nil, nil).Times(1)
+	statement, err := CW.makeUsageStatement("0x01", time.Date(2023, 12, 1, 0, 0, 0, 0, time.UTC))
+	assert.Nil(t, err)
+	assert.Empty(t, statement.Buckets)
+}
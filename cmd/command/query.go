@@ -6,6 +6,7 @@ import (
 	"encoding/hex"
 	"encoding/json"
 	"fmt"
+	"io"
 	"os"
 	"time"
 
@@ -248,12 +249,20 @@ func (w *CMDWrapper) getObjectAction(ctx *cli.Context) error {
 	task := &gfsptask.GfSpDownloadObjectTask{}
 	task.InitDownloadObjectTask(objectInfo, bucketInfo, params, coretask.UnSchedulingPriority,
 		GfSpCliUserName, 0, int64(objectInfo.GetPayloadSize()-1), 0, 0)
-	data, err := w.grpcAPI.GetObject(context.Background(), task)
+	reader, err := w.grpcAPI.GetObject(context.Background(), task)
 	if err != nil {
 		return fmt.Errorf("failed to get object, error: %v", err)
 	}
-	if err = os.WriteFile("./"+objectInfo.GetObjectName(), data, os.ModePerm); err != nil {
+	defer reader.Close()
+	file, err := os.OpenFile("./"+objectInfo.GetObjectName(), os.O_CREATE|os.O_WRONLY|os.O_TRUNC, os.ModePerm)
+	if err != nil {
 		fmt.Printf("failed to create file to wirte object payload data, error: %v", err)
+	} else {
+		_, err = io.Copy(file, reader)
+		_ = file.Close()
+		if err != nil {
+			return fmt.Errorf("failed to get object, error: %v", err)
+		}
 	}
 	fmt.Printf("succeed to get object\n\n"+
 		"BucketInfo: %s\n\n "+
@@ -1,7 +1,9 @@
 package command
 
 import (
+	"bytes"
 	"fmt"
+	"io"
 	"os"
 	"testing"
 
@@ -86,7 +88,7 @@ func TestGetObject(t *testing.T) {
 	o1 := mockConsensusAPI.EXPECT().QueryObjectInfoByID(gomock.Any(), gomock.Any()).Return(&storagetypes.ObjectInfo{}, nil)
 	o2 := mockConsensusAPI.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{}, nil)
 	o3 := mockConsensusAPI.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(&storagetypes.Params{}, nil)
-	o4 := mockGRPCAPI.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return([]byte{1}, nil)
+	o4 := mockGRPCAPI.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(bytes.NewReader([]byte{1})), nil)
 	gomock.InOrder(o1, o2, o3, o4)
 
 	app := cli.NewApp()
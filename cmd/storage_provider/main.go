@@ -8,6 +8,7 @@ import (
 	"github.com/urfave/cli/v2"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	"github.com/bnb-chain/greenfield-storage-provider/cmd/command"
 	"github.com/bnb-chain/greenfield-storage-provider/cmd/command/bs_data_migration"
 	"github.com/bnb-chain/greenfield-storage-provider/cmd/utils"
@@ -99,6 +100,7 @@ func init() {
 		VersionCmd,
 		// config category commands
 		command.ConfigDumpCmd,
+		command.ConfigReloadCmd,
 		// query category commands
 		command.ListModulesCmd,
 		command.ListErrorsCmd,
@@ -113,8 +115,15 @@ func init() {
 		// query primary and secondary SP income details
 		command.QueryPrimarySPIncomeCmd,
 		command.QuerySecondarySPIncomeCmd,
+		// metering commands
+		command.MeteringExportCmd,
+		command.MeteringStatementCmd,
+		command.MeteringReconcileCmd,
 		// p2p category commands
 		command.P2PCreateKeysCmd,
+		// keystore category commands
+		command.KeystoreCreateCmd,
+		command.KeystoreRotateCmd,
 		// debug commands
 		command.DebugCreateBucketApprovalCmd,
 		command.DebugCreateObjectApprovalCmd,
@@ -131,6 +140,9 @@ func init() {
 		command.SetQuotaCmd,
 		// block syncer
 		bs_data_migration.BsDataMigrationCmd,
+		command.BlockSyncerRebuildCmd,
+		command.BlockSyncerVerifyCmd,
+		command.BlockSyncerIndexCmd,
 		// be related to sp exit
 		command.SpExitCmd,
 		command.CompleteSpExitCmd,
@@ -172,5 +184,8 @@ func storageProvider(ctx *cli.Context) error {
 		log.Errorw("failed to init gf-sp app", "error", err)
 		return err
 	}
+	gfsp.SetConfigLoader(func() (*gfspconfig.GfSpConfig, error) {
+		return utils.ReloadConfig(ctx)
+	})
 	return gfsp.Start(context.Background())
 }
@@ -69,8 +69,30 @@ func MakeEnv(ctx *cli.Context, cfg *gfspconfig.GfSpConfig) error {
 	return nil
 }
 
+// ReloadConfig loads the configuration in the same way as MakeConfig and MakeEnv but does not init the
+// runtime environment, it is used to reload the configuration of the running storage provider.
+func ReloadConfig(ctx *cli.Context) (*gfspconfig.GfSpConfig, error) {
+	cfg, err := MakeConfig(ctx)
+	if err != nil {
+		return nil, err
+	}
+	makeLogConfig(ctx, cfg)
+	return cfg, nil
+}
+
 // initLog inits the log configuration from config file and command flags.
 func initLog(ctx *cli.Context, cfg *gfspconfig.GfSpConfig) error {
+	makeLogConfig(ctx, cfg)
+	level, err := log.ParseLevel(cfg.Log.Level)
+	if err != nil {
+		return err
+	}
+	log.Init(level, cfg.Log.Path)
+	return nil
+}
+
+// makeLogConfig fills the log configuration from command flags and the default values.
+func makeLogConfig(ctx *cli.Context, cfg *gfspconfig.GfSpConfig) {
 	if cfg.Log.Level == "" {
 		cfg.Log.Level = "debug"
 	}
@@ -86,12 +108,6 @@ func initLog(ctx *cli.Context, cfg *gfspconfig.GfSpConfig) error {
 	if ctx.IsSet(LogStdOutputFlag.Name) {
 		cfg.Log.Path = ""
 	}
-	level, err := log.ParseLevel(cfg.Log.Level)
-	if err != nil {
-		return err
-	}
-	log.Init(level, cfg.Log.Path)
-	return nil
 }
 
 func MakeGfSpClient(cfg *gfspconfig.GfSpConfig) *gfspclient.GfSpClient {
@@ -61,6 +61,8 @@ const (
 	AuthOpTypeAgentPutObject
 	// AuthOpTypeAgentUpdateObject  defines the agent UpdateObject operator
 	AuthOpTypeAgentUpdateObject
+	// AuthOpTypeManageBucketNotification defines the operator of managing and streaming the bucket notifications
+	AuthOpTypeManageBucketNotification
 )
 
 // Authenticator is an abstract interface to verify users authentication.
@@ -130,9 +132,10 @@ type Downloader interface {
 	// PreDownloadObject prepares to handle DownloadObject, it can do some checks
 	// such as checking for duplicates, if limitation of SP has been reached, etc.
 	PreDownloadObject(ctx context.Context, task task.DownloadObjectTask) error
-	// HandleDownloadObjectTask handles the DownloadObject and get data from piece store.
-	HandleDownloadObjectTask(ctx context.Context, task task.DownloadObjectTask) ([]byte, error)
-	// PostDownloadObject is called after HandleDownloadObjectTask, it can recycle
+	// HandleDownloadObjectTask handles the DownloadObject, gets data from piece store and writes it to w
+	// in order, the following segments may be prefetched while the current one is being written.
+	HandleDownloadObjectTask(ctx context.Context, task task.DownloadObjectTask, w io.Writer) error
+	// PostDownloadObject is called after HandleDownloadObjectTask whether it succeeds or not, it can recycle
 	// resources, make statistics and do some other operations..
 	PostDownloadObject(ctx context.Context, task task.DownloadObjectTask)
 
@@ -141,7 +144,7 @@ type Downloader interface {
 	PreDownloadPiece(ctx context.Context, task task.DownloadPieceTask) error
 	// HandleDownloadPieceTask handles the DownloadPiece and get data from piece store.
 	HandleDownloadPieceTask(ctx context.Context, task task.DownloadPieceTask) ([]byte, error)
-	// PostDownloadPiece is called after HandleDownloadPieceTask, it can recycle
+	// PostDownloadPiece is called after HandleDownloadPieceTask whether it succeeds or not, it can recycle
 	// resources, make statistics and do some other operations.
 	PostDownloadPiece(ctx context.Context, task task.DownloadPieceTask)
 	// PreChallengePiece prepares to handle ChallengePiece, it can do some checks
@@ -189,6 +192,21 @@ type Manager interface {
 	// DispatchTask dispatches the task to TaskExecutor module when it asks tasks.
 	// It will consider task remaining resources when dispatching task.
 	DispatchTask(ctx context.Context, limit rcmgr.Limit) (task.Task, error)
+	// LeaseTask leases a task below the limit to the dispatch stream session of an executor, it returns the lease
+	// id and the unix time that the lease expires at unless the executor renews it. The leases are owned by the
+	// session, so the leases of a reconnected executor are not touched when its broken stream is closed.
+	LeaseTask(ctx context.Context, sessionID string, limit rcmgr.Limit) (task.Task, string, int64, error)
+	// RenewTaskLeases renews the leases held by the session, it returns the new expire time and the leases
+	// that the session does not hold any more.
+	RenewTaskLeases(ctx context.Context, sessionID string, leaseIDs []string) (int64, []string)
+	// ReleaseTaskLeases releases the leases of the tasks that the session has finished.
+	ReleaseTaskLeases(ctx context.Context, sessionID string, leaseIDs []string)
+	// RevokeTaskLeases revokes the leases of the session, all of them if leaseIDs is empty, the tasks of the
+	// revoked leases can be dispatched again at once.
+	RevokeTaskLeases(ctx context.Context, sessionID string, leaseIDs []string)
+	// RejectTaskLeases revokes the leases that the session rejects before starting the tasks, the retries
+	// counted by leasing the tasks are given back.
+	RejectTaskLeases(ctx context.Context, sessionID string, leaseIDs []string)
 	// QueryTasks queries tasks that hold on manager by task sub-key.
 	QueryTasks(ctx context.Context, subKey task.TKey) ([]task.Task, error)
 	// QueryBucketMigrate queries tasks that hold on manager by task sub-key.
@@ -244,6 +262,8 @@ type Manager interface {
 	HandleMigrateGVGTask(ctx context.Context, task task.MigrateGVGTask) error
 	// QueryTasksStats queries tasks stats from Manager server
 	QueryTasksStats(ctx context.Context) (int, int, int, int, int, int, int, []string)
+	// QueryTaskSchedulerStats queries the policy and the dispatch shares of the task types from Manager server
+	QueryTaskSchedulerStats(ctx context.Context) *gfspserver.TaskSchedulerStats
 	// QueryBucketMigrationProgress queries migration progress from Manager server
 	QueryBucketMigrationProgress(ctx context.Context, bucketID uint64) (*gfspserver.MigrateBucketProgressMeta, error)
 	// NotifyPreMigrateBucketAndDeductQuota is used to notify src sp pre migrate bucket and deduct quota.
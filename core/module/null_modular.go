@@ -77,6 +77,10 @@ func (m *NullModular) QueryTasksStats(ctx context.Context) (int, int, int, int,
 	return 0, 0, 0, 0, 0, 0, 0, nil
 }
 
+func (m *NullModular) QueryTaskSchedulerStats(ctx context.Context) *gfspserver.TaskSchedulerStats {
+	return nil
+}
+
 func (m *NullModular) QueryBucketMigrationProgress(ctx context.Context, bucketID uint64) (*gfspserver.MigrateBucketProgressMeta, error) {
 	return &gfspserver.MigrateBucketProgressMeta{}, nil
 }
@@ -124,6 +128,15 @@ func (*NullModular) PostUploadObject(ctx context.Context, task task.UploadObject
 func (*NullModular) DispatchTask(context.Context, rcmgr.Limit) (task.Task, error) {
 	return nil, ErrNilModular
 }
+func (*NullModular) LeaseTask(context.Context, string, rcmgr.Limit) (task.Task, string, int64, error) {
+	return nil, "", 0, ErrNilModular
+}
+func (*NullModular) RenewTaskLeases(context.Context, string, []string) (int64, []string) {
+	return 0, nil
+}
+func (*NullModular) ReleaseTaskLeases(context.Context, string, []string) {}
+func (*NullModular) RevokeTaskLeases(context.Context, string, []string)  {}
+func (*NullModular) RejectTaskLeases(context.Context, string, []string)  {}
 func (*NullModular) QueryTask(context.Context, task.TKey) (task.Task, error) {
 	return nil, ErrNilModular
 }
@@ -226,8 +239,8 @@ func (*NilModular) QueryTasks(ctx context.Context, keyPrefix task.TKey) ([]task.
 func (*NilModular) PreDownloadObject(context.Context, task.DownloadObjectTask) error {
 	return ErrNilModular
 }
-func (*NilModular) HandleDownloadObjectTask(context.Context, task.DownloadObjectTask) ([]byte, error) {
-	return nil, ErrNilModular
+func (*NilModular) HandleDownloadObjectTask(context.Context, task.DownloadObjectTask, io.Writer) error {
+	return ErrNilModular
 }
 func (*NilModular) PostDownloadObject(context.Context, task.DownloadObjectTask) {}
 
@@ -15,6 +15,7 @@ func TestNullModular(t *testing.T) {
 	_, _ = n.QueryTasks(context.TODO(), "")
 	_, _ = n.QueryBucketMigrate(context.TODO())
 	_, _ = n.QuerySpExit(context.TODO())
+	_ = n.QueryTaskSchedulerStats(context.TODO())
 	_ = n.PreCreateBucketApproval(context.TODO(), nil)
 	_, _ = n.HandleCreateBucketApprovalTask(context.TODO(), nil)
 	n.PostCreateBucketApproval(context.TODO(), nil)
@@ -37,6 +38,11 @@ func TestNullModular(t *testing.T) {
 	_ = n.HandleUploadObjectTask(context.TODO(), nil, nil)
 	n.PostUploadObject(context.TODO(), nil)
 	_, _ = n.DispatchTask(context.TODO(), nil)
+	_, _, _, _ = n.LeaseTask(context.TODO(), "", nil)
+	_, _ = n.RenewTaskLeases(context.TODO(), "", nil)
+	n.ReleaseTaskLeases(context.TODO(), "", nil)
+	n.RevokeTaskLeases(context.TODO(), "", nil)
+	n.RejectTaskLeases(context.TODO(), "", nil)
 	_, _ = n.QueryTask(context.TODO(), "")
 	_ = n.HandleCreateUploadObjectTask(context.TODO(), nil)
 	_ = n.HandleDoneUploadObjectTask(context.TODO(), nil)
@@ -67,7 +73,7 @@ func TestNilModular(t *testing.T) {
 	n.ReleaseResource(context.TODO(), nil)
 	_, _ = n.QueryTasks(context.TODO(), "")
 	_ = n.PreDownloadObject(context.TODO(), nil)
-	_, _ = n.HandleDownloadObjectTask(context.TODO(), nil)
+	_ = n.HandleDownloadObjectTask(context.TODO(), nil, nil)
 	n.PostDownloadObject(context.TODO(), nil)
 	_ = n.PreDownloadPiece(context.TODO(), nil)
 	_, _ = n.HandleDownloadPieceTask(context.TODO(), nil)
@@ -8,6 +8,20 @@ const (
 	PrimarySPRedundancyIndex = -1
 )
 
+type downloadReadKey struct{}
+
+// ContextWithDownloadRead marks the piece reads with the returned context as serving the user downloads,
+// the piece store that places pieces across tiers uses them to track the access frequency of pieces.
+func ContextWithDownloadRead(ctx context.Context) context.Context {
+	return context.WithValue(ctx, downloadReadKey{}, true)
+}
+
+// IsDownloadRead returns whether the piece read with the context serves the user downloads.
+func IsDownloadRead(ctx context.Context) bool {
+	downloadRead, _ := ctx.Value(downloadReadKey{}).(bool)
+	return downloadRead
+}
+
 // PieceOp is a helper interface for piece key operator and piece size calculate.
 //
 //go:generate mockgen -source=./piecestore.go -destination=./piecestore_mock.go -package=piecestore
@@ -79,6 +79,28 @@ type ReadRecord struct {
 	ReadTimestampUs int64
 }
 
+// ReadRecordAudit defines a change of the read quota held by a read record, the reserved quota of a
+// read record is settled by a commit, a release or an expiry, and the remained quota is refunded.
+type ReadRecordAudit struct {
+	ReadRecordID uint64
+	Action       string
+	Size         uint64
+	TimestampUs  int64
+}
+
+const (
+	// ReadQuotaActionReserve is the action that reserves the quota before the data is served.
+	ReadQuotaActionReserve = "reserve"
+	// ReadQuotaActionCommit is the action that consumes the quota of the data that has been served.
+	ReadQuotaActionCommit = "commit"
+	// ReadQuotaActionRefund is the action that refunds the reserved quota of the data that has not been served.
+	ReadQuotaActionRefund = "refund"
+	// ReadQuotaActionRelease is the action that refunds all the reserved quota if no data is served.
+	ReadQuotaActionRelease = "release"
+	// ReadQuotaActionExpire is the action that refunds all the reserved quota if the reservation is not settled in time.
+	ReadQuotaActionExpire = "expire"
+)
+
 // BucketQuota defines read quota of a bucket.
 type BucketQuota struct {
 	ChargedQuotaSize     uint64 // the charged quota of bucket on greenfield chain meta
@@ -186,6 +208,78 @@ type RecoverFailedObject struct {
 	RetryTime       int
 }
 
+// ScrubCursor records the progress of scrubbing the pieces that the SP holds in a global virtual group,
+// the scrubber resumes from StartAfter after restarting, and starts a new round after a full sweep.
+type ScrubCursor struct {
+	VirtualGroupID  uint32
+	RedundancyIndex int32
+	StartAfter      uint64
+	Round           uint64
+	ScrubbedObjects uint64
+	CorruptedPieces uint64
+	UpdateTime      int64
+}
+
+// ReadUsage is the read usage of a bucket aggregated from the read records in a time range.
+type ReadUsage struct {
+	BucketID   uint64
+	BucketName string
+	ReadCount  uint64
+	ReadSize   uint64
+}
+
+// UsageRollup is the usage of a bucket in a UTC day metered by the SP, the Day is formatted like "2023-08-01",
+// the StoredSize is the snapshot of the bucket storage size when the day is rolled up.
+type UsageRollup struct {
+	BucketID       uint64
+	Day            string
+	BucketName     string
+	PaymentAddress string
+	ReadCount      uint64
+	ReadSize       uint64
+	StoredSize     uint64
+	UpdateTime     int64
+}
+
+// UsageRollupFilter filters the usage rollups, the days are inclusive and the zero value fields are ignored.
+type UsageRollupFilter struct {
+	StartDay       string
+	EndDay         string
+	BucketID       uint64
+	PaymentAddress string
+}
+
+// NotificationSubscription is the subscription of the notification events of a bucket, the events are
+// delivered to the webhook url and signed by the secret.
+type NotificationSubscription struct {
+	ID         uint64
+	BucketName string
+	// EventTypes is the subscribed event types, all the event types are subscribed if it is empty.
+	EventTypes []string
+	// Prefix filters the object events by the object name prefix.
+	Prefix     string
+	WebhookURL string
+	Secret     string
+	Creator    string
+	CreateTime int64
+	// DeliveredEventID is the id of the last notification event that has been delivered to the subscription,
+	// the events after it are delivered in order.
+	DeliveredEventID uint64
+}
+
+// NotificationDeadLetter is the notification that fails to be delivered after retrying.
+type NotificationDeadLetter struct {
+	ID             uint64
+	SubscriptionID uint64
+	EventID        uint64
+	BucketName     string
+	WebhookURL     string
+	Payload        string
+	Attempts       uint32
+	LastError      string
+	CreateTime     int64
+}
+
 // MigrateBucketProgressMeta is used to record migrate bucket progress meta.
 type MigrateBucketProgressMeta struct {
 	BucketID              uint64 // as primary key
@@ -1,6 +1,7 @@
 package spdb
 
 import (
+	"context"
 	"time"
 
 	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
@@ -21,6 +22,16 @@ type SPDB interface {
 	OffChainAuthKeyV2DB
 	MigrateDB
 	ExitRecoverDB
+	ScrubDB
+	MeteringDB
+	NotificationDB
+}
+
+// ContextSPDB is implemented by the SPDB that runs its statements in a context.
+type ContextSPDB interface {
+	// WithContext returns the SPDB whose statements are traced as the children of the span in ctx, the
+	// statements are not canceled with ctx.
+	WithContext(ctx context.Context) SPDB
 }
 
 // UploadObjectProgressDB interface which records upload object related progress(includes foreground and background) and state.
@@ -131,6 +142,18 @@ type TrafficDB interface {
 	// whether the added traffic record exceeds the quota, if it exceeds the quota,
 	// it will return error, Otherwise, add a record and return nil.
 	CheckQuotaAndAddReadRecord(record *ReadRecord, quota *BucketQuota) error
+	// ReserveReadQuota checks and deducts the quota of the record's read size like CheckQuotaAndAddReadRecord,
+	// and holds it as a reservation until it is committed, released, or expired at expireTimeUs. It returns
+	// the id of the added read record which identifies the reservation.
+	ReserveReadQuota(record *ReadRecord, quota *BucketQuota, expireTimeUs int64) (uint64, error)
+	// CommitReadQuota settles the reservation by the consumed size, and refunds the remained quota.
+	CommitReadQuota(readRecordID, consumedSize uint64) error
+	// ReleaseReadQuota settles the reservation without consuming, and refunds all the reserved quota.
+	ReleaseReadQuota(readRecordID uint64) error
+	// ExpireReadQuota refunds the reservations that expire before ts with limit, and returns the number of them.
+	ExpireReadQuota(ts int64, limit int) (int, error)
+	// GetReadRecordAudit return the quota changes of the read record in order.
+	GetReadRecordAudit(readRecordID uint64) ([]*ReadRecordAudit, error)
 	// InitBucketTraffic init the traffic info
 	InitBucketTraffic(record *ReadRecord, quota *BucketQuota) error
 	// GetBucketTraffic return bucket traffic info,
@@ -291,3 +314,46 @@ type ExitRecoverDB interface {
 	// CountRecoverFailedObject return the failed object total count
 	CountRecoverFailedObject() (int64, error)
 }
+
+// ScrubDB is used to persist the sweep cursors of the background piece scrubber.
+type ScrubDB interface {
+	// GetScrubCursor returns the scrub cursor of the gvg and redundancy index.
+	GetScrubCursor(gvgID uint32, redundancyIndex int32) (*ScrubCursor, error)
+	// UpdateScrubCursor inserts or updates the scrub cursor.
+	UpdateScrubCursor(cursor *ScrubCursor) error
+	// DeleteScrubCursor deletes the scrub cursor of the gvg and redundancy index.
+	DeleteScrubCursor(gvgID uint32, redundancyIndex int32) error
+}
+
+// MeteringDB is used to persist the daily usage rollups of the buckets for the billing.
+type MeteringDB interface {
+	// AggregateReadUsage aggregates the read records in [startTimestampUs, endTimestampUs) by bucket.
+	AggregateReadUsage(startTimestampUs, endTimestampUs int64) ([]*ReadUsage, error)
+	// UpdateUsageRollups inserts or updates the usage rollups in one transaction.
+	UpdateUsageRollups(rollups []*UsageRollup) error
+	// ListUsageRollups lists the usage rollups matching the filter, ordered by day and bucket id.
+	ListUsageRollups(filter *UsageRollupFilter, offset, limit int) ([]*UsageRollup, error)
+	// GetLatestUsageRollupDay returns the latest day that has been rolled up, empty if there is none.
+	GetLatestUsageRollupDay() (string, error)
+}
+
+// NotificationDB is used to persist the notification subscriptions of the buckets and the delivery progress.
+type NotificationDB interface {
+	// InsertNotificationSubscription inserts a new notification subscription and returns its id.
+	InsertNotificationSubscription(subscription *NotificationSubscription) (uint64, error)
+	// ListNotificationSubscriptions lists the notification subscriptions of the buckets.
+	ListNotificationSubscriptions(bucketNames []string) ([]*NotificationSubscription, error)
+	// ListAllNotificationSubscriptions lists the notification subscriptions of all the buckets.
+	ListAllNotificationSubscriptions() ([]*NotificationSubscription, error)
+	// DeleteNotificationSubscription deletes the notification subscription of the bucket, returns
+	// gorm.ErrRecordNotFound if there is no such subscription.
+	DeleteNotificationSubscription(bucketName string, subscriptionID uint64) error
+	// UpdateNotificationCursor updates the id of the last notification event that has been delivered to the
+	// subscription.
+	UpdateNotificationCursor(subscriptionID, eventID uint64) error
+	// InsertNotificationDeadLetter inserts the notification that fails to be delivered after retrying.
+	InsertNotificationDeadLetter(letter *NotificationDeadLetter) error
+	// ListNotificationDeadLetters lists the dead letters of the bucket, all the dead letters are listed
+	// if the bucket name is empty.
+	ListNotificationDeadLetters(bucketName string, offset, limit int) ([]*NotificationDeadLetter, error)
+}
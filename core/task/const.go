@@ -74,6 +74,7 @@ var TypeTaskMap = map[TType]string{
 	TypeTaskGCBucketMigration:      "GCBucketMigrationTask",
 	TypeTaskRecoverPiece:           "RecoverPieceTask",
 	TypeTaskMigrateGVG:             "MigrateGVGTask",
+	TypeTaskGCStaleVersionObject:   "GCStaleVersionObjectTask",
 }
 
 func TaskTypeName(taskType TType) string {
@@ -128,3 +129,30 @@ const (
 	// THighPriorityLevel defines the high task priority level.
 	THighPriorityLevel
 )
+
+// SPOutcomeType defines the type of the request to another SP whose outcome is recorded for
+// the SP reputation.
+type SPOutcomeType int32
+
+const (
+	// SPOutcomeReplicate defines the outcome of replicating pieces to the secondary SP.
+	SPOutcomeReplicate SPOutcomeType = iota
+	// SPOutcomeRecoveryFetch defines the outcome of fetching pieces from the SP for recovery.
+	SPOutcomeRecoveryFetch
+	// SPOutcomeApproval defines the outcome of asking the secondary SP for the signature of
+	// sealing object after replicating pieces.
+	SPOutcomeApproval
+)
+
+var SPOutcomeTypeMap = map[SPOutcomeType]string{
+	SPOutcomeReplicate:     "replicate",
+	SPOutcomeRecoveryFetch: "recovery_fetch",
+	SPOutcomeApproval:      "approval",
+}
+
+func SPOutcomeTypeName(outcomeType SPOutcomeType) string {
+	if _, ok := SPOutcomeTypeMap[outcomeType]; !ok {
+		return "unknown"
+	}
+	return SPOutcomeTypeMap[outcomeType]
+}
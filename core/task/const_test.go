@@ -36,3 +36,8 @@ func TestTaskTypeName(t *testing.T) {
 		})
 	}
 }
+
+func TestSPOutcomeTypeName(t *testing.T) {
+	assert.Equal(t, "recovery_fetch", SPOutcomeTypeName(SPOutcomeRecoveryFetch))
+	assert.Equal(t, "unknown", SPOutcomeTypeName(SPOutcomeType(-1)))
+}
@@ -163,6 +163,8 @@ func (*NullTask) GetFinished() bool                                 { return fal
 func (*NullTask) SetFinished(bool)                                  {}
 func (*NullTask) GetNotAvailableSpIdx() int32                       { return 0 }
 func (*NullTask) SetNotAvailableSpIdx(i int32)                      {}
+func (*NullTask) GetSPOutcomes() []*SPOutcome                       { return nil }
+func (*NullTask) SetSPOutcomes([]*SPOutcome)                        {}
 
 func (t *NullTask) InitGCBucketMigrationTask(priority TPriority, bucketID uint64, timeout, retry int64) {
 }
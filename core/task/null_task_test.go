@@ -125,4 +125,6 @@ func TestNullTask(t *testing.T) {
 	n.SetFinished(true)
 	n.GetNotAvailableSpIdx()
 	n.SetNotAvailableSpIdx(0)
+	n.GetSPOutcomes()
+	n.SetSPOutcomes(nil)
 }
@@ -1,6 +1,8 @@
 package task
 
 import (
+	"time"
+
 	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
@@ -298,6 +300,12 @@ type ReplicatePieceTask interface {
 	SetNotAvailableSpIdx(int32)
 	// GetIsAgentUpload returns the task's isAgentUpload.
 	GetIsAgentUpload() bool
+	// GetSPOutcomes returns the outcomes of replicating pieces to and asking approvals from
+	// the secondary SPs.
+	GetSPOutcomes() []*SPOutcome
+	// SetSPOutcomes sets the outcomes of replicating pieces to and asking approvals from
+	// the secondary SPs.
+	SetSPOutcomes([]*SPOutcome)
 }
 
 // ReceivePieceTask is an abstract interface to record the information for receiving pieces
@@ -578,6 +586,27 @@ type RecoveryPieceTask interface {
 	BySuccessorSP() bool
 	// GetGVGID return gvg id
 	GetGVGID() uint32
+	// GetSPOutcomes returns the outcomes of fetching pieces from the SPs for recovery.
+	GetSPOutcomes() []*SPOutcome
+	// SetSPOutcomes sets the outcomes of fetching pieces from the SPs for recovery.
+	SetSPOutcomes([]*SPOutcome)
+}
+
+// SPOutcome records the outcome of the requests of one type to another SP while executing a
+// task, the executor reports it to the manager along with the task to score the SP reputation.
+type SPOutcome struct {
+	// Endpoint is the endpoint of the requested SP.
+	Endpoint string
+	// Type is the type of the requests.
+	Type SPOutcomeType
+	// Requests is the number of the requests.
+	Requests uint32
+	// Failures is the number of the failed requests.
+	Failures uint32
+	// Size is the bytes of the payload transferred by the succeeded requests.
+	Size uint64
+	// Cost is the total duration of the succeeded requests.
+	Cost time.Duration
 }
 
 // MigrateGVGTask is an abstract interface to record migrate gvg information.
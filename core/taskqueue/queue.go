@@ -39,6 +39,8 @@ type TQueue interface {
 	Len() int
 	// Cap returns the capacity of queue.
 	Cap() int
+	// SetCap sets the capacity of queue, the tasks beyond the new capacity are kept until they are popped.
+	SetCap(int)
 	// ScanTask scans all tasks, and call the func one by one task.
 	ScanTask(func(task.Task))
 }
@@ -60,6 +62,8 @@ type TQueueWithLimit interface {
 	Len() int
 	// Cap returns the capacity of queue.
 	Cap() int
+	// SetCap sets the capacity of queue, the tasks beyond the new capacity are kept until they are popped.
+	SetCap(int)
 	// ScanTask scans all tasks, and call the func one by one task.
 	ScanTask(func(task.Task))
 }
@@ -125,6 +129,7 @@ func (*NilQueue) Has(task.TKey) bool                         { return false }
 func (*NilQueue) Push(task.Task) error                       { return nil }
 func (*NilQueue) Len() int                                   { return 0 }
 func (*NilQueue) Cap() int                                   { return 0 }
+func (*NilQueue) SetCap(int)                                 {}
 func (*NilQueue) ScanTask(func(task.Task))                   {}
 func (*NilQueue) TopByLimit(rcmgr.Limit) task.Task           { return nil }
 func (*NilQueue) PopByLimit(rcmgr.Limit) task.Task           { return nil }
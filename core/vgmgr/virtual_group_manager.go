@@ -1,8 +1,11 @@
 package vgmgr
 
 import (
+	"time"
+
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
 	sptypes "github.com/bnb-chain/greenfield/x/sp/types"
 	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
@@ -147,6 +150,24 @@ type VirtualGroupManager interface {
 	// ReleaseAllSP release all sp and their related GVG, in case that there is no enough balance to create a new GVG.
 	// should use the exisiting GVG even it failed to serve previously.
 	ReleaseAllSP()
+	// ReportSPOutcomes records the outcomes of replicating pieces to, fetching pieces for recovery from and asking
+	// approvals from other SPs, they score the SP reputations that weight picking GVG and generating GVG meta.
+	ReportSPOutcomes(outcomes []*task.SPOutcome)
+	// QuerySPReputations returns the reputations of the SPs that have reported outcomes.
+	QuerySPReputations() []*SPReputation
+}
+
+// SPReputation defines the reputation of a SP scored from the outcomes of the requests to it.
+type SPReputation struct {
+	SPID uint32
+	// Score is in (0, 1], the SP without enough recent outcomes is scored 1.
+	Score float64
+	// ErrorRate is the ratio of the failed requests.
+	ErrorRate float64
+	// Latency is the average duration of the requests without payload.
+	Latency time.Duration
+	// Throughput is the bytes per second of transferring the payload.
+	Throughput float64
 }
 
 // NewVirtualGroupManager is the virtual group manager init api.
@@ -12,6 +12,7 @@ package vgmgr
 import (
 	reflect "reflect"
 
+	task "github.com/bnb-chain/greenfield-storage-provider/core/task"
 	types "github.com/bnb-chain/greenfield/x/sp/types"
 	types0 "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 	gomock "go.uber.org/mock/gomock"
@@ -278,18 +279,6 @@ func (mr *MockVirtualGroupManagerMockRecorder) FreezeSPAndGVGs(spID, gvgs any) *
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FreezeSPAndGVGs", reflect.TypeOf((*MockVirtualGroupManager)(nil).FreezeSPAndGVGs), spID, gvgs)
 }
 
-// ReleaseAllSP indicates an expected call of ReleaseAllSP.
-func (m *MockVirtualGroupManager) ReleaseAllSP() {
-	m.ctrl.T.Helper()
-	m.ctrl.Call(m, "ReleaseAllSP")
-}
-
-// FreezeSPAndGVGs indicates an expected call of FreezeSPAndGVGs.
-func (mr *MockVirtualGroupManagerMockRecorder) ReleaseAllSP(spID, gvgs any) *gomock.Call {
-	mr.mock.ctrl.T.Helper()
-	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAllSP", reflect.TypeOf((*MockVirtualGroupManager)(nil).ReleaseAllSP))
-}
-
 // GenerateGlobalVirtualGroupMeta mocks base method.
 func (m *MockVirtualGroupManager) GenerateGlobalVirtualGroupMeta(genPolicy GenerateGVGSecondarySPsPolicy, excludeSPsFilter ExcludeFilter) (*GlobalVirtualGroupMeta, error) {
 	m.ctrl.T.Helper()
@@ -379,3 +368,41 @@ func (mr *MockVirtualGroupManagerMockRecorder) QuerySPByID(spID any) *gomock.Cal
 	mr.mock.ctrl.T.Helper()
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySPByID", reflect.TypeOf((*MockVirtualGroupManager)(nil).QuerySPByID), spID)
 }
+
+// QuerySPReputations mocks base method.
+func (m *MockVirtualGroupManager) QuerySPReputations() []*SPReputation {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "QuerySPReputations")
+	ret0, _ := ret[0].([]*SPReputation)
+	return ret0
+}
+
+// QuerySPReputations indicates an expected call of QuerySPReputations.
+func (mr *MockVirtualGroupManagerMockRecorder) QuerySPReputations() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "QuerySPReputations", reflect.TypeOf((*MockVirtualGroupManager)(nil).QuerySPReputations))
+}
+
+// ReleaseAllSP mocks base method.
+func (m *MockVirtualGroupManager) ReleaseAllSP() {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReleaseAllSP")
+}
+
+// ReleaseAllSP indicates an expected call of ReleaseAllSP.
+func (mr *MockVirtualGroupManagerMockRecorder) ReleaseAllSP() *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReleaseAllSP", reflect.TypeOf((*MockVirtualGroupManager)(nil).ReleaseAllSP))
+}
+
+// ReportSPOutcomes mocks base method.
+func (m *MockVirtualGroupManager) ReportSPOutcomes(outcomes []*task.SPOutcome) {
+	m.ctrl.T.Helper()
+	m.ctrl.Call(m, "ReportSPOutcomes", outcomes)
+}
+
+// ReportSPOutcomes indicates an expected call of ReportSPOutcomes.
+func (mr *MockVirtualGroupManagerMockRecorder) ReportSPOutcomes(outcomes any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ReportSPOutcomes", reflect.TypeOf((*MockVirtualGroupManager)(nil).ReportSPOutcomes), outcomes)
+}
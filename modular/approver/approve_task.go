@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"net/http"
+	"sync/atomic"
 	"time"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
@@ -126,7 +127,7 @@ func (a *ApprovalModular) PreMigrateBucketApproval(ctx context.Context, task cor
 		return fmt.Errorf("current SP is not the correct one to ask for approval")
 	}
 	if a.exceedMigrateGVGLimit() {
-		log.CtxErrorw(ctx, "Exceeding SP concurrent GVGs migration limit", "limit", a.migrateGVGLimit)
+		log.CtxErrorw(ctx, "Exceeding SP concurrent GVGs migration limit", "limit", atomic.LoadInt64(&a.migrateGVGLimit))
 		return ErrExceedApprovalLimit
 	}
 	return nil
@@ -52,7 +52,7 @@ type ApprovalModular struct {
 	objectApprovalTimeoutHeight uint64
 
 	// the maximum number of GVGs migrating to current SP concurrently is allowed
-	migrateGVGLimit int
+	migrateGVGLimit int64
 
 	statsMutex sync.RWMutex
 	tasksStats *managerTasksStats
@@ -174,5 +174,5 @@ func (a *ApprovalModular) exceedCreateObjectLimit() bool {
 func (a *ApprovalModular) exceedMigrateGVGLimit() bool {
 	a.statsMutex.RLock()
 	defer a.statsMutex.RUnlock()
-	return a.tasksStats.migrateGVGCount >= uint32(a.migrateGVGLimit)
+	return a.tasksStats.migrateGVGCount >= uint32(atomic.LoadInt64(&a.migrateGVGLimit))
 }
@@ -57,5 +57,5 @@ func defaultApprovalOptions(cfg *gfspconfig.GfSpConfig, approver *ApprovalModula
 	if cfg.Parallel.GlobalMigrateGVGParallel == 0 {
 		cfg.Parallel.GlobalMigrateGVGParallel = manager.DefaultGlobalMigrateGVGParallel
 	}
-	approver.migrateGVGLimit = cfg.Parallel.GlobalMigrateGVGParallel
+	approver.migrateGVGLimit = int64(cfg.Parallel.GlobalMigrateGVGParallel)
 }
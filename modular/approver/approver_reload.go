@@ -0,0 +1,48 @@
+package approver
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/manager"
+)
+
+var _ gfspapp.ReloadableModular = &ApprovalModular{}
+
+// ReloadableConfigs returns the configs that approver can apply at runtime.
+func (a *ApprovalModular) ReloadableConfigs() []string {
+	return []string{
+		"Parallel.GlobalCreateBucketApprovalParallel",
+		"Parallel.GlobalCreateObjectApprovalParallel",
+		"Parallel.GlobalMigrateGVGParallel",
+	}
+}
+
+// ReloadConfig updates the capacities of approval queues and the limit of migrating gvg.
+func (a *ApprovalModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if cfg.Parallel.GlobalCreateBucketApprovalParallel < 0 || cfg.Parallel.GlobalCreateObjectApprovalParallel < 0 ||
+		cfg.Parallel.GlobalMigrateGVGParallel < 0 {
+		return nil, fmt.Errorf("invalid create bucket approval parallel %d, create object approval parallel %d or "+
+			"migrate gvg parallel %d", cfg.Parallel.GlobalCreateBucketApprovalParallel,
+			cfg.Parallel.GlobalCreateObjectApprovalParallel, cfg.Parallel.GlobalMigrateGVGParallel)
+	}
+	bucketParallel := cfg.Parallel.GlobalCreateBucketApprovalParallel
+	if bucketParallel == 0 {
+		bucketParallel = DefaultCreateBucketApprovalParallel
+	}
+	objectParallel := cfg.Parallel.GlobalCreateObjectApprovalParallel
+	if objectParallel == 0 {
+		objectParallel = DefaultCreateObjectApprovalParallel
+	}
+	migrateGVGLimit := cfg.Parallel.GlobalMigrateGVGParallel
+	if migrateGVGLimit == 0 {
+		migrateGVGLimit = manager.DefaultGlobalMigrateGVGParallel
+	}
+	return func() {
+		a.bucketQueue.SetCap(bucketParallel)
+		a.objectQueue.SetCap(objectParallel)
+		atomic.StoreInt64(&a.migrateGVGLimit, int64(migrateGVGLimit))
+	}, nil
+}
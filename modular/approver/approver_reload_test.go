@@ -0,0 +1,30 @@
+package approver
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/manager"
+)
+
+func TestApprovalModular_ReloadConfig(t *testing.T) {
+	a := setup(t)
+	a.bucketQueue = gfsptqueue.NewGfSpTQueue("mock-bucket", 1)
+	a.objectQueue = gfsptqueue.NewGfSpTQueue("mock-object", 1)
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Parallel.GlobalCreateObjectApprovalParallel = 10
+	apply, err := a.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	apply()
+	assert.Equal(t, DefaultCreateBucketApprovalParallel, a.bucketQueue.Cap())
+	assert.Equal(t, 10, a.objectQueue.Cap())
+	assert.Equal(t, int64(manager.DefaultGlobalMigrateGVGParallel), a.migrateGVGLimit)
+
+	cfg.Parallel.GlobalMigrateGVGParallel = -1
+	_, err = a.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
@@ -312,6 +312,21 @@ func (a *AuthenticationModular) VerifyAuthentication(
 			return false, ErrNoSuchBucket
 		}
 		return true, nil
+	case coremodule.AuthOpTypeManageBucketNotification:
+		queryTime := time.Now()
+		bucketInfo, _ := a.baseApp.Consensus().QueryBucketInfo(ctx, bucket)
+		metrics.PerfAuthTimeHistogram.WithLabelValues("auth_server_manage_bucket_notification_query_bucket_time").Observe(time.Since(queryTime).Seconds())
+		if bucketInfo == nil {
+			log.CtxErrorw(ctx, "failed to verify authentication of managing bucket "+
+				"notification, bucket not existed", "bucket", bucket)
+			return false, ErrNoSuchBucket
+		}
+		if bucketInfo.GetOwner() != account {
+			log.CtxErrorw(ctx, "failed to verify authentication of managing bucket "+
+				"notification, account is not the bucket owner", "bucket", bucket, "account", account)
+			return false, nil
+		}
+		return true, nil
 	case coremodule.AuthOpAskCreateObjectApproval:
 		queryTime := time.Now()
 		bucketInfo, objectInfo, _ := a.baseApp.Consensus().QueryBucketInfoAndObjectInfo(ctx, bucket, object)
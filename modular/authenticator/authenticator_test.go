@@ -782,6 +782,39 @@ func Test_VerifyAuth_MigrateBucketApproval(t *testing.T) {
 	assert.Equal(t, true, result)
 }
 
+func Test_VerifyAuth_ManageBucketNotification(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+
+	privateKey, _ := crypto.GenerateKey()
+	userAddress := crypto.PubkeyToAddress(privateKey.PublicKey).Hex()
+
+	// bucket does not exist
+	mockedConsensus := consensus.NewMockConsensus(ctrl)
+	mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(nil, nil).Times(1)
+	a.baseApp.SetConsensus(mockedConsensus)
+	_, err := a.VerifyAuthentication(context.Background(), coremodule.AuthOpTypeManageBucketNotification, userAddress, "test_bucket", "")
+	assert.Equal(t, ErrNoSuchBucket, err)
+
+	// account is not the bucket owner
+	mockedConsensus = consensus.NewMockConsensus(ctrl)
+	mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{Owner: "0x01"}, nil).Times(1)
+	a.baseApp.SetConsensus(mockedConsensus)
+	result, err := a.VerifyAuthentication(context.Background(), coremodule.AuthOpTypeManageBucketNotification, userAddress, "test_bucket", "")
+	assert.Nil(t, err)
+	assert.Equal(t, false, result)
+
+	// account is the bucket owner
+	mockedConsensus = consensus.NewMockConsensus(ctrl)
+	mockedConsensus.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{Owner: userAddress}, nil).Times(1)
+	a.baseApp.SetConsensus(mockedConsensus)
+	result, err = a.VerifyAuthentication(context.Background(), coremodule.AuthOpTypeManageBucketNotification, userAddress, "test_bucket", "")
+	assert.Nil(t, err)
+	assert.Equal(t, true, result)
+}
+
 func Test_VerifyAuth_AskCreateObjectApproval(t *testing.T) {
 	a := setup(t)
 	ctrl := gomock.NewController(t)
@@ -6,6 +6,7 @@ import (
 	"strings"
 	"sync"
 	"sync/atomic"
+	"time"
 
 	"github.com/forbole/juno/v4/parser"
 	"github.com/forbole/juno/v4/types/config"
@@ -35,11 +36,18 @@ const (
 	DefaultBlockHeightDiff = 100
 	// DefaultCheckDiffPeriod defines check interval of block height diff
 	DefaultCheckDiffPeriod = 1
-	// MaxHeightGapFactor defines the gap coefficient between the block height in the Map and the processed block height
+	// MaxHeightGapFactor defines the default gap coefficient between the block height in the Map and the processed
+	// block height, the default number of the in-flight blocks is the workers multiplied by it
 	MaxHeightGapFactor    = 4
 	ObjectsNumberOfShards = 64
 	MinChargeSize         = 128000
 	CommitNumber          = 2000
+	// DefaultCheckpointIntervalBlocks defines the default block interval of taking the checkpoints
+	DefaultCheckpointIntervalBlocks = 100000
+	// DefaultMaxCheckpointCount defines the default number of the latest checkpoints to keep
+	DefaultMaxCheckpointCount = 3
+	// ProgressReportInterval defines the interval of reporting the catch-up rate and the lag
+	ProgressReportInterval = 10 * time.Second
 )
 
 type MigrateDBKey struct{}
@@ -57,6 +65,11 @@ type BlockSyncerModular struct {
 	DataStatisticsDuration int64
 	BlockResultStorage     bool
 	MaxBlockNum            int64
+	CheckpointInterval     uint64
+	MaxCheckpointCount     uint64
+	MaxInFlightBlocks      uint64
+	// prefetcher holds the *pipeline.Prefetcher once the prefetching starts
+	prefetcher atomic.Value
 }
 
 // Read concurrency required global variables
@@ -66,9 +79,6 @@ var (
 	txMap     *sync.Map
 	txHashMap *sync.Map
 
-	RealTimeStart *atomic.Bool
-	CatchEndBlock *atomic.Int64
-
 	MainService   *BlockSyncerModular
 	BackupService *BlockSyncerModular
 
@@ -23,12 +23,14 @@ import (
 	"gorm.io/gorm"
 
 	localDB "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/maintenance"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/pipeline"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
 )
 
-func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, modules []modules.Module, serviceName string, commitNumber uint64, blockResultStorageEnable bool) parser.Indexer {
-	return &Impl{
+func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, modules []modules.Module, serviceName string, commitNumber uint64, blockResultStorageEnable bool, checkpointInterval, maxCheckpointCount uint64) parser.Indexer {
+	indexer := &Impl{
 		codec:                    codec,
 		Node:                     proxy,
 		DB:                       db,
@@ -37,7 +39,14 @@ func NewIndexer(codec codec.Codec, proxy node.Node, db database.Database, module
 		ProcessedHeight:          0,
 		CommitNumber:             commitNumber,
 		BlockResultStorageEnable: blockResultStorageEnable,
+		CheckpointInterval:       checkpointInterval,
+		MaxCheckpointCount:       maxCheckpointCount,
+		checkpointCh:             make(chan struct{}, 1),
 	}
+	if checkpointInterval != 0 {
+		go indexer.checkpointLoop()
+	}
+	return indexer
 }
 
 type Impl struct {
@@ -47,11 +56,18 @@ type Impl struct {
 	DB      database.Database
 
 	LatestBlockHeight atomic.Value
-	ProcessedHeight   uint64
+	// ProcessedHeight is the last processed height, it is read by the prefetcher and must be accessed atomically
+	ProcessedHeight uint64
 
 	CommitNumber             uint64
 	BlockResultStorageEnable bool
 
+	// CheckpointInterval defines the block interval of taking the checkpoints, no checkpoint if it is zero
+	CheckpointInterval uint64
+	MaxCheckpointCount uint64
+	// checkpointCh notifies the checkpoint worker to take a checkpoint
+	checkpointCh chan struct{}
+
 	ServiceName string
 }
 
@@ -87,7 +103,9 @@ func (i *Impl) ExtractEvent(ctx context.Context, block *coretypes.ResultBlock, t
 	return allSQL, nil
 }
 
-// Process fetches a block for a given height and associated metadata and export it to a database.
+// Process exports the block of the given height into the database, the heights are processed strictly in
+// order by a single worker. The block is usually prefetched by the prefetcher, and it is fetched directly
+// if it is not prefetched yet, which happens at the chain head.
 // It returns an error if any export process fails.
 func (i *Impl) Process(height uint64) error {
 	heightKey := fmt.Sprintf("%s-%d", i.GetServiceName(), height)
@@ -98,96 +116,136 @@ func (i *Impl) Process(height uint64) error {
 	var txHash tmtypes.Txs
 	var err error
 
-	realTimeMode := RealTimeStart.Load()
-	catchEndBLock := CatchEndBlock.Load()
-
-	if realTimeMode && catchEndBLock < int64(height) {
-		rpcStartTime := time.Now()
-		block, err = i.Node.Block(int64(height))
-		if err != nil {
-			log.Warnf("failed to get block from node: %s", err)
-			return err
-		}
-		metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
-		rpcStartTime = time.Now()
-		events, err = i.Node.BlockResults(int64(height))
+	blockAny, okb := blockMap.Load(heightKey)
+	eventsAny, oke := eventMap.Load(heightKey)
+	txsAny, okt := txMap.Load(heightKey)
+	txHashAny, okth := txHashMap.Load(heightKey)
+	block, _ = blockAny.(*coretypes.ResultBlock)
+	events, _ = eventsAny.(*coretypes.ResultBlockResults)
+	txs, _ = txsAny.(map[common.Hash][]abci.Event)
+	txHash, _ = txHashAny.(tmtypes.Txs)
+	if !okb || !oke || !okt || !okth {
+		metrics.BlocksyncerPrefetchMiss.Inc()
+		block, events, err = i.FetchBlock(height)
 		if err != nil {
-			log.Warnf("failed to get block results from node: %s", err)
 			return err
 		}
-		metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
-		if i.BlockResultStorageEnable {
-			go i.SaveBlockResult(height, events)
-		}
 		txHash = block.Block.Data.Txs
-		txs = make(map[common.Hash][]cometbfttypes.Event)
-		for idx := 0; idx < len(events.TxsResults); idx++ {
-			k := block.Block.Data.Txs[idx]
-			v := events.TxsResults[idx].GetEvents()
-			txs[common.BytesToHash(k.Hash())] = v
+		txs = groupTxEvents(block, events)
+	}
+	if i.BlockResultStorageEnable {
+		go i.SaveBlockResult(height, events)
+	}
+
+	if err = i.commitBlock(height, block, events, txs, txHash); err != nil {
+		return err
+	}
+
+	atomic.StoreUint64(&i.ProcessedHeight, height)
+	blockMap.Delete(heightKey)
+	eventMap.Delete(heightKey)
+	txMap.Delete(heightKey)
+	txHashMap.Delete(heightKey)
+
+	// after each block height ends, clear the corresponding key value in ctx
+	for _, module := range i.Modules {
+		if eventModule, ok := module.(modules.EventModule); ok {
+			eventModule.ClearCtx()
 		}
-	} else {
-		blockAny, okb := blockMap.Load(heightKey)
-		eventsAny, oke := eventMap.Load(heightKey)
-		txsAny, okt := txMap.Load(heightKey)
-		txHashAny, okth := txHashMap.Load(heightKey)
-		block, _ = blockAny.(*coretypes.ResultBlock)
-		events, _ = eventsAny.(*coretypes.ResultBlockResults)
-		txs, _ = txsAny.(map[common.Hash][]abci.Event)
-		txHash, _ = txHashAny.(tmtypes.Txs)
-		if !okb || !oke || !okt || !okth {
-			log.Warnf("failed to get map data height: %d", height)
-			return ErrBlockNotFound
+	}
+
+	// the checkpoint is taken from a consistent snapshot by the worker, the syncing is not blocked
+	if i.CheckpointInterval != 0 && height%i.CheckpointInterval == 0 {
+		select {
+		case i.checkpointCh <- struct{}{}:
+		default:
+			log.Warnw("skip the checkpoint since the previous one is still in progress", "height", height)
 		}
-		if i.BlockResultStorageEnable {
-			go i.SaveBlockResult(height, events)
+	}
+
+	return nil
+}
+
+// FetchBlock fetches the block and the block results of the height from the node.
+func (i *Impl) FetchBlock(height uint64) (*coretypes.ResultBlock, *coretypes.ResultBlockResults, error) {
+	rpcStartTime := time.Now()
+	block, err := i.Node.Block(int64(height))
+	if err != nil {
+		log.Warnf("failed to get block from node: %s", err)
+		return nil, nil, err
+	}
+	metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
+	rpcStartTime = time.Now()
+	events, err := i.Node.BlockResults(int64(height))
+	if err != nil {
+		log.Warnf("failed to get block results from node: %s", err)
+		return nil, nil, err
+	}
+	metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
+	return block, events, nil
+}
+
+// ReplayBlock exports the events of the block into the database, it is used to replay the blocks fetched
+// from the node in order.
+func (i *Impl) ReplayBlock(block *coretypes.ResultBlock, events *coretypes.ResultBlockResults) error {
+	if err := i.commitBlock(uint64(block.Block.Height), block, events, groupTxEvents(block, events), block.Block.Data.Txs); err != nil {
+		return err
+	}
+	atomic.StoreUint64(&i.ProcessedHeight, uint64(block.Block.Height))
+	for _, module := range i.Modules {
+		if eventModule, ok := module.(modules.EventModule); ok {
+			eventModule.ClearCtx()
 		}
 	}
+	return nil
+}
 
+// groupTxEvents returns the events of the txs in the block by the tx hash.
+func groupTxEvents(block *coretypes.ResultBlock, events *coretypes.ResultBlockResults) map[common.Hash][]abci.Event {
+	txs := make(map[common.Hash][]cometbfttypes.Event)
+	for idx := 0; idx < len(events.TxsResults); idx++ {
+		k := block.Block.Data.Txs[idx]
+		v := events.TxsResults[idx].GetEvents()
+		txs[common.BytesToHash(k.Hash())] = v
+	}
+	return txs
+}
+
+// checkpointLoop takes a checkpoint of the tables every time it is notified, the failure is logged and does
+// not stop the syncing.
+func (i *Impl) checkpointLoop() {
+	for range i.checkpointCh {
+		startTime := time.Now()
+		if _, err := maintenance.CreateCheckpoint(context.Background(), localDB.Cast(i.DB).Db, int(i.MaxCheckpointCount)); err != nil {
+			log.Errorw("failed to create checkpoint", "error", err)
+			metrics.BlocksyncerCheckpointErr.Inc()
+			continue
+		}
+		metrics.BlocksyncerCheckpointTime.Set(float64(time.Since(startTime).Milliseconds()))
+	}
+}
+
+// commitBlock exports the events of the block with the epoch and commits the sql in batches.
+func (i *Impl) commitBlock(height uint64, block *coretypes.ResultBlock, events *coretypes.ResultBlockResults,
+	txs map[common.Hash][]abci.Event, txHash tmtypes.Txs) error {
 	startTime := time.Now()
 
-	beginBlockEvents := events.BeginBlockEvents
-	endBlockEvents := events.EndBlockEvents
 	txCount := len(txs)
-	allSQL := make([]map[string][]interface{}, 0)
 
 	// Global context added in the module to store event information for each block height.
 	// Example: Prefix tree module stores the count of folders and object creation status within the same block height.
 	// Context information is managed using Set(), Get(), and Clear() functions.
 	// This addition introduces a new process to the procedure and enables event handlers within the same block height to communicate with each other.
 	ctx := context.Background()
-	// 1. handle events in startBlock
-	if len(beginBlockEvents) > 0 {
-		sqls, err := i.ExportEventsWithoutTx(ctx, block, beginBlockEvents)
-		if err != nil {
-			log.Errorf("failed to export events without tx: %s", err)
-			return err
-		}
-		if len(sqls) > 0 {
-			allSQL = append(allSQL, sqls...)
-		}
-	}
-
-	// 2. handle events in txs
-	sqls, err := i.ExportEventsInTxs(ctx, block, txs, txHash)
+	// handle events in startBlock, events in txs and events in endBlock in order
+	blockEvents, err := collectBlockEvents(events, txs, txHash)
 	if err != nil {
-		log.Errorf("failed to export events in txs: %s", err)
 		return err
 	}
-	if len(sqls) > 0 {
-		allSQL = append(allSQL, sqls...)
-	}
-
-	// 3. handle events in endBlock
-	if len(endBlockEvents) > 0 {
-		sqls, err = i.ExportEventsWithoutTx(ctx, block, endBlockEvents)
-		if err != nil {
-			log.Errorf("failed to export events without tx: %s", err)
-			return err
-		}
-		if len(sqls) > 0 {
-			allSQL = append(allSQL, sqls...)
-		}
+	allSQL, err := i.ExtractEventsInParallel(ctx, block, blockEvents)
+	if err != nil {
+		log.Errorf("failed to export events: %s", err)
+		return err
 	}
 
 	sql, val := i.SaveEpoch(block)
@@ -238,22 +296,6 @@ func (i *Impl) Process(height uint64) error {
 
 	metrics.BlockHeightLagGauge.WithLabelValues("blocksyncer").Set(float64(block.Block.Height))
 	metrics.BlocksyncerCatchTime.Set(float64(time.Since(startTime).Milliseconds()))
-
-	i.ProcessedHeight = height
-	if !realTimeMode || catchEndBLock < int64(height) {
-		blockMap.Delete(heightKey)
-		eventMap.Delete(heightKey)
-		txMap.Delete(heightKey)
-		txHashMap.Delete(heightKey)
-	}
-
-	// after each block height ends, clear the corresponding key value in ctx
-	for _, module := range i.Modules {
-		if eventModule, ok := module.(modules.EventModule); ok {
-			eventModule.ClearCtx()
-		}
-	}
-
 	return nil
 }
 
@@ -305,9 +347,13 @@ type TxHashEvent struct {
 	TxHash common.Hash
 }
 
-// ExportEventsInTxs accepts a slice of events in tx in order to save in database.
-func (i *Impl) ExportEventsInTxs(ctx context.Context, block *coretypes.ResultBlock, txs map[common.Hash][]abci.Event, txHash tmtypes.Txs) ([]map[string][]interface{}, error) {
-	allSQL := make([]map[string][]interface{}, 0)
+// collectBlockEvents returns the events of the block in order, which are the begin block events, the events
+// of the txs in the order of the txs and the end block events. The begin and end block events have no tx hash.
+func collectBlockEvents(events *coretypes.ResultBlockResults, txs map[common.Hash][]abci.Event, txHash tmtypes.Txs) ([]TxHashEvent, error) {
+	blockEvents := make([]TxHashEvent, 0, len(events.BeginBlockEvents)+len(events.EndBlockEvents))
+	for _, event := range events.BeginBlockEvents {
+		blockEvents = append(blockEvents, TxHashEvent{Event: sdk.Event(event)})
+	}
 	for _, t := range txHash {
 		k := common.BytesToHash(t.Hash())
 		v, ok := txs[k]
@@ -316,33 +362,36 @@ func (i *Impl) ExportEventsInTxs(ctx context.Context, block *coretypes.ResultBlo
 			return nil, ErrEventNotFound
 		}
 		for _, event := range v {
-			sqls, err := i.ExtractEvent(ctx, block, k, sdk.Event(event))
-			if err != nil {
-				return nil, err
-			}
-			if len(sqls) != 0 {
-				allSQL = append(allSQL, sqls)
-			}
+			blockEvents = append(blockEvents, TxHashEvent{Event: sdk.Event(event), TxHash: k})
 		}
 	}
-	return allSQL, nil
+	for _, event := range events.EndBlockEvents {
+		blockEvents = append(blockEvents, TxHashEvent{Event: sdk.Event(event)})
+	}
+	return blockEvents, nil
 }
 
-// ExportEventsWithoutTx accepts a slice of events not in tx in order to save in database.
-// events here don't have txHash
-func (i *Impl) ExportEventsWithoutTx(ctx context.Context, block *coretypes.ResultBlock, events []abci.Event) ([]map[string][]interface{}, error) {
-	// call the event handlers
-	allSQL := make([]map[string][]interface{}, 0)
-	for _, event := range events {
-		sqls, err := i.ExtractEvent(ctx, block, common.Hash{}, sdk.Event(event))
+// ExtractEventsInParallel extracts the statements of the events of a block, the event modules run concurrently
+// and each module handles the events in order, so the per-block ctx of the modules works as before. The
+// modules read the database during extraction, so the blocks themselves must still be extracted one by one
+// after the previous block is committed.
+func (i *Impl) ExtractEventsInParallel(ctx context.Context, block *coretypes.ResultBlock, events []TxHashEvent) ([]map[string][]interface{}, error) {
+	eventModules := make([]modules.EventModule, 0, len(i.Modules))
+	names := make([]string, 0, len(i.Modules))
+	for _, module := range i.Modules {
+		if eventModule, ok := module.(modules.EventModule); ok {
+			eventModules = append(eventModules, eventModule)
+			names = append(names, module.Name())
+		}
+	}
+	return pipeline.ExtractInParallel(len(eventModules), len(events), func(moduleIdx, eventIdx int) (map[string][]interface{}, error) {
+		sqls, err := eventModules[moduleIdx].ExtractEventStatements(ctx, block, events[eventIdx].TxHash, events[eventIdx].Event)
 		if err != nil {
+			log.Errorw("failed to handle event", "module", names[moduleIdx], "event", events[eventIdx].Event, "error", err)
 			return nil, err
 		}
-		if len(sqls) != 0 {
-			allSQL = append(allSQL, sqls)
-		}
-	}
-	return allSQL, nil
+		return sqls, nil
+	})
 }
 
 // HandleGenesis accepts a GenesisDoc and calls all the registered genesis handlers in the order in which they have been registered.
@@ -13,7 +13,6 @@ import (
 	"sync/atomic"
 	"time"
 
-	cometbfttypes "github.com/cometbft/cometbft/abci/types"
 	"github.com/forbole/juno/v4/cmd"
 	parsecmdtypes "github.com/forbole/juno/v4/cmd/parse/types"
 	"github.com/forbole/juno/v4/common"
@@ -35,6 +34,7 @@ import (
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
 	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
 	registrar "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/pipeline"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
 	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
@@ -51,17 +51,23 @@ func NewBlockSyncerModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig)
 		DataStatisticsDuration: cfg.BlockSyncer.DataStatisticsDuration,
 		BlockResultStorage:     cfg.BlockSyncer.ChainDataStorage.EnableStorage,
 		MaxBlockNum:            int64(cfg.BlockSyncer.ChainDataStorage.MaximumStorageCount),
+		MaxInFlightBlocks:      cfg.BlockSyncer.MaxInFlightBlocks,
+	}
+	if cfg.BlockSyncer.Checkpoint.EnableCheckpoint {
+		MainService.CheckpointInterval = cfg.BlockSyncer.Checkpoint.IntervalBlocks
+		if MainService.CheckpointInterval == 0 {
+			MainService.CheckpointInterval = DefaultCheckpointIntervalBlocks
+		}
+		MainService.MaxCheckpointCount = cfg.BlockSyncer.Checkpoint.MaximumCheckpointCount
+		if MainService.MaxCheckpointCount == 0 {
+			MainService.MaxCheckpointCount = DefaultMaxCheckpointCount
+		}
 	}
 	blockMap = new(sync.Map)
 	eventMap = new(sync.Map)
 	txMap = new(sync.Map)
 	txHashMap = new(sync.Map)
 
-	RealTimeStart = &atomic.Bool{}
-	RealTimeStart.Store(false)
-	CatchEndBlock = &atomic.Int64{}
-	CatchEndBlock.Store(-1)
-
 	NeedBackup = junoCfg.EnableDualDB
 
 	if err := MainService.initClient(cfg); err != nil {
@@ -110,17 +116,7 @@ func (b *BlockSyncerModular) initClient(cfg *gfspconfig.GfSpConfig) error {
 		return readErr
 	}
 
-	username, password, envErr := getDBConfigFromEnv(bsdb.BsDBUser, bsdb.BsDBPasswd)
-	if envErr != nil {
-		log.Infof("failed to get username and password err:%v", envErr)
-		username = cfg.BsDB.User
-		password = cfg.BsDB.Passwd
-	}
-	dbAddress := cfg.BsDB.Address
-	if cfg.BlockSyncer.BsDBWriteAddress != "" {
-		dbAddress = cfg.BlockSyncer.BsDBWriteAddress
-	}
-	config.Cfg.Database.DSN = fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true&loc=Local&interpolateParams=true", username, password, dbAddress, cfg.BsDB.Database)
+	config.Cfg.Database.DSN = MakeBsDBDSN(cfg, cfg.BsDB.Database)
 
 	var ctx *parser.Context
 	ctx, err := parsecmdtypes.GetParserContext(config.Cfg, cmdCfg)
@@ -138,10 +134,26 @@ func (b *BlockSyncerModular) initClient(cfg *gfspconfig.GfSpConfig) error {
 		ctx.Node,
 		ctx.Database,
 		ctx.Modules,
-		b.Name(), commitNumber, b.BlockResultStorage)
+		b.Name(), commitNumber, b.BlockResultStorage, b.CheckpointInterval, b.MaxCheckpointCount)
 	return nil
 }
 
+// MakeBsDBDSN returns the dsn of writing the database of BSDB, the username and password are read from the
+// environment first.
+func MakeBsDBDSN(cfg *gfspconfig.GfSpConfig, database string) string {
+	username, password, envErr := getDBConfigFromEnv(bsdb.BsDBUser, bsdb.BsDBPasswd)
+	if envErr != nil {
+		log.Infof("failed to get username and password err:%v", envErr)
+		username = cfg.BsDB.User
+		password = cfg.BsDB.Passwd
+	}
+	dbAddress := cfg.BsDB.Address
+	if cfg.BlockSyncer.BsDBWriteAddress != "" {
+		dbAddress = cfg.BlockSyncer.BsDBWriteAddress
+	}
+	return fmt.Sprintf("%s:%s@tcp(%s)/%s?parseTime=true&multiStatements=true&loc=Local&interpolateParams=true", username, password, dbAddress, database)
+}
+
 // initDB create tables needed by block syncer. It depends on which modules are configured
 func (b *BlockSyncerModular) initDB(useMigrate bool) error {
 
@@ -170,6 +182,11 @@ func (b *BlockSyncerModular) initDB(useMigrate bool) error {
 		log.Errorw("failed to PrepareTables/AutoMigrate tables", "error", err)
 		return err
 	}
+	err = db.Cast(b.parserCtx.Database).Database.PrepareTables(context.TODO(), []schema.Tabler{&bsdb.Checkpoint{}})
+	if err != nil {
+		log.Errorw("failed to PrepareTables/AutoMigrate tables", "error", err)
+		return err
+	}
 
 	return nil
 }
@@ -214,8 +231,9 @@ func (b *BlockSyncerModular) serve(ctx context.Context) {
 		break
 	}
 
-	// fetch block data
-	go b.quickFetchBlockData(ctx, lastDbBlockHeight+1)
+	// prefetch block data ahead of the worker, which processes the blocks in order
+	go b.prefetchBlockData(ctx, lastDbBlockHeight+1)
+	go b.reportProgress(ctx)
 
 	go b.enqueueNewBlocks(ctx, exportQueue, lastDbBlockHeight+1)
 
@@ -282,100 +300,84 @@ func (b *BlockSyncerModular) getLatestBlockHeight(ctx context.Context) {
 	}
 }
 
-func (b *BlockSyncerModular) quickFetchBlockData(ctx context.Context, startHeight uint64) {
-	count := uint64(b.config.Parser.Workers)
-	cycle := uint64(0)
-	startBlock := uint64(0)
-	endBlock := uint64(0)
-	flag := 0
+// prefetchBlockData fetches and decodes the blocks concurrently from startHeight, at most MaxInFlightBlocks
+// blocks above the processed height are kept in the maps for the worker.
+func (b *BlockSyncerModular) prefetchBlockData(ctx context.Context, startHeight uint64) {
+	indexer := Cast(b.parserCtx.Indexer)
+	maxInFlight := b.MaxInFlightBlocks
+	if maxInFlight == 0 {
+		maxInFlight = uint64(MaxHeightGapFactor * b.config.Parser.Workers)
+	}
+	log.Infow("start to prefetch block data", "start_height", startHeight, "workers", b.config.Parser.Workers,
+		"max_in_flight_blocks", maxInFlight)
+	prefetcher := pipeline.NewPrefetcher(int(b.config.Parser.Workers), maxInFlight, b.fetchData, b.evictData,
+		func() uint64 {
+			latestBlockHeight, _ := indexer.GetLatestBlockHeight().Load().(int64)
+			return uint64(latestBlockHeight)
+		},
+		func() uint64 {
+			return atomic.LoadUint64(&indexer.ProcessedHeight)
+		})
+	b.prefetcher.Store(prefetcher)
+	prefetcher.Run(ctx, startHeight)
+}
 
-	ticker := time.NewTicker(20 * time.Millisecond)
-	defer ticker.Stop()
+// fetchData fetches the block of the height and stores it with the decoded tx events into the maps.
+func (b *BlockSyncerModular) fetchData(ctx context.Context, height uint64) error {
+	block, events, err := Cast(b.parserCtx.Indexer).FetchBlock(height)
+	if err != nil {
+		return err
+	}
+	txs := groupTxEvents(block, events)
+
+	heightKey := fmt.Sprintf("%s-%d", b.Name(), height)
+	blockMap.Store(heightKey, block)
+	eventMap.Store(heightKey, events)
+	txMap.Store(heightKey, txs)
+	txHashMap.Store(heightKey, block.Block.Data.Txs)
+	return nil
+}
+
+// evictData deletes the block of the height from the maps.
+func (b *BlockSyncerModular) evictData(height uint64) {
+	heightKey := fmt.Sprintf("%s-%d", b.Name(), height)
+	blockMap.Delete(heightKey)
+	eventMap.Delete(heightKey)
+	txMap.Delete(heightKey)
+	txHashMap.Delete(heightKey)
+}
 
+// reportProgress reports the catch-up rate, the lag to the chain latest height and the prefetched blocks.
+func (b *BlockSyncerModular) reportProgress(ctx context.Context) {
+	indexer := Cast(b.parserCtx.Indexer)
+	ticker := time.NewTicker(ProgressReportInterval)
+	defer ticker.Stop()
+	lastHeight, lastTime := atomic.LoadUint64(&indexer.ProcessedHeight), time.Now()
 	for {
 		select {
 		case <-ctx.Done():
-			log.Infof("Receive cancel signal, quickFetchBlockData routine will stop")
+			log.Infof("Receive cancel signal, reportProgress routine will stop")
 			return
 		case <-ticker.C:
-			latestBlockHeightAny := Cast(b.parserCtx.Indexer).GetLatestBlockHeight().Load()
-			latestBlockHeight := latestBlockHeightAny.(int64)
-			if latestBlockHeight == int64(endBlock) {
-				RealTimeStart.Store(true)
-				CatchEndBlock.Store(int64(endBlock))
-				return
+			processedHeight, now := atomic.LoadUint64(&indexer.ProcessedHeight), time.Now()
+			if processedHeight == 0 {
+				// nothing is processed since the start
+				lastTime = now
+				continue
 			}
-			if latestBlockHeight > int64(count*(cycle+1)+startHeight-1) {
-				startBlock = count*cycle + startHeight
-				endBlock = count*(cycle+1) + startHeight - 1
-				flag = 1
-				processedHeight := Cast(b.parserCtx.Indexer).ProcessedHeight
-				if processedHeight != 0 && int64(startBlock)-int64(processedHeight) > int64(MaxHeightGapFactor*count) {
-					log.Infof("processedHeight: %d", processedHeight)
-					time.Sleep(time.Second)
-					continue
-				}
-				cycle++
-			} else if flag != 0 {
-				startBlock = endBlock + 1
-				if startBlock > uint64(latestBlockHeight) {
-					startBlock = uint64(latestBlockHeight)
-				}
-				endBlock = uint64(latestBlockHeight)
-			} else {
-				flag = 1
-				startBlock = startHeight
-				endBlock = uint64(latestBlockHeight)
+			if lastHeight != 0 && processedHeight >= lastHeight {
+				metrics.BlocksyncerCatchUpRate.Set(float64(processedHeight-lastHeight) / now.Sub(lastTime).Seconds())
 			}
-
-			b.fetchData(startBlock, endBlock)
-		}
-	}
-}
-
-func (b *BlockSyncerModular) fetchData(start, end uint64) {
-	log.Infof("fetch data start:%d end:%d", start, end)
-	if start > end {
-		return
-	}
-	wg := &sync.WaitGroup{}
-	wg.Add(int(end - start + 1))
-	for i := start; i <= end; i++ {
-		go func(height uint64) {
-			defer wg.Done()
-
-			for {
-				rpcStartTime := time.Now()
-				block, err := b.parserCtx.Node.Block(int64(height))
-				if err != nil {
-					log.Warnf("failed to get block from node: %s", err)
-					continue
-				}
-				metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
-				rpcStartTime = time.Now()
-				events, err := b.parserCtx.Node.BlockResults(int64(height))
-				if err != nil {
-					log.Warnf("failed to get block results from node: %s", err)
-					continue
-				}
-				metrics.ChainRPCTime.Set(float64(time.Since(rpcStartTime).Milliseconds()))
-				txs := make(map[common.Hash][]cometbfttypes.Event)
-				for idx := 0; idx < len(events.TxsResults); idx++ {
-					k := block.Block.Data.Txs[idx]
-					v := events.TxsResults[idx].GetEvents()
-					txs[common.BytesToHash(k.Hash())] = v
-				}
-
-				heightKey := fmt.Sprintf("%s-%d", b.Name(), height)
-				blockMap.Store(heightKey, block)
-				eventMap.Store(heightKey, events)
-				txMap.Store(heightKey, txs)
-				txHashMap.Store(heightKey, block.Block.Data.Txs)
-				break
+			latestBlockHeight, _ := indexer.GetLatestBlockHeight().Load().(int64)
+			if uint64(latestBlockHeight) >= processedHeight {
+				metrics.BlocksyncerLagBlocks.Set(float64(uint64(latestBlockHeight) - processedHeight))
 			}
-		}(i)
+			if prefetcher, ok := b.prefetcher.Load().(*pipeline.Prefetcher); ok {
+				metrics.BlocksyncerPrefetchedBlocks.Set(float64(prefetcher.Prefetched()))
+			}
+			lastHeight, lastTime = processedHeight, now
+		}
 	}
-	wg.Wait()
 }
 
 func (b *BlockSyncerModular) prepareMasterFlagTable() error {
@@ -0,0 +1,140 @@
+package blocksyncer
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	db "github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/maintenance"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// RebuildLogInterval defines the block interval of logging the rebuild progress.
+const RebuildLogInterval = 1000
+
+var ErrInvalidRebuildHeight = errors.New("the from height must be positive and not above the to height")
+
+// RebuildOptions defines the options of rebuilding BSDB.
+type RebuildOptions struct {
+	// FromHeight is the first height that is not trusted, the blocks are replayed from the latest valid
+	// checkpoint below it, or from the genesis if there is no such checkpoint.
+	FromHeight int64
+	// ToHeight is the last height to replay, the epoch height of the live database is used if it is zero.
+	ToHeight int64
+	// NoSwap leaves the rebuilt shadow schema for inspection without swapping it into the live database.
+	NoSwap bool
+}
+
+// Rebuild replays the blocks into the shadow schema of BSDB and swaps the shadow schema into the live database
+// atomically. The block syncer must be stopped before swapping, the swap is refused if the live epoch moves
+// during the rebuild. The replaced live tables are kept in a retired schema, and the checkpoints at or above
+// the from height are deleted after swapping since they may contain the corrupted data.
+func Rebuild(ctx context.Context, cfg *gfspconfig.GfSpConfig, opts *RebuildOptions) error {
+	live := &BlockSyncerModular{
+		config: makeBlockSyncerConfig(cfg),
+		name:   coremodule.BlockSyncerModularName,
+	}
+	if err := live.initClient(cfg); err != nil {
+		return err
+	}
+	liveDB := db.Cast(live.parserCtx.Database).Db
+	database := cfg.BsDB.Database
+	epoch, err := live.parserCtx.Database.GetEpoch(ctx)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get the epoch of the live database", "error", err)
+		return err
+	}
+	toHeight := opts.ToHeight
+	if toHeight == 0 {
+		toHeight = epoch.BlockHeight
+	}
+	if opts.FromHeight <= 0 || opts.FromHeight > toHeight {
+		return ErrInvalidRebuildHeight
+	}
+
+	if err = maintenance.PrepareCheckpointTable(liveDB); err != nil {
+		return err
+	}
+	checkpoints, err := maintenance.ListCheckpoints(liveDB)
+	if err != nil {
+		return err
+	}
+	checkpoint, err := maintenance.PickCheckpoint(ctx, checkpoints, opts.FromHeight, func(height int64) (string, error) {
+		block, blockErr := live.parserCtx.Node.Block(height)
+		if blockErr != nil {
+			return "", blockErr
+		}
+		return common.BytesToHash(block.BlockID.Hash).String(), nil
+	})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to pick checkpoint", "error", err)
+		return err
+	}
+	shadow, err := maintenance.PrepareShadow(liveDB, database, checkpoint)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to prepare shadow schema", "error", err)
+		return err
+	}
+	startHeight := int64(1)
+	if checkpoint != nil {
+		startHeight = checkpoint.Height + 1
+	}
+	log.CtxInfow(ctx, "start to replay blocks into shadow schema", "shadow", shadow, "start_height", startHeight,
+		"to_height", toHeight, "live_height", epoch.BlockHeight)
+
+	shadowCfg := *cfg
+	shadowCfg.BsDB.Database = shadow
+	replayer := &BlockSyncerModular{
+		config: makeBlockSyncerConfig(&shadowCfg),
+		name:   coremodule.BlockSyncerModularName,
+	}
+	if err = replayer.initClient(&shadowCfg); err != nil {
+		return err
+	}
+	// the tables of the checkpoint are migrated in case the checkpoint is taken by an older version
+	if err = replayer.initDB(checkpoint != nil); err != nil {
+		return err
+	}
+	indexer := Cast(replayer.parserCtx.Indexer)
+	startTime := time.Now()
+	for height := startHeight; height <= toHeight; height++ {
+		if ctx.Err() != nil {
+			return ctx.Err()
+		}
+		block, blockErr := replayer.parserCtx.Node.Block(height)
+		if blockErr != nil {
+			log.CtxErrorw(ctx, "failed to get block from node", "height", height, "error", blockErr)
+			return blockErr
+		}
+		events, blockErr := replayer.parserCtx.Node.BlockResults(height)
+		if blockErr != nil {
+			log.CtxErrorw(ctx, "failed to get block results from node", "height", height, "error", blockErr)
+			return blockErr
+		}
+		if err = indexer.ReplayBlock(block, events); err != nil {
+			log.CtxErrorw(ctx, "failed to replay block", "height", height, "error", err)
+			return err
+		}
+		if height%RebuildLogInterval == 0 {
+			log.CtxInfow(ctx, "replaying blocks into shadow schema", "height", height, "to_height", toHeight,
+				"cost", time.Since(startTime).String())
+		}
+	}
+	log.CtxInfow(ctx, "succeed to replay blocks into shadow schema", "shadow", shadow, "to_height", toHeight,
+		"cost", time.Since(startTime).String())
+	if opts.NoSwap {
+		return nil
+	}
+
+	retired := maintenance.RetiredSchema(database, time.Now().Unix())
+	if err = maintenance.SwapShadow(ctx, liveDB, database, epoch.BlockHeight, retired); err != nil {
+		log.CtxErrorw(ctx, "failed to swap shadow schema", "error", err)
+		return err
+	}
+	return maintenance.DeleteCheckpointsFrom(liveDB, opts.FromHeight)
+}
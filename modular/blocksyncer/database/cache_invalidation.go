@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// SaveCacheInvalidations create cache invalidation table entries
+func (db *DB) SaveCacheInvalidations(ctx context.Context, invalidations []*bsdb.CacheInvalidation) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.CacheInvalidation{}).TableName()).Create(invalidations).Statement
+	return stat.SQL.String(), stat.Vars
+}
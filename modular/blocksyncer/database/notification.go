@@ -0,0 +1,15 @@
+package database
+
+import (
+	"context"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// SaveNotificationEvent create notification event table entry
+func (db *DB) SaveNotificationEvent(ctx context.Context, event *bsdb.NotificationEvent) (string, []interface{}) {
+	stat := db.Db.Session(&gorm.Session{DryRun: true}).Table((&bsdb.NotificationEvent{}).TableName()).Create(event).Statement
+	return stat.SQL.String(), stat.Vars
+}
@@ -0,0 +1,308 @@
+package maintenance
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/models"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+var (
+	ErrEmptyDatabase           = errors.New("failed to get the name of the current database")
+	ErrCheckpointHashMismatch  = errors.New("the block hash of the checkpoint does not match the chain")
+	ErrInvalidCheckpointHeight = errors.New("the checkpoint height must be positive")
+)
+
+// excludedTables are the tables that are not copied into the checkpoints and are not swapped by the rebuild,
+// they are either not derived from the blocks or belong to the live database only.
+var excludedTables = map[string]bool{
+	bsdb.MasterDBTableName:              true,
+	bsdb.CheckpointTableName:            true,
+	(&models.BlockResult{}).TableName(): true,
+}
+
+// CheckpointSchema returns the name of the schema that the checkpoint at the height is copied into.
+func CheckpointSchema(database string, height int64) string {
+	return fmt.Sprintf("%s_ckpt_%d", database, height)
+}
+
+// quote returns the quoted identifier of the table in the schema.
+func quote(schema, table string) string {
+	return "`" + strings.ReplaceAll(schema, "`", "``") + "`.`" + strings.ReplaceAll(table, "`", "``") + "`"
+}
+
+// quoteSchema returns the quoted identifier of the schema.
+func quoteSchema(schema string) string {
+	return "`" + strings.ReplaceAll(schema, "`", "``") + "`"
+}
+
+// CurrentDatabase returns the name of the database that the connection uses.
+func CurrentDatabase(db *gorm.DB) (string, error) {
+	var database string
+	if err := db.Raw("SELECT DATABASE()").Scan(&database).Error; err != nil {
+		return "", err
+	}
+	if database == "" {
+		return "", ErrEmptyDatabase
+	}
+	return database, nil
+}
+
+// ListTables returns the names of the block syncer tables in the schema in order.
+func ListTables(db *gorm.DB, schema string) ([]string, error) {
+	var tables []string
+	if err := db.Raw("SELECT table_name FROM information_schema.tables WHERE table_schema = ? AND table_type = ? ORDER BY table_name",
+		schema, "BASE TABLE").Scan(&tables).Error; err != nil {
+		return nil, err
+	}
+	result := make([]string, 0, len(tables))
+	for _, table := range tables {
+		if !excludedTables[table] {
+			result = append(result, table)
+		}
+	}
+	return result, nil
+}
+
+// CopyTables creates the dst schema and copies the tables with the data from the src schema into it.
+func CopyTables(db *gorm.DB, src, dst string, tables []string) error {
+	if err := db.Exec("CREATE DATABASE IF NOT EXISTS " + quoteSchema(dst)).Error; err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := db.Exec(fmt.Sprintf("CREATE TABLE %s LIKE %s", quote(dst, table), quote(src, table))).Error; err != nil {
+			return err
+		}
+		if err := db.Exec(fmt.Sprintf("INSERT INTO %s SELECT * FROM %s", quote(dst, table), quote(src, table))).Error; err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// DropSchema drops the schema with all the tables in it.
+func DropSchema(db *gorm.DB, schema string) error {
+	return db.Exec("DROP DATABASE IF EXISTS " + quoteSchema(schema)).Error
+}
+
+// GetEpochHeight returns the block height recorded in the epoch table of the schema, the schema of the
+// connection is used if schema is empty.
+func GetEpochHeight(db *gorm.DB, schema string) (int64, error) {
+	table := "`" + bsdb.EpochTableName + "`"
+	if schema != "" {
+		table = quote(schema, bsdb.EpochTableName)
+	}
+	var height int64
+	err := db.Raw(fmt.Sprintf("SELECT block_height FROM %s LIMIT 1", table)).Scan(&height).Error
+	return height, err
+}
+
+// PrepareCheckpointTable creates the checkpoint table if it does not exist.
+func PrepareCheckpointTable(db *gorm.DB) error {
+	return db.AutoMigrate(&bsdb.Checkpoint{})
+}
+
+// ListCheckpoints returns all the checkpoints order by the height desc.
+func ListCheckpoints(db *gorm.DB) ([]*bsdb.Checkpoint, error) {
+	var checkpoints []*bsdb.Checkpoint
+	err := db.Table(bsdb.CheckpointTableName).Order("height desc").Find(&checkpoints).Error
+	return checkpoints, err
+}
+
+// DeleteCheckpoint drops the schema of the checkpoint and deletes the checkpoint record.
+func DeleteCheckpoint(db *gorm.DB, checkpoint *bsdb.Checkpoint) error {
+	if err := DropSchema(db, checkpoint.SchemaName); err != nil {
+		return err
+	}
+	return db.Table(bsdb.CheckpointTableName).Where("id = ?", checkpoint.ID).Delete(&bsdb.Checkpoint{}).Error
+}
+
+// DeleteCheckpointsFrom deletes the checkpoints at or above the height.
+func DeleteCheckpointsFrom(db *gorm.DB, height int64) error {
+	checkpoints, err := ListCheckpoints(db)
+	if err != nil {
+		return err
+	}
+	for _, checkpoint := range checkpoints {
+		if checkpoint.Height < height {
+			continue
+		}
+		if err = DeleteCheckpoint(db, checkpoint); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// CheckpointBatchRows defines the number of the rows inserted into a checkpoint table by one statement.
+const CheckpointBatchRows = 500
+
+// CreateCheckpoint copies the block syncer tables of the current database into a checkpoint schema, and keeps at
+// most maxCount checkpoints by deleting the oldest ones. The rows are read in a transaction started with a
+// consistent snapshot and inserted by other connections, so it runs aside of the syncing without blocking the
+// writes, and the height of the checkpoint is the epoch height in the snapshot.
+func CreateCheckpoint(ctx context.Context, db *gorm.DB, maxCount int) (*bsdb.Checkpoint, error) {
+	database, err := CurrentDatabase(db)
+	if err != nil {
+		return nil, err
+	}
+	tables, err := ListTables(db, database)
+	if err != nil {
+		return nil, err
+	}
+	sqlDB, err := db.DB()
+	if err != nil {
+		return nil, err
+	}
+	conn, err := sqlDB.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	defer conn.Close()
+	// the consistent snapshot requires the repeatable read isolation, it only applies to the next transaction
+	if _, err = conn.ExecContext(ctx, "SET TRANSACTION ISOLATION LEVEL REPEATABLE READ"); err != nil {
+		return nil, err
+	}
+	if _, err = conn.ExecContext(ctx, "START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY"); err != nil {
+		return nil, err
+	}
+	defer func() { _, _ = conn.ExecContext(context.Background(), "ROLLBACK") }()
+
+	height, blockHash, err := snapshotEpoch(ctx, conn, database)
+	if err != nil {
+		return nil, err
+	}
+	if height <= 0 {
+		return nil, ErrInvalidCheckpointHeight
+	}
+	schema := CheckpointSchema(database, height)
+	// the schema may be left by a failed attempt at the same height
+	if err = DropSchema(db, schema); err != nil {
+		return nil, err
+	}
+	if err = copySnapshot(ctx, conn, db, sqlDB, database, schema, tables); err != nil {
+		log.CtxErrorw(ctx, "failed to copy tables into checkpoint", "schema", schema, "error", err)
+		_ = DropSchema(db, schema)
+		return nil, err
+	}
+
+	checkpoint := &bsdb.Checkpoint{
+		Height:     height,
+		BlockHash:  blockHash,
+		SchemaName: schema,
+		TableCount: len(tables),
+		CreateTime: time.Now().Unix(),
+	}
+	if err = db.Table(bsdb.CheckpointTableName).Where("height = ?", height).Delete(&bsdb.Checkpoint{}).Error; err != nil {
+		return nil, err
+	}
+	if err = db.Table(bsdb.CheckpointTableName).Create(checkpoint).Error; err != nil {
+		return nil, err
+	}
+
+	checkpoints, err := ListCheckpoints(db)
+	if err != nil {
+		return nil, err
+	}
+	for idx := maxCount; maxCount > 0 && idx < len(checkpoints); idx++ {
+		if err = DeleteCheckpoint(db, checkpoints[idx]); err != nil {
+			log.CtxErrorw(ctx, "failed to delete expired checkpoint", "height", checkpoints[idx].Height, "error", err)
+		}
+	}
+	log.CtxInfow(ctx, "succeed to create checkpoint", "height", height, "schema", schema, "table_count", len(tables))
+	return checkpoint, nil
+}
+
+// snapshotEpoch returns the block height and the block hash recorded in the epoch table in the snapshot of conn.
+func snapshotEpoch(ctx context.Context, conn *sql.Conn, database string) (int64, string, error) {
+	var (
+		height    int64
+		blockHash []byte
+	)
+	err := conn.QueryRowContext(ctx, fmt.Sprintf("SELECT block_height, block_hash FROM %s LIMIT 1",
+		quote(database, bsdb.EpochTableName))).Scan(&height, &blockHash)
+	if err != nil {
+		return 0, "", err
+	}
+	return height, common.BytesToHash(blockHash).String(), nil
+}
+
+// copySnapshot creates the dst schema with the tables of the src schema, and copies the rows of the tables read
+// in the snapshot of conn into it.
+func copySnapshot(ctx context.Context, conn *sql.Conn, db *gorm.DB, sqlDB *sql.DB, src, dst string, tables []string) error {
+	if err := db.Exec("CREATE DATABASE IF NOT EXISTS " + quoteSchema(dst)).Error; err != nil {
+		return err
+	}
+	for _, table := range tables {
+		if err := db.Exec(fmt.Sprintf("CREATE TABLE %s LIKE %s", quote(dst, table), quote(src, table))).Error; err != nil {
+			return err
+		}
+		if err := copyRows(ctx, conn, sqlDB, quote(src, table), quote(dst, table)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// copyRows reads the rows of the src table by conn and inserts them into the dst table by db in batches.
+func copyRows(ctx context.Context, conn *sql.Conn, db *sql.DB, src, dst string) error {
+	rows, err := conn.QueryContext(ctx, "SELECT * FROM "+src)
+	if err != nil {
+		return err
+	}
+	defer rows.Close()
+	columns, err := rows.Columns()
+	if err != nil {
+		return err
+	}
+	quoted := make([]string, 0, len(columns))
+	for _, column := range columns {
+		quoted = append(quoted, "`"+strings.ReplaceAll(column, "`", "``")+"`")
+	}
+	insert := fmt.Sprintf("INSERT INTO %s (%s) VALUES ", dst, strings.Join(quoted, ","))
+	placeholders := "(" + strings.TrimSuffix(strings.Repeat("?,", len(columns)), ",") + ")"
+
+	batch := make([]interface{}, 0, CheckpointBatchRows*len(columns))
+	count := 0
+	flush := func() error {
+		if count == 0 {
+			return nil
+		}
+		stmt := insert + strings.TrimSuffix(strings.Repeat(placeholders+",", count), ",")
+		if _, execErr := db.ExecContext(ctx, stmt, batch...); execErr != nil {
+			return execErr
+		}
+		batch = batch[:0]
+		count = 0
+		return nil
+	}
+	for rows.Next() {
+		values := make([]interface{}, len(columns))
+		pointers := make([]interface{}, len(columns))
+		for idx := range values {
+			pointers[idx] = &values[idx]
+		}
+		if err = rows.Scan(pointers...); err != nil {
+			return err
+		}
+		batch = append(batch, values...)
+		if count++; count == CheckpointBatchRows {
+			if err = flush(); err != nil {
+				return err
+			}
+		}
+	}
+	if err = rows.Err(); err != nil {
+		return err
+	}
+	return flush()
+}
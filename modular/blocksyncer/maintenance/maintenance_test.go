@@ -0,0 +1,318 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"regexp"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/driver/mysql"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+var mockErr = errors.New("mock error")
+
+func setupDB(t *testing.T) (*gorm.DB, sqlmock.Sqlmock) {
+	mockDB, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	dia := mysql.New(mysql.Config{
+		DriverName:                "mysql",
+		Conn:                      mockDB,
+		SkipInitializeWithVersion: true,
+	})
+	db, err := gorm.Open(dia, &gorm.Config{})
+	assert.Nil(t, err)
+	return db, mock
+}
+
+func expectListTables(mock sqlmock.Sqlmock, schema string, tables ...string) {
+	rows := sqlmock.NewRows([]string{"table_name"})
+	for _, table := range tables {
+		rows.AddRow(table)
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT table_name FROM information_schema.tables")).
+		WithArgs(schema, "BASE TABLE").WillReturnRows(rows)
+}
+
+func expectSnapshot(mock sqlmock.Sqlmock, height int64) {
+	mock.ExpectExec(regexp.QuoteMeta("SET TRANSACTION ISOLATION LEVEL REPEATABLE READ")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("START TRANSACTION WITH CONSISTENT SNAPSHOT, READ ONLY")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT block_height, block_hash FROM `bsdb`.`epoch` LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height", "block_hash"}).AddRow(height, common.HexToHash("0xabc").Bytes()))
+}
+
+func TestCreateCheckpoint(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DATABASE()")).WillReturnRows(sqlmock.NewRows([]string{"db"}).AddRow("bsdb"))
+	expectListTables(mock, "bsdb", "buckets", "epoch", "master_db", "block_syncer_checkpoints")
+	expectSnapshot(mock, 100)
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_ckpt_100`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS `bsdb_ckpt_100`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	// the rows are read in the snapshot and inserted in batches
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `bsdb_ckpt_100`.`buckets` LIKE `bsdb`.`buckets`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `bsdb`.`buckets`")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bucket_name"}).AddRow(1, "bucket-1").AddRow(2, nil))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `bsdb_ckpt_100`.`buckets` (`id`,`bucket_name`) VALUES (?,?),(?,?)")).
+		WithArgs(1, "bucket-1", 2, nil).WillReturnResult(sqlmock.NewResult(0, 2))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `bsdb_ckpt_100`.`epoch` LIKE `bsdb`.`epoch`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `bsdb`.`epoch`")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height"}).AddRow(100))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `bsdb_ckpt_100`.`epoch` (`block_height`) VALUES (?)")).
+		WithArgs(100).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_syncer_checkpoints` WHERE height = ?")).
+		WithArgs(100).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `block_syncer_checkpoints`")).WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectCommit()
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `block_syncer_checkpoints` ORDER BY height desc")).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "height", "schema_name"}).
+			AddRow(3, 100, "bsdb_ckpt_100").AddRow(2, 50, "bsdb_ckpt_50").AddRow(1, 10, "bsdb_ckpt_10"))
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_ckpt_10`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectBegin()
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM `block_syncer_checkpoints` WHERE id = ?")).
+		WithArgs(1).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	mock.ExpectExec(regexp.QuoteMeta("ROLLBACK")).WillReturnResult(sqlmock.NewResult(0, 0))
+
+	checkpoint, err := CreateCheckpoint(context.Background(), db, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), checkpoint.Height)
+	assert.Equal(t, common.HexToHash("0xabc").String(), checkpoint.BlockHash)
+	assert.Equal(t, "bsdb_ckpt_100", checkpoint.SchemaName)
+	assert.Equal(t, 2, checkpoint.TableCount)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateCheckpointFailure(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DATABASE()")).WillReturnRows(sqlmock.NewRows([]string{"db"}).AddRow("bsdb"))
+	expectListTables(mock, "bsdb", "epoch")
+	expectSnapshot(mock, 100)
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_ckpt_100`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS `bsdb_ckpt_100`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `bsdb_ckpt_100`.`epoch`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `bsdb`.`epoch`")).WillReturnError(mockErr)
+	// the partial checkpoint is dropped
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_ckpt_100`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("ROLLBACK")).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err := CreateCheckpoint(context.Background(), db, 2)
+	assert.Equal(t, mockErr, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+
+	// no checkpoint before the first block is synced
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT DATABASE()")).WillReturnRows(sqlmock.NewRows([]string{"db"}).AddRow("bsdb"))
+	expectListTables(mock, "bsdb", "epoch")
+	expectSnapshot(mock, 0)
+	mock.ExpectExec(regexp.QuoteMeta("ROLLBACK")).WillReturnResult(sqlmock.NewResult(0, 0))
+	_, err = CreateCheckpoint(context.Background(), db, 2)
+	assert.Equal(t, ErrInvalidCheckpointHeight, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestPickCheckpoint(t *testing.T) {
+	checkpoints := []*bsdb.Checkpoint{
+		{Height: 300, BlockHash: "0x3"},
+		{Height: 200, BlockHash: "0x2"},
+		{Height: 100, BlockHash: "0x1"},
+	}
+	hashes := map[int64]string{300: "0x3", 200: "0xbad", 100: "0X1"}
+	blockHash := func(height int64) (string, error) { return hashes[height], nil }
+
+	// the checkpoint at the from height is not used, and the one that does not match the chain is skipped
+	checkpoint, err := PickCheckpoint(context.Background(), checkpoints, 300, blockHash)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), checkpoint.Height)
+
+	checkpoint, err = PickCheckpoint(context.Background(), checkpoints, 301, blockHash)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(300), checkpoint.Height)
+
+	checkpoint, err = PickCheckpoint(context.Background(), checkpoints, 100, blockHash)
+	assert.Nil(t, err)
+	assert.Nil(t, checkpoint)
+
+	_, err = PickCheckpoint(context.Background(), checkpoints, 301, func(int64) (string, error) { return "", mockErr })
+	assert.Equal(t, mockErr, err)
+}
+
+func TestSwapShadow(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT block_height FROM `bsdb`.`epoch` LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height"}).AddRow(500))
+	expectListTables(mock, "bsdb", "buckets", "epoch", "master_db")
+	expectListTables(mock, "bsdb_shadow", "buckets", "epoch", "objects_00")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS `bsdb_retired_1`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("RENAME TABLE `bsdb`.`buckets` TO `bsdb_retired_1`.`buckets`, " +
+		"`bsdb`.`epoch` TO `bsdb_retired_1`.`epoch`, `bsdb_shadow`.`buckets` TO `bsdb`.`buckets`, " +
+		"`bsdb_shadow`.`epoch` TO `bsdb`.`epoch`, `bsdb_shadow`.`objects_00` TO `bsdb`.`objects_00`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_shadow`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	assert.Nil(t, SwapShadow(context.Background(), db, "bsdb", 500, RetiredSchema("bsdb", 1)))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSwapShadowFailure(t *testing.T) {
+	// the block syncer is still running
+	db, mock := setupDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT block_height FROM `bsdb`.`epoch` LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height"}).AddRow(501))
+	assert.Equal(t, ErrBlockSyncerRunning, SwapShadow(context.Background(), db, "bsdb", 500, RetiredSchema("bsdb", 1)))
+
+	// the shadow schema misses a live table
+	db, mock = setupDB(t)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT block_height FROM `bsdb`.`epoch` LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height"}).AddRow(500))
+	expectListTables(mock, "bsdb", "buckets", "epoch")
+	expectListTables(mock, "bsdb_shadow", "epoch")
+	assert.Equal(t, ErrShadowTableMissing, SwapShadow(context.Background(), db, "bsdb", 500, RetiredSchema("bsdb", 1)))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestPrepareShadow(t *testing.T) {
+	db, mock := setupDB(t)
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_shadow`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE `bsdb_shadow`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	shadow, err := PrepareShadow(db, "bsdb", nil)
+	assert.Nil(t, err)
+	assert.Equal(t, "bsdb_shadow", shadow)
+
+	mock.ExpectExec(regexp.QuoteMeta("DROP DATABASE IF EXISTS `bsdb_shadow`")).WillReturnResult(sqlmock.NewResult(0, 0))
+	expectListTables(mock, "bsdb_ckpt_100", "epoch")
+	mock.ExpectExec(regexp.QuoteMeta("CREATE DATABASE IF NOT EXISTS `bsdb_shadow`")).WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE `bsdb_shadow`.`epoch` LIKE `bsdb_ckpt_100`.`epoch`")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO `bsdb_shadow`.`epoch` SELECT * FROM `bsdb_ckpt_100`.`epoch`")).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	_, err = PrepareShadow(db, "bsdb", &bsdb.Checkpoint{Height: 100, SchemaName: "bsdb_ckpt_100"})
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCompareBucket(t *testing.T) {
+	owner := common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D")
+	bucket := &bsdb.Bucket{BucketName: "bucket", BucketID: common.BigToHash(sdkmath.NewUint(1).BigInt()), Owner: owner,
+		PaymentAddress: owner, Visibility: "VISIBILITY_TYPE_PRIVATE", Status: "BUCKET_STATUS_CREATED",
+		GlobalVirtualGroupFamilyID: 2, ChargedReadQuota: 100}
+	info := &storagetypes.BucketInfo{BucketName: "bucket", Id: sdkmath.NewUint(1), Owner: owner.String(),
+		PaymentAddress: owner.String(), Visibility: storagetypes.VISIBILITY_TYPE_PRIVATE,
+		BucketStatus: storagetypes.BUCKET_STATUS_CREATED, GlobalVirtualGroupFamilyId: 2, ChargedReadQuota: 100}
+	assert.Empty(t, CompareBucket(bucket, info))
+
+	info.ChargedReadQuota = 200
+	mismatches := CompareBucket(bucket, info)
+	assert.Equal(t, 1, len(mismatches))
+	assert.Equal(t, &Mismatch{Kind: BucketKind, BucketName: "bucket", Field: "charged_read_quota", BSDB: "100",
+		Chain: "200"}, mismatches[0])
+
+	// the bucket is deleted on chain but not in BSDB
+	mismatches = CompareBucket(bucket, nil)
+	assert.Equal(t, "removed", mismatches[0].Field)
+
+	// the removed bucket is consistent with a missing bucket or a recreated bucket
+	bucket.Removed = true
+	assert.Empty(t, CompareBucket(bucket, nil))
+	info.Id = sdkmath.NewUint(2)
+	assert.Empty(t, CompareBucket(bucket, info))
+	info.Id = sdkmath.NewUint(1)
+	assert.Equal(t, "removed", CompareBucket(bucket, info)[0].Field)
+}
+
+func TestCompareObject(t *testing.T) {
+	owner := common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D")
+	object := &bsdb.Object{BucketName: "bucket", ObjectName: "object", ObjectID: common.BigToHash(sdkmath.NewUint(3).BigInt()),
+		Owner: owner, Creator: owner, PayloadSize: 10, Visibility: "VISIBILITY_TYPE_INHERIT",
+		ObjectStatus: "OBJECT_STATUS_SEALED", RedundancyType: "REDUNDANCY_EC_TYPE", ContentType: "text/plain",
+		LocalVirtualGroupId: 1, Checksums: [][]byte{{1}, {2}}}
+	info := &storagetypes.ObjectInfo{BucketName: "bucket", ObjectName: "object", Id: sdkmath.NewUint(3),
+		Owner: owner.String(), Creator: owner.String(), PayloadSize: 10, Visibility: storagetypes.VISIBILITY_TYPE_INHERIT,
+		ObjectStatus: storagetypes.OBJECT_STATUS_SEALED, RedundancyType: storagetypes.REDUNDANCY_EC_TYPE,
+		ContentType: "text/plain", LocalVirtualGroupId: 1, Checksums: [][]byte{{1}, {2}}}
+	assert.Empty(t, CompareObject(object, info))
+
+	info.Checksums = [][]byte{{1}, {3}}
+	info.PayloadSize = 11
+	mismatches := CompareObject(object, info)
+	assert.Equal(t, 2, len(mismatches))
+	assert.Equal(t, "payload_size", mismatches[0].Field)
+	assert.Equal(t, "checksums", mismatches[1].Field)
+	assert.Equal(t, "object", mismatches[0].ObjectName)
+}
+
+func TestVerifier_Verify(t *testing.T) {
+	db, mock := setupDB(t)
+	ctrl := gomock.NewController(t)
+	chain := consensus.NewMockConsensus(ctrl)
+	owner := common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D")
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT block_height FROM `epoch` LIMIT 1")).
+		WillReturnRows(sqlmock.NewRows([]string{"block_height"}).AddRow(100))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(id), 0) FROM `buckets`")).
+		WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(1))
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT id FROM `buckets` WHERE id >= ? ORDER BY id LIMIT 1")).
+		WithArgs(1).WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(1))
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COALESCE(MAX(id), 0) FROM `" + bsdb.GetObjectsTableNameByShardNumber(i) + "`")).
+			WillReturnRows(sqlmock.NewRows([]string{"max"}).AddRow(0))
+	}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT * FROM `buckets` WHERE id = ? LIMIT 1")).WithArgs(1).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bucket_name", "owner", "payment_address", "status", "removed"}).
+			AddRow(1, "bucket", owner, owner, "BUCKET_STATUS_CREATED", false))
+	chain.EXPECT().QueryBucketInfo(gomock.Any(), "bucket").Return(nil, errors.New("rpc error: No such bucket")).Times(1)
+
+	verifier := NewVerifier(db, chain, 0)
+	report, err := verifier.Verify(context.Background(), 1, 3)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(100), report.EpochHeight)
+	assert.Equal(t, 1, report.CheckedBuckets)
+	assert.Equal(t, 0, report.CheckedObjects)
+	assert.Equal(t, 1, len(report.Mismatches))
+	assert.Equal(t, "removed", report.Mismatches[0].Field)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateObjectIndexes(t *testing.T) {
+	db, mock := setupDB(t)
+	indexes := []bsdb.ObjectIndex{{Name: "idx_bucket_name_payload_size", Columns: "bucket_name, payload_size"}}
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		table := bsdb.GetObjectsTableNameByShardNumber(i)
+		count := 1
+		if i == 0 {
+			count = 0
+		}
+		mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?")).
+			WithArgs(table, "idx_bucket_name_payload_size").WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(count))
+		if count == 0 {
+			// only the missing index is built online
+			mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `" + table + "` ADD INDEX `idx_bucket_name_payload_size` (bucket_name, payload_size), ALGORITHM=INPLACE, LOCK=NONE")).
+				WillReturnResult(sqlmock.NewResult(0, 0))
+		}
+	}
+	created, err := CreateObjectIndexes(context.Background(), db, indexes)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, created)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestCreateObjectIndexesFailure(t *testing.T) {
+	db, mock := setupDB(t)
+	indexes := []bsdb.ObjectIndex{{Name: "idx_bucket_name_payload_size", Columns: "bucket_name, payload_size"}}
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT COUNT(*) FROM information_schema.statistics")).
+		WillReturnRows(sqlmock.NewRows([]string{"count"}).AddRow(0))
+	mock.ExpectExec(regexp.QuoteMeta("ALTER TABLE `objects_00`")).WillReturnError(mockErr)
+	created, err := CreateObjectIndexes(context.Background(), db, indexes)
+	assert.Equal(t, mockErr, err)
+	assert.Equal(t, 0, created)
+}
@@ -0,0 +1,43 @@
+package maintenance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+// CreateObjectIndexes creates the indexes that do not exist on all the object shard tables and returns the number
+// of the created indexes. The indexes are built online by ALGORITHM=INPLACE, LOCK=NONE, so the block syncer and the
+// metadata service keep writing and reading the tables meanwhile, and the statement fails instead of locking the
+// table if the server can not build the index online.
+func CreateObjectIndexes(ctx context.Context, db *gorm.DB, indexes []bsdb.ObjectIndex) (int, error) {
+	created := 0
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		table := bsdb.GetObjectsTableNameByShardNumber(i)
+		for _, index := range indexes {
+			var count int64
+			if err := db.WithContext(ctx).Raw("SELECT COUNT(*) FROM information_schema.statistics WHERE table_schema = DATABASE() AND table_name = ? AND index_name = ?",
+				table, index.Name).Scan(&count).Error; err != nil {
+				return created, err
+			}
+			if count > 0 {
+				continue
+			}
+			startTime := time.Now()
+			if err := db.WithContext(ctx).Exec(fmt.Sprintf("ALTER TABLE `%s` ADD INDEX `%s` (%s), ALGORITHM=INPLACE, LOCK=NONE",
+				table, index.Name, index.Columns)).Error; err != nil {
+				log.CtxErrorw(ctx, "failed to create object index", "table", table, "index", index.Name, "error", err)
+				return created, err
+			}
+			created++
+			log.CtxInfow(ctx, "succeed to create object index", "table", table, "index", index.Name,
+				"cost", time.Since(startTime))
+		}
+	}
+	return created, nil
+}
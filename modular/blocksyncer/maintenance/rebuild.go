@@ -0,0 +1,117 @@
+package maintenance
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+var (
+	ErrBlockSyncerRunning = errors.New("the epoch of the live database has moved during the rebuild, " +
+		"stop the block syncer before swapping the shadow schema")
+	ErrShadowTableMissing = errors.New("the shadow schema misses a table of the live database")
+)
+
+// ShadowSchema returns the name of the schema that the blocks are replayed into.
+func ShadowSchema(database string) string {
+	return database + "_shadow"
+}
+
+// RetiredSchema returns the name of the schema that the replaced live tables are moved into.
+func RetiredSchema(database string, timestamp int64) string {
+	return fmt.Sprintf("%s_retired_%d", database, timestamp)
+}
+
+// PickCheckpoint returns the latest checkpoint below the from height whose block hash matches the chain, the
+// checkpoints are ordered by the height desc. It returns nil if there is no such checkpoint, then the blocks are
+// replayed from the genesis.
+func PickCheckpoint(ctx context.Context, checkpoints []*bsdb.Checkpoint, fromHeight int64,
+	blockHash func(height int64) (string, error)) (*bsdb.Checkpoint, error) {
+	for _, checkpoint := range checkpoints {
+		if checkpoint.Height >= fromHeight {
+			continue
+		}
+		hash, err := blockHash(checkpoint.Height)
+		if err != nil {
+			return nil, err
+		}
+		if !strings.EqualFold(hash, checkpoint.BlockHash) {
+			log.CtxWarnw(ctx, "skip the checkpoint that does not match the chain", "height", checkpoint.Height,
+				"checkpoint_hash", checkpoint.BlockHash, "chain_hash", hash, "error", ErrCheckpointHashMismatch)
+			continue
+		}
+		return checkpoint, nil
+	}
+	return nil, nil
+}
+
+// PrepareShadow recreates the shadow schema of the database from the checkpoint, or an empty shadow schema if
+// the checkpoint is nil. It returns the name of the shadow schema.
+func PrepareShadow(db *gorm.DB, database string, checkpoint *bsdb.Checkpoint) (string, error) {
+	shadow := ShadowSchema(database)
+	if err := DropSchema(db, shadow); err != nil {
+		return "", err
+	}
+	if checkpoint == nil {
+		return shadow, db.Exec("CREATE DATABASE " + quoteSchema(shadow)).Error
+	}
+	tables, err := ListTables(db, checkpoint.SchemaName)
+	if err != nil {
+		return "", err
+	}
+	return shadow, CopyTables(db, checkpoint.SchemaName, shadow, tables)
+}
+
+// SwapShadow swaps the tables of the shadow schema into the live database in a single atomic RENAME TABLE
+// statement, the replaced live tables are moved into the retired schema so that the swap can be reverted by
+// hand. liveHeight is the epoch height of the live database when the rebuild started, the swap is refused if
+// the live database has moved since then, which means the block syncer is still running.
+func SwapShadow(ctx context.Context, db *gorm.DB, database string, liveHeight int64, retired string) error {
+	shadow := ShadowSchema(database)
+	height, err := GetEpochHeight(db, database)
+	if err != nil {
+		return err
+	}
+	if height != liveHeight {
+		return ErrBlockSyncerRunning
+	}
+	liveTables, err := ListTables(db, database)
+	if err != nil {
+		return err
+	}
+	shadowTables, err := ListTables(db, shadow)
+	if err != nil {
+		return err
+	}
+	inShadow := make(map[string]bool, len(shadowTables))
+	for _, table := range shadowTables {
+		inShadow[table] = true
+	}
+	renames := make([]string, 0, len(liveTables)+len(shadowTables))
+	for _, table := range liveTables {
+		if !inShadow[table] {
+			log.CtxErrorw(ctx, "shadow schema misses table", "table", table)
+			return ErrShadowTableMissing
+		}
+		renames = append(renames, quote(database, table)+" TO "+quote(retired, table))
+	}
+	for _, table := range shadowTables {
+		renames = append(renames, quote(shadow, table)+" TO "+quote(database, table))
+	}
+
+	if err = db.Exec("CREATE DATABASE IF NOT EXISTS " + quoteSchema(retired)).Error; err != nil {
+		return err
+	}
+	if err = db.Exec("RENAME TABLE " + strings.Join(renames, ", ")).Error; err != nil {
+		return err
+	}
+	log.CtxInfow(ctx, "succeed to swap shadow schema", "database", database, "retired", retired,
+		"table_count", len(shadowTables))
+	return DropSchema(db, shadow)
+}
@@ -0,0 +1,272 @@
+package maintenance
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"math/rand"
+	"strconv"
+	"strings"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	// BucketKind defines the kind of the bucket rows.
+	BucketKind = "bucket"
+	// ObjectKind defines the kind of the object rows.
+	ObjectKind = "object"
+
+	// maxSampleAttemptsFactor limits the attempts of sampling the rows, the same row can be picked more than once.
+	maxSampleAttemptsFactor = 3
+)
+
+// Mismatch is a field of a row in BSDB that differs from the chain.
+type Mismatch struct {
+	Kind       string `json:"kind"`
+	BucketName string `json:"bucket_name"`
+	ObjectName string `json:"object_name,omitempty"`
+	Field      string `json:"field"`
+	BSDB       string `json:"bsdb"`
+	Chain      string `json:"chain"`
+}
+
+// VerifyReport is the result of verifying the sampled rows of BSDB against the chain.
+type VerifyReport struct {
+	EpochHeight    int64       `json:"epoch_height"`
+	CheckedBuckets int         `json:"checked_buckets"`
+	CheckedObjects int         `json:"checked_objects"`
+	Mismatches     []*Mismatch `json:"mismatches"`
+}
+
+// sampledRow identifies a sampled row by the table and the auto increment id.
+type sampledRow struct {
+	kind  string
+	table string
+	id    uint64
+}
+
+// Verifier samples the bucket and object rows of BSDB and compares them with the chain state.
+type Verifier struct {
+	db    *gorm.DB
+	chain consensus.Consensus
+	rand  *rand.Rand
+	// recheckDelay is the delay before rechecking the mismatched rows, the chain may have moved ahead of
+	// BSDB when the rows are checked at first, and the mismatches that disappear after BSDB catches up
+	// are not reported. No recheck if it is zero.
+	recheckDelay time.Duration
+}
+
+// NewVerifier returns a verifier of the BSDB connection against the chain.
+func NewVerifier(db *gorm.DB, chain consensus.Consensus, recheckDelay time.Duration) *Verifier {
+	return &Verifier{
+		db:           db,
+		chain:        chain,
+		rand:         rand.New(rand.NewSource(time.Now().UnixNano())),
+		recheckDelay: recheckDelay,
+	}
+}
+
+// Verify samples at most bucketSamples bucket rows and objectSamples object rows and compares them with
+// QueryBucketInfo and QueryObjectInfo of the chain.
+func (v *Verifier) Verify(ctx context.Context, bucketSamples, objectSamples int) (*VerifyReport, error) {
+	epochHeight, err := GetEpochHeight(v.db, "")
+	if err != nil {
+		return nil, err
+	}
+	report := &VerifyReport{EpochHeight: epochHeight, Mismatches: make([]*Mismatch, 0)}
+
+	rows, err := v.sample(BucketKind, []string{bsdb.BucketTableName}, bucketSamples)
+	if err != nil {
+		return nil, err
+	}
+	report.CheckedBuckets = len(rows)
+	objectTables := make([]string, 0, bsdb.ObjectsNumberOfShards)
+	for i := 0; i < bsdb.ObjectsNumberOfShards; i++ {
+		objectTables = append(objectTables, bsdb.GetObjectsTableNameByShardNumber(i))
+	}
+	objectRows, err := v.sample(ObjectKind, objectTables, objectSamples)
+	if err != nil {
+		return nil, err
+	}
+	report.CheckedObjects = len(objectRows)
+	rows = append(rows, objectRows...)
+
+	mismatched := make([]*sampledRow, 0)
+	for _, row := range rows {
+		mismatches, checkErr := v.check(ctx, row)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+		if len(mismatches) > 0 {
+			mismatched = append(mismatched, row)
+			report.Mismatches = append(report.Mismatches, mismatches...)
+		}
+	}
+	if len(mismatched) == 0 || v.recheckDelay == 0 {
+		return report, nil
+	}
+
+	log.CtxInfow(ctx, "recheck the mismatched rows", "count", len(mismatched), "delay", v.recheckDelay)
+	select {
+	case <-ctx.Done():
+		return nil, ctx.Err()
+	case <-time.After(v.recheckDelay):
+	}
+	report.Mismatches = make([]*Mismatch, 0)
+	for _, row := range mismatched {
+		mismatches, checkErr := v.check(ctx, row)
+		if checkErr != nil {
+			return nil, checkErr
+		}
+		report.Mismatches = append(report.Mismatches, mismatches...)
+	}
+	if report.EpochHeight, err = GetEpochHeight(v.db, ""); err != nil {
+		return nil, err
+	}
+	return report, nil
+}
+
+// sample picks at most count distinct rows from the tables by seeking to random ids, the gaps of the ids
+// make the rows after them more likely to be picked, which is fine for spotting corruption.
+func (v *Verifier) sample(kind string, tables []string, count int) ([]*sampledRow, error) {
+	maxIDs := make(map[string]uint64, len(tables))
+	nonEmpty := make([]string, 0, len(tables))
+	for _, table := range tables {
+		var maxID uint64
+		if err := v.db.Raw(fmt.Sprintf("SELECT COALESCE(MAX(id), 0) FROM `%s`", table)).Scan(&maxID).Error; err != nil {
+			return nil, err
+		}
+		if maxID > 0 {
+			maxIDs[table] = maxID
+			nonEmpty = append(nonEmpty, table)
+		}
+	}
+	rows := make([]*sampledRow, 0, count)
+	if len(nonEmpty) == 0 {
+		return rows, nil
+	}
+	seen := make(map[string]bool)
+	for attempts := 0; len(rows) < count && attempts < count*maxSampleAttemptsFactor; attempts++ {
+		table := nonEmpty[v.rand.Intn(len(nonEmpty))]
+		target := uint64(v.rand.Int63n(int64(maxIDs[table]))) + 1
+		var id uint64
+		if err := v.db.Raw(fmt.Sprintf("SELECT id FROM `%s` WHERE id >= ? ORDER BY id LIMIT 1", table), target).
+			Scan(&id).Error; err != nil {
+			return nil, err
+		}
+		key := table + "/" + strconv.FormatUint(id, 10)
+		if id == 0 || seen[key] {
+			continue
+		}
+		seen[key] = true
+		rows = append(rows, &sampledRow{kind: kind, table: table, id: id})
+	}
+	return rows, nil
+}
+
+// check reads the sampled row from BSDB and compares it with the chain.
+func (v *Verifier) check(ctx context.Context, row *sampledRow) ([]*Mismatch, error) {
+	if row.kind == BucketKind {
+		bucket := &bsdb.Bucket{}
+		if err := v.db.Table(row.table).Where("id = ?", row.id).Take(bucket).Error; err != nil {
+			return nil, err
+		}
+		info, err := v.chain.QueryBucketInfo(ctx, bucket.BucketName)
+		if err != nil && !strings.Contains(err.Error(), "No such bucket") {
+			return nil, err
+		}
+		return CompareBucket(bucket, info), nil
+	}
+	object := &bsdb.Object{}
+	if err := v.db.Table(row.table).Where("id = ?", row.id).Take(object).Error; err != nil {
+		return nil, err
+	}
+	info, err := v.chain.QueryObjectInfo(ctx, object.BucketName, object.ObjectName)
+	if err != nil && !strings.Contains(err.Error(), "No such object") {
+		return nil, err
+	}
+	return CompareObject(object, info), nil
+}
+
+// CompareBucket compares the bucket row with the bucket info of the chain, info is nil if the bucket does not
+// exist on the chain. A removed row is consistent with a missing bucket or a recreated bucket of the same name.
+func CompareBucket(bucket *bsdb.Bucket, info *storagetypes.BucketInfo) []*Mismatch {
+	diff := &differ{kind: BucketKind, bucketName: bucket.BucketName}
+	if bucket.Removed || info == nil {
+		if !bucket.Removed || (info != nil && info.Id.String() == bucket.BucketID.Big().String()) {
+			diff.add("removed", strconv.FormatBool(bucket.Removed), strconv.FormatBool(info == nil))
+		}
+		return diff.mismatches
+	}
+	diff.add("bucket_id", bucket.BucketID.Big().String(), info.Id.String())
+	diff.addFold("owner", bucket.Owner.String(), info.GetOwner())
+	diff.addFold("payment_address", bucket.PaymentAddress.String(), info.GetPaymentAddress())
+	diff.add("visibility", bucket.Visibility, info.GetVisibility().String())
+	diff.add("status", bucket.Status, info.GetBucketStatus().String())
+	diff.add("global_virtual_group_family_id", strconv.FormatUint(uint64(bucket.GlobalVirtualGroupFamilyID), 10),
+		strconv.FormatUint(uint64(info.GetGlobalVirtualGroupFamilyId()), 10))
+	diff.add("charged_read_quota", strconv.FormatUint(bucket.ChargedReadQuota, 10),
+		strconv.FormatUint(info.GetChargedReadQuota(), 10))
+	return diff.mismatches
+}
+
+// CompareObject compares the object row with the object info of the chain, info is nil if the object does not
+// exist on the chain. A removed row is consistent with a missing object or a recreated object of the same name.
+func CompareObject(object *bsdb.Object, info *storagetypes.ObjectInfo) []*Mismatch {
+	diff := &differ{kind: ObjectKind, bucketName: object.BucketName, objectName: object.ObjectName}
+	if object.Removed || info == nil {
+		if !object.Removed || (info != nil && info.Id.String() == object.ObjectID.Big().String()) {
+			diff.add("removed", strconv.FormatBool(object.Removed), strconv.FormatBool(info == nil))
+		}
+		return diff.mismatches
+	}
+	diff.add("object_id", object.ObjectID.Big().String(), info.Id.String())
+	diff.addFold("owner", object.Owner.String(), info.GetOwner())
+	diff.addFold("creator", object.Creator.String(), info.GetCreator())
+	diff.add("payload_size", strconv.FormatUint(object.PayloadSize, 10), strconv.FormatUint(info.GetPayloadSize(), 10))
+	diff.add("visibility", object.Visibility, info.GetVisibility().String())
+	diff.add("status", object.ObjectStatus, info.GetObjectStatus().String())
+	diff.add("redundancy_type", object.RedundancyType, info.GetRedundancyType().String())
+	diff.add("content_type", object.ContentType, info.GetContentType())
+	diff.add("local_virtual_group_id", strconv.FormatUint(uint64(object.LocalVirtualGroupId), 10),
+		strconv.FormatUint(uint64(info.GetLocalVirtualGroupId()), 10))
+	checksumsEqual := len(object.Checksums) == len(info.GetChecksums())
+	for i := 0; checksumsEqual && i < len(object.Checksums); i++ {
+		checksumsEqual = bytes.Equal(object.Checksums[i], info.GetChecksums()[i])
+	}
+	if !checksumsEqual {
+		diff.add("checksums", fmt.Sprintf("%x", [][]byte(object.Checksums)), fmt.Sprintf("%x", info.GetChecksums()))
+	}
+	return diff.mismatches
+}
+
+// differ collects the mismatched fields of a row.
+type differ struct {
+	kind       string
+	bucketName string
+	objectName string
+	mismatches []*Mismatch
+}
+
+func (d *differ) add(field, bsdbValue, chainValue string) {
+	if bsdbValue == chainValue {
+		return
+	}
+	d.mismatches = append(d.mismatches, &Mismatch{Kind: d.kind, BucketName: d.bucketName, ObjectName: d.objectName,
+		Field: field, BSDB: bsdbValue, Chain: chainValue})
+}
+
+// addFold compares the addresses case-insensitively since the checksum case may differ.
+func (d *differ) addFold(field, bsdbValue, chainValue string) {
+	if strings.EqualFold(bsdbValue, chainValue) {
+		return
+	}
+	d.add(field, bsdbValue, chainValue)
+}
@@ -0,0 +1,232 @@
+package cacheinvalidation
+
+import (
+	"context"
+	"errors"
+	"math/big"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/log"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	"github.com/bnb-chain/greenfield/types"
+	"github.com/bnb-chain/greenfield/types/resource"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+var (
+	EventCreateBucket               = proto.MessageName(&storagetypes.EventCreateBucket{})
+	EventDeleteBucket               = proto.MessageName(&storagetypes.EventDeleteBucket{})
+	EventUpdateBucketInfo           = proto.MessageName(&storagetypes.EventUpdateBucketInfo{})
+	EventDiscontinueBucket          = proto.MessageName(&storagetypes.EventDiscontinueBucket{})
+	EventMigrationBucket            = proto.MessageName(&storagetypes.EventMigrationBucket{})
+	EventCancelMigrationBucket      = proto.MessageName(&storagetypes.EventCancelMigrationBucket{})
+	EventRejectMigrateBucket        = proto.MessageName(&storagetypes.EventRejectMigrateBucket{})
+	EventCompleteMigrationBucket    = proto.MessageName(&storagetypes.EventCompleteMigrationBucket{})
+	EventToggleSPAsDelegatedAgent   = proto.MessageName(&storagetypes.EventToggleSPAsDelegatedAgent{})
+	EventBucketFlowRateLimitStatus  = proto.MessageName(&storagetypes.EventBucketFlowRateLimitStatus{})
+	EventCreateObject               = proto.MessageName(&storagetypes.EventCreateObject{})
+	EventCancelCreateObject         = proto.MessageName(&storagetypes.EventCancelCreateObject{})
+	EventSealObject                 = proto.MessageName(&storagetypes.EventSealObject{})
+	EventCopyObject                 = proto.MessageName(&storagetypes.EventCopyObject{})
+	EventDeleteObject               = proto.MessageName(&storagetypes.EventDeleteObject{})
+	EventRejectSealObject           = proto.MessageName(&storagetypes.EventRejectSealObject{})
+	EventDiscontinueObject          = proto.MessageName(&storagetypes.EventDiscontinueObject{})
+	EventUpdateObjectInfo           = proto.MessageName(&storagetypes.EventUpdateObjectInfo{})
+	EventUpdateObjectContent        = proto.MessageName(&storagetypes.EventUpdateObjectContent{})
+	EventUpdateObjectContentSuccess = proto.MessageName(&storagetypes.EventUpdateObjectContentSuccess{})
+	EventCancelUpdateObjectContent  = proto.MessageName(&storagetypes.EventCancelUpdateObjectContent{})
+	EventSetTag                     = proto.MessageName(&storagetypes.EventSetTag{})
+	EventDeleteGroup                = proto.MessageName(&storagetypes.EventDeleteGroup{})
+	EventLeaveGroup                 = proto.MessageName(&storagetypes.EventLeaveGroup{})
+	EventUpdateGroupMember          = proto.MessageName(&storagetypes.EventUpdateGroupMember{})
+	EventRenewGroupMember           = proto.MessageName(&storagetypes.EventRenewGroupMember{})
+	EventPutPolicy                  = proto.MessageName(&permissiontypes.EventPutPolicy{})
+	EventDeletePolicy               = proto.MessageName(&permissiontypes.EventDeletePolicy{})
+)
+
+var CacheInvalidationEvents = map[string]bool{
+	EventCreateBucket:               true,
+	EventDeleteBucket:               true,
+	EventUpdateBucketInfo:           true,
+	EventDiscontinueBucket:          true,
+	EventMigrationBucket:            true,
+	EventCancelMigrationBucket:      true,
+	EventRejectMigrateBucket:        true,
+	EventCompleteMigrationBucket:    true,
+	EventToggleSPAsDelegatedAgent:   true,
+	EventBucketFlowRateLimitStatus:  true,
+	EventCreateObject:               true,
+	EventCancelCreateObject:         true,
+	EventSealObject:                 true,
+	EventCopyObject:                 true,
+	EventDeleteObject:               true,
+	EventRejectSealObject:           true,
+	EventDiscontinueObject:          true,
+	EventUpdateObjectInfo:           true,
+	EventUpdateObjectContent:        true,
+	EventUpdateObjectContentSuccess: true,
+	EventCancelUpdateObjectContent:  true,
+	EventSetTag:                     true,
+	EventDeleteGroup:                true,
+	EventLeaveGroup:                 true,
+	EventUpdateGroupMember:          true,
+	EventRenewGroupMember:           true,
+	EventPutPolicy:                  true,
+	EventDeletePolicy:               true,
+}
+
+func (m *Module) ExtractEventStatements(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) (map[string][]interface{}, error) {
+	if !CacheInvalidationEvents[event.Type] {
+		return nil, nil
+	}
+
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+	if err != nil {
+		log.Errorw("parse typed events error", "module", m.Name(), "event", event, "err", err)
+		return nil, err
+	}
+
+	var invalidations []*bsdb.CacheInvalidation
+	switch e := typedEvent.(type) {
+	case *storagetypes.EventCreateBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventDeleteBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventUpdateBucketInfo:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventDiscontinueBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventMigrationBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventCancelMigrationBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventRejectMigrateBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventCompleteMigrationBucket:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventToggleSPAsDelegatedAgent:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventBucketFlowRateLimitStatus:
+		invalidations = append(invalidations, bucketInvalidation(e.BucketName, e.BucketId.BigInt()))
+	case *storagetypes.EventCreateObject:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventCancelCreateObject:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventSealObject:
+		// the storage size of the bucket is changed with the object
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()),
+			bucketInvalidation(e.BucketName, nil))
+	case *storagetypes.EventCopyObject:
+		invalidations = append(invalidations, objectInvalidation(e.DstBucketName, e.DstObjectName, e.DstObjectId.BigInt()))
+	case *storagetypes.EventDeleteObject:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()),
+			bucketInvalidation(e.BucketName, nil))
+	case *storagetypes.EventRejectSealObject:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventDiscontinueObject:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, "", e.ObjectId.BigInt()))
+	case *storagetypes.EventUpdateObjectInfo:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventUpdateObjectContent:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventUpdateObjectContentSuccess:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()),
+			bucketInvalidation(e.BucketName, nil))
+	case *storagetypes.EventCancelUpdateObjectContent:
+		invalidations = append(invalidations, objectInvalidation(e.BucketName, e.ObjectName, e.ObjectId.BigInt()))
+	case *storagetypes.EventSetTag:
+		var grn types.GRN
+		if err = grn.ParseFromString(e.Resource, true); err != nil {
+			log.Errorw("type parsing error", "type", "GRN", "value", e.Resource)
+			return nil, errors.New("set tag event assert error")
+		}
+		switch grn.ResourceType() {
+		case resource.RESOURCE_TYPE_BUCKET:
+			bucketName, _ := grn.GetBucketName()
+			invalidations = append(invalidations, bucketInvalidation(bucketName, nil))
+		case resource.RESOURCE_TYPE_OBJECT:
+			bucketName, objectName, _ := grn.GetBucketAndObjectName()
+			invalidations = append(invalidations, objectInvalidation(bucketName, objectName, nil))
+		}
+	case *storagetypes.EventDeleteGroup:
+		invalidations = append(invalidations, groupMemberInvalidation(e.GroupId.BigInt(), ""))
+	case *storagetypes.EventLeaveGroup:
+		invalidations = append(invalidations, groupMemberInvalidation(e.GroupId.BigInt(), e.MemberAddress))
+	case *storagetypes.EventUpdateGroupMember:
+		for _, member := range e.MembersToAdd {
+			invalidations = append(invalidations, groupMemberInvalidation(e.GroupId.BigInt(), member.Member))
+		}
+		for _, member := range e.MembersToDelete {
+			invalidations = append(invalidations, groupMemberInvalidation(e.GroupId.BigInt(), member))
+		}
+	case *storagetypes.EventRenewGroupMember:
+		for _, member := range e.Members {
+			invalidations = append(invalidations, groupMemberInvalidation(e.GroupId.BigInt(), member.Member))
+		}
+	case *permissiontypes.EventPutPolicy:
+		invalidations = append(invalidations, &bsdb.CacheInvalidation{
+			Kind:         bsdb.CacheInvalidationPolicy,
+			ResourceType: e.ResourceType.String(),
+			ResourceID:   common.BigToHash(e.ResourceId.BigInt()),
+			PolicyID:     common.BigToHash(e.PolicyId.BigInt()),
+		})
+	case *permissiontypes.EventDeletePolicy:
+		invalidations = append(invalidations, &bsdb.CacheInvalidation{
+			Kind:     bsdb.CacheInvalidationPolicy,
+			PolicyID: common.BigToHash(e.PolicyId.BigInt()),
+		})
+	default:
+		log.Errorw("type assert error", "type", event.Type, "event", typedEvent)
+		return nil, errors.New("cache invalidation event assert error")
+	}
+	if len(invalidations) == 0 {
+		return nil, nil
+	}
+
+	for _, invalidation := range invalidations {
+		invalidation.CreateAt = block.Block.Height
+		invalidation.CreateTime = block.Block.Time.UTC().Unix()
+	}
+	k, v := m.db.SaveCacheInvalidations(ctx, invalidations)
+	return map[string][]interface{}{
+		k: v,
+	}, nil
+}
+
+// HandleEvent handles the events relevant to the cache invalidation.
+func (m *Module) HandleEvent(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) error {
+	return nil
+}
+
+// bucketInvalidation returns the invalidation of the bucket, the bucket id is optional.
+func bucketInvalidation(bucketName string, bucketID *big.Int) *bsdb.CacheInvalidation {
+	invalidation := &bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationBucket, BucketName: bucketName}
+	if bucketID != nil {
+		invalidation.ResourceID = common.BigToHash(bucketID)
+	}
+	return invalidation
+}
+
+// objectInvalidation returns the invalidation of the object, either the object name or the object id is optional.
+func objectInvalidation(bucketName, objectName string, objectID *big.Int) *bsdb.CacheInvalidation {
+	invalidation := &bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationObject, BucketName: bucketName, ObjectName: objectName}
+	if objectID != nil {
+		invalidation.ResourceID = common.BigToHash(objectID)
+	}
+	return invalidation
+}
+
+// groupMemberInvalidation returns the invalidation of the group member, all the members if the member is empty.
+func groupMemberInvalidation(groupID *big.Int, member string) *bsdb.CacheInvalidation {
+	invalidation := &bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationGroupMember, ResourceID: common.BigToHash(groupID)}
+	if member != "" {
+		invalidation.Account = common.HexToAddress(member)
+	}
+	return invalidation
+}
@@ -0,0 +1,103 @@
+package notification
+
+import (
+	"context"
+	"errors"
+
+	abci "github.com/cometbft/cometbft/abci/types"
+	tmctypes "github.com/cometbft/cometbft/rpc/core/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/cosmos/gogoproto/proto"
+	"github.com/forbole/juno/v4/common"
+	"github.com/forbole/juno/v4/log"
+
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+var (
+	EventCreateObject            = proto.MessageName(&storagetypes.EventCreateObject{})
+	EventSealObject              = proto.MessageName(&storagetypes.EventSealObject{})
+	EventRejectSealObject        = proto.MessageName(&storagetypes.EventRejectSealObject{})
+	EventCancelCreateObject      = proto.MessageName(&storagetypes.EventCancelCreateObject{})
+	EventDeleteObject            = proto.MessageName(&storagetypes.EventDeleteObject{})
+	EventMigrationBucket         = proto.MessageName(&storagetypes.EventMigrationBucket{})
+	EventCompleteMigrationBucket = proto.MessageName(&storagetypes.EventCompleteMigrationBucket{})
+)
+
+// NotificationEvents maps the chain events to the notification event types.
+var NotificationEvents = map[string]string{
+	EventCreateObject:            bsdb.NotificationEventObjectCreated,
+	EventSealObject:              bsdb.NotificationEventObjectSealed,
+	EventRejectSealObject:        bsdb.NotificationEventObjectSealRejected,
+	EventCancelCreateObject:      bsdb.NotificationEventObjectCreateCanceled,
+	EventDeleteObject:            bsdb.NotificationEventObjectDeleted,
+	EventMigrationBucket:         bsdb.NotificationEventBucketMigrationStarted,
+	EventCompleteMigrationBucket: bsdb.NotificationEventBucketMigrationCompleted,
+}
+
+func (m *Module) ExtractEventStatements(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) (map[string][]interface{}, error) {
+	eventType, ok := NotificationEvents[event.Type]
+	if !ok {
+		return nil, nil
+	}
+
+	typedEvent, err := sdk.ParseTypedEvent(abci.Event(event))
+	if err != nil {
+		log.Errorw("parse typed events error", "module", m.Name(), "event", event, "err", err)
+		return nil, err
+	}
+
+	notificationEvent := &bsdb.NotificationEvent{
+		EventType:    eventType,
+		CreateAt:     block.Block.Height,
+		CreateTxHash: txHash,
+		CreateTime:   block.Block.Time.UTC().Unix(),
+	}
+	switch e := typedEvent.(type) {
+	case *storagetypes.EventCreateObject:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.ObjectName = e.ObjectName
+		notificationEvent.ObjectID = common.BigToHash(e.ObjectId.BigInt())
+		notificationEvent.Operator = common.HexToAddress(e.Creator)
+	case *storagetypes.EventSealObject:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.ObjectName = e.ObjectName
+		notificationEvent.ObjectID = common.BigToHash(e.ObjectId.BigInt())
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	case *storagetypes.EventRejectSealObject:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.ObjectName = e.ObjectName
+		notificationEvent.ObjectID = common.BigToHash(e.ObjectId.BigInt())
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	case *storagetypes.EventCancelCreateObject:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.ObjectName = e.ObjectName
+		notificationEvent.ObjectID = common.BigToHash(e.ObjectId.BigInt())
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	case *storagetypes.EventDeleteObject:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.ObjectName = e.ObjectName
+		notificationEvent.ObjectID = common.BigToHash(e.ObjectId.BigInt())
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	case *storagetypes.EventMigrationBucket:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	case *storagetypes.EventCompleteMigrationBucket:
+		notificationEvent.BucketName = e.BucketName
+		notificationEvent.Operator = common.HexToAddress(e.Operator)
+	default:
+		log.Errorw("type assert error", "type", event.Type, "event", typedEvent)
+		return nil, errors.New("notification event assert error")
+	}
+
+	k, v := m.db.SaveNotificationEvent(ctx, notificationEvent)
+	return map[string][]interface{}{
+		k: v,
+	}, nil
+}
+
+// HandleEvent handles the events relevant to the notification.
+func (m *Module) HandleEvent(ctx context.Context, block *tmctypes.ResultBlock, txHash common.Hash, event sdk.Event) error {
+	return nil
+}
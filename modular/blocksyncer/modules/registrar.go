@@ -8,9 +8,11 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/database"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/bucket"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/cacheinvalidation"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/events"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/general"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/group"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/notification"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/object"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/objectidmap"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/blocksyncer/modules/payment"
@@ -56,5 +58,7 @@ func (r *BlockSyncerRegistrar) BuildModules(ctx registrar.Context) modules.Modul
 		events.NewModule(db),
 		objectidmap.NewModule(db),
 		general.NewModule(db),
+		notification.NewModule(db),
+		cacheinvalidation.NewModule(db),
 	}
 }
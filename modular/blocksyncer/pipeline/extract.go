@@ -0,0 +1,56 @@
+package pipeline
+
+import (
+	"sync"
+)
+
+// ExtractFunc extracts the statements of the event at eventIdx by the extractor at extractorIdx.
+type ExtractFunc func(extractorIdx, eventIdx int) (map[string][]interface{}, error)
+
+// ExtractInParallel runs the extractors concurrently over the events of a block. Each extractor handles the
+// events one by one in order, so the extractors that keep the per-block context between the events work
+// as if they run alone. The statements of each event are merged in the order of the extractors, the later
+// extractor wins on the same statement, and the events without any statement are left out.
+//
+// The extractors must not depend on the statements of each other within the block, which holds since the
+// statements are only applied after all the events of the block are extracted.
+func ExtractInParallel(extractors, events int, extract ExtractFunc) ([]map[string][]interface{}, error) {
+	results := make([][]map[string][]interface{}, extractors)
+	errs := make([]error, extractors)
+	wg := &sync.WaitGroup{}
+	wg.Add(extractors)
+	for e := 0; e < extractors; e++ {
+		go func(extractorIdx int) {
+			defer wg.Done()
+			results[extractorIdx] = make([]map[string][]interface{}, events)
+			for eventIdx := 0; eventIdx < events; eventIdx++ {
+				sqls, err := extract(extractorIdx, eventIdx)
+				if err != nil {
+					errs[extractorIdx] = err
+					return
+				}
+				results[extractorIdx][eventIdx] = sqls
+			}
+		}(e)
+	}
+	wg.Wait()
+	for _, err := range errs {
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	allSQL := make([]map[string][]interface{}, 0, events)
+	for eventIdx := 0; eventIdx < events; eventIdx++ {
+		merged := make(map[string][]interface{})
+		for extractorIdx := 0; extractorIdx < extractors; extractorIdx++ {
+			for k, v := range results[extractorIdx][eventIdx] {
+				merged[k] = v
+			}
+		}
+		if len(merged) != 0 {
+			allSQL = append(allSQL, merged)
+		}
+	}
+	return allSQL, nil
+}
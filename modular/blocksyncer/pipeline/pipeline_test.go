@@ -0,0 +1,186 @@
+package pipeline
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+var mockErr = errors.New("mock error")
+
+// orderedConsumer processes the fetched heights strictly in order like the block syncer indexer.
+type orderedConsumer struct {
+	mu        sync.Mutex
+	store     map[uint64]bool
+	processed uint64
+	maxStored int
+	evicted   map[uint64]bool
+}
+
+func (c *orderedConsumer) fetch(_ context.Context, height uint64) error {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.store[height] = true
+	if len(c.store) > c.maxStored {
+		c.maxStored = len(c.store)
+	}
+	return nil
+}
+
+func (c *orderedConsumer) evict(height uint64) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	delete(c.store, height)
+	c.evicted[height] = true
+}
+
+func (c *orderedConsumer) processedHeight() uint64 {
+	return atomic.LoadUint64(&c.processed)
+}
+
+func (c *orderedConsumer) processNext() bool {
+	next := c.processedHeight() + 1
+	c.mu.Lock()
+	ok := c.store[next]
+	delete(c.store, next)
+	c.mu.Unlock()
+	if ok {
+		atomic.StoreUint64(&c.processed, next)
+	}
+	return ok
+}
+
+func TestPrefetcher_Run(t *testing.T) {
+	c := &orderedConsumer{store: make(map[uint64]bool), evicted: make(map[uint64]bool), processed: 9}
+	p := NewPrefetcher(4, 8, c.fetch, c.evict, func() uint64 { return 100 }, c.processedHeight)
+	p.SetIntervals(time.Millisecond, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	done := make(chan struct{})
+	go func() {
+		p.Run(ctx, 10)
+		close(done)
+	}()
+
+	deadline := time.Now().Add(10 * time.Second)
+	for c.processedHeight() < 100 && time.Now().Before(deadline) {
+		if !c.processNext() {
+			time.Sleep(time.Millisecond)
+		}
+	}
+	assert.Equal(t, uint64(100), c.processedHeight())
+	// the window bounds the stored blocks
+	c.mu.Lock()
+	assert.LessOrEqual(t, c.maxStored, 8)
+	c.mu.Unlock()
+
+	assert.Eventually(t, func() bool {
+		c.mu.Lock()
+		defer c.mu.Unlock()
+		return len(c.store) == 0
+	}, 5*time.Second, time.Millisecond)
+	cancel()
+	<-done
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	assert.False(t, c.evicted[9])
+	assert.True(t, c.evicted[10])
+	assert.True(t, c.evicted[100])
+}
+
+func TestPrefetcher_RetryAndSkipProcessed(t *testing.T) {
+	var processed uint64
+	var attempts int64
+	var fetched sync.Map
+	fetch := func(_ context.Context, height uint64) error {
+		if height == 2 && atomic.AddInt64(&attempts, 1) < 3 {
+			return mockErr
+		}
+		fetched.Store(height, true)
+		return nil
+	}
+	p := NewPrefetcher(2, 4, fetch, func(uint64) {}, func() uint64 { return 3 },
+		func() uint64 { return atomic.LoadUint64(&processed) })
+	p.SetIntervals(time.Millisecond, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.Run(ctx, 1)
+
+	assert.Eventually(t, func() bool {
+		_, ok1 := fetched.Load(uint64(1))
+		_, ok2 := fetched.Load(uint64(2))
+		_, ok3 := fetched.Load(uint64(3))
+		return ok1 && ok2 && ok3
+	}, 5*time.Second, time.Millisecond)
+	assert.Equal(t, int64(3), atomic.LoadInt64(&attempts))
+	assert.Equal(t, uint64(3), p.Prefetched())
+	atomic.StoreUint64(&processed, 3)
+	assert.Equal(t, uint64(0), p.Prefetched())
+	assert.Eventually(t, func() bool { return p.Fetching() == 0 }, 5*time.Second, time.Millisecond)
+}
+
+func TestPrefetcher_StopFetchingProcessedHeight(t *testing.T) {
+	var processed uint64
+	var evicted sync.Map
+	fetch := func(_ context.Context, height uint64) error {
+		if atomic.LoadUint64(&processed) < height {
+			// the consumer processes the height by itself while it is failing
+			atomic.StoreUint64(&processed, height)
+			return mockErr
+		}
+		return nil
+	}
+	p := NewPrefetcher(1, 1, fetch, func(height uint64) { evicted.Store(height, true) }, func() uint64 { return 1 },
+		func() uint64 { return atomic.LoadUint64(&processed) })
+	p.SetIntervals(time.Millisecond, time.Millisecond)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go p.Run(ctx, 1)
+
+	assert.Eventually(t, func() bool {
+		_, ok := evicted.Load(uint64(1))
+		return ok
+	}, 5*time.Second, time.Millisecond)
+	assert.Eventually(t, func() bool { return p.Fetching() == 0 }, 5*time.Second, time.Millisecond)
+}
+
+func TestExtractInParallel(t *testing.T) {
+	// every extractor keeps a per-block state that depends on the order of the events
+	states := make([]int, 3)
+	allSQL, err := ExtractInParallel(3, 4, func(extractorIdx, eventIdx int) (map[string][]interface{}, error) {
+		states[extractorIdx]++
+		if eventIdx == 2 {
+			return nil, nil
+		}
+		sqls := map[string][]interface{}{
+			fmt.Sprintf("sql-%d-%d", extractorIdx, eventIdx): {states[extractorIdx]},
+			fmt.Sprintf("shared-%d", eventIdx):               {extractorIdx},
+		}
+		return sqls, nil
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, 3, len(allSQL))
+	for idx, eventIdx := range []int{0, 1, 3} {
+		assert.Equal(t, 4, len(allSQL[idx]))
+		for extractorIdx := 0; extractorIdx < 3; extractorIdx++ {
+			assert.Equal(t, []interface{}{eventIdx + 1}, allSQL[idx][fmt.Sprintf("sql-%d-%d", extractorIdx, eventIdx)])
+		}
+		assert.Equal(t, []interface{}{2}, allSQL[idx][fmt.Sprintf("shared-%d", eventIdx)])
+	}
+}
+
+func TestExtractInParallel_Error(t *testing.T) {
+	allSQL, err := ExtractInParallel(2, 3, func(extractorIdx, eventIdx int) (map[string][]interface{}, error) {
+		if extractorIdx == 1 && eventIdx == 1 {
+			return nil, mockErr
+		}
+		return map[string][]interface{}{"sql": nil}, nil
+	})
+	assert.Equal(t, mockErr, err)
+	assert.Nil(t, allSQL)
+}
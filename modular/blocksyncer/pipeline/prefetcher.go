@@ -0,0 +1,167 @@
+package pipeline
+
+import (
+	"context"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// DefaultDispatchInterval defines the interval of checking the latest and the processed heights.
+	DefaultDispatchInterval = 20 * time.Millisecond
+	// DefaultRetryInterval defines the interval of retrying a failed fetch.
+	DefaultRetryInterval = 500 * time.Millisecond
+)
+
+// FetchFunc fetches and decodes the block of the height, and stores it for the ordered consumer.
+type FetchFunc func(ctx context.Context, height uint64) error
+
+// EvictFunc drops the stored block of the height after the consumer has processed it.
+type EvictFunc func(height uint64)
+
+// HeightFunc returns a block height, such as the latest height of the chain or the processed height.
+type HeightFunc func() uint64
+
+// Prefetcher fetches the blocks concurrently ahead of the consumer, which processes the blocks strictly in
+// height order. At most maxInFlight blocks above the processed height are fetched or kept at any time, so
+// the memory is bounded no matter how far the consumer is behind the chain.
+type Prefetcher struct {
+	workers          int
+	maxInFlight      uint64
+	fetch            FetchFunc
+	evict            EvictFunc
+	latest           HeightFunc
+	processed        HeightFunc
+	dispatchInterval time.Duration
+	retryInterval    time.Duration
+
+	// fetching is the number of the heights that are being fetched
+	fetching int64
+	// dispatched is the highest height that has been dispatched to the workers
+	dispatched uint64
+}
+
+// NewPrefetcher returns a prefetcher with workers concurrent fetchers and a window of maxInFlight blocks.
+func NewPrefetcher(workers int, maxInFlight uint64, fetch FetchFunc, evict EvictFunc, latest, processed HeightFunc) *Prefetcher {
+	if workers <= 0 {
+		workers = 1
+	}
+	if maxInFlight == 0 {
+		maxInFlight = uint64(workers)
+	}
+	return &Prefetcher{
+		workers:          workers,
+		maxInFlight:      maxInFlight,
+		fetch:            fetch,
+		evict:            evict,
+		latest:           latest,
+		processed:        processed,
+		dispatchInterval: DefaultDispatchInterval,
+		retryInterval:    DefaultRetryInterval,
+	}
+}
+
+// SetIntervals overrides the dispatch and the retry intervals.
+func (p *Prefetcher) SetIntervals(dispatch, retry time.Duration) {
+	p.dispatchInterval = dispatch
+	p.retryInterval = retry
+}
+
+// Fetching returns the number of the heights that are being fetched.
+func (p *Prefetcher) Fetching() int64 {
+	return atomic.LoadInt64(&p.fetching)
+}
+
+// Prefetched returns the number of the heights above the processed height that have been dispatched,
+// including the ones that are being fetched.
+func (p *Prefetcher) Prefetched() uint64 {
+	dispatched, processed := atomic.LoadUint64(&p.dispatched), p.processed()
+	if dispatched <= processed {
+		return 0
+	}
+	return dispatched - processed
+}
+
+// Run dispatches the heights from start to the workers until the ctx is done. The consumer may process a
+// height that is not fetched yet by itself, the height is skipped or dropped by the prefetcher then.
+func (p *Prefetcher) Run(ctx context.Context, start uint64) {
+	if start == 0 {
+		start = 1
+	}
+	heights := make(chan uint64)
+	wg := &sync.WaitGroup{}
+	wg.Add(p.workers)
+	for i := 0; i < p.workers; i++ {
+		go func() {
+			defer wg.Done()
+			for height := range heights {
+				p.fetchHeight(ctx, height)
+			}
+		}()
+	}
+	defer func() {
+		close(heights)
+		wg.Wait()
+	}()
+
+	next, evicted := start, start-1
+	atomic.StoreUint64(&p.dispatched, start-1)
+	ticker := time.NewTicker(p.dispatchInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			log.Infof("Receive cancel signal, prefetcher routine will stop")
+			return
+		case <-ticker.C:
+		}
+		processed := p.processed()
+		if processed < start-1 {
+			processed = start - 1
+		}
+		for ; evicted < processed; evicted++ {
+			p.evict(evicted + 1)
+		}
+		if next <= processed {
+			next = processed + 1
+		}
+		latest := p.latest()
+		for ; next <= latest && next <= processed+p.maxInFlight; next++ {
+			select {
+			case <-ctx.Done():
+				return
+			case heights <- next:
+				atomic.StoreUint64(&p.dispatched, next)
+			}
+		}
+	}
+}
+
+// fetchHeight fetches the height until it succeeds, the ctx is done or the consumer has processed it.
+func (p *Prefetcher) fetchHeight(ctx context.Context, height uint64) {
+	atomic.AddInt64(&p.fetching, 1)
+	defer atomic.AddInt64(&p.fetching, -1)
+	for {
+		if ctx.Err() != nil || height <= p.processed() {
+			return
+		}
+		err := p.fetch(ctx, height)
+		if err == nil {
+			break
+		}
+		log.Warnw("failed to prefetch block, retry later", "height", height, "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(p.retryInterval):
+		}
+	}
+	// the consumer may have processed the height by itself and the eviction may have passed it while
+	// fetching, drop the stored block here so that it is not leaked
+	if height <= p.processed() {
+		p.evict(height)
+	}
+}
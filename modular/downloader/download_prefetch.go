@@ -0,0 +1,106 @@
+package downloader
+
+import (
+	"context"
+	"io"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+// segmentResult is the result of fetching a segment piece.
+type segmentResult struct {
+	data []byte
+	err  error
+}
+
+// segmentPrefetcher fetches the segment pieces of a download object task in parallel ahead of the one
+// being written, and writes them in order. The memory of the segment pieces fetched ahead is reserved
+// in the downloader resource scope, the segment piece being written is covered by the task limit.
+type segmentPrefetcher struct {
+	d          *DownloadModular
+	task       task.DownloadObjectTask
+	pieceInfos []*SegmentPieceInfo
+	window     int
+	span       rcmgr.ResourceScopeSpan
+
+	results  []chan segmentResult
+	reserved []int64
+	launched int
+}
+
+// streamSegments writes the data of the segment pieces to w in order.
+func (d *DownloadModular) streamSegments(ctx context.Context, downloadObjectTask task.DownloadObjectTask,
+	pieceInfos []*SegmentPieceInfo, w io.Writer) error {
+	ctx, cancel := context.WithCancel(ctx)
+	// stop the fetching ahead if failed to write
+	defer cancel()
+	p := &segmentPrefetcher{
+		d:          d,
+		task:       downloadObjectTask,
+		pieceInfos: pieceInfos,
+		window:     d.prefetchSegments,
+		results:    make([]chan segmentResult, len(pieceInfos)),
+		reserved:   make([]int64, len(pieceInfos)),
+	}
+	if d.scope != nil {
+		span, err := d.scope.BeginSpan()
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to begin prefetch resource span", "error", err)
+			return ErrExceedRequest
+		}
+		p.span = span
+		defer p.span.Done()
+	}
+
+	for idx := range pieceInfos {
+		p.launch(ctx, idx)
+		var result segmentResult
+		select {
+		case result = <-p.results[idx]:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		if result.err != nil {
+			return result.err
+		}
+		_, err := w.Write(result.data)
+		p.release(idx)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to write segment piece data", "piece_info", pieceInfos[idx], "error", err)
+			return err
+		}
+	}
+	return nil
+}
+
+// launch starts fetching the segment pieces from idx to the end of the prefetch window. The segment piece
+// idx is always fetched, the following ones are fetched only if their memory can be reserved, otherwise
+// they are retried when the window moves.
+func (p *segmentPrefetcher) launch(ctx context.Context, idx int) {
+	for p.launched < len(p.pieceInfos) && p.launched <= idx+p.window {
+		i := p.launched
+		if i > idx && p.span != nil {
+			size := int64(p.pieceInfos[i].Length)
+			if err := p.span.ReserveMemory(size, rcmgr.ReservationPriorityMedium); err != nil {
+				log.CtxDebugw(ctx, "stop prefetching segment piece due to memory limit", "index", i, "error", err)
+				return
+			}
+			p.reserved[i] = size
+		}
+		p.results[i] = make(chan segmentResult, 1)
+		go func() {
+			data, err := p.d.getSegmentPiece(ctx, p.task, p.pieceInfos[i])
+			p.results[i] <- segmentResult{data: data, err: err}
+		}()
+		p.launched++
+	}
+}
+
+func (p *segmentPrefetcher) release(idx int) {
+	if p.reserved[idx] > 0 {
+		p.span.ReleaseMemory(p.reserved[idx])
+		p.reserved[idx] = 0
+	}
+}
@@ -0,0 +1,144 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	lru "github.com/hashicorp/golang-lru"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupPrefetch(t *testing.T, window int) (*DownloadModular, *piecestore.MockPieceStore) {
+	d := setup(t)
+	d.prefetchSegments = window
+	d.pieceCache, _ = lru.New(100)
+	m := piecestore.NewMockPieceStore(gomock.NewController(t))
+	d.baseApp.SetPieceStore(m)
+	return d, m
+}
+
+func mockPrefetchTask() *gfsptask.GfSpDownloadObjectTask {
+	return &gfsptask.GfSpDownloadObjectTask{
+		Task:       &gfsptask.GfSpTask{},
+		ObjectInfo: &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)},
+	}
+}
+
+func mockSegmentPieceInfos(count int) []*SegmentPieceInfo {
+	pieceInfos := make([]*SegmentPieceInfo, count)
+	for i := range pieceInfos {
+		pieceInfos[i] = &SegmentPieceInfo{SegmentPieceKey: fmt.Sprint(i), Length: 1}
+	}
+	return pieceInfos
+}
+
+type failedWriter struct{}
+
+func (failedWriter) Write(p []byte) (int, error) {
+	return 0, mockErr
+}
+
+func TestStreamSegments_Ordered(t *testing.T) {
+	d, m := setupPrefetch(t, 3)
+	var inflight, maxInflight int32
+	// the earlier segments are got slower to complete the segments out of order
+	m.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, offset, limit int64) ([]byte, error) {
+			n := atomic.AddInt32(&inflight, 1)
+			defer atomic.AddInt32(&inflight, -1)
+			for {
+				max := atomic.LoadInt32(&maxInflight)
+				if n <= max || atomic.CompareAndSwapInt32(&maxInflight, max, n) {
+					break
+				}
+			}
+			time.Sleep(time.Duration(10-key[0]+'0') * time.Millisecond)
+			return []byte(key), nil
+		}).Times(8)
+
+	buf := &bytes.Buffer{}
+	err := d.streamSegments(context.Background(), mockPrefetchTask(), mockSegmentPieceInfos(8), buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "01234567", buf.String())
+	assert.True(t, maxInflight > 1)
+	assert.True(t, maxInflight <= 4)
+}
+
+func TestStreamSegments_ReserveMemory(t *testing.T) {
+	d, m := setupPrefetch(t, 3)
+	ctrl := gomock.NewController(t)
+	scope := rcmgr.NewMockResourceScope(ctrl)
+	span := rcmgr.NewMockResourceScopeSpan(ctrl)
+	d.scope = scope
+	scope.EXPECT().BeginSpan().Return(span, nil).Times(1)
+	// only one segment can be fetched ahead at a time
+	var reserved int32
+	span.EXPECT().ReserveMemory(int64(1), rcmgr.ReservationPriorityMedium).DoAndReturn(
+		func(size int64, prio uint8) error {
+			if !atomic.CompareAndSwapInt32(&reserved, 0, 1) {
+				return mockErr
+			}
+			return nil
+		}).AnyTimes()
+	span.EXPECT().ReleaseMemory(int64(1)).Do(func(size int64) {
+		atomic.StoreInt32(&reserved, 0)
+	}).Times(2)
+	span.EXPECT().Done().Times(1)
+	m.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, offset, limit int64) ([]byte, error) {
+			return []byte(key), nil
+		}).Times(5)
+
+	buf := &bytes.Buffer{}
+	err := d.streamSegments(context.Background(), mockPrefetchTask(), mockSegmentPieceInfos(5), buf)
+	assert.Nil(t, err)
+	assert.Equal(t, "01234", buf.String())
+}
+
+func TestStreamSegments_BeginSpanFailure(t *testing.T) {
+	d, _ := setupPrefetch(t, 3)
+	scope := rcmgr.NewMockResourceScope(gomock.NewController(t))
+	d.scope = scope
+	scope.EXPECT().BeginSpan().Return(nil, mockErr).Times(1)
+
+	err := d.streamSegments(context.Background(), mockPrefetchTask(), mockSegmentPieceInfos(2), &bytes.Buffer{})
+	assert.Equal(t, ErrExceedRequest, err)
+}
+
+func TestStreamSegments_GetPieceFailure(t *testing.T) {
+	d, m := setupPrefetch(t, 2)
+	m.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, offset, limit int64) ([]byte, error) {
+			if key == "1" {
+				return nil, mockErr
+			}
+			return []byte(key), nil
+		}).AnyTimes()
+
+	buf := &bytes.Buffer{}
+	err := d.streamSegments(context.Background(), mockPrefetchTask(), mockSegmentPieceInfos(5), buf)
+	assert.Contains(t, err.Error(), mockErr.Error())
+	assert.Equal(t, "0", buf.String())
+}
+
+func TestStreamSegments_WriteFailure(t *testing.T) {
+	d, m := setupPrefetch(t, 2)
+	m.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, offset, limit int64) ([]byte, error) {
+			return []byte(key), nil
+		}).AnyTimes()
+
+	err := d.streamSegments(context.Background(), mockPrefetchTask(), mockSegmentPieceInfos(5), failedWriter{})
+	assert.Equal(t, mockErr, err)
+}
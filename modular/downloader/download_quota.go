@@ -0,0 +1,118 @@
+package downloader
+
+import (
+	"context"
+	"io"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/sqldb"
+)
+
+const (
+	// ReadQuotaExpireInterval defines the interval of refunding the expired read quota reservations.
+	ReadQuotaExpireInterval = time.Minute
+	// ReadQuotaExpireLimit defines the max number of the read quota reservations refunded in a batch.
+	ReadQuotaExpireLimit = 100
+)
+
+// readQuotaReservation is the read quota reserved by a download task before the data is served.
+type readQuotaReservation struct {
+	readRecordID uint64
+	reservedSize uint64
+	sentSize     int64
+}
+
+// reserveReadQuota checks and reserves the read quota of the record for the download task, the reservation
+// is settled by PostDownloadObject or PostDownloadPiece, or refunded after the reservation timeout.
+func (d *DownloadModular) reserveReadQuota(ctx context.Context, t task.Task, record *spdb.ReadRecord,
+	quota *spdb.BucketQuota) error {
+	expireTimeUs := record.ReadTimestampUs + d.reservationTimeout*int64(time.Second/time.Microsecond)
+	readRecordID, err := d.baseApp.GfSpDBWithContext(ctx).ReserveReadQuota(record, quota, expireTimeUs)
+	if err != nil {
+		return err
+	}
+	d.reservations.Store(t, &readQuotaReservation{readRecordID: readRecordID, reservedSize: record.ReadSize})
+	log.CtxDebugw(ctx, "succeed to reserve read quota", "read_record_id", readRecordID, "size", record.ReadSize)
+	return nil
+}
+
+// loadReadQuota returns the read quota reserved by the download task, returns nil if no quota is reserved.
+func (d *DownloadModular) loadReadQuota(t task.Task) *readQuotaReservation {
+	value, ok := d.reservations.Load(t)
+	if !ok {
+		return nil
+	}
+	return value.(*readQuotaReservation)
+}
+
+// settleReadQuota consumes the quota of the consumedSize and refunds the remained quota reserved by the
+// download task.
+func (d *DownloadModular) settleReadQuota(ctx context.Context, t task.Task, consumedSize func(*readQuotaReservation) uint64) {
+	value, ok := d.reservations.LoadAndDelete(t)
+	if !ok {
+		return
+	}
+	reservation := value.(*readQuotaReservation)
+	size := consumedSize(reservation)
+	var err error
+	if size == 0 {
+		err = d.baseApp.GfSpDBWithContext(ctx).ReleaseReadQuota(reservation.readRecordID)
+	} else {
+		err = d.baseApp.GfSpDBWithContext(ctx).CommitReadQuota(reservation.readRecordID, size)
+	}
+	if err != nil {
+		// the reservation that failed to settle is refunded after it expires
+		log.CtxErrorw(ctx, "failed to settle read quota", "read_record_id", reservation.readRecordID,
+			"reserved_size", reservation.reservedSize, "consumed_size", size, "error", err)
+		return
+	}
+	log.CtxDebugw(ctx, "succeed to settle read quota", "read_record_id", reservation.readRecordID,
+		"reserved_size", reservation.reservedSize, "consumed_size", size)
+}
+
+// expireReadQuotaLoop refunds the expired read quota reservations periodically, e.g. the ones reserved by
+// the downloader that exits before the download tasks finish.
+func (d *DownloadModular) expireReadQuotaLoop(ctx context.Context) {
+	ticker := time.NewTicker(ReadQuotaExpireInterval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			d.expireReadQuota(ctx)
+		}
+	}
+}
+
+func (d *DownloadModular) expireReadQuota(ctx context.Context) {
+	for {
+		expired, err := d.baseApp.GfSpDBWithContext(ctx).ExpireReadQuota(sqldb.GetCurrentTimestampUs(), ReadQuotaExpireLimit)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to expire read quota", "error", err)
+			return
+		}
+		if expired > 0 {
+			log.CtxInfow(ctx, "succeed to expire read quota", "expired", expired)
+		}
+		if expired < ReadQuotaExpireLimit {
+			return
+		}
+	}
+}
+
+// sentSizeWriter counts the size of the data that has been written to w.
+type sentSizeWriter struct {
+	w    io.Writer
+	size *int64
+}
+
+func (w *sentSizeWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	atomic.AddInt64(w.size, int64(n))
+	return n, err
+}
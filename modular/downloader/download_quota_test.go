@@ -0,0 +1,85 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+func setupReadQuota(t *testing.T) (*DownloadModular, *spdb.MockSPDB) {
+	d := setup(t)
+	d.reservationTimeout = 1
+	mockSPDB := spdb.NewMockSPDB(gomock.NewController(t))
+	d.baseApp.SetGfSpDB(mockSPDB)
+	return d, mockSPDB
+}
+
+func TestDownloadModular_settleReadQuotaOfObject(t *testing.T) {
+	d, mockSPDB := setupReadQuota(t)
+	downloadTask := mockPrefetchTask()
+	record := &spdb.ReadRecord{ReadSize: 10, ReadTimestampUs: 1}
+	mockSPDB.EXPECT().ReserveReadQuota(record, gomock.Any(), int64(1000001)).Return(uint64(5), nil).Times(1)
+	assert.Nil(t, d.reserveReadQuota(context.TODO(), downloadTask, record, &spdb.BucketQuota{}))
+
+	// only the quota of the sent data is consumed
+	w := &sentSizeWriter{w: &bytes.Buffer{}, size: &d.loadReadQuota(downloadTask).sentSize}
+	_, err := w.Write([]byte("mock"))
+	assert.Nil(t, err)
+	mockSPDB.EXPECT().CommitReadQuota(uint64(5), uint64(4)).Return(nil).Times(1)
+	d.PostDownloadObject(context.TODO(), downloadTask)
+	assert.Nil(t, d.loadReadQuota(downloadTask))
+
+	// settled only once
+	d.PostDownloadObject(context.TODO(), downloadTask)
+}
+
+func TestDownloadModular_settleReadQuotaOfPiece(t *testing.T) {
+	d, mockSPDB := setupReadQuota(t)
+	downloadTask := &gfsptask.GfSpDownloadPieceTask{
+		Task:       &gfsptask.GfSpTask{},
+		ObjectInfo: &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)},
+	}
+	record := &spdb.ReadRecord{ReadSize: 10}
+	mockSPDB.EXPECT().ReserveReadQuota(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(5), nil).Times(2)
+
+	assert.Nil(t, d.reserveReadQuota(context.TODO(), downloadTask, record, &spdb.BucketQuota{}))
+	mockSPDB.EXPECT().CommitReadQuota(uint64(5), uint64(10)).Return(nil).Times(1)
+	d.PostDownloadPiece(context.TODO(), downloadTask)
+
+	// all the quota is refunded if failed to download
+	assert.Nil(t, d.reserveReadQuota(context.TODO(), downloadTask, record, &spdb.BucketQuota{}))
+	downloadTask.SetError(errors.New("mock error"))
+	mockSPDB.EXPECT().ReleaseReadQuota(uint64(5)).Return(errors.New("mock error")).Times(1)
+	d.PostDownloadPiece(context.TODO(), downloadTask)
+	assert.Nil(t, d.loadReadQuota(downloadTask))
+}
+
+func TestDownloadModular_reserveReadQuotaFailure(t *testing.T) {
+	d, mockSPDB := setupReadQuota(t)
+	downloadTask := mockPrefetchTask()
+	mockSPDB.EXPECT().ReserveReadQuota(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(0), errors.New("mock error")).Times(1)
+	assert.NotNil(t, d.reserveReadQuota(context.TODO(), downloadTask, &spdb.ReadRecord{}, &spdb.BucketQuota{}))
+	assert.Nil(t, d.loadReadQuota(downloadTask))
+	d.PostDownloadObject(context.TODO(), downloadTask)
+}
+
+func TestDownloadModular_expireReadQuota(t *testing.T) {
+	d, mockSPDB := setupReadQuota(t)
+	gomock.InOrder(
+		mockSPDB.EXPECT().ExpireReadQuota(gomock.Any(), ReadQuotaExpireLimit).Return(ReadQuotaExpireLimit, nil),
+		mockSPDB.EXPECT().ExpireReadQuota(gomock.Any(), ReadQuotaExpireLimit).Return(1, nil),
+	)
+	d.expireReadQuota(context.TODO())
+
+	mockSPDB.EXPECT().ExpireReadQuota(gomock.Any(), ReadQuotaExpireLimit).Return(0, errors.New("mock error")).Times(1)
+	d.expireReadQuota(context.TODO())
+}
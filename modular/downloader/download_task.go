@@ -3,6 +3,7 @@ package downloader
 import (
 	"context"
 	"errors"
+	"io"
 	"net/http"
 	"strings"
 	"sync/atomic"
@@ -70,7 +71,7 @@ func (d *DownloadModular) PreDownloadObject(ctx context.Context, downloadObjectT
 		return ErrDownloadStatus
 	}
 	if downloadObjectTask.GetObjectInfo().GetObjectStatus() == storagetypes.OBJECT_STATUS_CREATED {
-		_, err = d.baseApp.GfSpDB().GetObjectIntegrity(downloadObjectTask.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+		_, err = d.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(downloadObjectTask.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to pre download piece due to get object integrity", "error", err)
 			return ErrDownloadStatus
@@ -100,7 +101,7 @@ func (d *DownloadModular) PreDownloadObject(ctx context.Context, downloadObjectT
 	}
 	yearMonth := sqldb.TimestampYearMonth(readRecord.ReadTimestampUs)
 	bucketID := downloadObjectTask.GetBucketInfo().Id.Uint64()
-	bucketTraffic, err = d.baseApp.GfSpDB().GetBucketTraffic(bucketID, yearMonth)
+	bucketTraffic, err = d.baseApp.GfSpDBWithContext(ctx).GetBucketTraffic(bucketID, yearMonth)
 	if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 		log.CtxErrorw(ctx, "failed to get bucket traffic", "bucket_id", bucketID, "error", err)
 		return err
@@ -113,10 +114,10 @@ func (d *DownloadModular) PreDownloadObject(ctx context.Context, downloadObjectT
 			return ErrConsensusWithDetail("QuerySPFreeQuota error: " + err.Error())
 		}
 		// only need to set the free quota when init the traffic table for every month
-		err = d.baseApp.GfSpDB().InitBucketTraffic(readRecord, &spdb.BucketQuota{
+		err = d.baseApp.GfSpDBWithContext(ctx).InitBucketTraffic(readRecord, &spdb.BucketQuota{
 			ChargedQuotaSize:     downloadObjectTask.GetBucketInfo().GetChargedReadQuota(),
 			FreeQuotaSize:        freeQuotaSize,
-			MonthlyFreeQuotaSize: d.monthlyFreeQuota,
+			MonthlyFreeQuotaSize: atomic.LoadUint64(&d.monthlyFreeQuota),
 		})
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to init bucket traffic", "error", err)
@@ -124,9 +125,8 @@ func (d *DownloadModular) PreDownloadObject(ctx context.Context, downloadObjectT
 		}
 	}
 
-	// TODO:: spilt check and add record steps, check in pre download, add record in post download
-	if err = d.baseApp.GfSpDB().CheckQuotaAndAddReadRecord(
-		readRecord,
+	// reserve the quota of the read size, the quota of the unsent data is refunded in post download
+	if err = d.reserveReadQuota(ctx, downloadObjectTask, readRecord,
 		&spdb.BucketQuota{
 			ChargedQuotaSize: downloadObjectTask.GetBucketInfo().GetChargedReadQuota(),
 			FreeQuotaSize:    freeQuotaSize,
@@ -145,7 +145,7 @@ func (d *DownloadModular) PreDownloadObject(ctx context.Context, downloadObjectT
 	return nil
 }
 
-func (d *DownloadModular) HandleDownloadObjectTask(ctx context.Context, downloadObjectTask task.DownloadObjectTask) ([]byte, error) {
+func (d *DownloadModular) HandleDownloadObjectTask(ctx context.Context, downloadObjectTask task.DownloadObjectTask, w io.Writer) error {
 	var err error
 	defer func() {
 		if err != nil {
@@ -158,40 +158,45 @@ func (d *DownloadModular) HandleDownloadObjectTask(ctx context.Context, download
 	}()
 	if atomic.AddInt64(&d.downloading, 1) >= atomic.LoadInt64(&d.downloadParallel) {
 		log.CtxErrorw(ctx, "failed to download object due to max download concurrent",
-			"current_download_concurrent", d.downloading, "max_download_concurrent", d.downloadParallel,
+			"current_download_concurrent", d.downloading, "max_download_concurrent", atomic.LoadInt64(&d.downloadParallel),
 			"task_info", downloadObjectTask.Info())
 		err = ErrExceedRequest
-		return nil, err
+		return err
 	}
 
 	pieceInfos, err := SplitToSegmentPieceInfos(downloadObjectTask, d.baseApp.PieceOp())
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to generate piece info to download", "error", err)
-		return nil, err
+		return err
 	}
-	var data []byte
-	for _, pInfo := range pieceInfos {
-		key := cacheKey(pInfo.SegmentPieceKey, int64(pInfo.Offset), int64(pInfo.Length))
-		pieceData, has := d.pieceCache.Get(key)
-		if has {
-			data = append(data, pieceData.([]byte)...)
-			continue
-		}
-		piece, getPieceErr := d.baseApp.PieceStore().GetPiece(ctx, pInfo.SegmentPieceKey,
-			int64(pInfo.Offset), int64(pInfo.Length))
-		if getPieceErr != nil {
-			log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadObjectTask.Info(), "piece_info", pInfo, "error", getPieceErr)
-			pieceStoreErrDetail := "failed to get piece data from piece store, task_info: " + downloadObjectTask.Info() + ", error: " + err.Error()
-			if isErrNoSuchKey(err) {
-				return nil, ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
-			} else {
-				return nil, ErrPieceStoreWithDetail(pieceStoreErrDetail)
-			}
+	if reservation := d.loadReadQuota(downloadObjectTask); reservation != nil {
+		w = &sentSizeWriter{w: w, size: &reservation.sentSize}
+	}
+	err = d.streamSegments(ctx, downloadObjectTask, pieceInfos, w)
+	return err
+}
+
+// getSegmentPiece gets the data of the segment piece from the memory cache or the piece store.
+func (d *DownloadModular) getSegmentPiece(ctx context.Context, downloadObjectTask task.DownloadObjectTask,
+	pInfo *SegmentPieceInfo) ([]byte, error) {
+	key := cacheKey(pInfo.SegmentPieceKey, int64(pInfo.Offset), int64(pInfo.Length))
+	pieceData, has := d.pieceCache.Get(key)
+	if has {
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheMemoryTier, "hit").Inc()
+		return pieceData.([]byte), nil
+	}
+	metrics.PieceCacheCounter.WithLabelValues(PieceCacheMemoryTier, "miss").Inc()
+	piece, err := d.getPiece(ctx, pInfo.SegmentPieceKey, int64(pInfo.Offset), int64(pInfo.Length))
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadObjectTask.Info(), "piece_info", pInfo, "error", err)
+		pieceStoreErrDetail := "failed to get piece data from piece store, task_info: " + downloadObjectTask.Info() + ", error: " + err.Error()
+		if isErrNoSuchKey(err) {
+			return nil, ErrPieceStoreNoSuchKeyWithDetail(pieceStoreErrDetail)
 		}
-		d.pieceCache.Add(key, piece)
-		data = append(data, piece...)
+		return nil, ErrPieceStoreWithDetail(pieceStoreErrDetail)
 	}
-	return data, nil
+	d.pieceCache.Add(key, piece)
+	return piece, nil
 }
 
 type SegmentPieceInfo struct {
@@ -252,7 +257,11 @@ func SplitToSegmentPieceInfos(downloadObjectTask task.DownloadObjectTask, op pie
 	return pieceInfos, nil
 }
 
-func (d *DownloadModular) PostDownloadObject(context.Context, task.DownloadObjectTask) {
+func (d *DownloadModular) PostDownloadObject(ctx context.Context, downloadObjectTask task.DownloadObjectTask) {
+	// only the quota of the data that has been sent is consumed
+	d.settleReadQuota(ctx, downloadObjectTask, func(reservation *readQuotaReservation) uint64 {
+		return uint64(atomic.LoadInt64(&reservation.sentSize))
+	})
 }
 
 func (d *DownloadModular) PreDownloadPiece(ctx context.Context, downloadPieceTask task.DownloadPieceTask) error {
@@ -279,7 +288,7 @@ func (d *DownloadModular) PreDownloadPiece(ctx context.Context, downloadPieceTas
 		return ErrDownloadStatus
 	}
 	if downloadPieceTask.GetObjectInfo().GetObjectStatus() == storagetypes.OBJECT_STATUS_CREATED {
-		_, err = d.baseApp.GfSpDB().GetObjectIntegrity(downloadPieceTask.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+		_, err = d.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(downloadPieceTask.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to pre download piece due to get object integrity", "error", err)
 			return ErrDownloadStatus
@@ -315,7 +324,7 @@ func (d *DownloadModular) PreDownloadPiece(ctx context.Context, downloadPieceTas
 		}
 
 		yearMonth := sqldb.TimestampYearMonth(readRecord.ReadTimestampUs)
-		bucketTraffic, err = d.baseApp.GfSpDB().GetBucketTraffic(bucketID, yearMonth)
+		bucketTraffic, err = d.baseApp.GfSpDBWithContext(ctx).GetBucketTraffic(bucketID, yearMonth)
 		if err != nil && !errors.Is(err, gorm.ErrRecordNotFound) {
 			log.CtxErrorw(ctx, "failed to get bucket traffic", "task_info", downloadPieceTask.Info(), "error", err)
 			return err
@@ -331,10 +340,10 @@ func (d *DownloadModular) PreDownloadPiece(ctx context.Context, downloadPieceTas
 				"free_quota", freeQuotaSize)
 
 			// only need to set the free quota when init the traffic table for every month
-			err = d.baseApp.GfSpDB().InitBucketTraffic(readRecord, &spdb.BucketQuota{
+			err = d.baseApp.GfSpDBWithContext(ctx).InitBucketTraffic(readRecord, &spdb.BucketQuota{
 				ChargedQuotaSize:     downloadPieceTask.GetBucketInfo().GetChargedReadQuota(),
 				FreeQuotaSize:        freeQuotaSize,
-				MonthlyFreeQuotaSize: d.monthlyFreeQuota,
+				MonthlyFreeQuotaSize: atomic.LoadUint64(&d.monthlyFreeQuota),
 			})
 			if err != nil {
 				log.CtxErrorw(ctx, "failed to init bucket traffic", "error", err)
@@ -342,8 +351,7 @@ func (d *DownloadModular) PreDownloadPiece(ctx context.Context, downloadPieceTas
 			}
 		}
 
-		if dbErr := d.baseApp.GfSpDB().CheckQuotaAndAddReadRecord(
-			readRecord,
+		if dbErr := d.reserveReadQuota(ctx, downloadPieceTask, readRecord,
 			&spdb.BucketQuota{
 				ChargedQuotaSize: downloadPieceTask.GetBucketInfo().GetChargedReadQuota(),
 			},
@@ -380,7 +388,7 @@ func (d *DownloadModular) HandleDownloadPieceTask(ctx context.Context, downloadP
 	}()
 	if atomic.AddInt64(&d.downloading, 1) >= atomic.LoadInt64(&d.downloadParallel) {
 		log.CtxErrorw(ctx, "failed to download object due to max download concurrent",
-			"current_download_concurrent", d.downloading, "max_download_concurrent", d.downloadParallel,
+			"current_download_concurrent", d.downloading, "max_download_concurrent", atomic.LoadInt64(&d.downloadParallel),
 			"task_info", downloadPieceTask.Info())
 		err = ErrExceedRequest
 		return nil, err
@@ -391,11 +399,13 @@ func (d *DownloadModular) HandleDownloadPieceTask(ctx context.Context, downloadP
 		int64(downloadPieceTask.GetPieceLength()))
 	data, has := d.pieceCache.Get(key)
 	if has {
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheMemoryTier, "hit").Inc()
 		return data.([]byte), nil
 	}
+	metrics.PieceCacheCounter.WithLabelValues(PieceCacheMemoryTier, "miss").Inc()
 
 	putPieceTime := time.Now()
-	if pieceData, err = d.baseApp.PieceStore().GetPiece(ctx, downloadPieceTask.GetPieceKey(),
+	if pieceData, err = d.getPiece(ctx, downloadPieceTask.GetPieceKey(),
 		int64(downloadPieceTask.GetPieceOffset()), int64(downloadPieceTask.GetPieceLength())); err != nil {
 		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_put_piece_time").Observe(time.Since(putPieceTime).Seconds())
 		log.CtxErrorw(ctx, "failed to get piece data from piece store", "task_info", downloadPieceTask.Info(), "error", err)
@@ -409,7 +419,14 @@ func (d *DownloadModular) HandleDownloadPieceTask(ctx context.Context, downloadP
 	return pieceData, nil
 }
 
-func (d *DownloadModular) PostDownloadPiece(context.Context, task.DownloadPieceTask) {
+func (d *DownloadModular) PostDownloadPiece(ctx context.Context, downloadPieceTask task.DownloadPieceTask) {
+	// the piece data is returned as a whole, all the quota is refunded if failed to get it
+	d.settleReadQuota(ctx, downloadPieceTask, func(reservation *readQuotaReservation) uint64 {
+		if downloadPieceTask.Error() != nil {
+			return 0
+		}
+		return reservation.reservedSize
+	})
 }
 
 func (d *DownloadModular) PreChallengePiece(ctx context.Context, challengePieceTask task.ChallengePieceTask) error {
@@ -446,7 +463,7 @@ func (d *DownloadModular) HandleChallengePiece(ctx context.Context, challengePie
 	}()
 	if atomic.AddInt64(&d.challenging, 1) >= atomic.LoadInt64(&d.challengeParallel) {
 		log.CtxErrorw(ctx, "failed to get challenge piece info due to max challenge concurrent",
-			"current_challenge_concurrent", d.challenging, "max_challenge_concurrent", d.challengeParallel,
+			"current_challenge_concurrent", d.challenging, "max_challenge_concurrent", atomic.LoadInt64(&d.challengeParallel),
 			"task_info", challengePieceTask.Info())
 		err = ErrExceedRequest
 		return nil, nil, nil, err
@@ -454,7 +471,7 @@ func (d *DownloadModular) HandleChallengePiece(ctx context.Context, challengePie
 
 	pieceKey := d.baseApp.PieceOp().ChallengePieceKey(challengePieceTask.GetObjectInfo().Id.Uint64(), challengePieceTask.GetSegmentIdx(), challengePieceTask.GetRedundancyIdx(), challengePieceTask.GetObjectInfo().GetVersion())
 	getIntegrityTime := time.Now()
-	integrity, err = d.baseApp.GfSpDB().GetObjectIntegrity(challengePieceTask.GetObjectInfo().Id.Uint64(), challengePieceTask.GetRedundancyIdx())
+	integrity, err = d.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(challengePieceTask.GetObjectInfo().Id.Uint64(), challengePieceTask.GetRedundancyIdx())
 	metrics.PerfChallengeTimeHistogram.WithLabelValues("challenge_get_integrity_time").Observe(time.Since(getIntegrityTime).Seconds())
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get integrity hash", "task", challengePieceTask, "error", err)
@@ -494,6 +511,33 @@ func (d *DownloadModular) QueryTasks(context.Context, task.TKey) ([]task.Task, e
 	return nil, nil
 }
 
+// getPiece returns the data in the range of the piece, if the disk cache tier is enabled, the whole
+// piece is read from the piece store and cached, and the range is served from the cached piece.
+func (d *DownloadModular) getPiece(ctx context.Context, pieceKey string, offset, length int64) ([]byte, error) {
+	ctx = piecestore.ContextWithDownloadRead(ctx)
+	if d.pieceDiskCache == nil {
+		return d.baseApp.PieceStore().GetPiece(ctx, pieceKey, offset, length)
+	}
+	if data, ok := d.pieceDiskCache.Get(pieceKey, offset, length); ok {
+		return data, nil
+	}
+	piece, err := d.baseApp.PieceStore().GetPiece(ctx, pieceKey, 0, -1)
+	if err != nil {
+		return nil, err
+	}
+	d.pieceDiskCache.Put(pieceKey, piece)
+	end := int64(len(piece))
+	if length >= 0 {
+		end = offset + length
+	}
+	if offset < 0 || offset > end || end > int64(len(piece)) {
+		log.CtxErrorw(ctx, "failed to get piece data due to out of range", "piece_key", pieceKey,
+			"offset", offset, "length", length, "piece_size", len(piece))
+		return nil, ErrInvalidParam
+	}
+	return piece[offset:end], nil
+}
+
 func isErrNoSuchKey(err error) bool {
 	msg := err.Error()
 	return strings.Contains(msg, s3.ErrCodeNoSuchKey)
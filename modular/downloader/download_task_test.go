@@ -1,6 +1,7 @@
 package downloader
 
 import (
+	"bytes"
 	"context"
 	"fmt"
 	"testing"
@@ -211,7 +212,7 @@ func TestPreDownloadObject(t *testing.T) {
 	d.baseApp.SetConsensus(mockConsensusAPI)
 	mockConsensusAPI.EXPECT().QuerySPFreeQuota(gomock.Any(), gomock.Any()).Return(uint64(100), nil)
 	mockSPDB.EXPECT().InitBucketTraffic(gomock.Any(), gomock.Any()).Return(nil)
-	mockSPDB.EXPECT().CheckQuotaAndAddReadRecord(gomock.Any(), gomock.Any()).Return(nil)
+	mockSPDB.EXPECT().ReserveReadQuota(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil)
 	err = d.PreDownloadObject(context.TODO(), mockTask2)
 	assert.Nil(t, err)
 }
@@ -232,13 +233,13 @@ func TestHandleDownloadObjectTask(t *testing.T) {
 	}
 
 	// failed due to exceed max download concurrent
-	_, err := d.HandleDownloadObjectTask(context.TODO(), mockTask1)
+	err := d.HandleDownloadObjectTask(context.TODO(), mockTask1, &bytes.Buffer{})
 	assert.NotNil(t, err)
 
 	// failed due to object param wrong
 	d.downloading = 1
 	d.downloadParallel = 100
-	_, err = d.HandleDownloadObjectTask(context.TODO(), mockTask1)
+	err = d.HandleDownloadObjectTask(context.TODO(), mockTask1, &bytes.Buffer{})
 	assert.NotNil(t, err)
 
 	// succeed
@@ -265,8 +266,10 @@ func TestHandleDownloadObjectTask(t *testing.T) {
 	mockPieceStoreAPI := piecestore.NewMockPieceStore(ctrl)
 	d.baseApp.SetPieceStore(mockPieceStoreAPI)
 	mockPieceStoreAPI.EXPECT().GetPiece(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).Return([]byte{'1'}, nil)
-	_, err = d.HandleDownloadObjectTask(context.TODO(), mockTask2)
+	buf := &bytes.Buffer{}
+	err = d.HandleDownloadObjectTask(context.TODO(), mockTask2, buf)
 	assert.Nil(t, err)
+	assert.Equal(t, []byte{'1'}, buf.Bytes())
 }
 
 func TestPostDownloadObject(t *testing.T) {
@@ -339,7 +342,7 @@ func TestPreDownloadPiece(t *testing.T) {
 	mockConsensusAPI.EXPECT().QueryVirtualGroupFamily(gomock.Any(), gomock.Any()).Return(&virtualgrouptypes.GlobalVirtualGroupFamily{}, nil)
 	mockConsensusAPI.EXPECT().QuerySPByID(gomock.Any(), gomock.Any()).Return(&sptypes.StorageProvider{}, nil)
 	mockSPDB.EXPECT().InitBucketTraffic(gomock.Any(), gomock.Any()).Return(nil)
-	mockSPDB.EXPECT().CheckQuotaAndAddReadRecord(gomock.Any(), gomock.Any()).Return(nil)
+	mockSPDB.EXPECT().ReserveReadQuota(gomock.Any(), gomock.Any(), gomock.Any()).Return(uint64(1), nil)
 	err = d.PreDownloadPiece(context.TODO(), mockTask2)
 	assert.Nil(t, err)
 }
@@ -3,6 +3,7 @@ package downloader
 import (
 	"context"
 	"fmt"
+	"sync"
 
 	lru "github.com/hashicorp/golang-lru"
 
@@ -17,11 +18,17 @@ type DownloadModular struct {
 	baseApp           *gfspapp.GfSpBaseApp
 	scope             rcmgr.ResourceScope
 	pieceCache        *lru.Cache
+	pieceDiskCache    *PieceDiskCache
+	prefetchSegments  int
 	downloading       int64
 	downloadParallel  int64
 	challenging       int64
 	challengeParallel int64
 	monthlyFreeQuota  uint64
+	// reservations is the read quota reserved by the download tasks, keyed by the task, it is settled
+	// after the download task finishes.
+	reservations       sync.Map
+	reservationTimeout int64
 }
 
 func (d *DownloadModular) Name() string {
@@ -34,6 +41,7 @@ func (d *DownloadModular) Start(ctx context.Context) error {
 		return err
 	}
 	d.scope = scope
+	go d.expireReadQuotaLoop(ctx)
 	return nil
 }
 
@@ -17,12 +17,20 @@ const (
 	DefaultChallengePieceParallelPerNode = 10240
 	// DefaultBucketFreeQuota defines the default free read quota per bucket
 	DefaultBucketFreeQuota = 10 * 1024 * 1024 * 1024
+	// DefaultPieceDiskCacheSizeMB defines the default capacity of the on-disk piece cache tier
+	DefaultPieceDiskCacheSizeMB = 10 * 1024
+	// DefaultPrefetchSegments defines the default max number of segment pieces fetched ahead when
+	// downloading an object
+	DefaultPrefetchSegments = 4
+	// DefaultReadQuotaReservationTimeout defines the default seconds after which the read quota reserved by
+	// an unfinished download is refunded
+	DefaultReadQuotaReservationTimeout = 6 * 60 * 60
 )
 
 func NewDownloadModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig) (coremodule.Modular, error) {
 	downloader := &DownloadModular{baseApp: app}
 	if err := DefaultDownloaderOptions(downloader, cfg); err != nil {
-		return nil, nil
+		return nil, err
 	}
 	return downloader, nil
 }
@@ -41,6 +49,25 @@ func DefaultDownloaderOptions(downloader *DownloadModular, cfg *gfspconfig.GfSpC
 	}
 
 	downloader.pieceCache = cache
+	if cfg.Downloader.PieceDiskCacheDir != "" {
+		if cfg.Downloader.PieceDiskCacheSizeMB == 0 {
+			cfg.Downloader.PieceDiskCacheSizeMB = DefaultPieceDiskCacheSizeMB
+		}
+		diskCache, err := NewPieceDiskCache(cfg.Downloader.PieceDiskCacheDir,
+			int64(cfg.Downloader.PieceDiskCacheSizeMB)*1024*1024, cfg.Downloader.PieceDiskCachePolicy)
+		if err != nil {
+			return err
+		}
+		downloader.pieceDiskCache = diskCache
+	}
+	if cfg.Downloader.PrefetchSegments == 0 {
+		cfg.Downloader.PrefetchSegments = DefaultPrefetchSegments
+	}
+	downloader.prefetchSegments = cfg.Downloader.PrefetchSegments
+	if cfg.Downloader.ReadQuotaReservationTimeout == 0 {
+		cfg.Downloader.ReadQuotaReservationTimeout = DefaultReadQuotaReservationTimeout
+	}
+	downloader.reservationTimeout = cfg.Downloader.ReadQuotaReservationTimeout
 	downloader.downloadParallel = int64(cfg.Parallel.DownloadObjectParallelPerNode)
 	downloader.challengeParallel = int64(cfg.Parallel.ChallengePieceParallelPerNode)
 	if cfg.Quota.MonthlyFreeQuota == 0 {
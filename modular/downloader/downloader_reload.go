@@ -0,0 +1,46 @@
+package downloader
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+var _ gfspapp.ReloadableModular = &DownloadModular{}
+
+// ReloadableConfigs returns the configs that downloader can apply at runtime.
+func (d *DownloadModular) ReloadableConfigs() []string {
+	return []string{
+		"Parallel.DownloadObjectParallelPerNode",
+		"Parallel.ChallengePieceParallelPerNode",
+		"Quota.MonthlyFreeQuota",
+	}
+}
+
+// ReloadConfig updates the download and challenge parallel, the piece cache size and the monthly free quota.
+func (d *DownloadModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if cfg.Parallel.DownloadObjectParallelPerNode < 0 || cfg.Parallel.ChallengePieceParallelPerNode < 0 {
+		return nil, fmt.Errorf("invalid download parallel %d or challenge parallel %d",
+			cfg.Parallel.DownloadObjectParallelPerNode, cfg.Parallel.ChallengePieceParallelPerNode)
+	}
+	downloadParallel := cfg.Parallel.DownloadObjectParallelPerNode
+	if downloadParallel == 0 {
+		downloadParallel = DefaultDownloadObjectParallelPerNode
+	}
+	challengeParallel := cfg.Parallel.ChallengePieceParallelPerNode
+	if challengeParallel == 0 {
+		challengeParallel = DefaultChallengePieceParallelPerNode
+	}
+	monthlyFreeQuota := cfg.Quota.MonthlyFreeQuota
+	if monthlyFreeQuota == 0 {
+		monthlyFreeQuota = gfspapp.DefaultSpMonthlyFreeQuota
+	}
+	return func() {
+		atomic.StoreInt64(&d.downloadParallel, int64(downloadParallel))
+		atomic.StoreInt64(&d.challengeParallel, int64(challengeParallel))
+		atomic.StoreUint64(&d.monthlyFreeQuota, monthlyFreeQuota)
+		d.pieceCache.Resize(downloadParallel)
+	}, nil
+}
@@ -0,0 +1,38 @@
+package downloader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+func TestDownloadModular_ReloadConfig(t *testing.T) {
+	d, err := NewDownloadModular(&gfspapp.GfSpBaseApp{}, &gfspconfig.GfSpConfig{})
+	assert.Nil(t, err)
+	downloader := d.(*DownloadModular)
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Parallel.DownloadObjectParallelPerNode = 100
+	cfg.Parallel.ChallengePieceParallelPerNode = 50
+	cfg.Quota.MonthlyFreeQuota = 1024
+	apply, err := downloader.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(DefaultDownloadObjectParallelPerNode), downloader.downloadParallel)
+	apply()
+	assert.Equal(t, int64(100), downloader.downloadParallel)
+	assert.Equal(t, int64(50), downloader.challengeParallel)
+	assert.Equal(t, uint64(1024), downloader.monthlyFreeQuota)
+
+	apply, err = downloader.ReloadConfig(&gfspconfig.GfSpConfig{})
+	assert.Nil(t, err)
+	apply()
+	assert.Equal(t, int64(DefaultDownloadObjectParallelPerNode), downloader.downloadParallel)
+	assert.Equal(t, uint64(gfspapp.DefaultSpMonthlyFreeQuota), downloader.monthlyFreeQuota)
+
+	cfg.Parallel.ChallengePieceParallelPerNode = -1
+	_, err = downloader.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
@@ -0,0 +1,226 @@
+package downloader
+
+import (
+	"container/list"
+)
+
+const (
+	// LRUPieceCachePolicy defines the least recently used eviction policy of the disk piece cache.
+	LRUPieceCachePolicy = "lru"
+	// ARCPieceCachePolicy defines the adaptive replacement eviction policy of the disk piece cache,
+	// it keeps the frequently read pieces from being flushed by a scan of once read pieces.
+	ARCPieceCachePolicy = "arc"
+)
+
+// pieceCachePolicy decides which cached piece is evicted, the pieces are weighted by their size.
+// It is not thread safe, the cache should protect it.
+type pieceCachePolicy interface {
+	// add records the newly cached piece.
+	add(key string, size int64)
+	// touch records the hit of the cached piece.
+	touch(key string)
+	// remove forgets the cached piece.
+	remove(key string)
+	// victim returns the piece that should be evicted, and forgets it.
+	victim() (string, bool)
+}
+
+func newPieceCachePolicy(policy string, capacity int64) (pieceCachePolicy, bool) {
+	switch policy {
+	case "", LRUPieceCachePolicy:
+		return newLRUList(), true
+	case ARCPieceCachePolicy:
+		return newARCPolicy(capacity), true
+	default:
+		return nil, false
+	}
+}
+
+type lruEntry struct {
+	key  string
+	size int64
+}
+
+// lruList is the size weighted list that the front is the most recently used.
+type lruList struct {
+	list  *list.List
+	items map[string]*list.Element
+	bytes int64
+}
+
+var _ pieceCachePolicy = &lruList{}
+
+func newLRUList() *lruList {
+	return &lruList{list: list.New(), items: make(map[string]*list.Element)}
+}
+
+func (l *lruList) has(key string) bool {
+	_, ok := l.items[key]
+	return ok
+}
+
+func (l *lruList) add(key string, size int64) {
+	if elem, ok := l.items[key]; ok {
+		l.bytes += size - elem.Value.(*lruEntry).size
+		elem.Value.(*lruEntry).size = size
+		l.list.MoveToFront(elem)
+		return
+	}
+	l.items[key] = l.list.PushFront(&lruEntry{key: key, size: size})
+	l.bytes += size
+}
+
+func (l *lruList) touch(key string) {
+	if elem, ok := l.items[key]; ok {
+		l.list.MoveToFront(elem)
+	}
+}
+
+func (l *lruList) remove(key string) {
+	elem, ok := l.items[key]
+	if !ok {
+		return
+	}
+	l.list.Remove(elem)
+	delete(l.items, key)
+	l.bytes -= elem.Value.(*lruEntry).size
+}
+
+func (l *lruList) victim() (string, bool) {
+	entry, ok := l.oldest()
+	if !ok {
+		return "", false
+	}
+	l.remove(entry.key)
+	return entry.key, true
+}
+
+func (l *lruList) oldest() (*lruEntry, bool) {
+	elem := l.list.Back()
+	if elem == nil {
+		return nil, false
+	}
+	return elem.Value.(*lruEntry), true
+}
+
+func (l *lruList) size(key string) int64 {
+	if elem, ok := l.items[key]; ok {
+		return elem.Value.(*lruEntry).size
+	}
+	return 0
+}
+
+// arcPolicy is the size weighted adaptive replacement policy. The pieces read once are kept in t1,
+// the pieces read more than once are kept in t2, and the ghost lists b1 and b2 remember the pieces
+// recently evicted from them. A hit in the ghost list adapts the target size of t1.
+type arcPolicy struct {
+	capacity int64
+	// target is the adaptive target bytes of t1
+	target int64
+	t1, t2 *lruList
+	b1, b2 *lruList
+}
+
+var _ pieceCachePolicy = &arcPolicy{}
+
+func newARCPolicy(capacity int64) *arcPolicy {
+	return &arcPolicy{
+		capacity: capacity,
+		t1:       newLRUList(),
+		t2:       newLRUList(),
+		b1:       newLRUList(),
+		b2:       newLRUList(),
+	}
+}
+
+func (a *arcPolicy) add(key string, size int64) {
+	if a.t1.has(key) || a.t2.has(key) {
+		a.touch(key)
+		return
+	}
+	switch {
+	case a.b1.has(key):
+		// the piece is evicted from t1 too early, enlarge t1
+		delta := size
+		if a.b2.bytes > a.b1.bytes && a.b1.bytes > 0 {
+			delta = size * a.b2.bytes / a.b1.bytes
+		}
+		a.target = minInt64(a.capacity, a.target+delta)
+		a.b1.remove(key)
+		a.t2.add(key, size)
+	case a.b2.has(key):
+		// the piece is evicted from t2 too early, shrink t1
+		delta := size
+		if a.b1.bytes > a.b2.bytes && a.b2.bytes > 0 {
+			delta = size * a.b1.bytes / a.b2.bytes
+		}
+		a.target = maxInt64(0, a.target-delta)
+		a.b2.remove(key)
+		a.t2.add(key, size)
+	default:
+		a.t1.add(key, size)
+	}
+	a.trimGhost()
+}
+
+func (a *arcPolicy) touch(key string) {
+	if a.t1.has(key) {
+		size := a.t1.size(key)
+		a.t1.remove(key)
+		a.t2.add(key, size)
+		return
+	}
+	a.t2.touch(key)
+}
+
+func (a *arcPolicy) remove(key string) {
+	a.t1.remove(key)
+	a.t2.remove(key)
+}
+
+func (a *arcPolicy) victim() (string, bool) {
+	if a.t1.bytes > 0 && (a.t1.bytes > a.target || a.t2.bytes == 0) {
+		entry, _ := a.t1.oldest()
+		a.t1.remove(entry.key)
+		a.b1.add(entry.key, entry.size)
+		a.trimGhost()
+		return entry.key, true
+	}
+	entry, ok := a.t2.oldest()
+	if !ok {
+		return "", false
+	}
+	a.t2.remove(entry.key)
+	a.b2.add(entry.key, entry.size)
+	a.trimGhost()
+	return entry.key, true
+}
+
+// trimGhost bounds the ghost lists, t1 and b1 remember at most the capacity bytes, and all the
+// lists remember at most twice of the capacity bytes.
+func (a *arcPolicy) trimGhost() {
+	for a.t1.bytes+a.b1.bytes > a.capacity {
+		if _, ok := a.b1.victim(); !ok {
+			break
+		}
+	}
+	for a.t1.bytes+a.t2.bytes+a.b1.bytes+a.b2.bytes > 2*a.capacity {
+		if _, ok := a.b2.victim(); !ok {
+			break
+		}
+	}
+}
+
+func minInt64(a, b int64) int64 {
+	if a < b {
+		return a
+	}
+	return b
+}
+
+func maxInt64(a, b int64) int64 {
+	if a > b {
+		return a
+	}
+	return b
+}
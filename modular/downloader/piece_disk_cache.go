@@ -0,0 +1,349 @@
+package downloader
+
+import (
+	"bufio"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// PieceCacheMemoryTier defines the metrics label of the in-memory piece cache tier.
+	PieceCacheMemoryTier = "memory"
+	// PieceCacheDiskTier defines the metrics label of the on-disk piece cache tier.
+	PieceCacheDiskTier = "disk"
+
+	pieceCacheBlobDir  = "blobs"
+	pieceCacheIndexDir = "index"
+)
+
+var ErrUnsupportedPieceCachePolicy = errors.New("unsupported piece cache policy")
+
+// pieceCacheEntry is the cached piece, the data is stored in the blob named by its content digest,
+// so the pieces with the same content share one blob.
+type pieceCacheEntry struct {
+	digest string
+	size   int64
+}
+
+// PieceDiskCache is the content-addressed on-disk cache of the whole segment pieces, the range
+// reads of a piece are served from the cached whole piece. The piece key is mapped to the content
+// digest by the index files, and the blobs are reference counted by the piece keys. The total blob
+// bytes are bounded by the capacity, and the pieces are evicted by the pieceCachePolicy. The cached
+// pieces survive restarting.
+type PieceDiskCache struct {
+	mux      sync.Mutex
+	dir      string
+	capacity int64
+	usage    int64
+	policy   pieceCachePolicy
+	entries  map[string]*pieceCacheEntry
+	// blobRefs counts the piece keys that refer to the blob
+	blobRefs map[string]int
+}
+
+// NewPieceDiskCache returns the PieceDiskCache instance, and loads the cached pieces in the dir.
+func NewPieceDiskCache(dir string, capacity int64, policy string) (*PieceDiskCache, error) {
+	cachePolicy, ok := newPieceCachePolicy(policy, capacity)
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedPieceCachePolicy, policy)
+	}
+	for _, sub := range []string{pieceCacheBlobDir, pieceCacheIndexDir} {
+		if err := os.MkdirAll(filepath.Join(dir, sub), 0750); err != nil {
+			return nil, err
+		}
+	}
+	cache := &PieceDiskCache{
+		dir:      dir,
+		capacity: capacity,
+		policy:   cachePolicy,
+		entries:  make(map[string]*pieceCacheEntry),
+		blobRefs: make(map[string]int),
+	}
+	if err := cache.load(); err != nil {
+		return nil, err
+	}
+	return cache, nil
+}
+
+// Get returns the data in the range of the cached piece, the length of -1 means reading to the end.
+func (c *PieceDiskCache) Get(pieceKey string, offset, length int64) ([]byte, bool) {
+	c.mux.Lock()
+	entry, ok := c.entries[pieceKey]
+	if !ok {
+		c.mux.Unlock()
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheDiskTier, "miss").Inc()
+		return nil, false
+	}
+	c.policy.touch(pieceKey)
+	// the opened blob can still be read after it is evicted
+	file, err := os.Open(c.blobPath(entry.digest))
+	c.mux.Unlock()
+	if err != nil {
+		log.Errorw("failed to open cached piece", "piece_key", pieceKey, "error", err)
+		c.remove(pieceKey)
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheDiskTier, "miss").Inc()
+		return nil, false
+	}
+	defer file.Close()
+
+	if length < 0 {
+		length = entry.size - offset
+	}
+	if offset < 0 || length < 0 || offset+length > entry.size {
+		log.Errorw("failed to read cached piece due to out of range", "piece_key", pieceKey,
+			"offset", offset, "length", length, "piece_size", entry.size)
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheDiskTier, "miss").Inc()
+		return nil, false
+	}
+	data := make([]byte, length)
+	if _, err = file.ReadAt(data, offset); err != nil && !(errors.Is(err, io.EOF) && length == 0) {
+		log.Errorw("failed to read cached piece", "piece_key", pieceKey, "error", err)
+		c.remove(pieceKey)
+		metrics.PieceCacheCounter.WithLabelValues(PieceCacheDiskTier, "miss").Inc()
+		return nil, false
+	}
+	metrics.PieceCacheCounter.WithLabelValues(PieceCacheDiskTier, "hit").Inc()
+	return data, true
+}
+
+// Put caches the whole piece, the least valuable pieces are evicted to make room for it. The piece
+// larger than the capacity is not cached.
+func (c *PieceDiskCache) Put(pieceKey string, piece []byte) {
+	size := int64(len(piece))
+	if size > c.capacity {
+		return
+	}
+	c.mux.Lock()
+	_, cached := c.entries[pieceKey]
+	c.mux.Unlock()
+	if cached {
+		return
+	}
+
+	sum := sha256.Sum256(piece)
+	digest := hex.EncodeToString(sum[:])
+	if err := c.writeBlob(digest, piece); err != nil {
+		log.Errorw("failed to write cached piece", "piece_key", pieceKey, "error", err)
+		return
+	}
+	if err := writeFileAtomic(c.indexPath(pieceKey), []byte(digest+"\n"+pieceKey)); err != nil {
+		log.Errorw("failed to write cached piece index", "piece_key", pieceKey, "error", err)
+		c.mux.Lock()
+		c.releaseBlob(digest, size)
+		c.mux.Unlock()
+		return
+	}
+
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if _, ok := c.entries[pieceKey]; ok {
+		// cached by the concurrent put, the blob ref of this put is given back
+		c.releaseBlob(digest, size)
+		return
+	}
+	c.entries[pieceKey] = &pieceCacheEntry{digest: digest, size: size}
+	c.policy.add(pieceKey, size)
+	c.evict()
+	metrics.PieceDiskCacheUsageGauge.Set(float64(c.usage))
+}
+
+// writeBlob writes the blob if it is not written yet, and takes a ref of it.
+func (c *PieceDiskCache) writeBlob(digest string, data []byte) error {
+	c.mux.Lock()
+	if c.blobRefs[digest] > 0 {
+		c.blobRefs[digest]++
+		c.mux.Unlock()
+		return nil
+	}
+	c.mux.Unlock()
+	if err := writeFileAtomic(c.blobPath(digest), data); err != nil {
+		return err
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if c.blobRefs[digest] == 0 {
+		c.usage += int64(len(data))
+	}
+	c.blobRefs[digest]++
+	return nil
+}
+
+// releaseBlob gives back a ref of the blob, and deletes the blob if it has no ref.
+func (c *PieceDiskCache) releaseBlob(digest string, size int64) {
+	c.blobRefs[digest]--
+	if c.blobRefs[digest] > 0 {
+		return
+	}
+	delete(c.blobRefs, digest)
+	c.usage -= size
+	if err := os.Remove(c.blobPath(digest)); err != nil && !os.IsNotExist(err) {
+		log.Errorw("failed to remove cached piece blob", "digest", digest, "error", err)
+	}
+}
+
+// evict evicts the pieces until the usage is within the capacity, it is called with the lock held.
+func (c *PieceDiskCache) evict() {
+	for c.usage > c.capacity {
+		key, ok := c.policy.victim()
+		if !ok {
+			return
+		}
+		c.removeEntry(key)
+	}
+}
+
+func (c *PieceDiskCache) remove(pieceKey string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.policy.remove(pieceKey)
+	c.removeEntry(pieceKey)
+	metrics.PieceDiskCacheUsageGauge.Set(float64(c.usage))
+}
+
+// removeEntry deletes the index and the blob ref of the piece, it is called with the lock held.
+func (c *PieceDiskCache) removeEntry(pieceKey string) {
+	entry, ok := c.entries[pieceKey]
+	if !ok {
+		return
+	}
+	delete(c.entries, pieceKey)
+	if err := os.Remove(c.indexPath(pieceKey)); err != nil && !os.IsNotExist(err) {
+		log.Errorw("failed to remove cached piece index", "piece_key", pieceKey, "error", err)
+	}
+	c.releaseBlob(entry.digest, entry.size)
+}
+
+// Usage returns the bytes of the cached pieces.
+func (c *PieceDiskCache) Usage() int64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.usage
+}
+
+// load rebuilds the cache from the index files, the pieces are added to the policy in the order of
+// their index file modification time. The broken index files and the blobs without ref are deleted.
+func (c *PieceDiskCache) load() error {
+	indexDir := filepath.Join(c.dir, pieceCacheIndexDir)
+	indexFiles, err := os.ReadDir(indexDir)
+	if err != nil {
+		return err
+	}
+	type loadedEntry struct {
+		key     string
+		entry   *pieceCacheEntry
+		modTime int64
+	}
+	var loaded []*loadedEntry
+	for _, indexFile := range indexFiles {
+		path := filepath.Join(indexDir, indexFile.Name())
+		digest, pieceKey, err := readIndexFile(path)
+		if err != nil || c.indexPath(pieceKey) != path {
+			log.Warnw("remove broken piece cache index", "path", path, "error", err)
+			_ = os.Remove(path)
+			continue
+		}
+		blob, err := os.Stat(c.blobPath(digest))
+		if err != nil {
+			log.Warnw("remove piece cache index without blob", "path", path, "error", err)
+			_ = os.Remove(path)
+			continue
+		}
+		info, err := indexFile.Info()
+		if err != nil {
+			return err
+		}
+		loaded = append(loaded, &loadedEntry{key: pieceKey, entry: &pieceCacheEntry{digest: digest, size: blob.Size()},
+			modTime: info.ModTime().UnixNano()})
+	}
+	sort.Slice(loaded, func(i, j int) bool { return loaded[i].modTime < loaded[j].modTime })
+	for _, l := range loaded {
+		c.entries[l.key] = l.entry
+		if c.blobRefs[l.entry.digest] == 0 {
+			c.usage += l.entry.size
+		}
+		c.blobRefs[l.entry.digest]++
+		c.policy.add(l.key, l.entry.size)
+	}
+
+	blobDir := filepath.Join(c.dir, pieceCacheBlobDir)
+	blobShards, err := os.ReadDir(blobDir)
+	if err != nil {
+		return err
+	}
+	for _, shard := range blobShards {
+		blobs, err := os.ReadDir(filepath.Join(blobDir, shard.Name()))
+		if err != nil {
+			continue
+		}
+		for _, blob := range blobs {
+			if c.blobRefs[blob.Name()] == 0 {
+				_ = os.Remove(filepath.Join(blobDir, shard.Name(), blob.Name()))
+			}
+		}
+	}
+	c.evict()
+	metrics.PieceDiskCacheUsageGauge.Set(float64(c.usage))
+	log.Infow("succeed to load piece disk cache", "dir", c.dir, "piece_number", len(c.entries), "usage", c.usage)
+	return nil
+}
+
+func (c *PieceDiskCache) blobPath(digest string) string {
+	return filepath.Join(c.dir, pieceCacheBlobDir, digest[:2], digest)
+}
+
+func (c *PieceDiskCache) indexPath(pieceKey string) string {
+	sum := sha256.Sum256([]byte(pieceKey))
+	return filepath.Join(c.dir, pieceCacheIndexDir, hex.EncodeToString(sum[:]))
+}
+
+func readIndexFile(path string) (string, string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return "", "", err
+	}
+	defer file.Close()
+	reader := bufio.NewReader(file)
+	digest, err := reader.ReadString('\n')
+	if err != nil {
+		return "", "", err
+	}
+	digest = strings.TrimSuffix(digest, "\n")
+	if len(digest) != sha256.Size*2 {
+		return "", "", fmt.Errorf("invalid digest: %s", digest)
+	}
+	pieceKey, err := io.ReadAll(reader)
+	if err != nil {
+		return "", "", err
+	}
+	return digest, string(pieceKey), nil
+}
+
+func writeFileAtomic(path string, data []byte) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0750); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), ".tmp-*")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
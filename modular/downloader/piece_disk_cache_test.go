@@ -0,0 +1,136 @@
+package downloader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+)
+
+func mockPiece(b byte, size int) []byte {
+	return bytes.Repeat([]byte{b}, size)
+}
+
+func TestPieceDiskCache_GetRange(t *testing.T) {
+	cache, err := NewPieceDiskCache(t.TempDir(), 1024, LRUPieceCachePolicy)
+	require.NoError(t, err)
+	piece := []byte("0123456789")
+	cache.Put("piece", piece)
+
+	data, ok := cache.Get("piece", 2, 3)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("234"), data)
+	data, ok = cache.Get("piece", 4, -1)
+	assert.True(t, ok)
+	assert.Equal(t, []byte("456789"), data)
+	_, ok = cache.Get("piece", 8, 3)
+	assert.False(t, ok)
+	_, ok = cache.Get("other", 0, 1)
+	assert.False(t, ok)
+}
+
+func TestPieceDiskCache_ContentAddressed(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewPieceDiskCache(dir, 1024, LRUPieceCachePolicy)
+	require.NoError(t, err)
+	cache.Put("piece_1", mockPiece('a', 100))
+	cache.Put("piece_2", mockPiece('a', 100))
+	assert.Equal(t, int64(100), cache.Usage())
+
+	cache.remove("piece_1")
+	data, ok := cache.Get("piece_2", 0, -1)
+	assert.True(t, ok)
+	assert.Equal(t, mockPiece('a', 100), data)
+	cache.remove("piece_2")
+	assert.Equal(t, int64(0), cache.Usage())
+	shards, err := os.ReadDir(filepath.Join(dir, pieceCacheBlobDir))
+	require.NoError(t, err)
+	for _, shard := range shards {
+		blobs, err := os.ReadDir(filepath.Join(dir, pieceCacheBlobDir, shard.Name()))
+		require.NoError(t, err)
+		assert.Empty(t, blobs)
+	}
+}
+
+func TestPieceDiskCache_EvictAndReload(t *testing.T) {
+	dir := t.TempDir()
+	cache, err := NewPieceDiskCache(dir, 300, LRUPieceCachePolicy)
+	require.NoError(t, err)
+	for i := 0; i < 3; i++ {
+		cache.Put(fmt.Sprintf("piece_%d", i), mockPiece(byte('a'+i), 100))
+	}
+	_, ok := cache.Get("piece_0", 0, 1)
+	assert.True(t, ok)
+	cache.Put("piece_3", mockPiece('d', 100))
+	_, ok = cache.Get("piece_1", 0, 1)
+	assert.False(t, ok)
+	assert.Equal(t, int64(300), cache.Usage())
+
+	reloaded, err := NewPieceDiskCache(dir, 300, LRUPieceCachePolicy)
+	require.NoError(t, err)
+	assert.Equal(t, int64(300), reloaded.Usage())
+	for _, key := range []string{"piece_0", "piece_2", "piece_3"} {
+		_, ok = reloaded.Get(key, 0, -1)
+		assert.True(t, ok, key)
+	}
+
+	// the smaller capacity evicts the pieces at loading
+	reloaded, err = NewPieceDiskCache(dir, 100, ARCPieceCachePolicy)
+	require.NoError(t, err)
+	assert.Equal(t, int64(100), reloaded.Usage())
+}
+
+func TestPieceDiskCache_UnsupportedPolicy(t *testing.T) {
+	_, err := NewPieceDiskCache(t.TempDir(), 100, "mock")
+	assert.ErrorIs(t, err, ErrUnsupportedPieceCachePolicy)
+}
+
+func TestARCPolicy_KeepFrequentPieces(t *testing.T) {
+	policy := newARCPolicy(300)
+	// the frequent pieces are read twice and are kept in t2
+	for _, key := range []string{"hot_1", "hot_2"} {
+		policy.add(key, 100)
+		policy.touch(key)
+	}
+	// a scan of once read pieces only evicts the pieces in t1
+	policy.add("scan_0", 100)
+	for i := 1; i < 10; i++ {
+		policy.add(fmt.Sprintf("scan_%d", i), 100)
+		key, ok := policy.victim()
+		require.True(t, ok)
+		assert.Equal(t, fmt.Sprintf("scan_%d", i-1), key)
+	}
+	assert.True(t, policy.t2.has("hot_1"))
+	assert.True(t, policy.t2.has("hot_2"))
+
+	// the ghost hit of t1 enlarges the target of t1
+	policy.add("scan_8", 100)
+	assert.Equal(t, int64(100), policy.target)
+	assert.True(t, policy.t2.has("scan_8"))
+}
+
+func TestDownloadModular_GetPieceWithDiskCache(t *testing.T) {
+	ctrl := gomock.NewController(t)
+	pieceStore := piecestore.NewMockPieceStore(ctrl)
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "piece", int64(0), int64(-1)).Return([]byte("0123456789"), nil).Times(1)
+	cache, err := NewPieceDiskCache(t.TempDir(), 1024, ARCPieceCachePolicy)
+	require.NoError(t, err)
+	d := &DownloadModular{baseApp: &gfspapp.GfSpBaseApp{}, pieceDiskCache: cache}
+	d.baseApp.SetPieceStore(pieceStore)
+
+	data, err := d.getPiece(context.Background(), "piece", 1, 2)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("12"), data)
+	data, err = d.getPiece(context.Background(), "piece", 5, 5)
+	require.NoError(t, err)
+	assert.Equal(t, []byte("56789"), data)
+}
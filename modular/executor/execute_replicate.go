@@ -125,7 +125,11 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 		replicateCount = rTask.GetStorageParams().VersionedParams.GetRedundantDataChunkNum() +
 			rTask.GetStorageParams().VersionedParams.GetRedundantParityChunkNum()
 		secondarySignatures = make([][]byte, replicateCount)
+		outcomes            = newSPOutcomeCollector()
 	)
+	defer func() {
+		rTask.SetSPOutcomes(outcomes.list())
+	}()
 
 	log.Debugw("replicate task info", "task_sps", rTask.GetSecondaryEndpoints())
 
@@ -135,7 +139,7 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 			log.Debugw("start to replicate ec piece", "sp", sp)
 			wg.Add(1)
 			go func(redundancyIdx int, sp string) {
-				err = e.doReplicatePiece(ctx, &wg, rTask, sp, segIdx, int32(redundancyIdx), data[redundancyIdx])
+				err = e.doReplicatePiece(ctx, &wg, rTask, sp, segIdx, int32(redundancyIdx), data[redundancyIdx], outcomes)
 				if err != nil {
 					rTask.SetNotAvailableSpIdx(int32(redundancyIdx))
 					errChan <- err
@@ -151,7 +155,7 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 			log.Debugw("start to replicate segment piece", "sp", sp)
 			wg.Add(1)
 			go func(redundancyIdx int, sp string) {
-				err = e.doReplicatePiece(ctx, &wg, rTask, sp, segIdx, int32(redundancyIdx), data)
+				err = e.doReplicatePiece(ctx, &wg, rTask, sp, segIdx, int32(redundancyIdx), data, outcomes)
 				if err != nil {
 					rTask.SetNotAvailableSpIdx(int32(redundancyIdx))
 					errChan <- err
@@ -183,10 +187,12 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 		}
 		for rIdx, spEp := range rTask.GetSecondaryEndpoints() {
 			log.Debugw("start to done replicate", "sp", spEp)
+			doneTime := time.Now()
 			signature, innerErr := e.doneReplicatePiece(ctx, rTask, spEp, int32(rIdx))
 			if innerErr == nil {
 				msg := storagetypes.NewSecondarySpSealObjectSignDoc(e.baseApp.ChainID(), gvg.Id, rTask.GetObjectInfo().Id, storagetypes.GenerateHash(rTask.GetObjectInfo().GetChecksums()[:])).GetBlsSignHash()
 				err = veritySecondarySpBlsSignature(e.getSpByID(gvg.GetSecondarySpIds()[rIdx]), signature, msg[:])
+				outcomes.record(ctx, spEp, coretask.SPOutcomeApproval, 0, time.Since(doneTime), err)
 				if err != nil {
 					rTask.SetNotAvailableSpIdx(int32(rIdx))
 					log.CtxErrorw(ctx, "failed to verify secondary SP bls signature", "secondary_sp_id", gvg.GetSecondarySpIds()[rIdx], "error", err.Error())
@@ -195,6 +201,7 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 				secondarySignatures[rIdx] = signature
 				metrics.ExecutorCounter.WithLabelValues(ExecutorSuccessDoneReplicatePiece).Inc()
 			} else {
+				outcomes.record(ctx, spEp, coretask.SPOutcomeApproval, 0, time.Since(doneTime), innerErr)
 				rTask.SetNotAvailableSpIdx(int32(rIdx))
 				metrics.ExecutorCounter.WithLabelValues(ExecutorFailureDoneReplicatePiece).Inc()
 				return innerErr
@@ -266,7 +273,7 @@ func (e *ExecuteModular) handleReplicatePiece(ctx context.Context, rTask coretas
 }
 
 func (e *ExecuteModular) doReplicatePiece(ctx context.Context, waitGroup *sync.WaitGroup, rTask coretask.ReplicatePieceTask,
-	spEndpoint string, segmentIdx uint32, redundancyIdx int32, data []byte) (err error) {
+	spEndpoint string, segmentIdx uint32, redundancyIdx int32, data []byte, outcomes *spOutcomeCollector) (err error) {
 	var signature []byte
 	if rTask.GetObjectInfo() == nil {
 		log.CtxErrorw(ctx, "ReplicatePieceTask object info is empty")
@@ -295,7 +302,7 @@ func (e *ExecuteModular) doReplicatePiece(ctx context.Context, waitGroup *sync.W
 	// save EC Chunk hash to db
 	if rTask.GetIsAgentUpload() {
 		objectId := rTask.GetObjectInfo().Id.Uint64()
-		if err = e.baseApp.GfSpDB().SetReplicatePieceChecksum(rTask.GetObjectInfo().Id.Uint64(), segmentIdx, redundancyIdx, pieceHash, rTask.GetObjectInfo().GetVersion()); err != nil {
+		if err = e.baseApp.GfSpDBWithContext(ctx).SetReplicatePieceChecksum(rTask.GetObjectInfo().Id.Uint64(), segmentIdx, redundancyIdx, pieceHash, rTask.GetObjectInfo().GetVersion()); err != nil {
 			log.CtxErrorw(ctx, "failed to set replicate piece checksum", "object_id", objectId,
 				"segment_index", segmentIdx, "redundancy_index", redundancyIdx, "error", err)
 			detail := fmt.Sprintf("failed to set replicate piece checksum, object_id: %d, segment_index: %v, redundancy_index: %v, error: %s",
@@ -317,7 +324,7 @@ func (e *ExecuteModular) doReplicatePiece(ctx context.Context, waitGroup *sync.W
 	}
 	receive.SetSignature(signature)
 	replicateOnePieceTime := time.Now()
-	if err = retry.Do(func() error {
+	err = retry.Do(func() error {
 		// timeout for single piece replication
 		ctxWithTimeout, cancel := context.WithTimeout(ctx, replicateTimeOut)
 		defer cancel()
@@ -327,7 +334,9 @@ func (e *ExecuteModular) doReplicatePiece(ctx context.Context, waitGroup *sync.W
 		RtyErr,
 		retry.OnRetry(func(n uint, err error) {
 			log.CtxErrorw(ctx, "failed to replicate piece", "sp_endpoint", spEndpoint, "segment_idx", segmentIdx, "redundancy_idx", redundancyIdx, "error", err, "attempt", n, "max_attempts", RtyAttNum)
-		})); err != nil {
+		}))
+	outcomes.record(ctx, spEndpoint, coretask.SPOutcomeReplicate, len(data), time.Since(replicateOnePieceTime), err)
+	if err != nil {
 		log.CtxErrorw(ctx, "failed to replicate piece", "sp_endpoint", spEndpoint, "segment_idx", segmentIdx, "redundancy_idx", redundancyIdx, "error", err)
 		return err
 	}
@@ -415,7 +424,7 @@ func veritySecondarySpBlsSignature(secondarySp *sptypes.StorageProvider, signatu
 }
 
 func (e *ExecuteModular) makeCheckSumsForAgentUpload(ctx context.Context, objectInfo *storagetypes.ObjectInfo, redundancyCount int, params *storagetypes.Params) ([][]byte, error) {
-	integrityMeta, err := e.baseApp.GfSpDB().GetObjectIntegrity(objectInfo.Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+	integrityMeta, err := e.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(objectInfo.Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object integrity",
 			"objectID", objectInfo.Id.Uint64(), "error", err)
@@ -426,7 +435,7 @@ func (e *ExecuteModular) makeCheckSumsForAgentUpload(ctx context.Context, object
 	spc := e.baseApp.PieceOp().SegmentPieceCount(objectInfo.GetPayloadSize(), params.VersionedParams.GetMaxSegmentSize())
 	for redundancyIdx := 0; redundancyIdx < redundancyCount; redundancyIdx++ {
 		var ecHash [][]byte
-		ecHash, err = e.baseApp.GfSpDB().GetAllReplicatePieceChecksum(objectInfo.Id.Uint64(), int32(redundancyIdx), spc)
+		ecHash, err = e.baseApp.GfSpDBWithContext(ctx).GetAllReplicatePieceChecksum(objectInfo.Id.Uint64(), int32(redundancyIdx), spc)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to get all replicate piece",
 				"objectID", objectInfo.Id.Uint64(), "error", err)
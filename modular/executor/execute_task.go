@@ -250,7 +250,7 @@ func (e *ExecuteModular) HandleReceivePieceTask(ctx context.Context, task coreta
 		log.CtxErrorw(ctx, "failed to confirm receive task, secondary sp mismatch", "expect",
 			gvg.GetSecondarySpIds()[int(task.GetRedundancyIdx())], "current", e.baseApp.OperatorAddress())
 		task.SetError(ErrSecondaryMismatch)
-		err = e.baseApp.GfSpDB().DeleteObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetRedundancyIdx())
+		err = e.baseApp.GfSpDBWithContext(ctx).DeleteObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetRedundancyIdx())
 		if err != nil {
 			log.CtxError(ctx, "failed to delete integrity")
 		}
@@ -284,8 +284,12 @@ func (e *ExecuteModular) HandleRecoverPieceTask(ctx context.Context, task coreta
 		recoveryMinEcIndex = -1
 		err                error
 		finishRecovery     = false
+		outcomes           = newSPOutcomeCollector()
 	)
 	defer func() {
+		if task != nil {
+			task.SetSPOutcomes(outcomes.list())
+		}
 		if err != nil {
 			task.SetError(err)
 		}
@@ -323,9 +327,9 @@ func (e *ExecuteModular) HandleRecoverPieceTask(ctx context.Context, task coreta
 	// used by secondary SP or successor secondary SP for GVG
 	if redundancyIdx >= 0 {
 		// recover secondary SP data by the primary SP
-		if err = e.recoverByPrimarySP(ctx, task); err != nil {
+		if err = e.recoverByPrimarySP(ctx, task, outcomes); err != nil {
 			// if failed to recover by the primary SP, try to recovery secondary SP data from the other secondary SPs
-			recoverErr := e.recoverBySecondarySP(ctx, task, true)
+			recoverErr := e.recoverBySecondarySP(ctx, task, true, outcomes)
 			if recoverErr != nil {
 				err = recoverErr
 				return
@@ -337,7 +341,7 @@ func (e *ExecuteModular) HandleRecoverPieceTask(ctx context.Context, task coreta
 	} else {
 		// used by Primary SP or successor primary SP for VGF
 		// recover primarySP data by secondary SPs
-		if recoverErr := e.recoverBySecondarySP(ctx, task, false); recoverErr != nil {
+		if recoverErr := e.recoverBySecondarySP(ctx, task, false, outcomes); recoverErr != nil {
 			err = recoverErr
 			return
 		}
@@ -347,7 +351,7 @@ func (e *ExecuteModular) HandleRecoverPieceTask(ctx context.Context, task coreta
 }
 
 // recoverByPrimarySP recover secondary SP by the corresponding primary SP
-func (e *ExecuteModular) recoverByPrimarySP(ctx context.Context, task coretask.RecoveryPieceTask) error {
+func (e *ExecuteModular) recoverByPrimarySP(ctx context.Context, task coretask.RecoveryPieceTask, outcomes *spOutcomeCollector) error {
 	log.CtxDebugw(ctx, "begin to recovery by the primary SP", "objectName:", task.GetObjectInfo().GetObjectName())
 	var (
 		err               error
@@ -367,7 +371,9 @@ func (e *ExecuteModular) recoverByPrimarySP(ctx context.Context, task coretask.R
 	}
 	task.SetSignature(signature)
 
+	fetchTime := time.Now()
 	pieceData, err = e.doRecoveryPiece(ctx, task, primarySPEndpoint)
+	outcomes.record(ctx, primarySPEndpoint, coretask.SPOutcomeRecoveryFetch, len(pieceData), time.Since(fetchTime), err)
 	if err != nil {
 		log.CtxDebugw(ctx, "failed to recover secondary SP data from primary SP")
 		return err
@@ -397,7 +403,8 @@ func (e *ExecuteModular) recoverByPrimarySP(ctx context.Context, task coretask.R
 }
 
 // recoverBySecondarySP recovery primarySP or recovery Secondary from secondary SPs
-func (e *ExecuteModular) recoverBySecondarySP(ctx context.Context, task coretask.RecoveryPieceTask, isMyselfSecondary bool) error {
+func (e *ExecuteModular) recoverBySecondarySP(ctx context.Context, task coretask.RecoveryPieceTask, isMyselfSecondary bool,
+	outcomes *spOutcomeCollector) error {
 	log.CtxDebugw(ctx, "begin to recovery from secondary SPs", "objectName:", task.GetObjectInfo().GetObjectName())
 	var (
 		dataShards         = task.GetStorageParams().VersionedParams.GetRedundantDataChunkNum()
@@ -437,7 +444,7 @@ func (e *ExecuteModular) recoverBySecondarySP(ctx context.Context, task coretask
 
 	if isMyselfSecondary {
 		operator := e.baseApp.OperatorAddress()
-		spInfo, dbErr := e.baseApp.GfSpDB().GetSpByAddress(operator, spdb.OperatorAddressType)
+		spInfo, dbErr := e.baseApp.GfSpDBWithContext(ctx).GetSpByAddress(operator, spdb.OperatorAddressType)
 		if dbErr != nil {
 			return dbErr
 		}
@@ -465,7 +472,9 @@ func (e *ExecuteModular) recoverBySecondarySP(ctx context.Context, task coretask
 				}
 				return
 			}
+			fetchTime := time.Now()
 			pieceData, recoverErr := e.doRecoveryPiece(ctx, task, secondaryEndpoints[secondaryIndex])
+			outcomes.record(ctx, secondaryEndpoint, coretask.SPOutcomeRecoveryFetch, len(pieceData), time.Since(fetchTime), recoverErr)
 			if recoverErr == nil {
 				recoveryDataSources[secondaryIndex] = pieceData
 				log.Debugf("get one piece from ", "piece length:%d ", len(pieceData), "secondary sp:", secondaryEndpoints[secondaryIndex])
@@ -580,7 +589,7 @@ func (e *ExecuteModular) getECPieceBySegment(ctx context.Context, redundancyIdx
 }
 
 func (e *ExecuteModular) checkRecoveryChecksum(ctx context.Context, task coretask.RecoveryPieceTask, recoveryChecksum []byte) error {
-	integrityMeta, err := e.baseApp.GfSpDB().GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetEcIdx())
+	integrityMeta, err := e.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetEcIdx())
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object integrity hash in db when recovery", "objectName:",
 			task.GetObjectInfo().ObjectName, "error", err)
@@ -685,7 +694,7 @@ func (e *ExecuteModular) setPieceMetadata(ctx context.Context, task coretask.Rec
 	version := task.GetObjectInfo().Version
 	pieceChecksum := hash.GenerateChecksum(pieceData)
 
-	if err := e.baseApp.GfSpDB().SetReplicatePieceChecksum(objectID, segmentIdx, redundancyIdx, pieceChecksum, version); err != nil {
+	if err := e.baseApp.GfSpDBWithContext(ctx).SetReplicatePieceChecksum(objectID, segmentIdx, redundancyIdx, pieceChecksum, version); err != nil {
 		log.CtxErrorw(ctx, "failed to set replicate piece checksum", "object_id", objectID,
 			"segment_index", segmentIdx, "redundancy_index", redundancyIdx, "error", err)
 		detail := fmt.Sprintf("failed to set replicate piece checksum, object_id: %s, segment_index: %v, redundancy_index: %v, error: %s",
@@ -695,7 +704,7 @@ func (e *ExecuteModular) setPieceMetadata(ctx context.Context, task coretask.Rec
 	segmentCount := e.baseApp.PieceOp().SegmentPieceCount(task.GetObjectInfo().GetPayloadSize(),
 		task.GetStorageParams().VersionedParams.GetMaxSegmentSize())
 
-	pieceChecksums, err := e.baseApp.GfSpDB().GetAllReplicatePieceChecksumOptimized(task.GetObjectInfo().Id.Uint64(), task.GetEcIdx(), segmentCount)
+	pieceChecksums, err := e.baseApp.GfSpDBWithContext(ctx).GetAllReplicatePieceChecksumOptimized(task.GetObjectInfo().Id.Uint64(), task.GetEcIdx(), segmentCount)
 	if err != nil {
 		log.CtxInfow(ctx, "failed to get recover piece checksum", "object_id", objectID,
 			"segment_index", segmentIdx, "error", err)
@@ -709,12 +718,12 @@ func (e *ExecuteModular) setPieceMetadata(ctx context.Context, task coretask.Rec
 			IntegrityChecksum: integrityChecksum,
 			PieceChecksumList: pieceChecksums,
 		}
-		if err = e.baseApp.GfSpDB().SetObjectIntegrity(integrityMeta); err != nil {
+		if err = e.baseApp.GfSpDBWithContext(ctx).SetObjectIntegrity(integrityMeta); err != nil {
 			log.CtxErrorw(ctx, "failed to set object integrity", "object_id", objectID,
 				"segment_index", segmentIdx, "error", err)
 			return err
 		}
-		err = e.baseApp.GfSpDB().DeleteAllReplicatePieceChecksum(objectID, task.GetEcIdx(), segmentCount)
+		err = e.baseApp.GfSpDBWithContext(ctx).DeleteAllReplicatePieceChecksum(objectID, task.GetEcIdx(), segmentCount)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to delete all recover piece checksum", "task", task, "error", err)
 			return err
@@ -28,8 +28,14 @@ type ExecuteModular struct {
 
 	maxExecuteNum int64
 	executingNum  int64
+	// workerNum is the number of running workers that ask tasks, it follows the maxExecuteNum.
+	workerNum int64
+	workerMux sync.Mutex
+	workerCh  chan struct{}
 
 	askTaskInterval int
+	// enableStreamDispatch is used to receive the task leases over the dispatch task stream instead of polling.
+	enableStreamDispatch bool
 
 	askReplicateApprovalTimeout  int64
 	askReplicateApprovalExFactor float64
@@ -90,21 +96,10 @@ func (e *ExecuteModular) Start(ctx context.Context) error {
 }
 
 func (e *ExecuteModular) eventLoop(ctx context.Context) {
-	for i := int64(0); i < e.maxExecuteNum; i++ {
-		go func(ctx context.Context) {
-			for {
-				select {
-				case <-ctx.Done():
-				default:
-					err := e.AskTask(ctx)
-					if err != nil {
-						rand.New(rand.NewSource(time.Now().Unix()))
-						sleep := rand.Intn(int(e.maxExecuteNum)) + 1
-						time.Sleep(time.Duration(sleep) * time.Millisecond)
-					}
-				}
-			}
-		}(ctx)
+	if e.enableStreamDispatch {
+		go e.receiveTaskLeases(ctx)
+	} else {
+		e.startWorkers(ctx)
 	}
 
 	statisticsTicker := time.NewTicker(time.Duration(e.statisticsOutputInterval) * time.Second)
@@ -115,6 +110,11 @@ func (e *ExecuteModular) eventLoop(ctx context.Context) {
 			return
 		case <-statisticsTicker.C:
 			log.CtxInfo(ctx, e.Statistics())
+		case <-e.workerCh:
+			// the streaming executor advertises the free slots by the maxExecuteNum instead of the workers
+			if !e.enableStreamDispatch {
+				e.startWorkers(ctx)
+			}
 		case <-updateSpTicker.C:
 			sps, err := e.baseApp.Consensus().ListSPs(ctx)
 			if err != nil {
@@ -129,6 +129,45 @@ func (e *ExecuteModular) eventLoop(ctx context.Context) {
 	}
 }
 
+// startWorkers starts the workers until the number of workers reaches the maxExecuteNum.
+func (e *ExecuteModular) startWorkers(ctx context.Context) {
+	e.workerMux.Lock()
+	defer e.workerMux.Unlock()
+	for ; e.workerNum < atomic.LoadInt64(&e.maxExecuteNum); e.workerNum++ {
+		go e.worker(ctx)
+	}
+}
+
+// retireWorker returns an indicator whether the worker should exit because the maxExecuteNum is decreased.
+func (e *ExecuteModular) retireWorker() bool {
+	e.workerMux.Lock()
+	defer e.workerMux.Unlock()
+	if e.workerNum > atomic.LoadInt64(&e.maxExecuteNum) {
+		e.workerNum--
+		return true
+	}
+	return false
+}
+
+func (e *ExecuteModular) worker(ctx context.Context) {
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		default:
+			if e.retireWorker() {
+				return
+			}
+			err := e.AskTask(ctx)
+			if err != nil {
+				rand.New(rand.NewSource(time.Now().Unix()))
+				sleep := rand.Intn(int(atomic.LoadInt64(&e.maxExecuteNum))) + 1
+				time.Sleep(time.Duration(sleep) * time.Millisecond)
+			}
+		}
+	}
+}
+
 func (e *ExecuteModular) getSpByID(id uint32) *sptypes.StorageProvider {
 	e.mutex.RLock()
 	defer e.mutex.RUnlock()
@@ -182,15 +221,38 @@ func (e *ExecuteModular) AskTask(ctx context.Context) error {
 	}
 	metrics.ReqCounter.WithLabelValues(ExecutorSuccessAskTask).Inc()
 	metrics.ReqTime.WithLabelValues(ExecutorSuccessAskTask).Observe(time.Since(startTime).Seconds())
+	return e.runTask(ctx, askTask, limit, startTime)
+}
+
+// runTask reserves the resource for the task, handles the task and reports the result to the manager, it only
+// returns error if the resource is not enough to run the task, and the task is not reported then.
+func (e *ExecuteModular) runTask(ctx context.Context, askTask coretask.Task, limit corercmgr.Limit, startTime time.Time) error {
+	span, err := e.reserveTask(ctx, askTask, limit)
+	if err != nil {
+		return err
+	}
+	e.handleTask(ctx, askTask, span, startTime)
+	return nil
+}
 
+// reserveTask takes an executing slot and reserves the resource for the task, both are released by handleTask.
+func (e *ExecuteModular) reserveTask(ctx context.Context, askTask coretask.Task, limit corercmgr.Limit) (
+	corercmgr.ResourceScopeSpan, error) {
 	atomic.AddInt64(&e.executingNum, 1)
-	defer atomic.AddInt64(&e.executingNum, -1)
 	span, err := e.ReserveResource(ctx, askTask.EstimateLimit().ScopeStat())
 	if err != nil {
+		atomic.AddInt64(&e.executingNum, -1)
 		log.CtxErrorw(ctx, "failed to reserve resource", "task_require",
 			askTask.EstimateLimit().String(), "remaining", limit.String(), "error", err)
-		return err
+		return nil, err
 	}
+	return span, nil
+}
+
+// handleTask handles the task that has been reserved by reserveTask and reports the result to the manager.
+func (e *ExecuteModular) handleTask(ctx context.Context, askTask coretask.Task, span corercmgr.ResourceScopeSpan,
+	startTime time.Time) {
+	defer atomic.AddInt64(&e.executingNum, -1)
 	metrics.RunningTaskNumberGauge.WithLabelValues("running_task_num").Set(float64(atomic.LoadInt64(&e.executingNum)))
 	metrics.MaxTaskNumberGauge.WithLabelValues("max_task_num").Set(float64(atomic.LoadInt64(&e.maxExecuteNum)))
 
@@ -279,7 +341,6 @@ func (e *ExecuteModular) AskTask(ctx context.Context) error {
 		log.CtxError(ctx, "unsupported task type")
 	}
 	log.CtxDebug(ctx, "finished to handle task")
-	return nil
 }
 
 func (e *ExecuteModular) ReportTask(ctx context.Context, task coretask.Task) (err error) {
@@ -0,0 +1,253 @@
+package executor
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// DefaultTaskLeaseHeartbeatInterval defines the interval of renewing the task leases before the manager
+	// tells the executor the interval.
+	DefaultTaskLeaseHeartbeatInterval = 10 * time.Second
+	// DefaultTaskLeaseReconnectInterval defines the interval of reopening the broken dispatch task stream.
+	DefaultTaskLeaseReconnectInterval = time.Second
+)
+
+// taskLeases tracks the task leases held by the executor and the finished leases that are not reported yet.
+type taskLeases struct {
+	mux      sync.Mutex
+	active   map[string]context.CancelFunc
+	released []string
+	rejected []string
+}
+
+func newTaskLeases() *taskLeases {
+	return &taskLeases{active: make(map[string]context.CancelFunc)}
+}
+
+func (l *taskLeases) add(leaseID string, cancel context.CancelFunc) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.active[leaseID] = cancel
+}
+
+// finish drops the lease of the finished task, the lease is rejected if the task is not run.
+func (l *taskLeases) finish(leaseID string, rejected bool) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	if _, ok := l.active[leaseID]; !ok {
+		// the lease has been revoked by the manager
+		return
+	}
+	delete(l.active, leaseID)
+	if rejected {
+		l.rejected = append(l.rejected, leaseID)
+	} else {
+		l.released = append(l.released, leaseID)
+	}
+}
+
+// reject reports the lease of the task that is not run.
+func (l *taskLeases) reject(leaseID string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.rejected = append(l.rejected, leaseID)
+}
+
+// revoke cancels the tasks of the leases revoked by the manager.
+func (l *taskLeases) revoke(leaseIDs []string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	for _, leaseID := range leaseIDs {
+		if cancel, ok := l.active[leaseID]; ok {
+			cancel()
+			delete(l.active, leaseID)
+		}
+	}
+}
+
+// revokeAll cancels the tasks of all the leases.
+func (l *taskLeases) revokeAll() {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	for leaseID, cancel := range l.active {
+		cancel()
+		delete(l.active, leaseID)
+	}
+}
+
+// take returns the active leases, and the finished leases that are cleared after being taken.
+func (l *taskLeases) take() (active, released, rejected []string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	for leaseID := range l.active {
+		active = append(active, leaseID)
+	}
+	released, rejected = l.released, l.rejected
+	l.released, l.rejected = nil, nil
+	return active, released, rejected
+}
+
+func makeExecutorID() string {
+	hostname, _ := os.Hostname()
+	return fmt.Sprintf("%s-%d-%d", hostname, os.Getpid(), time.Now().UnixNano())
+}
+
+// receiveTaskLeases receives the task leases from the manager over the dispatch task stream instead of polling,
+// and reopens the stream if it is broken until the ctx is done.
+func (e *ExecuteModular) receiveTaskLeases(ctx context.Context) {
+	executorID := makeExecutorID()
+	for {
+		if err := e.serveTaskLeaseStream(ctx, executorID); err != nil {
+			log.CtxErrorw(ctx, "dispatch task stream is broken", "executor_id", executorID, "error", err)
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(DefaultTaskLeaseReconnectInterval):
+		}
+	}
+}
+
+func (e *ExecuteModular) serveTaskLeaseStream(ctx context.Context, executorID string) error {
+	streamCtx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	stream, err := e.baseApp.GfSpClient().DispatchTaskStream(streamCtx)
+	if err != nil {
+		return err
+	}
+	leases := newTaskLeases()
+	// the manager revokes all the leases of the executor once the stream is broken
+	defer leases.revokeAll()
+	if err = e.sendExecutorStatus(stream, executorID, leases); err != nil {
+		return err
+	}
+
+	leaseCh := make(chan *gfspserver.GfSpTaskLease)
+	errCh := make(chan error, 1)
+	go func() {
+		for {
+			lease, recvErr := stream.Recv()
+			if recvErr != nil {
+				errCh <- recvErr
+				return
+			}
+			select {
+			case leaseCh <- lease:
+			case <-streamCtx.Done():
+				return
+			}
+		}
+	}()
+
+	// finishCh notifies the stream to report the finished leases and ask for more tasks
+	finishCh := make(chan struct{}, 1)
+	heartbeatInterval := DefaultTaskLeaseHeartbeatInterval
+	heartbeatTicker := time.NewTicker(heartbeatInterval)
+	defer heartbeatTicker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case err = <-errCh:
+			return err
+		case <-heartbeatTicker.C:
+		case <-finishCh:
+		case lease := <-leaseCh:
+			leases.revoke(lease.GetRevokedLeaseIds())
+			if interval := time.Duration(lease.GetHeartbeatInterval()) * time.Second; interval > 0 && interval != heartbeatInterval {
+				heartbeatInterval = interval
+				heartbeatTicker.Reset(heartbeatInterval)
+			}
+			if lease.GetTask() != nil {
+				e.runLeasedTask(ctx, lease, leases, finishCh)
+			}
+			continue
+		}
+		if err = e.sendExecutorStatus(stream, executorID, leases); err != nil {
+			return err
+		}
+	}
+}
+
+// runLeasedTask runs the leased task in the background, the task is canceled if the lease is revoked.
+func (e *ExecuteModular) runLeasedTask(ctx context.Context, lease *gfspserver.GfSpTaskLease, leases *taskLeases,
+	finishCh chan struct{}) {
+	leaseID := lease.GetLeaseId()
+	notify := func() {
+		select {
+		case finishCh <- struct{}{}:
+		default:
+		}
+	}
+	leaseTask, err := gfspclient.TaskFromAskTaskResponse(lease.GetTask())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to parse leased task", "lease_id", leaseID, "error", err)
+		leases.reject(leaseID)
+		notify()
+		return
+	}
+	metrics.ReqCounter.WithLabelValues(ExecutorSuccessAskTask).Inc()
+	// reserve the task before the next status is sent, so the advertised capacity and free slots exclude it
+	limit, err := e.scope.RemainingResource()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get remaining resource", "lease_id", leaseID, "error", err)
+		leases.reject(leaseID)
+		notify()
+		return
+	}
+	span, err := e.reserveTask(ctx, leaseTask, limit)
+	if err != nil {
+		leases.reject(leaseID)
+		notify()
+		return
+	}
+	taskCtx, cancel := context.WithCancel(ctx)
+	leases.add(leaseID, cancel)
+	go func() {
+		defer cancel()
+		e.handleTask(taskCtx, leaseTask, span, time.Now())
+		leases.finish(leaseID, false)
+		notify()
+	}()
+}
+
+// sendExecutorStatus advertises the capacity of the executor, renews the active leases and reports the
+// finished leases.
+func (e *ExecuteModular) sendExecutorStatus(stream gfspserver.GfSpManageService_GfSpDispatchTaskStreamClient,
+	executorID string, leases *taskLeases) error {
+	limit, err := e.scope.RemainingResource()
+	if err != nil {
+		log.Errorw("failed to get remaining resource", "error", err)
+		return err
+	}
+	capacity, ok := limit.(*gfsplimit.GfSpLimit)
+	if !ok {
+		log.Errorw("failed to advertise capacity due to unknown limit type", "limit", limit.String())
+		return ErrDanglingPointer
+	}
+	active, released, rejected := leases.take()
+	// the slots of the revoked tasks are still taken until the tasks exit
+	freeSlots := atomic.LoadInt64(&e.maxExecuteNum) - atomic.LoadInt64(&e.executingNum)
+	if freeSlots < 0 {
+		freeSlots = 0
+	}
+	return stream.Send(&gfspserver.GfSpExecutorStatus{
+		ExecutorId:       executorID,
+		Capacity:         capacity,
+		FreeSlots:        int32(freeSlots),
+		ActiveLeaseIds:   active,
+		ReleasedLeaseIds: released,
+		RejectedLeaseIds: rejected,
+	})
+}
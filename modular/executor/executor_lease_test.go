@@ -0,0 +1,119 @@
+package executor
+
+import (
+	"context"
+	"sort"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+)
+
+type mockTaskLeaseStream struct {
+	grpc.ClientStream
+	ctx    context.Context
+	sendCh chan *gfspserver.GfSpExecutorStatus
+	recvCh chan *gfspserver.GfSpTaskLease
+}
+
+func (s *mockTaskLeaseStream) Send(status *gfspserver.GfSpExecutorStatus) error {
+	s.sendCh <- status
+	return nil
+}
+
+func (s *mockTaskLeaseStream) Recv() (*gfspserver.GfSpTaskLease, error) {
+	select {
+	case lease := <-s.recvCh:
+		return lease, nil
+	case <-s.ctx.Done():
+		return nil, s.ctx.Err()
+	}
+}
+
+func TestTaskLeases(t *testing.T) {
+	leases := newTaskLeases()
+	var canceled []string
+	for _, id := range []string{"a", "b", "c", "d"} {
+		leaseID := id
+		leases.add(leaseID, func() { canceled = append(canceled, leaseID) })
+	}
+	leases.finish("a", false)
+	leases.finish("b", true)
+	leases.reject("e")
+	leases.revoke([]string{"c", "x"})
+	// the revoked lease is not reported after finishing
+	leases.finish("c", false)
+
+	active, released, rejected := leases.take()
+	assert.Equal(t, []string{"d"}, active)
+	assert.Equal(t, []string{"a"}, released)
+	assert.Equal(t, []string{"b", "e"}, rejected)
+	active, released, rejected = leases.take()
+	assert.Equal(t, []string{"d"}, active)
+	assert.Nil(t, released)
+	assert.Nil(t, rejected)
+
+	leases.revokeAll()
+	sort.Strings(canceled)
+	assert.Equal(t, []string{"c", "d"}, canceled)
+	active, _, _ = leases.take()
+	assert.Nil(t, active)
+}
+
+func TestExecuteModular_serveTaskLeaseStream(t *testing.T) {
+	e := setup(t)
+	e.maxExecuteNum = 4
+	ctrl := gomock.NewController(t)
+	scopeMock := corercmgr.NewMockResourceScope(ctrl)
+	e.scope = scopeMock
+	scopeMock.EXPECT().RemainingResource().Return(&gfsplimit.GfSpLimit{Memory: 100}, nil).AnyTimes()
+	scopeMock.EXPECT().BeginSpan().Return(nil, mockErr).Times(1)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	stream := &mockTaskLeaseStream{
+		ctx:    ctx,
+		sendCh: make(chan *gfspserver.GfSpExecutorStatus, 8),
+		recvCh: make(chan *gfspserver.GfSpTaskLease, 8),
+	}
+	clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+	clientMock.EXPECT().DispatchTaskStream(gomock.Any()).Return(stream, nil).Times(1)
+	e.baseApp.SetGfSpClient(clientMock)
+
+	done := make(chan error)
+	go func() {
+		done <- e.serveTaskLeaseStream(ctx, "executor-1")
+	}()
+	status := <-stream.sendCh
+	assert.Equal(t, "executor-1", status.GetExecutorId())
+	assert.Equal(t, int64(100), status.GetCapacity().GetMemory())
+	assert.Equal(t, int32(4), status.GetFreeSlots())
+
+	// the resource is not enough to run the leased task
+	stream.recvCh <- &gfspserver.GfSpTaskLease{
+		LeaseId: "lease-1",
+		Task: &gfspserver.GfSpAskTaskResponse{Response: &gfspserver.GfSpAskTaskResponse_GcObjectTask{
+			GcObjectTask: &gfsptask.GfSpGCObjectTask{Task: &gfsptask.GfSpTask{}},
+		}},
+	}
+	select {
+	case status = <-stream.sendCh:
+	case <-time.After(5 * time.Second):
+		t.Fatal("executor status is not sent")
+	}
+	assert.Equal(t, []string{"lease-1"}, status.GetRejectedLeaseIds())
+	assert.Equal(t, int32(4), status.GetFreeSlots())
+	// the slot taken by the rejected task is given back
+	assert.Equal(t, int64(0), atomic.LoadInt64(&e.executingNum))
+
+	cancel()
+	assert.Nil(t, <-done)
+}
@@ -92,6 +92,7 @@ func defaultExecutorOptions(executor *ExecuteModular, cfg *gfspconfig.GfSpConfig
 		cfg.Executor.MaxExecuteNumber = DefaultExecutorMaxExecuteNum
 	}
 	executor.maxExecuteNum = cfg.Executor.MaxExecuteNumber
+	executor.workerCh = make(chan struct{}, 1)
 	if cfg.Executor.AskTaskInterval == 0 {
 		cfg.Executor.AskTaskInterval = DefaultExecutorAskTaskInterval
 	}
@@ -142,4 +143,5 @@ func defaultExecutorOptions(executor *ExecuteModular, cfg *gfspconfig.GfSpConfig
 	executor.bucketTrafficKeepLatestDay = cfg.Executor.BucketTrafficKeepTimeDay
 	executor.readRecordKeepLatestDay = cfg.Executor.ReadRecordKeepTimeDay
 	executor.readRecordDeleteLimit = cfg.Executor.ReadRecordDeleteLimit
+	executor.enableStreamDispatch = cfg.Executor.EnableStreamDispatch
 }
@@ -0,0 +1,35 @@
+package executor
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+var _ gfspapp.ReloadableModular = &ExecuteModular{}
+
+// ReloadableConfigs returns the configs that executor can apply at runtime.
+func (e *ExecuteModular) ReloadableConfigs() []string {
+	return []string{"Executor.MaxExecuteNumber"}
+}
+
+// ReloadConfig updates the max execute number, the workers are started or retired to follow it, the
+// retired workers exit after finishing their executing tasks.
+func (e *ExecuteModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if cfg.Executor.MaxExecuteNumber < 0 {
+		return nil, fmt.Errorf("invalid max execute number %d", cfg.Executor.MaxExecuteNumber)
+	}
+	maxExecuteNum := cfg.Executor.MaxExecuteNumber
+	if maxExecuteNum == 0 {
+		maxExecuteNum = DefaultExecutorMaxExecuteNum
+	}
+	return func() {
+		atomic.StoreInt64(&e.maxExecuteNum, maxExecuteNum)
+		select {
+		case e.workerCh <- struct{}{}:
+		default:
+		}
+	}, nil
+}
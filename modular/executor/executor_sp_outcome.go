@@ -0,0 +1,63 @@
+package executor
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+type spOutcomeKey struct {
+	endpoint    string
+	outcomeType coretask.SPOutcomeType
+}
+
+// spOutcomeCollector collects the outcomes of the requests to other SPs while executing a task, the outcomes
+// are reported to the manager along with the task to score the SP reputations.
+type spOutcomeCollector struct {
+	mux      sync.Mutex
+	keys     []spOutcomeKey
+	outcomes map[spOutcomeKey]*coretask.SPOutcome
+}
+
+func newSPOutcomeCollector() *spOutcomeCollector {
+	return &spOutcomeCollector{outcomes: make(map[spOutcomeKey]*coretask.SPOutcome)}
+}
+
+// record records a request to the SP of the endpoint. The failed request whose ctx has been canceled is not
+// recorded, it is canceled by the failure of another request rather than the fault of the SP.
+func (c *spOutcomeCollector) record(ctx context.Context, endpoint string, outcomeType coretask.SPOutcomeType,
+	size int, cost time.Duration, err error) {
+	if err != nil && ctx.Err() != nil {
+		return
+	}
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	key := spOutcomeKey{endpoint: endpoint, outcomeType: outcomeType}
+	outcome, ok := c.outcomes[key]
+	if !ok {
+		outcome = &coretask.SPOutcome{Endpoint: endpoint, Type: outcomeType}
+		c.outcomes[key] = outcome
+		c.keys = append(c.keys, key)
+	}
+	outcome.Requests++
+	if err != nil {
+		outcome.Failures++
+		return
+	}
+	outcome.Size += uint64(size)
+	outcome.Cost += cost
+}
+
+// list returns the outcomes in the order of the first request to each SP.
+func (c *spOutcomeCollector) list() []*coretask.SPOutcome {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	outcomes := make([]*coretask.SPOutcome, 0, len(c.keys))
+	for _, key := range c.keys {
+		outcome := *c.outcomes[key]
+		outcomes = append(outcomes, &outcome)
+	}
+	return outcomes
+}
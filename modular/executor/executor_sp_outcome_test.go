@@ -0,0 +1,32 @@
+package executor
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+func TestSPOutcomeCollector(t *testing.T) {
+	c := newSPOutcomeCollector()
+	ctx := context.Background()
+	c.record(ctx, "sp2", coretask.SPOutcomeReplicate, 10, time.Second, nil)
+	c.record(ctx, "sp1", coretask.SPOutcomeReplicate, 20, 2*time.Second, nil)
+	c.record(ctx, "sp2", coretask.SPOutcomeReplicate, 10, time.Second, mockErr)
+	c.record(ctx, "sp2", coretask.SPOutcomeApproval, 0, time.Second, nil)
+
+	// the request canceled by the failure of another request is not recorded
+	canceledCtx, cancel := context.WithCancel(ctx)
+	cancel()
+	c.record(canceledCtx, "sp1", coretask.SPOutcomeReplicate, 20, time.Second, context.Canceled)
+	c.record(canceledCtx, "sp1", coretask.SPOutcomeReplicate, 20, time.Second, nil)
+
+	assert.Equal(t, []*coretask.SPOutcome{
+		{Endpoint: "sp2", Type: coretask.SPOutcomeReplicate, Requests: 2, Failures: 1, Size: 10, Cost: time.Second},
+		{Endpoint: "sp1", Type: coretask.SPOutcomeReplicate, Requests: 2, Size: 40, Cost: 3 * time.Second},
+		{Endpoint: "sp2", Type: coretask.SPOutcomeApproval, Requests: 1, Cost: time.Second},
+	}, c.list())
+}
@@ -961,8 +961,14 @@ func TestExecuteModular_recoverByPrimarySPSuccess(t *testing.T) {
 		ObjectInfo:    &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)},
 		StorageParams: &storagetypes.Params{},
 	}
-	err := e.recoverByPrimarySP(context.TODO(), task)
+	outcomes := newSPOutcomeCollector()
+	err := e.recoverByPrimarySP(context.TODO(), task, outcomes)
 	assert.Nil(t, err)
+	result := outcomes.list()
+	assert.Equal(t, 1, len(result))
+	assert.Equal(t, "endpoint", result[0].Endpoint)
+	assert.Equal(t, coretask.SPOutcomeRecoveryFetch, result[0].Type)
+	assert.Equal(t, uint64(len("body")), result[0].Size)
 }
 
 func TestExecuteModular_recoverBySecondarySPFailure1(t *testing.T) {
@@ -978,7 +984,7 @@ func TestExecuteModular_recoverBySecondarySPFailure1(t *testing.T) {
 		ObjectInfo:    &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)},
 		StorageParams: &storagetypes.Params{},
 	}
-	err := e.recoverBySecondarySP(context.TODO(), task, true)
+	err := e.recoverBySecondarySP(context.TODO(), task, true, newSPOutcomeCollector())
 	assert.Equal(t, mockErr, err)
 }
 
@@ -1002,7 +1008,7 @@ func TestExecuteModular_recoverBySecondarySPFailure2(t *testing.T) {
 		ObjectInfo:    &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)},
 		StorageParams: &storagetypes.Params{},
 	}
-	err := e.recoverBySecondarySP(context.TODO(), task, true)
+	err := e.recoverBySecondarySP(context.TODO(), task, true, newSPOutcomeCollector())
 	assert.Equal(t, ErrRecoveryPieceNotEnough, err)
 }
 
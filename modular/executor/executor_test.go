@@ -3,6 +3,7 @@ package executor
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 	"testing"
 	"time"
 
@@ -12,6 +13,7 @@ import (
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -419,3 +421,42 @@ func TestExecuteModular_getSPID(t *testing.T) {
 		})
 	}
 }
+
+func TestExecuteModular_ReloadConfig(t *testing.T) {
+	e := setup(t)
+	e.maxExecuteNum = 2
+	e.workerCh = make(chan struct{}, 1)
+	ctrl := gomock.NewController(t)
+	m := corercmgr.NewMockResourceScope(ctrl)
+	e.scope = m
+	m.EXPECT().RemainingResource().Return(nil, mockErr).AnyTimes()
+	ctx, cancel := context.WithCancel(context.TODO())
+	defer cancel()
+	e.startWorkers(ctx)
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Executor.MaxExecuteNumber = 4
+	apply, err := e.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	apply()
+	assert.Equal(t, int64(4), atomic.LoadInt64(&e.maxExecuteNum))
+	<-e.workerCh
+	e.startWorkers(ctx)
+	e.workerMux.Lock()
+	assert.Equal(t, int64(4), e.workerNum)
+	e.workerMux.Unlock()
+
+	cfg.Executor.MaxExecuteNumber = 1
+	apply, err = e.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	apply()
+	assert.Eventually(t, func() bool {
+		e.workerMux.Lock()
+		defer e.workerMux.Unlock()
+		return e.workerNum == 1
+	}, time.Second, 10*time.Millisecond)
+
+	cfg.Executor.MaxExecuteNumber = -1
+	_, err = e.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
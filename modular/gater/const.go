@@ -1,6 +1,8 @@
 package gater
 
 import (
+	"time"
+
 	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
 )
 
@@ -14,12 +16,36 @@ const (
 	RangeHeader = "Range"
 	// ContentRangeHeader response HTTP header indicates where in a full body message a partial message belongs
 	ContentRangeHeader = "Content-Range"
+	// AcceptRangesHeader indicates the server supports the range requests
+	AcceptRangesHeader = "Accept-Ranges"
+	// ETagHeader is the strong entity tag derived from the object checksums
+	ETagHeader = "ETag"
+	// LastModifiedHeader indicates the time the object was last updated on chain
+	LastModifiedHeader = "Last-Modified"
+	// IfMatchHeader makes the request conditional on the entity tag matching
+	IfMatchHeader = "If-Match"
+	// IfNoneMatchHeader makes the request conditional on the entity tag not matching
+	IfNoneMatchHeader = "If-None-Match"
+	// IfModifiedSinceHeader makes the request conditional on the object being modified after the date
+	IfModifiedSinceHeader = "If-Modified-Since"
+	// IfUnmodifiedSinceHeader makes the request conditional on the object not being modified after the date
+	IfUnmodifiedSinceHeader = "If-Unmodified-Since"
+	// IfRangeHeader makes the range request conditional, the whole object is sent if the validator does not match
+	IfRangeHeader = "If-Range"
+	// MultipartByteRangesValue is the content type of the response with multiple ranges
+	MultipartByteRangesValue = "multipart/byteranges"
+	// MaxRangeCount defines the max number of the ranges in a range request
+	MaxRangeCount = 64
 	// OctetStream is used to indicate the binary files
 	OctetStream = "application/octet-stream"
 	// ContentTypeJSONHeaderValue is used to indicate json
 	ContentTypeJSONHeaderValue = "application/json"
 	// ContentTypeXMLHeaderValue is used to indicate xml
 	ContentTypeXMLHeaderValue = "application/xml"
+	// ContentTypeEventStreamHeaderValue is used to indicate server-sent events
+	ContentTypeEventStreamHeaderValue = "text/event-stream"
+	// LastEventIDHeader is sent by the server-sent events client to resume the stream after reconnecting
+	LastEventIDHeader = "Last-Event-ID"
 	// ContentDispositionHeader is used to indicate the media disposition of the resource
 	ContentDispositionHeader = "Content-Disposition"
 	// ContentDispositionAttachmentValue is used to indicate attachment
@@ -73,6 +99,32 @@ const (
 	ListObjectsPrefixQuery = "prefix"
 	// ListObjectsIncludeRemovedQuery defines whether include removed objects
 	ListObjectsIncludeRemovedQuery = "include-removed"
+	// ListObjectsMinSizeQuery defines the min payload size of the listed objects
+	ListObjectsMinSizeQuery = "min-size"
+	// ListObjectsMaxSizeQuery defines the max payload size of the listed objects
+	ListObjectsMaxSizeQuery = "max-size"
+	// ListObjectsCreateTimeStartQuery defines the unix timestamp in seconds that the listed objects are created at or after
+	ListObjectsCreateTimeStartQuery = "create-time-start"
+	// ListObjectsCreateTimeEndQuery defines the unix timestamp in seconds that the listed objects are created at or before
+	ListObjectsCreateTimeEndQuery = "create-time-end"
+	// ListObjectsContentTypeQuery defines the content type of the listed objects
+	ListObjectsContentTypeQuery = "content-type"
+	// ListObjectsObjectStatusQuery defines the status of the listed objects
+	ListObjectsObjectStatusQuery = "object-status"
+	// ListObjectsVisibilityQuery defines the visibility of the listed objects
+	ListObjectsVisibilityQuery = "visibility"
+	// ListObjectsCreatorQuery defines the creator address of the listed objects
+	ListObjectsCreatorQuery = "creator"
+	// ListObjectsSortByQuery defines the key to sort the listed objects by, which can be name, size or create_time
+	ListObjectsSortByQuery = "sort-by"
+	// ListObjectsSortOrderQuery defines the order to sort the listed objects in, which can be asc or desc
+	ListObjectsSortOrderQuery = "sort-order"
+	// ListObjectsSortOrderAsc sorts the listed objects in ascending order
+	ListObjectsSortOrderAsc = "asc"
+	// ListObjectsSortOrderDesc sorts the listed objects in descending order
+	ListObjectsSortOrderDesc = "desc"
+	// ListObjectsSummaryQuery defines whether to return the object count and the total size per prefix instead of the objects
+	ListObjectsSummaryQuery = "summary"
 	// GetBucketMetaQuery defines get bucket metadata query, which is used to route request
 	GetBucketMetaQuery = "bucket-meta"
 	// GetBucketMigrationProgressQuery defines get bucket metadata query, which is used to route request
@@ -155,6 +207,24 @@ const (
 	ListPaymentAccountStreamsQuery = "payment-buckets"
 	// ListUserPaymentAccountsQuery defines list payment accounts by owner address, which is used to route request
 	ListUserPaymentAccountsQuery = "user-payments"
+	// ListUsageRollupQuery defines list the daily usage rollups of the buckets, which is used to route request
+	ListUsageRollupQuery = "usage-rollups"
+	// StartDayQuery defines the first day of the usage rollups, like 2023-08-01
+	StartDayQuery = "start-day"
+	// EndDayQuery defines the last day of the usage rollups, like 2023-08-31
+	EndDayQuery = "end-day"
+	// NotificationQuery defines create, list and delete the notification subscriptions of a bucket, which is used to route request
+	NotificationQuery = "notification"
+	// NotificationEventsQuery defines stream the notification events of a bucket as server-sent events, which is used to route request
+	NotificationEventsQuery = "notification-events"
+	// NotificationSubscriptionIDQuery defines the id of the notification subscription to be deleted
+	NotificationSubscriptionIDQuery = "subscription-id"
+	// NotificationEventTypesQuery defines the comma separated event types to be streamed, all types are streamed if empty
+	NotificationEventTypesQuery = "event-types"
+	// NotificationStartAfterQuery defines the id of the notification event that the stream starts after
+	NotificationStartAfterQuery = "start-after"
+	// NotificationPrefixQuery defines the object name prefix of the notification events to be streamed
+	NotificationPrefixQuery = "prefix"
 	// GetGroupMembersQuery defines query sp info, which is used to route request
 	GetGroupMembersQuery = "group-members"
 	// ResourceIDQuery defines the bucket/object/group id of the resource that grants permission for
@@ -325,6 +395,48 @@ const (
 	objectSpecialSuffixUrlReplacement = "?" + UniversalEndpointSpecialSuffixQuery + "="
 	// StatusPath defines the path for sp status
 	StatusPath = "/status"
+	// S3CredentialPath defines the path to get the S3 credential of the account authorized by the off-chain auth
+	S3CredentialPath = "/greenfield/s3/v1/credential"
+)
+
+// define the S3-compatible api constants
+const (
+	// S3AmzDateHeader is the signing time of the S3 request
+	S3AmzDateHeader = "X-Amz-Date"
+	// S3AmzContentSHA256Header is the signed payload hash of the S3 request
+	S3AmzContentSHA256Header = "X-Amz-Content-Sha256"
+	// S3AmzAlgorithmQuery is the signing algorithm of the S3 presigned request
+	S3AmzAlgorithmQuery = "X-Amz-Algorithm"
+	// S3AmzCredentialQuery is the credential scope of the S3 presigned request
+	S3AmzCredentialQuery = "X-Amz-Credential"
+	// S3AmzDateQuery is the signing time of the S3 presigned request
+	S3AmzDateQuery = "X-Amz-Date"
+	// S3AmzExpiresQuery is the valid seconds of the S3 presigned request
+	S3AmzExpiresQuery = "X-Amz-Expires"
+	// S3AmzSignedHeadersQuery is the signed headers of the S3 presigned request
+	S3AmzSignedHeadersQuery = "X-Amz-SignedHeaders"
+	// S3AmzSignatureQuery is the signature of the S3 presigned request
+	S3AmzSignatureQuery = "X-Amz-Signature"
+	// S3ListTypeQuery selects the version of the S3 list objects api
+	S3ListTypeQuery = "list-type"
+	// S3ListObjectsV2 is the list type of the S3 ListObjectsV2 api
+	S3ListObjectsV2 = "2"
+	// S3ContinuationTokenQuery is the continuation token of the S3 ListObjectsV2 api
+	S3ContinuationTokenQuery = "continuation-token"
+	// S3StartAfterQuery is the start after key of the S3 ListObjectsV2 api
+	S3StartAfterQuery = "start-after"
+	// S3MaxKeysQuery is the max keys of the S3 ListObjectsV2 api
+	S3MaxKeysQuery = "max-keys"
+	// S3PrefixQuery is the prefix of the S3 ListObjectsV2 api
+	S3PrefixQuery = "prefix"
+	// S3DelimiterQuery is the delimiter of the S3 ListObjectsV2 api
+	S3DelimiterQuery = "delimiter"
+	// S3XMLNamespace is the xml namespace of the S3 responses
+	S3XMLNamespace = "http://s3.amazonaws.com/doc/2006-03-01/"
+	// S3MaxClockSkew is the max difference between the S3 request time and the server time
+	S3MaxClockSkew = 15 * time.Minute
+	// S3MaxPresignExpires is the max valid duration of the S3 presigned request
+	S3MaxPresignExpires = 7 * 24 * time.Hour
 )
 
 const (
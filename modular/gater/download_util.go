@@ -0,0 +1,255 @@
+package gater
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"mime/multipart"
+	"net/http"
+	"net/textproto"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// httpRange is the byte range of the object, both the start and the end are inclusive.
+type httpRange struct {
+	start int64
+	end   int64
+}
+
+func (r httpRange) length() int64 {
+	return r.end - r.start + 1
+}
+
+func (r httpRange) contentRange(size int64) string {
+	return fmt.Sprintf("bytes %d-%d/%d", r.start, r.end, size)
+}
+
+// unsatisfiedContentRange returns the Content-Range of the 416 response, which tells the current size
+// of the object.
+func unsatisfiedContentRange(size int64) string {
+	return fmt.Sprintf("bytes */%d", size)
+}
+
+func (r httpRange) mimeHeader(contentType string, size int64) textproto.MIMEHeader {
+	return textproto.MIMEHeader{
+		ContentRangeHeader: {r.contentRange(size)},
+		ContentTypeHeader:  {contentType},
+	}
+}
+
+// parseRanges parses the Range header against the object size. The nil ranges mean the header is
+// ignored and the whole object is sent, it happens if the header is absent or malformed, the object
+// is empty, or the ranges are too many or overlapped too much. The unsatisfiable ranges are dropped,
+// and ErrRangeNotSatisfiable is returned if no range is satisfiable.
+func parseRanges(rangeStr string, size int64) ([]httpRange, error) {
+	if rangeStr == "" || size <= 0 {
+		return nil, nil
+	}
+	rangeStr = strings.ReplaceAll(strings.ToLower(rangeStr), " ", "")
+	if !strings.HasPrefix(rangeStr, "bytes=") {
+		return nil, nil
+	}
+	var (
+		ranges      []httpRange
+		specs       = strings.Split(rangeStr[len("bytes="):], ",")
+		totalLength int64
+	)
+	for _, spec := range specs {
+		if spec == "" {
+			continue
+		}
+		var r httpRange
+		if strings.HasPrefix(spec, "-") {
+			// the suffix range asks for the last bytes of the object
+			suffixLength, err := util.StringToInt64(spec[1:])
+			if err != nil || suffixLength < 0 {
+				return nil, nil
+			}
+			if suffixLength == 0 {
+				continue
+			}
+			if suffixLength > size {
+				suffixLength = size
+			}
+			r = httpRange{start: size - suffixLength, end: size - 1}
+		} else {
+			isRange, rangeStart, rangeEnd := parseRange("bytes=" + spec)
+			if !isRange {
+				return nil, nil
+			}
+			if rangeEnd < 0 || rangeEnd >= size {
+				rangeEnd = size - 1
+			}
+			if rangeStart < 0 || rangeStart > rangeEnd {
+				continue
+			}
+			r = httpRange{start: rangeStart, end: rangeEnd}
+		}
+		ranges = append(ranges, r)
+		totalLength += r.length()
+	}
+	if len(ranges) == 0 {
+		return nil, ErrRangeNotSatisfiable
+	}
+	// the total bytes of all the ranges are larger than the object, it is probably an attack or a dumb
+	// client, ignore the ranges like net/http does
+	if len(ranges) > MaxRangeCount || totalLength > size {
+		return nil, nil
+	}
+	return ranges, nil
+}
+
+// objectETag returns the strong entity tag of the object, it is derived from the on-chain checksums,
+// so it changes whenever the object content changes. The empty string is returned if the object has
+// no checksum.
+func objectETag(objectInfo *storagetypes.ObjectInfo) string {
+	if len(objectInfo.GetChecksums()) == 0 {
+		return ""
+	}
+	hash := sha256.New()
+	for _, checksum := range objectInfo.GetChecksums() {
+		hash.Write(checksum)
+	}
+	return "\"" + hex.EncodeToString(hash.Sum(nil)) + "\""
+}
+
+// objectModTime returns the time the object was last updated on chain, the zero time is returned if
+// it is unknown.
+func objectModTime(objectInfo *storagetypes.ObjectInfo) time.Time {
+	if objectInfo.GetLatestUpdatedTime() <= 0 {
+		return time.Time{}
+	}
+	return time.Unix(objectInfo.GetLatestUpdatedTime(), 0).UTC()
+}
+
+// etagMatch reports whether the etag matches any of the entity tags in the list header. The weak
+// comparison ignores the W/ prefix, the strong comparison never matches a weak entity tag.
+func etagMatch(list string, etag string, weak bool) bool {
+	for _, tag := range strings.Split(list, ",") {
+		tag = strings.TrimSpace(tag)
+		if tag == "*" {
+			return true
+		}
+		if etag == "" {
+			continue
+		}
+		if strings.HasPrefix(tag, "W/") {
+			if !weak {
+				continue
+			}
+			tag = tag[len("W/"):]
+		}
+		if tag == etag {
+			return true
+		}
+	}
+	return false
+}
+
+// checkPreconditions evaluates the conditional headers of the download request in the order of
+// RFC 7232, it returns http.StatusNotModified or http.StatusPreconditionFailed if the object should
+// not be sent, otherwise it returns http.StatusOK.
+func checkPreconditions(header http.Header, etag string, modTime time.Time) int {
+	if ifMatch := header.Get(IfMatchHeader); ifMatch != "" {
+		if !etagMatch(ifMatch, etag, false) {
+			return http.StatusPreconditionFailed
+		}
+	} else if ifUnmodifiedSince := header.Get(IfUnmodifiedSinceHeader); ifUnmodifiedSince != "" && !modTime.IsZero() {
+		if date, err := http.ParseTime(ifUnmodifiedSince); err == nil && modTime.After(date) {
+			return http.StatusPreconditionFailed
+		}
+	}
+	if ifNoneMatch := header.Get(IfNoneMatchHeader); ifNoneMatch != "" {
+		if etagMatch(ifNoneMatch, etag, true) {
+			return http.StatusNotModified
+		}
+	} else if ifModifiedSince := header.Get(IfModifiedSinceHeader); ifModifiedSince != "" && !modTime.IsZero() {
+		if date, err := http.ParseTime(ifModifiedSince); err == nil && !modTime.After(date) {
+			return http.StatusNotModified
+		}
+	}
+	return http.StatusOK
+}
+
+// checkIfRange reports whether the Range header should be served, the If-Range header carries either
+// an entity tag that must strongly match, or a date that must exactly match the modification time.
+func checkIfRange(header http.Header, etag string, modTime time.Time) bool {
+	ifRange := header.Get(IfRangeHeader)
+	if ifRange == "" {
+		return true
+	}
+	if strings.HasPrefix(ifRange, "W/") {
+		return false
+	}
+	if strings.HasPrefix(ifRange, "\"") {
+		return etagMatch(ifRange, etag, false)
+	}
+	if modTime.IsZero() {
+		return false
+	}
+	date, err := http.ParseTime(ifRange)
+	return err == nil && date.Equal(modTime)
+}
+
+// multipartRangesSize returns the content length of the multipart/byteranges response.
+func multipartRangesSize(ranges []httpRange, boundary string, contentType string, size int64) int64 {
+	var (
+		counter    = &countingWriter{}
+		mw         = multipart.NewWriter(counter)
+		dataLength int64
+	)
+	_ = mw.SetBoundary(boundary)
+	for _, r := range ranges {
+		_, _ = mw.CreatePart(r.mimeHeader(contentType, size))
+		dataLength += r.length()
+	}
+	_ = mw.Close()
+	return counter.n + dataLength
+}
+
+type countingWriter struct {
+	n int64
+}
+
+func (w *countingWriter) Write(p []byte) (int, error) {
+	w.n += int64(len(p))
+	return len(p), nil
+}
+
+// replyWriter records the error of replying to the client, so the failure of the client connection can be
+// told apart from the failure of the downloader.
+type replyWriter struct {
+	w   io.Writer
+	err error
+}
+
+func (w *replyWriter) Write(p []byte) (int, error) {
+	n, err := w.w.Write(p)
+	if err != nil {
+		w.err = err
+	}
+	return n, err
+}
+
+// deferredStatusWriter writes the status code right before the first body byte, so the error that
+// happens before any data is sent can still be replied with its own status code.
+type deferredStatusWriter struct {
+	http.ResponseWriter
+	status  int
+	written bool
+}
+
+var _ io.Writer = &deferredStatusWriter{}
+
+func (w *deferredStatusWriter) Write(p []byte) (int, error) {
+	if !w.written {
+		w.written = true
+		w.ResponseWriter.WriteHeader(w.status)
+	}
+	return w.ResponseWriter.Write(p)
+}
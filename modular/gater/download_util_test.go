@@ -0,0 +1,297 @@
+package gater
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"mime"
+	"mime/multipart"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"testing/iotest"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func Test_parseRanges(t *testing.T) {
+	cases := []struct {
+		name         string
+		rangeStr     string
+		size         int64
+		wantedRanges []httpRange
+		wantedErr    error
+	}{
+		{name: "no range", rangeStr: "", size: 10},
+		{name: "empty object", rangeStr: "bytes=0-1", size: 0},
+		{name: "malformed unit", rangeStr: "items=0-1", size: 10},
+		{name: "malformed range", rangeStr: "bytes=0-1,a-2", size: 10},
+		{name: "single range", rangeStr: "bytes=1-2", size: 10, wantedRanges: []httpRange{{1, 2}}},
+		{name: "open range", rangeStr: "bytes=8-", size: 10, wantedRanges: []httpRange{{8, 9}}},
+		{name: "suffix range", rangeStr: "bytes=-3", size: 10, wantedRanges: []httpRange{{7, 9}}},
+		{name: "suffix range larger than object", rangeStr: "bytes=-30", size: 10, wantedRanges: []httpRange{{0, 9}}},
+		{name: "clamp end", rangeStr: "bytes=5-100", size: 10, wantedRanges: []httpRange{{5, 9}}},
+		{name: "multiple ranges", rangeStr: "bytes=0-1, 4-5,-2", size: 10, wantedRanges: []httpRange{{0, 1}, {4, 5}, {8, 9}}},
+		{name: "drop unsatisfiable range", rangeStr: "bytes=0-1,20-30", size: 10, wantedRanges: []httpRange{{0, 1}}},
+		{name: "unsatisfiable ranges", rangeStr: "bytes=20-30,-0", size: 10, wantedErr: ErrRangeNotSatisfiable},
+		{name: "overlapped ranges", rangeStr: "bytes=0-9,0-9", size: 10},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			ranges, err := parseRanges(tt.rangeStr, tt.size)
+			assert.Equal(t, tt.wantedErr, err)
+			assert.Equal(t, tt.wantedRanges, ranges)
+		})
+	}
+}
+
+func Test_checkPreconditions(t *testing.T) {
+	etag := `"abc"`
+	modTime := time.Unix(1700000000, 0).UTC()
+	before := modTime.Add(-time.Hour).Format(http.TimeFormat)
+	after := modTime.Add(time.Hour).Format(http.TimeFormat)
+	cases := []struct {
+		name         string
+		header       map[string]string
+		wantedStatus int
+	}{
+		{name: "no condition", wantedStatus: http.StatusOK},
+		{name: "if-match", header: map[string]string{IfMatchHeader: `"xyz", "abc"`}, wantedStatus: http.StatusOK},
+		{name: "if-match any", header: map[string]string{IfMatchHeader: "*"}, wantedStatus: http.StatusOK},
+		{name: "if-match weak", header: map[string]string{IfMatchHeader: `W/"abc"`}, wantedStatus: http.StatusPreconditionFailed},
+		{name: "if-unmodified-since", header: map[string]string{IfUnmodifiedSinceHeader: before}, wantedStatus: http.StatusPreconditionFailed},
+		{name: "if-unmodified-since ignored", header: map[string]string{IfMatchHeader: etag, IfUnmodifiedSinceHeader: before}, wantedStatus: http.StatusOK},
+		{name: "if-none-match", header: map[string]string{IfNoneMatchHeader: `W/"abc"`}, wantedStatus: http.StatusNotModified},
+		{name: "if-none-match mismatch", header: map[string]string{IfNoneMatchHeader: `"xyz"`}, wantedStatus: http.StatusOK},
+		{name: "if-modified-since", header: map[string]string{IfModifiedSinceHeader: after}, wantedStatus: http.StatusNotModified},
+		{name: "if-modified-since modified", header: map[string]string{IfModifiedSinceHeader: before}, wantedStatus: http.StatusOK},
+		{name: "if-modified-since ignored", header: map[string]string{IfNoneMatchHeader: `"xyz"`, IfModifiedSinceHeader: after}, wantedStatus: http.StatusOK},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			for k, v := range tt.header {
+				header.Set(k, v)
+			}
+			assert.Equal(t, tt.wantedStatus, checkPreconditions(header, etag, modTime))
+		})
+	}
+}
+
+func Test_checkIfRange(t *testing.T) {
+	etag := `"abc"`
+	modTime := time.Unix(1700000000, 0).UTC()
+	cases := []struct {
+		name    string
+		ifRange string
+		wanted  bool
+	}{
+		{name: "no if-range", ifRange: "", wanted: true},
+		{name: "etag match", ifRange: etag, wanted: true},
+		{name: "etag mismatch", ifRange: `"xyz"`, wanted: false},
+		{name: "weak etag", ifRange: `W/"abc"`, wanted: false},
+		{name: "date match", ifRange: modTime.Format(http.TimeFormat), wanted: true},
+		{name: "date mismatch", ifRange: modTime.Add(time.Second).Format(http.TimeFormat), wanted: false},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			header := http.Header{}
+			header.Set(IfRangeHeader, tt.ifRange)
+			assert.Equal(t, tt.wanted, checkIfRange(header, etag, modTime))
+		})
+	}
+}
+
+func Test_objectETag(t *testing.T) {
+	object := &storagetypes.ObjectInfo{}
+	assert.Equal(t, "", objectETag(object))
+	object.Checksums = [][]byte{[]byte("a"), []byte("b")}
+	etag := objectETag(object)
+	assert.True(t, strings.HasPrefix(etag, `"`) && strings.HasSuffix(etag, `"`))
+	object.Checksums = [][]byte{[]byte("a"), []byte("c")}
+	assert.NotEqual(t, etag, objectETag(object))
+}
+
+const mockObjectPayload = "0123456789"
+
+func mockDownloadGater(t *testing.T, getObjectTimes int) *GateModular {
+	return mockDownloadGaterWithReader(t, getObjectTimes, func(task coretask.DownloadObjectTask) io.Reader {
+		return strings.NewReader(mockObjectPayload[task.GetLow() : task.GetHigh()+1])
+	})
+}
+
+func mockDownloadGaterWithReader(t *testing.T, getObjectTimes int, newReader func(task coretask.DownloadObjectTask) io.Reader) *GateModular {
+	g := setup(t)
+	ctrl := gomock.NewController(t)
+	clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+	clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any()).Return(false, mockErr).AnyTimes()
+	var allow = permissiontypes.EFFECT_ALLOW
+	clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+		Return(&allow, nil).Times(1)
+	clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, task coretask.DownloadObjectTask, opts ...grpc.DialOption) (io.ReadCloser, error) {
+			return io.NopCloser(newReader(task)), nil
+		}).Times(getObjectTimes)
+	g.baseApp.SetGfSpClient(clientMock)
+
+	consensusMock := consensus.NewMockConsensus(ctrl)
+	consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+		&storagetypes.ObjectInfo{
+			Id:          sdkmath.NewUint(1),
+			PayloadSize: uint64(len(mockObjectPayload)),
+			ContentType: "text/plain",
+			CreateAt:    1700000000,
+			Checksums:   [][]byte{[]byte("checksum")},
+		}, nil).Times(1)
+	consensusMock.EXPECT().QueryBucketInfo(gomock.Any(), gomock.Any()).Return(&storagetypes.BucketInfo{
+		Id: sdkmath.NewUint(2)}, nil).AnyTimes()
+	consensusMock.EXPECT().QueryStorageParamsByTimestamp(gomock.Any(), gomock.Any()).Return(
+		&storagetypes.Params{MaxPayloadSize: 10}, nil).AnyTimes()
+	g.baseApp.SetConsensus(consensusMock)
+
+	pieceOpMock := piecestore.NewMockPieceOp(ctrl)
+	pieceOpMock.EXPECT().SegmentPieceCount(gomock.Any(), gomock.Any()).Return(uint32(1)).AnyTimes()
+	pieceOpMock.EXPECT().SegmentPieceKey(gomock.Any(), gomock.Any(), gomock.Any()).Return("test").AnyTimes()
+	g.baseApp.SetPieceOp(pieceOpMock)
+	return g
+}
+
+func mockDownloadRequest(header map[string]string) *http.Request {
+	path := fmt.Sprintf("%s%s.%s/%s", scheme, mockBucketName, testDomain, mockObjectName)
+	req := httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+	for k, v := range header {
+		req.Header.Set(k, v)
+	}
+	return req
+}
+
+func TestGateModular_downloadObjectWithRanges(t *testing.T) {
+	router := mockGetObjectHandlerRoute(t, mockDownloadGater(t, 2))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockDownloadRequest(map[string]string{RangeHeader: "bytes=1-2,-3"}))
+
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "bytes", w.Header().Get(AcceptRangesHeader))
+	assert.NotEmpty(t, w.Header().Get(ETagHeader))
+	mediaType, mediaParams, err := mime.ParseMediaType(w.Header().Get(ContentTypeHeader))
+	require.NoError(t, err)
+	assert.Equal(t, MultipartByteRangesValue, mediaType)
+	assert.Equal(t, fmt.Sprint(w.Body.Len()), w.Header().Get(ContentLengthHeader))
+
+	reader := multipart.NewReader(w.Body, mediaParams["boundary"])
+	var parts []string
+	for {
+		part, err := reader.NextPart()
+		if err == io.EOF {
+			break
+		}
+		require.NoError(t, err)
+		data, err := io.ReadAll(part)
+		require.NoError(t, err)
+		assert.Equal(t, "text/plain", part.Header.Get(ContentTypeHeader))
+		parts = append(parts, part.Header.Get(ContentRangeHeader)+":"+string(data))
+	}
+	assert.Equal(t, []string{"bytes 1-2/10:12", "bytes 7-9/10:789"}, parts)
+}
+
+func TestGateModular_downloadObjectWithSingleRange(t *testing.T) {
+	router := mockGetObjectHandlerRoute(t, mockDownloadGater(t, 1))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockDownloadRequest(map[string]string{RangeHeader: "bytes=2-"}))
+
+	assert.Equal(t, http.StatusPartialContent, w.Code)
+	assert.Equal(t, "bytes 2-9/10", w.Header().Get(ContentRangeHeader))
+	assert.Equal(t, "8", w.Header().Get(ContentLengthHeader))
+	assert.Equal(t, mockObjectPayload[2:], w.Body.String())
+}
+
+func TestGateModular_downloadObjectWithUnsatisfiableRange(t *testing.T) {
+	router := mockGetObjectHandlerRoute(t, mockDownloadGater(t, 0))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockDownloadRequest(map[string]string{RangeHeader: "bytes=20-30"}))
+
+	assert.Equal(t, http.StatusRequestedRangeNotSatisfiable, w.Code)
+	assert.Equal(t, "bytes */10", w.Header().Get(ContentRangeHeader))
+}
+
+func TestGateModular_downloadObjectAborted(t *testing.T) {
+	// the quota of the unsent data is refunded by the downloader only, the gateway never recoups the quota
+	router := mockGetObjectHandlerRoute(t, mockDownloadGaterWithReader(t, 1, func(task coretask.DownloadObjectTask) io.Reader {
+		return io.MultiReader(strings.NewReader(mockObjectPayload[:2]), iotest.ErrReader(mockErr))
+	}))
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockDownloadRequest(nil))
+
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.True(t, strings.HasPrefix(w.Body.String(), mockObjectPayload[:2]))
+}
+
+func TestGateModular_downloadObjectWithConditions(t *testing.T) {
+	etag := objectETag(&storagetypes.ObjectInfo{Checksums: [][]byte{[]byte("checksum")}})
+	modTime := time.Unix(1700000000, 0).UTC()
+	cases := []struct {
+		name           string
+		header         map[string]string
+		getObjectTimes int
+		wantedCode     int
+		wantedBody     string
+	}{
+		{
+			name:       "not modified by etag",
+			header:     map[string]string{IfNoneMatchHeader: etag},
+			wantedCode: http.StatusNotModified,
+		},
+		{
+			name:       "not modified by date",
+			header:     map[string]string{IfModifiedSinceHeader: modTime.Format(http.TimeFormat)},
+			wantedCode: http.StatusNotModified,
+		},
+		{
+			name:       "precondition failed",
+			header:     map[string]string{IfMatchHeader: `"mismatch"`},
+			wantedCode: http.StatusPreconditionFailed,
+			wantedBody: "precondition failed",
+		},
+		{
+			name:           "if-range mismatch sends whole object",
+			header:         map[string]string{RangeHeader: "bytes=0-1", IfRangeHeader: `"mismatch"`},
+			getObjectTimes: 1,
+			wantedCode:     http.StatusOK,
+			wantedBody:     mockObjectPayload,
+		},
+		{
+			name:           "if-range match sends range",
+			header:         map[string]string{RangeHeader: "bytes=0-1", IfRangeHeader: etag},
+			getObjectTimes: 1,
+			wantedCode:     http.StatusPartialContent,
+			wantedBody:     mockObjectPayload[:2],
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockGetObjectHandlerRoute(t, mockDownloadGater(t, tt.getObjectTimes))
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, mockDownloadRequest(tt.header))
+			assert.Equal(t, tt.wantedCode, w.Code)
+			assert.Contains(t, w.Body.String(), tt.wantedBody)
+			if tt.wantedCode == http.StatusNotModified {
+				assert.Empty(t, w.Body.String())
+				assert.Equal(t, etag, w.Header().Get(ETagHeader))
+			}
+		})
+	}
+}
@@ -60,7 +60,24 @@ var (
 	// 2. Equals "/": Direct reference to the root directory, which is usually unsafe.
 	// 3. Contains "\": May indicate an attempt at illegal path or file operations, especially in Windows systems.
 	// 4. Fails SQL Injection Test (util.IsSQLInjection): Object name contains patterns that might be used for SQL injection, like ';select', 'xxx;insert', etc., or SQL comment patterns.
-	ErrInvalidObjectName = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50044, "invalid object name")
+	ErrInvalidObjectName  = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50044, "invalid object name")
+	ErrPreconditionFailed = gfsperrors.Register(module.GateModularName, http.StatusPreconditionFailed, 50045, "precondition failed")
+
+	ErrS3AuthorizationMalformed = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50046, "the S3 authorization is malformed")
+	ErrS3InvalidAccessKey       = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50047, "the S3 access key id does not exist")
+	ErrS3SignatureMismatch      = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50048, "the S3 request signature does not match")
+	ErrS3RequestTimeSkewed      = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50049, "the difference between the S3 request time and the server time is too large")
+	ErrS3RequestExpired         = gfsperrors.Register(module.GateModularName, http.StatusForbidden, 50050, "the S3 presigned request has expired")
+	ErrS3NotImplemented         = gfsperrors.Register(module.GateModularName, http.StatusNotImplemented, 50051, "the S3 operation is not implemented")
+	ErrS3Disabled               = gfsperrors.Register(module.GateModularName, http.StatusNotImplemented, 50052, "the S3 facade is not enabled")
+
+	ErrAccountRequestRateExceeded = gfsperrors.Register(module.GateModularName, http.StatusTooManyRequests, 50053, "too many requests from the account, please try it again later")
+	ErrBucketRequestRateExceeded  = gfsperrors.Register(module.GateModularName, http.StatusTooManyRequests, 50054, "too many requests to the bucket, please try it again later")
+	ErrAccountByteRateExceeded    = gfsperrors.Register(module.GateModularName, http.StatusTooManyRequests, 50055, "the bandwidth of the account is exhausted, please try it again later")
+	ErrBucketByteRateExceeded     = gfsperrors.Register(module.GateModularName, http.StatusTooManyRequests, 50056, "the bandwidth of the bucket is exhausted, please try it again later")
+
+	ErrMalformedNotificationConfiguration = gfsperrors.Register(module.GateModularName, http.StatusBadRequest, 50057, "the notification configuration is malformed")
+	ErrRangeNotSatisfiable                = gfsperrors.Register(module.GateModularName, http.StatusRequestedRangeNotSatisfiable, 50058, "requested range not satisfiable")
 )
 
 func ErrEncodeResponseWithDetail(detail string) *gfsperrors.GfSpError {
@@ -12,6 +12,8 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 )
 
 const ReadHeaderTimeout = 20 * time.Minute
@@ -29,8 +31,16 @@ type GateModular struct {
 	maxListReadQuota int64
 	maxPayloadSize   uint64
 
+	// s3Domain enables the S3-compatible api facade if it is not empty
+	s3Domain           string
+	s3CredentialSecret string
+	s3Region           string
+
 	spID        uint32
 	spCachePool *SPCachePool
+
+	// tenantLimiter limits the uploads and downloads per account and per bucket
+	tenantLimiter *mwhttp.TenantLimiter
 }
 
 func (g *GateModular) Name() string {
@@ -50,6 +60,8 @@ func (g *GateModular) Start(ctx context.Context) error {
 
 func (g *GateModular) server(ctx context.Context) {
 	router := mux.NewRouter().SkipClean(true)
+	// the tracing middleware is the outermost, so that the metrics record the trace ids as exemplars
+	router.Use(tracing.HTTPServerMiddleware)
 	if g.baseApp.EnableMetrics() {
 		router.Use(metrics.DefaultHTTPServerMetrics.InstrumentationHandler)
 	}
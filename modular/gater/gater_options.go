@@ -1,6 +1,7 @@
 package gater
 
 import (
+	"fmt"
 	"strings"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
@@ -42,11 +43,23 @@ func defaultGaterOptions(gater *GateModular, cfg *gfspconfig.GfSpConfig) error {
 	gater.domain = cfg.Gateway.DomainName
 	gater.httpAddress = cfg.Gateway.HTTPAddress
 	gater.maxListReadQuota = cfg.Bucket.MaxListReadQuotaNumber
+	if cfg.Gateway.S3DomainName != "" && cfg.Gateway.S3CredentialSecret == "" {
+		return fmt.Errorf("the S3 credential secret is required by the S3 facade")
+	}
+	gater.s3Domain = cfg.Gateway.S3DomainName
+	gater.s3CredentialSecret = cfg.Gateway.S3CredentialSecret
+	gater.s3Region = cfg.Gateway.S3Region
 	rateCfg := makeAPIRateLimitCfg(cfg.APIRateLimiter)
 	if err := mwhttp.NewAPILimiter(rateCfg); err != nil {
 		log.Errorw("failed to new api limiter", "err", err)
 		return err
 	}
+	tenantLimiter, err := mwhttp.NewTenantLimiter(cfg.APIRateLimiter.TenantLimitCfg)
+	if err != nil {
+		log.Errorw("failed to new tenant limiter", "err", err)
+		return err
+	}
+	gater.tenantLimiter = tenantLimiter
 	return nil
 }
 
@@ -0,0 +1,38 @@
+package gater
+
+import (
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+)
+
+var _ gfspapp.ReloadableModular = &GateModular{}
+
+// ReloadableConfigs returns the configs that gater can apply at runtime.
+func (g *GateModular) ReloadableConfigs() []string {
+	return []string{"APIRateLimiter"}
+}
+
+// ReloadConfig updates the api rate limits and the tenant limits, the counted requests and the tokens
+// that the tenants hold are kept.
+func (g *GateModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	applyAPILimiter, err := mwhttp.ReloadAPILimiter(makeAPIRateLimitCfg(cfg.APIRateLimiter))
+	if err != nil {
+		return nil, err
+	}
+	// the tenant limiter validates the whole config before applying, checks it by a temporary one
+	// to leave the running limits unchanged if any other modular rejects the reloading.
+	if _, err = mwhttp.NewTenantLimiter(cfg.APIRateLimiter.TenantLimitCfg); err != nil {
+		return nil, err
+	}
+	return func() {
+		applyAPILimiter()
+		if g.tenantLimiter == nil {
+			return
+		}
+		if err := g.tenantLimiter.Reload(cfg.APIRateLimiter.TenantLimitCfg); err != nil {
+			log.Errorw("failed to reload tenant limiter", "error", err)
+		}
+	}, nil
+}
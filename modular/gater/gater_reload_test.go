@@ -0,0 +1,42 @@
+package gater
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+)
+
+func TestGateModular_ReloadConfig(t *testing.T) {
+	g := setupTenantLimit(t)
+	assert.Equal(t, []string{"APIRateLimiter"}, g.ReloadableConfigs())
+	assert.Nil(t, mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{}))
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.APIRateLimiter.TenantLimitCfg = mwhttp.TenantLimitConfig{On: false}
+	apply, err := g.ReloadConfig(cfg)
+	assert.Nil(t, err)
+
+	reqCtx := &RequestContext{ctx: context.Background(), account: "0xAccount"}
+	assert.Nil(t, g.checkTenantRequest(reqCtx))
+	assert.Equal(t, ErrAccountRequestRateExceeded, g.checkTenantRequest(reqCtx))
+
+	// the tenant limit is disabled after applying
+	apply()
+	assert.Nil(t, g.checkTenantRequest(reqCtx))
+}
+
+func TestGateModular_ReloadConfigFailure(t *testing.T) {
+	g := setupTenantLimit(t)
+	assert.Nil(t, mwhttp.NewAPILimiter(&mwhttp.APILimiterConfig{}))
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.APIRateLimiter.TenantLimitCfg = mwhttp.TenantLimitConfig{
+		Tiers: []mwhttp.TenantLimitTier{{Name: "account", RequestRate: -1}},
+	}
+	_, err := g.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
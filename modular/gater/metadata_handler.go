@@ -20,6 +20,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
 	"github.com/bnb-chain/greenfield-storage-provider/util"
 	"github.com/bnb-chain/greenfield/types/resource"
 	resource_types "github.com/bnb-chain/greenfield/types/resource"
@@ -38,6 +39,9 @@ const (
 	MaximumIDSize                    = 100
 	DefaultGetGroupListLimit         = 50
 	DefaultGetGroupListOffset        = 0
+	DefaultListUsageRollupLimit      = 100
+	MaximumListUsageRollupLimit      = 1000
+	UsageRollupDayLayout             = "2006-01-02"
 	HandlerSuccess                   = "success"
 	HandlerFailure                   = "failure"
 	HandlerLevel                     = "handler"
@@ -136,8 +140,11 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 		requestDelimiter         string
 		requestPrefix            string
 		requestIncludeRemoved    string
+		requestSortBy            string
+		requestSortOrder         string
 		continuationToken        string
 		includedRemoved          bool
+		listReq                  *types.GfSpListObjectsByBucketNameRequest
 		decodedContinuationToken []byte
 		queryParams              url.Values
 	)
@@ -165,6 +172,8 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 	requestDelimiter = queryParams.Get(ListObjectsDelimiterQuery)
 	requestPrefix = queryParams.Get(ListObjectsPrefixQuery)
 	requestIncludeRemoved = queryParams.Get(ListObjectsIncludeRemovedQuery)
+	requestSortBy = queryParams.Get(ListObjectsSortByQuery)
+	requestSortOrder = queryParams.Get(ListObjectsSortOrderQuery)
 
 	if requestDelimiter != "" && requestDelimiter != "/" {
 		log.CtxErrorw(reqCtx.Context(), "failed to check delimiter", "delimiter", requestDelimiter, "error", err)
@@ -202,7 +211,11 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 			return
 		}
 		continuationToken = string(decodedContinuationToken)
+	}
 
+	// the continuation token of the objects sorted by size or create time starts with the sort value,
+	// which is checked by the metadata service
+	if requestContinuationToken != "" && (requestSortBy == "" || requestSortBy == bsdb.ListObjectsSortByName) {
 		if err = s3util.CheckValidObjectName(continuationToken); err != nil {
 			log.Errorw("failed to check requestContinuationToken", "continuation_token", continuationToken, "error", err)
 			err = ErrInvalidQuery
@@ -235,43 +248,44 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 		continuationToken = requestStartAfter
 	}
 
-	objects,
-		keyCount,
-		maxKeys,
-		isTruncated,
-		nextContinuationToken,
-		name,
-		prefix,
-		delimiter,
-		commonPrefixes,
-		continuationToken,
-		err :=
-		g.baseApp.GfSpClient().ListObjectsByBucketName(
-			reqCtx.Context(),
-			requestBucketName,
-			"",
-			maxKeys,
-			requestStartAfter,
-			continuationToken,
-			requestDelimiter,
-			requestPrefix,
-			includedRemoved)
-	if err != nil {
-		log.Errorf("failed to list objects by bucket name", "error", err)
+	listReq = &types.GfSpListObjectsByBucketNameRequest{
+		BucketName:        requestBucketName,
+		MaxKeys:           maxKeys,
+		StartAfter:        requestStartAfter,
+		ContinuationToken: continuationToken,
+		Delimiter:         requestDelimiter,
+		Prefix:            requestPrefix,
+		IncludeRemoved:    includedRemoved,
+		ContentType:       queryParams.Get(ListObjectsContentTypeQuery),
+		ObjectStatus:      queryParams.Get(ListObjectsObjectStatusQuery),
+		Visibility:        queryParams.Get(ListObjectsVisibilityQuery),
+		Creator:           queryParams.Get(ListObjectsCreatorQuery),
+		SortBy:            requestSortBy,
+	}
+	if err = parseListObjectsOptions(queryParams, listReq); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse list objects options", "error", err)
+		err = ErrInvalidQuery
+		return
+	}
+	switch requestSortOrder {
+	case "", ListObjectsSortOrderAsc:
+	case ListObjectsSortOrderDesc:
+		listReq.SortDesc = true
+	default:
+		log.CtxErrorw(reqCtx.Context(), "failed to check sort order", "sort_order", requestSortOrder)
+		err = ErrInvalidQuery
+		return
+	}
+	if listReq.Creator != "" && !common.IsHexAddress(listReq.Creator) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check creator", "creator", listReq.Creator)
+		err = ErrInvalidQuery
 		return
 	}
 
-	grpcResponse := &types.GfSpListObjectsByBucketNameResponse{
-		Objects:               objects,
-		KeyCount:              keyCount,
-		MaxKeys:               maxKeys,
-		IsTruncated:           isTruncated,
-		NextContinuationToken: nextContinuationToken,
-		Name:                  name,
-		Prefix:                prefix,
-		Delimiter:             delimiter,
-		CommonPrefixes:        commonPrefixes,
-		ContinuationToken:     continuationToken,
+	grpcResponse, err := g.baseApp.GfSpClient().ListObjectsByBucketNameWithOptions(reqCtx.Context(), listReq)
+	if err != nil {
+		log.Errorf("failed to list objects by bucket name", "error", err)
+		return
 	}
 
 	respBytes, err = xml.Marshal(grpcResponse)
@@ -285,6 +299,36 @@ func (g *GateModular) listObjectsByBucketNameHandler(w http.ResponseWriter, r *h
 	w.Write(respBytes)
 }
 
+// parseListObjectsOptions parses the numeric filters and the summary mode of listing objects in the query
+func parseListObjectsOptions(queryParams url.Values, req *types.GfSpListObjectsByBucketNameRequest) (err error) {
+	if value := queryParams.Get(ListObjectsMinSizeQuery); value != "" {
+		if req.MinPayloadSize, err = util.StringToUint64(value); err != nil {
+			return err
+		}
+	}
+	if value := queryParams.Get(ListObjectsMaxSizeQuery); value != "" {
+		if req.MaxPayloadSize, err = util.StringToUint64(value); err != nil {
+			return err
+		}
+	}
+	if value := queryParams.Get(ListObjectsCreateTimeStartQuery); value != "" {
+		if req.CreateTimeStart, err = util.StringToInt64(value); err != nil {
+			return err
+		}
+	}
+	if value := queryParams.Get(ListObjectsCreateTimeEndQuery); value != "" {
+		if req.CreateTimeEnd, err = util.StringToInt64(value); err != nil {
+			return err
+		}
+	}
+	if value := queryParams.Get(ListObjectsSummaryQuery); value != "" {
+		if req.Summary, err = strconv.ParseBool(value); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
 // getObjectMetaHandler handle get object metadata request
 func (g *GateModular) getObjectMetaHandler(w http.ResponseWriter, r *http.Request) {
 	var (
@@ -2825,3 +2869,103 @@ func (g *GateModular) getBsDBDataInfoHandler(w http.ResponseWriter, r *http.Requ
 	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
 	w.Write(respBytes)
 }
+
+// listUsageRollupHandler lists the daily usage rollups of the buckets by the day range, bucket id and payment
+// account in pages
+func (g *GateModular) listUsageRollupHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err         error
+		respBytes   []byte
+		reqCtx      *RequestContext
+		queryParams url.Values
+		bucketID    uint64
+		offset      uint64
+		limit       uint64
+		rollups     []*types.UsageRollup
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		handlerName := mux.CurrentRoute(r).GetName()
+		if err != nil {
+			reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+			modelgateway.MakeErrorResponse(w, err)
+			MetadataHandlerFailureMetrics(err, startTime, handlerName)
+		} else {
+			MetadataHandlerSuccessMetrics(startTime, handlerName)
+		}
+		log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+	}()
+
+	reqCtx, _ = NewRequestContext(r, g)
+
+	queryParams = reqCtx.request.URL.Query()
+	startDay := queryParams.Get(StartDayQuery)
+	endDay := queryParams.Get(EndDayQuery)
+	paymentAccount := queryParams.Get(PaymentAccountQuery)
+	for _, day := range []string{startDay, endDay} {
+		if day == "" {
+			continue
+		}
+		if _, err = time.Parse(UsageRollupDayLayout, day); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse day", "day", day, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if paymentAccount != "" && !common.IsHexAddress(paymentAccount) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check payment account", "payment_account", paymentAccount)
+		err = ErrInvalidQuery
+		return
+	}
+	if bucketIDStr := queryParams.Get(BucketIDQuery); bucketIDStr != "" {
+		if bucketID, err = util.StringToUint64(bucketIDStr); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse bucket id", "bucket_id", bucketIDStr, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if offsetStr := queryParams.Get(GetGroupListOffsetQuery); offsetStr != "" {
+		if offset, err = strconv.ParseUint(offsetStr, 10, 32); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse offset", "offset", offsetStr, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	limit = DefaultListUsageRollupLimit
+	if limitStr := queryParams.Get(LimitQuery); limitStr != "" {
+		if limit, err = strconv.ParseUint(limitStr, 10, 32); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse limit", "limit", limitStr, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+		if limit == 0 || limit > MaximumListUsageRollupLimit {
+			log.CtxErrorw(reqCtx.Context(), "limit is too large or limit equals 0", "limit", limit)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+
+	rollups, err = g.baseApp.GfSpClient().ListUsageRollup(reqCtx.Context(), &types.GfSpListUsageRollupRequest{
+		StartDay:       startDay,
+		EndDay:         endDay,
+		BucketId:       bucketID,
+		PaymentAddress: paymentAccount,
+		Offset:         uint32(offset),
+		Limit:          uint32(limit),
+	})
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to list usage rollup", "error", err)
+		return
+	}
+
+	grpcResponse := &types.GfSpListUsageRollupResponse{Rollups: rollups}
+	respBytes, err = xml.Marshal(grpcResponse)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to marshal usage rollup", "error", err)
+		return
+	}
+
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.Write(respBytes)
+}
@@ -1,6 +1,7 @@
 package gater
 
 import (
+	"context"
 	"encoding/base64"
 	"encoding/xml"
 	"errors"
@@ -18,6 +19,7 @@ import (
 	"github.com/gorilla/mux"
 	"github.com/stretchr/testify/assert"
 	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
 
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
 	payment_types "github.com/bnb-chain/greenfield/x/payment/types"
@@ -312,9 +314,7 @@ func TestGateModular_ListObjectsByBucketNameHandler(t *testing.T) {
 				g := setup(t)
 				ctrl := gomock.NewController(t)
 				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
-				clientMock.EXPECT().ListObjectsByBucketName(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-				).Return(nil, uint64(0), uint64(0), false, "", "", "", "", nil, "", mockErr).Times(1)
+				clientMock.EXPECT().ListObjectsByBucketNameWithOptions(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
 				g.baseApp.SetGfSpClient(clientMock)
 				return g
 			},
@@ -507,9 +507,8 @@ func TestGateModular_ListObjectsByBucketNameHandler(t *testing.T) {
 				g := setup(t)
 				ctrl := gomock.NewController(t)
 				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
-				clientMock.EXPECT().ListObjectsByBucketName(gomock.Any(), gomock.Any(), gomock.Any(),
-					gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
-				).Return(mockData, uint64(0), uint64(0), false, "", "", "", "", nil, "", nil).Times(1)
+				clientMock.EXPECT().ListObjectsByBucketNameWithOptions(gomock.Any(), gomock.Any()).Return(
+					&types.GfSpListObjectsByBucketNameResponse{Objects: mockData}, nil).Times(1)
 				g.baseApp.SetGfSpClient(clientMock)
 				return g
 			},
@@ -529,6 +528,110 @@ func TestGateModular_ListObjectsByBucketNameHandler(t *testing.T) {
 				return true
 			},
 		},
+		{
+			name: "list objects with filters and sort order",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListObjectsByBucketNameWithOptions(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest, opts ...grpc.DialOption) (
+						*types.GfSpListObjectsByBucketNameResponse, error) {
+						assert.Equal(t, uint64(100), req.MinPayloadSize)
+						assert.Equal(t, uint64(1000), req.MaxPayloadSize)
+						assert.Equal(t, int64(1700000000), req.CreateTimeStart)
+						assert.Equal(t, "image/png", req.ContentType)
+						assert.Equal(t, testAccount, req.Creator)
+						assert.Equal(t, "size", req.SortBy)
+						assert.True(t, req.SortDesc)
+						// the continuation token of the objects sorted by size is not an object name
+						assert.Equal(t, "500:a.png", req.ContinuationToken)
+						return &types.GfSpListObjectsByBucketNameResponse{Objects: mockData[:1]}, nil
+					}).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?min-size=100&max-size=1000&create-time-start=1700000000&content-type=image%%2Fpng"+
+					"&creator=%s&sort-by=size&sort-order=desc&continuation-token=%s", scheme, mockBucketName, testDomain, testAccount,
+					base64.StdEncoding.EncodeToString([]byte("500:a.png")))
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResultFn: func(body string) bool {
+				var res types.GfSpListObjectsByBucketNameResponse
+				if err := xml.Unmarshal([]byte(body), &res); err != nil {
+					return false
+				}
+				return len(res.Objects) == 1
+			},
+		},
+		{
+			name: "list objects summary",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListObjectsByBucketNameWithOptions(gomock.Any(), gomock.Any()).DoAndReturn(
+					func(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest, opts ...grpc.DialOption) (
+						*types.GfSpListObjectsByBucketNameResponse, error) {
+						assert.True(t, req.Summary)
+						return &types.GfSpListObjectsByBucketNameResponse{PrefixSummaries: []*types.ObjectPrefixSummary{
+							{Prefix: "dir/", ObjectCount: 2, TotalSize: 300}}}, nil
+					}).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?summary=true&delimiter=%%2F", scheme, mockBucketName, testDomain)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "<TotalSize>300</TotalSize>",
+		},
+		{
+			name: "wrong sort order",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?sort-by=size&sort-order=up", scheme, mockBucketName, testDomain)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "wrong min size",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?min-size=large", scheme, mockBucketName, testDomain)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "wrong creator",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s.%s/?creator=alice", scheme, mockBucketName, testDomain)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -2314,3 +2417,123 @@ func TestGateModular_listObjectPoliciesHandler(t *testing.T) {
 		})
 	}
 }
+
+func mockListUsageRollupHandlerRoute(t *testing.T, g *GateModular) *mux.Router {
+	t.Helper()
+	router := mux.NewRouter().SkipClean(true)
+	router.Path("/").Name(listUsageRollupRouterName).Methods(http.MethodGet).Queries(ListUsageRollupQuery, "").HandlerFunc(g.listUsageRollupHandler)
+	return router
+}
+
+func TestGateModular_ListUsageRollupHandler(t *testing.T) {
+	cases := []struct {
+		name           string
+		fn             func() *GateModular
+		request        func() *http.Request
+		wantedResult   string
+		wantedResultFn func(body string) bool
+	}{
+		{
+			name: "failed to list usage rollup",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListUsageRollup(gomock.Any(), gomock.Any()).Return(nil, mockErr).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?%s", scheme, testDomain, ListUsageRollupQuery)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "mock error",
+		},
+		{
+			name: "invalid day",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				g.baseApp.SetGfSpClient(gfspclient.NewMockGfSpClientAPI(ctrl))
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?%s&%s=%s", scheme, testDomain, ListUsageRollupQuery, StartDayQuery, "2023-13-01")
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "invalid payment account",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				g.baseApp.SetGfSpClient(gfspclient.NewMockGfSpClientAPI(ctrl))
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?%s&%s=%s", scheme, testDomain, ListUsageRollupQuery, PaymentAccountQuery, "invalid_payment_account")
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "limit exceeds the maximum",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				g.baseApp.SetGfSpClient(gfspclient.NewMockGfSpClientAPI(ctrl))
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?%s&%s=%d", scheme, testDomain, ListUsageRollupQuery, LimitQuery, MaximumListUsageRollupLimit+1)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResult: "invalid request params for query",
+		},
+		{
+			name: "xml response",
+			fn: func() *GateModular {
+				g := setup(t)
+				ctrl := gomock.NewController(t)
+				clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+				clientMock.EXPECT().ListUsageRollup(gomock.Any(), &types.GfSpListUsageRollupRequest{
+					StartDay:       "2023-08-01",
+					EndDay:         "2023-08-31",
+					BucketId:       1,
+					PaymentAddress: testAccount,
+					Offset:         10,
+					Limit:          DefaultListUsageRollupLimit,
+				}).Return([]*types.UsageRollup{{BucketId: 1, Day: "2023-08-01", BucketName: mockBucketName,
+					PaymentAddress: testAccount, ReadCount: 2, ReadSize: 200, StoredSize: 1000}}, nil).Times(1)
+				g.baseApp.SetGfSpClient(clientMock)
+				return g
+			},
+			request: func() *http.Request {
+				path := fmt.Sprintf("%s%s/?%s&%s=%s&%s=%s&%s=%d&%s=%s&%s=%d", scheme, testDomain, ListUsageRollupQuery,
+					StartDayQuery, "2023-08-01", EndDayQuery, "2023-08-31", BucketIDQuery, 1, PaymentAccountQuery, testAccount,
+					GetGroupListOffsetQuery, 10)
+				return httptest.NewRequest(http.MethodGet, path, strings.NewReader(""))
+			},
+			wantedResultFn: func(body string) bool {
+				assert.Equal(t, "<GfSpListUsageRollupResponse><Rollups><BucketId>1</BucketId><Day>2023-08-01</Day><BucketName>"+
+					mockBucketName+"</BucketName><PaymentAddress>"+testAccount+"</PaymentAddress><ReadCount>2</ReadCount>"+
+					"<ReadSize>200</ReadSize><StoredSize>1000</StoredSize></Rollups></GfSpListUsageRollupResponse>", body)
+				return true
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockListUsageRollupHandlerRoute(t, tt.fn())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, tt.request())
+			if tt.wantedResult != "" {
+				assert.Contains(t, w.Body.String(), tt.wantedResult)
+			}
+			if tt.wantedResultFn != nil {
+				assert.True(t, tt.wantedResultFn(w.Body.String()))
+			}
+		})
+	}
+}
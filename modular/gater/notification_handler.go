@@ -0,0 +1,336 @@
+package gater
+
+import (
+	"encoding/json"
+	"encoding/xml"
+	"fmt"
+	"io"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	modelgateway "github.com/bnb-chain/greenfield-storage-provider/model/gateway"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// MaxNotificationConfigurationSize defines the max size of the notification configuration request body.
+	MaxNotificationConfigurationSize = 64 * 1024
+	// NotificationStreamPollInterval defines the interval of polling the new notification events of a stream.
+	NotificationStreamPollInterval = time.Second
+	// NotificationStreamKeepAliveInterval defines the interval of sending the keepalive comment of a stream,
+	// which keeps the idle stream from being closed by the proxies.
+	NotificationStreamKeepAliveInterval = 15 * time.Second
+	// NotificationStreamBatchSize defines the max number of the notification events polled at a time.
+	NotificationStreamBatchSize = 100
+)
+
+// NotificationConfiguration is the request body of creating a notification subscription.
+type NotificationConfiguration struct {
+	XMLName    xml.Name `xml:"NotificationConfiguration"`
+	WebhookURL string   `xml:"WebhookURL"`
+	Secret     string   `xml:"Secret"`
+	EventTypes []string `xml:"EventType"`
+	Prefix     string   `xml:"Prefix"`
+}
+
+// NotificationSubscriptionInfo is the notification subscription in the responses, the secret is never returned.
+type NotificationSubscriptionInfo struct {
+	ID         uint64   `xml:"ID"`
+	WebhookURL string   `xml:"WebhookURL"`
+	EventTypes []string `xml:"EventType"`
+	Prefix     string   `xml:"Prefix"`
+	Creator    string   `xml:"Creator"`
+	CreateTime int64    `xml:"CreateTime"`
+}
+
+// notificationHandlerDefer replies the error or records the success of the notification handlers.
+func notificationHandlerDefer(w http.ResponseWriter, reqCtx *RequestContext, err error, startTime time.Time) {
+	reqCtx.Cancel()
+	if err != nil {
+		reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+		reqCtx.SetHTTPCode(int(gfsperrors.MakeGfSpError(err).GetHttpStatusCode()))
+		modelgateway.MakeErrorResponse(w, gfsperrors.MakeGfSpError(err))
+		metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+		metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+	} else {
+		reqCtx.SetHTTPCode(http.StatusOK)
+		metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+		metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+	}
+	log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+}
+
+// verifyManageBucketNotification verifies the account of the request is the owner of the bucket.
+func (g *GateModular) verifyManageBucketNotification(reqCtx *RequestContext) error {
+	authenticated, err := g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(),
+		coremodule.AuthOpTypeManageBucketNotification, reqCtx.Account(), reqCtx.bucketName, "")
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return err
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		return ErrNoPermission
+	}
+	return nil
+}
+
+// writeNotificationXML replies the xml response of the notification handlers.
+func writeNotificationXML(w http.ResponseWriter, v interface{}) error {
+	xmlBody, err := xml.Marshal(v)
+	if err != nil {
+		log.Errorw("failed to marshal xml", "error", err)
+		return ErrEncodeResponseWithDetail("failed to marshal xml, error: " + err.Error())
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	if _, err = w.Write(xmlBody); err != nil {
+		log.Errorw("failed to write body", "error", err)
+		return ErrEncodeResponseWithDetail("failed to write body, error: " + err.Error())
+	}
+	return nil
+}
+
+// createNotificationSubscriptionHandler creates a notification subscription of the bucket, only the bucket
+// owner is allowed to manage the notification subscriptions.
+func (g *GateModular) createNotificationSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err    error
+		reqCtx *RequestContext
+		body   []byte
+		id     uint64
+	)
+	startTime := time.Now()
+	defer func() {
+		notificationHandlerDefer(w, reqCtx, err, startTime)
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	if err = g.verifyManageBucketNotification(reqCtx); err != nil {
+		return
+	}
+	if body, err = io.ReadAll(io.LimitReader(r.Body, MaxNotificationConfigurationSize+1)); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to read notification configuration", "error", err)
+		err = ErrExceptionStream
+		return
+	}
+	configuration := &NotificationConfiguration{}
+	if len(body) > MaxNotificationConfigurationSize || xml.Unmarshal(body, configuration) != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse notification configuration")
+		err = ErrMalformedNotificationConfiguration
+		return
+	}
+	if id, err = g.baseApp.GfSpClient().CreateNotificationSubscription(reqCtx.Context(), &types.NotificationSubscription{
+		BucketName: reqCtx.bucketName,
+		EventTypes: configuration.EventTypes,
+		Prefix:     configuration.Prefix,
+		WebhookUrl: configuration.WebhookURL,
+		Creator:    reqCtx.Account(),
+	}, configuration.Secret); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to create notification subscription", "error", err)
+		return
+	}
+
+	var xmlInfo = struct {
+		XMLName xml.Name `xml:"CreateNotificationSubscriptionResult"`
+		Version string   `xml:"version,attr"`
+		ID      uint64   `xml:"ID"`
+	}{
+		Version: GnfdResponseXMLVersion,
+		ID:      id,
+	}
+	err = writeNotificationXML(w, &xmlInfo)
+}
+
+// listNotificationSubscriptionsHandler lists the notification subscriptions of the bucket.
+func (g *GateModular) listNotificationSubscriptionsHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err           error
+		reqCtx        *RequestContext
+		subscriptions []*types.NotificationSubscription
+	)
+	startTime := time.Now()
+	defer func() {
+		notificationHandlerDefer(w, reqCtx, err, startTime)
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	if err = g.verifyManageBucketNotification(reqCtx); err != nil {
+		return
+	}
+	if subscriptions, err = g.baseApp.GfSpClient().ListNotificationSubscriptions(reqCtx.Context(),
+		reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to list notification subscriptions", "error", err)
+		return
+	}
+
+	var xmlInfo = struct {
+		XMLName       xml.Name                        `xml:"ListNotificationSubscriptionsResult"`
+		Version       string                          `xml:"version,attr"`
+		Subscriptions []*NotificationSubscriptionInfo `xml:"Subscription"`
+	}{
+		Version: GnfdResponseXMLVersion,
+	}
+	for _, subscription := range subscriptions {
+		xmlInfo.Subscriptions = append(xmlInfo.Subscriptions, &NotificationSubscriptionInfo{
+			ID:         subscription.GetId(),
+			WebhookURL: subscription.GetWebhookUrl(),
+			EventTypes: subscription.GetEventTypes(),
+			Prefix:     subscription.GetPrefix(),
+			Creator:    subscription.GetCreator(),
+			CreateTime: subscription.GetCreateTime(),
+		})
+	}
+	err = writeNotificationXML(w, &xmlInfo)
+}
+
+// deleteNotificationSubscriptionHandler deletes the notification subscription of the bucket.
+func (g *GateModular) deleteNotificationSubscriptionHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err    error
+		reqCtx *RequestContext
+		id     uint64
+	)
+	startTime := time.Now()
+	defer func() {
+		notificationHandlerDefer(w, reqCtx, err, startTime)
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	if err = g.verifyManageBucketNotification(reqCtx); err != nil {
+		return
+	}
+	if id, err = strconv.ParseUint(reqCtx.vars[NotificationSubscriptionIDQuery], 10, 64); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse subscription id", "error", err)
+		err = ErrInvalidQuery
+		return
+	}
+	if err = g.baseApp.GfSpClient().DeleteNotificationSubscription(reqCtx.Context(), reqCtx.bucketName, id); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to delete notification subscription", "error", err)
+		return
+	}
+}
+
+// streamNotificationEventsHandler streams the notification events of the bucket as server-sent events. The
+// stream starts after the Last-Event-ID header or the start-after query if any, otherwise it starts from
+// the latest event. The stream is closed when the client disconnects.
+func (g *GateModular) streamNotificationEventsHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err        error
+		reqCtx     *RequestContext
+		startAfter uint64
+		eventTypes []string
+	)
+	startTime := time.Now()
+	defer func() {
+		notificationHandlerDefer(w, reqCtx, err, startTime)
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	if err = g.verifyManageBucketNotification(reqCtx); err != nil {
+		return
+	}
+	flusher, ok := w.(http.Flusher)
+	if !ok {
+		log.CtxError(reqCtx.Context(), "failed to stream notification events, the response writer does not support flushing")
+		err = ErrExceptionStream
+		return
+	}
+	queryParams := r.URL.Query()
+	if value := queryParams.Get(NotificationEventTypesQuery); value != "" {
+		eventTypes = strings.Split(value, ",")
+	}
+	prefix := queryParams.Get(NotificationPrefixQuery)
+	cursor := r.Header.Get(LastEventIDHeader)
+	if cursor == "" {
+		cursor = queryParams.Get(NotificationStartAfterQuery)
+	}
+	if cursor != "" {
+		if startAfter, err = strconv.ParseUint(cursor, 10, 64); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse notification stream cursor", "cursor", cursor, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	} else if _, startAfter, err = g.baseApp.GfSpClient().ListNotificationEvents(reqCtx.Context(),
+		&types.GfSpListNotificationEventsRequest{BucketName: reqCtx.bucketName, FromLatest: true}); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get latest notification event", "error", err)
+		return
+	}
+
+	w.Header().Set(ContentTypeHeader, ContentTypeEventStreamHeaderValue)
+	w.Header().Set("Cache-Control", "no-cache")
+	w.Header().Set("Connection", "keep-alive")
+	// disables the response buffering of the nginx proxies
+	w.Header().Set("X-Accel-Buffering", "no")
+	w.WriteHeader(http.StatusOK)
+	flusher.Flush()
+
+	// the response has been started, the errors of the stream are only logged
+	g.streamNotificationEvents(reqCtx, w, flusher, &types.GfSpListNotificationEventsRequest{
+		BucketName:   reqCtx.bucketName,
+		Prefix:       prefix,
+		EventTypes:   eventTypes,
+		StartAfterId: startAfter,
+		Limit:        NotificationStreamBatchSize,
+	})
+}
+
+// streamNotificationEvents polls the new notification events and writes them to the stream until the client
+// disconnects or the stream fails to be written.
+func (g *GateModular) streamNotificationEvents(reqCtx *RequestContext, w http.ResponseWriter, flusher http.Flusher,
+	req *types.GfSpListNotificationEventsRequest) {
+	pollTicker := time.NewTicker(NotificationStreamPollInterval)
+	defer pollTicker.Stop()
+	keepAliveTicker := time.NewTicker(NotificationStreamKeepAliveInterval)
+	defer keepAliveTicker.Stop()
+	for {
+		events, lastID, err := g.baseApp.GfSpClient().ListNotificationEvents(reqCtx.Context(), req)
+		if err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to list notification events", "error", err)
+		}
+		for _, event := range events {
+			data, marshalErr := json.Marshal(event)
+			if marshalErr != nil {
+				log.CtxErrorw(reqCtx.Context(), "failed to marshal notification event", "error", marshalErr)
+				return
+			}
+			if _, err = fmt.Fprintf(w, "id: %d\nevent: %s\ndata: %s\n\n", event.GetId(), event.GetEventType(), data); err != nil {
+				log.CtxErrorw(reqCtx.Context(), "failed to write notification event", "error", err)
+				return
+			}
+		}
+		if len(events) > 0 {
+			req.StartAfterId = lastID
+			flusher.Flush()
+			// keep polling without waiting if there are more events
+			if len(events) == NotificationStreamBatchSize {
+				continue
+			}
+		}
+
+		select {
+		case <-reqCtx.request.Context().Done():
+			return
+		case <-keepAliveTicker.C:
+			if _, err = io.WriteString(w, ": keepalive\n\n"); err != nil {
+				log.CtxErrorw(reqCtx.Context(), "failed to write notification keepalive", "error", err)
+				return
+			}
+			flusher.Flush()
+		case <-pollTicker.C:
+		}
+	}
+}
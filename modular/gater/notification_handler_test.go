@@ -0,0 +1,233 @@
+package gater
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"google.golang.org/grpc"
+
+	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+)
+
+func mockNotificationRoute(t *testing.T, g *GateModular) *mux.Router {
+	t.Helper()
+	router := mux.NewRouter().SkipClean(true)
+	var routers []*mux.Router
+	routers = append(routers, router.Host("{bucket:.+}."+g.domain).Subrouter())
+	routers = append(routers, router.PathPrefix("/{bucket}").Subrouter())
+	for _, r := range routers {
+		r.NewRoute().Name(createNotificationSubscriptionRouterName).Methods(http.MethodPut).
+			Queries(NotificationQuery, "").HandlerFunc(g.createNotificationSubscriptionHandler)
+		r.NewRoute().Name(listNotificationSubscriptionsRouterName).Methods(http.MethodGet).
+			Queries(NotificationQuery, "").HandlerFunc(g.listNotificationSubscriptionsHandler)
+		r.NewRoute().Name(deleteNotificationSubscriptionRouterName).Methods(http.MethodDelete).
+			Queries(NotificationQuery, "", NotificationSubscriptionIDQuery, "{subscription-id}").HandlerFunc(g.deleteNotificationSubscriptionHandler)
+		r.NewRoute().Name(streamNotificationEventsRouterName).Methods(http.MethodGet).
+			Queries(NotificationEventsQuery, "").HandlerFunc(g.streamNotificationEventsHandler)
+	}
+	return router
+}
+
+func mockNotificationRequest(method, query, body string) *http.Request {
+	path := fmt.Sprintf("%s%s.%s/?%s", scheme, mockBucketName, testDomain, query)
+	req := httptest.NewRequest(method, path, strings.NewReader(body))
+	validExpiryDateStr := time.Now().Add(time.Hour * 60).Format(ExpiryDateFormat)
+	req.Header.Set(commonhttp.HTTPHeaderExpiryTimestamp, validExpiryDateStr)
+	req.Header.Set(GnfdAuthorizationHeader, "GNFD1-EDDSA,Signature=48656c6c6f20476f7068657221")
+	return req
+}
+
+func mockNotificationClient(t *testing.T, g *GateModular, authenticated bool) *gfspclient.MockGfSpClientAPI {
+	ctrl := gomock.NewController(t)
+	clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+	clientMock.EXPECT().VerifyGNFD1EddsaSignature(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any()).Return(false, nil).Times(1)
+	clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
+		gomock.Any()).Return(authenticated, nil).Times(1)
+	g.baseApp.SetGfSpClient(clientMock)
+	return clientMock
+}
+
+func TestGateModular_createNotificationSubscriptionHandler(t *testing.T) {
+	cases := []struct {
+		name         string
+		fn           func() *GateModular
+		request      func() *http.Request
+		wantedResult string
+	}{
+		{
+			name: "no permission to operate",
+			fn: func() *GateModular {
+				g := setup(t)
+				mockNotificationClient(t, g, false)
+				return g
+			},
+			request: func() *http.Request {
+				return mockNotificationRequest(http.MethodPut, NotificationQuery, "")
+			},
+			wantedResult: "no permission",
+		},
+		{
+			name: "malformed notification configuration",
+			fn: func() *GateModular {
+				g := setup(t)
+				mockNotificationClient(t, g, true)
+				return g
+			},
+			request: func() *http.Request {
+				return mockNotificationRequest(http.MethodPut, NotificationQuery, "<NotificationConfiguration>")
+			},
+			wantedResult: "malformed",
+		},
+		{
+			name: "failed to create notification subscription",
+			fn: func() *GateModular {
+				g := setup(t)
+				clientMock := mockNotificationClient(t, g, true)
+				clientMock.EXPECT().CreateNotificationSubscription(gomock.Any(), gomock.Any(), gomock.Any()).
+					Return(uint64(0), mockErr).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockNotificationRequest(http.MethodPut, NotificationQuery,
+					"<NotificationConfiguration><WebhookURL>https://example.com/hook</WebhookURL></NotificationConfiguration>")
+			},
+			wantedResult: "mock error",
+		},
+		{
+			name: "success",
+			fn: func() *GateModular {
+				g := setup(t)
+				clientMock := mockNotificationClient(t, g, true)
+				clientMock.EXPECT().CreateNotificationSubscription(gomock.Any(), gomock.Any(), "secret").DoAndReturn(
+					func(ctx context.Context, subscription *metadatatypes.NotificationSubscription, secret string, opts ...grpc.DialOption) (uint64, error) {
+						assert.Equal(t, mockBucketName, subscription.GetBucketName())
+						assert.Equal(t, "https://example.com/hook", subscription.GetWebhookUrl())
+						assert.Equal(t, []string{"ObjectSealed", "ObjectDeleted"}, subscription.GetEventTypes())
+						assert.Equal(t, "photos/", subscription.GetPrefix())
+						return 1, nil
+					}).Times(1)
+				return g
+			},
+			request: func() *http.Request {
+				return mockNotificationRequest(http.MethodPut, NotificationQuery,
+					"<NotificationConfiguration><WebhookURL>https://example.com/hook</WebhookURL><Secret>secret</Secret>"+
+						"<EventType>ObjectSealed</EventType><EventType>ObjectDeleted</EventType><Prefix>photos/</Prefix>"+
+						"</NotificationConfiguration>")
+			},
+			wantedResult: "<ID>1</ID>",
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			router := mockNotificationRoute(t, tt.fn())
+			w := httptest.NewRecorder()
+			router.ServeHTTP(w, tt.request())
+			assert.Contains(t, w.Body.String(), tt.wantedResult)
+		})
+	}
+}
+
+func TestGateModular_listNotificationSubscriptionsHandler(t *testing.T) {
+	g := setup(t)
+	clientMock := mockNotificationClient(t, g, true)
+	clientMock.EXPECT().ListNotificationSubscriptions(gomock.Any(), mockBucketName).Return(
+		[]*metadatatypes.NotificationSubscription{{Id: 1, BucketName: mockBucketName, WebhookUrl: "https://example.com/hook",
+			EventTypes: []string{"ObjectSealed"}}}, nil).Times(1)
+	router := mockNotificationRoute(t, g)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockNotificationRequest(http.MethodGet, NotificationQuery, ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Contains(t, w.Body.String(), "<Subscription><ID>1</ID><WebhookURL>https://example.com/hook</WebhookURL>"+
+		"<EventType>ObjectSealed</EventType>")
+	assert.NotContains(t, w.Body.String(), "Secret")
+}
+
+func TestGateModular_deleteNotificationSubscriptionHandler(t *testing.T) {
+	g := setup(t)
+	mockNotificationClient(t, g, true)
+	router := mockNotificationRoute(t, g)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockNotificationRequest(http.MethodDelete,
+		NotificationQuery+"&"+NotificationSubscriptionIDQuery+"=abc", ""))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+
+	g = setup(t)
+	clientMock := mockNotificationClient(t, g, true)
+	clientMock.EXPECT().DeleteNotificationSubscription(gomock.Any(), mockBucketName, uint64(1)).Return(nil).Times(1)
+	router = mockNotificationRoute(t, g)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, mockNotificationRequest(http.MethodDelete,
+		NotificationQuery+"&"+NotificationSubscriptionIDQuery+"=1", ""))
+	assert.Equal(t, http.StatusOK, w.Code)
+}
+
+func TestGateModular_streamNotificationEventsHandler(t *testing.T) {
+	g := setup(t)
+	clientMock := mockNotificationClient(t, g, true)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	clientMock.EXPECT().ListNotificationEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, req *metadatatypes.GfSpListNotificationEventsRequest, opts ...grpc.DialOption) (
+			[]*metadatatypes.NotificationEvent, uint64, error) {
+			assert.Equal(t, uint64(10), req.GetStartAfterId())
+			assert.Equal(t, "photos/", req.GetPrefix())
+			assert.Equal(t, []string{"ObjectSealed", "ObjectDeleted"}, req.GetEventTypes())
+			// the client disconnects after receiving the events
+			cancel()
+			return []*metadatatypes.NotificationEvent{{Id: 11, EventType: "ObjectSealed", BucketName: mockBucketName,
+				ObjectName: "photos/a"}}, 11, nil
+		}).Times(1)
+	router := mockNotificationRoute(t, g)
+	w := httptest.NewRecorder()
+	req := mockNotificationRequest(http.MethodGet, NotificationEventsQuery+"&"+NotificationEventTypesQuery+
+		"=ObjectSealed,ObjectDeleted&"+NotificationPrefixQuery+"=photos/", "")
+	req.Header.Set(LastEventIDHeader, "10")
+	router.ServeHTTP(w, req.WithContext(ctx))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Equal(t, ContentTypeEventStreamHeaderValue, w.Header().Get(ContentTypeHeader))
+	assert.Contains(t, w.Body.String(), "id: 11\nevent: ObjectSealed\ndata: {")
+	assert.Contains(t, w.Body.String(), `"object_name":"photos/a"`)
+}
+
+func TestGateModular_streamNotificationEventsHandlerFromLatest(t *testing.T) {
+	g := setup(t)
+	clientMock := mockNotificationClient(t, g, true)
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	gomock.InOrder(
+		clientMock.EXPECT().ListNotificationEvents(gomock.Any(), &metadatatypes.GfSpListNotificationEventsRequest{
+			BucketName: mockBucketName, FromLatest: true}).Return(nil, uint64(20), nil).Times(1),
+		clientMock.EXPECT().ListNotificationEvents(gomock.Any(), gomock.Any()).DoAndReturn(
+			func(ctx context.Context, req *metadatatypes.GfSpListNotificationEventsRequest, opts ...grpc.DialOption) (
+				[]*metadatatypes.NotificationEvent, uint64, error) {
+				assert.Equal(t, uint64(20), req.GetStartAfterId())
+				cancel()
+				return nil, 20, nil
+			}).Times(1),
+	)
+	router := mockNotificationRoute(t, g)
+	w := httptest.NewRecorder()
+	router.ServeHTTP(w, mockNotificationRequest(http.MethodGet, NotificationEventsQuery, "").WithContext(ctx))
+	assert.Equal(t, http.StatusOK, w.Code)
+	assert.Empty(t, w.Body.String())
+
+	// failed due to invalid cursor
+	g = setup(t)
+	mockNotificationClient(t, g, true)
+	router = mockNotificationRoute(t, g)
+	w = httptest.NewRecorder()
+	router.ServeHTTP(w, mockNotificationRequest(http.MethodGet, NotificationEventsQuery+"&"+
+		NotificationStartAfterQuery+"=abc", ""))
+	assert.Equal(t, http.StatusBadRequest, w.Code)
+}
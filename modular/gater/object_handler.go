@@ -5,6 +5,8 @@ import (
 	"encoding/json"
 	"encoding/xml"
 	"fmt"
+	"io"
+	"mime/multipart"
 	"net/http"
 	"net/url"
 	"strconv"
@@ -24,7 +26,6 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
-	"github.com/bnb-chain/greenfield-storage-provider/store/sqldb"
 	servicetypes "github.com/bnb-chain/greenfield-storage-provider/store/types"
 	"github.com/bnb-chain/greenfield-storage-provider/util"
 	"github.com/bnb-chain/greenfield/types/s3util"
@@ -40,9 +41,7 @@ func (g *GateModular) putObjectHandler(w http.ResponseWriter, r *http.Request) {
 		err           error
 		reqCtx        *RequestContext
 		authenticated bool
-		bucketInfo    *storagetypes.BucketInfo
 		objectInfo    *storagetypes.ObjectInfo
-		params        *storagetypes.Params
 	)
 
 	uploadPrimaryStartTime := time.Now()
@@ -91,6 +90,20 @@ func (g *GateModular) putObjectHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 
+	objectInfo, err = g.uploadObject(reqCtx, r.Body, uploadPrimaryStartTime)
+}
+
+// uploadObject uploads the payload of the created object to the uploader, it is called by both
+// putObjectHandler and s3PutObjectHandler after passing the authentication and authorization.
+func (g *GateModular) uploadObject(reqCtx *RequestContext, body io.Reader, uploadPrimaryStartTime time.Time) (
+	objectInfo *storagetypes.ObjectInfo, err error) {
+	var (
+		bucketInfo *storagetypes.BucketInfo
+		params     *storagetypes.Params
+	)
+	if err = g.checkTenantRequest(reqCtx); err != nil {
+		return
+	}
 	startGetObjectInfoTime := time.Now()
 	bucketInfo, objectInfo, err = g.baseApp.Consensus().QueryBucketInfoAndObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_put_object_query_object_cost").Observe(time.Since(startGetObjectInfoTime).Seconds())
@@ -127,7 +140,8 @@ func (g *GateModular) putObjectHandler(w http.ResponseWriter, r *http.Request) {
 	task.AppendLog("gateway-create-upload-task")
 	ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, task.Key().String())
 	uploadDataTime := time.Now()
-	err = g.baseApp.GfSpClient().UploadObject(ctx, task, r.Body)
+	limitedBody := g.newTenantLimitReader(reqCtx, body)
+	err = limitedBody.failure(g.baseApp.GfSpClient().UploadObject(ctx, task, limitedBody))
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_put_object_data_cost").Observe(time.Since(uploadDataTime).Seconds())
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_put_object_data_end").Observe(time.Since(time.Unix(task.GetCreateTime(), 0)).Seconds())
 	if err != nil {
@@ -135,6 +149,7 @@ func (g *GateModular) putObjectHandler(w http.ResponseWriter, r *http.Request) {
 		return
 	}
 	log.CtxDebug(ctx, "succeed to upload payload data")
+	return objectInfo, nil
 }
 
 func (g *GateModular) checkAndAssignShadowObjectInfo(reqCtx *RequestContext, objectInfo *storagetypes.ObjectInfo) error {
@@ -235,6 +250,9 @@ func (g *GateModular) resumablePutObjectHandler(w http.ResponseWriter, r *http.R
 		err = ErrNoPermission
 		return
 	}
+	if err = g.checkTenantRequest(reqCtx); err != nil {
+		return
+	}
 
 	startGetObjectInfoTime := time.Now()
 	bucketInfo, objectInfo, err = g.baseApp.Consensus().QueryBucketInfoAndObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
@@ -308,7 +326,8 @@ func (g *GateModular) resumablePutObjectHandler(w http.ResponseWriter, r *http.R
 	task.AppendLog("gateway-create-resumable-upload-task")
 	ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, task.Key().String())
 	uploadDataTime := time.Now()
-	err = g.baseApp.GfSpClient().ResumableUploadObject(ctx, task, r.Body)
+	body := g.newTenantLimitReader(reqCtx, r.Body)
+	err = body.failure(g.baseApp.GfSpClient().ResumableUploadObject(ctx, task, body))
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_resumable_put_object_data_cost").Observe(time.Since(uploadDataTime).Seconds())
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_resumable_put_object_data_end").Observe(time.Since(time.Unix(task.GetCreateTime(), 0)).Seconds())
 	if err != nil {
@@ -545,39 +564,28 @@ func (g *GateModular) getObjectHandler(w http.ResponseWriter, r *http.Request) {
 // It is called by both getObjectHandler and getObjectByUniversalEndpointHandler after passing the authentication and authorization.
 func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestContext) error {
 	var (
-		err                       error
-		params                    *storagetypes.Params
-		bucketInfo                *storagetypes.BucketInfo
-		objectInfo                *storagetypes.ObjectInfo
-		isRange                   bool
-		rangeStart, rangeEnd      int64
-		lowOffset                 int64
-		highOffset                int64
-		pieceInfos                []*downloader.SegmentPieceInfo
-		pieceData                 []byte
-		extraQuota, consumedQuota uint64
-		replyDataSize             int
-		dbUpdateTimeStamp         int64
+		err              error
+		params           *storagetypes.Params
+		bucketInfo       *storagetypes.BucketInfo
+		objectInfo       *storagetypes.ObjectInfo
+		ranges           []httpRange
+		unsatisfiedRange string
 	)
 	defer func() {
 		if err != nil {
-			// if the bucket exists extra quota when download object, recoup the quota to user
-			if extraQuota > 0 {
-				quotaUpdateErr := g.baseApp.GfSpClient().RecoupQuota(reqCtx.Context(), bucketInfo.Id.Uint64(), extraQuota, sqldb.TimestampYearMonth(dbUpdateTimeStamp))
-				// no need to return the db error to user
-				if quotaUpdateErr != nil {
-					log.CtxErrorw(reqCtx.Context(), "failed to recoup extra quota to user", "error", err)
-				}
-				log.CtxDebugw(reqCtx.Context(), "//"+
-					"success to recoup extra quota to user", "extra quota:", extraQuota)
-			}
 			w.Header().Del(ContentLengthHeader)
 			w.Header().Del(ContentRangeHeader)
 			w.Header().Del(ContentTypeHeader)
 			w.Header().Del(ContentDispositionHeader)
+			if unsatisfiedRange != "" {
+				w.Header().Set(ContentRangeHeader, unsatisfiedRange)
+			}
 		}
 	}()
 
+	if err = g.checkTenantRequest(reqCtx); err != nil {
+		return err
+	}
 	getObjectTime := time.Now()
 	objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName)
 	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_get_object_info_time").Observe(time.Since(getObjectTime).Seconds())
@@ -591,6 +599,26 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 		return err
 	}
 
+	// the validators are derived from the on-chain object info, so the conditional requests are
+	// answered before any quota is consumed
+	etag := objectETag(objectInfo)
+	modTime := objectModTime(objectInfo)
+	if etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+	if !modTime.IsZero() {
+		w.Header().Set(LastModifiedHeader, modTime.Format(http.TimeFormat))
+	}
+	switch checkPreconditions(reqCtx.request.Header, etag, modTime) {
+	case http.StatusNotModified:
+		w.Header().Del(ContentDispositionHeader)
+		w.WriteHeader(http.StatusNotModified)
+		return nil
+	case http.StatusPreconditionFailed:
+		err = ErrPreconditionFailed
+		return err
+	}
+
 	getBucketTime := time.Now()
 	bucketInfo, err = g.baseApp.Consensus().QueryBucketInfo(reqCtx.Context(), objectInfo.GetBucketName())
 	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_get_bucket_info_time").Observe(time.Since(getBucketTime).Seconds())
@@ -609,82 +637,115 @@ func (g *GateModular) downloadObject(w http.ResponseWriter, reqCtx *RequestConte
 		return err
 	}
 
-	isRange, rangeStart, rangeEnd = parseRange(reqCtx.request.Header.Get(RangeHeader))
-	if isRange && (rangeEnd < 0 || rangeEnd >= int64(objectInfo.GetPayloadSize())) {
-		rangeEnd = int64(objectInfo.GetPayloadSize()) - 1
-	}
-	if isRange && (rangeStart < 0 || rangeEnd < 0 || rangeStart > rangeEnd) {
-		err = ErrInvalidRange
-		return err
+	payloadSize := int64(objectInfo.GetPayloadSize())
+	if checkIfRange(reqCtx.request.Header, etag, modTime) {
+		if ranges, err = parseRanges(reqCtx.request.Header.Get(RangeHeader), payloadSize); err != nil {
+			if err == ErrRangeNotSatisfiable {
+				unsatisfiedRange = unsatisfiedContentRange(payloadSize)
+			}
+			return err
+		}
 	}
 
-	if isRange {
-		lowOffset = rangeStart
-		highOffset = rangeEnd
-	} else {
-		lowOffset = 0
-		highOffset = int64(objectInfo.GetPayloadSize()) - 1
+	w.Header().Set(AcceptRangesHeader, "bytes")
+	getDataTime := time.Now()
+	switch len(ranges) {
+	case 0:
+		w.Header().Set(ContentTypeHeader, objectInfo.GetContentType())
+		w.Header().Set(ContentLengthHeader, util.Uint64ToString(objectInfo.GetPayloadSize()))
+		body := &deferredStatusWriter{ResponseWriter: w, status: http.StatusOK}
+		err = g.downloadObjectRange(reqCtx, objectInfo, bucketInfo, params,
+			httpRange{start: 0, end: payloadSize - 1}, func() (io.Writer, error) { return body, nil })
+		if err != nil {
+			return err
+		}
+	case 1:
+		w.Header().Set(ContentTypeHeader, objectInfo.GetContentType())
+		w.Header().Set(ContentRangeHeader, ranges[0].contentRange(payloadSize))
+		w.Header().Set(ContentLengthHeader, strconv.FormatInt(ranges[0].length(), 10))
+		body := &deferredStatusWriter{ResponseWriter: w, status: http.StatusPartialContent}
+		err = g.downloadObjectRange(reqCtx, objectInfo, bucketInfo, params,
+			ranges[0], func() (io.Writer, error) { return body, nil })
+		if err != nil {
+			return err
+		}
+	default:
+		body := &deferredStatusWriter{ResponseWriter: w, status: http.StatusPartialContent}
+		mw := multipart.NewWriter(body)
+		w.Header().Set(ContentTypeHeader, MultipartByteRangesValue+"; boundary="+mw.Boundary())
+		w.Header().Set(ContentLengthHeader, strconv.FormatInt(
+			multipartRangesSize(ranges, mw.Boundary(), objectInfo.GetContentType(), payloadSize), 10))
+		for _, r := range ranges {
+			part := r
+			err = g.downloadObjectRange(reqCtx, objectInfo, bucketInfo, params,
+				part, func() (io.Writer, error) {
+					return mw.CreatePart(part.mimeHeader(objectInfo.GetContentType(), payloadSize))
+				})
+			if err != nil {
+				return err
+			}
+		}
+		if err = mw.Close(); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to write the data to connection", "objectName", objectInfo.ObjectName, "error", err)
+			err = ErrReplyData
+			return err
+		}
 	}
+	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_get_data_time").Observe(time.Since(getDataTime).Seconds())
+	return nil
+}
+
+// downloadObjectRange streams the range of the object from the downloader, and writes the data to the
+// writer returned by newWriter, which is called after the first segment is received. The quota of the
+// range is reserved by the downloader before streaming, and the quota of the data that is not sent is
+// refunded by the downloader if the download fails after that.
+func (g *GateModular) downloadObjectRange(reqCtx *RequestContext, objectInfo *storagetypes.ObjectInfo,
+	bucketInfo *storagetypes.BucketInfo, params *storagetypes.Params, r httpRange, newWriter func() (io.Writer, error)) error {
+	var (
+		err            error
+		reader         io.ReadCloser
+		writer         io.Writer
+		downloadSize   = uint64(r.length())
+		downloadFailed = func(writeErr error) error {
+			log.CtxErrorw(reqCtx.Context(), "failed to write the data to connection", "objectName", objectInfo.ObjectName, "error", writeErr)
+			return ErrReplyData
+		}
+	)
 
 	task := &gfsptask.GfSpDownloadObjectTask{}
 	task.InitDownloadObjectTask(objectInfo, bucketInfo, params, g.baseApp.TaskPriority(task), reqCtx.Account(),
-		lowOffset, highOffset, g.baseApp.TaskTimeout(task, uint64(highOffset-lowOffset+1)), g.baseApp.TaskMaxRetry(task))
-	if pieceInfos, err = downloader.SplitToSegmentPieceInfos(task, g.baseApp.PieceOp()); err != nil {
+		r.start, r.end, g.baseApp.TaskTimeout(task, downloadSize), g.baseApp.TaskMaxRetry(task))
+	if _, err = downloader.SplitToSegmentPieceInfos(task, g.baseApp.PieceOp()); err != nil {
 		log.CtxErrorw(reqCtx.Context(), "failed to download object", "error", err)
 		return err
 	}
-	w.Header().Set(ContentTypeHeader, objectInfo.GetContentType())
-	if isRange {
-		w.Header().Set(ContentRangeHeader, "bytes "+util.Uint64ToString(uint64(lowOffset))+
-			"-"+util.Uint64ToString(uint64(highOffset)))
-	} else {
-		w.Header().Set(ContentLengthHeader, util.Uint64ToString(objectInfo.GetPayloadSize()))
-	}
 
-	getDataTime := time.Now()
-	consumedQuota = 0
-	extraQuota = 0
-	downloadSize := uint64(highOffset - lowOffset + 1)
-	for idx, pInfo := range pieceInfos {
-		enableCheck := false
-		if idx == 0 { // only check in first piece
-			enableCheck = true
-			dbUpdateTimeStamp = sqldb.GetCurrentTimestampUs()
-		}
-		pieceTask := &gfsptask.GfSpDownloadPieceTask{}
-		pieceTask.InitDownloadPieceTask(objectInfo, bucketInfo, params, g.baseApp.TaskPriority(task),
-			enableCheck, reqCtx.Account(), downloadSize, pInfo.SegmentPieceKey, pInfo.Offset,
-			pInfo.Length, g.baseApp.TaskTimeout(task, uint64(pieceTask.GetSize())), g.baseApp.TaskMaxRetry(task))
-		getSegmentTime := time.Now()
-		pieceData, err = g.baseApp.GfSpClient().GetPiece(reqCtx.Context(), pieceTask)
-
-		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_segment_data_time").Observe(time.Since(getSegmentTime).Seconds())
-		if err != nil {
-			log.CtxErrorw(reqCtx.Context(), "failed to download piece", "error", err)
-			downloaderErr := gfsperrors.MakeGfSpError(err)
-			// if it is the first piece and the quota db is not updated, no extra data need to updated
-			if idx >= 1 || (idx == 0 && (downloaderErr.GetInnerCode() == 85101 || downloaderErr.GetInnerCode() == 85102)) {
-				extraQuota = downloadSize - consumedQuota
-			}
-			return err
-		}
+	getSegmentTime := time.Now()
+	reader, err = g.baseApp.GfSpClient().GetObject(reqCtx.Context(), task)
+	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_segment_data_time").Observe(time.Since(getSegmentTime).Seconds())
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to download object", "error", err)
+		return err
+	}
+	defer reader.Close()
 
-		writeTime := time.Now()
-		replyDataSize, err = w.Write(pieceData)
+	if writer, err = newWriter(); err != nil {
+		return downloadFailed(err)
+	}
+	writeTime := time.Now()
+	rw := &replyWriter{w: writer}
+	_, err = io.Copy(rw, g.newTenantLimitReader(reqCtx, reader))
+	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_write_time").Observe(time.Since(writeTime).Seconds())
+	if err != nil {
 		// if the connection of client has been disconnected, the response will fail
-		if err != nil {
-			log.CtxErrorw(reqCtx.Context(), "failed to write the data to connection", "objectName", objectInfo.ObjectName, "error", err)
-			extraQuota = downloadSize - consumedQuota
-			err = ErrReplyData
-			return err
+		if rw.err != nil {
+			return downloadFailed(rw.err)
 		}
-		// the quota value should be computed by the reply content length
-		consumedQuota += uint64(replyDataSize)
-		metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_write_time").Observe(time.Since(writeTime).Seconds())
+		log.CtxErrorw(reqCtx.Context(), "failed to download object", "error", err)
+		return err
 	}
 
-	metrics.ReqPieceSize.WithLabelValues(GatewayGetObjectSize).Observe(float64(highOffset - lowOffset + 1))
-	metrics.PerfGetObjectTimeHistogram.WithLabelValues("get_object_get_data_time").Observe(time.Since(getDataTime).Seconds())
+	metrics.ReqPieceSize.WithLabelValues(GatewayGetObjectSize).Observe(float64(downloadSize))
 	return nil
 }
 
@@ -1089,6 +1150,9 @@ func (g *GateModular) delegatePutObjectHandler(w http.ResponseWriter, r *http.Re
 		err = ErrNoPermission
 		return
 	}
+	if err = g.checkTenantRequest(reqCtx); err != nil {
+		return
+	}
 	contentType = r.Header.Get(ContentTypeHeader)
 	if contentType == "" {
 		contentType = ContentDefault
@@ -1243,7 +1307,8 @@ func (g *GateModular) delegatePutObjectHandler(w http.ResponseWriter, r *http.Re
 	uploadTask.AppendLog("gateway-create-upload-task")
 	ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, uploadTask.Key().String())
 	uploadDataTime := time.Now()
-	err = g.baseApp.GfSpClient().UploadObject(ctx, uploadTask, r.Body)
+	body := g.newTenantLimitReader(reqCtx, r.Body)
+	err = body.failure(g.baseApp.GfSpClient().UploadObject(ctx, uploadTask, body))
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_agent_put_object_data_cost").Observe(time.Since(uploadDataTime).Seconds())
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_agent_put_object_data_end").Observe(time.Since(time.Unix(uploadTask.GetCreateTime(), 0)).Seconds())
 	if err != nil {
@@ -1354,6 +1419,9 @@ func (g *GateModular) delegateResumablePutObjectHandler(w http.ResponseWriter, r
 		err = ErrNoPermission
 		return
 	}
+	if err = g.checkTenantRequest(reqCtx); err != nil {
+		return
+	}
 
 	approvalMsg, err = hex.DecodeString(r.Header.Get(GnfdUnsignedApprovalMsgHeader))
 	if err != nil {
@@ -1514,7 +1582,8 @@ func (g *GateModular) delegateResumablePutObjectHandler(w http.ResponseWriter, r
 	uploadTask.AppendLog("gateway-create-resumable-upload-task")
 	ctx := log.WithValue(reqCtx.Context(), log.CtxKeyTask, uploadTask.Key().String())
 	uploadDataTime := time.Now()
-	err = g.baseApp.GfSpClient().ResumableUploadObject(ctx, uploadTask, r.Body)
+	body := g.newTenantLimitReader(reqCtx, r.Body)
+	err = body.failure(g.baseApp.GfSpClient().ResumableUploadObject(ctx, uploadTask, body))
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_resumable_put_object_data_cost").Observe(time.Since(uploadDataTime).Seconds())
 	metrics.PerfPutObjectTime.WithLabelValues("gateway_resumable_put_object_data_end").Observe(time.Since(time.Unix(uploadTask.GetCreateTime(), 0)).Seconds())
 	if err != nil {
@@ -3,6 +3,7 @@ package gater
 import (
 	"errors"
 	"fmt"
+	"io"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -1424,7 +1425,7 @@ func TestGateModular_getObjectHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(1)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return(nil, mockErr).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(nil, mockErr).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -1467,7 +1468,7 @@ func TestGateModular_getObjectHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(1)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2037,7 +2038,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2089,7 +2090,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2141,7 +2142,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2193,7 +2194,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // public file, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2234,7 +2235,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2281,7 +2282,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2328,7 +2329,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2377,7 +2378,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2438,7 +2439,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2492,7 +2493,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				var a = permissiontypes.EFFECT_ALLOW
 				clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
 					Return(&a, nil).Times(0) // can't reach here, so no need to verify permission
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2626,7 +2627,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 					nil).Times(1)
 				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 					gomock.Any()).Return(true, nil)
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return([]byte("a"), nil).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(io.NopCloser(strings.NewReader("a")), nil).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
@@ -2697,7 +2698,7 @@ func TestGateModular_getObjectByUniversalEndpointHandler(t *testing.T) {
 				clientMock.EXPECT().VerifyAuthentication(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(),
 					gomock.Any()).Return(true, nil)
 
-				clientMock.EXPECT().GetPiece(gomock.Any(), gomock.Any()).Return(nil, downloader.ErrExceedBucketQuota).AnyTimes()
+				clientMock.EXPECT().GetObject(gomock.Any(), gomock.Any()).Return(nil, downloader.ErrExceedBucketQuota).AnyTimes()
 				g.baseApp.SetGfSpClient(clientMock)
 
 				consensusMock := consensus.NewMockConsensus(ctrl)
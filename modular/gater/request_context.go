@@ -12,11 +12,13 @@ import (
 	"github.com/ethereum/go-ethereum/crypto"
 	"github.com/ethereum/go-ethereum/crypto/secp256k1"
 	"github.com/gorilla/mux"
+	"go.opentelemetry.io/otel/trace"
 	"golang.org/x/exp/slices"
 
 	commonhash "github.com/bnb-chain/greenfield-common/go/hash"
 	commonhttp "github.com/bnb-chain/greenfield-common/go/http"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 )
 
 // RequestContext generates from http request, it records the common info
@@ -51,6 +53,11 @@ var skipAuthRouterNames = []string{
 	getBucketMetaRouterName,
 	listBucketsByIDsRouterName,
 	listObjectsByIDsRouterName,
+	// the S3 routers verify the signature v4 by themselves
+	s3GetObjectRouterName,
+	s3HeadObjectRouterName,
+	s3PutObjectRouterName,
+	s3ListObjectsV2RouterName,
 }
 
 // NewRequestContext returns an instance of RequestContext, and verify the
@@ -62,7 +69,10 @@ func NewRequestContext(r *http.Request, g *GateModular) (*RequestContext, error)
 	if mux.CurrentRoute(r) != nil {
 		routerName = mux.CurrentRoute(r).GetName()
 	}
-	ctx, cancel := context.WithCancel(context.Background())
+	// the runtime context is not canceled with the request, but continues the trace of the request
+	ctx, cancel := context.WithCancel(tracing.DetachedContext(r.Context()))
+	trace.SpanFromContext(r.Context()).SetAttributes(tracing.Bucket.String(vars["bucket"]),
+		tracing.Object.String(vars["object"]))
 	reqCtx := &RequestContext{
 		g:          g,
 		ctx:        ctx,
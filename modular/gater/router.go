@@ -30,6 +30,11 @@ const (
 	listBucketReadRecordRouterName                 = "ListBucketReadRecord"
 	listBucketReadQuotaRouterName                  = "ListBucketReadQuota"
 	getBucketReadQuotaCountRouterName              = "GetBucketReadQuotaCount"
+	listUsageRollupRouterName                      = "ListUsageRollup"
+	createNotificationSubscriptionRouterName       = "CreateNotificationSubscription"
+	listNotificationSubscriptionsRouterName        = "ListNotificationSubscriptions"
+	deleteNotificationSubscriptionRouterName       = "DeleteNotificationSubscription"
+	streamNotificationEventsRouterName             = "StreamNotificationEvents"
 	requestNonceRouterName                         = "RequestNonce"
 	updateUserPublicKeyRouterName                  = "UpdateUserPublicKey"
 	updateUserPublicKeyV2RouterName                = "UpdateUserPublicKeyV2"
@@ -88,6 +93,12 @@ const (
 	getBucketSizeRouterName                        = "GetBucketSize"
 	getRecommendedVGFRouterName                    = "GetRecommendedVGF"
 	getBsDBDataInfo                                = "GetBsDBDataInfo"
+	getS3CredentialRouterName                      = "GetS3Credential"
+	s3GetObjectRouterName                          = "S3GetObject"
+	s3HeadObjectRouterName                         = "S3HeadObject"
+	s3PutObjectRouterName                          = "S3PutObject"
+	s3ListObjectsV2RouterName                      = "S3ListObjectsV2"
+	s3NotImplementedRouterName                     = "S3NotImplemented"
 )
 
 const (
@@ -96,6 +107,27 @@ const (
 	migrateBucketApprovalAction = "MigrateBucket"
 )
 
+// registerS3Handler registers the S3-compatible handlers, the bucket is addressed by either the
+// virtual hosted style or the path style of the S3 domain.
+func (g *GateModular) registerS3Handler(router *mux.Router) {
+	router.Path(S3CredentialPath).Name(getS3CredentialRouterName).Methods(http.MethodGet).HandlerFunc(g.s3CredentialHandler)
+	if g.s3Domain == "" {
+		return
+	}
+	var routers []*mux.Router
+	routers = append(routers, router.Host("{bucket:.+}."+g.s3Domain).Subrouter())
+	routers = append(routers, router.Host(g.s3Domain).PathPrefix("/{bucket}").Subrouter())
+	for _, r := range routers {
+		r.NewRoute().Name(s3GetObjectRouterName).Methods(http.MethodGet).Path("/{object:.+}").HandlerFunc(g.s3GetObjectHandler)
+		r.NewRoute().Name(s3HeadObjectRouterName).Methods(http.MethodHead).Path("/{object:.+}").HandlerFunc(g.s3HeadObjectHandler)
+		r.NewRoute().Name(s3PutObjectRouterName).Methods(http.MethodPut).Path("/{object:.+}").HandlerFunc(g.s3PutObjectHandler)
+		r.NewRoute().Name(s3ListObjectsV2RouterName).Methods(http.MethodGet).Queries(S3ListTypeQuery, "{list-type}").
+			HandlerFunc(g.s3ListObjectsV2Handler)
+		r.NewRoute().Name(s3NotImplementedRouterName).HandlerFunc(g.s3NotImplementedHandler)
+	}
+	router.Host(g.s3Domain).Name(s3NotImplementedRouterName).HandlerFunc(g.s3NotImplementedHandler)
+}
+
 // notFoundHandler log not found request info.
 func (g *GateModular) notFoundHandler(w http.ResponseWriter, r *http.Request) {
 	log.Errorw("failed to find the corresponding handler", "method", r.Method, "host", r.Host, "url", r.URL)
@@ -106,6 +138,10 @@ func (g *GateModular) notFoundHandler(w http.ResponseWriter, r *http.Request) {
 
 // RegisterHandler registers the handlers to the gateway router.
 func (g *GateModular) RegisterHandler(router *mux.Router) {
+	// S3-compatible router, it is registered first so that the S3 domain is not captured by the
+	// greenfield bucket routers
+	g.registerS3Handler(router)
+
 	// off-chain-auth router
 	router.Path(AuthRequestNoncePath).Name(requestNonceRouterName).Methods(http.MethodGet).HandlerFunc(g.requestNonceHandler)
 	router.Path(AuthUpdateKeyPath).Name(updateUserPublicKeyRouterName).Methods(http.MethodPost).HandlerFunc(g.updateUserPublicKeyHandler)
@@ -196,6 +232,16 @@ func (g *GateModular) RegisterHandler(router *mux.Router) {
 		r.NewRoute().Name(queryMigrationProgressRouterName).Methods(http.MethodGet).HandlerFunc(g.queryBucketMigrationProgressHandler).
 			Queries(GetBucketMigrationProgressQuery, "")
 
+		// Bucket notification subscriptions and event stream
+		r.NewRoute().Name(createNotificationSubscriptionRouterName).Methods(http.MethodPut).
+			Queries(NotificationQuery, "").HandlerFunc(g.createNotificationSubscriptionHandler)
+		r.NewRoute().Name(listNotificationSubscriptionsRouterName).Methods(http.MethodGet).
+			Queries(NotificationQuery, "").HandlerFunc(g.listNotificationSubscriptionsHandler)
+		r.NewRoute().Name(deleteNotificationSubscriptionRouterName).Methods(http.MethodDelete).
+			Queries(NotificationQuery, "", NotificationSubscriptionIDQuery, "{subscription-id}").HandlerFunc(g.deleteNotificationSubscriptionHandler)
+		r.NewRoute().Name(streamNotificationEventsRouterName).Methods(http.MethodGet).
+			Queries(NotificationEventsQuery, "").HandlerFunc(g.streamNotificationEventsHandler)
+
 		// Get Object Meta
 		r.NewRoute().Name(getObjectMetaRouterName).Methods(http.MethodGet).Path("/{object:.+}").HandlerFunc(g.getObjectMetaHandler).
 			Queries(GetObjectMetaQuery, "")
@@ -267,6 +313,9 @@ func (g *GateModular) RegisterHandler(router *mux.Router) {
 	// List Bucket Read Quota Count
 	router.Path("/").Name(getBucketReadQuotaCountRouterName).Methods(http.MethodGet).Queries(ListBucketReadCountQuery, "").HandlerFunc(g.getBucketReadQuotaCountHandler)
 
+	// List Usage Rollup
+	router.Path("/").Name(listUsageRollupRouterName).Methods(http.MethodGet).Queries(ListUsageRollupQuery, "").HandlerFunc(g.listUsageRollupHandler)
+
 	// Get BsDB data statistics Info
 	router.Path("/").Name(getBsDBDataInfo).Methods(http.MethodGet).Queries(BsDBInfoQuery, "").HandlerFunc(g.getBsDBDataInfoHandler)
 
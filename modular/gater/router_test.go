@@ -312,6 +312,72 @@ func TestRouters(t *testing.T) {
 			shouldMatch:      true,
 			wantedRouterName: queryMigrationProgressRouterName,
 		},
+		{
+			name:             "Create notification subscription router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodPut,
+			url:              fmt.Sprintf("%s%s.%s/?%s", scheme, mockBucketName, testDomain, NotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: createNotificationSubscriptionRouterName,
+		},
+		{
+			name:             "Create notification subscription router, path style",
+			router:           gwRouter,
+			method:           http.MethodPut,
+			url:              fmt.Sprintf("%s%s/%s?%s", scheme, testDomain, mockBucketName, NotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: createNotificationSubscriptionRouterName,
+		},
+		{
+			name:             "List notification subscriptions router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s/?%s", scheme, mockBucketName, testDomain, NotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: listNotificationSubscriptionsRouterName,
+		},
+		{
+			name:             "List notification subscriptions router, path style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/%s?%s", scheme, testDomain, mockBucketName, NotificationQuery),
+			shouldMatch:      true,
+			wantedRouterName: listNotificationSubscriptionsRouterName,
+		},
+		{
+			name:   "Delete notification subscription router, virtual host style",
+			router: gwRouter,
+			method: http.MethodDelete,
+			url: fmt.Sprintf("%s%s.%s/?%s&%s=1", scheme, mockBucketName, testDomain, NotificationQuery,
+				NotificationSubscriptionIDQuery),
+			shouldMatch:      true,
+			wantedRouterName: deleteNotificationSubscriptionRouterName,
+		},
+		{
+			name:   "Delete notification subscription router, path style",
+			router: gwRouter,
+			method: http.MethodDelete,
+			url: fmt.Sprintf("%s%s/%s?%s&%s=1", scheme, testDomain, mockBucketName, NotificationQuery,
+				NotificationSubscriptionIDQuery),
+			shouldMatch:      true,
+			wantedRouterName: deleteNotificationSubscriptionRouterName,
+		},
+		{
+			name:             "Stream notification events router, virtual host style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s/?%s", scheme, mockBucketName, testDomain, NotificationEventsQuery),
+			shouldMatch:      true,
+			wantedRouterName: streamNotificationEventsRouterName,
+		},
+		{
+			name:             "Stream notification events router, path style",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/%s?%s", scheme, testDomain, mockBucketName, NotificationEventsQuery),
+			shouldMatch:      true,
+			wantedRouterName: streamNotificationEventsRouterName,
+		},
 		{
 			name:             "Challenge router",
 			router:           gwRouter,
@@ -741,6 +807,14 @@ func TestRouters(t *testing.T) {
 			shouldMatch:      true,
 			wantedRouterName: listBucketReadQuotaRouterName,
 		},
+		{
+			name:             "list usage rollup router",
+			router:           gwRouter,
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/?%s&%s&%s", scheme, testDomain, ListUsageRollupQuery, StartDayQuery, EndDayQuery),
+			shouldMatch:      true,
+			wantedRouterName: listUsageRollupRouterName,
+		},
 		{
 			name:             "delegate create folder",
 			router:           gwRouter,
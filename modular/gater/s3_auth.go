@@ -0,0 +1,364 @@
+package gater
+
+import (
+	"bufio"
+	"bytes"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"hash"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"golang.org/x/exp/slices"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	s3SigV4Algorithm         = "AWS4-HMAC-SHA256"
+	s3SigV4ChunkAlgorithm    = "AWS4-HMAC-SHA256-PAYLOAD"
+	s3SigV4Terminator        = "aws4_request"
+	s3Service                = "s3"
+	s3TimeFormat             = "20060102T150405Z"
+	s3DateFormat             = "20060102"
+	s3UnsignedPayload        = "UNSIGNED-PAYLOAD"
+	s3StreamingPayload       = "STREAMING-AWS4-HMAC-SHA256-PAYLOAD"
+	s3EmptyPayloadHash       = "e3b0c44298fc1c149afbf4c8996fb92427ae41e4649b934ca495991b7852b855"
+	s3MaxChunkSize           = 16 * 1024 * 1024
+	s3CredentialDerivePrefix = "greenfield-s3-credential:"
+)
+
+// errS3NoAuthorization means the S3 request is anonymous.
+var errS3NoAuthorization = errors.New("no S3 authorization")
+
+// s3Signature is the AWS signature v4 carried by the Authorization header or the presigned url query.
+type s3Signature struct {
+	accessKey     string
+	date          string
+	region        string
+	signedHeaders []string
+	signature     string
+	signTime      time.Time
+	payloadHash   string
+	presigned     bool
+	expires       time.Duration
+}
+
+func (s *s3Signature) scope() string {
+	return strings.Join([]string{s.date, s.region, s3Service, s3SigV4Terminator}, "/")
+}
+
+// s3CredentialOf returns the S3 credential of the account, the access key id is the account address and
+// the secret access key is derived from the credential secret of the SP, so no credential is stored.
+func s3CredentialOf(credentialSecret string, account string) (string, string) {
+	mac := hmac.New(sha256.New, []byte(credentialSecret))
+	mac.Write([]byte(s3CredentialDerivePrefix + account))
+	return account, base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+// parseS3Signature parses the signature v4 of the S3 request, errS3NoAuthorization is returned if the
+// request is anonymous.
+func parseS3Signature(r *http.Request) (*s3Signature, error) {
+	var (
+		sig        = &s3Signature{}
+		credential string
+		signedHdrs string
+		signTime   string
+		query      = r.URL.Query()
+	)
+	if auth := r.Header.Get(GnfdAuthorizationHeader); auth != "" {
+		if !strings.HasPrefix(auth, s3SigV4Algorithm+" ") {
+			return nil, ErrS3AuthorizationMalformed
+		}
+		for _, field := range strings.Split(strings.TrimPrefix(auth, s3SigV4Algorithm+" "), ",") {
+			key, value, found := strings.Cut(strings.TrimSpace(field), "=")
+			if !found {
+				return nil, ErrS3AuthorizationMalformed
+			}
+			switch key {
+			case "Credential":
+				credential = value
+			case "SignedHeaders":
+				signedHdrs = value
+			case "Signature":
+				sig.signature = value
+			}
+		}
+		signTime = r.Header.Get(S3AmzDateHeader)
+		sig.payloadHash = r.Header.Get(S3AmzContentSHA256Header)
+		if sig.payloadHash == "" {
+			return nil, ErrS3AuthorizationMalformed
+		}
+	} else if query.Get(S3AmzAlgorithmQuery) != "" {
+		if query.Get(S3AmzAlgorithmQuery) != s3SigV4Algorithm {
+			return nil, ErrS3AuthorizationMalformed
+		}
+		credential = query.Get(S3AmzCredentialQuery)
+		signedHdrs = query.Get(S3AmzSignedHeadersQuery)
+		sig.signature = query.Get(S3AmzSignatureQuery)
+		signTime = query.Get(S3AmzDateQuery)
+		sig.payloadHash = s3UnsignedPayload
+		sig.presigned = true
+		expires, err := strconv.ParseInt(query.Get(S3AmzExpiresQuery), 10, 64)
+		if err != nil || expires <= 0 || time.Duration(expires)*time.Second > S3MaxPresignExpires {
+			return nil, ErrS3AuthorizationMalformed
+		}
+		sig.expires = time.Duration(expires) * time.Second
+	} else {
+		return nil, errS3NoAuthorization
+	}
+
+	// the credential is formatted as <access key>/<date>/<region>/s3/aws4_request
+	scope := strings.Split(credential, "/")
+	if len(scope) != 5 || scope[0] == "" || scope[3] != s3Service || scope[4] != s3SigV4Terminator {
+		return nil, ErrS3AuthorizationMalformed
+	}
+	sig.accessKey, sig.date, sig.region = scope[0], scope[1], scope[2]
+	if signedHdrs == "" || sig.signature == "" {
+		return nil, ErrS3AuthorizationMalformed
+	}
+	sig.signedHeaders = strings.Split(signedHdrs, ";")
+	var err error
+	if sig.signTime, err = time.Parse(s3TimeFormat, signTime); err != nil || sig.signTime.Format(s3DateFormat) != sig.date {
+		return nil, ErrS3AuthorizationMalformed
+	}
+	return sig, nil
+}
+
+// verifyS3Signature verifies the signature v4 of the S3 request, and returns the account that signs
+// the request and the signing key for verifying the chunked payload.
+func (g *GateModular) verifyS3Signature(r *http.Request, sig *s3Signature) (string, []byte, error) {
+	if g.s3Region != "" && sig.region != g.s3Region {
+		log.Errorw("failed to verify S3 signature due to mismatched region", "region", sig.region)
+		return "", nil, ErrS3AuthorizationMalformed
+	}
+	if !slices.Contains(sig.signedHeaders, "host") {
+		return "", nil, ErrS3AuthorizationMalformed
+	}
+	now := time.Now()
+	if sig.presigned {
+		if sig.signTime.After(now.Add(S3MaxClockSkew)) || now.After(sig.signTime.Add(sig.expires)) {
+			return "", nil, ErrS3RequestExpired
+		}
+	} else if sig.signTime.Before(now.Add(-S3MaxClockSkew)) || sig.signTime.After(now.Add(S3MaxClockSkew)) {
+		return "", nil, ErrS3RequestTimeSkewed
+	}
+	accAddress, err := sdk.AccAddressFromHexUnsafe(sig.accessKey)
+	if err != nil {
+		log.Errorw("failed to parse S3 access key", "access_key", sig.accessKey, "error", err)
+		return "", nil, ErrS3InvalidAccessKey
+	}
+	account := accAddress.String()
+	_, secretKey := s3CredentialOf(g.s3CredentialSecret, account)
+
+	signingKey := s3SigningKey(secretKey, sig.date, sig.region)
+	stringToSign := strings.Join([]string{s3SigV4Algorithm, sig.signTime.Format(s3TimeFormat), sig.scope(),
+		hexSHA256([]byte(s3CanonicalRequest(r, sig)))}, "\n")
+	expected := hex.EncodeToString(hmacSHA256(signingKey, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expected), []byte(sig.signature)) {
+		log.Debugw("failed to verify S3 signature", "access_key", sig.accessKey, "string_to_sign", stringToSign)
+		return "", nil, ErrS3SignatureMismatch
+	}
+	return account, signingKey, nil
+}
+
+// s3CanonicalRequest returns the canonical request of the signature v4.
+func s3CanonicalRequest(r *http.Request, sig *s3Signature) string {
+	query := r.URL.Query()
+	if sig.presigned {
+		query.Del(S3AmzSignatureQuery)
+	}
+	keys := make([]string, 0, len(query))
+	for key := range query {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys)
+	var canonicalQuery []string
+	for _, key := range keys {
+		values := append([]string{}, query[key]...)
+		sort.Strings(values)
+		for _, value := range values {
+			canonicalQuery = append(canonicalQuery, s3URIEncode(key, true)+"="+s3URIEncode(value, true))
+		}
+	}
+
+	var canonicalHeaders strings.Builder
+	for _, name := range sig.signedHeaders {
+		var value string
+		if name == "host" {
+			value = r.Host
+		} else {
+			values := append([]string{}, r.Header.Values(name)...)
+			for i := range values {
+				values[i] = strings.Join(strings.Fields(values[i]), " ")
+			}
+			value = strings.Join(values, ",")
+		}
+		canonicalHeaders.WriteString(name + ":" + value + "\n")
+	}
+
+	return strings.Join([]string{
+		r.Method,
+		s3URIEncode(r.URL.Path, false),
+		strings.Join(canonicalQuery, "&"),
+		canonicalHeaders.String(),
+		strings.Join(sig.signedHeaders, ";"),
+		sig.payloadHash,
+	}, "\n")
+}
+
+// s3URIEncode encodes the string as the signature v4 requires, every byte except the unreserved
+// characters is percent encoded, and the slash is kept in the path.
+func s3URIEncode(s string, encodeSlash bool) string {
+	var buf strings.Builder
+	for i := 0; i < len(s); i++ {
+		c := s[i]
+		if ('A' <= c && c <= 'Z') || ('a' <= c && c <= 'z') || ('0' <= c && c <= '9') ||
+			c == '-' || c == '_' || c == '.' || c == '~' || (c == '/' && !encodeSlash) {
+			buf.WriteByte(c)
+			continue
+		}
+		buf.WriteString(fmt.Sprintf("%%%02X", c))
+	}
+	return buf.String()
+}
+
+func s3SigningKey(secretKey string, date string, region string) []byte {
+	key := hmacSHA256([]byte("AWS4"+secretKey), []byte(date))
+	key = hmacSHA256(key, []byte(region))
+	key = hmacSHA256(key, []byte(s3Service))
+	return hmacSHA256(key, []byte(s3SigV4Terminator))
+}
+
+func hmacSHA256(key []byte, data []byte) []byte {
+	mac := hmac.New(sha256.New, key)
+	mac.Write(data)
+	return mac.Sum(nil)
+}
+
+func hexSHA256(data []byte) string {
+	sum := sha256.Sum256(data)
+	return hex.EncodeToString(sum[:])
+}
+
+// s3PayloadReader returns the reader of the S3 request payload, the payload is verified against the
+// signed payload hash, and the chunked payload is decoded and verified chunk by chunk.
+func s3PayloadReader(r *http.Request, sig *s3Signature, signingKey []byte) (io.Reader, error) {
+	switch {
+	case sig.payloadHash == s3UnsignedPayload:
+		return r.Body, nil
+	case sig.payloadHash == s3StreamingPayload:
+		return &s3ChunkedReader{
+			reader:        bufio.NewReader(r.Body),
+			signingKey:    signingKey,
+			signTime:      sig.signTime.Format(s3TimeFormat),
+			scope:         sig.scope(),
+			prevSignature: sig.signature,
+		}, nil
+	case len(sig.payloadHash) == sha256.Size*2:
+		return &s3HashingReader{reader: r.Body, hash: sha256.New(), expected: sig.payloadHash}, nil
+	default:
+		return nil, ErrS3NotImplemented
+	}
+}
+
+// s3HashingReader fails the reading at the end of the payload if the payload hash mismatches, the upload
+// stream is aborted instead of closed on the failure, so the uploader never seals the payload and deletes
+// the pieces that are already written.
+type s3HashingReader struct {
+	reader   io.Reader
+	hash     hash.Hash
+	expected string
+	mismatch bool
+}
+
+func (h *s3HashingReader) Read(p []byte) (int, error) {
+	n, err := h.reader.Read(p)
+	h.hash.Write(p[:n])
+	if errors.Is(err, io.EOF) && hex.EncodeToString(h.hash.Sum(nil)) != h.expected {
+		h.mismatch = true
+		return n, ErrS3SignatureMismatch
+	}
+	return n, err
+}
+
+// s3PayloadFailure returns the signature mismatch error if the upload failed due to the payload hash
+// mismatch, the upload client wraps the read errors of the payload.
+func s3PayloadFailure(body io.Reader, err error) error {
+	if h, ok := body.(*s3HashingReader); ok && err != nil && h.mismatch {
+		return ErrS3SignatureMismatch
+	}
+	return err
+}
+
+// s3ChunkedReader decodes the aws-chunked payload, every chunk is signed with the signature of the
+// previous chunk, and the seed signature is the signature of the request.
+type s3ChunkedReader struct {
+	reader        *bufio.Reader
+	signingKey    []byte
+	signTime      string
+	scope         string
+	prevSignature string
+	chunk         []byte
+	offset        int
+	err           error
+}
+
+func (c *s3ChunkedReader) Read(p []byte) (int, error) {
+	for c.offset >= len(c.chunk) {
+		if c.err != nil {
+			return 0, c.err
+		}
+		c.err = c.readChunk()
+	}
+	n := copy(p, c.chunk[c.offset:])
+	c.offset += n
+	return n, nil
+}
+
+// readChunk reads the chunk formatted as <hex size>;chunk-signature=<signature>\r\n<data>\r\n, io.EOF is
+// returned after the last empty chunk is verified.
+func (c *s3ChunkedReader) readChunk() error {
+	line, err := c.reader.ReadSlice('\n')
+	if err != nil {
+		return err
+	}
+	sizeStr, signature, found := strings.Cut(strings.TrimRight(string(line), "\r\n"), ";chunk-signature=")
+	if !found {
+		return ErrS3AuthorizationMalformed
+	}
+	size, err := strconv.ParseInt(sizeStr, 16, 64)
+	if err != nil || size < 0 || size > s3MaxChunkSize {
+		return ErrS3AuthorizationMalformed
+	}
+	c.chunk = make([]byte, size)
+	c.offset = 0
+	if _, err = io.ReadFull(c.reader, c.chunk); err != nil {
+		return err
+	}
+	crlf := make([]byte, 2)
+	if _, err = io.ReadFull(c.reader, crlf); err != nil || !bytes.Equal(crlf, []byte("\r\n")) {
+		return ErrS3AuthorizationMalformed
+	}
+	stringToSign := strings.Join([]string{s3SigV4ChunkAlgorithm, c.signTime, c.scope, c.prevSignature,
+		s3EmptyPayloadHash, hexSHA256(c.chunk)}, "\n")
+	expected := hex.EncodeToString(hmacSHA256(c.signingKey, []byte(stringToSign)))
+	if !hmac.Equal([]byte(expected), []byte(signature)) {
+		return ErrS3SignatureMismatch
+	}
+	c.prevSignature = signature
+	if size == 0 {
+		return io.EOF
+	}
+	return nil
+}
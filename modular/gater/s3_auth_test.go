@@ -0,0 +1,266 @@
+package gater
+
+import (
+	"bytes"
+	"encoding/hex"
+	"fmt"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/aws/aws-sdk-go/aws/credentials"
+	v4 "github.com/aws/aws-sdk-go/aws/signer/v4"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	testS3Domain           = "s3.route-test.com"
+	testS3Region           = "us-east-1"
+	testS3CredentialSecret = "mock-credential-secret"
+)
+
+var testS3Account = sdk.AccAddress(bytes.Repeat([]byte{0x1f}, 20)).String()
+
+func setupS3(t *testing.T) *GateModular {
+	g := setup(t)
+	g.s3Domain = testS3Domain
+	g.s3Region = testS3Region
+	g.s3CredentialSecret = testS3CredentialSecret
+	return g
+}
+
+func mockS3Signer(account string) *v4.Signer {
+	accessKey, secretKey := s3CredentialOf(testS3CredentialSecret, account)
+	return v4.NewSigner(credentials.NewStaticCredentials(accessKey, secretKey, ""), func(s *v4.Signer) {
+		s.DisableURIPathEscaping = true
+	})
+}
+
+func mockS3Request(method string, body string) *http.Request {
+	path := fmt.Sprintf("%s%s.%s/%s", scheme, mockBucketName, testS3Domain, mockObjectName)
+	return httptest.NewRequest(method, path, strings.NewReader(body))
+}
+
+func TestS3CredentialOf(t *testing.T) {
+	accessKey, secretKey := s3CredentialOf(testS3CredentialSecret, testS3Account)
+	assert.Equal(t, testS3Account, accessKey)
+	_, sameSecretKey := s3CredentialOf(testS3CredentialSecret, testS3Account)
+	assert.Equal(t, secretKey, sameSecretKey)
+	_, rotatedSecretKey := s3CredentialOf("rotated-credential-secret", testS3Account)
+	assert.NotEqual(t, secretKey, rotatedSecretKey)
+}
+
+func TestParseS3Signature(t *testing.T) {
+	cases := []struct {
+		name    string
+		header  map[string]string
+		wantErr error
+	}{
+		{
+			name:    "anonymous",
+			wantErr: errS3NoAuthorization,
+		},
+		{
+			name:    "unsupported algorithm",
+			header:  map[string]string{GnfdAuthorizationHeader: "AWS AKIDEXAMPLE:signature"},
+			wantErr: ErrS3AuthorizationMalformed,
+		},
+		{
+			name: "missing payload hash",
+			header: map[string]string{
+				GnfdAuthorizationHeader: s3SigV4Algorithm + " Credential=key/20230101/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=abc",
+				S3AmzDateHeader:         "20230101T000000Z",
+			},
+			wantErr: ErrS3AuthorizationMalformed,
+		},
+		{
+			name: "mismatched scope date",
+			header: map[string]string{
+				GnfdAuthorizationHeader:  s3SigV4Algorithm + " Credential=key/20230102/us-east-1/s3/aws4_request, SignedHeaders=host, Signature=abc",
+				S3AmzDateHeader:          "20230101T000000Z",
+				S3AmzContentSHA256Header: s3UnsignedPayload,
+			},
+			wantErr: ErrS3AuthorizationMalformed,
+		},
+		{
+			name: "valid",
+			header: map[string]string{
+				GnfdAuthorizationHeader:  s3SigV4Algorithm + " Credential=key/20230101/us-east-1/s3/aws4_request, SignedHeaders=host;x-amz-date, Signature=abc",
+				S3AmzDateHeader:          "20230101T000000Z",
+				S3AmzContentSHA256Header: s3UnsignedPayload,
+			},
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := mockS3Request(http.MethodGet, "")
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			sig, err := parseS3Signature(req)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, "key", sig.accessKey)
+				assert.Equal(t, []string{"host", "x-amz-date"}, sig.signedHeaders)
+				assert.Equal(t, "20230101/us-east-1/s3/aws4_request", sig.scope())
+			}
+		})
+	}
+}
+
+func TestVerifyS3Signature(t *testing.T) {
+	g := setupS3(t)
+	cases := []struct {
+		name    string
+		request func() *http.Request
+		wantErr error
+	}{
+		{
+			name: "signed header",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodPut, "payload")
+				_, _ = mockS3Signer(testS3Account).Sign(req, strings.NewReader("payload"), s3Service, testS3Region, time.Now())
+				return req
+			},
+		},
+		{
+			name: "presigned url",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodGet, "")
+				_, _ = mockS3Signer(testS3Account).Presign(req, nil, s3Service, testS3Region, time.Hour, time.Now())
+				return req
+			},
+		},
+		{
+			name: "tampered request",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodPut, "payload")
+				_, _ = mockS3Signer(testS3Account).Sign(req, strings.NewReader("payload"), s3Service, testS3Region, time.Now())
+				req.URL.Path += "-tampered"
+				return req
+			},
+			wantErr: ErrS3SignatureMismatch,
+		},
+		{
+			name: "mismatched region",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodGet, "")
+				_, _ = mockS3Signer(testS3Account).Sign(req, nil, s3Service, "eu-west-1", time.Now())
+				return req
+			},
+			wantErr: ErrS3AuthorizationMalformed,
+		},
+		{
+			name: "skewed request time",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodGet, "")
+				_, _ = mockS3Signer(testS3Account).Sign(req, nil, s3Service, testS3Region, time.Now().Add(-time.Hour))
+				return req
+			},
+			wantErr: ErrS3RequestTimeSkewed,
+		},
+		{
+			name: "expired presigned url",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodGet, "")
+				_, _ = mockS3Signer(testS3Account).Presign(req, nil, s3Service, testS3Region, time.Minute, time.Now().Add(-time.Hour))
+				return req
+			},
+			wantErr: ErrS3RequestExpired,
+		},
+		{
+			name: "invalid access key",
+			request: func() *http.Request {
+				req := mockS3Request(http.MethodGet, "")
+				_, _ = mockS3Signer("invalid-access-key").Sign(req, nil, s3Service, testS3Region, time.Now())
+				return req
+			},
+			wantErr: ErrS3InvalidAccessKey,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			req := tt.request()
+			sig, err := parseS3Signature(req)
+			assert.Nil(t, err)
+			account, signingKey, err := g.verifyS3Signature(req, sig)
+			assert.Equal(t, tt.wantErr, err)
+			if tt.wantErr == nil {
+				assert.Equal(t, testS3Account, account)
+				assert.NotEmpty(t, signingKey)
+			}
+		})
+	}
+}
+
+// mockS3ChunkedPayload encodes the chunks in aws-chunked format, every chunk is signed by the
+// signature of the previous chunk.
+func mockS3ChunkedPayload(sig *s3Signature, signingKey []byte, chunks ...string) string {
+	var (
+		buf           strings.Builder
+		prevSignature = sig.signature
+	)
+	for _, chunk := range append(chunks, "") {
+		stringToSign := strings.Join([]string{s3SigV4ChunkAlgorithm, sig.signTime.Format(s3TimeFormat), sig.scope(),
+			prevSignature, s3EmptyPayloadHash, hexSHA256([]byte(chunk))}, "\n")
+		prevSignature = hex.EncodeToString(hmacSHA256(signingKey, []byte(stringToSign)))
+		buf.WriteString(fmt.Sprintf("%x;chunk-signature=%s\r\n%s\r\n", len(chunk), prevSignature, chunk))
+	}
+	return buf.String()
+}
+
+func TestS3PayloadReader(t *testing.T) {
+	g := setupS3(t)
+	signedRequest := func(payloadHash string) (*http.Request, *s3Signature, []byte) {
+		req := mockS3Request(http.MethodPut, "")
+		req.Header.Set(S3AmzContentSHA256Header, payloadHash)
+		_, err := mockS3Signer(testS3Account).Sign(req, nil, s3Service, testS3Region, time.Now())
+		assert.Nil(t, err)
+		sig, err := parseS3Signature(req)
+		assert.Nil(t, err)
+		_, signingKey, err := g.verifyS3Signature(req, sig)
+		assert.Nil(t, err)
+		return req, sig, signingKey
+	}
+
+	t.Run("chunked payload", func(t *testing.T) {
+		req, sig, signingKey := signedRequest(s3StreamingPayload)
+		req.Body = io.NopCloser(strings.NewReader(mockS3ChunkedPayload(sig, signingKey, "hello ", "world")))
+		reader, err := s3PayloadReader(req, sig, signingKey)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(reader)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello world", string(data))
+	})
+	t.Run("tampered chunk", func(t *testing.T) {
+		req, sig, signingKey := signedRequest(s3StreamingPayload)
+		payload := strings.Replace(mockS3ChunkedPayload(sig, signingKey, "hello ", "world"), "world", "w0rld", 1)
+		req.Body = io.NopCloser(strings.NewReader(payload))
+		reader, err := s3PayloadReader(req, sig, signingKey)
+		assert.Nil(t, err)
+		_, err = io.ReadAll(reader)
+		assert.Equal(t, ErrS3SignatureMismatch, err)
+	})
+	t.Run("mismatched payload hash", func(t *testing.T) {
+		req, sig, signingKey := signedRequest(hexSHA256([]byte("hello world")))
+		req.Body = io.NopCloser(strings.NewReader("hello w0rld"))
+		reader, err := s3PayloadReader(req, sig, signingKey)
+		assert.Nil(t, err)
+		_, err = io.ReadAll(reader)
+		assert.Equal(t, ErrS3SignatureMismatch, err)
+		assert.Equal(t, ErrS3SignatureMismatch, s3PayloadFailure(reader, mockErr))
+	})
+	t.Run("unsigned payload", func(t *testing.T) {
+		req, sig, signingKey := signedRequest(s3UnsignedPayload)
+		req.Body = io.NopCloser(strings.NewReader("hello world"))
+		reader, err := s3PayloadReader(req, sig, signingKey)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(reader)
+		assert.Nil(t, err)
+		assert.Equal(t, "hello world", string(data))
+	})
+}
@@ -0,0 +1,511 @@
+package gater
+
+import (
+	"encoding/base64"
+	"encoding/xml"
+	"errors"
+	"net/http"
+	"strconv"
+	"strings"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+	"github.com/bnb-chain/greenfield/types/s3util"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	s3TimestampFormat   = "2006-01-02T15:04:05.000Z"
+	s3StorageClass      = "STANDARD"
+	s3DefaultRegion     = "us-east-1"
+	s3ErrInvalidRequest = "InvalidRequest"
+	s3ErrAccessDenied   = "AccessDenied"
+	s3ErrNoSuchKey      = "NoSuchKey"
+	s3ErrInternalError  = "InternalError"
+	s3ErrNotImplemented = "NotImplemented"
+)
+
+// s3ErrorResponse is the error response of the S3 api.
+type s3ErrorResponse struct {
+	XMLName  xml.Name `xml:"Error"`
+	Code     string   `xml:"Code"`
+	Message  string   `xml:"Message"`
+	Resource string   `xml:"Resource"`
+}
+
+// s3ListBucketResult is the response of the S3 ListObjectsV2 api.
+type s3ListBucketResult struct {
+	XMLName               xml.Name         `xml:"ListBucketResult"`
+	Xmlns                 string           `xml:"xmlns,attr"`
+	Name                  string           `xml:"Name"`
+	Prefix                string           `xml:"Prefix"`
+	Delimiter             string           `xml:"Delimiter,omitempty"`
+	StartAfter            string           `xml:"StartAfter,omitempty"`
+	ContinuationToken     string           `xml:"ContinuationToken,omitempty"`
+	NextContinuationToken string           `xml:"NextContinuationToken,omitempty"`
+	MaxKeys               uint64           `xml:"MaxKeys"`
+	KeyCount              int              `xml:"KeyCount"`
+	IsTruncated           bool             `xml:"IsTruncated"`
+	Contents              []s3Object       `xml:"Contents"`
+	CommonPrefixes        []s3CommonPrefix `xml:"CommonPrefixes"`
+}
+
+type s3Object struct {
+	Key          string `xml:"Key"`
+	LastModified string `xml:"LastModified"`
+	ETag         string `xml:"ETag"`
+	Size         uint64 `xml:"Size"`
+	StorageClass string `xml:"StorageClass"`
+}
+
+type s3CommonPrefix struct {
+	Prefix string `xml:"Prefix"`
+}
+
+// s3CredentialResponse is the S3 credential of the account.
+type s3CredentialResponse struct {
+	XMLName         xml.Name `xml:"S3Credential"`
+	AccessKeyID     string   `xml:"AccessKeyId"`
+	SecretAccessKey string   `xml:"SecretAccessKey"`
+	Region          string   `xml:"Region"`
+	Endpoint        string   `xml:"Endpoint"`
+}
+
+// s3ErrorCodeOf maps the gateway error to the S3 error code and http status code, so the S3 clients
+// can recognize the error and decide whether to retry.
+func s3ErrorCodeOf(err error) (string, int) {
+	gfspErr := gfsperrors.MakeGfSpError(err)
+	switch gfspErr.GetInnerCode() {
+	case ErrS3AuthorizationMalformed.GetInnerCode():
+		return "AuthorizationHeaderMalformed", http.StatusBadRequest
+	case ErrS3InvalidAccessKey.GetInnerCode():
+		return "InvalidAccessKeyId", http.StatusForbidden
+	case ErrS3SignatureMismatch.GetInnerCode():
+		return "SignatureDoesNotMatch", http.StatusForbidden
+	case ErrS3RequestTimeSkewed.GetInnerCode():
+		return "RequestTimeTooSkewed", http.StatusForbidden
+	case ErrS3RequestExpired.GetInnerCode(), ErrNoPermission.GetInnerCode():
+		return s3ErrAccessDenied, http.StatusForbidden
+	case ErrS3NotImplemented.GetInnerCode(), ErrS3Disabled.GetInnerCode():
+		return s3ErrNotImplemented, http.StatusNotImplemented
+	case ErrInvalidRange.GetInnerCode(), ErrRangeNotSatisfiable.GetInnerCode():
+		return "InvalidRange", http.StatusRequestedRangeNotSatisfiable
+	case ErrPreconditionFailed.GetInnerCode():
+		return "PreconditionFailed", http.StatusPreconditionFailed
+	case ErrNoSuchObject.GetInnerCode(), ErrConsensusNotFoundWithDetail("").GetInnerCode():
+		return s3ErrNoSuchKey, http.StatusNotFound
+	case ErrInvalidQuery.GetInnerCode(), ErrInvalidObjectName.GetInnerCode():
+		return "InvalidArgument", http.StatusBadRequest
+	}
+	status := int(gfspErr.GetHttpStatusCode())
+	switch {
+	case status == http.StatusNotFound:
+		return s3ErrNoSuchKey, status
+	case status == http.StatusUnauthorized || status == http.StatusForbidden:
+		return s3ErrAccessDenied, http.StatusForbidden
+	case status >= http.StatusInternalServerError:
+		return s3ErrInternalError, status
+	case status >= http.StatusBadRequest:
+		return s3ErrInvalidRequest, status
+	default:
+		return s3ErrInternalError, http.StatusInternalServerError
+	}
+}
+
+// makeS3ErrorResponse writes the error response in the S3 format.
+func makeS3ErrorResponse(w http.ResponseWriter, r *http.Request, err error) {
+	code, status := s3ErrorCodeOf(err)
+	xmlBody, marshalErr := xml.Marshal(&s3ErrorResponse{
+		Code:     code,
+		Message:  gfsperrors.MakeGfSpError(err).GetDescription(),
+		Resource: r.URL.Path,
+	})
+	if marshalErr != nil {
+		log.Errorw("failed to marshal S3 error response", "code", code, "error", marshalErr)
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	w.WriteHeader(status)
+	if _, writeErr := w.Write(append([]byte(xml.Header), xmlBody...)); writeErr != nil {
+		log.Errorw("failed to write S3 error response", "code", code, "error", writeErr)
+	}
+}
+
+// makeS3XMLResponse writes the response in the S3 format.
+func makeS3XMLResponse(w http.ResponseWriter, v interface{}) error {
+	xmlBody, err := xml.Marshal(v)
+	if err != nil {
+		log.Errorw("failed to marshal S3 response", "error", err)
+		return ErrEncodeResponseWithDetail("failed to marshal S3 response, error: " + err.Error())
+	}
+	w.Header().Set(ContentTypeHeader, ContentTypeXMLHeaderValue)
+	if _, err = w.Write(append([]byte(xml.Header), xmlBody...)); err != nil {
+		log.Errorw("failed to write S3 response", "error", err)
+	}
+	return nil
+}
+
+// s3Authenticate verifies the signature v4 of the S3 request, and fills the account of the request
+// context with the signer. The nil signature is returned if the request is anonymous, and the
+// signing key is returned for verifying the chunked payload.
+func (g *GateModular) s3Authenticate(reqCtx *RequestContext) (*s3Signature, []byte, error) {
+	sig, err := parseS3Signature(reqCtx.request)
+	if errors.Is(err, errS3NoAuthorization) {
+		return nil, nil, nil
+	}
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to parse S3 signature", "error", err)
+		return nil, nil, err
+	}
+	account, signingKey, err := g.verifyS3Signature(reqCtx.request, sig)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify S3 signature", "error", err)
+		return nil, nil, err
+	}
+	reqCtx.account = account
+	return sig, signingKey, nil
+}
+
+// s3AuthorizeGetObject checks whether the S3 request is allowed to read the object, the anonymous
+// request can only read the public object.
+func (g *GateModular) s3AuthorizeGetObject(reqCtx *RequestContext) error {
+	if err := s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
+		return ErrInvalidQuery
+	}
+	if err := s3util.CheckValidObjectName(reqCtx.objectName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check object name", "object_name", reqCtx.objectName, "error", err)
+		return ErrInvalidQuery
+	}
+	sig, _, err := g.s3Authenticate(reqCtx)
+	if err != nil {
+		return err
+	}
+	if sig == nil {
+		permission, err := g.baseApp.GfSpClient().VerifyPermission(reqCtx.Context(), sdk.AccAddress{}.String(),
+			reqCtx.bucketName, reqCtx.objectName, permissiontypes.ACTION_GET_OBJECT)
+		if err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to verify authentication for getting public object", "error", err)
+			return err
+		}
+		if *permission != permissiontypes.EFFECT_ALLOW {
+			log.CtxErrorw(reqCtx.Context(), "no permission to operate, object is not public")
+			return ErrNoPermission
+		}
+		return nil
+	}
+	authenticated, err := g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(), coremodule.AuthOpTypeGetObject,
+		reqCtx.Account(), reqCtx.bucketName, reqCtx.objectName)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return err
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		return ErrNoPermission
+	}
+	return nil
+}
+
+// s3HandlerMetrics records the result of the S3 request and writes the S3 error response.
+func s3HandlerMetrics(w http.ResponseWriter, r *http.Request, reqCtx *RequestContext, err error, startTime time.Time) {
+	if err != nil {
+		reqCtx.SetError(gfsperrors.MakeGfSpError(err))
+		_, status := s3ErrorCodeOf(err)
+		reqCtx.SetHTTPCode(status)
+		makeS3ErrorResponse(w, r, err)
+		metrics.ReqCounter.WithLabelValues(GatewayTotalFailure).Inc()
+		metrics.ReqTime.WithLabelValues(GatewayTotalFailure).Observe(time.Since(startTime).Seconds())
+	} else {
+		reqCtx.SetHTTPCode(http.StatusOK)
+		metrics.ReqCounter.WithLabelValues(GatewayTotalSuccess).Inc()
+		metrics.ReqTime.WithLabelValues(GatewayTotalSuccess).Observe(time.Since(startTime).Seconds())
+	}
+	log.CtxDebugw(reqCtx.Context(), reqCtx.String())
+}
+
+// s3GetObjectHandler handles the S3 GetObject request.
+func (g *GateModular) s3GetObjectHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err    error
+		reqCtx *RequestContext
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		s3HandlerMetrics(w, r, reqCtx, err, startTime)
+	}()
+
+	reqCtx, _ = NewRequestContext(r, g)
+	if err = g.s3AuthorizeGetObject(reqCtx); err != nil {
+		return
+	}
+	err = g.downloadObject(w, reqCtx)
+}
+
+// s3HeadObjectHandler handles the S3 HeadObject request, only the sealed object is visible.
+func (g *GateModular) s3HeadObjectHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err        error
+		reqCtx     *RequestContext
+		objectInfo *storagetypes.ObjectInfo
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		s3HandlerMetrics(w, r, reqCtx, err, startTime)
+	}()
+
+	reqCtx, _ = NewRequestContext(r, g)
+	if err = g.s3AuthorizeGetObject(reqCtx); err != nil {
+		return
+	}
+	if objectInfo, err = g.baseApp.Consensus().QueryObjectInfo(reqCtx.Context(), reqCtx.bucketName, reqCtx.objectName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to get object info from consensus", "error", err)
+		if strings.Contains(err.Error(), "No such object") {
+			err = ErrNoSuchObject
+		} else {
+			err = ErrConsensusWithDetail("failed to get object info from consensus, object_name: " + reqCtx.objectName + ", bucket_name: " + reqCtx.bucketName + ", error:" + err.Error())
+		}
+		return
+	}
+	if objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_SEALED {
+		log.CtxErrorw(reqCtx.Context(), "object is not sealed", "status", objectInfo.GetObjectStatus())
+		err = ErrNoSuchObject
+		return
+	}
+
+	etag := objectETag(objectInfo)
+	modTime := objectModTime(objectInfo)
+	if etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+	if !modTime.IsZero() {
+		w.Header().Set(LastModifiedHeader, modTime.Format(http.TimeFormat))
+	}
+	switch checkPreconditions(r.Header, etag, modTime) {
+	case http.StatusNotModified:
+		w.WriteHeader(http.StatusNotModified)
+		return
+	case http.StatusPreconditionFailed:
+		err = ErrPreconditionFailed
+		return
+	}
+	w.Header().Set(AcceptRangesHeader, "bytes")
+	w.Header().Set(ContentTypeHeader, objectInfo.GetContentType())
+	w.Header().Set(ContentLengthHeader, util.Uint64ToString(objectInfo.GetPayloadSize()))
+	w.WriteHeader(http.StatusOK)
+}
+
+// s3PutObjectHandler handles the S3 PutObject request, the object must have been created on chain,
+// and the payload is verified against the signature before it is uploaded.
+func (g *GateModular) s3PutObjectHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err           error
+		reqCtx        *RequestContext
+		sig           *s3Signature
+		signingKey    []byte
+		authenticated bool
+		objectInfo    *storagetypes.ObjectInfo
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		s3HandlerMetrics(w, r, reqCtx, err, startTime)
+	}()
+
+	reqCtx, _ = NewRequestContext(r, g)
+	if sig, signingKey, err = g.s3Authenticate(reqCtx); err != nil {
+		return
+	}
+	if sig == nil {
+		log.CtxError(reqCtx.Context(), "anonymous S3 request is not allowed to put object")
+		err = ErrNoPermission
+		return
+	}
+	if err = g.checkSPAndBucketStatus(reqCtx.Context(), reqCtx.bucketName, reqCtx.account); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "put object failed to check sp and bucket status", "error", err)
+		return
+	}
+	if authenticated, err = g.baseApp.GfSpClient().VerifyAuthentication(reqCtx.Context(), coremodule.AuthOpTypePutObject,
+		reqCtx.Account(), reqCtx.bucketName, reqCtx.objectName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to verify authentication", "error", err)
+		return
+	}
+	if !authenticated {
+		log.CtxErrorw(reqCtx.Context(), "no permission to operate")
+		err = ErrNoPermission
+		return
+	}
+	body, err := s3PayloadReader(r, sig, signingKey)
+	if err != nil {
+		return
+	}
+	if objectInfo, err = g.uploadObject(reqCtx, body, startTime); err != nil {
+		err = s3PayloadFailure(body, err)
+		return
+	}
+	if etag := objectETag(objectInfo); etag != "" {
+		w.Header().Set(ETagHeader, etag)
+	}
+}
+
+// s3ListObjectsV2Handler handles the S3 ListObjectsV2 request, the listing is public as the
+// listObjectsByBucketNameHandler, but the signature is still verified if it is present.
+func (g *GateModular) s3ListObjectsV2Handler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err               error
+		reqCtx            *RequestContext
+		maxKeys           uint64
+		continuationToken string
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		s3HandlerMetrics(w, r, reqCtx, err, startTime)
+	}()
+
+	reqCtx, _ = NewRequestContext(r, g)
+	queryParams := r.URL.Query()
+	if queryParams.Get(S3ListTypeQuery) != S3ListObjectsV2 {
+		log.CtxErrorw(reqCtx.Context(), "unsupported S3 list type", "list_type", queryParams.Get(S3ListTypeQuery))
+		err = ErrS3NotImplemented
+		return
+	}
+	if _, _, err = g.s3Authenticate(reqCtx); err != nil {
+		return
+	}
+	if err = s3util.CheckValidBucketName(reqCtx.bucketName); err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to check bucket name", "bucket_name", reqCtx.bucketName, "error", err)
+		err = ErrInvalidQuery
+		return
+	}
+
+	requestPrefix := queryParams.Get(S3PrefixQuery)
+	requestDelimiter := queryParams.Get(S3DelimiterQuery)
+	requestStartAfter := queryParams.Get(S3StartAfterQuery)
+	requestContinuationToken := queryParams.Get(S3ContinuationTokenQuery)
+	if requestDelimiter != "" && requestDelimiter != "/" {
+		log.CtxErrorw(reqCtx.Context(), "failed to check delimiter", "delimiter", requestDelimiter)
+		err = ErrInvalidQuery
+		return
+	}
+	if !checkValidObjectPrefix(requestPrefix) {
+		log.CtxErrorw(reqCtx.Context(), "failed to check prefix", "prefix", requestPrefix)
+		err = ErrInvalidQuery
+		return
+	}
+	if requestMaxKeys := queryParams.Get(S3MaxKeysQuery); requestMaxKeys != "" {
+		if maxKeys, err = strconv.ParseUint(requestMaxKeys, 10, 64); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to parse max keys", "max_keys", requestMaxKeys, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestStartAfter != "" {
+		if err = s3util.CheckValidObjectName(requestStartAfter); err != nil {
+			log.CtxErrorw(reqCtx.Context(), "failed to check start after", "start_after", requestStartAfter, "error", err)
+			err = ErrInvalidQuery
+			return
+		}
+	}
+	if requestContinuationToken != "" {
+		decodedContinuationToken, decodeErr := base64.StdEncoding.DecodeString(requestContinuationToken)
+		if decodeErr != nil || !strings.HasPrefix(string(decodedContinuationToken), requestPrefix) {
+			log.CtxErrorw(reqCtx.Context(), "failed to check continuation token", "continuation_token", requestContinuationToken, "error", decodeErr)
+			err = ErrInvalidQuery
+			return
+		}
+		continuationToken = string(decodedContinuationToken)
+	} else {
+		continuationToken = requestStartAfter
+	}
+
+	objects, _, maxKeys, isTruncated, nextContinuationToken, _, _, _, commonPrefixes, _, err :=
+		g.baseApp.GfSpClient().ListObjectsByBucketName(reqCtx.Context(), reqCtx.bucketName, "", maxKeys,
+			requestStartAfter, continuationToken, requestDelimiter, requestPrefix, false)
+	if err != nil {
+		log.CtxErrorw(reqCtx.Context(), "failed to list objects by bucket name", "error", err)
+		return
+	}
+
+	result := &s3ListBucketResult{
+		Xmlns:             S3XMLNamespace,
+		Name:              reqCtx.bucketName,
+		Prefix:            requestPrefix,
+		Delimiter:         requestDelimiter,
+		StartAfter:        requestStartAfter,
+		ContinuationToken: requestContinuationToken,
+		MaxKeys:           maxKeys,
+		IsTruncated:       isTruncated,
+		Contents:          make([]s3Object, 0, len(objects)),
+		CommonPrefixes:    make([]s3CommonPrefix, 0, len(commonPrefixes)),
+	}
+	if isTruncated {
+		result.NextContinuationToken = nextContinuationToken
+	}
+	for _, object := range objects {
+		objectInfo := object.GetObjectInfo()
+		// the object in uploading can not be read by the S3 clients, so it is invisible
+		if objectInfo == nil || objectInfo.GetObjectStatus() != storagetypes.OBJECT_STATUS_SEALED {
+			continue
+		}
+		result.Contents = append(result.Contents, s3Object{
+			Key:          objectInfo.GetObjectName(),
+			LastModified: objectModTime(objectInfo).Format(s3TimestampFormat),
+			ETag:         objectETag(objectInfo),
+			Size:         objectInfo.GetPayloadSize(),
+			StorageClass: s3StorageClass,
+		})
+	}
+	for _, prefix := range commonPrefixes {
+		result.CommonPrefixes = append(result.CommonPrefixes, s3CommonPrefix{Prefix: prefix})
+	}
+	result.KeyCount = len(result.Contents) + len(result.CommonPrefixes)
+	err = makeS3XMLResponse(w, result)
+}
+
+// s3NotImplementedHandler replies NotImplemented to the S3 operations that are not supported.
+func (g *GateModular) s3NotImplementedHandler(w http.ResponseWriter, r *http.Request) {
+	log.Debugw("unsupported S3 operation", "method", r.Method, "host", r.Host, "url", r.URL)
+	makeS3ErrorResponse(w, r, ErrS3NotImplemented)
+}
+
+// s3CredentialHandler issues the S3 credential to the account authorized by the off-chain auth, the
+// credential can be revoked for all the accounts by rotating the credential secret of the SP.
+func (g *GateModular) s3CredentialHandler(w http.ResponseWriter, r *http.Request) {
+	var (
+		err    error
+		reqCtx *RequestContext
+	)
+	startTime := time.Now()
+	defer func() {
+		reqCtx.Cancel()
+		s3HandlerMetrics(w, r, reqCtx, err, startTime)
+	}()
+
+	if reqCtx, err = NewRequestContext(r, g); err != nil {
+		return
+	}
+	if g.s3Domain == "" {
+		err = ErrS3Disabled
+		return
+	}
+	accessKey, secretKey := s3CredentialOf(g.s3CredentialSecret, reqCtx.Account())
+	region := g.s3Region
+	if region == "" {
+		region = s3DefaultRegion
+	}
+	err = makeS3XMLResponse(w, &s3CredentialResponse{
+		AccessKeyID:     accessKey,
+		SecretAccessKey: secretKey,
+		Region:          region,
+		Endpoint:        g.s3Domain,
+	})
+}
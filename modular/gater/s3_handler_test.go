@@ -0,0 +1,218 @@
+package gater
+
+import (
+	"encoding/xml"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	metadatatypes "github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	permissiontypes "github.com/bnb-chain/greenfield/x/permission/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupS3Router(g *GateModular) *mux.Router {
+	router := mux.NewRouter().SkipClean(true)
+	g.registerS3Handler(router)
+	return router
+}
+
+func TestS3Routers(t *testing.T) {
+	router := setupS3Router(setupS3(t))
+	cases := []struct {
+		name             string
+		method           string
+		url              string
+		wantedRouterName string
+	}{
+		{
+			name:             "virtual hosted style get object",
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s/%s", scheme, mockBucketName, testS3Domain, mockObjectName),
+			wantedRouterName: s3GetObjectRouterName,
+		},
+		{
+			name:             "path style head object",
+			method:           http.MethodHead,
+			url:              fmt.Sprintf("%s%s/%s/%s", scheme, testS3Domain, mockBucketName, mockObjectName),
+			wantedRouterName: s3HeadObjectRouterName,
+		},
+		{
+			name:             "virtual hosted style put object with port",
+			method:           http.MethodPut,
+			url:              fmt.Sprintf("%s%s.%s:9033/%s", scheme, mockBucketName, testS3Domain, mockObjectName),
+			wantedRouterName: s3PutObjectRouterName,
+		},
+		{
+			name:             "virtual hosted style list objects",
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s.%s/?%s=%s", scheme, mockBucketName, testS3Domain, S3ListTypeQuery, S3ListObjectsV2),
+			wantedRouterName: s3ListObjectsV2RouterName,
+		},
+		{
+			name:             "path style list objects",
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/%s?%s=%s", scheme, testS3Domain, mockBucketName, S3ListTypeQuery, S3ListObjectsV2),
+			wantedRouterName: s3ListObjectsV2RouterName,
+		},
+		{
+			name:             "delete object is not implemented",
+			method:           http.MethodDelete,
+			url:              fmt.Sprintf("%s%s.%s/%s", scheme, mockBucketName, testS3Domain, mockObjectName),
+			wantedRouterName: s3NotImplementedRouterName,
+		},
+		{
+			name:             "list buckets is not implemented",
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s/", scheme, testS3Domain),
+			wantedRouterName: s3NotImplementedRouterName,
+		},
+		{
+			name:             "get S3 credential",
+			method:           http.MethodGet,
+			url:              fmt.Sprintf("%s%s%s", scheme, testDomain, S3CredentialPath),
+			wantedRouterName: getS3CredentialRouterName,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			request := httptest.NewRequest(tt.method, tt.url, strings.NewReader(""))
+			var match mux.RouteMatch
+			assert.True(t, router.Match(request, &match))
+			assert.Equal(t, tt.wantedRouterName, match.Route.GetName())
+		})
+	}
+}
+
+func TestS3ErrorCodeOf(t *testing.T) {
+	cases := []struct {
+		err        error
+		wantCode   string
+		wantStatus int
+	}{
+		{ErrS3SignatureMismatch, "SignatureDoesNotMatch", http.StatusForbidden},
+		{ErrNoPermission, "AccessDenied", http.StatusForbidden},
+		{ErrInvalidRange, "InvalidRange", http.StatusRequestedRangeNotSatisfiable},
+		{ErrRangeNotSatisfiable, "InvalidRange", http.StatusRequestedRangeNotSatisfiable},
+		{ErrConsensusNotFoundWithDetail("deleted"), "NoSuchKey", http.StatusNotFound},
+		{ErrConsensusWithDetail("unavailable"), "InternalError", http.StatusInternalServerError},
+		{mockErr, "InternalError", http.StatusInternalServerError},
+	}
+	for _, tt := range cases {
+		t.Run(tt.wantCode, func(t *testing.T) {
+			code, status := s3ErrorCodeOf(tt.err)
+			assert.Equal(t, tt.wantCode, code)
+			assert.Equal(t, tt.wantStatus, status)
+		})
+	}
+}
+
+func TestS3HeadObjectHandler(t *testing.T) {
+	cases := []struct {
+		name       string
+		status     storagetypes.ObjectStatus
+		header     map[string]string
+		wantStatus int
+	}{
+		{
+			name:       "sealed object",
+			status:     storagetypes.OBJECT_STATUS_SEALED,
+			wantStatus: http.StatusOK,
+		},
+		{
+			name:       "not modified",
+			status:     storagetypes.OBJECT_STATUS_SEALED,
+			header:     map[string]string{IfNoneMatchHeader: "*"},
+			wantStatus: http.StatusNotModified,
+		},
+		{
+			name:       "object in uploading",
+			status:     storagetypes.OBJECT_STATUS_CREATED,
+			wantStatus: http.StatusNotFound,
+		},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			g := setupS3(t)
+			ctrl := gomock.NewController(t)
+			clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+			var allow = permissiontypes.EFFECT_ALLOW
+			clientMock.EXPECT().VerifyPermission(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).
+				Return(&allow, nil).Times(1)
+			g.baseApp.SetGfSpClient(clientMock)
+			consensusMock := consensus.NewMockConsensus(ctrl)
+			consensusMock.EXPECT().QueryObjectInfo(gomock.Any(), gomock.Any(), gomock.Any()).Return(
+				&storagetypes.ObjectInfo{
+					Id:           sdkmath.NewUint(1),
+					PayloadSize:  uint64(len(mockObjectPayload)),
+					ContentType:  "text/plain",
+					ObjectStatus: tt.status,
+					Checksums:    [][]byte{[]byte("checksum")},
+				}, nil).Times(1)
+			g.baseApp.SetConsensus(consensusMock)
+
+			req := mockS3Request(http.MethodHead, "")
+			for k, v := range tt.header {
+				req.Header.Set(k, v)
+			}
+			w := httptest.NewRecorder()
+			setupS3Router(g).ServeHTTP(w, req)
+			assert.Equal(t, tt.wantStatus, w.Code)
+			if tt.wantStatus == http.StatusOK {
+				assert.Equal(t, fmt.Sprint(len(mockObjectPayload)), w.Header().Get(ContentLengthHeader))
+				assert.NotEmpty(t, w.Header().Get(ETagHeader))
+			}
+		})
+	}
+}
+
+func TestS3ListObjectsV2Handler(t *testing.T) {
+	g := setupS3(t)
+	ctrl := gomock.NewController(t)
+	clientMock := gfspclient.NewMockGfSpClientAPI(ctrl)
+	clientMock.EXPECT().ListObjectsByBucketName(gomock.Any(), mockBucketName, "", uint64(2), "", "", "/", "dir/", false).
+		Return([]*metadatatypes.Object{
+			{ObjectInfo: &storagetypes.ObjectInfo{ObjectName: "dir/sealed", PayloadSize: 10,
+				ObjectStatus: storagetypes.OBJECT_STATUS_SEALED, Checksums: [][]byte{[]byte("checksum")}}},
+			{ObjectInfo: &storagetypes.ObjectInfo{ObjectName: "dir/created", PayloadSize: 10,
+				ObjectStatus: storagetypes.OBJECT_STATUS_CREATED}},
+		}, uint64(3), uint64(2), true, "bmV4dA==", mockBucketName, "dir/", "/", []string{"dir/sub/"}, "", nil).Times(1)
+	g.baseApp.SetGfSpClient(clientMock)
+
+	req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("%s%s/%s?%s=%s&%s=%s&%s=%s&%s=2", scheme, testS3Domain, mockBucketName,
+		S3ListTypeQuery, S3ListObjectsV2, S3PrefixQuery, "dir/", S3DelimiterQuery, "/", S3MaxKeysQuery), strings.NewReader(""))
+	w := httptest.NewRecorder()
+	setupS3Router(g).ServeHTTP(w, req)
+	assert.Equal(t, http.StatusOK, w.Code)
+
+	var result s3ListBucketResult
+	assert.Nil(t, xml.Unmarshal(w.Body.Bytes(), &result))
+	assert.Equal(t, S3XMLNamespace, result.Xmlns)
+	assert.Equal(t, 2, result.KeyCount)
+	assert.True(t, result.IsTruncated)
+	assert.Equal(t, "bmV4dA==", result.NextContinuationToken)
+	assert.Equal(t, 1, len(result.Contents))
+	assert.Equal(t, "dir/sealed", result.Contents[0].Key)
+	assert.Equal(t, []s3CommonPrefix{{Prefix: "dir/sub/"}}, result.CommonPrefixes)
+}
+
+func TestS3NotImplementedHandler(t *testing.T) {
+	g := setupS3(t)
+	req := mockS3Request(http.MethodDelete, "")
+	w := httptest.NewRecorder()
+	setupS3Router(g).ServeHTTP(w, req)
+	assert.Equal(t, http.StatusNotImplemented, w.Code)
+
+	var resp s3ErrorResponse
+	assert.Nil(t, xml.Unmarshal(w.Body.Bytes(), &resp))
+	assert.Equal(t, "NotImplemented", resp.Code)
+}
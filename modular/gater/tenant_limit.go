@@ -0,0 +1,76 @@
+package gater
+
+import (
+	"context"
+	"io"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+)
+
+// checkTenantRequest takes a request token from the account and the bucket of the request, the anonymous
+// requests are only limited by the bucket.
+func (g *GateModular) checkTenantRequest(reqCtx *RequestContext) error {
+	if g.tenantLimiter == nil {
+		return nil
+	}
+	switch g.tenantLimiter.AllowRequest(reqCtx.Account(), reqCtx.bucketName) {
+	case mwhttp.TenantAccount:
+		log.CtxErrorw(reqCtx.Context(), "account exceeds the request rate", "account", reqCtx.Account())
+		return ErrAccountRequestRateExceeded
+	case mwhttp.TenantBucket:
+		log.CtxErrorw(reqCtx.Context(), "bucket exceeds the request rate", "bucket_name", reqCtx.bucketName)
+		return ErrBucketRequestRateExceeded
+	}
+	return nil
+}
+
+// tenantLimitReader shapes the reading of the upload or download data by the byte rate of the account
+// and the bucket, it fails if the wait for the bandwidth is canceled or exceeds the request deadline.
+type tenantLimitReader struct {
+	ctx     context.Context
+	r       io.Reader
+	limiter *mwhttp.TenantLimiter
+	account string
+	bucket  string
+	// err is the error of exceeding the byte rate, it is kept since the callers may wrap the read errors
+	err *gfsperrors.GfSpError
+}
+
+func (g *GateModular) newTenantLimitReader(reqCtx *RequestContext, r io.Reader) *tenantLimitReader {
+	return &tenantLimitReader{
+		ctx:     reqCtx.Context(),
+		r:       r,
+		limiter: g.tenantLimiter,
+		account: reqCtx.Account(),
+		bucket:  reqCtx.bucketName,
+	}
+}
+
+func (r *tenantLimitReader) Read(p []byte) (int, error) {
+	n, err := r.r.Read(p)
+	if n == 0 || r.limiter == nil {
+		return n, err
+	}
+	kind, waitErr := r.limiter.WaitBytes(r.ctx, r.account, r.bucket, n)
+	if waitErr == nil {
+		return n, err
+	}
+	log.CtxErrorw(r.ctx, "failed to wait for the bandwidth", "account", r.account, "bucket_name", r.bucket,
+		"tenant", kind, "error", waitErr)
+	if kind == mwhttp.TenantAccount {
+		r.err = ErrAccountByteRateExceeded
+	} else {
+		r.err = ErrBucketByteRateExceeded
+	}
+	return n, r.err
+}
+
+// failure returns the error of exceeding the byte rate in place of err if it happened.
+func (r *tenantLimitReader) failure(err error) error {
+	if err != nil && r.err != nil {
+		return r.err
+	}
+	return err
+}
@@ -0,0 +1,71 @@
+package gater
+
+import (
+	"bytes"
+	"context"
+	"io"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	mwhttp "github.com/bnb-chain/greenfield-storage-provider/pkg/middleware/http"
+)
+
+func setupTenantLimit(t *testing.T) *GateModular {
+	g := setup(t)
+	limiter, err := mwhttp.NewTenantLimiter(mwhttp.TenantLimitConfig{
+		On:                 true,
+		DefaultAccountTier: "account",
+		Tiers: []mwhttp.TenantLimitTier{
+			{Name: "account", RequestRate: 1, RequestBurst: 1, ByteRate: 4},
+			{Name: "bucket", RequestRate: 1, RequestBurst: 1, ByteRate: 8},
+		},
+		BucketTiers: []mwhttp.TenantTierCell{{Key: mockBucketName, Tier: "bucket"}},
+	})
+	assert.Nil(t, err)
+	g.tenantLimiter = limiter
+	return g
+}
+
+func TestGateModular_checkTenantRequest(t *testing.T) {
+	g := setupTenantLimit(t)
+	reqCtx := &RequestContext{ctx: context.Background(), account: "0xAccount"}
+	assert.Nil(t, g.checkTenantRequest(reqCtx))
+	assert.Equal(t, ErrAccountRequestRateExceeded, g.checkTenantRequest(reqCtx))
+
+	reqCtx = &RequestContext{ctx: context.Background(), bucketName: mockBucketName}
+	assert.Nil(t, g.checkTenantRequest(reqCtx))
+	assert.Equal(t, ErrBucketRequestRateExceeded, g.checkTenantRequest(reqCtx))
+
+	// the tenant limit is disabled if the limiter is not set
+	assert.Nil(t, setup(t).checkTenantRequest(reqCtx))
+}
+
+func TestTenantLimitReader(t *testing.T) {
+	g := setupTenantLimit(t)
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	reqCtx := &RequestContext{ctx: ctx, account: "0xAccount"}
+
+	// the burst of the account is 4 bytes, the remaining bytes can not be read before the deadline
+	r := g.newTenantLimitReader(reqCtx, bytes.NewReader([]byte("0123456789")))
+	data, err := io.ReadAll(io.LimitReader(r, 4))
+	assert.Nil(t, err)
+	assert.Equal(t, []byte("0123"), data)
+	_, err = io.ReadAll(r)
+	assert.Equal(t, ErrAccountByteRateExceeded, err)
+	assert.Equal(t, ErrAccountByteRateExceeded, r.failure(mockErr))
+
+	reqCtx = &RequestContext{ctx: ctx, bucketName: mockBucketName}
+	r = g.newTenantLimitReader(reqCtx, bytes.NewReader(make([]byte, 20)))
+	_, err = io.ReadAll(r)
+	assert.Equal(t, ErrBucketByteRateExceeded, err)
+
+	// the reader is passed through if the limiter is not set
+	r = setup(t).newTenantLimitReader(reqCtx, bytes.NewReader(make([]byte, 20)))
+	data, err = io.ReadAll(r)
+	assert.Nil(t, err)
+	assert.Equal(t, 20, len(data))
+	assert.Equal(t, mockErr, r.failure(mockErr))
+}
@@ -79,7 +79,7 @@ func (m *ManageModular) HandleCreateUploadObjectTask(ctx context.Context, task t
 		log.CtxErrorw(ctx, "failed to handle begin upload object due to task pointer dangling")
 		return ErrDanglingTask
 	}
-	if m.UploadingObjectNumber() >= m.maxUploadObjectNumber {
+	if m.UploadingObjectNumber() >= int(atomic.LoadInt64(&m.maxUploadObjectNumber)) {
 		log.CtxErrorw(ctx, "uploading object exceed", "uploading", m.uploadQueue.Len(),
 			"replicating", m.replicateQueue.Len(), "sealing", m.sealQueue.Len())
 		return ErrExceedTask
@@ -92,7 +92,7 @@ func (m *ManageModular) HandleCreateUploadObjectTask(ctx context.Context, task t
 		log.CtxErrorw(ctx, "failed to push upload object task to queue", "task_info", task.Info(), "error", err)
 		return err
 	}
-	if err := m.baseApp.GfSpDB().InsertUploadProgress(task.GetObjectInfo().Id.Uint64(), task.GetIsAgentUpload()); err != nil {
+	if err := m.baseApp.GfSpDBWithContext(ctx).InsertUploadProgress(task.GetObjectInfo().Id.Uint64(), task.GetIsAgentUpload()); err != nil {
 		if strings.Contains(err.Error(), "Duplicate entry") {
 			log.Infow("insert upload progress with duplicate entry", "task_info", task.Info())
 			return nil
@@ -117,7 +117,7 @@ func (m *ManageModular) HandleDoneUploadObjectTask(ctx context.Context, task tas
 	}
 	if task.Error() != nil {
 		go func() {
-			err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+			err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 				ObjectID:         task.GetObjectInfo().Id.Uint64(),
 				TaskState:        types.TaskState_TASK_STATE_UPLOAD_OBJECT_ERROR,
 				ErrorDescription: task.Error().Error(),
@@ -168,7 +168,7 @@ func (m *ManageModular) pickGVGAndReplicate(ctx context.Context, vgfID uint32, t
 	}
 	go m.backUpTask()
 	go func() {
-		err = m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+		err = m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:             task.GetObjectInfo().Id.Uint64(),
 			TaskState:            types.TaskState_TASK_STATE_REPLICATE_OBJECT_DOING,
 			GlobalVirtualGroupID: gvgMeta.ID,
@@ -188,7 +188,7 @@ func (m *ManageModular) HandleCreateResumableUploadObjectTask(ctx context.Contex
 		log.CtxErrorw(ctx, "failed to handle begin upload object due to task pointer dangling")
 		return ErrDanglingTask
 	}
-	if m.UploadingObjectNumber() >= m.maxUploadObjectNumber {
+	if m.UploadingObjectNumber() >= int(atomic.LoadInt64(&m.maxUploadObjectNumber)) {
 		log.CtxErrorw(ctx, "uploading object exceed", "uploading", m.uploadQueue.Len(),
 			"replicating", m.replicateQueue.Len(), "sealing", m.sealQueue.Len(), "resumable uploading", m.resumableUploadQueue.Len())
 		return ErrExceedTask
@@ -201,7 +201,7 @@ func (m *ManageModular) HandleCreateResumableUploadObjectTask(ctx context.Contex
 		log.CtxErrorw(ctx, "failed to push resumable upload object task to queue", "task_info", task.Info(), "error", err)
 		return err
 	}
-	if err := m.baseApp.GfSpDB().InsertUploadProgress(task.GetObjectInfo().Id.Uint64(), task.GetIsAgentUpload()); err != nil {
+	if err := m.baseApp.GfSpDBWithContext(ctx).InsertUploadProgress(task.GetObjectInfo().Id.Uint64(), task.GetIsAgentUpload()); err != nil {
 		if strings.Contains(err.Error(), "Duplicate entry") {
 			return nil
 		} else {
@@ -226,7 +226,7 @@ func (m *ManageModular) HandleDoneResumableUploadObjectTask(ctx context.Context,
 	}
 	if task.Error() != nil {
 		go func() error {
-			err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+			err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 				ObjectID:         task.GetObjectInfo().Id.Uint64(),
 				TaskState:        types.TaskState_TASK_STATE_UPLOAD_OBJECT_ERROR,
 				ErrorDescription: task.Error().Error(),
@@ -276,7 +276,7 @@ func (m *ManageModular) HandleDoneResumableUploadObjectTask(ctx context.Context,
 	}
 	go m.backUpTask()
 	go func() error {
-		err = m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+		err = m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:             task.GetObjectInfo().Id.Uint64(),
 			TaskState:            types.TaskState_TASK_STATE_REPLICATE_OBJECT_DOING,
 			GlobalVirtualGroupID: gvgMeta.ID,
@@ -297,6 +297,9 @@ func (m *ManageModular) HandleReplicatePieceTask(ctx context.Context, task task.
 		log.CtxErrorw(ctx, "failed to handle replicate piece due to pointer dangling")
 		return ErrDanglingTask
 	}
+	if outcomes := task.GetSPOutcomes(); len(outcomes) > 0 {
+		m.virtualGroupManager.ReportSPOutcomes(outcomes)
+	}
 	if task.Error() != nil {
 		log.CtxErrorw(ctx, "failed to replicate piece task", "task_info", task.Info(), "error", task.Error())
 		_ = m.handleFailedReplicatePieceTask(ctx, task)
@@ -317,23 +320,23 @@ func (m *ManageModular) HandleReplicatePieceTask(ctx context.Context, task task.
 	if task.GetSealed() {
 		task.AppendLog(fmt.Sprintf("manager-handle-succeed-replicate-task-retry:%d", task.GetRetry()))
 		go func() {
-			_ = m.baseApp.GfSpDB().InsertPutEvent(task)
+			_ = m.baseApp.GfSpDBWithContext(ctx).InsertPutEvent(task)
 			log.Debugw("replicate piece object task has combined seal object task", "task_info", task.Info())
-			if err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+			if err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 				ObjectID:  task.GetObjectInfo().Id.Uint64(),
 				TaskState: types.TaskState_TASK_STATE_SEAL_OBJECT_DONE,
 			}); err != nil {
 				log.Errorw("failed to update object task state", "task_info", task.Info(), "error", err)
 			}
 			log.Errorw("succeed to update object task state", "task_info", task.Info())
-			_ = m.baseApp.GfSpDB().DeleteUploadProgress(task.GetObjectInfo().Id.Uint64())
+			_ = m.baseApp.GfSpDBWithContext(ctx).DeleteUploadProgress(task.GetObjectInfo().Id.Uint64())
 
 			if task.GetIsAgentUpload() {
-				_ = m.baseApp.GfSpDB().DeleteReplicatePieceChecksumsByObjectID(task.GetObjectInfo().Id.Uint64())
+				_ = m.baseApp.GfSpDBWithContext(ctx).DeleteReplicatePieceChecksumsByObjectID(task.GetObjectInfo().Id.Uint64())
 			}
 
 			if task.GetObjectInfo().GetIsUpdating() {
-				shadowIntegrityMeta, err := m.baseApp.GfSpDB().GetShadowObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+				shadowIntegrityMeta, err := m.baseApp.GfSpDBWithContext(ctx).GetShadowObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 				if err != nil {
 					log.Debugw("get object integrity meta", "task_info", task.Info(), "error", err)
 					return
@@ -377,7 +380,7 @@ func (m *ManageModular) HandleReplicatePieceTask(ctx context.Context, task task.
 	}
 	go m.backUpTask()
 	go func() {
-		if err = m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+		if err = m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:             task.GetObjectInfo().Id.Uint64(),
 			TaskState:            types.TaskState_TASK_STATE_SEAL_OBJECT_DOING,
 			GlobalVirtualGroupID: task.GetGlobalVirtualGroupId(),
@@ -461,8 +464,8 @@ func (m *ManageModular) handleFailedReplicatePieceTask(ctx context.Context, hand
 		metrics.ManagerTime.WithLabelValues(ManagerCancelReplicate).Observe(
 			time.Since(time.Unix(handleTask.GetCreateTime(), 0)).Seconds())
 		go func() {
-			_ = m.baseApp.GfSpDB().InsertPutEvent(shadowTask)
-			if err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+			_ = m.baseApp.GfSpDBWithContext(ctx).InsertPutEvent(shadowTask)
+			if err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 				ObjectID:         handleTask.GetObjectInfo().Id.Uint64(),
 				TaskState:        types.TaskState_TASK_STATE_REPLICATE_OBJECT_ERROR,
 				ErrorDescription: "exceed_replicate_retry",
@@ -497,8 +500,8 @@ func (m *ManageModular) HandleSealObjectTask(ctx context.Context, task task.Seal
 	go func() {
 		m.sealQueue.PopByKey(task.Key())
 		task.AppendLog(fmt.Sprintf("manager-handle-succeed-seal-task-retry:%d", task.GetRetry()))
-		_ = m.baseApp.GfSpDB().InsertPutEvent(task)
-		if err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+		_ = m.baseApp.GfSpDBWithContext(ctx).InsertPutEvent(task)
+		if err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 			ObjectID:  task.GetObjectInfo().Id.Uint64(),
 			TaskState: types.TaskState_TASK_STATE_SEAL_OBJECT_DONE,
 		}); err != nil {
@@ -506,7 +509,7 @@ func (m *ManageModular) HandleSealObjectTask(ctx context.Context, task task.Seal
 			return
 		}
 		// delete this upload db record
-		_ = m.baseApp.GfSpDB().DeleteUploadProgress(task.GetObjectInfo().Id.Uint64())
+		_ = m.baseApp.GfSpDBWithContext(ctx).DeleteUploadProgress(task.GetObjectInfo().Id.Uint64())
 		log.Debugw("succeed to seal object on chain", "task_info", task.Info())
 	}()
 	return nil
@@ -533,12 +536,12 @@ func (m *ManageModular) handleFailedSealObjectTask(ctx context.Context, handleTa
 		return nil
 	} else {
 		shadowTask.AppendLog(fmt.Sprintf("manager-handle-failed-seal-task-error:%s-retry:%d", shadowTask.Error().Error(), handleTask.GetRetry()))
-		_ = m.baseApp.GfSpDB().InsertPutEvent(shadowTask)
+		_ = m.baseApp.GfSpDBWithContext(ctx).InsertPutEvent(shadowTask)
 		metrics.ManagerCounter.WithLabelValues(ManagerCancelSeal).Inc()
 		metrics.ManagerTime.WithLabelValues(ManagerCancelSeal).Observe(
 			time.Since(time.Unix(handleTask.GetCreateTime(), 0)).Seconds())
 		go func() {
-			if err := m.baseApp.GfSpDB().UpdateUploadProgress(&spdb.UploadObjectMeta{
+			if err := m.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&spdb.UploadObjectMeta{
 				ObjectID:         handleTask.GetObjectInfo().Id.Uint64(),
 				TaskState:        types.TaskState_TASK_STATE_SEAL_OBJECT_ERROR,
 				ErrorDescription: "exceed_seal_retry",
@@ -834,6 +837,9 @@ func (m *ManageModular) HandleRecoverPieceTask(ctx context.Context, task task.Re
 		log.CtxErrorw(ctx, "failed to handle recovery piece due to pointer dangling")
 		return ErrDanglingTask
 	}
+	if outcomes := task.GetSPOutcomes(); len(outcomes) > 0 {
+		m.virtualGroupManager.ReportSPOutcomes(outcomes)
+	}
 
 	if task.GetRecovered() {
 		m.recoveryQueue.PopByKey(task.Key())
@@ -82,7 +82,8 @@ type ManageModular struct {
 	migrateGVGQueue    taskqueue.TQueueOnStrategyWithLimit
 	migrateGVGQueueMux sync.Mutex
 
-	maxUploadObjectNumber int
+	maxUploadObjectNumber int64
+	gcConfigCh            chan *gcConfig
 
 	gcObjectTimeInterval  int
 	gcBlockHeight         uint64
@@ -144,6 +145,16 @@ type ManageModular struct {
 	rejectUnsealThresholdSecond uint64
 	taskRetryScheduler          *TaskRetryScheduler
 
+	enableScrubber      bool
+	scrubInterval       time.Duration
+	scrubBatchSize      uint32
+	scrubBandwidthLimit uint64
+	scrubSampleRate     uint32
+	scrubScheduler      *ScrubScheduler
+
+	taskLeases    *taskLeaseTable
+	taskScheduler TaskScheduler
+
 	spMonthlyFreeQuota uint64
 }
 
@@ -200,6 +211,7 @@ func (m *ManageModular) Start(ctx context.Context) error {
 		}
 	}
 	m.startTaskRetryScheduler()
+	m.startScrubScheduler(ctx)
 	go m.delayStartMigrateScheduler()
 	go m.eventLoop(ctx)
 	return nil
@@ -214,6 +226,15 @@ func (m *ManageModular) startTaskRetryScheduler() {
 	m.taskRetryScheduler.Start()
 }
 
+func (m *ManageModular) startScrubScheduler(ctx context.Context) {
+	if !m.enableScrubber {
+		log.Info("Skip to start scrub scheduler")
+		return
+	}
+	m.scrubScheduler = NewScrubScheduler(m)
+	go m.scrubScheduler.Start(ctx)
+}
+
 func (m *ManageModular) delayStartMigrateScheduler() {
 	// delay start to wait metadata service ready.
 	// migrate scheduler init depend metadata.
@@ -244,6 +265,7 @@ func (m *ManageModular) eventLoop(ctx context.Context) {
 	syncAvailableVGFTicker := time.NewTicker(time.Duration(m.syncAvailableVGFInterval) * time.Second)
 
 	backupTaskTicker := time.NewTicker(time.Duration(DefaultBackupTaskTimeout) * time.Second)
+	revokeTaskLeaseTicker := time.NewTicker(m.taskLeases.ttl / 3)
 	for {
 		select {
 		case <-ctx.Done():
@@ -254,6 +276,18 @@ func (m *ManageModular) eventLoop(ctx context.Context) {
 			m.syncConsensusInfo(ctx)
 		case <-backupTaskTicker.C:
 			m.backUpTask()
+		case <-revokeTaskLeaseTicker.C:
+			m.revokeExpiredTaskLeases(ctx)
+		case gc := <-m.gcConfigCh:
+			m.applyGCConfig(gc)
+			gcObjectTicker.Reset(time.Duration(m.gcObjectTimeInterval) * time.Second)
+			gcZombiePieceTicker.Reset(time.Duration(m.gcZombiePieceTimeInterval) * time.Second)
+			gcMetaTicker.Reset(time.Duration(m.gcMetaTimeInterval) * time.Second)
+			gcObjectStaleVersionPieceTicker.Reset(time.Duration(m.gcStaleVersionObjectTimeInterval) * time.Second)
+			gcExpiredOffChainAuthKeysTicker.Reset(time.Duration(m.gcExpiredOffChainAuthKeysTimeInterval) * time.Second)
+			log.CtxInfow(ctx, "succeed to apply gc config", "gc_object_interval", m.gcObjectTimeInterval,
+				"gc_zombie_enabled", m.gcZombiePieceEnabled, "gc_meta_enabled", m.gcMetaEnabled,
+				"gc_stale_version_object_enabled", m.gcStaleVersionObjectEnabled)
 		case <-gcObjectTicker.C:
 			start := m.gcBlockHeight
 			end := m.gcBlockHeight + m.gcObjectBlockInterval
@@ -909,14 +943,22 @@ func (m *ManageModular) backUpTask() {
 	m.backupTaskMux.Lock()
 	defer m.backupTaskMux.Unlock()
 
+	targetTask := m.pickUpTaskByLimit(context.Background(), &rcmgr.Unlimited{})
+	if targetTask != nil {
+		atomic.AddInt64(&m.backupTaskNum, 1)
+		m.taskCh <- targetTask
+	}
+}
+
+// pickUpTaskByLimit pops the top task that fits the limit from every queue, picks up one of them by the
+// priority and pushes the others back, the picked task is left out of its queue. It must be called with
+// the backupTaskMux held.
+func (m *ManageModular) pickUpTaskByLimit(ctx context.Context, limit rcmgr.Limit) task.Task {
 	startPopTime := time.Now().String()
 	var (
 		backupTasks   []task.Task
 		reservedTasks []task.Task
 		targetTask    task.Task
-
-		ctx   = context.Background()
-		limit = &rcmgr.Unlimited{}
 	)
 
 	targetTask = m.replicateQueue.PopByLimit(limit)
@@ -981,20 +1023,18 @@ func (m *ManageModular) backUpTask() {
 	endPopTime := time.Now().String()
 
 	startPickUpTime := time.Now().String()
-	targetTask, reservedTasks = m.PickUpTask(ctx, backupTasks)
+	targetTask, reservedTasks = m.taskScheduler.Pick(ctx, backupTasks)
 	if targetTask != nil {
 		targetTask.AppendLog("start-pop-task-from-queue:" + startPopTime)
 		targetTask.AppendLog("end-pop-task-from-queue:" + endPopTime)
 		targetTask.AppendLog("start-pickup-task-to-dispatch:" + startPickUpTime)
 		targetTask.AppendLog("end-pickup-task-to-dispatch")
-
-		atomic.AddInt64(&m.backupTaskNum, 1)
-		m.taskCh <- targetTask
 	}
 
 	for _, reservedTask := range reservedTasks {
 		m.repushTask(reservedTask)
 	}
+	return targetTask
 }
 
 func (m *ManageModular) repushTask(reserved task.Task) {
@@ -1086,13 +1126,17 @@ func (m *ManageModular) QueryTasksStats(_ context.Context) (uploadTasks int,
 	replicateCount = m.replicateQueue.Len()
 	sealCount = m.sealQueue.Len()
 	resumableUploadCount = m.resumableUploadQueue.Len()
-	maxUploadCount = m.maxUploadObjectNumber
+	maxUploadCount = int(atomic.LoadInt64(&m.maxUploadObjectNumber))
 	migrateGVGCount = m.migrateGVGQueue.Len()
 	recoveryProcessCount = len(m.recoveryTaskMap)
 	recoveryFailedList = m.recoveryFailedList
 	return
 }
 
+func (m *ManageModular) QueryTaskSchedulerStats(_ context.Context) *gfspserver.TaskSchedulerStats {
+	return m.taskScheduler.Stats()
+}
+
 func (m *ManageModular) QueryBucketMigrationProgress(_ context.Context, bucketID uint64) (*gfspserver.MigrateBucketProgressMeta, error) {
 	var (
 		progress      *spdb.MigrateBucketProgressMeta
@@ -1,6 +1,8 @@
 package manager
 
 import (
+	"time"
+
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -110,6 +112,18 @@ const (
 	DefaultSubscribeSwapOutEventIntervalMillisecond = 2000
 	// DefaultGCExpiredOffChainAuthKeysTimeInterval define the default time interval to gc expired off chain auth keys
 	DefaultGCExpiredOffChainAuthKeysTimeInterval = 24 * 3600
+
+	// DefaultScrubIntervalSecond defines the default interval between two scrub batches of one gvg.
+	DefaultScrubIntervalSecond uint64 = 60
+	// DefaultScrubBatchSize defines the default object number of one scrub batch.
+	DefaultScrubBatchSize uint32 = 50
+	// DefaultScrubBandwidthLimitBytes defines the default max bytes per second read by the scrubber.
+	DefaultScrubBandwidthLimitBytes uint64 = 16 * 1024 * 1024
+	// DefaultScrubSampleRate defines the default percentage of objects to verify, it means a full sweep.
+	DefaultScrubSampleRate uint32 = 100
+
+	// DefaultTaskLeaseTTLSecond defines the default seconds after which an unrenewed task lease is revoked.
+	DefaultTaskLeaseTTLSecond int64 = 30
 )
 
 const (
@@ -137,100 +151,7 @@ func NewManageModular(app *gfspapp.GfSpBaseApp, cfg *gfspconfig.GfSpConfig) (cor
 }
 
 func DefaultManagerOptions(manager *ManageModular, cfg *gfspconfig.GfSpConfig) (err error) {
-	if cfg.Parallel.GlobalMaxUploadingParallel == 0 {
-		cfg.Parallel.GlobalMaxUploadingParallel = DefaultGlobalMaxUploadingNumber
-	}
-	if cfg.Parallel.GlobalUploadObjectParallel == 0 {
-		cfg.Parallel.GlobalUploadObjectParallel = DefaultGlobalUploadObjectParallel
-	}
-	if cfg.Parallel.GlobalReplicatePieceParallel == 0 {
-		cfg.Parallel.GlobalReplicatePieceParallel = DefaultGlobalReplicatePieceParallel
-	}
-	if cfg.Parallel.GlobalSealObjectParallel == 0 {
-		cfg.Parallel.GlobalSealObjectParallel = DefaultGlobalSealObjectParallel
-	}
-	if cfg.Parallel.GlobalReceiveObjectParallel == 0 {
-		cfg.Parallel.GlobalReceiveObjectParallel = DefaultGlobalReceiveObjectParallel
-	}
-	if cfg.Parallel.GlobalGCObjectParallel == 0 {
-		cfg.Parallel.GlobalGCObjectParallel = DefaultGlobalGCObjectParallel
-	}
-	if cfg.Parallel.GlobalGCZombieParallel == 0 {
-		cfg.Parallel.GlobalGCZombieParallel = DefaultGlobalGCZombieParallel
-	}
-	if cfg.Parallel.GlobalGCMetaParallel == 0 {
-		cfg.Parallel.GlobalGCMetaParallel = DefaultGlobalGCMetaParallel
-	}
-	if cfg.Parallel.GlobalGCStaleVersionObjectParallel == 0 {
-		cfg.Parallel.GlobalGCStaleVersionObjectParallel = DefaultGlobalGCStaleVersionObjectParallel
-	}
-	if cfg.Parallel.GlobalGCBucketMigrationParallel == 0 {
-		cfg.Parallel.GlobalGCBucketMigrationParallel = DefaultGlobalGCBucketMigrationParallel
-	}
-	if cfg.Parallel.GlobalRecoveryPieceParallel == 0 {
-		cfg.Parallel.GlobalRecoveryPieceParallel = DefaultGlobalRecoveryPieceParallel
-	}
-	if cfg.Parallel.GlobalMigrateGVGParallel == 0 {
-		cfg.Parallel.GlobalMigrateGVGParallel = DefaultGlobalMigrateGVGParallel
-	}
-	if cfg.Parallel.GlobalBackupTaskParallel == 0 {
-		cfg.Parallel.GlobalBackupTaskParallel = DefaultGlobalBackupTaskParallel
-	}
-
-	if cfg.Parallel.GlobalDownloadObjectTaskCacheSize == 0 {
-		cfg.Parallel.GlobalDownloadObjectTaskCacheSize = DefaultGlobalDownloadObjectTaskCacheSize
-	}
-	if cfg.Parallel.GlobalChallengePieceTaskCacheSize == 0 {
-		cfg.Parallel.GlobalChallengePieceTaskCacheSize = DefaultGlobalChallengePieceTaskCacheSize
-	}
-
-	if cfg.GC.GCObjectTimeInterval == 0 {
-		cfg.GC.GCObjectTimeInterval = DefaultGlobalBatchGCObjectTimeInterval
-	}
-	if cfg.GC.GCObjectBlockInterval == 0 {
-		cfg.GC.GCObjectBlockInterval = DefaultGlobalGCObjectBlockInterval
-	}
-	if cfg.GC.GCObjectSafeBlockDistance == 0 {
-		cfg.GC.GCObjectSafeBlockDistance = DefaultGlobalGCObjectSafeBlockDistance
-	}
-
-	if cfg.GC.GCZombiePieceTimeInterval == 0 {
-		cfg.GC.GCZombiePieceTimeInterval = DefaultGlobalGCZombiePieceTimeInterval
-	}
-	if cfg.GC.GCZombiePieceObjectIDInterval == 0 {
-		cfg.GC.GCZombiePieceObjectIDInterval = DefaultGlobalGCZombiePieceObjectIDInterval
-	}
-	if cfg.GC.GCZombieSafeObjectIDDistance == 0 {
-		cfg.GC.GCZombieSafeObjectIDDistance = DefaultGlobalGCZombieSafeObjectIDDistance
-	}
-
-	if cfg.GC.GCMetaTimeInterval == 0 {
-		cfg.GC.GCMetaTimeInterval = DefaultGlobalGCMetaTimeInterval
-	}
-	if cfg.GC.GCStaleVersionTimeInterval == 0 {
-		cfg.GC.GCStaleVersionTimeInterval = DefaultGlobalGCStaleVersionObjectInterval
-	}
-	if cfg.Parallel.GlobalSyncConsensusInfoInterval == 0 {
-		cfg.Parallel.GlobalSyncConsensusInfoInterval = DefaultGlobalSyncConsensusInfoInterval
-	}
-	if cfg.Parallel.DiscontinueBucketTimeInterval == 0 {
-		cfg.Parallel.DiscontinueBucketTimeInterval = DefaultDiscontinueTimeInterval
-	}
-	if cfg.Parallel.DiscontinueBucketKeepAliveDays == 0 {
-		cfg.Parallel.DiscontinueBucketKeepAliveDays = DefaultDiscontinueBucketKeepAliveDays
-	}
-	if cfg.Parallel.GlobalRecoveryPieceParallel == 0 {
-		cfg.Parallel.GlobalRecoveryPieceParallel = DefaultGlobalRecoveryPieceParallel
-	}
-	if cfg.Parallel.LoadReplicateTimeout == 0 {
-		cfg.Parallel.LoadReplicateTimeout = DefaultLoadReplicateTimeout
-	}
-	if cfg.Parallel.LoadSealTimeout == 0 {
-		cfg.Parallel.LoadSealTimeout = DefaultLoadSealTimeout
-	}
-	if cfg.GC.GCExpiredOffChainAuthKeysTimeInterval == 0 {
-		cfg.GC.GCExpiredOffChainAuthKeysTimeInterval = DefaultGCExpiredOffChainAuthKeysTimeInterval
-	}
+	defaultManagerConfig(cfg)
 
 	manager.enableLoadTask = cfg.Manager.EnableLoadTask
 	manager.enableHealthyChecker = cfg.Manager.EnableHealthyChecker
@@ -240,7 +161,8 @@ func DefaultManagerOptions(manager *ManageModular, cfg *gfspconfig.GfSpConfig) (
 
 	manager.statisticsOutputInterval = DefaultStatisticsOutputInterval
 	manager.syncAvailableVGFInterval = DefaultSyncAvailableVGFInterval
-	manager.maxUploadObjectNumber = cfg.Parallel.GlobalMaxUploadingParallel
+	manager.maxUploadObjectNumber = int64(cfg.Parallel.GlobalMaxUploadingParallel)
+	manager.gcConfigCh = make(chan *gcConfig, 1)
 	manager.gcObjectTimeInterval = cfg.GC.GCObjectTimeInterval
 	manager.gcObjectBlockInterval = cfg.GC.GCObjectBlockInterval
 	manager.gcSafeBlockDistance = cfg.GC.GCObjectSafeBlockDistance
@@ -320,6 +242,32 @@ func DefaultManagerOptions(manager *ManageModular, cfg *gfspconfig.GfSpConfig) (
 
 	manager.enableBucketMigrateCache = cfg.Manager.EnableBucketMigrateCache
 
+	manager.enableScrubber = cfg.Manager.EnableScrubber
+	if cfg.Manager.ScrubIntervalSecond == 0 {
+		cfg.Manager.ScrubIntervalSecond = DefaultScrubIntervalSecond
+	}
+	manager.scrubInterval = time.Duration(cfg.Manager.ScrubIntervalSecond) * time.Second
+	if cfg.Manager.ScrubBatchSize == 0 {
+		cfg.Manager.ScrubBatchSize = DefaultScrubBatchSize
+	}
+	manager.scrubBatchSize = cfg.Manager.ScrubBatchSize
+	if cfg.Manager.ScrubBandwidthLimitBytes == 0 {
+		cfg.Manager.ScrubBandwidthLimitBytes = DefaultScrubBandwidthLimitBytes
+	}
+	manager.scrubBandwidthLimit = cfg.Manager.ScrubBandwidthLimitBytes
+	if cfg.Manager.ScrubSampleRate == 0 || cfg.Manager.ScrubSampleRate > 100 {
+		cfg.Manager.ScrubSampleRate = DefaultScrubSampleRate
+	}
+	manager.scrubSampleRate = cfg.Manager.ScrubSampleRate
+
+	if cfg.Manager.TaskLeaseTTLSecond <= 0 {
+		cfg.Manager.TaskLeaseTTLSecond = DefaultTaskLeaseTTLSecond
+	}
+	manager.taskLeases = newTaskLeaseTable(time.Duration(cfg.Manager.TaskLeaseTTLSecond) * time.Second)
+	if manager.taskScheduler, err = NewTaskScheduler(manager, &cfg.Manager.TaskScheduler); err != nil {
+		return err
+	}
+
 	if cfg.Quota.MonthlyFreeQuota == 0 {
 		manager.spMonthlyFreeQuota = gfspapp.DefaultSpMonthlyFreeQuota
 	} else {
@@ -328,3 +276,101 @@ func DefaultManagerOptions(manager *ManageModular, cfg *gfspconfig.GfSpConfig) (
 
 	return nil
 }
+
+// defaultManagerConfig fills the default values into the configs that used by manager.
+func defaultManagerConfig(cfg *gfspconfig.GfSpConfig) {
+	if cfg.Parallel.GlobalMaxUploadingParallel == 0 {
+		cfg.Parallel.GlobalMaxUploadingParallel = DefaultGlobalMaxUploadingNumber
+	}
+	if cfg.Parallel.GlobalUploadObjectParallel == 0 {
+		cfg.Parallel.GlobalUploadObjectParallel = DefaultGlobalUploadObjectParallel
+	}
+	if cfg.Parallel.GlobalReplicatePieceParallel == 0 {
+		cfg.Parallel.GlobalReplicatePieceParallel = DefaultGlobalReplicatePieceParallel
+	}
+	if cfg.Parallel.GlobalSealObjectParallel == 0 {
+		cfg.Parallel.GlobalSealObjectParallel = DefaultGlobalSealObjectParallel
+	}
+	if cfg.Parallel.GlobalReceiveObjectParallel == 0 {
+		cfg.Parallel.GlobalReceiveObjectParallel = DefaultGlobalReceiveObjectParallel
+	}
+	if cfg.Parallel.GlobalGCObjectParallel == 0 {
+		cfg.Parallel.GlobalGCObjectParallel = DefaultGlobalGCObjectParallel
+	}
+	if cfg.Parallel.GlobalGCZombieParallel == 0 {
+		cfg.Parallel.GlobalGCZombieParallel = DefaultGlobalGCZombieParallel
+	}
+	if cfg.Parallel.GlobalGCMetaParallel == 0 {
+		cfg.Parallel.GlobalGCMetaParallel = DefaultGlobalGCMetaParallel
+	}
+	if cfg.Parallel.GlobalGCStaleVersionObjectParallel == 0 {
+		cfg.Parallel.GlobalGCStaleVersionObjectParallel = DefaultGlobalGCStaleVersionObjectParallel
+	}
+	if cfg.Parallel.GlobalGCBucketMigrationParallel == 0 {
+		cfg.Parallel.GlobalGCBucketMigrationParallel = DefaultGlobalGCBucketMigrationParallel
+	}
+	if cfg.Parallel.GlobalRecoveryPieceParallel == 0 {
+		cfg.Parallel.GlobalRecoveryPieceParallel = DefaultGlobalRecoveryPieceParallel
+	}
+	if cfg.Parallel.GlobalMigrateGVGParallel == 0 {
+		cfg.Parallel.GlobalMigrateGVGParallel = DefaultGlobalMigrateGVGParallel
+	}
+	if cfg.Parallel.GlobalBackupTaskParallel == 0 {
+		cfg.Parallel.GlobalBackupTaskParallel = DefaultGlobalBackupTaskParallel
+	}
+
+	if cfg.Parallel.GlobalDownloadObjectTaskCacheSize == 0 {
+		cfg.Parallel.GlobalDownloadObjectTaskCacheSize = DefaultGlobalDownloadObjectTaskCacheSize
+	}
+	if cfg.Parallel.GlobalChallengePieceTaskCacheSize == 0 {
+		cfg.Parallel.GlobalChallengePieceTaskCacheSize = DefaultGlobalChallengePieceTaskCacheSize
+	}
+
+	if cfg.GC.GCObjectTimeInterval == 0 {
+		cfg.GC.GCObjectTimeInterval = DefaultGlobalBatchGCObjectTimeInterval
+	}
+	if cfg.GC.GCObjectBlockInterval == 0 {
+		cfg.GC.GCObjectBlockInterval = DefaultGlobalGCObjectBlockInterval
+	}
+	if cfg.GC.GCObjectSafeBlockDistance == 0 {
+		cfg.GC.GCObjectSafeBlockDistance = DefaultGlobalGCObjectSafeBlockDistance
+	}
+
+	if cfg.GC.GCZombiePieceTimeInterval == 0 {
+		cfg.GC.GCZombiePieceTimeInterval = DefaultGlobalGCZombiePieceTimeInterval
+	}
+	if cfg.GC.GCZombiePieceObjectIDInterval == 0 {
+		cfg.GC.GCZombiePieceObjectIDInterval = DefaultGlobalGCZombiePieceObjectIDInterval
+	}
+	if cfg.GC.GCZombieSafeObjectIDDistance == 0 {
+		cfg.GC.GCZombieSafeObjectIDDistance = DefaultGlobalGCZombieSafeObjectIDDistance
+	}
+
+	if cfg.GC.GCMetaTimeInterval == 0 {
+		cfg.GC.GCMetaTimeInterval = DefaultGlobalGCMetaTimeInterval
+	}
+	if cfg.GC.GCStaleVersionTimeInterval == 0 {
+		cfg.GC.GCStaleVersionTimeInterval = DefaultGlobalGCStaleVersionObjectInterval
+	}
+	if cfg.Parallel.GlobalSyncConsensusInfoInterval == 0 {
+		cfg.Parallel.GlobalSyncConsensusInfoInterval = DefaultGlobalSyncConsensusInfoInterval
+	}
+	if cfg.Parallel.DiscontinueBucketTimeInterval == 0 {
+		cfg.Parallel.DiscontinueBucketTimeInterval = DefaultDiscontinueTimeInterval
+	}
+	if cfg.Parallel.DiscontinueBucketKeepAliveDays == 0 {
+		cfg.Parallel.DiscontinueBucketKeepAliveDays = DefaultDiscontinueBucketKeepAliveDays
+	}
+	if cfg.Parallel.GlobalRecoveryPieceParallel == 0 {
+		cfg.Parallel.GlobalRecoveryPieceParallel = DefaultGlobalRecoveryPieceParallel
+	}
+	if cfg.Parallel.LoadReplicateTimeout == 0 {
+		cfg.Parallel.LoadReplicateTimeout = DefaultLoadReplicateTimeout
+	}
+	if cfg.Parallel.LoadSealTimeout == 0 {
+		cfg.Parallel.LoadSealTimeout = DefaultLoadSealTimeout
+	}
+	if cfg.GC.GCExpiredOffChainAuthKeysTimeInterval == 0 {
+		cfg.GC.GCExpiredOffChainAuthKeysTimeInterval = DefaultGCExpiredOffChainAuthKeysTimeInterval
+	}
+}
@@ -0,0 +1,148 @@
+package manager
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+var _ gfspapp.ReloadableModular = &ManageModular{}
+
+// gcConfig is the gc configs that applied by the event loop, the gc fields of manager are only
+// accessed by the event loop.
+type gcConfig struct {
+	objectTimeInterval  int
+	objectBlockInterval uint64
+	safeBlockDistance   uint64
+
+	zombieEnabled              bool
+	zombieTimeInterval         int
+	zombieObjectIDInterval     uint64
+	zombieSafeObjectIDDistance uint64
+
+	metaEnabled      bool
+	metaTimeInterval int
+
+	staleVersionObjectEnabled      bool
+	staleVersionObjectTimeInterval int
+
+	expiredOffChainAuthKeysEnabled      bool
+	expiredOffChainAuthKeysTimeInterval int
+}
+
+// ReloadableConfigs returns the configs that manager can apply at runtime.
+func (m *ManageModular) ReloadableConfigs() []string {
+	return []string{
+		"Parallel.GlobalMaxUploadingParallel",
+		"Parallel.GlobalUploadObjectParallel",
+		"Parallel.GlobalReplicatePieceParallel",
+		"Parallel.GlobalSealObjectParallel",
+		"Parallel.GlobalReceiveObjectParallel",
+		"Parallel.GlobalGCObjectParallel",
+		"Parallel.GlobalGCZombieParallel",
+		"Parallel.GlobalGCMetaParallel",
+		"Parallel.GlobalGCBucketMigrationParallel",
+		"Parallel.GlobalGCStaleVersionObjectParallel",
+		"Parallel.GlobalRecoveryPieceParallel",
+		"Parallel.GlobalMigrateGVGParallel",
+		"Parallel.GlobalDownloadObjectTaskCacheSize",
+		"Parallel.GlobalChallengePieceTaskCacheSize",
+		"GC",
+	}
+}
+
+// ReloadConfig updates the max uploading number, the capacities of task queues and the gc configs, the
+// tasks beyond the new capacities are kept until they are finished.
+func (m *ManageModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	limits := map[string]int{
+		"GlobalMaxUploadingParallel":            cfg.Parallel.GlobalMaxUploadingParallel,
+		"GlobalUploadObjectParallel":            cfg.Parallel.GlobalUploadObjectParallel,
+		"GlobalReplicatePieceParallel":          cfg.Parallel.GlobalReplicatePieceParallel,
+		"GlobalSealObjectParallel":              cfg.Parallel.GlobalSealObjectParallel,
+		"GlobalReceiveObjectParallel":           cfg.Parallel.GlobalReceiveObjectParallel,
+		"GlobalGCObjectParallel":                cfg.Parallel.GlobalGCObjectParallel,
+		"GlobalGCZombieParallel":                cfg.Parallel.GlobalGCZombieParallel,
+		"GlobalGCMetaParallel":                  cfg.Parallel.GlobalGCMetaParallel,
+		"GlobalGCBucketMigrationParallel":       cfg.Parallel.GlobalGCBucketMigrationParallel,
+		"GlobalGCStaleVersionObjectParallel":    cfg.Parallel.GlobalGCStaleVersionObjectParallel,
+		"GlobalRecoveryPieceParallel":           cfg.Parallel.GlobalRecoveryPieceParallel,
+		"GlobalMigrateGVGParallel":              cfg.Parallel.GlobalMigrateGVGParallel,
+		"GlobalDownloadObjectTaskCacheSize":     cfg.Parallel.GlobalDownloadObjectTaskCacheSize,
+		"GlobalChallengePieceTaskCacheSize":     cfg.Parallel.GlobalChallengePieceTaskCacheSize,
+		"GCObjectTimeInterval":                  cfg.GC.GCObjectTimeInterval,
+		"GCZombiePieceTimeInterval":             cfg.GC.GCZombiePieceTimeInterval,
+		"GCMetaTimeInterval":                    cfg.GC.GCMetaTimeInterval,
+		"GCStaleVersionTimeInterval":            cfg.GC.GCStaleVersionTimeInterval,
+		"GCExpiredOffChainAuthKeysTimeInterval": cfg.GC.GCExpiredOffChainAuthKeysTimeInterval,
+	}
+	for name, value := range limits {
+		if value < 0 {
+			return nil, fmt.Errorf("invalid %s %d", name, value)
+		}
+	}
+	defaultManagerConfig(cfg)
+	gc := &gcConfig{
+		objectTimeInterval:                  cfg.GC.GCObjectTimeInterval,
+		objectBlockInterval:                 cfg.GC.GCObjectBlockInterval,
+		safeBlockDistance:                   cfg.GC.GCObjectSafeBlockDistance,
+		zombieEnabled:                       cfg.GC.EnableGCZombie,
+		zombieTimeInterval:                  cfg.GC.GCZombiePieceTimeInterval,
+		zombieObjectIDInterval:              cfg.GC.GCZombiePieceObjectIDInterval,
+		zombieSafeObjectIDDistance:          cfg.GC.GCZombieSafeObjectIDDistance,
+		metaEnabled:                         cfg.GC.EnableGCMeta,
+		metaTimeInterval:                    cfg.GC.GCMetaTimeInterval,
+		staleVersionObjectEnabled:           cfg.GC.EnableGCStaleVersionObject,
+		staleVersionObjectTimeInterval:      cfg.GC.GCStaleVersionTimeInterval,
+		expiredOffChainAuthKeysEnabled:      cfg.GC.EnableGCExpiredOffChainAuthKeys,
+		expiredOffChainAuthKeysTimeInterval: cfg.GC.GCExpiredOffChainAuthKeysTimeInterval,
+	}
+	return func() {
+		atomic.StoreInt64(&m.maxUploadObjectNumber, int64(cfg.Parallel.GlobalMaxUploadingParallel))
+		m.uploadQueue.SetCap(cfg.Parallel.GlobalUploadObjectParallel)
+		m.resumableUploadQueue.SetCap(cfg.Parallel.GlobalUploadObjectParallel)
+		m.replicateQueue.SetCap(cfg.Parallel.GlobalReplicatePieceParallel)
+		m.sealQueue.SetCap(cfg.Parallel.GlobalSealObjectParallel)
+		m.receiveQueue.SetCap(cfg.Parallel.GlobalReceiveObjectParallel)
+		m.gcObjectQueue.SetCap(cfg.Parallel.GlobalGCObjectParallel)
+		m.gcZombieQueue.SetCap(cfg.Parallel.GlobalGCZombieParallel)
+		m.gcMetaQueue.SetCap(cfg.Parallel.GlobalGCMetaParallel)
+		m.gcBucketMigrationQueue.SetCap(cfg.Parallel.GlobalGCBucketMigrationParallel)
+		m.gcStaleVersionObjectQueue.SetCap(cfg.Parallel.GlobalGCStaleVersionObjectParallel)
+		m.recoveryQueue.SetCap(cfg.Parallel.GlobalRecoveryPieceParallel)
+		m.migrateGVGQueue.SetCap(cfg.Parallel.GlobalMigrateGVGParallel)
+		m.downloadQueue.SetCap(cfg.Parallel.GlobalDownloadObjectTaskCacheSize)
+		m.challengeQueue.SetCap(cfg.Parallel.GlobalChallengePieceTaskCacheSize)
+		m.updateGCConfig(gc)
+	}, nil
+}
+
+// updateGCConfig passes the gc configs to the event loop, the pending one that is not applied is replaced.
+func (m *ManageModular) updateGCConfig(gc *gcConfig) {
+	select {
+	case <-m.gcConfigCh:
+	default:
+	}
+	select {
+	case m.gcConfigCh <- gc:
+	default:
+	}
+}
+
+// applyGCConfig is called by the event loop.
+func (m *ManageModular) applyGCConfig(gc *gcConfig) {
+	m.gcObjectTimeInterval = gc.objectTimeInterval
+	m.gcObjectBlockInterval = gc.objectBlockInterval
+	m.gcSafeBlockDistance = gc.safeBlockDistance
+	m.gcZombiePieceEnabled = gc.zombieEnabled
+	m.gcZombiePieceTimeInterval = gc.zombieTimeInterval
+	m.gcZombiePieceObjectIDInterval = gc.zombieObjectIDInterval
+	m.gcZombiePieceSafeObjectIDDistance = gc.zombieSafeObjectIDDistance
+	m.gcMetaEnabled = gc.metaEnabled
+	m.gcMetaTimeInterval = gc.metaTimeInterval
+	m.gcStaleVersionObjectEnabled = gc.staleVersionObjectEnabled
+	m.gcStaleVersionObjectTimeInterval = gc.staleVersionObjectTimeInterval
+	m.gcExpiredOffChainAuthKeysEnabled = gc.expiredOffChainAuthKeysEnabled
+	m.gcExpiredOffChainAuthKeysTimeInterval = gc.expiredOffChainAuthKeysTimeInterval
+}
@@ -0,0 +1,60 @@
+package manager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+func TestManageModular_ReloadConfig(t *testing.T) {
+	manage := setup(t)
+	manage.gcConfigCh = make(chan *gcConfig, 1)
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Parallel.GlobalMaxUploadingParallel = 10
+	cfg.Parallel.GlobalSealObjectParallel = 20
+	cfg.GC.GCObjectTimeInterval = 30
+	cfg.GC.EnableGCMeta = true
+	apply, err := manage.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(0), manage.maxUploadObjectNumber)
+	apply()
+	assert.Equal(t, int64(10), manage.maxUploadObjectNumber)
+	assert.Equal(t, 20, manage.sealQueue.Cap())
+	assert.Equal(t, DefaultGlobalReplicatePieceParallel, manage.replicateQueue.Cap())
+
+	// the gc configs are applied by the event loop.
+	assert.Equal(t, 2, manage.gcObjectTimeInterval)
+	manage.applyGCConfig(<-manage.gcConfigCh)
+	assert.Equal(t, 30, manage.gcObjectTimeInterval)
+	assert.True(t, manage.gcMetaEnabled)
+	assert.Equal(t, DefaultGlobalGCZombiePieceTimeInterval, manage.gcZombiePieceTimeInterval)
+}
+
+func TestManageModular_ReloadConfigReplacePendingGC(t *testing.T) {
+	manage := setup(t)
+	manage.gcConfigCh = make(chan *gcConfig, 1)
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.GC.GCObjectTimeInterval = 30
+	apply, err := manage.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	apply()
+	cfg = &gfspconfig.GfSpConfig{}
+	cfg.GC.GCObjectTimeInterval = 40
+	apply, err = manage.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	apply()
+	manage.applyGCConfig(<-manage.gcConfigCh)
+	assert.Equal(t, 40, manage.gcObjectTimeInterval)
+	assert.Len(t, manage.gcConfigCh, 0)
+}
+
+func TestManageModular_ReloadConfigFailure(t *testing.T) {
+	manage := setup(t)
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.GC.GCMetaTimeInterval = -1
+	_, err := manage.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
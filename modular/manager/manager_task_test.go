@@ -4,6 +4,7 @@ import (
 	"context"
 	"errors"
 	"testing"
+	"time"
 
 	sdkmath "cosmossdk.io/math"
 	types2 "github.com/bnb-chain/greenfield/x/sp/types"
@@ -17,6 +18,7 @@ import (
 	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
 	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
 	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
 	"github.com/bnb-chain/greenfield-storage-provider/core/vgmgr"
 )
 
@@ -211,6 +213,32 @@ func TestManageModular_HandleReplicatePieceTask(t *testing.T) {
 	assert.Equal(t, nil, err)
 }
 
+func TestManageModular_HandleReplicatePieceTaskReportSPOutcomes(t *testing.T) {
+	m := setup(t)
+	ctrl := gomock.NewController(t)
+	m.replicateQueue = gfsptqueue.NewGfSpTQueueWithLimit("test", 2)
+	db := spdb.NewMockSPDB(ctrl)
+	m.baseApp.SetGfSpDB(db)
+	db.EXPECT().InsertPutEvent(gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().UpdateUploadProgress(gomock.Any()).Return(nil).AnyTimes()
+	db.EXPECT().DeleteUploadProgress(gomock.Any()).Return(nil).AnyTimes()
+
+	outcomes := []*task.SPOutcome{{Endpoint: "sp1", Type: task.SPOutcomeReplicate, Requests: 2, Size: 10, Cost: time.Second}}
+	vgm := vgmgr.NewMockVirtualGroupManager(ctrl)
+	m.virtualGroupManager = vgm
+	vgm.EXPECT().ReportSPOutcomes(outcomes).Times(1)
+
+	replicatePieceTask := &gfsptask.GfSpReplicatePieceTask{
+		ObjectInfo:    &types0.ObjectInfo{Id: sdkmath.NewUint(1)},
+		Task:          &gfsptask.GfSpTask{TaskPriority: 1},
+		StorageParams: &types0.Params{},
+		Sealed:        true,
+	}
+	replicatePieceTask.SetSPOutcomes(outcomes)
+	err := m.HandleReplicatePieceTask(context.TODO(), replicatePieceTask)
+	assert.Equal(t, nil, err)
+}
+
 func TestManageModular_HandleFailedReplicatePieceTask(t *testing.T) {
 	m := setup(t)
 	ctrl := gomock.NewController(t)
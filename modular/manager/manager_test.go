@@ -59,7 +59,9 @@ func setup(t *testing.T) *ManageModular {
 		spID:                                  1,
 		gcExpiredOffChainAuthKeysEnabled:      true,
 		gcExpiredOffChainAuthKeysTimeInterval: 300,
+		taskLeases:                            newTaskLeaseTable(time.Minute),
 	}
+	manager.taskScheduler = &priorityScheduler{manager: manager, window: newDispatchWindow(DefaultTaskSchedulerWindowSize)}
 
 	return manager
 }
@@ -427,6 +429,14 @@ func TestManageModular_QueryTasksStats(t *testing.T) {
 	assert.Equal(t, 0, recoveryProcessCount)
 }
 
+func TestManageModular_QueryTaskSchedulerStats(t *testing.T) {
+	manage := setup(t)
+	stats := manage.QueryTaskSchedulerStats(context.TODO())
+	assert.Equal(t, TaskSchedulerPolicyPriority, stats.GetPolicy())
+	assert.Equal(t, DefaultTaskSchedulerWindowSize, stats.GetWindowSize())
+	assert.Empty(t, stats.GetShares())
+}
+
 func TestManageModular_RejectUnSealObject(t *testing.T) {
 	manage := setup(t)
 	ctrl := gomock.NewController(t)
@@ -0,0 +1,292 @@
+package manager
+
+import (
+	"bytes"
+	"context"
+	"encoding/binary"
+	"errors"
+	"hash/fnv"
+	"time"
+
+	"golang.org/x/time/rate"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+const (
+	scrubResultHealthy      = "healthy"
+	scrubResultCorrupted    = "corrupted"
+	scrubResultMissing      = "missing"
+	scrubResultNoIntegrity  = "no_integrity"
+	scrubScopePrimary       = "primary"
+	scrubScopeSecondary     = "secondary"
+	scrubSampleRateFullScan = 100
+)
+
+// scrubTarget is a gvg and the redundancy index of the pieces that the SP stores for it, the primary SP
+// stores the segment pieces with piecestore.PrimarySPRedundancyIndex.
+type scrubTarget struct {
+	gvgID           uint32
+	redundancyIndex int32
+}
+
+// ScrubScheduler is used to continuously verify the pieces stored by the SP. It sweeps the objects of every
+// gvg that the SP serves as primary or secondary, re-reads the pieces from the piece store, compares their
+// checksums with the integrity meta and enqueues recovery piece tasks for the corrupt or missing ones. The
+// sweep cursors are persisted in the sp db, so the sweep continues from where it stopped after restarting.
+type ScrubScheduler struct {
+	manager    *ManageModular
+	limiter    *rate.Limiter
+	interval   time.Duration
+	batchSize  uint32
+	sampleRate uint32
+	targets    map[scrubTarget]struct{}
+}
+
+// NewScrubScheduler returns a scrub scheduler instance.
+func NewScrubScheduler(m *ManageModular) *ScrubScheduler {
+	return &ScrubScheduler{
+		manager:    m,
+		limiter:    rate.NewLimiter(rate.Limit(m.scrubBandwidthLimit), int(m.scrubBandwidthLimit)),
+		interval:   m.scrubInterval,
+		batchSize:  m.scrubBatchSize,
+		sampleRate: m.scrubSampleRate,
+		targets:    make(map[scrubTarget]struct{}),
+	}
+}
+
+// Start is used to start the scrub scheduler, it scrubs one batch of every gvg per interval until the ctx is done.
+func (s *ScrubScheduler) Start(ctx context.Context) {
+	log.Infow("scrub scheduler startup", "interval", s.interval, "batch_size", s.batchSize,
+		"sample_rate", s.sampleRate, "bandwidth_limit", s.limiter.Limit())
+	ticker := time.NewTicker(s.interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			s.scrubRound(ctx)
+		}
+	}
+}
+
+func (s *ScrubScheduler) scrubRound(ctx context.Context) {
+	storageParams, err := s.manager.baseApp.Consensus().QueryStorageParams(ctx)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to query storage params", "error", err)
+		return
+	}
+	targets, err := s.listScrubTargets(ctx)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list scrub targets", "error", err)
+		return
+	}
+	for target := range s.targets {
+		if _, ok := targets[target]; ok {
+			continue
+		}
+		// the sp does not serve the gvg anymore, e.g. swapped out or the gvg is deleted
+		if err = s.manager.baseApp.GfSpDB().DeleteScrubCursor(target.gvgID, target.redundancyIndex); err != nil {
+			log.CtxErrorw(ctx, "failed to delete scrub cursor", "gvg_id", target.gvgID,
+				"redundancy_index", target.redundancyIndex, "error", err)
+		}
+	}
+	s.targets = targets
+	for target := range targets {
+		if ctx.Err() != nil {
+			return
+		}
+		if err = s.scrubBatch(ctx, storageParams, target); err != nil {
+			log.CtxErrorw(ctx, "failed to scrub gvg", "gvg_id", target.gvgID,
+				"redundancy_index", target.redundancyIndex, "error", err)
+		}
+	}
+}
+
+// listScrubTargets returns the gvgs in which the SP is the primary or a secondary.
+func (s *ScrubScheduler) listScrubTargets(ctx context.Context) (map[scrubTarget]struct{}, error) {
+	spID, err := s.manager.getSPID()
+	if err != nil {
+		return nil, err
+	}
+	targets := make(map[scrubTarget]struct{})
+	families, err := s.manager.baseApp.GfSpClient().ListVirtualGroupFamiliesSpID(ctx, spID)
+	if err != nil {
+		return nil, err
+	}
+	for _, family := range families {
+		for _, gvgID := range family.GetGlobalVirtualGroupIds() {
+			targets[scrubTarget{gvgID: gvgID, redundancyIndex: piecestore.PrimarySPRedundancyIndex}] = struct{}{}
+		}
+	}
+	gvgs, err := s.manager.baseApp.GfSpClient().ListGlobalVirtualGroupsBySecondarySP(ctx, spID)
+	if err != nil {
+		return nil, err
+	}
+	for _, gvg := range gvgs {
+		for idx, secondarySPID := range gvg.GetSecondarySpIds() {
+			if secondarySPID == spID {
+				targets[scrubTarget{gvgID: gvg.GetId(), redundancyIndex: int32(idx)}] = struct{}{}
+			}
+		}
+	}
+	return targets, nil
+}
+
+// scrubBatch scrubs the next batch of objects of the target and advances its cursor, the cursor wraps to the
+// beginning of the gvg and starts the next round once all objects have been swept.
+func (s *ScrubScheduler) scrubBatch(ctx context.Context, storageParams *storagetypes.Params, target scrubTarget) error {
+	cursor, err := s.manager.baseApp.GfSpDB().GetScrubCursor(target.gvgID, target.redundancyIndex)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		cursor = &spdb.ScrubCursor{VirtualGroupID: target.gvgID, RedundancyIndex: target.redundancyIndex}
+	} else if err != nil {
+		return err
+	}
+	objects, err := s.manager.baseApp.GfSpClient().ListObjectsInGVG(ctx, target.gvgID, cursor.StartAfter, s.batchSize)
+	if err != nil {
+		return err
+	}
+	if len(objects) == 0 {
+		log.CtxInfow(ctx, "finished to scrub gvg", "gvg_id", target.gvgID, "redundancy_index", target.redundancyIndex,
+			"round", cursor.Round, "scrubbed_objects", cursor.ScrubbedObjects, "corrupted_pieces", cursor.CorruptedPieces)
+		cursor.StartAfter = 0
+		cursor.Round++
+		cursor.UpdateTime = time.Now().Unix()
+		return s.manager.baseApp.GfSpDB().UpdateScrubCursor(cursor)
+	}
+
+	var scrubErr error
+	for _, object := range objects {
+		objectInfo := object.GetObject().GetObjectInfo()
+		if objectInfo.GetObjectStatus() == storagetypes.OBJECT_STATUS_SEALED && s.sampled(objectInfo.Id.Uint64(), cursor.Round) {
+			corrupted, err := s.scrubObject(ctx, storageParams, target, objectInfo)
+			cursor.CorruptedPieces += uint64(corrupted)
+			if err != nil {
+				// keep the cursor before the object, it is scrubbed again in the next batch
+				scrubErr = err
+				break
+			}
+			cursor.ScrubbedObjects++
+		}
+		cursor.StartAfter = objectInfo.Id.Uint64()
+	}
+	cursor.UpdateTime = time.Now().Unix()
+	if err = s.manager.baseApp.GfSpDB().UpdateScrubCursor(cursor); err != nil {
+		return err
+	}
+	return scrubErr
+}
+
+// sampled returns whether the object is verified in the round, a different sample of objects is picked in
+// every round, so that all objects are verified over the rounds.
+func (s *ScrubScheduler) sampled(objectID uint64, round uint64) bool {
+	if s.sampleRate >= scrubSampleRateFullScan {
+		return true
+	}
+	var buf [16]byte
+	binary.BigEndian.PutUint64(buf[:8], objectID)
+	binary.BigEndian.PutUint64(buf[8:], round)
+	h := fnv.New64a()
+	_, _ = h.Write(buf[:])
+	return h.Sum64()%scrubSampleRateFullScan < uint64(s.sampleRate)
+}
+
+// scrubObject verifies all pieces of the object stored by the SP, and returns the number of bad pieces.
+func (s *ScrubScheduler) scrubObject(ctx context.Context, storageParams *storagetypes.Params, target scrubTarget,
+	objectInfo *storagetypes.ObjectInfo) (uint32, error) {
+	objectID := objectInfo.Id.Uint64()
+	scope := scrubScopeSecondary
+	if target.redundancyIndex == piecestore.PrimarySPRedundancyIndex {
+		scope = scrubScopePrimary
+	}
+	integrity, err := s.manager.baseApp.GfSpDB().GetObjectIntegrity(objectID, target.redundancyIndex)
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		// without the integrity meta the pieces can not be verified or recovered against it
+		log.CtxErrorw(ctx, "failed to scrub object, integrity meta not exist", "object_id", objectID,
+			"redundancy_index", target.redundancyIndex)
+		metrics.ScrubPieceCounter.WithLabelValues(scrubResultNoIntegrity).Inc()
+		return 0, nil
+	} else if err != nil {
+		return 0, err
+	}
+
+	maxSegmentSize := storageParams.GetMaxSegmentSize()
+	segmentCount := segmentPieceCount(objectInfo.GetPayloadSize(), maxSegmentSize)
+	var corrupted uint32
+	for segmentIdx := uint32(0); segmentIdx < segmentCount; segmentIdx++ {
+		var pieceKey string
+		if target.redundancyIndex == piecestore.PrimarySPRedundancyIndex {
+			pieceKey = s.manager.baseApp.PieceOp().SegmentPieceKey(objectID, segmentIdx, objectInfo.GetVersion())
+		} else {
+			pieceKey = s.manager.baseApp.PieceOp().ECPieceKey(objectID, segmentIdx, uint32(target.redundancyIndex),
+				objectInfo.GetVersion())
+		}
+		result := scrubResultHealthy
+		data, err := s.manager.baseApp.PieceStore().GetPiece(ctx, pieceKey, 0, -1)
+		if err != nil {
+			result = scrubResultMissing
+		} else {
+			if err = s.waitBandwidth(ctx, len(data)); err != nil {
+				return corrupted, err
+			}
+			metrics.ScrubPieceSizeCounter.WithLabelValues(scope).Add(float64(len(data)))
+			if int(segmentIdx) >= len(integrity.PieceChecksumList) ||
+				!bytes.Equal(hash.GenerateChecksum(data), integrity.PieceChecksumList[segmentIdx]) {
+				result = scrubResultCorrupted
+			}
+		}
+		metrics.ScrubPieceCounter.WithLabelValues(result).Inc()
+		if result == scrubResultHealthy {
+			continue
+		}
+		corrupted++
+		log.CtxErrorw(ctx, "scrubbed a bad piece", "object_id", objectID, "segment_idx", segmentIdx,
+			"redundancy_index", target.redundancyIndex, "result", result, "error", err)
+		if err = s.recoverPiece(ctx, storageParams, target, objectInfo, segmentIdx); err != nil {
+			return corrupted, err
+		}
+	}
+	return corrupted, nil
+}
+
+// waitBandwidth blocks until the read bytes are allowed by the bandwidth budget.
+func (s *ScrubScheduler) waitBandwidth(ctx context.Context, n int) error {
+	for n > 0 {
+		chunk := n
+		if chunk > s.limiter.Burst() {
+			chunk = s.limiter.Burst()
+		}
+		if err := s.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+func (s *ScrubScheduler) recoverPiece(ctx context.Context, storageParams *storagetypes.Params, target scrubTarget,
+	objectInfo *storagetypes.ObjectInfo, segmentIdx uint32) error {
+	task := &gfsptask.GfSpRecoverPieceTask{}
+	task.InitRecoverPieceTask(objectInfo, storageParams, coretask.DefaultSmallerPriority, segmentIdx,
+		target.redundancyIndex, storageParams.GetMaxSegmentSize(), MaxRecoveryTime, maxRecoveryRetry)
+	task.SetGVGID(target.gvgID)
+	err := s.manager.HandleRecoverPieceTask(ctx, task)
+	if errors.Is(err, ErrRepeatedTask) {
+		return nil
+	}
+	if errors.Is(err, gfsptqueue.ErrTaskQueueExceed) {
+		log.CtxErrorw(ctx, "recovery queue exceeds, pause scrubbing", "object_id", objectInfo.Id.Uint64(),
+			"segment_idx", segmentIdx, "redundancy_index", target.redundancyIndex)
+	}
+	return err
+}
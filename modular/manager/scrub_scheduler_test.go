@@ -0,0 +1,117 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspclient"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/core/consensus"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+	virtualgrouptypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
+)
+
+func setupScrubScheduler(t *testing.T, sampleRate uint32) *ScrubScheduler {
+	m := setup(t)
+	m.recoveryQueue = gfsptqueue.NewGfSpTQueueWithLimit("test", 10)
+	m.recoveryTaskMap = make(map[string]string)
+	m.scrubInterval = time.Duration(DefaultScrubIntervalSecond) * time.Second
+	m.scrubBatchSize = DefaultScrubBatchSize
+	m.scrubBandwidthLimit = DefaultScrubBandwidthLimitBytes
+	m.scrubSampleRate = sampleRate
+	return NewScrubScheduler(m)
+}
+
+func TestScrubScheduler_ScrubRound(t *testing.T) {
+	s := setupScrubScheduler(t, DefaultScrubSampleRate)
+	s.targets[scrubTarget{gvgID: 4, redundancyIndex: piecestore.PrimarySPRedundancyIndex}] = struct{}{}
+	ctrl := gomock.NewController(t)
+
+	con := consensus.NewMockConsensus(ctrl)
+	con.EXPECT().QueryStorageParams(gomock.Any()).Return(&storagetypes.Params{
+		VersionedParams: storagetypes.VersionedParams{MaxSegmentSize: 10},
+	}, nil).Times(1)
+	s.manager.baseApp.SetConsensus(con)
+
+	spClient := gfspclient.NewMockGfSpClientAPI(ctrl)
+	spClient.EXPECT().ListVirtualGroupFamiliesSpID(gomock.Any(), uint32(1)).Return(
+		[]*virtualgrouptypes.GlobalVirtualGroupFamily{{Id: 1, PrimarySpId: 1, GlobalVirtualGroupIds: []uint32{2}}}, nil).Times(1)
+	spClient.EXPECT().ListGlobalVirtualGroupsBySecondarySP(gomock.Any(), uint32(1)).Return(
+		[]*virtualgrouptypes.GlobalVirtualGroup{{Id: 3, PrimarySpId: 5, SecondarySpIds: []uint32{6, 1}}}, nil).Times(1)
+	spClient.EXPECT().ListObjectsInGVG(gomock.Any(), uint32(2), uint64(0), DefaultScrubBatchSize).Return(
+		[]*types.ObjectDetails{
+			{Object: &types.Object{ObjectInfo: &storagetypes.ObjectInfo{Id: sdkmath.NewUint(8), PayloadSize: 20,
+				ObjectStatus: storagetypes.OBJECT_STATUS_CREATED}}},
+			{Object: &types.Object{ObjectInfo: &storagetypes.ObjectInfo{Id: sdkmath.NewUint(10), PayloadSize: 20,
+				ObjectStatus: storagetypes.OBJECT_STATUS_SEALED}}},
+		}, nil).Times(1)
+	spClient.EXPECT().ListObjectsInGVG(gomock.Any(), uint32(3), uint64(20), DefaultScrubBatchSize).Return(
+		[]*types.ObjectDetails{}, nil).Times(1)
+	s.manager.baseApp.SetGfSpClient(spClient)
+
+	pieceOp := piecestore.NewMockPieceOp(ctrl)
+	pieceOp.EXPECT().SegmentPieceKey(uint64(10), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(objectID uint64, segmentIdx uint32, version int64) string {
+			return fmt.Sprintf("s%d_s%d", objectID, segmentIdx)
+		}).Times(2)
+	s.manager.baseApp.SetPieceOp(pieceOp)
+
+	pieceStore := piecestore.NewMockPieceStore(ctrl)
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "s10_s0", int64(0), int64(-1)).Return([]byte("healthy"), nil).Times(1)
+	pieceStore.EXPECT().GetPiece(gomock.Any(), "s10_s1", int64(0), int64(-1)).Return([]byte("corrupted"), nil).Times(1)
+	s.manager.baseApp.SetPieceStore(pieceStore)
+
+	db := spdb.NewMockSPDB(ctrl)
+	db.EXPECT().DeleteScrubCursor(uint32(4), int32(piecestore.PrimarySPRedundancyIndex)).Return(nil).Times(1)
+	db.EXPECT().GetScrubCursor(uint32(2), int32(piecestore.PrimarySPRedundancyIndex)).Return(nil, gorm.ErrRecordNotFound).Times(1)
+	db.EXPECT().GetScrubCursor(uint32(3), int32(1)).Return(&spdb.ScrubCursor{VirtualGroupID: 3, RedundancyIndex: 1,
+		StartAfter: 20, Round: 2}, nil).Times(1)
+	db.EXPECT().GetObjectIntegrity(uint64(10), int32(piecestore.PrimarySPRedundancyIndex)).Return(&spdb.IntegrityMeta{
+		PieceChecksumList: [][]byte{hash.GenerateChecksum([]byte("healthy")), hash.GenerateChecksum([]byte("original"))},
+	}, nil).Times(1)
+	cursors := make(map[uint32]*spdb.ScrubCursor)
+	db.EXPECT().UpdateScrubCursor(gomock.Any()).DoAndReturn(func(cursor *spdb.ScrubCursor) error {
+		cursors[cursor.VirtualGroupID] = cursor
+		return nil
+	}).Times(2)
+	s.manager.baseApp.SetGfSpDB(db)
+
+	s.scrubRound(context.Background())
+
+	assert.Equal(t, 2, len(s.targets))
+	assert.Equal(t, uint64(10), cursors[2].StartAfter)
+	assert.Equal(t, uint64(0), cursors[2].Round)
+	assert.Equal(t, uint64(1), cursors[2].ScrubbedObjects)
+	assert.Equal(t, uint64(1), cursors[2].CorruptedPieces)
+	assert.Equal(t, uint64(0), cursors[3].StartAfter)
+	assert.Equal(t, uint64(3), cursors[3].Round)
+	assert.Equal(t, 1, s.manager.recoveryQueue.Len())
+}
+
+func TestScrubScheduler_Sampled(t *testing.T) {
+	full := setupScrubScheduler(t, DefaultScrubSampleRate)
+	half := setupScrubScheduler(t, 50)
+	var sampled, changed int
+	for objectID := uint64(0); objectID < 1000; objectID++ {
+		assert.True(t, full.sampled(objectID, 0))
+		if half.sampled(objectID, 0) {
+			sampled++
+		}
+		if half.sampled(objectID, 0) != half.sampled(objectID, 1) {
+			changed++
+		}
+	}
+	assert.InDelta(t, 500, sampled, 100)
+	assert.Greater(t, changed, 0)
+}
@@ -0,0 +1,219 @@
+package manager
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/util"
+)
+
+// taskLease records a task that is leased to a dispatch stream session of an executor.
+type taskLease struct {
+	id        string
+	sessionID string
+	task      task.Task
+	// updateTime is the update time of the task set by the lease, the task is only expired on revoking
+	// if it is not dispatched again since then.
+	updateTime int64
+	// retry is the retry counter of the task counted by the lease, it is given back if the lease is rejected.
+	retry    int64
+	expireAt time.Time
+}
+
+// taskLeaseTable holds the task leases of the streaming executors, a lease is revoked if the executor does
+// not renew it within the ttl.
+type taskLeaseTable struct {
+	mux    sync.Mutex
+	ttl    time.Duration
+	prefix string
+	seq    uint64
+	leases map[string]*taskLease
+}
+
+func newTaskLeaseTable(ttl time.Duration) *taskLeaseTable {
+	return &taskLeaseTable{
+		ttl: ttl,
+		// the lease ids of different manager processes never collide, so the stale lease ids reported by the
+		// executors after the manager restarts are ignored
+		prefix: fmt.Sprintf("%x", time.Now().UnixNano()),
+		leases: make(map[string]*taskLease),
+	}
+}
+
+func (l *taskLeaseTable) grant(sessionID string, t task.Task) *taskLease {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	l.seq++
+	lease := &taskLease{
+		id:         fmt.Sprintf("%s-%d", l.prefix, l.seq),
+		sessionID:  sessionID,
+		task:       t,
+		updateTime: t.GetUpdateTime(),
+		retry:      t.GetRetry(),
+		expireAt:   time.Now().Add(l.ttl),
+	}
+	l.leases[lease.id] = lease
+	return lease
+}
+
+// renew extends the leases of the session, and returns the new expire time and the ids that are not leased
+// to the session.
+func (l *taskLeaseTable) renew(sessionID string, ids []string) (time.Time, []string) {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	var (
+		now      = time.Now()
+		expireAt = now.Add(l.ttl)
+		unknown  []string
+	)
+	for _, id := range ids {
+		lease, ok := l.leases[id]
+		if !ok || lease.sessionID != sessionID {
+			unknown = append(unknown, id)
+			continue
+		}
+		lease.expireAt = expireAt
+		if lease.task.GetUpdateTime() == lease.updateTime {
+			// keep the task from being dispatched again by the timeout while the executor is alive
+			lease.updateTime = now.Unix()
+			lease.task.SetUpdateTime(lease.updateTime)
+		}
+	}
+	return expireAt, unknown
+}
+
+// remove deletes the leases of the session, all the leases of the session are deleted if ids is empty.
+func (l *taskLeaseTable) remove(sessionID string, ids []string) []*taskLease {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	var removed []*taskLease
+	if len(ids) == 0 {
+		for id, lease := range l.leases {
+			if lease.sessionID == sessionID {
+				removed = append(removed, lease)
+				delete(l.leases, id)
+			}
+		}
+		return removed
+	}
+	for _, id := range ids {
+		if lease, ok := l.leases[id]; ok && lease.sessionID == sessionID {
+			removed = append(removed, lease)
+			delete(l.leases, id)
+		}
+	}
+	return removed
+}
+
+// removeExpired deletes and returns the leases that expire before now.
+func (l *taskLeaseTable) removeExpired(now time.Time) []*taskLease {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	var expired []*taskLease
+	for id, lease := range l.leases {
+		if lease.expireAt.Before(now) {
+			expired = append(expired, lease)
+			delete(l.leases, id)
+		}
+	}
+	return expired
+}
+
+func (l *taskLeaseTable) len() int {
+	l.mux.Lock()
+	defer l.mux.Unlock()
+	return len(l.leases)
+}
+
+// LeaseTask picks up a task that fits the limit advertised by the executor and leases it to the executor,
+// it returns nil task if there is no suitable task. Unlike DispatchTask, it never blocks and the task that
+// exceeds the limit is left in its queue.
+func (m *ManageModular) LeaseTask(ctx context.Context, sessionID string, limit rcmgr.Limit) (task.Task, string, int64, error) {
+	var leaseTask task.Task
+	// the tasks that are backed up for the polling executors are taken first
+	select {
+	case backupTask := <-m.taskCh:
+		atomic.AddInt64(&m.backupTaskNum, -1)
+		if limit.NotLess(backupTask.EstimateLimit()) {
+			leaseTask = backupTask
+		} else {
+			m.repushTask(backupTask)
+		}
+	default:
+	}
+	if leaseTask == nil {
+		m.backupTaskMux.Lock()
+		leaseTask = m.pickUpTaskByLimit(ctx, limit)
+		m.backupTaskMux.Unlock()
+	}
+	if leaseTask == nil {
+		return nil, "", 0, nil
+	}
+	leaseTask.IncRetry()
+	leaseTask.SetError(nil)
+	leaseTask.SetUpdateTime(time.Now().Unix())
+	leaseTask.SetAddress(util.GetRPCRemoteAddress(ctx))
+	m.repushTask(leaseTask)
+	lease := m.taskLeases.grant(sessionID, leaseTask)
+	log.CtxDebugw(ctx, "lease task to executor", "session_id", sessionID, "lease_id", lease.id,
+		"executor_limit", limit.String(), "key_info", leaseTask.Info())
+	return leaseTask, lease.id, lease.expireAt.Unix(), nil
+}
+
+// RenewTaskLeases extends the leases that are held by the executor, and returns the new expire time and the
+// lease ids that are not held by the executor any more.
+func (m *ManageModular) RenewTaskLeases(ctx context.Context, sessionID string, leaseIDs []string) (int64, []string) {
+	expireAt, revoked := m.taskLeases.renew(sessionID, leaseIDs)
+	if len(revoked) != 0 {
+		log.CtxWarnw(ctx, "executor renews revoked task leases", "session_id", sessionID, "lease_ids", revoked)
+	}
+	return expireAt.Unix(), revoked
+}
+
+// ReleaseTaskLeases deletes the leases of the tasks that are finished by the executor, the results of the
+// tasks are reported by ReportTask.
+func (m *ManageModular) ReleaseTaskLeases(ctx context.Context, sessionID string, leaseIDs []string) {
+	released := m.taskLeases.remove(sessionID, leaseIDs)
+	log.CtxDebugw(ctx, "release task leases", "session_id", sessionID, "released", len(released))
+}
+
+// RevokeTaskLeases deletes the leases of the session, all the leases are revoked if leaseIDs is empty. The
+// revoked tasks are expired at once, so they are dispatched again or retired by the queue strategies.
+func (m *ManageModular) RevokeTaskLeases(ctx context.Context, sessionID string, leaseIDs []string) {
+	m.expireTaskLeases(ctx, m.taskLeases.remove(sessionID, leaseIDs))
+}
+
+// RejectTaskLeases deletes the leases that are rejected by the executor before it starts the tasks, e.g. it
+// can not reserve the resources, the retries counted by LeaseTask are given back unless the tasks have been
+// dispatched again, then the tasks are expired at once like RevokeTaskLeases.
+func (m *ManageModular) RejectTaskLeases(ctx context.Context, sessionID string, leaseIDs []string) {
+	leases := m.taskLeases.remove(sessionID, leaseIDs)
+	for _, lease := range leases {
+		if lease.task.GetUpdateTime() == lease.updateTime && lease.task.GetRetry() == lease.retry {
+			lease.task.SetRetry(lease.retry - 1)
+		}
+	}
+	m.expireTaskLeases(ctx, leases)
+}
+
+func (m *ManageModular) revokeExpiredTaskLeases(ctx context.Context) {
+	m.expireTaskLeases(ctx, m.taskLeases.removeExpired(time.Now()))
+}
+
+func (m *ManageModular) expireTaskLeases(ctx context.Context, leases []*taskLease) {
+	for _, lease := range leases {
+		if lease.task.GetUpdateTime() != lease.updateTime {
+			// the task has been dispatched again
+			continue
+		}
+		lease.task.SetUpdateTime(time.Now().Unix() - lease.task.GetTimeout() - 1)
+		log.CtxInfow(ctx, "revoke task lease", "session_id", lease.sessionID, "lease_id", lease.id,
+			"key_info", lease.task.Info())
+	}
+}
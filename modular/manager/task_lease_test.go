@@ -0,0 +1,186 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsplimit"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupTaskLease(t *testing.T, ttl time.Duration) *ManageModular {
+	m := setup(t)
+	m.sealQueue = gfsptqueue.NewGfSpTQueueWithLimit("test-seal", 4)
+	// the leased task that stays in the queue is not picked up again until it times out
+	m.sealQueue.SetFilterTaskStrategy(m.FilterUploadingTask)
+	m.taskCh = make(chan task.Task, 4)
+	m.taskLeases = newTaskLeaseTable(ttl)
+	return m
+}
+
+func mockLeaseSealTask(objectID uint64) *gfsptask.GfSpSealObjectTask {
+	return &gfsptask.GfSpSealObjectTask{
+		ObjectInfo: &storagetypes.ObjectInfo{
+			Id:         sdkmath.NewUint(objectID),
+			BucketName: "test",
+			ObjectName: "test",
+		},
+		Task: &gfsptask.GfSpTask{
+			TaskPriority: 1,
+			Timeout:      60,
+			MaxRetry:     3,
+		},
+		StorageParams:        &storagetypes.Params{},
+		GlobalVirtualGroupId: 1,
+	}
+}
+
+func TestManageModular_LeaseTask(t *testing.T) {
+	m := setupTaskLease(t, time.Minute)
+	ctx := context.Background()
+	sealTask := mockLeaseSealTask(1)
+	assert.Nil(t, m.sealQueue.Push(sealTask))
+
+	// the task exceeds the limit and is left in the queue
+	leased, leaseID, _, err := m.LeaseTask(ctx, "executor-1", &gfsplimit.GfSpLimit{})
+	assert.Nil(t, err)
+	assert.Nil(t, leased)
+	assert.Equal(t, "", leaseID)
+	assert.True(t, m.sealQueue.Has(sealTask.Key()))
+
+	leased, leaseID, expireTime, err := m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+	assert.Nil(t, err)
+	assert.Equal(t, sealTask, leased)
+	assert.NotEqual(t, "", leaseID)
+	assert.Greater(t, expireTime, time.Now().Unix())
+	assert.Equal(t, int64(1), sealTask.GetRetry())
+	// the leased task stays in its queue until it is reported
+	assert.True(t, m.sealQueue.Has(sealTask.Key()))
+	assert.Equal(t, 1, m.taskLeases.len())
+}
+
+func TestManageModular_LeaseBackupTask(t *testing.T) {
+	m := setupTaskLease(t, time.Minute)
+	ctx := context.Background()
+	sealTask := mockLeaseSealTask(1)
+	assert.Nil(t, m.sealQueue.Push(sealTask))
+	m.backUpTask()
+	assert.Equal(t, int64(1), m.backupTaskNum)
+	assert.False(t, m.sealQueue.Has(sealTask.Key()))
+
+	// the backup task that exceeds the limit is pushed back into its queue instead of the backup channel
+	leased, _, _, err := m.LeaseTask(ctx, "executor-1", &gfsplimit.GfSpLimit{})
+	assert.Nil(t, err)
+	assert.Nil(t, leased)
+	assert.Equal(t, int64(0), m.backupTaskNum)
+	assert.True(t, m.sealQueue.Has(sealTask.Key()))
+
+	m.backUpTask()
+	leased, _, _, err = m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+	assert.Nil(t, err)
+	assert.Equal(t, sealTask, leased)
+	assert.Equal(t, int64(0), m.backupTaskNum)
+	assert.True(t, m.sealQueue.Has(sealTask.Key()))
+}
+
+func TestManageModular_RenewAndReleaseTaskLeases(t *testing.T) {
+	m := setupTaskLease(t, time.Minute)
+	ctx := context.Background()
+	sealTask := mockLeaseSealTask(1)
+	assert.Nil(t, m.sealQueue.Push(sealTask))
+	_, leaseID, _, err := m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+	assert.Nil(t, err)
+
+	sealTask.SetUpdateTime(sealTask.GetUpdateTime() - 10)
+	m.taskLeases.leases[leaseID].updateTime = sealTask.GetUpdateTime()
+	expireTime, revoked := m.RenewTaskLeases(ctx, "executor-1", []string{leaseID, "unknown"})
+	assert.Greater(t, expireTime, time.Now().Unix())
+	assert.Equal(t, []string{"unknown"}, revoked)
+	// the heartbeat keeps the task from timing out
+	assert.GreaterOrEqual(t, sealTask.GetUpdateTime(), time.Now().Unix()-1)
+
+	// the lease is only renewed or released by its holder
+	_, revoked = m.RenewTaskLeases(ctx, "executor-2", []string{leaseID})
+	assert.Equal(t, []string{leaseID}, revoked)
+	m.ReleaseTaskLeases(ctx, "executor-2", []string{leaseID})
+	assert.Equal(t, 1, m.taskLeases.len())
+
+	m.ReleaseTaskLeases(ctx, "executor-1", []string{leaseID})
+	assert.Equal(t, 0, m.taskLeases.len())
+	assert.False(t, sealTask.ExceedTimeout())
+}
+
+func TestManageModular_RevokeTaskLeases(t *testing.T) {
+	m := setupTaskLease(t, time.Minute)
+	ctx := context.Background()
+	task1, task2, task3 := mockLeaseSealTask(1), mockLeaseSealTask(2), mockLeaseSealTask(3)
+	var leaseIDs []string
+	for _, sealTask := range []*gfsptask.GfSpSealObjectTask{task1, task2, task3} {
+		assert.Nil(t, m.sealQueue.Push(sealTask))
+		_, leaseID, _, err := m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+		assert.Nil(t, err)
+		leaseIDs = append(leaseIDs, leaseID)
+	}
+
+	m.RevokeTaskLeases(ctx, "executor-1", []string{leaseIDs[0]})
+	assert.True(t, task1.ExceedTimeout())
+	assert.False(t, task2.ExceedTimeout())
+	assert.Equal(t, 2, m.taskLeases.len())
+
+	// the task that has been dispatched again is not expired by the revoked lease
+	task2.SetUpdateTime(task2.GetUpdateTime() + 1)
+	m.RevokeTaskLeases(ctx, "executor-1", nil)
+	assert.False(t, task2.ExceedTimeout())
+	assert.True(t, task3.ExceedTimeout())
+	assert.Equal(t, 0, m.taskLeases.len())
+}
+
+func TestManageModular_RejectTaskLeases(t *testing.T) {
+	m := setupTaskLease(t, time.Minute)
+	ctx := context.Background()
+	task1, task2 := mockLeaseSealTask(1), mockLeaseSealTask(2)
+	var leaseIDs []string
+	for _, sealTask := range []*gfsptask.GfSpSealObjectTask{task1, task2} {
+		assert.Nil(t, m.sealQueue.Push(sealTask))
+		_, leaseID, _, err := m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+		assert.Nil(t, err)
+		assert.Equal(t, int64(1), sealTask.GetRetry())
+		leaseIDs = append(leaseIDs, leaseID)
+	}
+
+	// the rejected task does not burn a retry
+	m.RejectTaskLeases(ctx, "executor-1", []string{leaseIDs[0]})
+	assert.Equal(t, int64(0), task1.GetRetry())
+	assert.True(t, task1.ExceedTimeout())
+	assert.Equal(t, 1, m.taskLeases.len())
+
+	// the retry of the task that has been dispatched again is kept
+	task2.IncRetry()
+	task2.SetUpdateTime(task2.GetUpdateTime() + 1)
+	m.RejectTaskLeases(ctx, "executor-1", []string{leaseIDs[1]})
+	assert.Equal(t, int64(2), task2.GetRetry())
+	assert.False(t, task2.ExceedTimeout())
+	assert.Equal(t, 0, m.taskLeases.len())
+}
+
+func TestManageModular_RevokeExpiredTaskLeases(t *testing.T) {
+	m := setupTaskLease(t, time.Millisecond)
+	ctx := context.Background()
+	sealTask := mockLeaseSealTask(1)
+	assert.Nil(t, m.sealQueue.Push(sealTask))
+	_, _, _, err := m.LeaseTask(ctx, "executor-1", &rcmgr.Unlimited{})
+	assert.Nil(t, err)
+
+	time.Sleep(5 * time.Millisecond)
+	m.revokeExpiredTaskLeases(ctx)
+	assert.Equal(t, 0, m.taskLeases.len())
+	assert.True(t, sealTask.ExceedTimeout())
+}
@@ -0,0 +1,267 @@
+package manager
+
+import (
+	"context"
+	"net/http"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// TaskSchedulerPolicyPriority picks up the task randomly by the task priority.
+	TaskSchedulerPolicyPriority = "priority"
+	// TaskSchedulerPolicyWFQ picks up the task by the weighted fair queuing across the task types.
+	TaskSchedulerPolicyWFQ = "wfq"
+
+	// DefaultTaskSchedulerWindowSize defines the default number of the latest dispatched tasks that the shares
+	// are computed over.
+	DefaultTaskSchedulerWindowSize uint32 = 1000
+	// DefaultTaskSchedulerAgingSecond defines the default seconds after which the weight of a task type that
+	// is not dispatched is doubled.
+	DefaultTaskSchedulerAgingSecond int64 = 60
+)
+
+var ErrUnknownTaskSchedulerPolicy = gfsperrors.Register(module.ManageModularName, http.StatusInternalServerError, 60007, "unknown task scheduler policy")
+
+// defaultTaskTypeSchedulerConfigs defines the default weights and min shares of the task types for wfq, the
+// uploading tasks have the user-visible latency and are guaranteed a share against the background tasks.
+var defaultTaskTypeSchedulerConfigs = []gfspconfig.TaskTypeSchedulerConfig{
+	{TaskType: task.TaskTypeName(task.TypeTaskReplicatePiece), Weight: 8, MinSharePercent: 20},
+	{TaskType: task.TaskTypeName(task.TypeTaskSealObject), Weight: 8, MinSharePercent: 20},
+	{TaskType: task.TaskTypeName(task.TypeTaskReceivePiece), Weight: 4},
+	{TaskType: task.TaskTypeName(task.TypeTaskRecoverPiece), Weight: 2},
+	{TaskType: task.TaskTypeName(task.TypeTaskMigrateGVG), Weight: 2},
+	{TaskType: task.TaskTypeName(task.TypeTaskGCObject), Weight: 1},
+	{TaskType: task.TaskTypeName(task.TypeTaskGCZombiePiece), Weight: 1},
+	{TaskType: task.TaskTypeName(task.TypeTaskGCMeta), Weight: 1},
+	{TaskType: task.TaskTypeName(task.TypeTaskGCBucketMigration), Weight: 1},
+	{TaskType: task.TaskTypeName(task.TypeTaskGCStaleVersionObject), Weight: 1},
+}
+
+// TaskScheduler picks up the task to dispatch among the candidates that are popped from the task queues, one
+// candidate at most from each queue.
+type TaskScheduler interface {
+	// Pick returns the picked task and the other candidates that should be pushed back to their queues.
+	Pick(ctx context.Context, candidates []task.Task) (task.Task, []task.Task)
+	// Stats returns the policy and the dispatch shares of the task types.
+	Stats() *gfspserver.TaskSchedulerStats
+}
+
+// NewTaskScheduler returns the task scheduler of the policy in the config.
+func NewTaskScheduler(m *ManageModular, cfg *gfspconfig.TaskSchedulerConfig) (TaskScheduler, error) {
+	windowSize := cfg.WindowSize
+	if windowSize == 0 {
+		windowSize = DefaultTaskSchedulerWindowSize
+	}
+	switch strings.ToLower(cfg.Policy) {
+	case "", TaskSchedulerPolicyPriority:
+		return &priorityScheduler{manager: m, window: newDispatchWindow(windowSize)}, nil
+	case TaskSchedulerPolicyWFQ:
+		aging := cfg.AgingSecond
+		if aging == 0 {
+			aging = DefaultTaskSchedulerAgingSecond
+		}
+		return newWFQScheduler(cfg.TaskTypes, time.Duration(aging)*time.Second, windowSize), nil
+	default:
+		log.Errorw("unknown task scheduler policy", "policy", cfg.Policy)
+		return nil, ErrUnknownTaskSchedulerPolicy
+	}
+}
+
+// dispatchWindow records the task types of the latest dispatched tasks.
+type dispatchWindow struct {
+	ring       []string
+	next       int
+	full       bool
+	dispatched map[string]uint32
+}
+
+func newDispatchWindow(size uint32) *dispatchWindow {
+	return &dispatchWindow{ring: make([]string, size), dispatched: make(map[string]uint32)}
+}
+
+func (w *dispatchWindow) add(taskType string) {
+	if w.full {
+		w.dispatched[w.ring[w.next]]--
+	}
+	w.ring[w.next] = taskType
+	w.dispatched[taskType]++
+	w.next++
+	if w.next == len(w.ring) {
+		w.next, w.full = 0, true
+	}
+}
+
+func (w *dispatchWindow) total() uint32 {
+	if w.full {
+		return uint32(len(w.ring))
+	}
+	return uint32(w.next)
+}
+
+func (w *dispatchWindow) share(taskType string) *gfspserver.TaskTypeShare {
+	share := &gfspserver.TaskTypeShare{TaskType: taskType, Dispatched: w.dispatched[taskType]}
+	if total := w.total(); total != 0 {
+		share.SharePercent = float64(share.Dispatched) * 100 / float64(total)
+	}
+	return share
+}
+
+// priorityScheduler picks up the task randomly by the task priority.
+type priorityScheduler struct {
+	manager *ManageModular
+	mux     sync.Mutex
+	window  *dispatchWindow
+}
+
+func (s *priorityScheduler) Pick(ctx context.Context, candidates []task.Task) (task.Task, []task.Task) {
+	picked, reserved := s.manager.PickUpTask(ctx, candidates)
+	if picked != nil {
+		s.mux.Lock()
+		s.window.add(task.TaskTypeName(picked.Type()))
+		s.mux.Unlock()
+	}
+	return picked, reserved
+}
+
+func (s *priorityScheduler) Stats() *gfspserver.TaskSchedulerStats {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stats := &gfspserver.TaskSchedulerStats{Policy: TaskSchedulerPolicyPriority, WindowSize: uint32(len(s.window.ring))}
+	for taskType := range s.window.dispatched {
+		stats.Shares = append(stats.Shares, s.window.share(taskType))
+	}
+	sort.Slice(stats.Shares, func(i, j int) bool { return stats.Shares[i].GetTaskType() < stats.Shares[j].GetTaskType() })
+	return stats
+}
+
+// wfqScheduler picks up the task by the weighted fair queuing across the task types. The task type that is
+// below its min share over the window is picked first, otherwise the task type with the least dispatched
+// tasks per weight is picked. The weight of a task type grows with the time it is not dispatched, so that
+// the task type with a small weight is never starved.
+type wfqScheduler struct {
+	mux        sync.Mutex
+	weights    map[string]uint32
+	minShares  map[string]uint32
+	aging      time.Duration
+	window     *dispatchWindow
+	lastPicked map[string]time.Time
+	now        func() time.Time
+	taskTypes  []string
+}
+
+func newWFQScheduler(taskTypes []gfspconfig.TaskTypeSchedulerConfig, aging time.Duration, windowSize uint32) *wfqScheduler {
+	s := &wfqScheduler{
+		weights:    make(map[string]uint32),
+		minShares:  make(map[string]uint32),
+		aging:      aging,
+		window:     newDispatchWindow(windowSize),
+		lastPicked: make(map[string]time.Time),
+		now:        time.Now,
+	}
+	for _, cfgs := range [][]gfspconfig.TaskTypeSchedulerConfig{defaultTaskTypeSchedulerConfigs, taskTypes} {
+		for _, cfg := range cfgs {
+			s.weights[cfg.TaskType] = cfg.Weight
+			s.minShares[cfg.TaskType] = cfg.MinSharePercent
+		}
+	}
+	for taskType, weight := range s.weights {
+		if weight == 0 {
+			s.weights[taskType] = 1
+		}
+		s.taskTypes = append(s.taskTypes, taskType)
+	}
+	sort.Strings(s.taskTypes)
+	return s
+}
+
+func (s *wfqScheduler) weight(taskType string) uint32 {
+	if weight, ok := s.weights[taskType]; ok {
+		return weight
+	}
+	return 1
+}
+
+// agedWeight returns the weight of the task type that grows by the base weight every aging interval since
+// the task type was dispatched last time.
+func (s *wfqScheduler) agedWeight(taskType string, now time.Time) float64 {
+	weight := float64(s.weight(taskType))
+	if s.aging <= 0 {
+		return weight
+	}
+	return weight * (1 + float64(now.Sub(s.lastPicked[taskType]))/float64(s.aging))
+}
+
+func (s *wfqScheduler) Pick(ctx context.Context, candidates []task.Task) (task.Task, []task.Task) {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	now := s.now()
+	var (
+		picked     = -1
+		pickedType string
+		// deficit is the number of the dispatched tasks that the picked task type is below its min share
+		deficit float64
+		// cost is the dispatched tasks per aged weight of the picked task type
+		cost float64
+	)
+	for i, t := range candidates {
+		if t.GetPriority() == task.UnSchedulingPriority {
+			continue
+		}
+		taskType := task.TaskTypeName(t.Type())
+		if _, ok := s.lastPicked[taskType]; !ok {
+			// the task type starts aging when it has tasks to dispatch for the first time
+			s.lastPicked[taskType] = now
+		}
+		dispatched := float64(s.window.dispatched[taskType])
+		taskDeficit := float64(s.minShares[taskType])*float64(s.window.total())/100 - dispatched
+		taskCost := (dispatched + 1) / s.agedWeight(taskType, now)
+		if picked >= 0 {
+			var better bool
+			switch {
+			case taskDeficit > 0 || deficit > 0:
+				better = taskDeficit > deficit
+			case taskCost == cost:
+				better = t.GetPriority() > candidates[picked].GetPriority()
+			default:
+				better = taskCost < cost
+			}
+			if !better {
+				continue
+			}
+		}
+		picked, pickedType, deficit, cost = i, taskType, taskDeficit, taskCost
+	}
+	if picked < 0 {
+		return nil, candidates
+	}
+	target := candidates[picked]
+	reserved := append(append([]task.Task{}, candidates[:picked]...), candidates[picked+1:]...)
+	s.window.add(pickedType)
+	s.lastPicked[pickedType] = now
+	target.AppendLog("pickup-to-backup-task-pool")
+	log.CtxDebugw(ctx, "pick up task by wfq", "task_type", pickedType, "deficit", deficit, "cost", cost)
+	return target, reserved
+}
+
+func (s *wfqScheduler) Stats() *gfspserver.TaskSchedulerStats {
+	s.mux.Lock()
+	defer s.mux.Unlock()
+	stats := &gfspserver.TaskSchedulerStats{Policy: TaskSchedulerPolicyWFQ, WindowSize: uint32(len(s.window.ring))}
+	for _, taskType := range s.taskTypes {
+		share := s.window.share(taskType)
+		share.Weight = s.weight(taskType)
+		share.MinSharePercent = s.minShares[taskType]
+		stats.Shares = append(stats.Shares, share)
+	}
+	return stats
+}
@@ -0,0 +1,133 @@
+package manager
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/core/task"
+)
+
+func mockSchedulerTasks() (sealTask, recoverTask, gcTask task.Task) {
+	sealTask = mockLeaseSealTask(1)
+	recoverTask = &gfsptask.GfSpRecoverPieceTask{Task: &gfsptask.GfSpTask{TaskPriority: 1}}
+	gcTask = &gfsptask.GfSpGCObjectTask{Task: &gfsptask.GfSpTask{TaskPriority: 1}}
+	return sealTask, recoverTask, gcTask
+}
+
+// pickTimes picks up the task among the same candidates for times and returns the dispatched shares.
+func pickTimes(s TaskScheduler, times int, step time.Duration, clock *time.Time, candidates ...task.Task) map[string]uint32 {
+	for i := 0; i < times; i++ {
+		picked, reserved := s.Pick(context.Background(), append([]task.Task{}, candidates...))
+		if picked == nil || len(reserved) != len(candidates)-1 {
+			return nil
+		}
+		*clock = clock.Add(step)
+	}
+	dispatched := make(map[string]uint32)
+	for _, share := range s.Stats().GetShares() {
+		dispatched[share.GetTaskType()] = share.GetDispatched()
+	}
+	return dispatched
+}
+
+func newTestWFQScheduler(taskTypes []gfspconfig.TaskTypeSchedulerConfig, aging time.Duration) (*wfqScheduler, *time.Time) {
+	s := newWFQScheduler(taskTypes, aging, 100)
+	clock := time.Unix(0, 0)
+	s.now = func() time.Time { return clock }
+	return s, &clock
+}
+
+func TestNewTaskScheduler(t *testing.T) {
+	m := setup(t)
+	s, err := NewTaskScheduler(m, &gfspconfig.TaskSchedulerConfig{})
+	assert.Nil(t, err)
+	assert.Equal(t, TaskSchedulerPolicyPriority, s.Stats().GetPolicy())
+	assert.Equal(t, DefaultTaskSchedulerWindowSize, s.Stats().GetWindowSize())
+
+	s, err = NewTaskScheduler(m, &gfspconfig.TaskSchedulerConfig{
+		Policy:    "WFQ",
+		TaskTypes: []gfspconfig.TaskTypeSchedulerConfig{{TaskType: "RecoverPieceTask", Weight: 5, MinSharePercent: 10}},
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, TaskSchedulerPolicyWFQ, s.Stats().GetPolicy())
+	for _, share := range s.Stats().GetShares() {
+		if share.GetTaskType() == "RecoverPieceTask" {
+			assert.Equal(t, uint32(5), share.GetWeight())
+			assert.Equal(t, uint32(10), share.GetMinSharePercent())
+		}
+	}
+
+	_, err = NewTaskScheduler(m, &gfspconfig.TaskSchedulerConfig{Policy: "unknown"})
+	assert.Equal(t, ErrUnknownTaskSchedulerPolicy, err)
+}
+
+func TestDispatchWindow(t *testing.T) {
+	w := newDispatchWindow(3)
+	for _, taskType := range []string{"a", "b", "a", "b", "b"} {
+		w.add(taskType)
+	}
+	assert.Equal(t, uint32(3), w.total())
+	assert.Equal(t, uint32(1), w.share("a").GetDispatched())
+	assert.Equal(t, uint32(2), w.share("b").GetDispatched())
+	assert.InDelta(t, 66.67, w.share("b").GetSharePercent(), 0.01)
+}
+
+func TestPriorityScheduler_Pick(t *testing.T) {
+	m := setup(t)
+	sealTask, _, _ := mockSchedulerTasks()
+	picked, reserved := m.taskScheduler.Pick(context.Background(), []task.Task{sealTask})
+	assert.Equal(t, sealTask, picked)
+	assert.Empty(t, reserved)
+	stats := m.taskScheduler.Stats()
+	assert.Equal(t, 1, len(stats.GetShares()))
+	assert.Equal(t, "SealObjectTask", stats.GetShares()[0].GetTaskType())
+	assert.Equal(t, float64(100), stats.GetShares()[0].GetSharePercent())
+}
+
+func TestWFQScheduler_PickByWeight(t *testing.T) {
+	s, clock := newTestWFQScheduler(nil, -1)
+	sealTask, recoverTask, gcTask := mockSchedulerTasks()
+	// the recover tasks flood the queue but only get the share of their weight
+	dispatched := pickTimes(s, 200, time.Second, clock, recoverTask, sealTask, gcTask)
+	assert.InDelta(t, 73, dispatched["SealObjectTask"], 2)
+	assert.InDelta(t, 18, dispatched["RecoverPieceTask"], 2)
+	assert.InDelta(t, 9, dispatched["GCObjectTask"], 2)
+}
+
+func TestWFQScheduler_PickByMinShare(t *testing.T) {
+	s, clock := newTestWFQScheduler([]gfspconfig.TaskTypeSchedulerConfig{
+		{TaskType: "SealObjectTask", Weight: 1, MinSharePercent: 30},
+		{TaskType: "RecoverPieceTask", Weight: 100},
+	}, -1)
+	sealTask, recoverTask, _ := mockSchedulerTasks()
+	dispatched := pickTimes(s, 100, time.Second, clock, recoverTask, sealTask)
+	assert.InDelta(t, 30, dispatched["SealObjectTask"], 2)
+}
+
+func TestWFQScheduler_PickByAging(t *testing.T) {
+	taskTypes := []gfspconfig.TaskTypeSchedulerConfig{{TaskType: "RecoverPieceTask", Weight: 10}}
+	_, recoverTask, gcTask := mockSchedulerTasks()
+
+	s, clock := newTestWFQScheduler(taskTypes, -1)
+	dispatched := pickTimes(s, 5, time.Second, clock, recoverTask, gcTask)
+	assert.Equal(t, uint32(0), dispatched["GCObjectTask"])
+
+	// the weight of the gc tasks grows while they are waiting
+	s, clock = newTestWFQScheduler(taskTypes, time.Second)
+	dispatched = pickTimes(s, 5, time.Second, clock, recoverTask, gcTask)
+	assert.Equal(t, uint32(1), dispatched["GCObjectTask"])
+}
+
+func TestWFQScheduler_PickUnSchedulingTask(t *testing.T) {
+	s, _ := newTestWFQScheduler(nil, 0)
+	gcTask := &gfsptask.GfSpGCObjectTask{Task: &gfsptask.GfSpTask{}}
+	picked, reserved := s.Pick(context.Background(), []task.Task{gcTask})
+	assert.Nil(t, picked)
+	assert.Equal(t, []task.Task{gcTask}, reserved)
+	assert.Equal(t, uint32(0), s.window.total())
+}
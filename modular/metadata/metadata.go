@@ -2,6 +2,8 @@ package metadata
 
 import (
 	"context"
+	"net/http"
+	"time"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -22,6 +24,23 @@ type MetadataModular struct {
 	maxMetadataRequest int64
 	// retrievingRequest defines the handling retrieve request number
 	retrievingRequest int64
+	// enableMetering defines whether to roll up the daily usage of the buckets
+	enableMetering bool
+	// meteringIntervalSec defines the interval of checking the days to be rolled up
+	meteringIntervalSec int64
+	// enableNotification defines whether to deliver the notification events to the webhooks
+	enableNotification bool
+	// notificationMaxAttempts defines the max attempts of delivering a notification event
+	notificationMaxAttempts uint32
+	// notificationRetryInterval defines the initial backoff of retrying a notification delivery
+	notificationRetryInterval time.Duration
+	// notificationEventRetention defines how long the notification events are kept after delivered to all the
+	// subscriptions
+	notificationEventRetention time.Duration
+	// notificationClient defines the http client of delivering the notification events
+	notificationClient *http.Client
+	// cache defines the read-through cache of the buckets, objects, group members and policies, nil if disabled
+	cache *metadataCache
 }
 
 func (r *MetadataModular) Name() string {
@@ -34,6 +53,15 @@ func (r *MetadataModular) Start(ctx context.Context) error {
 		return err
 	}
 	r.scope = scope
+	if r.enableMetering {
+		go r.meterUsageLoop(ctx)
+	}
+	if r.enableNotification {
+		go r.dispatchNotificationLoop(ctx)
+	}
+	if r.cache != nil {
+		go r.invalidateCacheLoop(ctx)
+	}
 	return nil
 }
 
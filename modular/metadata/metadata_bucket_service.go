@@ -129,7 +129,7 @@ func (r *MetadataModular) GfSpGetBucketByBucketName(ctx context.Context, req *ty
 		return nil, ErrInvalidBucketName
 	}
 
-	bucket, err = r.baseApp.GfBsDB().GetBucketByName(req.BucketName, req.IncludePrivate)
+	bucket, err = r.bsDB().GetBucketByName(req.BucketName, req.IncludePrivate)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get bucket by bucket name", "error", err)
 		return nil, err
@@ -381,9 +381,9 @@ func (r *MetadataModular) GfSpGetBucketReadQuota(
 				ConsumedSize:                0,
 				FreeQuotaConsumeSize:        0,
 				MonthlyFreeQuotaConsumeSize: 0,
-				SpMonthlyFreeQuotaSize:      MonthlyFreeQuota,
+				SpMonthlyFreeQuotaSize:      atomic.LoadUint64(&MonthlyFreeQuota),
 				TotalConsumeSize:            0,
-				RemainQuotaSize:             freeQuotaSize + MonthlyFreeQuota + req.GetBucketInfo().GetChargedReadQuota(),
+				RemainQuotaSize:             freeQuotaSize + atomic.LoadUint64(&MonthlyFreeQuota) + req.GetBucketInfo().GetChargedReadQuota(),
 			}, nil
 		} else {
 			log.Errorw("failed to get bucket traffic",
@@ -0,0 +1,491 @@
+package metadata
+
+import (
+	"context"
+	"math/big"
+	"net/http"
+	"strconv"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/hashicorp/golang-lru/simplelru"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+const (
+	// DefaultMetadataCacheSize defines the default max number of the entries in the metadata cache.
+	DefaultMetadataCacheSize = 100000
+	// DefaultMetadataCacheTTLSec defines the default seconds after which a metadata cache entry expires.
+	DefaultMetadataCacheTTLSec = 30
+	// CacheInvalidationBatchSize defines the number of the cache invalidations applied in a round.
+	CacheInvalidationBatchSize = 1000
+	// CacheInvalidationPollInterval defines the interval of polling the new cache invalidations.
+	CacheInvalidationPollInterval = 500 * time.Millisecond
+	// CacheInvalidationPruneInterval defines the interval of pruning the applied cache invalidations.
+	CacheInvalidationPruneInterval = 10 * time.Minute
+	// CacheInvalidationRetention defines the min duration of keeping the applied cache invalidations, so that the
+	// other metadata services sharing the bsdb have applied them before they are pruned.
+	CacheInvalidationRetention = time.Hour
+	// CacheInvalidationModuleName defines the name of the block syncer module that records the cache invalidations.
+	CacheInvalidationModuleName = "cache_invalidation"
+)
+
+// ErrNoCacheInvalidationModule is returned on starting if the cache is enabled without the block syncer module
+// recording the cache invalidations, the cached entries would never be invalidated otherwise.
+var ErrNoCacheInvalidationModule = gfsperrors.Register(coremodule.MetadataModularName, http.StatusInternalServerError, 90015,
+	"the cache_invalidation module of the block syncer is required to enable the metadata cache")
+
+func bucketNameTag(bucketName string) string {
+	return "bucket/" + bucketName
+}
+
+func bucketIDTag(bucketID common.Hash) string {
+	return "bucket-id/" + bucketID.Hex()
+}
+
+func objectNameTag(bucketName, objectName string) string {
+	return "object/" + bucketName + "/" + objectName
+}
+
+func objectIDTag(objectID common.Hash) string {
+	return "object-id/" + objectID.Hex()
+}
+
+func groupTag(groupID common.Hash) string {
+	return "group/" + groupID.Hex()
+}
+
+func groupMemberTag(groupID common.Hash, account common.Address) string {
+	return "group-member/" + groupID.Hex() + "/" + account.Hex()
+}
+
+func policyResourceTag(resourceType string, resourceID common.Hash) string {
+	return "policy/" + resourceType + "/" + resourceID.Hex()
+}
+
+func policyIDTag(policyID common.Hash) string {
+	return "policy-id/" + policyID.Hex()
+}
+
+type metadataCacheEntry struct {
+	value    interface{}
+	tags     []string
+	expireAt time.Time
+}
+
+// metadataCache is the read-through cache of the bsdb records that are queried on every request. The number of
+// the entries is bounded and the entries expire after the ttl. Every entry is tagged by the records it depends
+// on, and the entries are invalidated by the tags as the block syncer commits the events that change the records.
+type metadataCache struct {
+	mux sync.Mutex
+	lru *simplelru.LRU
+	ttl time.Duration
+	// tags indexes the keys of the entries by the tags
+	tags map[string]map[string]struct{}
+	// sequence increases on every invalidation, the query result is not cached if the sequence is changed
+	// during the query, otherwise the invalidated record may be cached again
+	sequence uint64
+	// ready is set once the cursor of the cache invalidations is initialized, the cache is bypassed before
+	ready bool
+	now   func() time.Time
+}
+
+func newMetadataCache(size int, ttl time.Duration) (*metadataCache, error) {
+	c := &metadataCache{
+		ttl:  ttl,
+		tags: make(map[string]map[string]struct{}),
+		now:  time.Now,
+	}
+	lru, err := simplelru.NewLRU(size, c.onEvicted)
+	if err != nil {
+		return nil, err
+	}
+	c.lru = lru
+	return c, nil
+}
+
+// onEvicted is called by the lru with the mux held.
+func (c *metadataCache) onEvicted(key interface{}, value interface{}) {
+	for _, tag := range value.(*metadataCacheEntry).tags {
+		if keys, ok := c.tags[tag]; ok {
+			delete(keys, key.(string))
+			if len(keys) == 0 {
+				delete(c.tags, tag)
+			}
+		}
+	}
+}
+
+func (c *metadataCache) setReady() {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.ready = true
+}
+
+func (c *metadataCache) get(key string) (interface{}, bool) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if !c.ready {
+		return nil, false
+	}
+	value, ok := c.lru.Get(key)
+	if !ok {
+		return nil, false
+	}
+	entry := value.(*metadataCacheEntry)
+	if c.now().After(entry.expireAt) {
+		c.lru.Remove(key)
+		return nil, false
+	}
+	return entry.value, true
+}
+
+func (c *metadataCache) currentSequence() uint64 {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.sequence
+}
+
+// set caches the query result unless an invalidation is applied after the query starts at the sequence.
+func (c *metadataCache) set(key string, sequence uint64, value interface{}, tags []string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	if !c.ready || c.sequence != sequence {
+		return
+	}
+	// drop the tags of the replaced entry
+	c.lru.Remove(key)
+	c.lru.Add(key, &metadataCacheEntry{value: value, tags: tags, expireAt: c.now().Add(c.ttl)})
+	for _, tag := range tags {
+		keys, ok := c.tags[tag]
+		if !ok {
+			keys = make(map[string]struct{})
+			c.tags[tag] = keys
+		}
+		keys[key] = struct{}{}
+	}
+}
+
+// invalidate removes the entries tagged by any of the tags.
+func (c *metadataCache) invalidate(tags ...string) {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	c.sequence++
+	for _, tag := range tags {
+		for key := range c.tags[tag] {
+			c.lru.Remove(key)
+		}
+	}
+}
+
+func (c *metadataCache) len() int {
+	c.mux.Lock()
+	defer c.mux.Unlock()
+	return c.lru.Len()
+}
+
+// load returns the cached query result of the key, or queries and caches the result with the tags.
+func (c *metadataCache) load(query string, key string, load func() (interface{}, []string, error)) (interface{}, error) {
+	if value, ok := c.get(key); ok {
+		metrics.MetadataCacheCounter.WithLabelValues(query, "hit").Inc()
+		return value, nil
+	}
+	metrics.MetadataCacheCounter.WithLabelValues(query, "miss").Inc()
+	sequence := c.currentSequence()
+	value, tags, err := load()
+	if err != nil {
+		return nil, err
+	}
+	c.set(key, sequence, value, tags)
+	return value, nil
+}
+
+// apply invalidates the entries of the records changed by the cache invalidation.
+func (c *metadataCache) apply(invalidation *bsdb.CacheInvalidation) {
+	var tags []string
+	switch invalidation.Kind {
+	case bsdb.CacheInvalidationBucket:
+		tags = append(tags, bucketNameTag(invalidation.BucketName))
+		if invalidation.ResourceID != (common.Hash{}) {
+			tags = append(tags, bucketIDTag(invalidation.ResourceID))
+		}
+	case bsdb.CacheInvalidationObject:
+		if invalidation.ObjectName != "" {
+			tags = append(tags, objectNameTag(invalidation.BucketName, invalidation.ObjectName))
+		}
+		if invalidation.ResourceID != (common.Hash{}) {
+			tags = append(tags, objectIDTag(invalidation.ResourceID))
+		}
+	case bsdb.CacheInvalidationGroupMember:
+		if invalidation.Account == (common.Address{}) {
+			tags = append(tags, groupTag(invalidation.ResourceID))
+		} else {
+			tags = append(tags, groupMemberTag(invalidation.ResourceID, invalidation.Account))
+		}
+	case bsdb.CacheInvalidationPolicy:
+		tags = append(tags, policyIDTag(invalidation.PolicyID))
+		if invalidation.ResourceType != "" {
+			tags = append(tags, policyResourceTag(invalidation.ResourceType, invalidation.ResourceID))
+		}
+	default:
+		log.Warnw("unknown cache invalidation kind", "id", invalidation.ID, "kind", invalidation.Kind)
+		return
+	}
+	metrics.MetadataCacheInvalidationCounter.WithLabelValues(invalidation.Kind).Inc()
+	c.invalidate(tags...)
+}
+
+// invalidateCacheLoop applies the new cache invalidations recorded by the block syncer until the context is done.
+func (r *MetadataModular) invalidateCacheLoop(ctx context.Context) {
+	var (
+		cursor uint64
+		err    error
+	)
+	for {
+		// the cache is empty and bypassed until the cursor is initialized, so no invalidation is missed
+		if cursor, err = r.baseApp.GfBsDB().GetLatestCacheInvalidationID(); err == nil {
+			break
+		}
+		log.CtxErrorw(ctx, "failed to get latest cache invalidation id", "error", err)
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(CacheInvalidationPollInterval):
+		}
+	}
+	r.cache.setReady()
+
+	prunedAt := time.Now()
+	for {
+		invalidations, listErr := r.baseApp.GfBsDB().ListCacheInvalidations(cursor, CacheInvalidationBatchSize)
+		if listErr != nil {
+			log.CtxErrorw(ctx, "failed to list cache invalidations", "cursor", cursor, "error", listErr)
+		}
+		for _, invalidation := range invalidations {
+			r.cache.apply(invalidation)
+			cursor = invalidation.ID
+		}
+		// keep applying without waiting if there are more invalidations
+		if listErr == nil && len(invalidations) == CacheInvalidationBatchSize {
+			continue
+		}
+		if time.Since(prunedAt) >= CacheInvalidationPruneInterval {
+			r.pruneCacheInvalidations(ctx, cursor)
+			prunedAt = time.Now()
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(CacheInvalidationPollInterval):
+		}
+	}
+}
+
+// pruneCacheInvalidations deletes the cache invalidations that have been applied up to the cursor and are older
+// than both the retention and the ttl, the entries cached before such an invalidation have expired anyway.
+func (r *MetadataModular) pruneCacheInvalidations(ctx context.Context, cursor uint64) {
+	retention := CacheInvalidationRetention
+	if r.cache.ttl > retention {
+		retention = r.cache.ttl
+	}
+	createTimeBefore := time.Now().Add(-retention).Unix()
+	var total int64
+	for ctx.Err() == nil {
+		deleted, err := r.baseApp.GfBsDB().DeleteCacheInvalidations(cursor, createTimeBefore, CacheInvalidationBatchSize)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to delete cache invalidations", "cursor", cursor, "error", err)
+			return
+		}
+		total += deleted
+		if deleted < CacheInvalidationBatchSize {
+			break
+		}
+	}
+	log.CtxInfow(ctx, "succeed to prune cache invalidations", "cursor", cursor, "deleted", total)
+}
+
+// bsDB returns the bsdb that queries through the cache if the cache is enabled.
+func (r *MetadataModular) bsDB() bsdb.BSDB {
+	if r.cache == nil {
+		return r.baseApp.GfBsDB()
+	}
+	return &cachedBsDB{BSDB: r.baseApp.GfBsDB(), cache: r.cache}
+}
+
+// cachedBsDB queries the buckets, objects, group members and policies through the cache, the other queries go
+// to the bsdb directly.
+type cachedBsDB struct {
+	bsdb.BSDB
+	cache *metadataCache
+}
+
+func hashesKey(hashes []common.Hash) string {
+	hexes := make([]string, len(hashes))
+	for i, hash := range hashes {
+		hexes[i] = hash.Hex()
+	}
+	return strings.Join(hexes, ",")
+}
+
+func bucketTags(bucket *bsdb.Bucket) []string {
+	return []string{bucketNameTag(bucket.BucketName), bucketIDTag(bucket.BucketID)}
+}
+
+func objectTags(object *bsdb.Object) []string {
+	return []string{objectNameTag(object.BucketName, object.ObjectName), objectIDTag(object.ObjectID)}
+}
+
+// copyBucket returns a copy of the cached bucket, so that the caller can not modify the cached one.
+func copyBucket(value interface{}) *bsdb.Bucket {
+	bucket := value.(*bsdb.Bucket)
+	if bucket == nil {
+		return nil
+	}
+	copied := *bucket
+	return &copied
+}
+
+// copyObject returns a copy of the cached object, so that the caller can not modify the cached one.
+func copyObject(value interface{}) *bsdb.Object {
+	object := value.(*bsdb.Object)
+	if object == nil {
+		return nil
+	}
+	copied := *object
+	return &copied
+}
+
+func (db *cachedBsDB) GetBucketByName(bucketName string, includePrivate bool) (*bsdb.Bucket, error) {
+	key := "GetBucketByName/" + bucketName + "/" + strconv.FormatBool(includePrivate)
+	value, err := db.cache.load("GetBucketByName", key, func() (interface{}, []string, error) {
+		bucket, err := db.BSDB.GetBucketByName(bucketName, includePrivate)
+		if err != nil || bucket == nil {
+			return bucket, []string{bucketNameTag(bucketName)}, err
+		}
+		return bucket, bucketTags(bucket), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyBucket(value), nil
+}
+
+func (db *cachedBsDB) GetBucketByID(bucketID int64, includePrivate bool) (*bsdb.Bucket, error) {
+	key := "GetBucketByID/" + strconv.FormatInt(bucketID, 10) + "/" + strconv.FormatBool(includePrivate)
+	value, err := db.cache.load("GetBucketByID", key, func() (interface{}, []string, error) {
+		bucket, err := db.BSDB.GetBucketByID(bucketID, includePrivate)
+		if err != nil || bucket == nil {
+			return bucket, []string{bucketIDTag(common.BigToHash(big.NewInt(bucketID)))}, err
+		}
+		return bucket, bucketTags(bucket), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyBucket(value), nil
+}
+
+func (db *cachedBsDB) GetObjectByName(objectName string, bucketName string, includePrivate bool) (*bsdb.Object, error) {
+	key := "GetObjectByName/" + bucketName + "/" + strconv.FormatBool(includePrivate) + "/" + objectName
+	value, err := db.cache.load("GetObjectByName", key, func() (interface{}, []string, error) {
+		object, err := db.BSDB.GetObjectByName(objectName, bucketName, includePrivate)
+		if err != nil || object == nil {
+			return object, []string{objectNameTag(bucketName, objectName)}, err
+		}
+		return object, objectTags(object), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyObject(value), nil
+}
+
+func (db *cachedBsDB) GetObjectByID(objectID int64, includeRemoved bool) (*bsdb.Object, error) {
+	key := "GetObjectByID/" + strconv.FormatInt(objectID, 10) + "/" + strconv.FormatBool(includeRemoved)
+	value, err := db.cache.load("GetObjectByID", key, func() (interface{}, []string, error) {
+		object, err := db.BSDB.GetObjectByID(objectID, includeRemoved)
+		if err != nil || object == nil {
+			return object, []string{objectIDTag(common.BigToHash(big.NewInt(objectID)))}, err
+		}
+		return object, objectTags(object), nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return copyObject(value), nil
+}
+
+func (db *cachedBsDB) GetPermissionByResourceAndPrincipal(resourceType, principalType, principalValue string,
+	resourceID common.Hash) (*bsdb.Permission, error) {
+	key := "GetPermissionByResourceAndPrincipal/" + resourceType + "/" + resourceID.Hex() + "/" + principalType + "/" + principalValue
+	value, err := db.cache.load("GetPermissionByResourceAndPrincipal", key, func() (interface{}, []string, error) {
+		permission, err := db.BSDB.GetPermissionByResourceAndPrincipal(resourceType, principalType, principalValue, resourceID)
+		tags := []string{policyResourceTag(resourceType, resourceID)}
+		if permission != nil {
+			tags = append(tags, policyIDTag(permission.PolicyID))
+		}
+		return permission, tags, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.(*bsdb.Permission), nil
+}
+
+func (db *cachedBsDB) GetPermissionsByResourceAndPrincipleType(resourceType, principalType string, resourceID common.Hash,
+	includeRemoved bool) ([]*bsdb.Permission, error) {
+	key := "GetPermissionsByResourceAndPrincipleType/" + resourceType + "/" + resourceID.Hex() + "/" + principalType +
+		"/" + strconv.FormatBool(includeRemoved)
+	value, err := db.cache.load("GetPermissionsByResourceAndPrincipleType", key, func() (interface{}, []string, error) {
+		permissions, err := db.BSDB.GetPermissionsByResourceAndPrincipleType(resourceType, principalType, resourceID, includeRemoved)
+		tags := []string{policyResourceTag(resourceType, resourceID)}
+		for _, permission := range permissions {
+			tags = append(tags, policyIDTag(permission.PolicyID))
+		}
+		return permissions, tags, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]*bsdb.Permission), nil
+}
+
+func (db *cachedBsDB) GetStatementsByPolicyID(policyIDList []common.Hash, includeRemoved bool) ([]*bsdb.Statement, error) {
+	key := "GetStatementsByPolicyID/" + strconv.FormatBool(includeRemoved) + "/" + hashesKey(policyIDList)
+	value, err := db.cache.load("GetStatementsByPolicyID", key, func() (interface{}, []string, error) {
+		statements, err := db.BSDB.GetStatementsByPolicyID(policyIDList, includeRemoved)
+		tags := make([]string, len(policyIDList))
+		for i, policyID := range policyIDList {
+			tags[i] = policyIDTag(policyID)
+		}
+		return statements, tags, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]*bsdb.Statement), nil
+}
+
+func (db *cachedBsDB) GetGroupsByGroupIDAndAccount(groupIDList []common.Hash, account common.Address,
+	includeRemoved bool) ([]*bsdb.Group, error) {
+	key := "GetGroupsByGroupIDAndAccount/" + account.Hex() + "/" + strconv.FormatBool(includeRemoved) + "/" + hashesKey(groupIDList)
+	value, err := db.cache.load("GetGroupsByGroupIDAndAccount", key, func() (interface{}, []string, error) {
+		groups, err := db.BSDB.GetGroupsByGroupIDAndAccount(groupIDList, account, includeRemoved)
+		tags := make([]string, 0, 2*len(groupIDList))
+		for _, groupID := range groupIDList {
+			tags = append(tags, groupTag(groupID), groupMemberTag(groupID, account))
+		}
+		return groups, tags, err
+	})
+	if err != nil {
+		return nil, err
+	}
+	return value.([]*bsdb.Group), nil
+}
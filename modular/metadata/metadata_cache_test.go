@@ -0,0 +1,246 @@
+package metadata
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"cosmossdk.io/math"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	gnfdresource "github.com/bnb-chain/greenfield/types/resource"
+	permtypes "github.com/bnb-chain/greenfield/x/permission/types"
+)
+
+func setupCache(t *testing.T) (*MetadataModular, *bsdb.MockMetadata) {
+	r := setup(t)
+	cache, err := newMetadataCache(16, time.Minute)
+	assert.Nil(t, err)
+	cache.setReady()
+	r.cache = cache
+	bs := bsdb.NewMockMetadata(gomock.NewController(t))
+	r.baseApp.SetGfBsDB(bs)
+	return r, bs
+}
+
+func TestMetadataOptions_EnableCache(t *testing.T) {
+	cfg := &gfspconfig.GfSpConfig{
+		Metadata:    gfspconfig.MetadataConfig{EnableCache: true},
+		BlockSyncer: gfspconfig.BlockSyncerConfig{Modules: []string{CacheInvalidationModuleName}},
+	}
+	metadata := &MetadataModular{baseApp: &gfspapp.GfSpBaseApp{}}
+	assert.Nil(t, DefaultMetadataOptions(metadata, cfg))
+	assert.NotNil(t, metadata.cache)
+	assert.Equal(t, DefaultMetadataCacheSize, cfg.Metadata.CacheSize)
+	assert.Equal(t, time.Duration(DefaultMetadataCacheTTLSec)*time.Second, metadata.cache.ttl)
+}
+
+func TestMetadataOptions_EnableCacheWithoutInvalidationModule(t *testing.T) {
+	cfg := &gfspconfig.GfSpConfig{Metadata: gfspconfig.MetadataConfig{EnableCache: true}}
+	metadata := &MetadataModular{baseApp: &gfspapp.GfSpBaseApp{}}
+	assert.Equal(t, ErrNoCacheInvalidationModule, DefaultMetadataOptions(metadata, cfg))
+	assert.Nil(t, metadata.cache)
+}
+
+func TestMetadataCache_Invalidate(t *testing.T) {
+	c, err := newMetadataCache(2, time.Minute)
+	assert.Nil(t, err)
+	// the cache is bypassed until it is ready
+	c.set("a", c.currentSequence(), 1, []string{"tag-a", "tag-ab"})
+	_, ok := c.get("a")
+	assert.False(t, ok)
+
+	c.setReady()
+	c.set("a", c.currentSequence(), 1, []string{"tag-a", "tag-ab"})
+	c.set("b", c.currentSequence(), 2, []string{"tag-ab"})
+	value, ok := c.get("a")
+	assert.True(t, ok)
+	assert.Equal(t, 1, value)
+
+	c.invalidate("tag-ab")
+	_, ok = c.get("a")
+	assert.False(t, ok)
+	_, ok = c.get("b")
+	assert.False(t, ok)
+	assert.Empty(t, c.tags)
+
+	// the evicted entry is removed from the tag index
+	for _, key := range []string{"a", "b", "c"} {
+		c.set(key, c.currentSequence(), key, []string{"tag-" + key})
+	}
+	assert.Equal(t, 2, c.len())
+	assert.Equal(t, 2, len(c.tags))
+	_, ok = c.tags["tag-a"]
+	assert.False(t, ok)
+}
+
+func TestMetadataCache_Expire(t *testing.T) {
+	c, err := newMetadataCache(2, time.Minute)
+	assert.Nil(t, err)
+	c.setReady()
+	now := time.Now()
+	c.now = func() time.Time { return now }
+	c.set("a", c.currentSequence(), 1, nil)
+	_, ok := c.get("a")
+	assert.True(t, ok)
+
+	now = now.Add(2 * time.Minute)
+	_, ok = c.get("a")
+	assert.False(t, ok)
+	assert.Equal(t, 0, c.len())
+}
+
+func TestMetadataCache_InvalidateDuringQuery(t *testing.T) {
+	c, err := newMetadataCache(2, time.Minute)
+	assert.Nil(t, err)
+	c.setReady()
+	sequence := c.currentSequence()
+	c.invalidate("tag-a")
+	// the result queried before the invalidation is not cached
+	c.set("a", sequence, 1, []string{"tag-a"})
+	_, ok := c.get("a")
+	assert.False(t, ok)
+}
+
+func TestMetadataModular_CachedGetBucketByName(t *testing.T) {
+	r, bs := setupCache(t)
+	bucketID := common.BigToHash(math.NewUint(1).BigInt())
+	bs.EXPECT().GetBucketByName("mock-bucket", true).Return(&bsdb.Bucket{BucketName: "mock-bucket", BucketID: bucketID}, nil).Times(2)
+	bucket, err := r.bsDB().GetBucketByName("mock-bucket", true)
+	assert.Nil(t, err)
+	assert.Equal(t, bucketID, bucket.BucketID)
+	// the caller gets a copy of the cached bucket
+	bucket.BucketName = "changed"
+	bucket, err = r.bsDB().GetBucketByName("mock-bucket", true)
+	assert.Nil(t, err)
+	assert.Equal(t, "mock-bucket", bucket.BucketName)
+
+	// the bucket is queried again after it is changed
+	r.cache.apply(&bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationBucket, BucketName: "mock-bucket"})
+	_, err = r.bsDB().GetBucketByName("mock-bucket", true)
+	assert.Nil(t, err)
+
+	// the error is not cached
+	bs.EXPECT().GetBucketByName("error-bucket", true).Return(nil, mockErr).Times(2)
+	for i := 0; i < 2; i++ {
+		_, err = r.bsDB().GetBucketByName("error-bucket", true)
+		assert.Equal(t, mockErr, err)
+	}
+}
+
+func TestMetadataModular_CachedGetObjectByID(t *testing.T) {
+	r, bs := setupCache(t)
+	objectID := common.BigToHash(math.NewUint(2).BigInt())
+	bs.EXPECT().GetObjectByID(int64(2), false).Return(&bsdb.Object{BucketName: "mock-bucket", ObjectName: "mock-object",
+		ObjectID: objectID}, nil).Times(2)
+	for i := 0; i < 2; i++ {
+		object, err := r.bsDB().GetObjectByID(2, false)
+		assert.Nil(t, err)
+		assert.Equal(t, "mock-object", object.ObjectName)
+	}
+	// the object queried by id is invalidated by name
+	r.cache.apply(&bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationObject, BucketName: "mock-bucket", ObjectName: "mock-object"})
+	_, err := r.bsDB().GetObjectByID(2, false)
+	assert.Nil(t, err)
+}
+
+func TestMetadataModular_CachedVerifyPolicy(t *testing.T) {
+	r, bs := setupCache(t)
+	operator := sdk.AccAddress(common.HexToAddress("0x11").Bytes())
+	resourceID := math.NewUint(1)
+	resourceHash := common.BigToHash(resourceID.BigInt())
+	groupID := common.BigToHash(math.NewUint(3).BigInt())
+	policyID := common.BigToHash(math.NewUint(4).BigInt())
+	resourceType := gnfdresource.RESOURCE_TYPE_BUCKET.String()
+	permission := &bsdb.Permission{PrincipalValue: "3", PolicyID: policyID}
+	statement := &bsdb.Statement{PolicyID: policyID, Effect: permtypes.EFFECT_ALLOW.String(),
+		ActionValue: 1 << bsdb.ActionTypeMap[permtypes.ACTION_GET_OBJECT]}
+
+	expectQueries := func(times int) {
+		bs.EXPECT().GetPermissionByResourceAndPrincipal(resourceType, permtypes.PRINCIPAL_TYPE_GNFD_ACCOUNT.String(),
+			operator.String(), resourceHash).Return(nil, nil).Times(times)
+		bs.EXPECT().GetPermissionsByResourceAndPrincipleType(resourceType, permtypes.PRINCIPAL_TYPE_GNFD_GROUP.String(),
+			resourceHash, false).Return([]*bsdb.Permission{permission}, nil).Times(times)
+		bs.EXPECT().GetGroupsByGroupIDAndAccount([]common.Hash{groupID}, common.HexToAddress(operator.String()), false).
+			Return([]*bsdb.Group{{GroupID: groupID}}, nil).Times(times)
+		bs.EXPECT().GetStatementsByPolicyID([]common.Hash{policyID}, false).Return([]*bsdb.Statement{statement}, nil).Times(times)
+	}
+	verify := func() permtypes.Effect {
+		effect, err := r.VerifyPolicy(context.TODO(), resourceID, gnfdresource.RESOURCE_TYPE_BUCKET, operator,
+			permtypes.ACTION_GET_OBJECT, nil)
+		assert.Nil(t, err)
+		return effect
+	}
+
+	expectQueries(1)
+	assert.Equal(t, permtypes.EFFECT_ALLOW, verify())
+	assert.Equal(t, permtypes.EFFECT_ALLOW, verify())
+
+	// the member is removed from the group
+	bs.EXPECT().GetGroupsByGroupIDAndAccount([]common.Hash{groupID}, common.HexToAddress(operator.String()), false).
+		Return(nil, nil).Times(1)
+	bs.EXPECT().GetStatementsByPolicyID([]common.Hash{}, false).Return(nil, nil).AnyTimes()
+	r.cache.apply(&bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationGroupMember, ResourceID: groupID,
+		Account: common.HexToAddress(operator.String())})
+	assert.Equal(t, permtypes.EFFECT_UNSPECIFIED, verify())
+
+	// the policy is deleted
+	r.cache.apply(&bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationPolicy, PolicyID: policyID})
+	bs.EXPECT().GetPermissionsByResourceAndPrincipleType(resourceType, permtypes.PRINCIPAL_TYPE_GNFD_GROUP.String(),
+		resourceHash, false).Return(nil, nil).Times(1)
+	assert.Equal(t, permtypes.EFFECT_UNSPECIFIED, verify())
+
+	// a policy granting the account is put on the resource
+	r.cache.apply(&bsdb.CacheInvalidation{Kind: bsdb.CacheInvalidationPolicy, ResourceType: resourceType,
+		ResourceID: resourceHash, PolicyID: policyID})
+	bs.EXPECT().GetPermissionByResourceAndPrincipal(resourceType, permtypes.PRINCIPAL_TYPE_GNFD_ACCOUNT.String(),
+		operator.String(), resourceHash).Return(&bsdb.Permission{PolicyID: policyID}, nil).Times(1)
+	bs.EXPECT().GetStatementsByPolicyID([]common.Hash{policyID}, false).Return([]*bsdb.Statement{statement}, nil).Times(1)
+	assert.Equal(t, permtypes.EFFECT_ALLOW, verify())
+	assert.Equal(t, permtypes.EFFECT_ALLOW, verify())
+}
+
+func TestMetadataModular_InvalidateCacheLoop(t *testing.T) {
+	r, bs := setupCache(t)
+	r.cache.ready = false
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	bs.EXPECT().GetLatestCacheInvalidationID().Return(uint64(0), mockErr).Times(1)
+	bs.EXPECT().GetLatestCacheInvalidationID().Return(uint64(10), nil).Times(1)
+	bs.EXPECT().ListCacheInvalidations(uint64(10), CacheInvalidationBatchSize).Return([]*bsdb.CacheInvalidation{
+		{ID: 11, Kind: bsdb.CacheInvalidationBucket, BucketName: "mock-bucket"},
+	}, nil).Times(1)
+	bs.EXPECT().ListCacheInvalidations(uint64(11), CacheInvalidationBatchSize).Return(nil, nil).AnyTimes()
+
+	go r.invalidateCacheLoop(ctx)
+	assert.Eventually(t, func() bool {
+		r.cache.mux.Lock()
+		defer r.cache.mux.Unlock()
+		return r.cache.ready && r.cache.sequence == 1
+	}, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestMetadataModular_PruneCacheInvalidations(t *testing.T) {
+	r, bs := setupCache(t)
+	var createTimeBefore int64
+	gomock.InOrder(
+		bs.EXPECT().DeleteCacheInvalidations(uint64(11), gomock.Any(), CacheInvalidationBatchSize).DoAndReturn(
+			func(endID uint64, before int64, limit int) (int64, error) {
+				createTimeBefore = before
+				return int64(limit), nil
+			}),
+		bs.EXPECT().DeleteCacheInvalidations(uint64(11), gomock.Any(), CacheInvalidationBatchSize).Return(int64(1), nil),
+	)
+	r.pruneCacheInvalidations(context.Background(), 11)
+	// the invalidations are kept for the retention at least
+	assert.LessOrEqual(t, createTimeBefore, time.Now().Add(-CacheInvalidationRetention).Unix())
+
+	bs.EXPECT().DeleteCacheInvalidations(uint64(11), gomock.Any(), CacheInvalidationBatchSize).Return(int64(0), mockErr)
+	r.pruneCacheInvalidations(context.Background(), 11)
+}
@@ -0,0 +1,210 @@
+package metadata
+
+import (
+	"context"
+	"math/big"
+	"sync/atomic"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+const (
+	// MeteringDayLayout defines the layout of the days of the usage rollups.
+	MeteringDayLayout = "2006-01-02"
+	// MeteringBatchSize defines the batch size of listing the buckets.
+	MeteringBatchSize = 500
+	// MaxUsageRollupListLimit defines the max number of the usage rollups returned by a list request.
+	MaxUsageRollupListLimit = 1000
+)
+
+// GfSpListUsageRollup lists the daily usage rollups of the buckets by the filters in pages.
+func (r *MetadataModular) GfSpListUsageRollup(
+	ctx context.Context,
+	req *types.GfSpListUsageRollupRequest) (
+	*types.GfSpListUsageRollupResponse,
+	error) {
+	defer atomic.AddInt64(&r.retrievingRequest, -1)
+	if atomic.AddInt64(&r.retrievingRequest, 1) >
+		atomic.LoadInt64(&r.maxMetadataRequest) {
+		return nil, ErrExceedRequest
+	}
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > MaxUsageRollupListLimit {
+		limit = MaxUsageRollupListLimit
+	}
+	rollups, err := r.baseApp.GfSpDB().ListUsageRollups(&spdb.UsageRollupFilter{
+		StartDay:       req.GetStartDay(),
+		EndDay:         req.GetEndDay(),
+		BucketID:       req.GetBucketId(),
+		PaymentAddress: req.GetPaymentAddress(),
+	}, int(req.GetOffset()), limit)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list usage rollup", "error", err)
+		return &types.GfSpListUsageRollupResponse{Err: ErrGfSpDBWithDetail("failed to list usage rollup, error: " + err.Error())}, nil
+	}
+	result := make([]*types.UsageRollup, 0, len(rollups))
+	for _, rollup := range rollups {
+		result = append(result, &types.UsageRollup{
+			BucketId:       rollup.BucketID,
+			Day:            rollup.Day,
+			BucketName:     rollup.BucketName,
+			PaymentAddress: rollup.PaymentAddress,
+			ReadCount:      rollup.ReadCount,
+			ReadSize:       rollup.ReadSize,
+			StoredSize:     rollup.StoredSize,
+		})
+	}
+	return &types.GfSpListUsageRollupResponse{Rollups: result}, nil
+}
+
+// meterUsageLoop rolls up the finished days periodically.
+func (r *MetadataModular) meterUsageLoop(ctx context.Context) {
+	ticker := time.NewTicker(time.Duration(r.meteringIntervalSec) * time.Second)
+	defer ticker.Stop()
+	r.meterUsage(ctx, time.Now())
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			r.meterUsage(ctx, time.Now())
+		}
+	}
+}
+
+// meterUsage rolls up the last finished UTC day before now if it has not been rolled up, the rollups of a day
+// are written in one transaction, so the latest rolled up day is complete. The stored size of a bucket is the
+// current one, so the earlier days missed by the metering, e.g. the SP has been stopped for days, are skipped
+// rather than rolled up with the stored size of today.
+func (r *MetadataModular) meterUsage(ctx context.Context, now time.Time) {
+	day := now.UTC().Truncate(24*time.Hour).AddDate(0, 0, -1)
+	latest, err := r.baseApp.GfSpDB().GetLatestUsageRollupDay()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get latest usage rollup day", "error", err)
+		return
+	}
+	if latest != "" {
+		latestDay, parseErr := time.Parse(MeteringDayLayout, latest)
+		if parseErr != nil {
+			log.CtxErrorw(ctx, "failed to parse latest usage rollup day", "day", latest, "error", parseErr)
+			return
+		}
+		if !latestDay.Before(day) {
+			return
+		}
+		if missed := latestDay.AddDate(0, 0, 1); missed.Before(day) {
+			log.CtxWarnw(ctx, "skip the usage rollup of the missed days whose stored size is unknown",
+				"from", missed.Format(MeteringDayLayout), "to", day.AddDate(0, 0, -1).Format(MeteringDayLayout))
+		}
+	}
+	if err = r.rollupUsage(ctx, day); err != nil {
+		log.CtxErrorw(ctx, "failed to roll up usage", "day", day.Format(MeteringDayLayout), "error", err)
+		return
+	}
+	log.CtxInfow(ctx, "succeed to roll up usage", "day", day.Format(MeteringDayLayout))
+}
+
+// rollupUsage aggregates the reads of the buckets in the UTC day and the current storage size of the buckets
+// that the SP serves as primary SP, the buckets that have been read in the day but are no longer served, e.g.
+// deleted or migrated, are rolled up with zero storage size. The rollups of the day are written at once.
+func (r *MetadataModular) rollupUsage(ctx context.Context, day time.Time) error {
+	reads, err := r.baseApp.GfSpDB().AggregateReadUsage(day.UnixMicro(), day.AddDate(0, 0, 1).UnixMicro())
+	if err != nil {
+		return err
+	}
+	readUsages := make(map[uint64]*spdb.ReadUsage, len(reads))
+	for _, read := range reads {
+		readUsages[read.BucketID] = read
+	}
+
+	updateTime := time.Now().Unix()
+	dayStr := day.Format(MeteringDayLayout)
+	rollups := make([]*spdb.UsageRollup, 0, MeteringBatchSize)
+	addRollup := func(bucket *bsdb.Bucket, storedSize uint64) {
+		bucketID := bucket.BucketID.Big().Uint64()
+		rollup := &spdb.UsageRollup{
+			BucketID:       bucketID,
+			Day:            dayStr,
+			BucketName:     bucket.BucketName,
+			PaymentAddress: bucket.PaymentAddress.String(),
+			StoredSize:     storedSize,
+			UpdateTime:     updateTime,
+		}
+		if read, ok := readUsages[bucketID]; ok {
+			rollup.ReadCount = read.ReadCount
+			rollup.ReadSize = read.ReadSize
+			delete(readUsages, bucketID)
+		}
+		rollups = append(rollups, rollup)
+	}
+
+	vgfIDs, err := r.primaryVGFIDs()
+	if err != nil {
+		return err
+	}
+	if len(vgfIDs) > 0 {
+		var startAfter common.Hash
+		for {
+			buckets, listErr := r.baseApp.GfBsDB().ListBucketsByVgfID(vgfIDs, startAfter, MeteringBatchSize)
+			if listErr != nil {
+				return listErr
+			}
+			for _, bucket := range buckets {
+				addRollup(bucket, bucket.StorageSize.BigInt().Uint64())
+			}
+			if len(buckets) < MeteringBatchSize {
+				break
+			}
+			startAfter = buckets[len(buckets)-1].BucketID
+		}
+	}
+
+	if len(readUsages) > 0 {
+		ids := make([]common.Hash, 0, len(readUsages))
+		for bucketID := range readUsages {
+			ids = append(ids, common.BigToHash(new(big.Int).SetUint64(bucketID)))
+		}
+		buckets, listErr := r.baseApp.GfBsDB().ListBucketsByIDs(ids, true)
+		if listErr != nil {
+			return listErr
+		}
+		for _, bucket := range buckets {
+			addRollup(bucket, 0)
+		}
+		// the buckets unknown by the metadata are still rolled up to keep the reads
+		for _, read := range readUsages {
+			rollups = append(rollups, &spdb.UsageRollup{
+				BucketID:   read.BucketID,
+				Day:        dayStr,
+				BucketName: read.BucketName,
+				ReadCount:  read.ReadCount,
+				ReadSize:   read.ReadSize,
+				UpdateTime: updateTime,
+			})
+		}
+	}
+	return r.baseApp.GfSpDB().UpdateUsageRollups(rollups)
+}
+
+// primaryVGFIDs returns the ids of the global virtual group families that the SP serves as primary SP.
+func (r *MetadataModular) primaryVGFIDs() ([]uint32, error) {
+	sp, err := r.baseApp.GfBsDB().GetSPByAddress(common.HexToAddress(SpOperatorAddress))
+	if err != nil {
+		return nil, err
+	}
+	families, err := r.baseApp.GfBsDB().ListVirtualGroupFamiliesBySpID(sp.SpId)
+	if err != nil {
+		return nil, err
+	}
+	vgfIDs := make([]uint32, 0, len(families))
+	for _, family := range families {
+		vgfIDs = append(vgfIDs, family.GlobalVirtualGroupFamilyId)
+	}
+	return vgfIDs, nil
+}
@@ -0,0 +1,133 @@
+package metadata
+
+import (
+	"context"
+	"math/big"
+	"testing"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/shopspring/decimal"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func mockMeteringBucket(bucketID uint64, storageSize int64) *bsdb.Bucket {
+	return &bsdb.Bucket{
+		BucketName:     "mock-bucket",
+		BucketID:       common.BigToHash(new(big.Int).SetUint64(bucketID)),
+		PaymentAddress: common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D"),
+		StorageSize:    decimal.NewFromInt(storageSize),
+	}
+}
+
+func TestMetadataModular_GfSpListUsageRollup_Success(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	m.EXPECT().ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-08-01", EndDay: "2023-08-31", BucketID: 1},
+		10, MaxUsageRollupListLimit).Return([]*spdb.UsageRollup{{BucketID: 1, Day: "2023-08-01", BucketName: "mock-bucket",
+		PaymentAddress: "0x01", ReadCount: 1, ReadSize: 100, StoredSize: 1000, UpdateTime: 1}}, nil).Times(1)
+	resp, err := a.GfSpListUsageRollup(context.Background(), &types.GfSpListUsageRollupRequest{
+		StartDay: "2023-08-01",
+		EndDay:   "2023-08-31",
+		BucketId: 1,
+		Offset:   10,
+	})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetErr())
+	assert.Equal(t, []*types.UsageRollup{{BucketId: 1, Day: "2023-08-01", BucketName: "mock-bucket",
+		PaymentAddress: "0x01", ReadCount: 1, ReadSize: 100, StoredSize: 1000}}, resp.GetRollups())
+}
+
+func TestMetadataModular_GfSpListUsageRollup_Failure(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	m.EXPECT().ListUsageRollups(gomock.Any(), 0, 10).Return(nil, mockErr).Times(1)
+	resp, err := a.GfSpListUsageRollup(context.Background(), &types.GfSpListUsageRollupRequest{Limit: 10})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp.GetErr())
+}
+
+func TestMetadataModular_MeterUsage(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	spDB := spdb.NewMockSPDB(ctrl)
+	bsDB := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfSpDB(spDB)
+	a.baseApp.SetGfBsDB(bsDB)
+
+	now := time.Date(2023, 8, 3, 10, 0, 0, 0, time.UTC)
+	day := time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC)
+	spDB.EXPECT().GetLatestUsageRollupDay().Return("2023-08-01", nil).Times(1)
+	spDB.EXPECT().AggregateReadUsage(day.UnixMicro(), now.Truncate(24*time.Hour).UnixMicro()).Return([]*spdb.ReadUsage{
+		{BucketID: 1, BucketName: "mock-bucket", ReadCount: 2, ReadSize: 200},
+		{BucketID: 3, BucketName: "mock-bucket", ReadCount: 1, ReadSize: 100},
+		{BucketID: 4, BucketName: "unknown-bucket", ReadCount: 1, ReadSize: 10},
+	}, nil).Times(1)
+	bsDB.EXPECT().GetSPByAddress(gomock.Any()).Return(&bsdb.StorageProvider{SpId: 1}, nil).Times(1)
+	bsDB.EXPECT().ListVirtualGroupFamiliesBySpID(uint32(1)).Return([]*bsdb.GlobalVirtualGroupFamily{
+		{GlobalVirtualGroupFamilyId: 10}, {GlobalVirtualGroupFamilyId: 11}}, nil).Times(1)
+	bsDB.EXPECT().ListBucketsByVgfID([]uint32{10, 11}, common.Hash{}, MeteringBatchSize).Return(
+		[]*bsdb.Bucket{mockMeteringBucket(1, 1000), mockMeteringBucket(2, 2000)}, nil).Times(1)
+	bsDB.EXPECT().ListBucketsByIDs(gomock.Any(), true).Return([]*bsdb.Bucket{mockMeteringBucket(3, 3000)}, nil).Times(1)
+	spDB.EXPECT().UpdateUsageRollups(gomock.Any()).DoAndReturn(func(rollups []*spdb.UsageRollup) error {
+		assert.Equal(t, 4, len(rollups))
+		payment := common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D").String()
+		for _, rollup := range rollups {
+			assert.Equal(t, "2023-08-02", rollup.Day)
+			rollup.UpdateTime = 0
+		}
+		assert.Equal(t, &spdb.UsageRollup{BucketID: 1, Day: "2023-08-02", BucketName: "mock-bucket",
+			PaymentAddress: payment, ReadCount: 2, ReadSize: 200, StoredSize: 1000}, rollups[0])
+		assert.Equal(t, &spdb.UsageRollup{BucketID: 2, Day: "2023-08-02", BucketName: "mock-bucket",
+			PaymentAddress: payment, StoredSize: 2000}, rollups[1])
+		assert.Equal(t, &spdb.UsageRollup{BucketID: 3, Day: "2023-08-02", BucketName: "mock-bucket",
+			PaymentAddress: payment, ReadCount: 1, ReadSize: 100}, rollups[2])
+		assert.Equal(t, &spdb.UsageRollup{BucketID: 4, Day: "2023-08-02", BucketName: "unknown-bucket",
+			ReadCount: 1, ReadSize: 10}, rollups[3])
+		return nil
+	}).Times(1)
+	a.meterUsage(context.Background(), now)
+}
+
+func TestMetadataModular_MeterUsageUpToDate(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	spDB := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(spDB)
+	spDB.EXPECT().GetLatestUsageRollupDay().Return("2023-08-02", nil).Times(1)
+	a.meterUsage(context.Background(), time.Date(2023, 8, 3, 10, 0, 0, 0, time.UTC))
+}
+
+func TestMetadataModular_MeterUsageStopsOnFailure(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	spDB := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(spDB)
+	// the first time of metering rolls up the last finished day only, and stops on failure
+	spDB.EXPECT().GetLatestUsageRollupDay().Return("", nil).Times(1)
+	spDB.EXPECT().AggregateReadUsage(time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC).UnixMicro(), gomock.Any()).
+		Return(nil, mockErr).Times(1)
+	a.meterUsage(context.Background(), time.Date(2023, 8, 3, 10, 0, 0, 0, time.UTC))
+}
+
+func TestMetadataModular_MeterUsageSkipsMissedDays(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	spDB := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(spDB)
+	// only the last finished day is rolled up, the stored size of the missed days is unknown
+	now := time.Date(2023, 8, 3, 10, 0, 0, 0, time.UTC)
+	spDB.EXPECT().GetLatestUsageRollupDay().Return("2023-01-01", nil).Times(1)
+	spDB.EXPECT().AggregateReadUsage(time.Date(2023, 8, 2, 0, 0, 0, 0, time.UTC).UnixMicro(),
+		now.Truncate(24*time.Hour).UnixMicro()).Return(nil, mockErr).Times(1)
+	a.meterUsage(context.Background(), now)
+}
@@ -0,0 +1,320 @@
+package metadata
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+const (
+	// NotificationBatchSize defines the number of the notification events dispatched in a round.
+	NotificationBatchSize = 100
+	// NotificationPollInterval defines the interval of polling the new notification events.
+	NotificationPollInterval = time.Second
+	// NotificationSubscriptionRefreshInterval defines the interval of refreshing the subscriptions to dispatch.
+	NotificationSubscriptionRefreshInterval = 10 * time.Second
+	// NotificationPruneInterval defines the interval of pruning the notification events.
+	NotificationPruneInterval = time.Hour
+	// NotificationPruneBatchSize defines the number of the notification events deleted by a statement.
+	NotificationPruneBatchSize = 1000
+	// DefaultNotificationRetryInterval defines the initial backoff of retrying a notification delivery,
+	// the backoff doubles after every failed attempt.
+	DefaultNotificationRetryInterval = time.Second
+
+	// NotificationEventIDHeader defines the header of the notification event id.
+	NotificationEventIDHeader = "X-Gnfd-Notification-Id"
+	// NotificationEventTypeHeader defines the header of the notification event type.
+	NotificationEventTypeHeader = "X-Gnfd-Notification-Event"
+	// NotificationSignatureHeader defines the header of the notification signature, the format is
+	// "t=<unix timestamp>,v1=<hex(HMAC-SHA256(secret, "<unix timestamp>.<body>"))>".
+	NotificationSignatureHeader = "X-Gnfd-Notification-Signature"
+)
+
+var errPrivateWebhookAddress = errors.New("webhook resolves to a loopback, private or link-local address")
+
+// newWebhookClient returns the http client of delivering the notification events, the connections to the
+// loopback, private and link-local addresses are rejected after resolving unless allowPrivate is set.
+func newWebhookClient(timeout time.Duration, allowPrivate bool) *http.Client {
+	dialer := &net.Dialer{Timeout: timeout}
+	if !allowPrivate {
+		dialer.Control = func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			ip := net.ParseIP(host)
+			if ip == nil || ip.IsLoopback() || ip.IsPrivate() || ip.IsUnspecified() ||
+				ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() {
+				return errPrivateWebhookAddress
+			}
+			return nil
+		}
+	}
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	transport.Proxy = nil
+	transport.DialContext = dialer.DialContext
+	return &http.Client{
+		Timeout:   timeout,
+		Transport: transport,
+		// the redirects are not followed, otherwise the address check can be bypassed
+		CheckRedirect: func(*http.Request, []*http.Request) error {
+			return http.ErrUseLastResponse
+		},
+	}
+}
+
+// SignNotification returns the signature header value of the notification body.
+func SignNotification(secret string, timestamp int64, body []byte) string {
+	t := strconv.FormatInt(timestamp, 10)
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(t))
+	mac.Write([]byte("."))
+	mac.Write(body)
+	return "t=" + t + ",v1=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// matchNotificationSubscription returns whether the notification event matches the filters of the subscription.
+func matchNotificationSubscription(subscription *spdb.NotificationSubscription, event *bsdb.NotificationEvent) bool {
+	if subscription.BucketName != event.BucketName {
+		return false
+	}
+	if subscription.Prefix != "" && !strings.HasPrefix(event.ObjectName, subscription.Prefix) {
+		return false
+	}
+	if len(subscription.EventTypes) == 0 {
+		return true
+	}
+	for _, eventType := range subscription.EventTypes {
+		if eventType == event.EventType {
+			return true
+		}
+	}
+	return false
+}
+
+// dispatchNotificationLoop runs a dispatcher for every notification subscription until the context is done, so a
+// slow or dead webhook only delays the events of its own subscription, and prunes the notification events that
+// have been delivered to all the subscriptions periodically.
+func (r *MetadataModular) dispatchNotificationLoop(ctx context.Context) {
+	dispatchers := make(map[uint64]context.CancelFunc)
+	refreshTicker := time.NewTicker(NotificationSubscriptionRefreshInterval)
+	defer refreshTicker.Stop()
+	pruneTicker := time.NewTicker(NotificationPruneInterval)
+	defer pruneTicker.Stop()
+	r.refreshNotificationDispatchers(ctx, dispatchers)
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-refreshTicker.C:
+			r.refreshNotificationDispatchers(ctx, dispatchers)
+		case <-pruneTicker.C:
+			r.pruneNotificationEvents(ctx)
+		}
+	}
+}
+
+// refreshNotificationDispatchers starts the dispatchers of the new subscriptions and stops the ones of the
+// deleted subscriptions.
+func (r *MetadataModular) refreshNotificationDispatchers(ctx context.Context, dispatchers map[uint64]context.CancelFunc) {
+	subscriptions, err := r.baseApp.GfSpDB().ListAllNotificationSubscriptions()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list notification subscriptions", "error", err)
+		return
+	}
+	existed := make(map[uint64]bool, len(subscriptions))
+	for _, subscription := range subscriptions {
+		existed[subscription.ID] = true
+		if _, ok := dispatchers[subscription.ID]; ok {
+			continue
+		}
+		dispatchCtx, cancel := context.WithCancel(ctx)
+		dispatchers[subscription.ID] = cancel
+		go r.dispatchSubscriptionLoop(dispatchCtx, subscription)
+	}
+	for id, cancel := range dispatchers {
+		if !existed[id] {
+			cancel()
+			delete(dispatchers, id)
+		}
+	}
+}
+
+// dispatchSubscriptionLoop delivers the new notification events of the subscription until the context is done.
+func (r *MetadataModular) dispatchSubscriptionLoop(ctx context.Context, subscription *spdb.NotificationSubscription) {
+	cursor := subscription.DeliveredEventID
+	for ctx.Err() == nil {
+		var (
+			count int
+			err   error
+		)
+		cursor, count, err = r.dispatchSubscription(ctx, subscription, cursor)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to dispatch notifications", "subscription_id", subscription.ID, "error", err)
+		}
+		// keep dispatching without waiting if there are more events
+		if err == nil && count == NotificationBatchSize {
+			continue
+		}
+		select {
+		case <-ctx.Done():
+		case <-time.After(NotificationPollInterval):
+		}
+	}
+}
+
+// dispatchSubscription delivers a batch of the notification events of the bucket after the cursor to the webhook
+// of the subscription in order, and returns the new cursor and the number of the listed events. The event that
+// fails to be delivered after the max attempts is saved as a dead letter, so it does not stop the subscription.
+// The cursor is moved to the latest event once all the events of the bucket are delivered, so the cursors of the
+// quiet buckets do not hold back the pruning.
+func (r *MetadataModular) dispatchSubscription(ctx context.Context, subscription *spdb.NotificationSubscription,
+	cursor uint64) (uint64, int, error) {
+	latest, err := r.baseApp.GfBsDB().GetLatestNotificationEventID()
+	if err != nil || latest <= cursor {
+		return cursor, 0, err
+	}
+	events, err := r.baseApp.GfBsDB().ListNotificationEvents(cursor, NotificationBatchSize,
+		bsdb.BucketNameFilter(subscription.BucketName), bsdb.EventIDUpToFilter(latest))
+	if err != nil {
+		return cursor, 0, err
+	}
+
+	delivered := cursor
+	for _, event := range events {
+		if matchNotificationSubscription(subscription, event) {
+			r.deliverNotification(ctx, subscription, event)
+		}
+		if ctx.Err() != nil {
+			break
+		}
+		delivered = event.ID
+	}
+	if ctx.Err() == nil && len(events) < NotificationBatchSize {
+		delivered = latest
+	}
+	if delivered == cursor {
+		return cursor, len(events), nil
+	}
+	if err = r.baseApp.GfSpDB().UpdateNotificationCursor(subscription.ID, delivered); err != nil {
+		// the events are delivered again after restarting, the delivery is at-least-once
+		return delivered, len(events), err
+	}
+	return delivered, len(events), nil
+}
+
+// pruneNotificationEvents deletes the notification events that have been delivered to all the subscriptions and
+// are older than the retention, the slowest subscription holds back the pruning.
+func (r *MetadataModular) pruneNotificationEvents(ctx context.Context) {
+	endID, err := r.baseApp.GfBsDB().GetLatestNotificationEventID()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get latest notification event id", "error", err)
+		return
+	}
+	subscriptions, err := r.baseApp.GfSpDB().ListAllNotificationSubscriptions()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list notification subscriptions", "error", err)
+		return
+	}
+	for _, subscription := range subscriptions {
+		if subscription.DeliveredEventID < endID {
+			endID = subscription.DeliveredEventID
+		}
+	}
+	createTimeBefore := time.Now().Add(-r.notificationEventRetention).Unix()
+	var total int64
+	for ctx.Err() == nil {
+		deleted, deleteErr := r.baseApp.GfBsDB().DeleteNotificationEvents(endID, createTimeBefore, NotificationPruneBatchSize)
+		if deleteErr != nil {
+			log.CtxErrorw(ctx, "failed to delete notification events", "end_id", endID, "error", deleteErr)
+			return
+		}
+		total += deleted
+		if deleted < NotificationPruneBatchSize {
+			break
+		}
+	}
+	log.CtxInfow(ctx, "succeed to prune notification events", "end_id", endID, "deleted", total)
+}
+
+// deliverNotification posts the notification event to the webhook of the subscription with exponential
+// backoff retries, and saves the event as a dead letter if all the attempts fail.
+func (r *MetadataModular) deliverNotification(ctx context.Context, subscription *spdb.NotificationSubscription,
+	event *bsdb.NotificationEvent) {
+	body, err := json.Marshal(toNotificationEvent(event))
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to marshal notification event", "event_id", event.ID, "error", err)
+		return
+	}
+	backoff := r.notificationRetryInterval
+	var attempts uint32
+	for attempts = 1; ; attempts++ {
+		if err = r.postNotification(ctx, subscription, event, body); err == nil {
+			return
+		}
+		log.CtxWarnw(ctx, "failed to deliver notification", "subscription_id", subscription.ID,
+			"event_id", event.ID, "attempts", attempts, "error", err)
+		if attempts >= r.notificationMaxAttempts {
+			break
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(backoff):
+		}
+		backoff *= 2
+	}
+
+	if err = r.baseApp.GfSpDB().InsertNotificationDeadLetter(&spdb.NotificationDeadLetter{
+		SubscriptionID: subscription.ID,
+		EventID:        event.ID,
+		BucketName:     event.BucketName,
+		WebhookURL:     subscription.WebhookURL,
+		Payload:        string(body),
+		Attempts:       attempts,
+		LastError:      err.Error(),
+		CreateTime:     time.Now().Unix(),
+	}); err != nil {
+		log.CtxErrorw(ctx, "failed to insert notification dead letter", "subscription_id", subscription.ID,
+			"event_id", event.ID, "error", err)
+	}
+}
+
+// postNotification posts the notification body to the webhook of the subscription once.
+func (r *MetadataModular) postNotification(ctx context.Context, subscription *spdb.NotificationSubscription,
+	event *bsdb.NotificationEvent, body []byte) error {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, subscription.WebhookURL, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set(NotificationEventIDHeader, strconv.FormatUint(event.ID, 10))
+	req.Header.Set(NotificationEventTypeHeader, event.EventType)
+	if subscription.Secret != "" {
+		req.Header.Set(NotificationSignatureHeader, SignNotification(subscription.Secret, time.Now().Unix(), body))
+	}
+	resp, err := r.notificationClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < http.StatusOK || resp.StatusCode >= http.StatusMultipleChoices {
+		return fmt.Errorf("webhook responds with status code %d", resp.StatusCode)
+	}
+	return nil
+}
@@ -0,0 +1,220 @@
+package metadata
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/url"
+	"sync/atomic"
+	"time"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+	"github.com/bnb-chain/greenfield/types/s3util"
+)
+
+const (
+	// MaxNotificationSubscriptionsPerBucket defines the max number of the notification subscriptions of a bucket.
+	MaxNotificationSubscriptionsPerBucket = 10
+	// MaxNotificationSecretLength defines the max length of the secret of a notification subscription.
+	MaxNotificationSecretLength = 256
+	// MaxNotificationPrefixLength defines the max length of the prefix filter of a notification subscription.
+	MaxNotificationPrefixLength = 1024
+	// MaxNotificationWebhookURLLength defines the max length of the webhook url of a notification subscription.
+	MaxNotificationWebhookURLLength = 1024
+)
+
+var (
+	ErrInvalidNotificationSubscription = gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90011, "invalid notification subscription")
+	ErrNoSuchNotificationSubscription  = gfsperrors.Register(coremodule.MetadataModularName, http.StatusNotFound, 90012, "the specified notification subscription does not exist")
+	ErrExceedNotificationSubscriptions = gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90013, "the bucket has too many notification subscriptions")
+)
+
+func ErrInvalidNotificationSubscriptionWithDetail(detail string) *gfsperrors.GfSpError {
+	return gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90011, detail)
+}
+
+// GfSpCreateNotificationSubscription creates a notification subscription of the bucket.
+func (r *MetadataModular) GfSpCreateNotificationSubscription(
+	ctx context.Context,
+	req *types.GfSpCreateNotificationSubscriptionRequest) (
+	*types.GfSpCreateNotificationSubscriptionResponse,
+	error) {
+	subscription := req.GetSubscription()
+	if err := checkNotificationSubscription(subscription, req.GetSecret()); err != nil {
+		log.CtxErrorw(ctx, "failed to check notification subscription", "error", err)
+		return &types.GfSpCreateNotificationSubscriptionResponse{Err: err}, nil
+	}
+	existed, err := r.baseApp.GfSpDB().ListNotificationSubscriptions([]string{subscription.GetBucketName()})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list notification subscriptions", "error", err)
+		return &types.GfSpCreateNotificationSubscriptionResponse{Err: ErrGfSpDBWithDetail("failed to list notification subscriptions, error: " + err.Error())}, nil
+	}
+	if len(existed) >= MaxNotificationSubscriptionsPerBucket {
+		return &types.GfSpCreateNotificationSubscriptionResponse{Err: ErrExceedNotificationSubscriptions}, nil
+	}
+	// the events recorded before the subscription is created are not delivered
+	latest, err := r.baseApp.GfBsDB().GetLatestNotificationEventID()
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to get latest notification event id", "error", err)
+		return &types.GfSpCreateNotificationSubscriptionResponse{Err: ErrGfSpDBWithDetail("failed to get latest notification event id, error: " + err.Error())}, nil
+	}
+	id, err := r.baseApp.GfSpDB().InsertNotificationSubscription(&spdb.NotificationSubscription{
+		BucketName: subscription.GetBucketName(),
+		EventTypes: subscription.GetEventTypes(),
+		Prefix:     subscription.GetPrefix(),
+		WebhookURL: subscription.GetWebhookUrl(),
+		Secret:     req.GetSecret(),
+		Creator:    subscription.GetCreator(),
+		CreateTime: time.Now().Unix(),
+
+		DeliveredEventID: latest,
+	})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to insert notification subscription", "error", err)
+		return &types.GfSpCreateNotificationSubscriptionResponse{Err: ErrGfSpDBWithDetail("failed to insert notification subscription, error: " + err.Error())}, nil
+	}
+	log.CtxInfow(ctx, "succeed to create notification subscription", "bucket_name", subscription.GetBucketName(), "id", id)
+	return &types.GfSpCreateNotificationSubscriptionResponse{Id: id}, nil
+}
+
+// GfSpListNotificationSubscriptions lists the notification subscriptions of the bucket without the secrets.
+func (r *MetadataModular) GfSpListNotificationSubscriptions(
+	ctx context.Context,
+	req *types.GfSpListNotificationSubscriptionsRequest) (
+	*types.GfSpListNotificationSubscriptionsResponse,
+	error) {
+	subscriptions, err := r.baseApp.GfSpDB().ListNotificationSubscriptions([]string{req.GetBucketName()})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list notification subscriptions", "error", err)
+		return &types.GfSpListNotificationSubscriptionsResponse{Err: ErrGfSpDBWithDetail("failed to list notification subscriptions, error: " + err.Error())}, nil
+	}
+	result := make([]*types.NotificationSubscription, 0, len(subscriptions))
+	for _, subscription := range subscriptions {
+		result = append(result, &types.NotificationSubscription{
+			Id:         subscription.ID,
+			BucketName: subscription.BucketName,
+			EventTypes: subscription.EventTypes,
+			Prefix:     subscription.Prefix,
+			WebhookUrl: subscription.WebhookURL,
+			Creator:    subscription.Creator,
+			CreateTime: subscription.CreateTime,
+		})
+	}
+	return &types.GfSpListNotificationSubscriptionsResponse{Subscriptions: result}, nil
+}
+
+// GfSpDeleteNotificationSubscription deletes the notification subscription of the bucket.
+func (r *MetadataModular) GfSpDeleteNotificationSubscription(
+	ctx context.Context,
+	req *types.GfSpDeleteNotificationSubscriptionRequest) (
+	*types.GfSpDeleteNotificationSubscriptionResponse,
+	error) {
+	err := r.baseApp.GfSpDB().DeleteNotificationSubscription(req.GetBucketName(), req.GetId())
+	if errors.Is(err, gorm.ErrRecordNotFound) {
+		return &types.GfSpDeleteNotificationSubscriptionResponse{Err: ErrNoSuchNotificationSubscription}, nil
+	}
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to delete notification subscription", "error", err)
+		return &types.GfSpDeleteNotificationSubscriptionResponse{Err: ErrGfSpDBWithDetail("failed to delete notification subscription, error: " + err.Error())}, nil
+	}
+	log.CtxInfow(ctx, "succeed to delete notification subscription", "bucket_name", req.GetBucketName(), "id", req.GetId())
+	return &types.GfSpDeleteNotificationSubscriptionResponse{}, nil
+}
+
+// GfSpListNotificationEvents lists the notification events of the bucket after the start after id.
+func (r *MetadataModular) GfSpListNotificationEvents(
+	ctx context.Context,
+	req *types.GfSpListNotificationEventsRequest) (
+	*types.GfSpListNotificationEventsResponse,
+	error) {
+	defer atomic.AddInt64(&r.retrievingRequest, -1)
+	if atomic.AddInt64(&r.retrievingRequest, 1) >
+		atomic.LoadInt64(&r.maxMetadataRequest) {
+		return nil, ErrExceedRequest
+	}
+	if req.GetFromLatest() {
+		latest, err := r.baseApp.GfBsDB().GetLatestNotificationEventID()
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to get latest notification event id", "error", err)
+			return &types.GfSpListNotificationEventsResponse{Err: ErrGfSpDBWithDetail("failed to get latest notification event id, error: " + err.Error())}, nil
+		}
+		return &types.GfSpListNotificationEventsResponse{LastId: latest}, nil
+	}
+
+	limit := int(req.GetLimit())
+	if limit <= 0 || limit > bsdb.ListNotificationEventsLimitSize {
+		limit = bsdb.ListNotificationEventsLimitSize
+	}
+	filters := []func(*gorm.DB) *gorm.DB{bsdb.BucketNameFilter(req.GetBucketName())}
+	if req.GetPrefix() != "" {
+		filters = append(filters, bsdb.PrefixFilter(req.GetPrefix()))
+	}
+	if len(req.GetEventTypes()) > 0 {
+		filters = append(filters, bsdb.EventTypesFilter(req.GetEventTypes()))
+	}
+	events, err := r.baseApp.GfBsDB().ListNotificationEvents(req.GetStartAfterId(), limit, filters...)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list notification events", "error", err)
+		return &types.GfSpListNotificationEventsResponse{Err: ErrGfSpDBWithDetail("failed to list notification events, error: " + err.Error())}, nil
+	}
+	result := make([]*types.NotificationEvent, 0, len(events))
+	for _, event := range events {
+		result = append(result, toNotificationEvent(event))
+	}
+	lastID := req.GetStartAfterId()
+	if len(events) > 0 {
+		lastID = events[len(events)-1].ID
+	}
+	return &types.GfSpListNotificationEventsResponse{Events: result, LastId: lastID}, nil
+}
+
+func toNotificationEvent(event *bsdb.NotificationEvent) *types.NotificationEvent {
+	return &types.NotificationEvent{
+		Id:          event.ID,
+		EventType:   event.EventType,
+		BucketName:  event.BucketName,
+		ObjectName:  event.ObjectName,
+		ObjectId:    event.ObjectID.Big().Uint64(),
+		Operator:    event.Operator.String(),
+		BlockHeight: event.CreateAt,
+		TxHash:      event.CreateTxHash.String(),
+		EventTime:   event.CreateTime,
+	}
+}
+
+// checkNotificationSubscription checks the bucket name, the event types, the webhook url and the filters
+// of the notification subscription.
+func checkNotificationSubscription(subscription *types.NotificationSubscription, secret string) *gfsperrors.GfSpError {
+	if subscription == nil {
+		return ErrInvalidNotificationSubscription
+	}
+	if err := s3util.CheckValidBucketName(subscription.GetBucketName()); err != nil {
+		return ErrInvalidBucketName
+	}
+	for _, eventType := range subscription.GetEventTypes() {
+		if !bsdb.NotificationEventTypes[eventType] {
+			return ErrInvalidNotificationSubscriptionWithDetail("unsupported notification event type: " + eventType)
+		}
+	}
+	if len(subscription.GetPrefix()) > MaxNotificationPrefixLength {
+		return ErrInvalidNotificationSubscriptionWithDetail("notification prefix is too long")
+	}
+	if len(secret) > MaxNotificationSecretLength {
+		return ErrInvalidNotificationSubscriptionWithDetail("notification secret is too long")
+	}
+	if len(subscription.GetWebhookUrl()) > MaxNotificationWebhookURLLength {
+		return ErrInvalidNotificationSubscriptionWithDetail("notification webhook url is too long")
+	}
+	webhookURL, err := url.Parse(subscription.GetWebhookUrl())
+	if err != nil || (webhookURL.Scheme != "http" && webhookURL.Scheme != "https") || webhookURL.Host == "" {
+		return ErrInvalidNotificationSubscriptionWithDetail("invalid notification webhook url")
+	}
+	return nil
+}
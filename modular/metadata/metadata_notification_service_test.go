@@ -0,0 +1,341 @@
+package metadata
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
+	"github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
+)
+
+func mockNotificationEvent(id uint64, eventType, objectName string) *bsdb.NotificationEvent {
+	return &bsdb.NotificationEvent{
+		ID:         id,
+		EventType:  eventType,
+		BucketName: "mock-bucket",
+		ObjectName: objectName,
+		ObjectID:   common.HexToHash("0x10"),
+		Operator:   common.HexToAddress("0x11E0A11A7A01E2E757447B52FBD7152004AC699D"),
+		CreateAt:   100,
+		CreateTime: 1,
+	}
+}
+
+func TestMetadataModular_GfSpCreateNotificationSubscription(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	bs := bsdb.NewMockMetadata(ctrl)
+	a.baseApp.SetGfBsDB(bs)
+	subscription := &types.NotificationSubscription{
+		BucketName: "mock-bucket",
+		EventTypes: []string{bsdb.NotificationEventObjectSealed},
+		Prefix:     "photos/",
+		WebhookUrl: "https://example.com/hook",
+		Creator:    "0x01",
+	}
+	m.EXPECT().ListNotificationSubscriptions([]string{"mock-bucket"}).Return(nil, nil).Times(1)
+	bs.EXPECT().GetLatestNotificationEventID().Return(uint64(20), nil).Times(1)
+	m.EXPECT().InsertNotificationSubscription(gomock.Any()).DoAndReturn(
+		func(sub *spdb.NotificationSubscription) (uint64, error) {
+			assert.Equal(t, "secret", sub.Secret)
+			assert.Equal(t, []string{bsdb.NotificationEventObjectSealed}, sub.EventTypes)
+			assert.Equal(t, uint64(20), sub.DeliveredEventID)
+			return 1, nil
+		}).Times(1)
+	resp, err := a.GfSpCreateNotificationSubscription(context.Background(),
+		&types.GfSpCreateNotificationSubscriptionRequest{Subscription: subscription, Secret: "secret"})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetErr())
+	assert.Equal(t, uint64(1), resp.GetId())
+
+	// failed due to too many subscriptions
+	existed := make([]*spdb.NotificationSubscription, MaxNotificationSubscriptionsPerBucket)
+	m.EXPECT().ListNotificationSubscriptions([]string{"mock-bucket"}).Return(existed, nil).Times(1)
+	resp, err = a.GfSpCreateNotificationSubscription(context.Background(),
+		&types.GfSpCreateNotificationSubscriptionRequest{Subscription: subscription})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrExceedNotificationSubscriptions, resp.GetErr())
+
+	// failed due to db error
+	m.EXPECT().ListNotificationSubscriptions([]string{"mock-bucket"}).Return(nil, mockErr).Times(1)
+	resp, err = a.GfSpCreateNotificationSubscription(context.Background(),
+		&types.GfSpCreateNotificationSubscriptionRequest{Subscription: subscription})
+	assert.Nil(t, err)
+	assert.NotNil(t, resp.GetErr())
+}
+
+func TestCheckNotificationSubscription(t *testing.T) {
+	cases := []struct {
+		name         string
+		subscription *types.NotificationSubscription
+		secret       string
+		valid        bool
+	}{
+		{"valid", &types.NotificationSubscription{BucketName: "mock-bucket", WebhookUrl: "http://example.com/hook"}, "", true},
+		{"nil subscription", nil, "", false},
+		{"invalid bucket name", &types.NotificationSubscription{BucketName: "B", WebhookUrl: "http://example.com"}, "", false},
+		{"invalid event type", &types.NotificationSubscription{BucketName: "mock-bucket", EventTypes: []string{"Unknown"},
+			WebhookUrl: "http://example.com"}, "", false},
+		{"invalid scheme", &types.NotificationSubscription{BucketName: "mock-bucket", WebhookUrl: "ftp://example.com"}, "", false},
+		{"relative url", &types.NotificationSubscription{BucketName: "mock-bucket", WebhookUrl: "/hook"}, "", false},
+		{"too long secret", &types.NotificationSubscription{BucketName: "mock-bucket", WebhookUrl: "http://example.com"},
+			strings.Repeat("s", MaxNotificationSecretLength+1), false},
+	}
+	for _, c := range cases {
+		t.Run(c.name, func(t *testing.T) {
+			err := checkNotificationSubscription(c.subscription, c.secret)
+			assert.Equal(t, c.valid, err == nil)
+		})
+	}
+}
+
+func TestMetadataModular_GfSpListNotificationSubscriptions(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	m.EXPECT().ListNotificationSubscriptions([]string{"mock-bucket"}).Return([]*spdb.NotificationSubscription{
+		{ID: 1, BucketName: "mock-bucket", WebhookURL: "https://example.com/hook", Secret: "secret", CreateTime: 1},
+	}, nil).Times(1)
+	resp, err := a.GfSpListNotificationSubscriptions(context.Background(),
+		&types.GfSpListNotificationSubscriptionsRequest{BucketName: "mock-bucket"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*types.NotificationSubscription{{Id: 1, BucketName: "mock-bucket",
+		WebhookUrl: "https://example.com/hook", CreateTime: 1}}, resp.GetSubscriptions())
+}
+
+func TestMetadataModular_GfSpDeleteNotificationSubscription(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(m)
+	m.EXPECT().DeleteNotificationSubscription("mock-bucket", uint64(1)).Return(nil).Times(1)
+	resp, err := a.GfSpDeleteNotificationSubscription(context.Background(),
+		&types.GfSpDeleteNotificationSubscriptionRequest{BucketName: "mock-bucket", Id: 1})
+	assert.Nil(t, err)
+	assert.Nil(t, resp.GetErr())
+
+	m.EXPECT().DeleteNotificationSubscription("mock-bucket", uint64(2)).Return(gorm.ErrRecordNotFound).Times(1)
+	resp, err = a.GfSpDeleteNotificationSubscription(context.Background(),
+		&types.GfSpDeleteNotificationSubscriptionRequest{BucketName: "mock-bucket", Id: 2})
+	assert.Nil(t, err)
+	assert.Equal(t, ErrNoSuchNotificationSubscription, resp.GetErr())
+}
+
+func TestMetadataModular_GfSpListNotificationEvents(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockMetadata(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().GetLatestNotificationEventID().Return(uint64(10), nil).Times(1)
+	resp, err := a.GfSpListNotificationEvents(context.Background(),
+		&types.GfSpListNotificationEventsRequest{BucketName: "mock-bucket", FromLatest: true})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), resp.GetLastId())
+	assert.Empty(t, resp.GetEvents())
+
+	m.EXPECT().ListNotificationEvents(uint64(10), bsdb.ListNotificationEventsLimitSize, gomock.Any(), gomock.Any()).
+		Return([]*bsdb.NotificationEvent{mockNotificationEvent(11, bsdb.NotificationEventObjectSealed, "photos/a")}, nil).
+		Times(1)
+	resp, err = a.GfSpListNotificationEvents(context.Background(),
+		&types.GfSpListNotificationEventsRequest{BucketName: "mock-bucket", StartAfterId: 10, Prefix: "photos/"})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(11), resp.GetLastId())
+	assert.Equal(t, 1, len(resp.GetEvents()))
+	assert.Equal(t, uint64(16), resp.GetEvents()[0].GetObjectId())
+	assert.Equal(t, "photos/a", resp.GetEvents()[0].GetObjectName())
+
+	// no new events keeps the start after id
+	m.EXPECT().ListNotificationEvents(uint64(11), 5, gomock.Any()).Return(nil, nil).Times(1)
+	resp, err = a.GfSpListNotificationEvents(context.Background(),
+		&types.GfSpListNotificationEventsRequest{BucketName: "mock-bucket", StartAfterId: 11, Limit: 5})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(11), resp.GetLastId())
+}
+
+func TestSignNotification(t *testing.T) {
+	mac := hmac.New(sha256.New, []byte("secret"))
+	mac.Write([]byte("1.{}"))
+	assert.Equal(t, "t=1,v1="+hex.EncodeToString(mac.Sum(nil)), SignNotification("secret", 1, []byte("{}")))
+	assert.NotEqual(t, SignNotification("secret", 1, []byte("{}")), SignNotification("other", 1, []byte("{}")))
+}
+
+func TestMatchNotificationSubscription(t *testing.T) {
+	event := mockNotificationEvent(1, bsdb.NotificationEventObjectSealed, "photos/a")
+	assert.True(t, matchNotificationSubscription(&spdb.NotificationSubscription{BucketName: "mock-bucket"}, event))
+	assert.True(t, matchNotificationSubscription(&spdb.NotificationSubscription{BucketName: "mock-bucket",
+		Prefix: "photos/", EventTypes: []string{bsdb.NotificationEventObjectSealed}}, event))
+	assert.False(t, matchNotificationSubscription(&spdb.NotificationSubscription{BucketName: "other-bucket"}, event))
+	assert.False(t, matchNotificationSubscription(&spdb.NotificationSubscription{BucketName: "mock-bucket",
+		Prefix: "videos/"}, event))
+	assert.False(t, matchNotificationSubscription(&spdb.NotificationSubscription{BucketName: "mock-bucket",
+		EventTypes: []string{bsdb.NotificationEventObjectDeleted}}, event))
+}
+
+func TestMetadataModular_DispatchSubscription(t *testing.T) {
+	var received int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := io.ReadAll(r.Body)
+		event := &types.NotificationEvent{}
+		assert.Nil(t, json.Unmarshal(body, event))
+		signature := r.Header.Get(NotificationSignatureHeader)
+		timestamp := strings.TrimPrefix(strings.Split(signature, ",")[0], "t=")
+		ts, _ := strconv.ParseInt(timestamp, 10, 64)
+		assert.Equal(t, SignNotification("secret", ts, body), signature)
+		atomic.AddInt32(&received, 1)
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+
+	a := setup(t)
+	a.notificationClient = newWebhookClient(time.Second, true)
+	ctrl := gomock.NewController(t)
+	sp := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(sp)
+	bs := bsdb.NewMockMetadata(ctrl)
+	a.baseApp.SetGfBsDB(bs)
+	subscription := &spdb.NotificationSubscription{ID: 1, BucketName: "mock-bucket", Prefix: "photos/",
+		WebhookURL: server.URL, Secret: "secret", DeliveredEventID: 10}
+	bs.EXPECT().GetLatestNotificationEventID().Return(uint64(20), nil).Times(1)
+	bs.EXPECT().ListNotificationEvents(uint64(10), NotificationBatchSize, gomock.Any(), gomock.Any()).Return(
+		[]*bsdb.NotificationEvent{
+			mockNotificationEvent(11, bsdb.NotificationEventObjectCreated, "photos/a"),
+			mockNotificationEvent(12, bsdb.NotificationEventObjectSealed, "photos/a"),
+			mockNotificationEvent(13, bsdb.NotificationEventObjectSealed, "videos/b"),
+		}, nil).Times(1)
+	// all the events of the bucket up to the latest event are delivered
+	sp.EXPECT().UpdateNotificationCursor(uint64(1), uint64(20)).Return(nil).Times(1)
+	cursor, count, err := a.dispatchSubscription(context.Background(), subscription, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(20), cursor)
+	assert.Equal(t, 3, count)
+	assert.Equal(t, int32(2), atomic.LoadInt32(&received))
+
+	// nothing is listed if there is no new event
+	bs.EXPECT().GetLatestNotificationEventID().Return(uint64(20), nil).Times(1)
+	cursor, count, err = a.dispatchSubscription(context.Background(), subscription, 20)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(20), cursor)
+	assert.Equal(t, 0, count)
+}
+
+func TestMetadataModular_DispatchSubscriptionCanceled(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	sp := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(sp)
+	bs := bsdb.NewMockMetadata(ctrl)
+	a.baseApp.SetGfBsDB(bs)
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	// the cursor is not moved if the dispatcher is stopped before delivering
+	bs.EXPECT().GetLatestNotificationEventID().Return(uint64(20), nil).Times(1)
+	bs.EXPECT().ListNotificationEvents(uint64(10), NotificationBatchSize, gomock.Any(), gomock.Any()).Return(
+		[]*bsdb.NotificationEvent{mockNotificationEvent(11, bsdb.NotificationEventObjectCreated, "photos/a")}, nil).Times(1)
+	cursor, count, err := a.dispatchSubscription(ctx, &spdb.NotificationSubscription{ID: 1, BucketName: "mock-bucket",
+		WebhookURL: "http://127.0.0.1:1"}, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(10), cursor)
+	assert.Equal(t, 1, count)
+}
+
+func TestMetadataModular_RefreshNotificationDispatchers(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	sp := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(sp)
+	// the dispatchers exit at once with the canceled context
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+	var stopped bool
+	dispatchers := map[uint64]context.CancelFunc{1: func() {}, 3: func() { stopped = true }}
+	sp.EXPECT().ListAllNotificationSubscriptions().Return([]*spdb.NotificationSubscription{
+		{ID: 1, BucketName: "mock-bucket"}, {ID: 2, BucketName: "mock-bucket"}}, nil).Times(1)
+	a.refreshNotificationDispatchers(ctx, dispatchers)
+	assert.Equal(t, 2, len(dispatchers))
+	assert.NotNil(t, dispatchers[1])
+	assert.NotNil(t, dispatchers[2])
+	assert.True(t, stopped)
+
+	sp.EXPECT().ListAllNotificationSubscriptions().Return(nil, mockErr).Times(1)
+	a.refreshNotificationDispatchers(ctx, dispatchers)
+	assert.Equal(t, 2, len(dispatchers))
+}
+
+func TestMetadataModular_PruneNotificationEvents(t *testing.T) {
+	a := setup(t)
+	a.notificationEventRetention = time.Hour
+	ctrl := gomock.NewController(t)
+	sp := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(sp)
+	bs := bsdb.NewMockMetadata(ctrl)
+	a.baseApp.SetGfBsDB(bs)
+	bs.EXPECT().GetLatestNotificationEventID().Return(uint64(5000), nil).Times(1)
+	sp.EXPECT().ListAllNotificationSubscriptions().Return([]*spdb.NotificationSubscription{
+		{ID: 1, DeliveredEventID: 5000}, {ID: 2, DeliveredEventID: 3000}}, nil).Times(1)
+	// the events are pruned up to the cursor of the slowest subscription
+	gomock.InOrder(
+		bs.EXPECT().DeleteNotificationEvents(uint64(3000), gomock.Any(), NotificationPruneBatchSize).
+			Return(int64(NotificationPruneBatchSize), nil),
+		bs.EXPECT().DeleteNotificationEvents(uint64(3000), gomock.Any(), NotificationPruneBatchSize).
+			DoAndReturn(func(endID uint64, createTimeBefore int64, limit int) (int64, error) {
+				assert.InDelta(t, time.Now().Add(-time.Hour).Unix(), createTimeBefore, 5)
+				return 10, nil
+			}),
+	)
+	a.pruneNotificationEvents(context.Background())
+}
+
+func TestMetadataModular_DeliverNotificationDeadLetter(t *testing.T) {
+	var attempts int32
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		atomic.AddInt32(&attempts, 1)
+		w.WriteHeader(http.StatusInternalServerError)
+	}))
+	defer server.Close()
+
+	a := setup(t)
+	a.notificationClient = newWebhookClient(time.Second, true)
+	a.notificationMaxAttempts = 3
+	a.notificationRetryInterval = time.Millisecond
+	ctrl := gomock.NewController(t)
+	sp := spdb.NewMockSPDB(ctrl)
+	a.baseApp.SetGfSpDB(sp)
+	sp.EXPECT().InsertNotificationDeadLetter(gomock.Any()).DoAndReturn(func(letter *spdb.NotificationDeadLetter) error {
+		assert.Equal(t, uint64(1), letter.SubscriptionID)
+		assert.Equal(t, uint64(11), letter.EventID)
+		assert.Equal(t, uint32(3), letter.Attempts)
+		return nil
+	}).Times(1)
+	a.deliverNotification(context.Background(), &spdb.NotificationSubscription{ID: 1, BucketName: "mock-bucket",
+		WebhookURL: server.URL}, mockNotificationEvent(11, bsdb.NotificationEventObjectCreated, "photos/a"))
+	assert.Equal(t, int32(3), atomic.LoadInt32(&attempts))
+}
+
+func TestNewWebhookClient_RejectPrivateAddress(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusOK)
+	}))
+	defer server.Close()
+	_, err := newWebhookClient(time.Second, false).Get(server.URL)
+	assert.ErrorContains(t, err, errPrivateWebhookAddress.Error())
+}
@@ -4,11 +4,14 @@ import (
 	"context"
 	"encoding/base64"
 	"errors"
+	"net/http"
 
 	"cosmossdk.io/math"
 	"github.com/forbole/juno/v4/common"
 	"gorm.io/gorm"
 
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsperrors"
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
 	"github.com/bnb-chain/greenfield-storage-provider/modular/metadata/types"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	model "github.com/bnb-chain/greenfield-storage-provider/store/bsdb"
@@ -17,6 +20,13 @@ import (
 	virtualtypes "github.com/bnb-chain/greenfield/x/virtualgroup/types"
 )
 
+// ErrInvalidListObjectsOptions defines the error of the invalid filters, sort order or summary mode of listing objects
+var ErrInvalidListObjectsOptions = gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90014, "invalid list objects options")
+
+func ErrInvalidListObjectsOptionsWithDetail(detail string) *gfsperrors.GfSpError {
+	return gfsperrors.Register(coremodule.MetadataModularName, http.StatusBadRequest, 90014, detail)
+}
+
 // GfSpListObjectsByBucketName list objects info by a bucket name
 func (r *MetadataModular) GfSpListObjectsByBucketName(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest) (resp *types.GfSpListObjectsByBucketNameResponse, err error) {
 	var (
@@ -41,6 +51,17 @@ func (r *MetadataModular) GfSpListObjectsByBucketName(ctx context.Context, req *
 	}
 
 	ctx = log.Context(ctx, req)
+	if err = checkListObjectsOptions(req); err != nil {
+		log.CtxErrorw(ctx, "failed to check list objects options", "error", err)
+		return nil, err
+	}
+	if req.Summary {
+		return r.summarizeObjectsByBucketName(ctx, req, maxKeys)
+	}
+	if hasListObjectsOptions(req) {
+		return r.listObjectsByBucketNameWithFilters(ctx, req, maxKeys)
+	}
+
 	results, err = r.baseApp.GfBsDB().ListObjectsByBucketName(req.BucketName, req.ContinuationToken, req.Prefix, req.Delimiter, int(maxKeys), req.IncludeRemoved)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to list objects by bucket name", "error", err)
@@ -65,38 +86,7 @@ func (r *MetadataModular) GfSpListObjectsByBucketName(ctx context.Context, req *
 		if object.ResultType == "common_prefix" {
 			commonPrefixes = append(commonPrefixes, object.PathName)
 		} else {
-			res = append(res, &types.Object{
-				ObjectInfo: &storagetypes.ObjectInfo{
-					Owner:               object.Owner.String(),
-					Creator:             object.Creator.String(),
-					BucketName:          object.BucketName,
-					ObjectName:          object.ObjectName,
-					Id:                  math.NewUintFromBigInt(object.ObjectID.Big()),
-					LocalVirtualGroupId: object.LocalVirtualGroupId,
-					PayloadSize:         object.PayloadSize,
-					Visibility:          storagetypes.VisibilityType(storagetypes.VisibilityType_value[object.Visibility]),
-					ContentType:         object.ContentType,
-					CreateAt:            object.CreateTime,
-					ObjectStatus:        storagetypes.ObjectStatus(storagetypes.ObjectStatus_value[object.ObjectStatus]),
-					RedundancyType:      storagetypes.RedundancyType(storagetypes.RedundancyType_value[object.RedundancyType]),
-					SourceType:          storagetypes.SourceType(storagetypes.SourceType_value[object.SourceType]),
-					Checksums:           object.Checksums,
-					Tags:                object.GetResourceTags(),
-					IsUpdating:          object.IsUpdating,
-					UpdatedAt:           object.ContentUpdatedTime,
-					UpdatedBy:           object.Updater.String(),
-					Version:             object.Version,
-				},
-				LockedBalance: object.LockedBalance.String(),
-				Removed:       object.Removed,
-				UpdateAt:      object.UpdateAt,
-				DeleteAt:      object.DeleteAt,
-				DeleteReason:  object.DeleteReason,
-				Operator:      object.Operator.String(),
-				CreateTxHash:  object.CreateTxHash.String(),
-				UpdateTxHash:  object.UpdateTxHash.String(),
-				SealTxHash:    object.SealTxHash.String(),
-			})
+			res = append(res, newObject(object.Object))
 		}
 	}
 
@@ -116,6 +106,210 @@ func (r *MetadataModular) GfSpListObjectsByBucketName(ctx context.Context, req *
 	return resp, nil
 }
 
+// hasListObjectsOptions returns whether the request lists the objects with the filters or the sort order
+func hasListObjectsOptions(req *types.GfSpListObjectsByBucketNameRequest) bool {
+	return req.MinPayloadSize != 0 || req.MaxPayloadSize != 0 || req.CreateTimeStart != 0 || req.CreateTimeEnd != 0 ||
+		req.ContentType != "" || req.ObjectStatus != "" || req.Visibility != "" || req.Creator != "" ||
+		req.SortBy != "" || req.SortDesc
+}
+
+// checkListObjectsOptions checks the filters, the sort order and the summary mode of listing objects
+func checkListObjectsOptions(req *types.GfSpListObjectsByBucketNameRequest) error {
+	if _, ok := model.ListObjectsSortColumns[req.SortBy]; req.SortBy != "" && !ok {
+		return ErrInvalidListObjectsOptionsWithDetail("unknown sort key: " + req.SortBy)
+	}
+	if req.MaxPayloadSize != 0 && req.MaxPayloadSize < req.MinPayloadSize {
+		return ErrInvalidListObjectsOptionsWithDetail("max payload size is less than min payload size")
+	}
+	if req.CreateTimeEnd != 0 && req.CreateTimeEnd < req.CreateTimeStart {
+		return ErrInvalidListObjectsOptionsWithDetail("create time end is before create time start")
+	}
+	if req.Creator != "" && !common.IsHexAddress(req.Creator) {
+		return ErrInvalidListObjectsOptionsWithDetail("invalid creator address: " + req.Creator)
+	}
+	if req.ObjectStatus != "" {
+		if _, ok := storagetypes.ObjectStatus_value[req.ObjectStatus]; !ok {
+			return ErrInvalidListObjectsOptionsWithDetail("unknown object status: " + req.ObjectStatus)
+		}
+	}
+	if req.Visibility != "" {
+		if _, ok := storagetypes.VisibilityType_value[req.Visibility]; !ok {
+			return ErrInvalidListObjectsOptionsWithDetail("unknown visibility: " + req.Visibility)
+		}
+	}
+	// the common prefixes of the delimiter are listed from the prefix tree that has no object attributes
+	if !req.Summary && req.Delimiter != "" && hasListObjectsOptions(req) {
+		return ErrInvalidListObjectsOptionsWithDetail("delimiter is not supported with filters or sort order")
+	}
+	if req.Summary && (req.SortBy != "" || req.SortDesc) {
+		return ErrInvalidListObjectsOptionsWithDetail("sort order is not supported with summary")
+	}
+	return nil
+}
+
+// listObjectsFilters returns the filters on the object attributes of listing objects
+func listObjectsFilters(req *types.GfSpListObjectsByBucketNameRequest) []func(*gorm.DB) *gorm.DB {
+	var filters []func(*gorm.DB) *gorm.DB
+	if !req.IncludeRemoved {
+		filters = append(filters, model.RemovedFilter(false))
+	}
+	if req.MinPayloadSize != 0 {
+		filters = append(filters, model.MinPayloadSizeFilter(req.MinPayloadSize))
+	}
+	if req.MaxPayloadSize != 0 {
+		filters = append(filters, model.MaxPayloadSizeFilter(req.MaxPayloadSize))
+	}
+	if req.CreateTimeStart != 0 {
+		filters = append(filters, model.CreateTimeStartFilter(req.CreateTimeStart))
+	}
+	if req.CreateTimeEnd != 0 {
+		filters = append(filters, model.CreateTimeEndFilter(req.CreateTimeEnd))
+	}
+	if req.ContentType != "" {
+		filters = append(filters, model.ContentTypeFilter(req.ContentType))
+	}
+	if req.ObjectStatus != "" {
+		filters = append(filters, model.ObjectStatusFilter(req.ObjectStatus))
+	}
+	if req.Visibility != "" {
+		filters = append(filters, model.VisibilityFilter(req.Visibility))
+	}
+	if req.Creator != "" {
+		filters = append(filters, model.CreatorFilter(common.HexToAddress(req.Creator)))
+	}
+	return filters
+}
+
+// listObjectsByBucketNameWithFilters lists the objects that match the filters in the sort order of the request
+func (r *MetadataModular) listObjectsByBucketNameWithFilters(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest,
+	maxKeys uint64) (*types.GfSpListObjectsByBucketNameResponse, error) {
+	var (
+		isTruncated           bool
+		nextContinuationToken string
+		res                   []*types.Object
+	)
+
+	filters := listObjectsFilters(req)
+	if req.Prefix != "" {
+		filters = append(filters, model.PrefixFilter(req.Prefix))
+	}
+	if req.ContinuationToken != "" {
+		sortValue, objectName, err := model.DecodeSortedContinuationToken(req.SortBy, req.ContinuationToken)
+		if err != nil {
+			log.CtxErrorw(ctx, "failed to decode continuation token", "error", err)
+			return nil, ErrInvalidListObjectsOptionsWithDetail(err.Error())
+		}
+		filters = append(filters, model.SortedContinuationTokenFilter(req.SortBy, req.SortDesc, sortValue, objectName))
+	}
+
+	// return NextContinuationToken by adding 1 additionally
+	objects, err := r.baseApp.GfBsDB().ListObjectsWithFilters(req.BucketName, req.SortBy, req.SortDesc, int(maxKeys)+1, filters...)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to list objects with filters", "error", err)
+		return nil, err
+	}
+	if uint64(len(objects)) == maxKeys+1 {
+		isTruncated = true
+		nextContinuationToken = model.EncodeSortedContinuationToken(req.SortBy, objects[len(objects)-1])
+		objects = objects[:len(objects)-1]
+	}
+	for _, object := range objects {
+		res = append(res, newObject(object))
+	}
+
+	log.CtxInfo(ctx, "succeed to list objects by bucket name with filters")
+	return &types.GfSpListObjectsByBucketNameResponse{
+		Objects:               res,
+		KeyCount:              uint64(len(res)),
+		MaxKeys:               maxKeys,
+		IsTruncated:           isTruncated,
+		NextContinuationToken: base64.StdEncoding.EncodeToString([]byte(nextContinuationToken)),
+		Name:                  req.BucketName,
+		Prefix:                req.Prefix,
+		ContinuationToken:     base64.StdEncoding.EncodeToString([]byte(req.ContinuationToken)),
+	}, nil
+}
+
+// summarizeObjectsByBucketName returns the object count and the total size of the objects that match the filters
+// per common prefix of the delimiter under the prefix
+func (r *MetadataModular) summarizeObjectsByBucketName(ctx context.Context, req *types.GfSpListObjectsByBucketNameRequest,
+	maxKeys uint64) (*types.GfSpListObjectsByBucketNameResponse, error) {
+	var (
+		isTruncated           bool
+		nextContinuationToken string
+		res                   []*types.ObjectPrefixSummary
+	)
+
+	// return NextContinuationToken by adding 1 additionally
+	summaries, err := r.baseApp.GfBsDB().SummarizeObjectsByPrefix(req.BucketName, req.Prefix, req.Delimiter,
+		req.ContinuationToken, int(maxKeys)+1, listObjectsFilters(req)...)
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to summarize objects by prefix", "error", err)
+		return nil, err
+	}
+	if uint64(len(summaries)) == maxKeys+1 {
+		isTruncated = true
+		nextContinuationToken = summaries[len(summaries)-1].Prefix
+		summaries = summaries[:len(summaries)-1]
+	}
+	for _, summary := range summaries {
+		res = append(res, &types.ObjectPrefixSummary{
+			Prefix:      summary.Prefix,
+			ObjectCount: summary.ObjectCount,
+			TotalSize:   summary.TotalSize,
+		})
+	}
+
+	log.CtxInfo(ctx, "succeed to summarize objects by bucket name")
+	return &types.GfSpListObjectsByBucketNameResponse{
+		KeyCount:              uint64(len(res)),
+		MaxKeys:               maxKeys,
+		IsTruncated:           isTruncated,
+		NextContinuationToken: base64.StdEncoding.EncodeToString([]byte(nextContinuationToken)),
+		Name:                  req.BucketName,
+		Prefix:                req.Prefix,
+		Delimiter:             req.Delimiter,
+		ContinuationToken:     base64.StdEncoding.EncodeToString([]byte(req.ContinuationToken)),
+		PrefixSummaries:       res,
+	}, nil
+}
+
+// newObject converts the object of bsdb to the object of the metadata response
+func newObject(object *model.Object) *types.Object {
+	return &types.Object{
+		ObjectInfo: &storagetypes.ObjectInfo{
+			Owner:               object.Owner.String(),
+			Creator:             object.Creator.String(),
+			BucketName:          object.BucketName,
+			ObjectName:          object.ObjectName,
+			Id:                  math.NewUintFromBigInt(object.ObjectID.Big()),
+			LocalVirtualGroupId: object.LocalVirtualGroupId,
+			PayloadSize:         object.PayloadSize,
+			Visibility:          storagetypes.VisibilityType(storagetypes.VisibilityType_value[object.Visibility]),
+			ContentType:         object.ContentType,
+			CreateAt:            object.CreateTime,
+			ObjectStatus:        storagetypes.ObjectStatus(storagetypes.ObjectStatus_value[object.ObjectStatus]),
+			RedundancyType:      storagetypes.RedundancyType(storagetypes.RedundancyType_value[object.RedundancyType]),
+			SourceType:          storagetypes.SourceType(storagetypes.SourceType_value[object.SourceType]),
+			Checksums:           object.Checksums,
+			Tags:                object.GetResourceTags(),
+			IsUpdating:          object.IsUpdating,
+			UpdatedAt:           object.ContentUpdatedTime,
+			UpdatedBy:           object.Updater.String(),
+			Version:             object.Version,
+		},
+		LockedBalance: object.LockedBalance.String(),
+		Removed:       object.Removed,
+		UpdateAt:      object.UpdateAt,
+		DeleteAt:      object.DeleteAt,
+		DeleteReason:  object.DeleteReason,
+		Operator:      object.Operator.String(),
+		CreateTxHash:  object.CreateTxHash.String(),
+		UpdateTxHash:  object.UpdateTxHash.String(),
+		SealTxHash:    object.SealTxHash.String(),
+	}
+}
+
 // GfSpListDeletedObjectsByBlockNumberRange list deleted objects info by a block number range
 func (r *MetadataModular) GfSpListDeletedObjectsByBlockNumberRange(ctx context.Context, req *types.GfSpListDeletedObjectsByBlockNumberRangeRequest) (resp *types.GfSpListDeletedObjectsByBlockNumberRangeResponse, err error) {
 	ctx = log.Context(ctx, req)
@@ -193,7 +387,7 @@ func (r *MetadataModular) GfSpGetObjectMeta(ctx context.Context, req *types.GfSp
 		return nil, ErrInvalidParams
 	}
 
-	object, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, req.IncludePrivate)
+	object, err = r.bsDB().GetObjectByName(req.ObjectName, req.BucketName, req.IncludePrivate)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object by object name", "error", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
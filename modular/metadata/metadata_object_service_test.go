@@ -358,6 +358,88 @@ func TestMetadataModular_GfSpListObjectsByBucketName_Failed(t *testing.T) {
 	assert.NotNil(t, err)
 }
 
+func TestMetadataModular_GfSpListObjectsByBucketName_WithFilters(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().ListObjectsWithFilters("barry", bsdb.ListObjectsSortBySize, true, 3, gomock.Any()).DoAndReturn(
+		func(bucketName, sortBy string, sortDesc bool, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*bsdb.Object, error) {
+			// removed, min payload size, content type, creator, prefix and continuation token
+			assert.Equal(t, 6, len(filters))
+			return []*bsdb.Object{
+				{BucketName: "barry", ObjectName: "dir/c", PayloadSize: 300},
+				{BucketName: "barry", ObjectName: "dir/a", PayloadSize: 200},
+				{BucketName: "barry", ObjectName: "dir/b", PayloadSize: 200},
+			}, nil
+		}).Times(1)
+	resp, err := a.GfSpListObjectsByBucketName(context.Background(), &types.GfSpListObjectsByBucketNameRequest{
+		BucketName:        "barry",
+		MaxKeys:           2,
+		Prefix:            "dir/",
+		ContinuationToken: "400:dir/d",
+		MinPayloadSize:    100,
+		ContentType:       "image/png",
+		Creator:           "0xe978A9160BC061f602fa083e9C68539C549A421D",
+		SortBy:            bsdb.ListObjectsSortBySize,
+		SortDesc:          true,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(2), resp.KeyCount)
+	assert.True(t, resp.IsTruncated)
+	assert.Equal(t, "dir/a", resp.Objects[1].ObjectInfo.ObjectName)
+	assert.Equal(t, "MjAwOmRpci9i", resp.NextContinuationToken)
+}
+
+func TestMetadataModular_GfSpListObjectsByBucketName_Summary(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	m.EXPECT().SummarizeObjectsByPrefix("barry", "dir/", "/", "", 51, gomock.Any(), gomock.Any()).Return(
+		[]*bsdb.ObjectPrefixSummary{{Prefix: "dir/", ObjectCount: 1, TotalSize: 10}, {Prefix: "dir/a/", ObjectCount: 2, TotalSize: 300}}, nil).Times(1)
+	resp, err := a.GfSpListObjectsByBucketName(context.Background(), &types.GfSpListObjectsByBucketNameRequest{
+		BucketName:   "barry",
+		Prefix:       "dir/",
+		Delimiter:    "/",
+		ObjectStatus: "OBJECT_STATUS_SEALED",
+		Summary:      true,
+	})
+	assert.Nil(t, err)
+	assert.False(t, resp.IsTruncated)
+	assert.Empty(t, resp.Objects)
+	assert.Equal(t, []*types.ObjectPrefixSummary{{Prefix: "dir/", ObjectCount: 1, TotalSize: 10},
+		{Prefix: "dir/a/", ObjectCount: 2, TotalSize: 300}}, resp.PrefixSummaries)
+
+	m.EXPECT().SummarizeObjectsByPrefix("barry", "", "", "", 51, gomock.Any()).Return(nil, gorm.ErrInvalidDB).Times(1)
+	_, err = a.GfSpListObjectsByBucketName(context.Background(), &types.GfSpListObjectsByBucketNameRequest{
+		BucketName: "barry",
+		Summary:    true,
+	})
+	assert.Equal(t, gorm.ErrInvalidDB, err)
+}
+
+func TestMetadataModular_GfSpListObjectsByBucketName_InvalidOptions(t *testing.T) {
+	a := setup(t)
+	ctrl := gomock.NewController(t)
+	m := bsdb.NewMockBSDB(ctrl)
+	a.baseApp.SetGfBsDB(m)
+	for _, req := range []*types.GfSpListObjectsByBucketNameRequest{
+		{BucketName: "barry", SortBy: "owner"},
+		{BucketName: "barry", MinPayloadSize: 100, MaxPayloadSize: 10},
+		{BucketName: "barry", CreateTimeStart: 100, CreateTimeEnd: 10},
+		{BucketName: "barry", Creator: "creator"},
+		{BucketName: "barry", ObjectStatus: "SEALED"},
+		{BucketName: "barry", Visibility: "PUBLIC"},
+		{BucketName: "barry", Delimiter: "/", SortBy: bsdb.ListObjectsSortByName},
+		{BucketName: "barry", Summary: true, SortDesc: true},
+		{BucketName: "barry", SortBy: bsdb.ListObjectsSortByCreateTime, ContinuationToken: "dir/a"},
+	} {
+		_, err := a.GfSpListObjectsByBucketName(context.Background(), req)
+		assert.NotNil(t, err)
+	}
+}
+
 func TestMetadataModular_GfSpListDeletedObjectsByBlockNumberRange_Success(t *testing.T) {
 	a := setup(t)
 	ctrl := gomock.NewController(t)
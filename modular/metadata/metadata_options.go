@@ -4,6 +4,8 @@ import (
 	"runtime"
 	"time"
 
+	"golang.org/x/exp/slices"
+
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
@@ -16,6 +18,14 @@ const (
 	DefaultQuerySPParallelPerNode int64 = 10240
 	// DefaultBsDBSwitchCheckIntervalSec defines the default db switch check interval in seconds
 	DefaultBsDBSwitchCheckIntervalSec = 30
+	// DefaultMeteringIntervalSec defines the default interval of checking the days to be rolled up in seconds
+	DefaultMeteringIntervalSec = 60 * 60
+	// DefaultNotificationMaxAttempts defines the default max attempts of delivering a notification event
+	DefaultNotificationMaxAttempts = 5
+	// DefaultNotificationTimeoutSec defines the default timeout of a webhook request in seconds
+	DefaultNotificationTimeoutSec = 10
+	// DefaultNotificationEventRetentionSec defines the default seconds of keeping the delivered notification events
+	DefaultNotificationEventRetentionSec = 7 * 24 * 60 * 60
 )
 
 var (
@@ -52,6 +62,46 @@ func DefaultMetadataOptions(metadata *MetadataModular, cfg *gfspconfig.GfSpConfi
 
 	metadata.maxMetadataRequest = cfg.Parallel.QuerySPParallelPerNode
 
+	if cfg.Metadata.MeteringIntervalSec == 0 {
+		cfg.Metadata.MeteringIntervalSec = DefaultMeteringIntervalSec
+	}
+	metadata.enableMetering = cfg.Metadata.EnableMetering
+	metadata.meteringIntervalSec = cfg.Metadata.MeteringIntervalSec
+
+	if cfg.Metadata.NotificationMaxAttempts == 0 {
+		cfg.Metadata.NotificationMaxAttempts = DefaultNotificationMaxAttempts
+	}
+	if cfg.Metadata.NotificationTimeoutSec == 0 {
+		cfg.Metadata.NotificationTimeoutSec = DefaultNotificationTimeoutSec
+	}
+	if cfg.Metadata.NotificationEventRetentionSec == 0 {
+		cfg.Metadata.NotificationEventRetentionSec = DefaultNotificationEventRetentionSec
+	}
+	metadata.enableNotification = cfg.Metadata.EnableNotification
+	metadata.notificationMaxAttempts = cfg.Metadata.NotificationMaxAttempts
+	metadata.notificationRetryInterval = DefaultNotificationRetryInterval
+	metadata.notificationEventRetention = time.Duration(cfg.Metadata.NotificationEventRetentionSec) * time.Second
+	metadata.notificationClient = newWebhookClient(
+		time.Duration(cfg.Metadata.NotificationTimeoutSec)*time.Second, cfg.Metadata.NotificationAllowPrivateWebhook)
+
+	if cfg.Metadata.EnableCache {
+		// the cached entries are never invalidated without the module recording the cache invalidations
+		if !slices.Contains(cfg.BlockSyncer.Modules, CacheInvalidationModuleName) {
+			return ErrNoCacheInvalidationModule
+		}
+		if cfg.Metadata.CacheSize <= 0 {
+			cfg.Metadata.CacheSize = DefaultMetadataCacheSize
+		}
+		if cfg.Metadata.CacheTTLSec <= 0 {
+			cfg.Metadata.CacheTTLSec = DefaultMetadataCacheTTLSec
+		}
+		cache, err := newMetadataCache(cfg.Metadata.CacheSize, time.Duration(cfg.Metadata.CacheTTLSec)*time.Second)
+		if err != nil {
+			return err
+		}
+		metadata.cache = cache
+	}
+
 	metadata.baseApp.SetGfBsDB(metadata.baseApp.GfBsDBMaster())
 
 	BsModules = cfg.BlockSyncer.Modules
@@ -50,7 +50,7 @@ func (r *MetadataModular) GfSpVerifyPermission(ctx context.Context, req *storage
 		return nil, ErrInvalidBucketName
 	}
 
-	bucketInfo, err = r.baseApp.GfBsDB().GetBucketByName(req.BucketName, true)
+	bucketInfo, err = r.bsDB().GetBucketByName(req.BucketName, true)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get bucket info", "error", err)
 		return nil, err
@@ -67,7 +67,7 @@ func (r *MetadataModular) GfSpVerifyPermission(ctx context.Context, req *storage
 			return nil, err
 		}
 	} else {
-		objectInfo, err = r.baseApp.GfBsDB().GetObjectByName(req.ObjectName, req.BucketName, true)
+		objectInfo, err = r.bsDB().GetObjectByName(req.ObjectName, req.BucketName, true)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to get object info", "error", err)
 			if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -132,7 +132,7 @@ func (r *MetadataModular) verifyObject(ctx context.Context, req *types.GfSpVerif
 		return permtypes.EFFECT_DENY, err
 	}
 
-	objectInfo, err = r.baseApp.GfBsDB().GetObjectByID(int64(req.ResourceId), false)
+	objectInfo, err = r.bsDB().GetObjectByID(int64(req.ResourceId), false)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get object info", "error", err)
 		if errors.Is(err, gorm.ErrRecordNotFound) {
@@ -140,7 +140,7 @@ func (r *MetadataModular) verifyObject(ctx context.Context, req *types.GfSpVerif
 		}
 		return permtypes.EFFECT_DENY, err
 	}
-	bucketInfo, err = r.baseApp.GfBsDB().GetBucketByID(objectInfo.BucketID.Big().Int64(), true)
+	bucketInfo, err = r.bsDB().GetBucketByID(objectInfo.BucketID.Big().Int64(), true)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get bucket info", "error", err)
 		return permtypes.EFFECT_DENY, err
@@ -171,7 +171,7 @@ func (r *MetadataModular) verifyBucket(ctx context.Context, req *types.GfSpVerif
 		return permtypes.EFFECT_DENY, err
 	}
 
-	bucketInfo, err = r.baseApp.GfBsDB().GetBucketByID(int64(req.ResourceId), true)
+	bucketInfo, err = r.bsDB().GetBucketByID(int64(req.ResourceId), true)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get bucket info", "error", err)
 		return permtypes.EFFECT_DENY, err
@@ -202,7 +202,7 @@ func (r *MetadataModular) verifyGroup(ctx context.Context, req *types.GfSpVerify
 		return permtypes.EFFECT_DENY, err
 	}
 
-	groupInfo, err = r.baseApp.GfBsDB().GetGroupByID(int64(req.ResourceId), false)
+	groupInfo, err = r.bsDB().GetGroupByID(int64(req.ResourceId), false)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get group info", "error", err)
 		return permtypes.EFFECT_DENY, err
@@ -357,7 +357,7 @@ func (r *MetadataModular) VerifyPolicy(ctx context.Context, resourceID math.Uint
 	)
 
 	// verify policy which grant permission to account
-	permission, err = r.baseApp.GfBsDB().GetPermissionByResourceAndPrincipal(resourceType.String(), permtypes.PRINCIPAL_TYPE_GNFD_ACCOUNT.String(), operator.String(), common.BigToHash(resourceID.BigInt()))
+	permission, err = r.bsDB().GetPermissionByResourceAndPrincipal(resourceType.String(), permtypes.PRINCIPAL_TYPE_GNFD_ACCOUNT.String(), operator.String(), common.BigToHash(resourceID.BigInt()))
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get permission by resource and principal", "error", err)
 		return permtypes.EFFECT_DENY, err
@@ -365,7 +365,7 @@ func (r *MetadataModular) VerifyPolicy(ctx context.Context, resourceID math.Uint
 
 	if permission != nil {
 		accountPolicyID = append(accountPolicyID, permission.PolicyID)
-		statements, err = r.baseApp.GfBsDB().GetStatementsByPolicyID(accountPolicyID, false)
+		statements, err = r.bsDB().GetStatementsByPolicyID(accountPolicyID, false)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to get statements by policy id", "error", err)
 			return permtypes.EFFECT_DENY, err
@@ -379,7 +379,7 @@ func (r *MetadataModular) VerifyPolicy(ctx context.Context, resourceID math.Uint
 	}
 
 	// verify policy which grant permission to group
-	permissions, err = r.baseApp.GfBsDB().GetPermissionsByResourceAndPrincipleType(resourceType.String(), permtypes.PRINCIPAL_TYPE_GNFD_GROUP.String(), common.BigToHash(resourceID.BigInt()), false)
+	permissions, err = r.bsDB().GetPermissionsByResourceAndPrincipleType(resourceType.String(), permtypes.PRINCIPAL_TYPE_GNFD_GROUP.String(), common.BigToHash(resourceID.BigInt()), false)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get permission by resource and principle type", "error", err)
 		return permtypes.EFFECT_DENY, err
@@ -389,7 +389,7 @@ func (r *MetadataModular) VerifyPolicy(ctx context.Context, resourceID math.Uint
 		for i, perm := range permissions {
 			groupIDList[i] = common.BigToHash(math.NewUintFromString(perm.PrincipalValue).BigInt())
 		}
-		groups, err = r.baseApp.GfBsDB().GetGroupsByGroupIDAndAccount(groupIDList, common.HexToAddress(operator.String()), false)
+		groups, err = r.bsDB().GetGroupsByGroupIDAndAccount(groupIDList, common.HexToAddress(operator.String()), false)
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to get groups by group id and account", "error", err)
 			return permtypes.EFFECT_DENY, err
@@ -417,7 +417,7 @@ func (r *MetadataModular) VerifyPolicy(ctx context.Context, resourceID math.Uint
 		}
 	}
 
-	statements, err = r.baseApp.GfBsDB().GetStatementsByPolicyID(policyIDList, false)
+	statements, err = r.bsDB().GetStatementsByPolicyID(policyIDList, false)
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to get statements by policy id", "error", err)
 		return permtypes.EFFECT_DENY, err
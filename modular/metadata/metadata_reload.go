@@ -0,0 +1,38 @@
+package metadata
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+var _ gfspapp.ReloadableModular = &MetadataModular{}
+
+// ReloadableConfigs returns the configs that metadata can apply at runtime.
+func (r *MetadataModular) ReloadableConfigs() []string {
+	return []string{
+		"Parallel.QuerySPParallelPerNode",
+		"Quota.MonthlyFreeQuota",
+	}
+}
+
+// ReloadConfig updates the max handling metadata request number and the monthly free quota.
+func (r *MetadataModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if cfg.Parallel.QuerySPParallelPerNode < 0 {
+		return nil, fmt.Errorf("invalid query sp parallel %d", cfg.Parallel.QuerySPParallelPerNode)
+	}
+	maxMetadataRequest := cfg.Parallel.QuerySPParallelPerNode
+	if maxMetadataRequest == 0 {
+		maxMetadataRequest = DefaultQuerySPParallelPerNode
+	}
+	monthlyFreeQuota := cfg.Quota.MonthlyFreeQuota
+	if monthlyFreeQuota == 0 {
+		monthlyFreeQuota = gfspapp.DefaultSpMonthlyFreeQuota
+	}
+	return func() {
+		atomic.StoreInt64(&r.maxMetadataRequest, maxMetadataRequest)
+		atomic.StoreUint64(&MonthlyFreeQuota, monthlyFreeQuota)
+	}, nil
+}
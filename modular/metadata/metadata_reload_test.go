@@ -0,0 +1,29 @@
+package metadata
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+func TestMetadataModular_ReloadConfig(t *testing.T) {
+	defer func() { MonthlyFreeQuota = gfspapp.DefaultSpMonthlyFreeQuota }()
+	metadata := &MetadataModular{maxMetadataRequest: DefaultQuerySPParallelPerNode}
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Parallel.QuerySPParallelPerNode = 100
+	cfg.Quota.MonthlyFreeQuota = 1024
+	apply, err := metadata.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, DefaultQuerySPParallelPerNode, metadata.maxMetadataRequest)
+	apply()
+	assert.Equal(t, int64(100), metadata.maxMetadataRequest)
+	assert.Equal(t, uint64(1024), MonthlyFreeQuota)
+
+	cfg.Parallel.QuerySPParallelPerNode = -1
+	_, err = metadata.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
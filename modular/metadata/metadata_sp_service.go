@@ -3,6 +3,7 @@ package metadata
 import (
 	"context"
 	"errors"
+	"sync/atomic"
 
 	"cosmossdk.io/math"
 	"github.com/forbole/juno/v4/common"
@@ -138,7 +139,7 @@ func (r *MetadataModular) GfSpGetStatus(ctx context.Context, req *types.GfSpGetS
 		SpCodeVersion:      spVersion.SpCodeVersion,
 		SpOperatingSystem:  spVersion.SpOperatingSystem,
 		SpArchitecture:     spVersion.SpArchitecture,
-		SpMonthlyFreeQuota: MonthlyFreeQuota,
+		SpMonthlyFreeQuota: atomic.LoadUint64(&MonthlyFreeQuota),
 	}
 
 	chainInfo = &types.ChainInfo{
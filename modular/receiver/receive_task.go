@@ -71,7 +71,7 @@ func (r *ReceiveModular) HandleReceivePieceTask(ctx context.Context, task task.R
 		pieceKey = r.baseApp.PieceOp().SegmentPieceKey(task.GetObjectInfo().Id.Uint64(), task.GetSegmentIdx(), task.GetObjectInfo().GetVersion())
 	}
 	setDBTime := time.Now()
-	if err = r.baseApp.GfSpDB().SetReplicatePieceChecksum(task.GetObjectInfo().Id.Uint64(), task.GetSegmentIdx(), task.GetRedundancyIdx(), task.GetPieceChecksum(), task.GetObjectInfo().GetVersion()); err != nil {
+	if err = r.baseApp.GfSpDBWithContext(ctx).SetReplicatePieceChecksum(task.GetObjectInfo().Id.Uint64(), task.GetSegmentIdx(), task.GetRedundancyIdx(), task.GetPieceChecksum(), task.GetObjectInfo().GetVersion()); err != nil {
 		metrics.PerfReceivePieceTimeHistogram.WithLabelValues("receive_piece_server_set_mysql_time").Observe(time.Since(setDBTime).Seconds())
 		log.CtxErrorw(ctx, "failed to set checksum to db", "task", task, "error", err)
 		return ErrGfSpDBWithDetail("failed to set checksum to db, error: " + err.Error())
@@ -107,7 +107,7 @@ func (r *ReceiveModular) HandleDoneReceivePieceTask(ctx context.Context, task ta
 	segmentCount := r.baseApp.PieceOp().SegmentPieceCount(task.GetObjectInfo().GetPayloadSize(),
 		task.GetStorageParams().VersionedParams.GetMaxSegmentSize())
 	getChecksumsTime := time.Now()
-	pieceChecksums, err := r.baseApp.GfSpDB().GetAllReplicatePieceChecksumOptimized(task.GetObjectInfo().Id.Uint64(),
+	pieceChecksums, err := r.baseApp.GfSpDBWithContext(ctx).GetAllReplicatePieceChecksumOptimized(task.GetObjectInfo().Id.Uint64(),
 		task.GetRedundancyIdx(), segmentCount)
 	metrics.PerfReceivePieceTimeHistogram.WithLabelValues("receive_piece_server_done_get_checksums_time").Observe(time.Since(getChecksumsTime).Seconds())
 	if err != nil {
@@ -118,7 +118,7 @@ func (r *ReceiveModular) HandleDoneReceivePieceTask(ctx context.Context, task ta
 	skipInsertIntegrityMeta := false
 	if len(pieceChecksums) != int(segmentCount) {
 		// Interface idempotent processing. If it already have integrity data, can skip this check
-		integrityMeta, integrityErr := r.baseApp.GfSpDB().GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetRedundancyIdx())
+		integrityMeta, integrityErr := r.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), task.GetRedundancyIdx())
 		if integrityMeta != nil && integrityErr == nil {
 			// The checksum is obtained from integrityMeta
 			pieceChecksums = integrityMeta.PieceChecksumList
@@ -157,7 +157,7 @@ func (r *ReceiveModular) HandleDoneReceivePieceTask(ctx context.Context, task ta
 				Version:           task.GetObjectInfo().GetVersion(),
 				ObjectSize:        task.GetObjectInfo().GetPayloadSize(),
 			}
-			err = r.baseApp.GfSpDB().SetShadowObjectIntegrity(integrityMeta)
+			err = r.baseApp.GfSpDBWithContext(ctx).SetShadowObjectIntegrity(integrityMeta)
 		} else {
 			integrityMeta := &corespdb.IntegrityMeta{
 				ObjectID:          task.GetObjectInfo().Id.Uint64(),
@@ -166,7 +166,7 @@ func (r *ReceiveModular) HandleDoneReceivePieceTask(ctx context.Context, task ta
 				PieceChecksumList: pieceChecksums,
 				ObjectSize:        task.GetObjectInfo().GetPayloadSize(),
 			}
-			err = r.baseApp.GfSpDB().SetObjectIntegrity(integrityMeta)
+			err = r.baseApp.GfSpDBWithContext(ctx).SetObjectIntegrity(integrityMeta)
 		}
 	}
 	metrics.PerfReceivePieceTimeHistogram.WithLabelValues("receive_piece_server_done_set_integrity_time").Observe(time.Since(setIntegrityTime).Seconds())
@@ -195,7 +195,7 @@ func (r *ReceiveModular) HandleDoneReceivePieceTask(ctx context.Context, task ta
 	}
 	deletePieceHashTime := time.Now()
 	if !skipDeleteChecksum {
-		if err = r.baseApp.GfSpDB().DeleteAllReplicatePieceChecksumOptimized(
+		if err = r.baseApp.GfSpDBWithContext(ctx).DeleteAllReplicatePieceChecksumOptimized(
 			task.GetObjectInfo().Id.Uint64(), task.GetRedundancyIdx()); err != nil {
 			log.CtxErrorw(ctx, "failed to delete all replicate piece checksum", "task", task, "error", err)
 			// ignore the error,let the request go, the background task will gc the meta again later
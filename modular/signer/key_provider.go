@@ -0,0 +1,185 @@
+package signer
+
+import (
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strconv"
+	"strings"
+
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+const (
+	// PlainKeyProviderType defines the key provider that reads the plaintext private keys from the
+	// config, it is the default key provider and keeps the env variables overriding.
+	PlainKeyProviderType = "config"
+	// EnvKeyProviderType defines the key provider that reads the private keys injected by the env
+	// variables, files or inherited file descriptors.
+	EnvKeyProviderType = "env"
+	// KeystoreKeyProviderType defines the key provider that decrypts the private keys from the
+	// passphrase encrypted keystore files.
+	KeystoreKeyProviderType = "keystore"
+	// RemoteKeyProviderType defines the key provider that signs by the remote signer over gRPC,
+	// the private keys never leave the remote signer.
+	RemoteKeyProviderType = "remote"
+
+	// EnvFileSuffix defines the suffix of the env variable whose value is the file path of the secret,
+	// e.g. SIGNER_OPERATOR_PRIV_KEY_FILE=/run/secrets/operator.
+	EnvFileSuffix = "_FILE"
+	// EnvFDSuffix defines the suffix of the env variable whose value is the inherited file descriptor
+	// of the secret, e.g. SIGNER_OPERATOR_PRIV_KEY_FD=3, the descriptor is closed after reading.
+	EnvFDSuffix = "_FD"
+
+	// SpKeystorePassphrase defines env variable name for the passphrase of the keystore files, the
+	// _FILE and _FD suffixes are also supported.
+	SpKeystorePassphrase = "SIGNER_KEYSTORE_PASSPHRASE"
+)
+
+var (
+	ErrUnsupportedKeyProvider = errors.New("unsupported key provider type")
+	ErrMissingPrivateKey      = errors.New("private key is missing")
+	ErrMissingPassphrase      = errors.New("keystore passphrase is missing")
+)
+
+// KeyProvider provides the key managers that sign the msg and tx for the sign types. The key
+// manager of SignBls is the bls key that signs the secondary seal.
+type KeyProvider interface {
+	// KeyManager returns the key manager of the sign type.
+	KeyManager(scope SignType) (keys.KeyManager, error)
+}
+
+// SpSignTypes is the sign types that the signer requires the key managers.
+var SpSignTypes = []SignType{SignOperator, SignSeal, SignApproval, SignGc, SignBls}
+
+// SpPrivKeyEnvs maps the sign types to the env variable names of the private keys.
+var SpPrivKeyEnvs = map[SignType]string{
+	SignOperator: SpOperatorPrivKey,
+	SignSeal:     SpSealPrivKey,
+	SignApproval: SpApprovalPrivKey,
+	SignGc:       SpGcPrivKey,
+	SignBls:      SpBlsPrivKey,
+}
+
+var _ KeyProvider = &PlainKeyProvider{}
+
+// PlainKeyProvider provides the key managers from the plaintext hex private keys.
+type PlainKeyProvider struct {
+	privKeys map[SignType]string
+}
+
+// NewPlainKeyProvider returns the PlainKeyProvider instance.
+func NewPlainKeyProvider(privKeys map[SignType]string) *PlainKeyProvider {
+	return &PlainKeyProvider{privKeys: privKeys}
+}
+
+func (p *PlainKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	privKey := p.privKeys[scope]
+	if privKey == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingPrivateKey, scope)
+	}
+	return newKeyManager(scope, privKey)
+}
+
+var _ KeyProvider = &EnvKeyProvider{}
+
+// EnvKeyProvider provides the key managers from the private keys injected by the env variables
+// in SpPrivKeyEnvs, the private key can be the value of the env variable, or be read from the file
+// or the inherited file descriptor given by the env variable with EnvFileSuffix or EnvFDSuffix.
+type EnvKeyProvider struct{}
+
+// NewEnvKeyProvider returns the EnvKeyProvider instance.
+func NewEnvKeyProvider() *EnvKeyProvider {
+	return &EnvKeyProvider{}
+}
+
+func (p *EnvKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	env, ok := SpPrivKeyEnvs[scope]
+	if !ok {
+		return nil, fmt.Errorf("%w: %s", ErrMissingPrivateKey, scope)
+	}
+	privKey, err := LookupEnvSecret(env)
+	if err != nil {
+		return nil, err
+	}
+	if privKey == "" {
+		return nil, fmt.Errorf("%w: %s", ErrMissingPrivateKey, scope)
+	}
+	return newKeyManager(scope, privKey)
+}
+
+// LookupEnvSecret returns the secret injected by the env variable, it is looked up in the order
+// of the env variable value, the file of <env>_FILE and the file descriptor of <env>_FD. Empty
+// string is returned if none of them is set, the trailing newline of the file is trimmed.
+func LookupEnvSecret(env string) (string, error) {
+	if val, ok := os.LookupEnv(env); ok {
+		return val, nil
+	}
+	if path, ok := os.LookupEnv(env + EnvFileSuffix); ok {
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", env+EnvFileSuffix, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	if val, ok := os.LookupEnv(env + EnvFDSuffix); ok {
+		fd, err := strconv.ParseUint(val, 10, 32)
+		if err != nil {
+			return "", fmt.Errorf("invalid %s: %w", env+EnvFDSuffix, err)
+		}
+		file := os.NewFile(uintptr(fd), env+EnvFDSuffix)
+		if file == nil {
+			return "", fmt.Errorf("invalid %s: %s", env+EnvFDSuffix, val)
+		}
+		defer file.Close()
+		data, err := io.ReadAll(file)
+		if err != nil {
+			return "", fmt.Errorf("failed to read %s: %w", env+EnvFDSuffix, err)
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return "", nil
+}
+
+var _ KeyProvider = &KeystoreKeyProvider{}
+
+// KeystoreKeyProvider provides the key managers from the keystore files in the dir, the keystore
+// file of each sign type is named by KeystoreFilePath.
+type KeystoreKeyProvider struct {
+	dir        string
+	passphrase string
+}
+
+// NewKeystoreKeyProvider returns the KeystoreKeyProvider instance.
+func NewKeystoreKeyProvider(dir, passphrase string) (*KeystoreKeyProvider, error) {
+	if passphrase == "" {
+		return nil, ErrMissingPassphrase
+	}
+	return &KeystoreKeyProvider{dir: dir, passphrase: passphrase}, nil
+}
+
+func (p *KeystoreKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	ks, err := ReadKeystoreFile(KeystoreFilePath(p.dir, scope))
+	if err != nil {
+		return nil, err
+	}
+	privKey, err := DecryptKey(ks, scope, p.passphrase)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decrypt %s keystore: %w", scope, err)
+	}
+	return newKeyManager(scope, privKey)
+}
+
+// ReadKeystorePassphrase returns the passphrase of the keystore files, the passphrase file has
+// higher priority than the SpKeystorePassphrase env variable.
+func ReadKeystorePassphrase(passphraseFile string) (string, error) {
+	if passphraseFile != "" {
+		data, err := os.ReadFile(passphraseFile)
+		if err != nil {
+			return "", err
+		}
+		return strings.TrimRight(string(data), "\r\n"), nil
+	}
+	return LookupEnvSecret(SpKeystorePassphrase)
+}
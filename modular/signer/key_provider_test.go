@@ -0,0 +1,218 @@
+package signer
+
+import (
+	"context"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"math/big"
+	"net"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+)
+
+const (
+	mockPrivKey    = "4b6a4d9e6e5b0f1f1b8f3e0c1a8e1b5c2f9d0e7a6c5b4a3928170605f4e3d2c1"
+	mockBlsPrivKey = "2c0d4e8f5a3b1c7d9e0f2a4b6c8d0e1f3a5b7c9d1e2f4a6b8c0d2e4f6a8b0c1d"
+	mockPassphrase = "mock passphrase"
+)
+
+func TestKeystore_EncryptAndDecrypt(t *testing.T) {
+	ks, err := EncryptKey(SignOperator, "0x"+mockPrivKey, mockPassphrase, LightScryptN, LightScryptP)
+	require.NoError(t, err)
+	assert.NotContains(t, ks.Crypto.CipherText, mockPrivKey)
+
+	privKey, err := DecryptKey(ks, SignOperator, mockPassphrase)
+	require.NoError(t, err)
+	assert.Equal(t, mockPrivKey, privKey)
+
+	_, err = DecryptKey(ks, SignOperator, "wrong passphrase")
+	assert.ErrorIs(t, err, ErrKeystorePassphrase)
+	_, err = DecryptKey(ks, SignSeal, mockPassphrase)
+	assert.ErrorIs(t, err, ErrKeystoreScope)
+
+	// the sign type is authenticated, changing it in the file is detected
+	ks.Scope = SignSeal
+	_, err = DecryptKey(ks, SignSeal, mockPassphrase)
+	assert.ErrorIs(t, err, ErrKeystorePassphrase)
+}
+
+func TestKeystoreKeyProvider_KeyManager(t *testing.T) {
+	dir := t.TempDir()
+	for scope, privKey := range map[SignType]string{SignOperator: mockPrivKey, SignBls: mockBlsPrivKey} {
+		ks, err := EncryptKey(scope, privKey, mockPassphrase, LightScryptN, LightScryptP)
+		require.NoError(t, err)
+		require.NoError(t, WriteKeystoreFile(KeystoreFilePath(dir, scope), ks))
+	}
+
+	provider, err := NewKeystoreKeyProvider(dir, mockPassphrase)
+	require.NoError(t, err)
+	for scope, privKey := range map[SignType]string{SignOperator: mockPrivKey, SignBls: mockBlsPrivKey} {
+		km, err := provider.KeyManager(scope)
+		require.NoError(t, err)
+		expect, err := newKeyManager(scope, privKey)
+		require.NoError(t, err)
+		assert.Equal(t, expect.GetAddr(), km.GetAddr())
+	}
+	_, err = provider.KeyManager(SignSeal)
+	assert.ErrorIs(t, err, os.ErrNotExist)
+
+	_, err = NewKeystoreKeyProvider(dir, "")
+	assert.ErrorIs(t, err, ErrMissingPassphrase)
+}
+
+func TestEnvKeyProvider_KeyManager(t *testing.T) {
+	expect, err := newKeyManager(SignSeal, mockPrivKey)
+	require.NoError(t, err)
+
+	t.Setenv(SpSealPrivKey, mockPrivKey)
+	km, err := NewEnvKeyProvider().KeyManager(SignSeal)
+	require.NoError(t, err)
+	assert.Equal(t, expect.GetAddr(), km.GetAddr())
+
+	path := filepath.Join(t.TempDir(), "approval")
+	require.NoError(t, os.WriteFile(path, []byte(mockPrivKey+"\n"), 0600))
+	t.Setenv(SpApprovalPrivKey+EnvFileSuffix, path)
+	km, err = NewEnvKeyProvider().KeyManager(SignApproval)
+	require.NoError(t, err)
+	assert.Equal(t, expect.GetAddr(), km.GetAddr())
+
+	_, err = NewEnvKeyProvider().KeyManager(SignGc)
+	assert.ErrorIs(t, err, ErrMissingPrivateKey)
+}
+
+func TestRemoteKeyProvider_KeyManager(t *testing.T) {
+	local := NewPlainKeyProvider(map[SignType]string{SignGc: mockPrivKey, SignBls: mockBlsPrivKey})
+
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer()
+	RegisterRemoteSignerServer(server, NewLocalRemoteSigner(local))
+	go server.Serve(listener)
+	defer server.Stop()
+
+	remote, err := NewGRPCRemoteSigner("bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return listener.Dial() }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	require.NoError(t, err)
+	defer remote.Close()
+
+	provider := NewRemoteKeyProvider(remote, 0)
+	for _, scope := range []SignType{SignGc, SignBls} {
+		expect, err := local.KeyManager(scope)
+		require.NoError(t, err)
+		km, err := provider.KeyManager(scope)
+		require.NoError(t, err)
+		assert.Equal(t, expect.GetAddr(), km.GetAddr())
+		assert.True(t, km.Equals(expect))
+
+		msg := []byte("mock msg")
+		sig, err := km.Sign(msg)
+		require.NoError(t, err)
+		expectSig, err := expect.Sign(msg)
+		require.NoError(t, err)
+		assert.Equal(t, expectSig, sig)
+	}
+
+	_, err = provider.KeyManager(SignOperator)
+	assert.Error(t, err)
+
+	// the private key bytes are never returned by the remote signer
+	km, err := provider.KeyManager(SignGc)
+	require.NoError(t, err)
+	assert.Nil(t, km.Bytes())
+
+	// the generated client of the service is usable by the other signer implementations
+	_, err = types.NewRemoteSignerServiceClient(remote.conn).Sign(context.Background(),
+		&types.RemoteSignerSignRequest{Msg: []byte("mock msg")})
+	assert.ErrorContains(t, err, ErrRemoteSignerScope.Error())
+}
+
+// writeMockCert writes the PEM cert and key signed by the parent, or self-signed if the parent is nil.
+func writeMockCert(t *testing.T, dir, name string, parent *x509.Certificate, parentKey *ecdsa.PrivateKey) (
+	*x509.Certificate, *ecdsa.PrivateKey) {
+	key, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	require.NoError(t, err)
+	template := &x509.Certificate{
+		SerialNumber: big.NewInt(time.Now().UnixNano()),
+		Subject:      pkix.Name{CommonName: name},
+		DNSNames:     []string{"bufnet"},
+		NotBefore:    time.Now().Add(-time.Hour),
+		NotAfter:     time.Now().Add(time.Hour),
+		ExtKeyUsage:  []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth, x509.ExtKeyUsageClientAuth},
+	}
+	if parent == nil {
+		template.IsCA = true
+		template.BasicConstraintsValid = true
+		template.KeyUsage = x509.KeyUsageCertSign
+		parent, parentKey = template, key
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, parent, &key.PublicKey, parentKey)
+	require.NoError(t, err)
+	keyDER, err := x509.MarshalECPrivateKey(key)
+	require.NoError(t, err)
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".crt"),
+		pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600))
+	require.NoError(t, os.WriteFile(filepath.Join(dir, name+".key"),
+		pem.EncodeToMemory(&pem.Block{Type: "EC PRIVATE KEY", Bytes: keyDER}), 0600))
+	cert, err := x509.ParseCertificate(der)
+	require.NoError(t, err)
+	return cert, key
+}
+
+func TestRemoteKeyProvider_MutualTLS(t *testing.T) {
+	dir := t.TempDir()
+	ca, caKey := writeMockCert(t, dir, "ca", nil, nil)
+	writeMockCert(t, dir, "server", ca, caKey)
+	writeMockCert(t, dir, "client", ca, caKey)
+	writeMockCert(t, dir, "other_ca", nil, nil)
+	file := func(name string) string { return filepath.Join(dir, name) }
+
+	serverTLS, err := NewRemoteSignerServerTLSConfig(file("ca.crt"), file("server.crt"), file("server.key"))
+	require.NoError(t, err)
+	listener := bufconn.Listen(1 << 20)
+	server := grpc.NewServer(grpc.Creds(credentials.NewTLS(serverTLS)))
+	RegisterRemoteSignerServer(server, NewLocalRemoteSigner(NewPlainKeyProvider(map[SignType]string{SignGc: mockPrivKey})))
+	go server.Serve(listener)
+	defer server.Stop()
+	dialer := grpc.WithContextDialer(func(ctx context.Context, s string) (net.Conn, error) { return listener.Dial() })
+
+	clientTLS, err := NewRemoteSignerClientTLSConfig(file("ca.crt"), file("client.crt"), file("client.key"))
+	require.NoError(t, err)
+	remote, err := NewGRPCRemoteSigner("bufnet", dialer, grpc.WithTransportCredentials(credentials.NewTLS(clientTLS)))
+	require.NoError(t, err)
+	defer remote.Close()
+	km, err := NewRemoteKeyProvider(remote, 0).KeyManager(SignGc)
+	require.NoError(t, err)
+	assert.Nil(t, km.Bytes())
+
+	// the client whose certificate is not issued by the CA is rejected
+	otherTLS, err := NewRemoteSignerClientTLSConfig(file("ca.crt"), file("other_ca.crt"), file("other_ca.key"))
+	require.NoError(t, err)
+	other, err := NewGRPCRemoteSigner("bufnet", dialer, grpc.WithTransportCredentials(credentials.NewTLS(otherTLS)))
+	require.NoError(t, err)
+	defer other.Close()
+	_, err = NewRemoteKeyProvider(other, time.Second).KeyManager(SignGc)
+	assert.Error(t, err)
+
+	// the plaintext connection is only allowed with the explicit insecure flag
+	_, err = NewKeyProvider(&gfspconfig.SpAccountConfig{KeyProvider: RemoteKeyProviderType, RemoteSignerAddress: "bufnet"})
+	assert.ErrorIs(t, err, ErrRemoteSignerTLS)
+	_, err = NewKeyProvider(&gfspconfig.SpAccountConfig{KeyProvider: RemoteKeyProviderType, RemoteSignerAddress: "bufnet",
+		RemoteSignerInsecure: true})
+	assert.NoError(t, err)
+}
@@ -0,0 +1,234 @@
+package signer
+
+import (
+	"crypto/aes"
+	"crypto/cipher"
+	"crypto/rand"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"golang.org/x/crypto/scrypt"
+
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+const (
+	// KeystoreVersion defines the version of the keystore file format.
+	KeystoreVersion = 1
+	// KeystoreFileSuffix defines the suffix of the keystore file, the file is named by the sign type.
+	KeystoreFileSuffix = ".json"
+	// KeystoreCipher defines the cipher that encrypts the private key.
+	KeystoreCipher = "aes-256-gcm"
+	// KeystoreKDF defines the key derivation function that derives the cipher key from the passphrase.
+	KeystoreKDF = "scrypt"
+
+	// StandardScryptN defines the scrypt N parameter used by the keystore by default.
+	StandardScryptN = 1 << 18
+	// StandardScryptP defines the scrypt P parameter used by the keystore by default.
+	StandardScryptP = 1
+	// LightScryptN defines the scrypt N parameter that is cheaper, only for testing.
+	LightScryptN = 1 << 12
+	// LightScryptP defines the scrypt P parameter that is cheaper, only for testing.
+	LightScryptP = 6
+
+	keystoreScryptR     = 8
+	keystoreScryptDKLen = 32
+	keystoreSaltLen     = 32
+)
+
+var (
+	ErrKeystoreVersion    = errors.New("unsupported keystore version")
+	ErrKeystoreCrypto     = errors.New("unsupported keystore cipher or kdf")
+	ErrKeystoreScope      = errors.New("keystore sign type mismatch")
+	ErrKeystorePassphrase = errors.New("could not decrypt keystore with given passphrase")
+)
+
+// KeystoreKDFParams defines the scrypt params to derive the cipher key.
+type KeystoreKDFParams struct {
+	N     int    `json:"n"`
+	R     int    `json:"r"`
+	P     int    `json:"p"`
+	DKLen int    `json:"dklen"`
+	Salt  string `json:"salt"`
+}
+
+// KeystoreCrypto defines the encrypted private key and the params to decrypt it.
+type KeystoreCrypto struct {
+	Cipher     string            `json:"cipher"`
+	CipherText string            `json:"ciphertext"`
+	Nonce      string            `json:"nonce"`
+	KDF        string            `json:"kdf"`
+	KDFParams  KeystoreKDFParams `json:"kdfparams"`
+}
+
+// Keystore is the passphrase encrypted private key of a sign type, the sign type is bound to the
+// cipher text as the additional data, so a keystore can not be used as the other sign type's.
+type Keystore struct {
+	Version int            `json:"version"`
+	Scope   SignType       `json:"scope"`
+	Address string         `json:"address"`
+	PubKey  string         `json:"pub_key"`
+	Crypto  KeystoreCrypto `json:"crypto"`
+}
+
+// KeystoreFilePath returns the keystore file path of the sign type in the dir.
+func KeystoreFilePath(dir string, scope SignType) string {
+	return filepath.Join(dir, string(scope)+KeystoreFileSuffix)
+}
+
+// EncryptKey encrypts the hex private key of the sign type with the passphrase.
+func EncryptKey(scope SignType, privKey, passphrase string, scryptN, scryptP int) (*Keystore, error) {
+	privKey = trimPrivKey(privKey)
+	km, err := newKeyManager(scope, privKey)
+	if err != nil {
+		return nil, err
+	}
+	salt := make([]byte, keystoreSaltLen)
+	if _, err = rand.Read(salt); err != nil {
+		return nil, err
+	}
+	params := KeystoreKDFParams{
+		N:     scryptN,
+		R:     keystoreScryptR,
+		P:     scryptP,
+		DKLen: keystoreScryptDKLen,
+		Salt:  hex.EncodeToString(salt),
+	}
+	aead, err := newKeystoreAEAD(passphrase, params)
+	if err != nil {
+		return nil, err
+	}
+	nonce := make([]byte, aead.NonceSize())
+	if _, err = rand.Read(nonce); err != nil {
+		return nil, err
+	}
+	plain, err := hex.DecodeString(privKey)
+	if err != nil {
+		return nil, err
+	}
+	return &Keystore{
+		Version: KeystoreVersion,
+		Scope:   scope,
+		Address: km.GetAddr().String(),
+		PubKey:  hex.EncodeToString(km.PubKey().Bytes()),
+		Crypto: KeystoreCrypto{
+			Cipher:     KeystoreCipher,
+			CipherText: hex.EncodeToString(aead.Seal(nil, nonce, plain, []byte(scope))),
+			Nonce:      hex.EncodeToString(nonce),
+			KDF:        KeystoreKDF,
+			KDFParams:  params,
+		},
+	}, nil
+}
+
+// DecryptKey decrypts the keystore with the passphrase and returns the hex private key.
+func DecryptKey(ks *Keystore, scope SignType, passphrase string) (string, error) {
+	if ks.Version != KeystoreVersion {
+		return "", ErrKeystoreVersion
+	}
+	if ks.Crypto.Cipher != KeystoreCipher || ks.Crypto.KDF != KeystoreKDF {
+		return "", ErrKeystoreCrypto
+	}
+	if ks.Scope != scope {
+		return "", ErrKeystoreScope
+	}
+	aead, err := newKeystoreAEAD(passphrase, ks.Crypto.KDFParams)
+	if err != nil {
+		return "", err
+	}
+	nonce, err := hex.DecodeString(ks.Crypto.Nonce)
+	if err != nil {
+		return "", err
+	}
+	if len(nonce) != aead.NonceSize() {
+		return "", ErrKeystoreCrypto
+	}
+	cipherText, err := hex.DecodeString(ks.Crypto.CipherText)
+	if err != nil {
+		return "", err
+	}
+	plain, err := aead.Open(nil, nonce, cipherText, []byte(scope))
+	if err != nil {
+		return "", ErrKeystorePassphrase
+	}
+	return hex.EncodeToString(plain), nil
+}
+
+func newKeystoreAEAD(passphrase string, params KeystoreKDFParams) (cipher.AEAD, error) {
+	salt, err := hex.DecodeString(params.Salt)
+	if err != nil {
+		return nil, err
+	}
+	if params.DKLen != keystoreScryptDKLen {
+		return nil, ErrKeystoreCrypto
+	}
+	derivedKey, err := scrypt.Key([]byte(passphrase), salt, params.N, params.R, params.P, params.DKLen)
+	if err != nil {
+		return nil, err
+	}
+	block, err := aes.NewCipher(derivedKey)
+	if err != nil {
+		return nil, err
+	}
+	return cipher.NewGCM(block)
+}
+
+// ReadKeystoreFile reads the keystore from the file.
+func ReadKeystoreFile(path string) (*Keystore, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, err
+	}
+	ks := &Keystore{}
+	if err = json.Unmarshal(data, ks); err != nil {
+		return nil, fmt.Errorf("failed to parse keystore %s: %w", path, err)
+	}
+	return ks, nil
+}
+
+// WriteKeystoreFile writes the keystore to the file, the file is replaced atomically, so the
+// signer never reads a partial keystore during rotation.
+func WriteKeystoreFile(path string, ks *Keystore) error {
+	data, err := json.MarshalIndent(ks, "", "  ")
+	if err != nil {
+		return err
+	}
+	if err = os.MkdirAll(filepath.Dir(path), 0700); err != nil {
+		return err
+	}
+	tmp, err := os.CreateTemp(filepath.Dir(path), filepath.Base(path)+".tmp")
+	if err != nil {
+		return err
+	}
+	defer os.Remove(tmp.Name())
+	if _, err = tmp.Write(data); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Sync(); err != nil {
+		tmp.Close()
+		return err
+	}
+	if err = tmp.Close(); err != nil {
+		return err
+	}
+	return os.Rename(tmp.Name(), path)
+}
+
+// newKeyManager returns the key manager of the hex private key, the bls sign type uses the bls key.
+func newKeyManager(scope SignType, privKey string) (keys.KeyManager, error) {
+	privKey = trimPrivKey(privKey)
+	if scope == SignBls {
+		return keys.NewBlsPrivateKeyManager(privKey)
+	}
+	return keys.NewPrivateKeyManager(privKey)
+}
+
+func trimPrivKey(privKey string) string {
+	return strings.TrimPrefix(strings.TrimSpace(privKey), "0x")
+}
@@ -0,0 +1,281 @@
+package signer
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"errors"
+	"fmt"
+	"os"
+	"time"
+
+	ethbls "github.com/cosmos/cosmos-sdk/crypto/keys/eth/bls"
+	"github.com/cosmos/cosmos-sdk/crypto/keys/eth/ethsecp256k1"
+	cryptotypes "github.com/cosmos/cosmos-sdk/crypto/types"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+
+	"github.com/bnb-chain/greenfield-storage-provider/modular/signer/types"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield/sdk/keys"
+)
+
+const (
+	// DefaultRemoteSignTimeout defines the default timeout of the remote signing.
+	DefaultRemoteSignTimeout = 10 * time.Second
+)
+
+var (
+	ErrRemoteSignerScope  = errors.New("remote signer sign type is missing")
+	ErrRemoteSignerPubKey = errors.New("invalid public key from remote signer")
+	ErrRemoteSignerTLS    = errors.New("remote signer requires the CA, cert and key files for mutual TLS")
+	// ErrRemoteKeyBytes is logged when the private key bytes of a remote key manager are read, they are kept
+	// by the remote signer and can not be exported.
+	ErrRemoteKeyBytes = errors.New("the private key is kept by the remote signer and can not be read")
+)
+
+// RemoteSigner signs the msg by the keys that are kept remotely, the private keys never leave it.
+type RemoteSigner interface {
+	// PubKey returns the public key bytes of the sign type.
+	PubKey(ctx context.Context, scope SignType) ([]byte, error)
+	// Sign returns the signature of the msg signed by the sign type's key.
+	Sign(ctx context.Context, scope SignType, msg []byte) ([]byte, error)
+}
+
+var _ KeyProvider = &RemoteKeyProvider{}
+
+// RemoteKeyProvider provides the key managers that delegate signing to the RemoteSigner.
+type RemoteKeyProvider struct {
+	signer  RemoteSigner
+	timeout time.Duration
+}
+
+// NewRemoteKeyProvider returns the RemoteKeyProvider instance.
+func NewRemoteKeyProvider(signer RemoteSigner, timeout time.Duration) *RemoteKeyProvider {
+	if timeout <= 0 {
+		timeout = DefaultRemoteSignTimeout
+	}
+	return &RemoteKeyProvider{signer: signer, timeout: timeout}
+}
+
+func (p *RemoteKeyProvider) KeyManager(scope SignType) (keys.KeyManager, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), p.timeout)
+	defer cancel()
+	bz, err := p.signer.PubKey(ctx, scope)
+	if err != nil {
+		return nil, err
+	}
+	var pubKey cryptotypes.PubKey
+	if scope == SignBls {
+		pubKey = &ethbls.PubKey{Key: bz}
+	} else {
+		if len(bz) != ethsecp256k1.PubKeySize {
+			return nil, fmt.Errorf("%w: %s", ErrRemoteSignerPubKey, scope)
+		}
+		pubKey = &ethsecp256k1.PubKey{Key: bz}
+	}
+	return &remoteKeyManager{
+		signer:  p.signer,
+		timeout: p.timeout,
+		scope:   scope,
+		pubKey:  pubKey,
+		addr:    sdk.AccAddress(pubKey.Address()),
+	}, nil
+}
+
+var _ keys.KeyManager = &remoteKeyManager{}
+
+// remoteKeyManager implements the keys.KeyManager by the RemoteSigner, so it can be used by the
+// greenfield client to sign the tx.
+type remoteKeyManager struct {
+	signer  RemoteSigner
+	timeout time.Duration
+	scope   SignType
+	pubKey  cryptotypes.PubKey
+	addr    sdk.AccAddress
+}
+
+// Bytes always returns nil since the private key never leaves the remote signer, the keys.KeyManager has no way
+// to return an error here. The SP only signs by Sign and reads the public key by PubKey, the callers that need
+// the private key bytes, such as exporting the keys, must not be used with the remote key provider.
+func (km *remoteKeyManager) Bytes() []byte {
+	log.Errorw("failed to get private key bytes", "scope", km.scope, "error", ErrRemoteKeyBytes)
+	return nil
+}
+
+func (km *remoteKeyManager) Sign(msg []byte) ([]byte, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), km.timeout)
+	defer cancel()
+	sig, err := km.signer.Sign(ctx, km.scope, msg)
+	if err != nil {
+		log.Errorw("failed to sign by remote signer", "scope", km.scope, "error", err)
+		return nil, err
+	}
+	return sig, nil
+}
+
+func (km *remoteKeyManager) PubKey() cryptotypes.PubKey {
+	return km.pubKey
+}
+
+func (km *remoteKeyManager) Equals(key cryptotypes.LedgerPrivKey) bool {
+	return km.pubKey.Equals(key.PubKey())
+}
+
+func (km *remoteKeyManager) Type() string {
+	return km.pubKey.Type()
+}
+
+func (km *remoteKeyManager) GetAddr() sdk.AccAddress {
+	return km.addr
+}
+
+func (km *remoteKeyManager) String() string { return string(km.scope) }
+func (km *remoteKeyManager) ProtoMessage()  {}
+func (km *remoteKeyManager) Reset()         {}
+
+var _ RemoteSigner = &GRPCRemoteSigner{}
+
+// GRPCRemoteSigner is the RemoteSigner client of the RemoteSignerService defined in
+// proto/modular/signer/types/remote_signer.proto, the service is registered by RegisterRemoteSignerServer.
+type GRPCRemoteSigner struct {
+	conn   *grpc.ClientConn
+	client types.RemoteSignerServiceClient
+}
+
+// NewGRPCRemoteSigner returns the GRPCRemoteSigner instance that connects to the address.
+func NewGRPCRemoteSigner(address string, opts ...grpc.DialOption) (*GRPCRemoteSigner, error) {
+	conn, err := grpc.Dial(address, opts...)
+	if err != nil {
+		log.Errorw("failed to dial remote signer", "address", address, "error", err)
+		return nil, err
+	}
+	return &GRPCRemoteSigner{conn: conn, client: types.NewRemoteSignerServiceClient(conn)}, nil
+}
+
+func (s *GRPCRemoteSigner) PubKey(ctx context.Context, scope SignType) ([]byte, error) {
+	resp, err := s.client.PubKey(ctx, &types.RemoteSignerPubKeyRequest{Scope: string(scope)})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetPubKey(), nil
+}
+
+func (s *GRPCRemoteSigner) Sign(ctx context.Context, scope SignType, msg []byte) ([]byte, error) {
+	resp, err := s.client.Sign(ctx, &types.RemoteSignerSignRequest{Scope: string(scope), Msg: msg})
+	if err != nil {
+		return nil, err
+	}
+	return resp.GetSignature(), nil
+}
+
+// NewRemoteSignerClientTLSConfig returns the mutual TLS config that verifies the remote signer by the CA
+// and presents the client certificate to the remote signer.
+func NewRemoteSignerClientTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	pool, cert, err := loadRemoteSignerTLSFiles(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{RootCAs: pool, Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+// NewRemoteSignerServerTLSConfig returns the mutual TLS config of the remote signer service, which only
+// accepts the clients whose certificates are issued by the CA.
+func NewRemoteSignerServerTLSConfig(caFile, certFile, keyFile string) (*tls.Config, error) {
+	pool, cert, err := loadRemoteSignerTLSFiles(caFile, certFile, keyFile)
+	if err != nil {
+		return nil, err
+	}
+	return &tls.Config{ClientCAs: pool, ClientAuth: tls.RequireAndVerifyClientCert,
+		Certificates: []tls.Certificate{cert}, MinVersion: tls.VersionTLS12}, nil
+}
+
+func loadRemoteSignerTLSFiles(caFile, certFile, keyFile string) (*x509.CertPool, tls.Certificate, error) {
+	if caFile == "" || certFile == "" || keyFile == "" {
+		return nil, tls.Certificate{}, ErrRemoteSignerTLS
+	}
+	caPEM, err := os.ReadFile(caFile)
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+	pool := x509.NewCertPool()
+	if !pool.AppendCertsFromPEM(caPEM) {
+		return nil, tls.Certificate{}, fmt.Errorf("%w: no certificate in %s", ErrRemoteSignerTLS, caFile)
+	}
+	cert, err := tls.LoadX509KeyPair(certFile, keyFile)
+	if err != nil {
+		return nil, tls.Certificate{}, err
+	}
+	return pool, cert, nil
+}
+
+// Close closes the connection to the remote signer.
+func (s *GRPCRemoteSigner) Close() error {
+	return s.conn.Close()
+}
+
+var _ RemoteSigner = &LocalRemoteSigner{}
+
+// LocalRemoteSigner is the RemoteSigner that signs by the local KeyProvider, it is served as the
+// remote signer service, and replaces the remote signer in tests.
+type LocalRemoteSigner struct {
+	provider KeyProvider
+}
+
+// NewLocalRemoteSigner returns the LocalRemoteSigner instance.
+func NewLocalRemoteSigner(provider KeyProvider) *LocalRemoteSigner {
+	return &LocalRemoteSigner{provider: provider}
+}
+
+func (s *LocalRemoteSigner) PubKey(ctx context.Context, scope SignType) ([]byte, error) {
+	km, err := s.provider.KeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+	return km.PubKey().Bytes(), nil
+}
+
+func (s *LocalRemoteSigner) Sign(ctx context.Context, scope SignType, msg []byte) ([]byte, error) {
+	km, err := s.provider.KeyManager(scope)
+	if err != nil {
+		return nil, err
+	}
+	return km.Sign(msg)
+}
+
+// RegisterRemoteSignerServer registers the RemoteSigner as the RemoteSignerService, the server should be
+// created with the credentials of NewRemoteSignerServerTLSConfig, so only the authenticated clients can sign.
+func RegisterRemoteSignerServer(server *grpc.Server, signer RemoteSigner) {
+	types.RegisterRemoteSignerServiceServer(server, &remoteSignerServer{signer: signer})
+}
+
+var _ types.RemoteSignerServiceServer = &remoteSignerServer{}
+
+// remoteSignerServer serves the RemoteSignerService by the RemoteSigner.
+type remoteSignerServer struct {
+	signer RemoteSigner
+}
+
+func (s *remoteSignerServer) PubKey(ctx context.Context, req *types.RemoteSignerPubKeyRequest) (
+	*types.RemoteSignerPubKeyResponse, error) {
+	if req.GetScope() == "" {
+		return nil, ErrRemoteSignerScope
+	}
+	bz, err := s.signer.PubKey(ctx, SignType(req.GetScope()))
+	if err != nil {
+		return nil, err
+	}
+	return &types.RemoteSignerPubKeyResponse{PubKey: bz}, nil
+}
+
+func (s *remoteSignerServer) Sign(ctx context.Context, req *types.RemoteSignerSignRequest) (
+	*types.RemoteSignerSignResponse, error) {
+	if req.GetScope() == "" {
+		return nil, ErrRemoteSignerScope
+	}
+	sig, err := s.signer.Sign(ctx, SignType(req.GetScope()), req.GetMsg())
+	if err != nil {
+		return nil, err
+	}
+	return &types.RemoteSignerSignResponse{Signature: sig}, nil
+}
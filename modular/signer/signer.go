@@ -55,10 +55,12 @@ func (s *SignModular) Name() string {
 }
 
 func (s *SignModular) Start(ctx context.Context) error {
+	s.client.startTxPipelines()
 	return nil
 }
 
 func (s *SignModular) Stop(ctx context.Context) error {
+	s.client.stopTxPipelines()
 	return nil
 }
 
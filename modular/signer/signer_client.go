@@ -14,6 +14,7 @@ import (
 	"google.golang.org/grpc"
 
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	"github.com/bnb-chain/greenfield/sdk/client"
 	"github.com/bnb-chain/greenfield/sdk/keys"
 	ctypes "github.com/bnb-chain/greenfield/sdk/types"
@@ -43,6 +44,9 @@ const (
 	// SignGc is the type of signature signed by the gc account
 	SignGc SignType = "gc"
 
+	// SignBls is the type of signature signed by the bls key
+	SignBls SignType = "bls"
+
 	// BroadcastTxRetry defines the max retry for broadcasting tx on-chain
 	BroadcastTxRetry = 3
 
@@ -67,63 +71,58 @@ type GasInfo struct {
 type GreenfieldChainSignClient struct {
 	signer *SignModular
 
-	opLock   sync.Mutex
-	sealLock sync.Mutex
-	gcLock   sync.Mutex
+	opLock sync.Mutex
 
 	gasInfo           map[GasInfoType]GasInfo
 	greenfieldClients map[SignType]*client.GreenfieldClient
 	operatorAccNonce  uint64
-	sealAccNonce      uint64
-	gcAccNonce        uint64
 	blsKm             keys.KeyManager
+	// txPipelines broadcast the txs of the seal and gc accounts with the local nonces and batching
+	txPipelines map[SignType]*txPipeline
 }
 
-// NewGreenfieldChainSignClient return the GreenfieldChainSignClient instance
-func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoType]GasInfo, operatorPrivateKey, fundingPrivateKey,
-	sealPrivateKey, approvalPrivateKey, gcPrivateKey string, blsPrivKey string) (*GreenfieldChainSignClient, error) {
+// NewGreenfieldChainSignClient return the GreenfieldChainSignClient instance, the signing keys are
+// provided by the KeyProvider, and the txs of seal and gc accounts are broadcast by the tx pipelines.
+func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoType]GasInfo,
+	provider KeyProvider, pipelineCfg TxPipelineConfig) (*GreenfieldChainSignClient, error) {
 	// init clients
 	// TODO: Get private key from KMS(AWS, GCP, Azure, Aliyun)
-	operatorKM, err := keys.NewPrivateKeyManager(operatorPrivateKey)
-	if err != nil {
-		log.Errorw("failed to new operator private key manager", "error", err)
-		return nil, err
+	newClient := func(scope SignType) (*client.GreenfieldClient, uint64, error) {
+		km, err := provider.KeyManager(scope)
+		if err != nil {
+			log.Errorw("failed to new private key manager", "scope", scope, "error", err)
+			return nil, 0, err
+		}
+		gnfdClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(km))
+		if err != nil {
+			log.Errorw("failed to new greenfield client", "scope", scope, "error", err)
+			return nil, 0, err
+		}
+		nonce, err := gnfdClient.GetNonce(context.Background())
+		if err != nil {
+			log.Errorw("failed to get nonce", "scope", scope, "error", err)
+			return nil, 0, err
+		}
+		return gnfdClient, nonce, nil
 	}
 
-	operatorClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(operatorKM))
-	if err != nil {
-		log.Errorw("failed to new operator greenfield client", "error", err)
-		return nil, err
-	}
-	operatorAccNonce, err := operatorClient.GetNonce(context.Background())
+	operatorClient, operatorAccNonce, err := newClient(SignOperator)
 	if err != nil {
-		log.Errorw("failed to get operator nonce", "error", err)
 		return nil, err
 	}
 
-	blsKM, err := keys.NewBlsPrivateKeyManager(blsPrivKey)
+	blsKM, err := provider.KeyManager(SignBls)
 	if err != nil {
 		log.Errorw("failed to new bls private key manager", "error", err)
 		return nil, err
 	}
 
-	sealKM, err := keys.NewPrivateKeyManager(sealPrivateKey)
+	sealClient, sealAccNonce, err := newClient(SignSeal)
 	if err != nil {
-		log.Errorw("failed to new seal private key manager", "error", err)
-		return nil, err
-	}
-	sealClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(sealKM))
-	if err != nil {
-		log.Errorw("failed to new seal greenfield client", "error", err)
-		return nil, err
-	}
-	sealAccNonce, err := sealClient.GetNonce(context.Background())
-	if err != nil {
-		log.Errorw("failed to get seal nonce", "error", err)
 		return nil, err
 	}
 
-	approvalKM, err := keys.NewPrivateKeyManager(approvalPrivateKey)
+	approvalKM, err := provider.KeyManager(SignApproval)
 	if err != nil {
 		log.Errorw("failed to new approval private key manager", "error", err)
 		return nil, err
@@ -134,19 +133,8 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		return nil, err
 	}
 
-	gcKM, err := keys.NewPrivateKeyManager(gcPrivateKey)
-	if err != nil {
-		log.Errorw("failed to new gc private key manager", "error", err)
-		return nil, err
-	}
-	gcClient, err := client.NewGreenfieldClient(rpcAddr, chainID, client.WithKeyManager(gcKM))
-	if err != nil {
-		log.Errorw("failed to new gc greenfield client", "error", err)
-		return nil, err
-	}
-	gcAccNonce, err := gcClient.GetNonce(context.Background())
+	gcClient, gcAccNonce, err := newClient(SignGc)
 	if err != nil {
-		log.Errorw("failed to get gc nonce", "error", err)
 		return nil, err
 	}
 
@@ -157,14 +145,33 @@ func NewGreenfieldChainSignClient(rpcAddr, chainID string, gasInfo map[GasInfoTy
 		SignGc:       gcClient,
 	}
 
-	return &GreenfieldChainSignClient{
+	signClient := &GreenfieldChainSignClient{
 		gasInfo:           gasInfo,
 		greenfieldClients: greenfieldClients,
-		sealAccNonce:      sealAccNonce,
-		gcAccNonce:        gcAccNonce,
 		operatorAccNonce:  operatorAccNonce,
 		blsKm:             blsKM,
-	}, nil
+	}
+	signClient.txPipelines = map[SignType]*txPipeline{
+		SignSeal: newTxPipeline(SignSeal, &gnfdTxBroadcaster{client: signClient, gnfdClient: sealClient},
+			sealAccNonce, pipelineCfg),
+		SignGc: newTxPipeline(SignGc, &gnfdTxBroadcaster{client: signClient, gnfdClient: gcClient},
+			gcAccNonce, pipelineCfg),
+	}
+	return signClient, nil
+}
+
+// startTxPipelines starts the tx pipelines to broadcast the txs.
+func (client *GreenfieldChainSignClient) startTxPipelines() {
+	for _, pipeline := range client.txPipelines {
+		pipeline.start()
+	}
+}
+
+// stopTxPipelines stops the tx pipelines, the msgs that are not broadcast are failed.
+func (client *GreenfieldChainSignClient) stopTxPipelines() {
+	for _, pipeline := range client.txPipelines {
+		pipeline.stop()
+	}
 }
 
 // GetAddr returns the public address of the private key.
@@ -209,54 +216,18 @@ func (client *GreenfieldChainSignClient) SealObject(ctx context.Context, scope S
 		return "", ErrSignMsg
 	}
 
-	client.sealLock.Lock()
-	defer client.sealLock.Unlock()
-
 	msgSealObject := storagetypes.NewMsgSealObject(km.GetAddr(),
 		sealObject.GetBucketName(), sealObject.GetObjectName(), sealObject.GetGlobalVirtualGroupId(),
 		sealObject.GetSecondarySpBlsAggSignatures())
 
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-
-	var (
-		txHash   string
-		nonce    uint64
-		nonceErr error
-	)
-	for i := 0; i < BroadcastTxRetry; i++ {
-		nonce = client.sealAccNonce
-		txOpt := &ctypes.TxOption{
-			NoSimulate: true,
-			Mode:       &mode,
-			GasLimit:   client.gasInfo[Seal].GasLimit,
-			FeeAmount:  client.gasInfo[Seal].FeeAmount,
-			Nonce:      nonce,
-		}
-
-		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgSealObject}, txOpt)
-		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-			if nonceErr != nil {
-				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
-				ErrSealObjectOnChain.SetError(fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr))
-				return "", ErrSealObjectOnChain
-			}
-			client.sealAccNonce = nonce
-		}
-
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to broadcast seal object tx", "retry_number", i, "error", err)
-			continue
-		}
-		client.sealAccNonce = nonce + 1
-		log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
-		return txHash, nil
+	txHash, err := client.txPipelines[scope].submit(ctx, msgSealObject, client.gasInfo[Seal])
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to broadcast seal object tx", "error", err)
+		ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
+		return "", ErrSealObjectOnChain
 	}
-
-	// failed to broadcast tx
-	ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
-	return "", ErrSealObjectOnChain
+	log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
+	return txHash, nil
 }
 
 // RejectUnSealObject reject seal object on the greenfield chain.
@@ -274,51 +245,15 @@ func (client *GreenfieldChainSignClient) RejectUnSealObject(ctx context.Context,
 		return "", ErrSignMsg
 	}
 
-	client.sealLock.Lock()
-	defer client.sealLock.Unlock()
-
 	msgRejectUnSealObject := storagetypes.NewMsgRejectUnsealedObject(km.GetAddr(), rejectObject.GetBucketName(), rejectObject.GetObjectName())
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-
-	var (
-		txHash   string
-		nonce    uint64
-		nonceErr error
-	)
-
-	for i := 0; i < BroadcastTxRetry; i++ {
-		nonce = client.sealAccNonce
-		txOpt := &ctypes.TxOption{
-			NoSimulate: true,
-			Mode:       &mode,
-			GasLimit:   client.gasInfo[RejectSeal].GasLimit,
-			FeeAmount:  client.gasInfo[RejectSeal].FeeAmount,
-			Nonce:      nonce,
-		}
-		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgRejectUnSealObject}, txOpt)
-		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-			if nonceErr != nil {
-				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
-				ErrRejectUnSealObjectOnChain.SetError(fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr))
-				return "", ErrRejectUnSealObjectOnChain
-			}
-			client.sealAccNonce = nonce
-		}
-
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to broadcast reject unseal object", "retry_number", i, "error", err)
-			continue
-		}
-
-		client.sealAccNonce = nonce + 1
-		log.CtxDebugw(ctx, "succeed to broadcast reject unseal object tx", "tx_hash", txHash)
-		return txHash, nil
+	txHash, err := client.txPipelines[scope].submit(ctx, msgRejectUnSealObject, client.gasInfo[RejectSeal])
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to broadcast reject unseal object", "error", err)
+		ErrRejectUnSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast reject unseal object tx, error: %v", err))
+		return "", ErrRejectUnSealObjectOnChain
 	}
-	// failed to broadcast tx
-	ErrRejectUnSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast reject unseal object tx, error: %v", err))
-	return "", ErrRejectUnSealObjectOnChain
+	log.CtxDebugw(ctx, "succeed to broadcast reject unseal object tx", "tx_hash", txHash)
+	return txHash, nil
 }
 
 // DiscontinueBucket stops serving the bucket on the greenfield chain.
@@ -330,38 +265,15 @@ func (client *GreenfieldChainSignClient) DiscontinueBucket(ctx context.Context,
 		return "", ErrSignMsg
 	}
 
-	client.gcLock.Lock()
-	defer client.gcLock.Unlock()
-	nonce := client.gcAccNonce
-
 	msgDiscontinueBucket := storagetypes.NewMsgDiscontinueBucket(km.GetAddr(),
 		discontinueBucket.BucketName, discontinueBucket.Reason)
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-	txOpt := &ctypes.TxOption{ // allow simulation here to save gas cost
-		Mode:  &mode,
-		Nonce: nonce,
-	}
-
-	txHash, err := client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgDiscontinueBucket}, txOpt)
-	if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-		// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-		nonce, nonceErr := client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-		if nonceErr != nil {
-			log.CtxErrorw(ctx, "failed to get gc account nonce", "error", nonceErr)
-			ErrDiscontinueBucketOnChain.SetError(fmt.Errorf("failed to get gc account nonce, error: %v", nonceErr))
-			return "", ErrDiscontinueBucketOnChain
-		}
-		client.gcAccNonce = nonce
-	}
-
-	// failed to broadcast tx
+	// allow simulation here to save gas cost
+	txHash, err := client.txPipelines[scope].submit(ctx, msgDiscontinueBucket, GasInfo{})
 	if err != nil {
 		log.CtxErrorw(ctx, "failed to broadcast discontinue bucket", "error", err, "discontinue_bucket", msgDiscontinueBucket.String())
 		ErrDiscontinueBucketOnChain.SetError(fmt.Errorf("failed to broadcast discontinue bucket, error: %v", err))
 		return "", ErrDiscontinueBucketOnChain
 	}
-	// update nonce when tx is successful submitted
-	client.gcAccNonce = nonce + 1
 	return txHash, nil
 }
 
@@ -1048,7 +960,16 @@ func (client *GreenfieldChainSignClient) getNonceOnChain(ctx context.Context, gn
 }
 
 func (client *GreenfieldChainSignClient) broadcastTx(ctx context.Context, gnfdClient *client.GreenfieldClient,
-	msgs []sdk.Msg, txOpt *ctypes.TxOption, opts ...grpc.CallOption) (string, error) {
+	msgs []sdk.Msg, txOpt *ctypes.TxOption, opts ...grpc.CallOption) (txHash string, err error) {
+	msgTypes := make([]string, 0, len(msgs))
+	for _, msg := range msgs {
+		msgTypes = append(msgTypes, sdk.MsgTypeURL(msg))
+	}
+	ctx, span := tracing.StartSpan(ctx, "Signer.BroadcastTx", tracing.TxMsgs.StringSlice(msgTypes))
+	defer func() {
+		span.SetAttributes(tracing.TxHash.String(txHash))
+		tracing.EndSpan(span, err)
+	}()
 	resp, err := gnfdClient.BroadcastTx(ctx, msgs, txOpt, opts...)
 	if err != nil {
 		if strings.Contains(err.Error(), "account sequence mismatch") {
@@ -1239,52 +1160,16 @@ func (client *GreenfieldChainSignClient) SealObjectV2(ctx context.Context, scope
 		return "", ErrSignMsg
 	}
 
-	client.sealLock.Lock()
-	defer client.sealLock.Unlock()
-
 	msgSealObject := storagetypes.NewMsgSealObjectV2(km.GetAddr(),
 		sealObject.GetBucketName(), sealObject.GetObjectName(), sealObject.GetGlobalVirtualGroupId(),
 		sealObject.GetSecondarySpBlsAggSignatures(), sealObject.GetExpectChecksums())
 
-	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
-
-	var (
-		txHash   string
-		nonce    uint64
-		nonceErr error
-	)
-	for i := 0; i < BroadcastTxRetry; i++ {
-		nonce = client.sealAccNonce
-		txOpt := &ctypes.TxOption{
-			NoSimulate: true,
-			Mode:       &mode,
-			GasLimit:   client.gasInfo[Seal].GasLimit,
-			FeeAmount:  client.gasInfo[Seal].FeeAmount,
-			Nonce:      nonce,
-		}
-
-		txHash, err = client.broadcastTx(ctx, client.greenfieldClients[scope], []sdk.Msg{msgSealObject}, txOpt)
-		if errors.IsOf(err, sdkErrors.ErrWrongSequence) {
-			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
-			nonce, nonceErr = client.getNonceOnChain(ctx, client.greenfieldClients[scope])
-			if nonceErr != nil {
-				log.CtxErrorw(ctx, "failed to get seal account nonce", "error", nonceErr)
-				ErrSealObjectOnChain.SetError(fmt.Errorf("failed to get seal account nonce, error: %v", nonceErr))
-				return "", ErrSealObjectOnChain
-			}
-			client.sealAccNonce = nonce
-		}
-
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to broadcast seal object tx", "retry_number", i, "error", err)
-			continue
-		}
-		client.sealAccNonce = nonce + 1
-		log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
-		return txHash, nil
+	txHash, err := client.txPipelines[scope].submit(ctx, msgSealObject, client.gasInfo[Seal])
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to broadcast seal object tx", "error", err)
+		ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
+		return "", ErrSealObjectOnChain
 	}
-
-	// failed to broadcast tx
-	ErrSealObjectOnChain.SetError(fmt.Errorf("failed to broadcast seal object tx, error: %v", err))
-	return "", ErrSealObjectOnChain
+	log.CtxDebugw(ctx, "succeed to broadcast seal object tx", "tx_hash", txHash, "seal_msg", msgSealObject)
+	return txHash, nil
 }
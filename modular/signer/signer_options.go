@@ -3,12 +3,17 @@ package signer
 import (
 	"fmt"
 	"os"
+	"time"
 
 	sdk "github.com/cosmos/cosmos-sdk/types"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
 
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
 	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
 	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield/sdk/types"
 )
 
@@ -78,22 +83,6 @@ func DefaultSignerOptions(signer *SignModular, cfg *gfspconfig.GfSpConfig) error
 	if cfg.Chain.CompleteMigrateBucketFeeAmount == 0 {
 		cfg.Chain.CompleteMigrateBucketFeeAmount = DefaultCompleteMigrateBucketFeeAmount
 	}
-	if val, ok := os.LookupEnv(SpOperatorPrivKey); ok {
-		cfg.SpAccount.OperatorPrivateKey = val
-	}
-	if val, ok := os.LookupEnv(SpSealPrivKey); ok {
-		cfg.SpAccount.SealPrivateKey = val
-	}
-	if val, ok := os.LookupEnv(SpBlsPrivKey); ok {
-		cfg.SpAccount.BlsPrivateKey = val
-	}
-	if val, ok := os.LookupEnv(SpApprovalPrivKey); ok {
-		cfg.SpAccount.ApprovalPrivateKey = val
-	}
-	if val, ok := os.LookupEnv(SpGcPrivKey); ok {
-		cfg.SpAccount.GcPrivateKey = val
-	}
-
 	gasInfo := make(map[GasInfoType]GasInfo)
 	gasInfo[Seal] = GasInfo{
 		GasLimit:  cfg.Chain.SealGasLimit,
@@ -112,9 +101,17 @@ func DefaultSignerOptions(signer *SignModular, cfg *gfspconfig.GfSpConfig) error
 		FeeAmount: sdk.NewCoins(sdk.NewCoin(types.Denom, sdk.NewInt(int64(cfg.Chain.CreateGlobalVirtualGroupFeeAmount)))),
 	}
 
-	client, err := NewGreenfieldChainSignClient(cfg.Chain.ChainAddress[0], cfg.Chain.ChainID,
-		gasInfo, cfg.SpAccount.OperatorPrivateKey, cfg.SpAccount.FundingPrivateKey,
-		cfg.SpAccount.SealPrivateKey, cfg.SpAccount.ApprovalPrivateKey, cfg.SpAccount.GcPrivateKey, cfg.SpAccount.BlsPrivateKey)
+	provider, err := NewKeyProvider(&cfg.SpAccount)
+	if err != nil {
+		return err
+	}
+	pipelineCfg := TxPipelineConfig{
+		BatchSize:       int(cfg.Chain.TxBatchSize),
+		BatchWindow:     time.Duration(cfg.Chain.TxBatchWindowMillisecond) * time.Millisecond,
+		ResubmitTimeout: time.Duration(cfg.Chain.TxResubmitTimeoutSecond) * time.Second,
+		MaxResubmit:     int(cfg.Chain.TxMaxResubmit),
+	}
+	client, err := NewGreenfieldChainSignClient(cfg.Chain.ChainAddress[0], cfg.Chain.ChainID, gasInfo, provider, pipelineCfg)
 	if err != nil {
 		return err
 	}
@@ -122,3 +119,67 @@ func DefaultSignerOptions(signer *SignModular, cfg *gfspconfig.GfSpConfig) error
 	client.signer = signer
 	return nil
 }
+
+// NewKeyProvider returns the KeyProvider of the signing keys by the SpAccount config.
+func NewKeyProvider(cfg *gfspconfig.SpAccountConfig) (KeyProvider, error) {
+	switch cfg.KeyProvider {
+	case "", PlainKeyProviderType:
+		if val, ok := os.LookupEnv(SpOperatorPrivKey); ok {
+			cfg.OperatorPrivateKey = val
+		}
+		if val, ok := os.LookupEnv(SpSealPrivKey); ok {
+			cfg.SealPrivateKey = val
+		}
+		if val, ok := os.LookupEnv(SpBlsPrivKey); ok {
+			cfg.BlsPrivateKey = val
+		}
+		if val, ok := os.LookupEnv(SpApprovalPrivKey); ok {
+			cfg.ApprovalPrivateKey = val
+		}
+		if val, ok := os.LookupEnv(SpGcPrivKey); ok {
+			cfg.GcPrivateKey = val
+		}
+		return NewPlainKeyProvider(map[SignType]string{
+			SignOperator: cfg.OperatorPrivateKey,
+			SignSeal:     cfg.SealPrivateKey,
+			SignApproval: cfg.ApprovalPrivateKey,
+			SignGc:       cfg.GcPrivateKey,
+			SignBls:      cfg.BlsPrivateKey,
+		}), nil
+	case EnvKeyProviderType:
+		return NewEnvKeyProvider(), nil
+	case KeystoreKeyProviderType:
+		if cfg.KeystoreDir == "" {
+			return nil, fmt.Errorf("keystore dir missing")
+		}
+		passphrase, err := ReadKeystorePassphrase(cfg.KeystorePassphraseFile)
+		if err != nil {
+			return nil, err
+		}
+		return NewKeystoreKeyProvider(cfg.KeystoreDir, passphrase)
+	case RemoteKeyProviderType:
+		if cfg.RemoteSignerAddress == "" {
+			return nil, fmt.Errorf("remote signer address missing")
+		}
+		var creds credentials.TransportCredentials
+		if cfg.RemoteSignerInsecure {
+			log.Warnw("connect to remote signer without transport security, it is only for the local development",
+				"address", cfg.RemoteSignerAddress)
+			creds = insecure.NewCredentials()
+		} else {
+			tlsConfig, err := NewRemoteSignerClientTLSConfig(cfg.RemoteSignerCAFile, cfg.RemoteSignerCertFile,
+				cfg.RemoteSignerKeyFile)
+			if err != nil {
+				return nil, err
+			}
+			creds = credentials.NewTLS(tlsConfig)
+		}
+		signer, err := NewGRPCRemoteSigner(cfg.RemoteSignerAddress, grpc.WithTransportCredentials(creds))
+		if err != nil {
+			return nil, err
+		}
+		return NewRemoteKeyProvider(signer, DefaultRemoteSignTimeout), nil
+	default:
+		return nil, fmt.Errorf("%w: %s", ErrUnsupportedKeyProvider, cfg.KeyProvider)
+	}
+}
@@ -0,0 +1,596 @@
+package signer
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	errorsmod "cosmossdk.io/errors"
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/cosmos/cosmos-sdk/types/tx"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
+	"github.com/bnb-chain/greenfield/sdk/client"
+	ctypes "github.com/bnb-chain/greenfield/sdk/types"
+)
+
+const (
+	// DefaultTxBatchSize defines the default max number of msgs that are broadcast in one tx.
+	DefaultTxBatchSize = 16
+	// DefaultTxQueueSize defines the default number of msgs that can wait to be broadcast.
+	DefaultTxQueueSize = 1024
+	// DefaultTxResubmitTimeout defines the default timeout after that the uncommitted tx is
+	// regarded as dropped and is resubmitted.
+	DefaultTxResubmitTimeout = 30 * time.Second
+	// DefaultTxMaxResubmit defines the default max number of resubmitting a dropped tx.
+	DefaultTxMaxResubmit = 3
+	// DefaultTxConfirmInterval defines the default interval of querying the results of the pending txs.
+	DefaultTxConfirmInterval = time.Second
+)
+
+var (
+	errTxPipelineStopped = errors.New("tx pipeline is stopped")
+	errTxExecution       = errors.New("tx is failed to execute in block")
+	errTxDropped         = errors.New("tx is dropped and given up after resubmitting")
+	errTxNonceUsed       = errors.New("tx nonce is used by another tx")
+)
+
+// TxPipelineConfig defines the config of the tx pipeline of a signing account.
+type TxPipelineConfig struct {
+	// BatchSize is the max number of msgs that are broadcast in one tx, 1 disables the batching.
+	BatchSize int
+	// BatchWindow is the time to wait for more msgs to fill a batch, 0 means only the msgs that
+	// are already queued are batched, so the batching adds no latency.
+	BatchWindow time.Duration
+	// QueueSize is the number of msgs that can wait to be broadcast.
+	QueueSize int
+	// ResubmitTimeout is the timeout after that the uncommitted tx is regarded as dropped.
+	ResubmitTimeout time.Duration
+	// MaxResubmit is the max number of resubmitting a dropped tx.
+	MaxResubmit int
+	// ConfirmInterval is the interval of querying the results of the pending txs.
+	ConfirmInterval time.Duration
+}
+
+func (cfg *TxPipelineConfig) fillDefault() {
+	if cfg.BatchSize <= 0 {
+		cfg.BatchSize = DefaultTxBatchSize
+	}
+	if cfg.QueueSize <= 0 {
+		cfg.QueueSize = DefaultTxQueueSize
+	}
+	if cfg.ResubmitTimeout <= 0 {
+		cfg.ResubmitTimeout = DefaultTxResubmitTimeout
+	}
+	if cfg.MaxResubmit <= 0 {
+		cfg.MaxResubmit = DefaultTxMaxResubmit
+	}
+	if cfg.ConfirmInterval <= 0 {
+		cfg.ConfirmInterval = DefaultTxConfirmInterval
+	}
+}
+
+// txBroadcaster is the chain interface that the tx pipeline broadcasts the txs by.
+type txBroadcaster interface {
+	// BroadcastTx broadcasts the msgs in one tx, and returns the tx hash after the tx passes the check.
+	BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *ctypes.TxOption) (string, error)
+	// GetTxResult returns the result of the tx committed in block, the result is nil if the tx is
+	// not committed yet.
+	GetTxResult(ctx context.Context, txHash string) (*txExecResult, error)
+	// GetNonce returns the committed nonce of the account on chain.
+	GetNonce(ctx context.Context) (uint64, error)
+	// WaitForNextBlock waits for the next block committed.
+	WaitForNextBlock(ctx context.Context) error
+}
+
+var _ txBroadcaster = &gnfdTxBroadcaster{}
+
+type gnfdTxBroadcaster struct {
+	client     *GreenfieldChainSignClient
+	gnfdClient *client.GreenfieldClient
+}
+
+func (b *gnfdTxBroadcaster) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *ctypes.TxOption) (string, error) {
+	return b.client.broadcastTx(ctx, b.gnfdClient, msgs, txOpt)
+}
+
+func (b *gnfdTxBroadcaster) GetTxResult(ctx context.Context, txHash string) (*txExecResult, error) {
+	resp, err := b.gnfdClient.GetTx(ctx, &tx.GetTxRequest{Hash: txHash})
+	if err != nil {
+		if strings.Contains(err.Error(), "not found") {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &txExecResult{code: resp.TxResponse.Code, codespace: resp.TxResponse.Codespace,
+		rawLog: resp.TxResponse.RawLog}, nil
+}
+
+func (b *gnfdTxBroadcaster) GetNonce(ctx context.Context) (uint64, error) {
+	return b.gnfdClient.GetNonce(ctx)
+}
+
+func (b *gnfdTxBroadcaster) WaitForNextBlock(ctx context.Context) error {
+	return b.client.signer.baseApp.Consensus().WaitForNextBlock(ctx)
+}
+
+// nonceAllocator allocates the nonces of an account locally, so the txs are broadcast one after
+// another without waiting for the previous ones committed.
+type nonceAllocator struct {
+	mux  sync.Mutex
+	next uint64
+}
+
+func (a *nonceAllocator) allocate() uint64 {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	nonce := a.next
+	a.next++
+	return nonce
+}
+
+// release gives back the nonce whose tx is failed to broadcast, only the latest allocated nonce
+// can be given back, otherwise the allocated nonces will have a gap.
+func (a *nonceAllocator) release(nonce uint64) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	if a.next == nonce+1 {
+		a.next = nonce
+	}
+}
+
+func (a *nonceAllocator) reset(nonce uint64) {
+	a.mux.Lock()
+	defer a.mux.Unlock()
+	a.next = nonce
+}
+
+type txResult struct {
+	txHash string
+	err    error
+}
+
+// txExecResult is the result of executing the tx in block, the code 0 means the tx is succeeded.
+type txExecResult struct {
+	code      uint32
+	codespace string
+	rawLog    string
+}
+
+type txRequest struct {
+	ctx     context.Context
+	msg     sdk.Msg
+	gasInfo GasInfo
+	result  chan txResult
+}
+
+// batchable returns whether the msg can be broadcast with the others in one tx, the msg needs
+// simulating the gas is broadcast alone.
+func (r *txRequest) batchable() bool {
+	return r.gasInfo.GasLimit > 0
+}
+
+func (r *txRequest) done(txHash string, err error) {
+	r.result <- txResult{txHash: txHash, err: err}
+}
+
+type pendingTx struct {
+	txHash   string
+	msgs     []sdk.Msg
+	txOpt    *ctypes.TxOption
+	sentAt   time.Time
+	resubmit int
+	// requests are the submitters waiting for the result of the tx.
+	requests []*txRequest
+}
+
+// done returns the result to the submitters of the tx.
+func (t *pendingTx) done(txHash string, err error) {
+	for _, req := range t.requests {
+		req.done(txHash, err)
+	}
+}
+
+// txPipeline broadcasts the msgs of a signing account. The msgs are broadcast by a single worker
+// in the submitted order, the queued msgs are batched into one tx, and the nonce is allocated
+// locally. The broadcast txs are tracked until they are committed, the submitters get the result
+// only after the tx is executed in block. The dropped txs are resubmitted with the same nonce, and
+// the msgs of the failed batch are bisected and broadcast again, so a bad msg does not fail the others.
+type txPipeline struct {
+	scope       SignType
+	cfg         TxPipelineConfig
+	broadcaster txBroadcaster
+	nonce       *nonceAllocator
+
+	requests chan *txRequest
+	// deferred is the msg that is taken from the requests but can not be batched, it is broadcast
+	// before the next msg in the requests.
+	deferred *txRequest
+	pending  map[uint64]*pendingTx
+
+	ctx      context.Context
+	cancel   context.CancelFunc
+	stopOnce sync.Once
+	wg       sync.WaitGroup
+}
+
+func newTxPipeline(scope SignType, broadcaster txBroadcaster, nonce uint64, cfg TxPipelineConfig) *txPipeline {
+	cfg.fillDefault()
+	ctx, cancel := context.WithCancel(context.Background())
+	return &txPipeline{
+		scope:       scope,
+		cfg:         cfg,
+		broadcaster: broadcaster,
+		nonce:       &nonceAllocator{next: nonce},
+		requests:    make(chan *txRequest, cfg.QueueSize),
+		pending:     make(map[uint64]*pendingTx),
+		ctx:         ctx,
+		cancel:      cancel,
+	}
+}
+
+func (p *txPipeline) start() {
+	p.wg.Add(1)
+	go p.run()
+}
+
+func (p *txPipeline) stop() {
+	p.stopOnce.Do(func() {
+		p.cancel()
+		p.wg.Wait()
+	})
+}
+
+// submit queues the msg and waits for it being executed in block, the msg is broadcast with the fixed
+// gas info, or with the simulated gas if the gas limit is 0.
+func (p *txPipeline) submit(ctx context.Context, msg sdk.Msg, gasInfo GasInfo) (txHash string, err error) {
+	ctx, span := tracing.StartSpan(ctx, "Signer.SubmitTx", tracing.SignScope.String(string(p.scope)),
+		tracing.TxMsgs.StringSlice([]string{sdk.MsgTypeURL(msg)}))
+	defer func() {
+		span.SetAttributes(tracing.TxHash.String(txHash))
+		tracing.EndSpan(span, err)
+	}()
+	req := &txRequest{ctx: ctx, msg: msg, gasInfo: gasInfo, result: make(chan txResult, 1)}
+	metrics.SignerTxQueueGauge.WithLabelValues(string(p.scope)).Inc()
+	select {
+	case p.requests <- req:
+	case <-ctx.Done():
+		p.dequeued()
+		return "", ctx.Err()
+	case <-p.ctx.Done():
+		p.dequeued()
+		return "", errTxPipelineStopped
+	}
+	select {
+	case res := <-req.result:
+		return res.txHash, res.err
+	case <-ctx.Done():
+		return "", ctx.Err()
+	case <-p.ctx.Done():
+		return "", errTxPipelineStopped
+	}
+}
+
+func (p *txPipeline) run() {
+	defer p.wg.Done()
+	interval := p.cfg.ResubmitTimeout / 2
+	if interval <= 0 {
+		interval = p.cfg.ResubmitTimeout
+	}
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	confirmTicker := time.NewTicker(p.cfg.ConfirmInterval)
+	defer confirmTicker.Stop()
+	for {
+		if p.deferred != nil {
+			req := p.deferred
+			p.deferred = nil
+			p.broadcast([]*txRequest{req})
+			continue
+		}
+		select {
+		case <-p.ctx.Done():
+			return
+		case req := <-p.requests:
+			p.dequeued()
+			p.broadcast(p.collect(req))
+		case <-confirmTicker.C:
+			p.confirmPending()
+		case <-ticker.C:
+			p.resubmitDropped()
+		}
+	}
+}
+
+func (p *txPipeline) dequeued() {
+	metrics.SignerTxQueueGauge.WithLabelValues(string(p.scope)).Dec()
+}
+
+// collect batches the queued msgs with the first one, the msgs whose submitters have gone are
+// skipped, and the first msg that can not be batched is deferred.
+func (p *txPipeline) collect(first *txRequest) []*txRequest {
+	batch := []*txRequest{first}
+	if !first.batchable() || p.cfg.BatchSize <= 1 {
+		return batch
+	}
+	var window <-chan time.Time
+	if p.cfg.BatchWindow > 0 {
+		timer := time.NewTimer(p.cfg.BatchWindow)
+		defer timer.Stop()
+		window = timer.C
+	}
+	for len(batch) < p.cfg.BatchSize {
+		var req *txRequest
+		if window == nil {
+			select {
+			case req = <-p.requests:
+			default:
+				return batch
+			}
+		} else {
+			select {
+			case req = <-p.requests:
+			case <-window:
+				return batch
+			case <-p.ctx.Done():
+				return batch
+			}
+		}
+		p.dequeued()
+		if req.ctx.Err() != nil {
+			req.done("", req.ctx.Err())
+			continue
+		}
+		if !req.batchable() {
+			p.deferred = req
+			return batch
+		}
+		batch = append(batch, req)
+	}
+	return batch
+}
+
+// broadcast broadcasts the batch in one tx, if it is failed, the msgs are broadcast one by one,
+// so that a bad msg does not fail the others. The submitters wait for the tx executed in block.
+func (p *txPipeline) broadcast(batch []*txRequest) {
+	if len(batch) == 1 && batch[0].ctx.Err() != nil {
+		batch[0].done("", batch[0].ctx.Err())
+		return
+	}
+	msgs := make([]sdk.Msg, 0, len(batch))
+	gasInfo := GasInfo{}
+	for _, req := range batch {
+		msgs = append(msgs, req.msg)
+		gasInfo.GasLimit += req.gasInfo.GasLimit
+		gasInfo.FeeAmount = gasInfo.FeeAmount.Add(req.gasInfo.FeeAmount...)
+	}
+	retry := BroadcastTxRetry
+	if len(batch) > 1 {
+		retry = 1
+	}
+	ctx, span := p.startBroadcastSpan(batch)
+	txHash, err := p.broadcastMsgs(ctx, msgs, gasInfo, retry, batch)
+	span.SetAttributes(tracing.TxHash.String(txHash))
+	tracing.EndSpan(span, err)
+	if err != nil && len(batch) > 1 {
+		log.Warnw("failed to broadcast batched tx, fall back to broadcast one by one", "scope", p.scope,
+			"batch_size", len(batch), "error", err)
+		for _, req := range batch {
+			p.broadcast([]*txRequest{req})
+		}
+		return
+	}
+	if err != nil {
+		batch[0].done("", err)
+		return
+	}
+	metrics.SignerTxBatchSizeHistogram.WithLabelValues(string(p.scope)).Observe(float64(len(batch)))
+}
+
+// startBroadcastSpan starts the span of broadcasting the batch, it is the child of the submitting span
+// if the batch has only one msg, otherwise it links to the submitting spans of the msgs.
+func (p *txPipeline) startBroadcastSpan(batch []*txRequest) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{tracing.SignScope.String(string(p.scope)), tracing.TxBatchSize.Int(len(batch))}
+	if len(batch) == 1 {
+		ctx := trace.ContextWithSpanContext(p.ctx, trace.SpanContextFromContext(batch[0].ctx))
+		return tracing.StartSpan(ctx, "Signer.BroadcastBatch", attrs...)
+	}
+	links := make([]trace.Link, 0, len(batch))
+	for _, req := range batch {
+		links = append(links, trace.Link{SpanContext: trace.SpanContextFromContext(req.ctx)})
+	}
+	return tracing.Tracer().Start(p.ctx, "Signer.BroadcastBatch", trace.WithLinks(links...),
+		trace.WithAttributes(attrs...))
+}
+
+// broadcastMsgs broadcasts the msgs in one tx with the local allocated nonce, and retries on the
+// nonce mismatch by resetting the nonce from chain. The broadcast tx is pending with the requests
+// until it is executed in block.
+func (p *txPipeline) broadcastMsgs(ctx context.Context, msgs []sdk.Msg, gasInfo GasInfo, retry int,
+	requests []*txRequest) (string, error) {
+	mode := tx.BroadcastMode_BROADCAST_MODE_SYNC
+	var (
+		txHash string
+		err    error
+	)
+	for i := 0; i < retry || errorsmod.IsOf(err, sdkErrors.ErrWrongSequence) && i < BroadcastTxRetry; i++ {
+		nonce := p.nonce.allocate()
+		txOpt := &ctypes.TxOption{Mode: &mode, Nonce: nonce}
+		if gasInfo.GasLimit > 0 {
+			txOpt.NoSimulate = true
+			txOpt.GasLimit = gasInfo.GasLimit
+			txOpt.FeeAmount = gasInfo.FeeAmount
+		}
+		txHash, err = p.broadcaster.BroadcastTx(ctx, msgs, txOpt)
+		if err == nil {
+			p.pending[nonce] = &pendingTx{txHash: txHash, msgs: msgs, txOpt: txOpt, sentAt: time.Now(),
+				requests: requests}
+			metrics.SignerTxPendingGauge.WithLabelValues(string(p.scope)).Set(float64(len(p.pending)))
+			log.Debugw("succeed to broadcast tx", "scope", p.scope, "nonce", nonce, "msg_number", len(msgs),
+				"tx_hash", txHash)
+			return txHash, nil
+		}
+		p.nonce.release(nonce)
+		log.Errorw("failed to broadcast tx", "scope", p.scope, "nonce", nonce, "msg_number", len(msgs),
+			"retry_number", i, "error", err)
+		if errorsmod.IsOf(err, sdkErrors.ErrWrongSequence) {
+			// if nonce mismatch, wait for next block, reset nonce by querying the nonce on chain
+			if nonceErr := p.resetNonce(true); nonceErr != nil {
+				return "", nonceErr
+			}
+		}
+	}
+	return "", err
+}
+
+func (p *txPipeline) resetNonce(waitBlock bool) error {
+	if waitBlock {
+		if err := p.broadcaster.WaitForNextBlock(p.ctx); err != nil {
+			log.Errorw("failed to wait next block", "scope", p.scope, "error", err)
+			return err
+		}
+	}
+	nonce, err := p.broadcaster.GetNonce(p.ctx)
+	if err != nil {
+		log.Errorw("failed to get nonce on chain", "scope", p.scope, "error", err)
+		return err
+	}
+	p.nonce.reset(nonce)
+	return nil
+}
+
+func (p *txPipeline) sortedPendingNonces() []uint64 {
+	nonces := make([]uint64, 0, len(p.pending))
+	for nonce := range p.pending {
+		nonces = append(nonces, nonce)
+	}
+	sort.Slice(nonces, func(i, j int) bool { return nonces[i] < nonces[j] })
+	return nonces
+}
+
+// confirmPending queries the results of the pending txs, the submitters of the succeeded tx get the
+// tx hash, and the msgs of the tx failed to execute are retried by retryFailedTx.
+func (p *txPipeline) confirmPending() {
+	if len(p.pending) == 0 {
+		return
+	}
+	for _, nonce := range p.sortedPendingNonces() {
+		pending := p.pending[nonce]
+		result, err := p.broadcaster.GetTxResult(p.ctx, pending.txHash)
+		if err != nil {
+			log.Errorw("failed to get tx result", "scope", p.scope, "nonce", nonce, "tx_hash", pending.txHash,
+				"error", err)
+			continue
+		}
+		if result == nil {
+			continue
+		}
+		delete(p.pending, nonce)
+		if result.code == 0 {
+			log.Debugw("succeed to execute tx", "scope", p.scope, "nonce", nonce, "tx_hash", pending.txHash)
+			pending.done(pending.txHash, nil)
+			continue
+		}
+		log.Errorw("failed to execute tx", "scope", p.scope, "nonce", nonce, "tx_hash", pending.txHash,
+			"msg_number", len(pending.msgs), "code", result.code, "codespace", result.codespace, "log", result.rawLog)
+		p.retryFailedTx(pending, fmt.Errorf("%w, tx_hash: %s, code: %d, codespace: %s, log: %s", errTxExecution,
+			pending.txHash, result.code, result.codespace, result.rawLog))
+	}
+	metrics.SignerTxPendingGauge.WithLabelValues(string(p.scope)).Set(float64(len(p.pending)))
+}
+
+// retryFailedTx bisects the msgs of the batched tx that is failed to execute and broadcasts the halves
+// again, so the bad msg is isolated and the others succeed, the submitter of the single msg tx gets
+// the error.
+func (p *txPipeline) retryFailedTx(pending *pendingTx, err error) {
+	var requests []*txRequest
+	for _, req := range pending.requests {
+		if req.ctx.Err() != nil {
+			req.done("", req.ctx.Err())
+			continue
+		}
+		requests = append(requests, req)
+	}
+	if len(requests) == 0 {
+		return
+	}
+	if len(pending.requests) == 1 {
+		requests[0].done("", err)
+		return
+	}
+	mid := (len(requests) + 1) / 2
+	p.broadcast(requests[:mid])
+	if mid < len(requests) {
+		p.broadcast(requests[mid:])
+	}
+}
+
+// resubmitDropped resubmits the pending txs that are not committed in the resubmit timeout, they
+// are regarded as dropped from the mempool, and they block the txs with the larger nonces. The
+// pending txs whose nonces are less than the nonce on chain are committed, their results are
+// confirmed by confirmPending, unless the nonces are used by other txs.
+func (p *txPipeline) resubmitDropped() {
+	if len(p.pending) == 0 {
+		return
+	}
+	chainNonce, err := p.broadcaster.GetNonce(p.ctx)
+	if err != nil {
+		log.Errorw("failed to get nonce on chain", "scope", p.scope, "error", err)
+		return
+	}
+	for _, nonce := range p.sortedPendingNonces() {
+		pending := p.pending[nonce]
+		if time.Since(pending.sentAt) < p.cfg.ResubmitTimeout {
+			continue
+		}
+		if nonce < chainNonce {
+			// the committed tx is still not found by the hash after the timeout
+			log.Errorw("failed to confirm tx whose nonce is used", "scope", p.scope, "nonce", nonce,
+				"tx_hash", pending.txHash)
+			delete(p.pending, nonce)
+			pending.done("", errTxNonceUsed)
+			continue
+		}
+		if pending.resubmit >= p.cfg.MaxResubmit {
+			// give up the dropped tx, the following txs are blocked by the gap of nonce, so they are
+			// given up too, and the nonce is reset to fill the gap by the next tx
+			log.Errorw("failed to resubmit dropped tx, give up the pending txs", "scope", p.scope,
+				"nonce", nonce, "tx_hash", pending.txHash, "pending_number", len(p.pending))
+			for n, dropped := range p.pending {
+				if n >= chainNonce {
+					delete(p.pending, n)
+					dropped.done("", errTxDropped)
+				}
+			}
+			p.nonce.reset(chainNonce)
+			metrics.SignerTxPendingGauge.WithLabelValues(string(p.scope)).Set(float64(len(p.pending)))
+			return
+		}
+		pending.resubmit++
+		pending.sentAt = time.Now()
+		metrics.SignerTxResubmitCounter.WithLabelValues(string(p.scope)).Inc()
+		txHash, err := p.broadcaster.BroadcastTx(p.ctx, pending.msgs, pending.txOpt)
+		if errorsmod.IsOf(err, sdkErrors.ErrWrongSequence) {
+			// the nonce is used by the committed tx, its result is confirmed by the tx hash
+			continue
+		}
+		if err != nil {
+			log.Errorw("failed to resubmit dropped tx", "scope", p.scope, "nonce", nonce,
+				"resubmit", pending.resubmit, "error", err)
+			continue
+		}
+		pending.txHash = txHash
+		log.Infow("succeed to resubmit dropped tx", "scope", p.scope, "nonce", nonce,
+			"resubmit", pending.resubmit, "tx_hash", txHash)
+	}
+	metrics.SignerTxPendingGauge.WithLabelValues(string(p.scope)).Set(float64(len(p.pending)))
+}
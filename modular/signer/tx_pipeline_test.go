@@ -0,0 +1,259 @@
+package signer
+
+import (
+	"context"
+	"fmt"
+	"sync"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	sdk "github.com/cosmos/cosmos-sdk/types"
+	sdkErrors "github.com/cosmos/cosmos-sdk/types/errors"
+	"github.com/stretchr/testify/assert"
+	"github.com/stretchr/testify/require"
+
+	ctypes "github.com/bnb-chain/greenfield/sdk/types"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+type mockTx struct {
+	nonce    uint64
+	gasLimit uint64
+	msgs     []sdk.Msg
+}
+
+type mockBroadcaster struct {
+	mux        sync.Mutex
+	txs        []mockTx
+	chainNonce uint64
+	// gate blocks the broadcasting until it is closed if it is not nil
+	gate    chan struct{}
+	entered atomic.Int32
+	// fail returns the broadcasting error of the tx
+	fail func(nonce uint64, msgs []sdk.Msg) error
+	// exec returns the result of executing the tx in block, nil means the tx is not committed, all
+	// the txs are committed and succeeded if it is nil
+	exec   func(tx mockTx) *txExecResult
+	hashes map[string]mockTx
+}
+
+func (b *mockBroadcaster) BroadcastTx(ctx context.Context, msgs []sdk.Msg, txOpt *ctypes.TxOption) (string, error) {
+	b.entered.Add(1)
+	if b.gate != nil {
+		<-b.gate
+	}
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	if b.fail != nil {
+		if err := b.fail(txOpt.Nonce, msgs); err != nil {
+			return "", err
+		}
+	}
+	tx := mockTx{nonce: txOpt.Nonce, gasLimit: txOpt.GasLimit, msgs: msgs}
+	b.txs = append(b.txs, tx)
+	txHash := fmt.Sprintf("tx_%d_%d", txOpt.Nonce, len(b.txs))
+	if b.hashes == nil {
+		b.hashes = make(map[string]mockTx)
+	}
+	b.hashes[txHash] = tx
+	return txHash, nil
+}
+
+func (b *mockBroadcaster) GetTxResult(ctx context.Context, txHash string) (*txExecResult, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	tx, ok := b.hashes[txHash]
+	if !ok {
+		return nil, nil
+	}
+	if b.exec == nil {
+		return &txExecResult{}, nil
+	}
+	return b.exec(tx), nil
+}
+
+func (b *mockBroadcaster) GetNonce(ctx context.Context) (uint64, error) {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return b.chainNonce, nil
+}
+
+func (b *mockBroadcaster) WaitForNextBlock(ctx context.Context) error {
+	return nil
+}
+
+func (b *mockBroadcaster) sentTxs() []mockTx {
+	b.mux.Lock()
+	defer b.mux.Unlock()
+	return append([]mockTx{}, b.txs...)
+}
+
+func mockSealMsg(name string) sdk.Msg {
+	return &storagetypes.MsgSealObject{BucketName: "bucket", ObjectName: name}
+}
+
+var mockSealGas = GasInfo{GasLimit: 1200, FeeAmount: sdk.NewCoins(sdk.NewInt64Coin("BNB", 10))}
+
+func TestTxPipeline_BatchQueuedMsgs(t *testing.T) {
+	broadcaster := &mockBroadcaster{gate: make(chan struct{})}
+	pipeline := newTxPipeline(SignSeal, broadcaster, 10, TxPipelineConfig{BatchSize: 3, ConfirmInterval: time.Millisecond})
+	pipeline.start()
+	defer pipeline.stop()
+
+	var wg sync.WaitGroup
+	hashes := make([]string, 5)
+	submit := func(i int) {
+		defer wg.Done()
+		txHash, err := pipeline.submit(context.Background(), mockSealMsg(fmt.Sprintf("object_%d", i)), mockSealGas)
+		assert.NoError(t, err)
+		hashes[i] = txHash
+	}
+	// the first msg blocks the worker, the others are queued
+	wg.Add(1)
+	go submit(0)
+	require.Eventually(t, func() bool { return broadcaster.entered.Load() == 1 }, time.Second, time.Millisecond)
+	for i := 1; i < 5; i++ {
+		wg.Add(1)
+		go submit(i)
+	}
+	require.Eventually(t, func() bool { return len(pipeline.requests) == 4 }, time.Second, time.Millisecond)
+	close(broadcaster.gate)
+	wg.Wait()
+
+	txs := broadcaster.sentTxs()
+	require.Len(t, txs, 3)
+	assert.Equal(t, []int{1, 3, 1}, []int{len(txs[0].msgs), len(txs[1].msgs), len(txs[2].msgs)})
+	assert.Equal(t, []uint64{10, 11, 12}, []uint64{txs[0].nonce, txs[1].nonce, txs[2].nonce})
+	assert.Equal(t, 3*mockSealGas.GasLimit, txs[1].gasLimit)
+	assert.Equal(t, 3, countTxHash(hashes))
+}
+
+func countTxHash(hashes []string) int {
+	set := make(map[string]struct{})
+	for _, hash := range hashes {
+		set[hash] = struct{}{}
+	}
+	return len(set)
+}
+
+func TestTxPipeline_SplitFailedBatch(t *testing.T) {
+	badMsg := mockSealMsg("bad")
+	broadcaster := &mockBroadcaster{fail: func(nonce uint64, msgs []sdk.Msg) error {
+		for _, msg := range msgs {
+			if msg == badMsg {
+				return fmt.Errorf("mock invalid msg")
+			}
+		}
+		return nil
+	}}
+	pipeline := newTxPipeline(SignSeal, broadcaster, 0, TxPipelineConfig{})
+	reqs := []*txRequest{
+		{ctx: context.Background(), msg: mockSealMsg("good_1"), gasInfo: mockSealGas, result: make(chan txResult, 1)},
+		{ctx: context.Background(), msg: badMsg, gasInfo: mockSealGas, result: make(chan txResult, 1)},
+		{ctx: context.Background(), msg: mockSealMsg("good_2"), gasInfo: mockSealGas, result: make(chan txResult, 1)},
+	}
+	pipeline.broadcast(reqs)
+	pipeline.confirmPending()
+
+	assert.NoError(t, (<-reqs[0].result).err)
+	assert.Error(t, (<-reqs[1].result).err)
+	assert.NoError(t, (<-reqs[2].result).err)
+	txs := broadcaster.sentTxs()
+	require.Len(t, txs, 2)
+	// the nonce of the failed msgs is released, so the nonces have no gap
+	assert.Equal(t, []uint64{0, 1}, []uint64{txs[0].nonce, txs[1].nonce})
+}
+
+func TestTxPipeline_ResetNonceOnWrongSequence(t *testing.T) {
+	broadcaster := &mockBroadcaster{chainNonce: 7, fail: func(nonce uint64, msgs []sdk.Msg) error {
+		if nonce < 7 {
+			return sdkErrors.ErrWrongSequence
+		}
+		return nil
+	}}
+	pipeline := newTxPipeline(SignGc, broadcaster, 3, TxPipelineConfig{ConfirmInterval: time.Millisecond})
+	pipeline.start()
+	defer pipeline.stop()
+
+	_, err := pipeline.submit(context.Background(), mockSealMsg("object"), GasInfo{})
+	require.NoError(t, err)
+	txs := broadcaster.sentTxs()
+	require.Len(t, txs, 1)
+	assert.Equal(t, uint64(7), txs[0].nonce)
+	assert.Equal(t, uint64(8), pipeline.nonce.next)
+}
+
+func mockTxRequest(msg sdk.Msg) *txRequest {
+	return &txRequest{ctx: context.Background(), msg: msg, gasInfo: mockSealGas, result: make(chan txResult, 1)}
+}
+
+func TestTxPipeline_ResubmitDroppedTx(t *testing.T) {
+	// the tx of nonce 0 is committed, the others are dropped
+	broadcaster := &mockBroadcaster{chainNonce: 1, exec: func(tx mockTx) *txExecResult {
+		if tx.nonce == 0 {
+			return &txExecResult{}
+		}
+		return nil
+	}}
+	pipeline := newTxPipeline(SignSeal, broadcaster, 0, TxPipelineConfig{ResubmitTimeout: time.Millisecond, MaxResubmit: 2})
+	reqs := make([]*txRequest, 3)
+	for i := 0; i < 3; i++ {
+		reqs[i] = mockTxRequest(mockSealMsg(fmt.Sprintf("object_%d", i)))
+		_, err := pipeline.broadcastMsgs(context.Background(), []sdk.Msg{reqs[i].msg}, mockSealGas, 1, reqs[i:i+1])
+		require.NoError(t, err)
+	}
+	pipeline.confirmPending()
+	assert.NoError(t, (<-reqs[0].result).err)
+	time.Sleep(2 * time.Millisecond)
+	pipeline.resubmitDropped()
+	assert.Len(t, pipeline.pending, 2)
+	txs := broadcaster.sentTxs()
+	require.Len(t, txs, 5)
+	assert.Equal(t, []uint64{1, 2}, []uint64{txs[3].nonce, txs[4].nonce})
+
+	// give up the dropped txs after the max resubmit, reset the nonce to fill the gap, and the
+	// submitters get the failure
+	time.Sleep(2 * time.Millisecond)
+	pipeline.resubmitDropped()
+	time.Sleep(2 * time.Millisecond)
+	pipeline.resubmitDropped()
+	assert.Len(t, pipeline.pending, 0)
+	assert.Equal(t, uint64(1), pipeline.nonce.next)
+	assert.ErrorIs(t, (<-reqs[1].result).err, errTxDropped)
+	assert.ErrorIs(t, (<-reqs[2].result).err, errTxDropped)
+}
+
+func TestTxPipeline_BisectFailedExecution(t *testing.T) {
+	badMsg := mockSealMsg("bad")
+	broadcaster := &mockBroadcaster{exec: func(tx mockTx) *txExecResult {
+		for _, msg := range tx.msgs {
+			if msg == badMsg {
+				return &txExecResult{code: 1, codespace: "storage", rawLog: "mock invalid msg"}
+			}
+		}
+		return &txExecResult{}
+	}}
+	pipeline := newTxPipeline(SignSeal, broadcaster, 0, TxPipelineConfig{})
+	reqs := []*txRequest{mockTxRequest(mockSealMsg("good_1")), mockTxRequest(badMsg), mockTxRequest(mockSealMsg("good_2"))}
+	pipeline.broadcast(reqs)
+	for _, req := range reqs {
+		// the tx passes the check, the submitters wait for it executed in block
+		assert.Len(t, req.result, 0)
+	}
+
+	// the failed batch is bisected until the bad msg is isolated
+	for i := 0; i < 3; i++ {
+		pipeline.confirmPending()
+	}
+	assert.Len(t, pipeline.pending, 0)
+	res := <-reqs[0].result
+	assert.NoError(t, res.err)
+	assert.NotEmpty(t, res.txHash)
+	assert.ErrorIs(t, (<-reqs[1].result).err, errTxExecution)
+	assert.NoError(t, (<-reqs[2].result).err)
+	txs := broadcaster.sentTxs()
+	require.Len(t, txs, 5)
+	assert.Equal(t, []int{3, 2, 1, 1, 1}, []int{len(txs[0].msgs), len(txs[1].msgs), len(txs[2].msgs),
+		len(txs[3].msgs), len(txs[4].msgs)})
+}
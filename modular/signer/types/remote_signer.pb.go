@@ -0,0 +1,1028 @@
+// Code generated by protoc-gen-gogo. DO NOT EDIT.
+// source: modular/signer/types/remote_signer.proto
+
+package types
+
+import (
+	context "context"
+	fmt "fmt"
+	grpc1 "github.com/cosmos/gogoproto/grpc"
+	proto "github.com/cosmos/gogoproto/proto"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	io "io"
+	math "math"
+	math_bits "math/bits"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.GoGoProtoPackageIsVersion3 // please upgrade the proto package
+
+// RemoteSignerPubKeyRequest is request type for the PubKey RPC method.
+type RemoteSignerPubKeyRequest struct {
+	// scope defines the sign type of the key, such as operator, seal, approval, gc or bls
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+}
+
+func (m *RemoteSignerPubKeyRequest) Reset()         { *m = RemoteSignerPubKeyRequest{} }
+func (m *RemoteSignerPubKeyRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerPubKeyRequest) ProtoMessage()    {}
+func (*RemoteSignerPubKeyRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fad7c73fe08e2e9, []int{0}
+}
+func (m *RemoteSignerPubKeyRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerPubKeyRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerPubKeyRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerPubKeyRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerPubKeyRequest.Merge(m, src)
+}
+func (m *RemoteSignerPubKeyRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerPubKeyRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerPubKeyRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerPubKeyRequest proto.InternalMessageInfo
+
+func (m *RemoteSignerPubKeyRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+// RemoteSignerPubKeyResponse is response type for the PubKey RPC method.
+type RemoteSignerPubKeyResponse struct {
+	// pub_key defines the public key bytes of the sign type
+	PubKey []byte `protobuf:"bytes,1,opt,name=pub_key,json=pubKey,proto3" json:"pub_key,omitempty"`
+}
+
+func (m *RemoteSignerPubKeyResponse) Reset()         { *m = RemoteSignerPubKeyResponse{} }
+func (m *RemoteSignerPubKeyResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerPubKeyResponse) ProtoMessage()    {}
+func (*RemoteSignerPubKeyResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fad7c73fe08e2e9, []int{1}
+}
+func (m *RemoteSignerPubKeyResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerPubKeyResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerPubKeyResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerPubKeyResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerPubKeyResponse.Merge(m, src)
+}
+func (m *RemoteSignerPubKeyResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerPubKeyResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerPubKeyResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerPubKeyResponse proto.InternalMessageInfo
+
+func (m *RemoteSignerPubKeyResponse) GetPubKey() []byte {
+	if m != nil {
+		return m.PubKey
+	}
+	return nil
+}
+
+// RemoteSignerSignRequest is request type for the Sign RPC method.
+type RemoteSignerSignRequest struct {
+	// scope defines the sign type of the key that signs the msg
+	Scope string `protobuf:"bytes,1,opt,name=scope,proto3" json:"scope,omitempty"`
+	// msg defines the bytes to be signed
+	Msg []byte `protobuf:"bytes,2,opt,name=msg,proto3" json:"msg,omitempty"`
+}
+
+func (m *RemoteSignerSignRequest) Reset()         { *m = RemoteSignerSignRequest{} }
+func (m *RemoteSignerSignRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerSignRequest) ProtoMessage()    {}
+func (*RemoteSignerSignRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fad7c73fe08e2e9, []int{2}
+}
+func (m *RemoteSignerSignRequest) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerSignRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerSignRequest.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerSignRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerSignRequest.Merge(m, src)
+}
+func (m *RemoteSignerSignRequest) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerSignRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerSignRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerSignRequest proto.InternalMessageInfo
+
+func (m *RemoteSignerSignRequest) GetScope() string {
+	if m != nil {
+		return m.Scope
+	}
+	return ""
+}
+
+func (m *RemoteSignerSignRequest) GetMsg() []byte {
+	if m != nil {
+		return m.Msg
+	}
+	return nil
+}
+
+// RemoteSignerSignResponse is response type for the Sign RPC method.
+type RemoteSignerSignResponse struct {
+	// signature defines the signature of the msg
+	Signature []byte `protobuf:"bytes,1,opt,name=signature,proto3" json:"signature,omitempty"`
+}
+
+func (m *RemoteSignerSignResponse) Reset()         { *m = RemoteSignerSignResponse{} }
+func (m *RemoteSignerSignResponse) String() string { return proto.CompactTextString(m) }
+func (*RemoteSignerSignResponse) ProtoMessage()    {}
+func (*RemoteSignerSignResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_7fad7c73fe08e2e9, []int{3}
+}
+func (m *RemoteSignerSignResponse) XXX_Unmarshal(b []byte) error {
+	return m.Unmarshal(b)
+}
+func (m *RemoteSignerSignResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	if deterministic {
+		return xxx_messageInfo_RemoteSignerSignResponse.Marshal(b, m, deterministic)
+	} else {
+		b = b[:cap(b)]
+		n, err := m.MarshalToSizedBuffer(b)
+		if err != nil {
+			return nil, err
+		}
+		return b[:n], nil
+	}
+}
+func (m *RemoteSignerSignResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoteSignerSignResponse.Merge(m, src)
+}
+func (m *RemoteSignerSignResponse) XXX_Size() int {
+	return m.Size()
+}
+func (m *RemoteSignerSignResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoteSignerSignResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoteSignerSignResponse proto.InternalMessageInfo
+
+func (m *RemoteSignerSignResponse) GetSignature() []byte {
+	if m != nil {
+		return m.Signature
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*RemoteSignerPubKeyRequest)(nil), "modular.signer.types.RemoteSignerPubKeyRequest")
+	proto.RegisterType((*RemoteSignerPubKeyResponse)(nil), "modular.signer.types.RemoteSignerPubKeyResponse")
+	proto.RegisterType((*RemoteSignerSignRequest)(nil), "modular.signer.types.RemoteSignerSignRequest")
+	proto.RegisterType((*RemoteSignerSignResponse)(nil), "modular.signer.types.RemoteSignerSignResponse")
+}
+
+func init() {
+	proto.RegisterFile("modular/signer/types/remote_signer.proto", fileDescriptor_7fad7c73fe08e2e9)
+}
+
+var fileDescriptor_7fad7c73fe08e2e9 = []byte{
+	// 323 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x92, 0x4d, 0x4b, 0xc3, 0x40,
+	0x10, 0x86, 0x13, 0x3f, 0x2a, 0x1d, 0x3c, 0xc8, 0x5a, 0x68, 0x2d, 0x12, 0x24, 0xa7, 0x5e, 0x92,
+	0xf5, 0x03, 0xc1, 0xab, 0x82, 0x27, 0x2f, 0x12, 0x6f, 0x5e, 0x4a, 0x92, 0x8e, 0xe9, 0x62, 0x93,
+	0x5d, 0xf7, 0xa3, 0xd0, 0x7f, 0xe1, 0xcf, 0xf2, 0xd8, 0xa3, 0x47, 0x69, 0xcf, 0xfe, 0x07, 0xc9,
+	0x26, 0x62, 0xc5, 0xa8, 0xbd, 0x84, 0xec, 0xbb, 0xef, 0x33, 0xef, 0xec, 0x30, 0x30, 0xc8, 0xf9,
+	0xc8, 0x4c, 0x62, 0x49, 0x15, 0xcb, 0x0a, 0x94, 0x54, 0xcf, 0x04, 0x2a, 0x2a, 0x31, 0xe7, 0x1a,
+	0x87, 0x95, 0x16, 0x0a, 0xc9, 0x35, 0x27, 0x9d, 0xda, 0x19, 0xd6, 0xaa, 0x75, 0xfa, 0x27, 0x70,
+	0x10, 0x59, 0xf3, 0x9d, 0x55, 0x6f, 0x4d, 0x72, 0x83, 0xb3, 0x08, 0x9f, 0x0c, 0x2a, 0x4d, 0x3a,
+	0xb0, 0xad, 0x52, 0x2e, 0xb0, 0xe7, 0x1e, 0xb9, 0x83, 0x76, 0x54, 0x1d, 0xfc, 0x73, 0xe8, 0x37,
+	0x21, 0x4a, 0xf0, 0x42, 0x21, 0xe9, 0xc2, 0x8e, 0x30, 0xc9, 0xf0, 0x11, 0x67, 0x96, 0xda, 0x8d,
+	0x5a, 0xc2, 0x1a, 0xfc, 0x4b, 0xe8, 0xae, 0x62, 0xe5, 0xf7, 0xcf, 0x1c, 0xb2, 0x07, 0x9b, 0xb9,
+	0xca, 0x7a, 0x1b, 0xb6, 0x4a, 0xf9, 0xeb, 0x5f, 0x40, 0xef, 0x67, 0x89, 0x3a, 0xf7, 0x10, 0xda,
+	0xe5, 0xc3, 0x62, 0x6d, 0x24, 0xd6, 0xc9, 0x5f, 0xc2, 0xe9, 0xbb, 0x0b, 0xfb, 0xdf, 0x50, 0x94,
+	0x53, 0x96, 0x22, 0xc9, 0xa1, 0x55, 0xf5, 0x4f, 0x68, 0xd8, 0x34, 0x9f, 0xf0, 0xd7, 0xe1, 0xf4,
+	0x8f, 0xd7, 0x07, 0xaa, 0x16, 0x7d, 0x87, 0x64, 0xb0, 0x55, 0xde, 0x90, 0xe0, 0x7f, 0x76, 0x65,
+	0x3e, 0xfd, 0x70, 0x5d, 0xfb, 0x67, 0xd0, 0xd5, 0xf0, 0x65, 0xe1, 0xb9, 0xf3, 0x85, 0xe7, 0xbe,
+	0x2d, 0x3c, 0xf7, 0x79, 0xe9, 0x39, 0xf3, 0xa5, 0xe7, 0xbc, 0x2e, 0x3d, 0xe7, 0xfe, 0x3a, 0x63,
+	0x7a, 0x6c, 0x92, 0x30, 0xe5, 0x39, 0x4d, 0x8a, 0x24, 0x48, 0xc7, 0x31, 0x2b, 0x68, 0x26, 0x11,
+	0x8b, 0x07, 0x86, 0x93, 0x51, 0xa0, 0x34, 0x97, 0x71, 0x86, 0x81, 0x90, 0x7c, 0xca, 0x46, 0x28,
+	0x69, 0xd3, 0x86, 0x25, 0x2d, 0xbb, 0x54, 0x67, 0x1f, 0x03, 0x00, 0x27, 0x1e, 0x10, 0xc5, 0x80,
+	0x02, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConn
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion4
+
+// RemoteSignerServiceClient is the client API for RemoteSignerService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type RemoteSignerServiceClient interface {
+	// PubKey returns the public key of the sign type.
+	PubKey(ctx context.Context, in *RemoteSignerPubKeyRequest, opts ...grpc.CallOption) (*RemoteSignerPubKeyResponse, error)
+	// Sign returns the signature of the msg signed by the key of the sign type.
+	Sign(ctx context.Context, in *RemoteSignerSignRequest, opts ...grpc.CallOption) (*RemoteSignerSignResponse, error)
+}
+
+type remoteSignerServiceClient struct {
+	cc grpc1.ClientConn
+}
+
+func NewRemoteSignerServiceClient(cc grpc1.ClientConn) RemoteSignerServiceClient {
+	return &remoteSignerServiceClient{cc}
+}
+
+func (c *remoteSignerServiceClient) PubKey(ctx context.Context, in *RemoteSignerPubKeyRequest, opts ...grpc.CallOption) (*RemoteSignerPubKeyResponse, error) {
+	out := new(RemoteSignerPubKeyResponse)
+	err := c.cc.Invoke(ctx, "/modular.signer.types.RemoteSignerService/PubKey", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *remoteSignerServiceClient) Sign(ctx context.Context, in *RemoteSignerSignRequest, opts ...grpc.CallOption) (*RemoteSignerSignResponse, error) {
+	out := new(RemoteSignerSignResponse)
+	err := c.cc.Invoke(ctx, "/modular.signer.types.RemoteSignerService/Sign", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// RemoteSignerServiceServer is the server API for RemoteSignerService service.
+type RemoteSignerServiceServer interface {
+	// PubKey returns the public key of the sign type.
+	PubKey(context.Context, *RemoteSignerPubKeyRequest) (*RemoteSignerPubKeyResponse, error)
+	// Sign returns the signature of the msg signed by the key of the sign type.
+	Sign(context.Context, *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error)
+}
+
+// UnimplementedRemoteSignerServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedRemoteSignerServiceServer struct {
+}
+
+func (*UnimplementedRemoteSignerServiceServer) PubKey(ctx context.Context, req *RemoteSignerPubKeyRequest) (*RemoteSignerPubKeyResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method PubKey not implemented")
+}
+func (*UnimplementedRemoteSignerServiceServer) Sign(ctx context.Context, req *RemoteSignerSignRequest) (*RemoteSignerSignResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sign not implemented")
+}
+
+func RegisterRemoteSignerServiceServer(s grpc1.Server, srv RemoteSignerServiceServer) {
+	s.RegisterService(&_RemoteSignerService_serviceDesc, srv)
+}
+
+func _RemoteSignerService_PubKey_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerPubKeyRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServiceServer).PubKey(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modular.signer.types.RemoteSignerService/PubKey",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServiceServer).PubKey(ctx, req.(*RemoteSignerPubKeyRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _RemoteSignerService_Sign_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoteSignerSignRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(RemoteSignerServiceServer).Sign(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/modular.signer.types.RemoteSignerService/Sign",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(RemoteSignerServiceServer).Sign(ctx, req.(*RemoteSignerSignRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _RemoteSignerService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "modular.signer.types.RemoteSignerService",
+	HandlerType: (*RemoteSignerServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "PubKey",
+			Handler:    _RemoteSignerService_PubKey_Handler,
+		},
+		{
+			MethodName: "Sign",
+			Handler:    _RemoteSignerService_Sign_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "modular/signer/types/remote_signer.proto",
+}
+
+func (m *RemoteSignerPubKeyRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerPubKeyRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerPubKeyRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignerPubKeyResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerPubKeyResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerPubKeyResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.PubKey) > 0 {
+		i -= len(m.PubKey)
+		copy(dAtA[i:], m.PubKey)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.PubKey)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignerSignRequest) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerSignRequest) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerSignRequest) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Msg) > 0 {
+		i -= len(m.Msg)
+		copy(dAtA[i:], m.Msg)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Msg)))
+		i--
+		dAtA[i] = 0x12
+	}
+	if len(m.Scope) > 0 {
+		i -= len(m.Scope)
+		copy(dAtA[i:], m.Scope)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Scope)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func (m *RemoteSignerSignResponse) Marshal() (dAtA []byte, err error) {
+	size := m.Size()
+	dAtA = make([]byte, size)
+	n, err := m.MarshalToSizedBuffer(dAtA[:size])
+	if err != nil {
+		return nil, err
+	}
+	return dAtA[:n], nil
+}
+
+func (m *RemoteSignerSignResponse) MarshalTo(dAtA []byte) (int, error) {
+	size := m.Size()
+	return m.MarshalToSizedBuffer(dAtA[:size])
+}
+
+func (m *RemoteSignerSignResponse) MarshalToSizedBuffer(dAtA []byte) (int, error) {
+	i := len(dAtA)
+	_ = i
+	var l int
+	_ = l
+	if len(m.Signature) > 0 {
+		i -= len(m.Signature)
+		copy(dAtA[i:], m.Signature)
+		i = encodeVarintRemoteSigner(dAtA, i, uint64(len(m.Signature)))
+		i--
+		dAtA[i] = 0xa
+	}
+	return len(dAtA) - i, nil
+}
+
+func encodeVarintRemoteSigner(dAtA []byte, offset int, v uint64) int {
+	offset -= sovRemoteSigner(v)
+	base := offset
+	for v >= 1<<7 {
+		dAtA[offset] = uint8(v&0x7f | 0x80)
+		v >>= 7
+		offset++
+	}
+	dAtA[offset] = uint8(v)
+	return base
+}
+func (m *RemoteSignerPubKeyRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignerPubKeyResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.PubKey)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignerSignRequest) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Scope)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	l = len(m.Msg)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func (m *RemoteSignerSignResponse) Size() (n int) {
+	if m == nil {
+		return 0
+	}
+	var l int
+	_ = l
+	l = len(m.Signature)
+	if l > 0 {
+		n += 1 + l + sovRemoteSigner(uint64(l))
+	}
+	return n
+}
+
+func sovRemoteSigner(x uint64) (n int) {
+	return (math_bits.Len64(x|1) + 6) / 7
+}
+func sozRemoteSigner(x uint64) (n int) {
+	return sovRemoteSigner(uint64((x << 1) ^ uint64((int64(x) >> 63))))
+}
+func (m *RemoteSignerPubKeyRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerPubKeyRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerPubKeyRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignerPubKeyResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerPubKeyResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerPubKeyResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field PubKey", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.PubKey = append(m.PubKey[:0], dAtA[iNdEx:postIndex]...)
+			if m.PubKey == nil {
+				m.PubKey = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignerSignRequest) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerSignRequest: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerSignRequest: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Scope", wireType)
+			}
+			var stringLen uint64
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				stringLen |= uint64(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			intStringLen := int(stringLen)
+			if intStringLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + intStringLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Scope = string(dAtA[iNdEx:postIndex])
+			iNdEx = postIndex
+		case 2:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Msg", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Msg = append(m.Msg[:0], dAtA[iNdEx:postIndex]...)
+			if m.Msg == nil {
+				m.Msg = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func (m *RemoteSignerSignResponse) Unmarshal(dAtA []byte) error {
+	l := len(dAtA)
+	iNdEx := 0
+	for iNdEx < l {
+		preIndex := iNdEx
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= uint64(b&0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		fieldNum := int32(wire >> 3)
+		wireType := int(wire & 0x7)
+		if wireType == 4 {
+			return fmt.Errorf("proto: RemoteSignerSignResponse: wiretype end group for non-group")
+		}
+		if fieldNum <= 0 {
+			return fmt.Errorf("proto: RemoteSignerSignResponse: illegal tag %d (wire type %d)", fieldNum, wire)
+		}
+		switch fieldNum {
+		case 1:
+			if wireType != 2 {
+				return fmt.Errorf("proto: wrong wireType = %d for field Signature", wireType)
+			}
+			var byteLen int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				byteLen |= int(b&0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if byteLen < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			postIndex := iNdEx + byteLen
+			if postIndex < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if postIndex > l {
+				return io.ErrUnexpectedEOF
+			}
+			m.Signature = append(m.Signature[:0], dAtA[iNdEx:postIndex]...)
+			if m.Signature == nil {
+				m.Signature = []byte{}
+			}
+			iNdEx = postIndex
+		default:
+			iNdEx = preIndex
+			skippy, err := skipRemoteSigner(dAtA[iNdEx:])
+			if err != nil {
+				return err
+			}
+			if (skippy < 0) || (iNdEx+skippy) < 0 {
+				return ErrInvalidLengthRemoteSigner
+			}
+			if (iNdEx + skippy) > l {
+				return io.ErrUnexpectedEOF
+			}
+			iNdEx += skippy
+		}
+	}
+
+	if iNdEx > l {
+		return io.ErrUnexpectedEOF
+	}
+	return nil
+}
+func skipRemoteSigner(dAtA []byte) (n int, err error) {
+	l := len(dAtA)
+	iNdEx := 0
+	depth := 0
+	for iNdEx < l {
+		var wire uint64
+		for shift := uint(0); ; shift += 7 {
+			if shift >= 64 {
+				return 0, ErrIntOverflowRemoteSigner
+			}
+			if iNdEx >= l {
+				return 0, io.ErrUnexpectedEOF
+			}
+			b := dAtA[iNdEx]
+			iNdEx++
+			wire |= (uint64(b) & 0x7F) << shift
+			if b < 0x80 {
+				break
+			}
+		}
+		wireType := int(wire & 0x7)
+		switch wireType {
+		case 0:
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				iNdEx++
+				if dAtA[iNdEx-1] < 0x80 {
+					break
+				}
+			}
+		case 1:
+			iNdEx += 8
+		case 2:
+			var length int
+			for shift := uint(0); ; shift += 7 {
+				if shift >= 64 {
+					return 0, ErrIntOverflowRemoteSigner
+				}
+				if iNdEx >= l {
+					return 0, io.ErrUnexpectedEOF
+				}
+				b := dAtA[iNdEx]
+				iNdEx++
+				length |= (int(b) & 0x7F) << shift
+				if b < 0x80 {
+					break
+				}
+			}
+			if length < 0 {
+				return 0, ErrInvalidLengthRemoteSigner
+			}
+			iNdEx += length
+		case 3:
+			depth++
+		case 4:
+			if depth == 0 {
+				return 0, ErrUnexpectedEndOfGroupRemoteSigner
+			}
+			depth--
+		case 5:
+			iNdEx += 4
+		default:
+			return 0, fmt.Errorf("proto: illegal wireType %d", wireType)
+		}
+		if iNdEx < 0 {
+			return 0, ErrInvalidLengthRemoteSigner
+		}
+		if depth == 0 {
+			return iNdEx, nil
+		}
+	}
+	return 0, io.ErrUnexpectedEOF
+}
+
+var (
+	ErrInvalidLengthRemoteSigner        = fmt.Errorf("proto: negative length found during unmarshaling")
+	ErrIntOverflowRemoteSigner          = fmt.Errorf("proto: integer overflow")
+	ErrUnexpectedEndOfGroupRemoteSigner = fmt.Errorf("proto: unexpected end of group")
+)
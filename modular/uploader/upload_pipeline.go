@@ -0,0 +1,222 @@
+package uploader
+
+import (
+	"context"
+	"errors"
+	"io"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+// segmentPiece is a segment read from the upload stream and waiting to be hashed and put to the piece store.
+type segmentPiece struct {
+	index uint32
+	data  []byte
+	buf   []byte
+}
+
+// uploadPipeline overlaps reading the upload stream with hashing and putting the segment pieces. The stream
+// is read by the caller goroutine, the segments are hashed and put by the workers, and the number of in-flight
+// segments is bounded by the number of buffers which are accounted in the uploader resource scope.
+type uploadPipeline struct {
+	u           *UploadModular
+	objectInfo  *storagetypes.ObjectInfo
+	segmentSize int64
+	parallel    int
+	createTime  int64
+
+	ctx    context.Context
+	cancel context.CancelFunc
+	span   rcmgr.ResourceScopeSpan
+	// allocated is only accessed by the reader goroutine
+	allocated int
+	free      chan []byte
+	segments  chan *segmentPiece
+
+	mu        sync.Mutex
+	err       error
+	checksums map[uint32][]byte
+	written   []string
+}
+
+func newUploadPipeline(ctx context.Context, u *UploadModular, objectInfo *storagetypes.ObjectInfo, segmentSize int64,
+	createTime int64) *uploadPipeline {
+	parallel := int(atomic.LoadInt64(&u.uploadPieceParallel))
+	if parallel <= 0 {
+		parallel = 1
+	}
+	p := &uploadPipeline{
+		u:           u,
+		objectInfo:  objectInfo,
+		segmentSize: segmentSize,
+		parallel:    parallel,
+		createTime:  createTime,
+		free:        make(chan []byte, parallel),
+		segments:    make(chan *segmentPiece),
+		checksums:   make(map[uint32][]byte),
+	}
+	p.ctx, p.cancel = context.WithCancel(ctx)
+	return p
+}
+
+// run reads the stream to the end and returns the checksums of the segment pieces in order and the read size.
+// The segment pieces that had been put are deleted if the pipeline fails.
+func (p *uploadPipeline) run(stream io.Reader) ([][]byte, int, error) {
+	defer p.cancel()
+	if p.u.scope != nil {
+		span, err := p.u.scope.BeginSpan()
+		if err != nil {
+			log.CtxErrorw(p.ctx, "failed to begin upload pipeline resource span", "error", err)
+			return nil, 0, ErrExceedUploadResource
+		}
+		p.span = span
+		defer p.span.Done()
+	}
+
+	var wg sync.WaitGroup
+	for i := 0; i < p.parallel; i++ {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			p.putSegments()
+		}()
+	}
+	readSize := p.readSegments(stream)
+	close(p.segments)
+	wg.Wait()
+
+	if err := p.failure(); err != nil {
+		p.cleanup()
+		return nil, readSize, err
+	}
+	checksums := make([][]byte, len(p.checksums))
+	for idx, checksum := range p.checksums {
+		checksums[idx] = checksum
+	}
+	return checksums, readSize, nil
+}
+
+func (p *uploadPipeline) readSegments(stream io.Reader) int {
+	var readSize int
+	for segIdx := uint32(0); ; segIdx++ {
+		buf, err := p.acquire()
+		if err != nil {
+			p.fail(err)
+			return readSize
+		}
+		startReadFromGateway := time.Now()
+		readN, err := StreamReadAt(stream, buf)
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_cost").Observe(time.Since(startReadFromGateway).Seconds())
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_end").Observe(time.Since(time.Unix(p.createTime, 0)).Seconds())
+		readSize += readN
+		if err != nil && !errors.Is(err, io.EOF) {
+			log.CtxErrorw(p.ctx, "stream closed abnormally", "segment_index", segIdx, "error", err)
+			p.fail(ErrClosedStream)
+			return readSize
+		}
+		if readN == 0 {
+			p.free <- buf
+			return readSize
+		}
+		select {
+		case p.segments <- &segmentPiece{index: segIdx, data: buf[:readN], buf: buf}:
+		case <-p.ctx.Done():
+			return readSize
+		}
+		if err != nil { // io.EOF, the last segment piece
+			return readSize
+		}
+	}
+}
+
+// acquire returns a free segment buffer, a new buffer is allocated if the memory can be reserved and the
+// number of in-flight segments does not reach the parallel, otherwise it waits for an in-flight segment.
+func (p *uploadPipeline) acquire() ([]byte, error) {
+	select {
+	case buf := <-p.free:
+		return buf, nil
+	default:
+	}
+	if p.allocated < p.parallel {
+		// the memory of the first segment had been estimated by the task limit
+		prio := rcmgr.ReservationPriorityMedium
+		if p.allocated == 0 {
+			prio = rcmgr.ReservationPriorityAlways
+		}
+		if p.span == nil || p.span.ReserveMemory(p.segmentSize, prio) == nil {
+			p.allocated++
+			return make([]byte, p.segmentSize), nil
+		}
+		if p.allocated == 0 {
+			log.CtxErrorw(p.ctx, "failed to reserve memory for segment piece", "segment_size", p.segmentSize)
+			return nil, ErrExceedUploadResource
+		}
+	}
+	select {
+	case buf := <-p.free:
+		return buf, nil
+	case <-p.ctx.Done():
+		return nil, p.ctx.Err()
+	}
+}
+
+func (p *uploadPipeline) putSegments() {
+	for seg := range p.segments {
+		if p.ctx.Err() != nil {
+			continue
+		}
+		checksum := hash.GenerateChecksum(seg.data)
+		pieceKey := p.u.baseApp.PieceOp().SegmentPieceKey(p.objectInfo.Id.Uint64(), seg.index, p.objectInfo.GetVersion())
+		startPutPiece := time.Now()
+		err := p.u.baseApp.PieceStore().PutPiece(p.ctx, pieceKey, seg.data)
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutPiece).Seconds())
+		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(time.Unix(p.createTime, 0)).Seconds())
+		p.free <- seg.buf
+		if err != nil {
+			log.CtxErrorw(p.ctx, "failed to put segment piece to piece store", "piece_key", pieceKey, "error", err)
+			p.fail(ErrPieceStoreWithDetail("failed to put segment piece to piece store, piece_key: " + pieceKey + ", error: " + err.Error()))
+			continue
+		}
+		p.mu.Lock()
+		p.checksums[seg.index] = checksum
+		p.written = append(p.written, pieceKey)
+		p.mu.Unlock()
+	}
+}
+
+// fail records the first error of the pipeline and stops the pipeline.
+func (p *uploadPipeline) fail(err error) {
+	p.mu.Lock()
+	if p.err == nil {
+		p.err = err
+	}
+	p.mu.Unlock()
+	p.cancel()
+}
+
+func (p *uploadPipeline) failure() error {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.err != nil {
+		return p.err
+	}
+	return p.ctx.Err()
+}
+
+// cleanup deletes the segment pieces that had been put, the context of the pipeline may had been canceled.
+func (p *uploadPipeline) cleanup() {
+	ctx := tracing.DetachedContext(p.ctx)
+	for _, pieceKey := range p.written {
+		if err := p.u.baseApp.PieceStore().DeletePiece(ctx, pieceKey); err != nil {
+			log.CtxErrorw(ctx, "failed to delete partial segment piece", "piece_key", pieceKey, "error", err)
+		}
+	}
+}
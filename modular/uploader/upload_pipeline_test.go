@@ -0,0 +1,134 @@
+package uploader
+
+import (
+	"bytes"
+	"context"
+	"fmt"
+	"sync"
+	"testing"
+	"time"
+
+	sdkmath "cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.uber.org/mock/gomock"
+
+	"github.com/bnb-chain/greenfield-common/go/hash"
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupPipeline(t *testing.T, parallel int) (*UploadModular, *piecestore.MockPieceStore) {
+	u := setup(t)
+	u.uploadPieceParallel = int64(parallel)
+	ctrl := gomock.NewController(t)
+	m1 := piecestore.NewMockPieceOp(ctrl)
+	u.baseApp.SetPieceOp(m1)
+	m1.EXPECT().SegmentPieceKey(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(objectID uint64, segmentIdx uint32, version int64) string {
+			return fmt.Sprintf("s%d_s%d", objectID, segmentIdx)
+		}).AnyTimes()
+	m2 := piecestore.NewMockPieceStore(ctrl)
+	u.baseApp.SetPieceStore(m2)
+	return u, m2
+}
+
+func TestUploadPipeline_OrderedChecksums(t *testing.T) {
+	u, m := setupPipeline(t, 4)
+	payload := []byte("0123456789")
+	// the earlier segments are put slower to complete the segments out of order
+	m.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value []byte) error {
+			time.Sleep(time.Duration(10-value[0]+'0') * time.Millisecond)
+			return nil
+		}).Times(4)
+
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)}
+	checksums, readSize, err := newUploadPipeline(context.Background(), u, objectInfo, 3, 0).run(bytes.NewReader(payload))
+	assert.Nil(t, err)
+	assert.Equal(t, len(payload), readSize)
+	expected := [][]byte{hash.GenerateChecksum(payload[0:3]), hash.GenerateChecksum(payload[3:6]),
+		hash.GenerateChecksum(payload[6:9]), hash.GenerateChecksum(payload[9:])}
+	assert.Equal(t, expected, checksums)
+}
+
+func TestUploadPipeline_CleanupOnFailure(t *testing.T) {
+	u, m := setupPipeline(t, 2)
+	var (
+		mu      sync.Mutex
+		written = make(map[string]bool)
+		deleted = make(map[string]bool)
+	)
+	m.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value []byte) error {
+			if key == "s1_s2" {
+				return mockErr
+			}
+			mu.Lock()
+			written[key] = true
+			mu.Unlock()
+			return nil
+		}).AnyTimes()
+	m.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string) error {
+			assert.Nil(t, ctx.Err())
+			deleted[key] = true
+			return nil
+		}).AnyTimes()
+
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)}
+	_, _, err := newUploadPipeline(context.Background(), u, objectInfo, 1, 0).run(bytes.NewReader(make([]byte, 64)))
+	assert.Contains(t, err.Error(), mockErr.Error())
+	assert.NotEmpty(t, written)
+	assert.Equal(t, written, deleted)
+}
+
+func TestUploadPipeline_Canceled(t *testing.T) {
+	u, m := setupPipeline(t, 2)
+	ctx, cancel := context.WithCancel(context.Background())
+	m.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value []byte) error {
+			cancel()
+			return nil
+		}).AnyTimes()
+	m.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).Return(nil).MinTimes(1)
+
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)}
+	_, _, err := newUploadPipeline(ctx, u, objectInfo, 1, 0).run(bytes.NewReader(make([]byte, 64)))
+	assert.Equal(t, context.Canceled, err)
+}
+
+func TestUploadPipeline_ReserveMemory(t *testing.T) {
+	u, m := setupPipeline(t, 4)
+	ctrl := gomock.NewController(t)
+	scope := rcmgr.NewMockResourceScope(ctrl)
+	span := rcmgr.NewMockResourceScopeSpan(ctrl)
+	u.scope = scope
+	scope.EXPECT().BeginSpan().Return(span, nil).Times(1)
+	// only the first segment buffer can be reserved, the pipeline degrades to one in-flight segment
+	span.EXPECT().ReserveMemory(int64(2), rcmgr.ReservationPriorityAlways).Return(nil).Times(1)
+	span.EXPECT().ReserveMemory(int64(2), rcmgr.ReservationPriorityMedium).Return(mockErr).AnyTimes()
+	span.EXPECT().Done().Times(1)
+	m.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).Return(nil).Times(3)
+
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)}
+	checksums, readSize, err := newUploadPipeline(context.Background(), u, objectInfo, 2, 0).run(bytes.NewReader(make([]byte, 5)))
+	assert.Nil(t, err)
+	assert.Equal(t, 5, readSize)
+	assert.Equal(t, 3, len(checksums))
+}
+
+func TestUploadPipeline_ReserveMemoryFailure(t *testing.T) {
+	u, _ := setupPipeline(t, 4)
+	ctrl := gomock.NewController(t)
+	scope := rcmgr.NewMockResourceScope(ctrl)
+	span := rcmgr.NewMockResourceScopeSpan(ctrl)
+	u.scope = scope
+	scope.EXPECT().BeginSpan().Return(span, nil).Times(1)
+	span.EXPECT().ReserveMemory(gomock.Any(), gomock.Any()).Return(mockErr).Times(1)
+	span.EXPECT().Done().Times(1)
+
+	objectInfo := &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1)}
+	_, _, err := newUploadPipeline(context.Background(), u, objectInfo, 2, 0).run(bytes.NewReader(make([]byte, 5)))
+	assert.Equal(t, ErrExceedUploadResource, err)
+}
@@ -40,6 +40,7 @@ var (
 	ErrInvalidUploadRequest = gfsperrors.Register(module.UploadModularName, http.StatusConflict, 110006, "the object had already been fully uploaded and any further uploading attempt is not allowed")
 	ErrGetObjectUploadState = gfsperrors.Register(module.UploadModularName, http.StatusInternalServerError, 110007, "failed to get upload object state")
 	ErrPayloadSize          = gfsperrors.Register(module.UploadModularName, http.StatusBadRequest, 110008, "The file payload size is inconsistent with the parameter payload size")
+	ErrExceedUploadResource = gfsperrors.Register(module.UploadModularName, http.StatusServiceUnavailable, 110009, "upload resource exhausted, try again later")
 )
 
 func ErrPieceStoreWithDetail(detail string) *gfsperrors.GfSpError {
@@ -100,13 +101,9 @@ func (u *UploadModular) HandleUploadObjectTask(ctx context.Context, uploadObject
 		uploadObjectTask.GetStorageParams().GetMaxSegmentSize())
 	var (
 		err       error
-		segIdx    uint32 = 0
-		pieceKey  string
 		integrity []byte
 		checksums [][]byte
-		readN     int
 		readSize  int
-		data      = make([]byte, segmentSize)
 	)
 	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_begin_from_task_create").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
 	defer func() {
@@ -132,103 +129,69 @@ func (u *UploadModular) HandleUploadObjectTask(ctx context.Context, uploadObject
 			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_after_report_manager_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
 		}()
 	}()
-	startTime := time.Now()
-	for {
-		if err != nil {
-			return err
-		}
-		data = data[0:segmentSize]
-		startReadFromGateway := time.Now()
-		readN, err = StreamReadAt(stream, data)
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_cost").Observe(time.Since(startReadFromGateway).Seconds())
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_read_data_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-		readSize += readN
-		data = data[0:readN]
 
-		if err == io.EOF {
-			err = nil
-			if readN != 0 { // the last segment piece
-				pieceKey = u.baseApp.PieceOp().SegmentPieceKey(uploadObjectTask.GetObjectInfo().Id.Uint64(), segIdx, uploadObjectTask.GetObjectInfo().Version)
-				checksums = append(checksums, hash.GenerateChecksum(data))
-				startPutPiece := time.Now()
-				err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
-				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutPiece).Seconds())
-				metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(startTime).Seconds())
-				if err != nil {
-					log.CtxErrorw(ctx, "failed to put segment piece to piece store", "piece_key", pieceKey, "error", err)
-					return ErrPieceStoreWithDetail("failed to put segment piece to piece store, piece_key: " + pieceKey + ", error: " + err.Error())
-				}
-			}
-			integrity = hash.GenerateIntegrityHash(checksums)
-			if !uploadObjectTask.GetIsAgentUpload() {
-				expectedChecksum := uploadObjectTask.GetObjectInfo().GetChecksums()[0]
-				if !bytes.Equal(integrity, expectedChecksum) {
-					log.CtxErrorw(ctx, "failed to put object due to check integrity hash not consistent",
-						"object_info", uploadObjectTask.GetObjectInfo(), "actual_integrity", hex.EncodeToString(integrity),
-						"expected_integrity", hex.EncodeToString(expectedChecksum))
-					err = ErrInvalidIntegrity
-					return ErrInvalidIntegrity
-				}
-			}
-			if uint64(readSize) != uploadObjectTask.GetObjectInfo().GetPayloadSize() {
-				log.CtxErrorw(ctx, "readSize is not equal payloadSize", "objectID", uploadObjectTask.GetObjectInfo().Id.Uint64(), "readSize", readSize, "payloadSize", uploadObjectTask.GetObjectInfo().GetPayloadSize())
-				go u.rejectCreateObject(ctx, uploadObjectTask.GetObjectInfo())
-				return ErrPayloadSize
-			}
-			startUpdateSignature := time.Now()
-			if uploadObjectTask.GetObjectInfo().GetIsUpdating() {
-				integrityMeta := &corespdb.ShadowIntegrityMeta{
-					ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
-					RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
-					PieceChecksumList: checksums,
-					IntegrityChecksum: integrity,
-					Version:           uploadObjectTask.GetObjectInfo().GetVersion(),
-					ObjectSize:        uint64(readSize),
-				}
-				err = u.baseApp.GfSpDB().SetShadowObjectIntegrity(integrityMeta)
-			} else {
-				integrityMeta := &corespdb.IntegrityMeta{
-					ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
-					RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
-					PieceChecksumList: checksums,
-					IntegrityChecksum: integrity,
-					ObjectSize:        uint64(readSize),
-				}
-				err = u.baseApp.GfSpDB().SetObjectIntegrity(integrityMeta)
-			}
-			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_cost").Observe(time.Since(startUpdateSignature).Seconds())
-			metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-			if err != nil {
-				log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
-				return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
-			}
-			err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
-				ObjectID:  uploadObjectTask.GetObjectInfo().Id.Uint64(),
-				TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
-			})
-			if err != nil {
-				log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
-				return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
-			}
-			log.CtxDebugw(ctx, "succeed to upload payload to piece store")
-			return nil
+	// the pipeline deletes the written pieces if the stream is aborted, e.g. the gateway aborts the stream
+	// before the end if the payload mismatches the signed hash, so nothing is sealed for a rejected payload
+	pipeline := newUploadPipeline(ctx, u, uploadObjectTask.GetObjectInfo(), segmentSize, uploadObjectTask.GetCreateTime())
+	checksums, readSize, err = pipeline.run(stream)
+	if err != nil {
+		return err
+	}
+	integrity = hash.GenerateIntegrityHash(checksums)
+	if !uploadObjectTask.GetIsAgentUpload() {
+		expectedChecksum := uploadObjectTask.GetObjectInfo().GetChecksums()[0]
+		if !bytes.Equal(integrity, expectedChecksum) {
+			log.CtxErrorw(ctx, "failed to put object due to check integrity hash not consistent",
+				"object_info", uploadObjectTask.GetObjectInfo(), "actual_integrity", hex.EncodeToString(integrity),
+				"expected_integrity", hex.EncodeToString(expectedChecksum))
+			pipeline.cleanup()
+			err = ErrInvalidIntegrity
+			return ErrInvalidIntegrity
 		}
-		if err != nil {
-			log.CtxErrorw(ctx, "stream closed abnormally", "piece_key", pieceKey, "error", err)
-			return ErrClosedStream
+	}
+	if uint64(readSize) != uploadObjectTask.GetObjectInfo().GetPayloadSize() {
+		log.CtxErrorw(ctx, "readSize is not equal payloadSize", "objectID", uploadObjectTask.GetObjectInfo().Id.Uint64(), "readSize", readSize, "payloadSize", uploadObjectTask.GetObjectInfo().GetPayloadSize())
+		pipeline.cleanup()
+		go u.rejectCreateObject(ctx, uploadObjectTask.GetObjectInfo())
+		return ErrPayloadSize
+	}
+	startUpdateSignature := time.Now()
+	if uploadObjectTask.GetObjectInfo().GetIsUpdating() {
+		integrityMeta := &corespdb.ShadowIntegrityMeta{
+			ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
+			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
+			PieceChecksumList: checksums,
+			IntegrityChecksum: integrity,
+			Version:           uploadObjectTask.GetObjectInfo().GetVersion(),
+			ObjectSize:        uint64(readSize),
 		}
-		checksums = append(checksums, hash.GenerateChecksum(data))
-		startPutPiece := time.Now()
-		pieceKey = u.baseApp.PieceOp().SegmentPieceKey(uploadObjectTask.GetObjectInfo().Id.Uint64(), segIdx, uploadObjectTask.GetObjectInfo().Version)
-		err = u.baseApp.PieceStore().PutPiece(ctx, pieceKey, data)
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_cost").Observe(time.Since(startPutPiece).Seconds())
-		metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_server_put_piece_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
-		if err != nil {
-			log.CtxErrorw(ctx, "failed to put segment piece to piece store", "error", err)
-			return ErrPieceStoreWithDetail("failed to put segment piece to piece store, error: " + err.Error())
+		err = u.baseApp.GfSpDBWithContext(ctx).SetShadowObjectIntegrity(integrityMeta)
+	} else {
+		integrityMeta := &corespdb.IntegrityMeta{
+			ObjectID:          uploadObjectTask.GetObjectInfo().Id.Uint64(),
+			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
+			PieceChecksumList: checksums,
+			IntegrityChecksum: integrity,
+			ObjectSize:        uint64(readSize),
 		}
-		segIdx++
+		err = u.baseApp.GfSpDBWithContext(ctx).SetObjectIntegrity(integrityMeta)
+	}
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_cost").Observe(time.Since(startUpdateSignature).Seconds())
+	metrics.PerfPutObjectTime.WithLabelValues("uploader_put_object_set_integrity_end").Observe(time.Since(time.Unix(uploadObjectTask.GetCreateTime(), 0)).Seconds())
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
+		return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
 	}
+	err = u.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&corespdb.UploadObjectMeta{
+		ObjectID:  uploadObjectTask.GetObjectInfo().Id.Uint64(),
+		TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
+	})
+	if err != nil {
+		log.CtxErrorw(ctx, "failed to update upload progress", "error", err)
+		return ErrGfSpDBWithDetail("failed to update upload progress, error: " + err.Error())
+	}
+	log.CtxDebugw(ctx, "succeed to upload payload to piece store")
+	return nil
 }
 
 func (u *UploadModular) PostUploadObject(ctx context.Context, uploadObjectTask coretask.UploadObjectTask) {
@@ -332,7 +295,7 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 					return ErrPieceStoreWithDetail(fmt.Sprintf("failed to put segment piece to piece store, piece_key: %s, error: %s",
 						pieceKey, err.Error()))
 				}
-				err = u.updatePieceCheckSum(task, data, isUpdate, uint64(readN))
+				err = u.updatePieceCheckSum(ctx, task, data, isUpdate, uint64(readN))
 				if err != nil {
 					log.CtxErrorw(ctx, "failed to append integrity checksum to db", "error", err)
 					return ErrGfSpDBWithDetail("failed to append integrity checksum to db, error: " + err.Error())
@@ -340,7 +303,7 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 			}
 			if task.GetCompleted() {
 				var pieceChecksumList [][]byte
-				pieceChecksumList, pieceSize, err = u.getPieceCheckSumListAndPieceSize(task, isUpdate)
+				pieceChecksumList, pieceSize, err = u.getPieceCheckSumListAndPieceSize(ctx, task, isUpdate)
 				if err != nil {
 					log.CtxErrorw(ctx, "failed to get object integrity hash", "error", err)
 					return err
@@ -356,12 +319,12 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 					err = ErrInvalidIntegrity
 					return ErrInvalidIntegrity
 				}
-				err = u.updateIntegrityChecksum(task.GetObjectInfo().Id.Uint64(), integrityHash, isUpdate)
+				err = u.updateIntegrityChecksum(ctx, task.GetObjectInfo().Id.Uint64(), integrityHash, isUpdate)
 				if err != nil {
 					log.CtxErrorw(ctx, "failed to write integrity hash to db", "error", err)
 					return ErrGfSpDBWithDetail("failed to write integrity hash to db, error: " + err.Error())
 				}
-				err = u.baseApp.GfSpDB().UpdateUploadProgress(&corespdb.UploadObjectMeta{
+				err = u.baseApp.GfSpDBWithContext(ctx).UpdateUploadProgress(&corespdb.UploadObjectMeta{
 					ObjectID:  task.GetObjectInfo().Id.Uint64(),
 					TaskState: types.TaskState_TASK_STATE_UPLOAD_OBJECT_DONE,
 				})
@@ -384,7 +347,7 @@ func (u *UploadModular) HandleResumableUploadObjectTask(ctx context.Context, tas
 			log.CtxErrorw(ctx, "failed to put segment piece to piece store", "error", err)
 			return ErrPieceStoreWithDetail("failed to put segment piece to piece store, error: " + err.Error())
 		}
-		err = u.updatePieceCheckSum(task, data, isUpdate, uint64(readN))
+		err = u.updatePieceCheckSum(ctx, task, data, isUpdate, uint64(readN))
 		if err != nil {
 			log.CtxErrorw(ctx, "failed to append integrity checksum to db", "error", err)
 			return ErrGfSpDBWithDetail("failed to append integrity checksum to db, error: " + err.Error())
@@ -416,32 +379,32 @@ func StreamReadAt(stream io.Reader, b []byte) (int, error) {
 	}
 }
 
-func (u *UploadModular) updatePieceCheckSum(task coretask.ResumableUploadObjectTask, data []byte, isUpdate bool, dataLength uint64) error {
+func (u *UploadModular) updatePieceCheckSum(ctx context.Context, task coretask.ResumableUploadObjectTask, data []byte, isUpdate bool, dataLength uint64) error {
 	var err error
 	if isUpdate {
-		err = u.baseApp.GfSpDB().UpdateShadowPieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), task.GetObjectInfo().GetVersion(), dataLength)
+		err = u.baseApp.GfSpDBWithContext(ctx).UpdateShadowPieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), task.GetObjectInfo().GetVersion(), dataLength)
 	} else {
-		err = u.baseApp.GfSpDB().UpdatePieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), dataLength)
+		err = u.baseApp.GfSpDBWithContext(ctx).UpdatePieceChecksum(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex, hash.GenerateChecksum(data), dataLength)
 	}
 	return err
 }
 
-func (u *UploadModular) getPieceCheckSumListAndPieceSize(task coretask.ResumableUploadObjectTask, isUpdate bool) ([][]byte, uint64, error) {
+func (u *UploadModular) getPieceCheckSumListAndPieceSize(ctx context.Context, task coretask.ResumableUploadObjectTask, isUpdate bool) ([][]byte, uint64, error) {
 	if isUpdate {
-		shadowIntegrityMeta, err := u.baseApp.GfSpDB().GetShadowObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+		shadowIntegrityMeta, err := u.baseApp.GfSpDBWithContext(ctx).GetShadowObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 		if err != nil {
 			return nil, 0, err
 		}
 		return shadowIntegrityMeta.PieceChecksumList, shadowIntegrityMeta.ObjectSize, nil
 	}
-	integrityMeta, err := u.baseApp.GfSpDB().GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
+	integrityMeta, err := u.baseApp.GfSpDBWithContext(ctx).GetObjectIntegrity(task.GetObjectInfo().Id.Uint64(), piecestore.PrimarySPRedundancyIndex)
 	if err != nil {
 		return nil, 0, err
 	}
 	return integrityMeta.PieceChecksumList, integrityMeta.ObjectSize, nil
 }
 
-func (u *UploadModular) updateIntegrityChecksum(objectID uint64, integrityHash []byte, isUpdate bool) error {
+func (u *UploadModular) updateIntegrityChecksum(ctx context.Context, objectID uint64, integrityHash []byte, isUpdate bool) error {
 	var err error
 	if isUpdate {
 		shadowIntegrityMeta := &corespdb.ShadowIntegrityMeta{
@@ -449,14 +412,14 @@ func (u *UploadModular) updateIntegrityChecksum(objectID uint64, integrityHash [
 			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
 			IntegrityChecksum: integrityHash,
 		}
-		err = u.baseApp.GfSpDB().UpdateShadowIntegrityChecksum(shadowIntegrityMeta)
+		err = u.baseApp.GfSpDBWithContext(ctx).UpdateShadowIntegrityChecksum(shadowIntegrityMeta)
 	} else {
 		integrityMeta := &corespdb.IntegrityMeta{
 			ObjectID:          objectID,
 			RedundancyIndex:   piecestore.PrimarySPRedundancyIndex,
 			IntegrityChecksum: integrityHash,
 		}
-		err = u.baseApp.GfSpDB().UpdateIntegrityChecksum(integrityMeta)
+		err = u.baseApp.GfSpDBWithContext(ctx).UpdateIntegrityChecksum(integrityMeta)
 	}
 	return err
 }
@@ -16,6 +16,7 @@ type UploadModular struct {
 	scope                 rcmgr.ResourceScope
 	uploadQueue           taskqueue.TQueueOnStrategy
 	resumeableUploadQueue taskqueue.TQueueOnStrategy
+	uploadPieceParallel   int64
 }
 
 func (u *UploadModular) Name() string {
@@ -10,6 +10,9 @@ const (
 	// DefaultUploadObjectParallelPerNode defines the default max parallel of uploading
 	// object per uploader.
 	DefaultUploadObjectParallelPerNode = 10240
+	// DefaultUploadPieceParallelPerObject defines the default max number of in-flight segment
+	// pieces of uploading an object.
+	DefaultUploadPieceParallelPerObject = 4
 	// RejectUnSealObjectRetry defines the retry number of sending reject unseal object tx.
 	RejectUnSealObjectRetry = 3
 	// RejectUnSealObjectTimeout defines the timeout of sending reject unseal object tx.
@@ -28,6 +31,10 @@ func defaultUploaderOptions(uploader *UploadModular, cfg *gfspconfig.GfSpConfig)
 	if cfg.Parallel.UploadObjectParallelPerNode == 0 {
 		cfg.Parallel.UploadObjectParallelPerNode = DefaultUploadObjectParallelPerNode
 	}
+	if cfg.Parallel.UploadPieceParallelPerObject == 0 {
+		cfg.Parallel.UploadPieceParallelPerObject = DefaultUploadPieceParallelPerObject
+	}
+	uploader.uploadPieceParallel = int64(cfg.Parallel.UploadPieceParallelPerObject)
 	uploader.uploadQueue = cfg.Customize.NewStrategyTQueueFunc(
 		uploader.Name()+"-upload-object", cfg.Parallel.UploadObjectParallelPerNode)
 	uploader.resumeableUploadQueue = cfg.Customize.NewStrategyTQueueFunc(
@@ -0,0 +1,41 @@
+package uploader
+
+import (
+	"fmt"
+	"sync/atomic"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspapp"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+)
+
+var _ gfspapp.ReloadableModular = &UploadModular{}
+
+// ReloadableConfigs returns the configs that uploader can apply at runtime.
+func (u *UploadModular) ReloadableConfigs() []string {
+	return []string{
+		"Parallel.UploadObjectParallelPerNode",
+		"Parallel.UploadPieceParallelPerObject",
+	}
+}
+
+// ReloadConfig updates the capacities of upload queues and the piece parallel per object, the uploading
+// objects keep their parallel until finished.
+func (u *UploadModular) ReloadConfig(cfg *gfspconfig.GfSpConfig) (func(), error) {
+	if cfg.Parallel.UploadObjectParallelPerNode < 0 || cfg.Parallel.UploadPieceParallelPerObject < 0 {
+		return nil, fmt.Errorf("invalid upload object parallel %d or upload piece parallel %d",
+			cfg.Parallel.UploadObjectParallelPerNode, cfg.Parallel.UploadPieceParallelPerObject)
+	}
+	objectParallel := cfg.Parallel.UploadObjectParallelPerNode
+	if objectParallel == 0 {
+		objectParallel = DefaultUploadObjectParallelPerNode
+	}
+	pieceParallel := cfg.Parallel.UploadPieceParallelPerObject
+	if pieceParallel == 0 {
+		pieceParallel = DefaultUploadPieceParallelPerObject
+	}
+	return func() {
+		u.uploadQueue.SetCap(objectParallel)
+		u.resumeableUploadQueue.SetCap(objectParallel)
+		atomic.StoreInt64(&u.uploadPieceParallel, int64(pieceParallel))
+	}, nil
+}
@@ -0,0 +1,30 @@
+package uploader
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfspconfig"
+	"github.com/bnb-chain/greenfield-storage-provider/base/gfsptqueue"
+)
+
+func TestUploadModular_ReloadConfig(t *testing.T) {
+	u := setup(t)
+	u.uploadQueue = gfsptqueue.NewGfSpTQueue("mock-upload", 1)
+	u.resumeableUploadQueue = gfsptqueue.NewGfSpTQueue("mock-resumable-upload", 1)
+
+	cfg := &gfspconfig.GfSpConfig{}
+	cfg.Parallel.UploadObjectParallelPerNode = 10
+	apply, err := u.ReloadConfig(cfg)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, u.uploadQueue.Cap())
+	apply()
+	assert.Equal(t, 10, u.uploadQueue.Cap())
+	assert.Equal(t, 10, u.resumeableUploadQueue.Cap())
+	assert.Equal(t, int64(DefaultUploadPieceParallelPerObject), u.uploadPieceParallel)
+
+	cfg.Parallel.UploadPieceParallelPerObject = -1
+	_, err = u.ReloadConfig(cfg)
+	assert.NotNil(t, err)
+}
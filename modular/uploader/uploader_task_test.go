@@ -1,11 +1,15 @@
 package uploader
 
 import (
+	"bytes"
 	"context"
 	"errors"
+	"fmt"
 	"io"
 	"strings"
+	"sync"
 	"testing"
+	"testing/iotest"
 
 	sdkmath "cosmossdk.io/math"
 	"github.com/stretchr/testify/assert"
@@ -331,6 +335,63 @@ func TestUploadModular_HandleUploadObjectTaskFailure3(t *testing.T) {
 	assert.Equal(t, ErrInvalidIntegrity, err)
 }
 
+func TestUploadModular_HandleUploadObjectTaskAbortedStream(t *testing.T) {
+	t.Log("Failure case description: the stream is aborted after the whole payload due to the mismatched payload hash")
+	u := setup(t)
+	ctrl := gomock.NewController(t)
+
+	m1 := taskqueue.NewMockTQueueOnStrategy(ctrl)
+	u.uploadQueue = m1
+	m1.EXPECT().Push(gomock.Any()).Return(nil).Times(1)
+	m1.EXPECT().PopByKey(gomock.Any()).Return(&gfsptask.GfSpUploadObjectTask{}).AnyTimes()
+
+	m2 := piecestore.NewMockPieceOp(ctrl)
+	u.baseApp.SetPieceOp(m2)
+	m2.EXPECT().MaxSegmentPieceSize(gomock.Any(), gomock.Any()).Return(int64(4)).Times(1)
+	m2.EXPECT().SegmentPieceKey(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(objectID uint64, segmentIdx uint32, version int64) string {
+			return fmt.Sprintf("s%d_s%d", objectID, segmentIdx)
+		}).AnyTimes()
+
+	var (
+		mu     sync.Mutex
+		pieces = make(map[string][]byte)
+	)
+	m3 := piecestore.NewMockPieceStore(ctrl)
+	u.baseApp.SetPieceStore(m3)
+	m3.EXPECT().PutPiece(gomock.Any(), gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string, value []byte) error {
+			mu.Lock()
+			pieces[key] = value
+			mu.Unlock()
+			return nil
+		}).AnyTimes()
+	m3.EXPECT().DeletePiece(gomock.Any(), gomock.Any()).DoAndReturn(
+		func(ctx context.Context, key string) error {
+			mu.Lock()
+			delete(pieces, key)
+			mu.Unlock()
+			return nil
+		}).AnyTimes()
+
+	// no integrity hash or upload progress is expected to be written to the db
+	u.baseApp.SetGfSpDB(corespdb.NewMockSPDB(ctrl))
+	m4 := gfspclient.NewMockGfSpClientAPI(ctrl)
+	u.baseApp.SetGfSpClient(m4)
+	m4.EXPECT().ReportTask(gomock.Any(), gomock.Any()).Return(nil).AnyTimes()
+
+	payload := []byte("hello world")
+	uploadObjectTask := &gfsptask.GfSpUploadObjectTask{
+		Task:          &gfsptask.GfSpTask{TaskPriority: 1},
+		ObjectInfo:    &storagetypes.ObjectInfo{Id: sdkmath.NewUint(1), PayloadSize: uint64(len(payload))},
+		StorageParams: &storagetypes.Params{},
+	}
+	stream := io.MultiReader(bytes.NewReader(payload), iotest.ErrReader(mockErr))
+	err := u.HandleUploadObjectTask(context.TODO(), uploadObjectTask, stream)
+	assert.NotNil(t, err)
+	assert.Empty(t, pieces)
+}
+
 func TestUploadModular_HandleUploadObjectTaskFailure4(t *testing.T) {
 	t.Log("Failure case description: failed to write integrity hash to db")
 	u := setup(t)
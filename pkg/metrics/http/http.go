@@ -52,6 +52,14 @@ func (wd *responseWriterDelegator) WriteHeader(statusCode int) {
 	wd.w.WriteHeader(statusCode)
 }
 
+// Flush flushes the buffered data to the client if the underlying writer supports it, which is required
+// by the streaming responses.
+func (wd *responseWriterDelegator) Flush() {
+	if f, ok := wd.w.(http.Flusher); ok {
+		f.Flush()
+	}
+}
+
 func (wd *responseWriterDelegator) StatusCode() int {
 	if !wd.written {
 		return http.StatusOK
@@ -30,6 +30,11 @@ var MetricsItems = []prometheus.Collector{
 	PieceStoreTime,
 	PieceStoreCounter,
 	PieceStoreUsageAmountGauge,
+	PieceStoreTierUsageGauge,
+	PieceStoreTierReadCounter,
+	PieceStoreTierMoveCounter,
+	PieceStoreTierDemotedBytesCounter,
+	PieceStoreRebalanceCounter,
 
 	// db metrics category
 	SPDBTime,
@@ -63,6 +68,7 @@ var MetricsItems = []prometheus.Collector{
 	GCBlockNumberGauge,
 	SPHealthCheckerTime,
 	SPHealthCheckerFailureCounter,
+	SPReputationScoreGauge,
 
 	// workflow metrics category
 	PerfApprovalTime,
@@ -82,6 +88,12 @@ var MetricsItems = []prometheus.Collector{
 	ChainLatestHeight,
 	ChainRPCTime,
 	SaveBlockResultErr,
+	BlocksyncerCheckpointTime,
+	BlocksyncerCheckpointErr,
+	BlocksyncerCatchUpRate,
+	BlocksyncerLagBlocks,
+	BlocksyncerPrefetchedBlocks,
+	BlocksyncerPrefetchMiss,
 
 	// metadata metrics category
 	MetadataReqTime,
@@ -94,6 +106,24 @@ var MetricsItems = []prometheus.Collector{
 	MigrateGVGCounter,
 	MigrateObjectTimeHistogram,
 	MigrateObjectCounter,
+
+	// downloader piece cache category
+	PieceCacheCounter,
+	PieceDiskCacheUsageGauge,
+
+	// metadata cache category
+	MetadataCacheCounter,
+	MetadataCacheInvalidationCounter,
+
+	// signer tx pipeline category
+	SignerTxQueueGauge,
+	SignerTxPendingGauge,
+	SignerTxBatchSizeHistogram,
+	SignerTxResubmitCounter,
+
+	// piece scrubber category
+	ScrubPieceCounter,
+	ScrubPieceSizeCounter,
 }
 
 // basic metrics items
@@ -146,6 +176,26 @@ var (
 		Name: "usage_amount_piece_store",
 		Help: "Track usage amount of piece store.",
 	}, []string{"usage_amount_piece_store"})
+	PieceStoreTierUsageGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "piece_store_tier_usage",
+		Help: "Track the piece bytes stored in the hot tier of the tiered piece store.",
+	}, []string{"tier"})
+	PieceStoreTierReadCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_store_tier_read_counter",
+		Help: "Track the piece reads served by every tier of the tiered piece store.",
+	}, []string{"tier"})
+	PieceStoreTierMoveCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_store_tier_move_counter",
+		Help: "Track the pieces demoted from the hot tier to the cold tier.",
+	}, []string{"result"})
+	PieceStoreTierDemotedBytesCounter = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "piece_store_tier_demoted_bytes",
+		Help: "Track the piece bytes demoted from the hot tier to the cold tier.",
+	})
+	PieceStoreRebalanceCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_store_rebalance_counter",
+		Help: "Track the pieces moved to their owner shards by the sharded piece store rebalancer.",
+	}, []string{"result"})
 
 	// spdb metrics
 	SPDBTime = prometheus.NewHistogramVec(prometheus.HistogramOpts{
@@ -263,6 +313,10 @@ var (
 		},
 		[]string{"sp_id"},
 	)
+	SPReputationScoreGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "sp_reputation_score",
+		Help: "Track the reputation score of the sp scored from replicate, recovery fetch and approval outcomes.",
+	}, []string{"sp_id"})
 )
 
 // workflow metrics items
@@ -335,6 +389,30 @@ var (
 		Name: "data_statistics_err",
 		Help: "Track the data statistics err",
 	})
+	BlocksyncerCheckpointTime = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "blocksyncer_checkpoint_time",
+		Help: "Track the time of taking the checkpoint. ",
+	})
+	BlocksyncerCheckpointErr = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "blocksyncer_checkpoint_err",
+		Help: "Track the checkpoint err",
+	})
+	BlocksyncerCatchUpRate = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "blocksyncer_catch_up_rate",
+		Help: "Track the number of the blocks processed per second. ",
+	})
+	BlocksyncerLagBlocks = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "blocksyncer_lag_blocks",
+		Help: "Track the number of the blocks between the chain latest height and the processed height. ",
+	})
+	BlocksyncerPrefetchedBlocks = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "blocksyncer_prefetched_blocks",
+		Help: "Track the number of the in-flight blocks that are fetched or being fetched ahead of the processed height. ",
+	})
+	BlocksyncerPrefetchMiss = prometheus.NewCounter(prometheus.CounterOpts{
+		Name: "blocksyncer_prefetch_miss",
+		Help: "Track the number of the blocks that are not prefetched when they are processed",
+	})
 )
 
 var (
@@ -373,3 +451,60 @@ var (
 		Help: "Track migrate object number",
 	}, []string{"migrate_object_counter"})
 )
+
+// downloader piece cache metrics
+var (
+	PieceCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "piece_cache_counter",
+		Help: "Track the hit and miss number of the downloader piece cache tiers.",
+	}, []string{"tier", "result"})
+	PieceDiskCacheUsageGauge = prometheus.NewGauge(prometheus.GaugeOpts{
+		Name: "piece_disk_cache_usage",
+		Help: "Track the bytes of the pieces cached in the downloader disk cache.",
+	})
+)
+
+// metadata cache metrics
+var (
+	MetadataCacheCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "metadata_cache_counter",
+		Help: "Track the hit and miss number of the metadata cache queries.",
+	}, []string{"query", "result"})
+	MetadataCacheInvalidationCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "metadata_cache_invalidation_counter",
+		Help: "Track the number of the metadata cache invalidations applied.",
+	}, []string{"kind"})
+)
+
+// signer tx pipeline metrics
+var (
+	SignerTxQueueGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "signer_tx_queue_number",
+		Help: "Track the msg number waiting to be broadcast by the signer tx pipeline.",
+	}, []string{"scope"})
+	SignerTxPendingGauge = prometheus.NewGaugeVec(prometheus.GaugeOpts{
+		Name: "signer_tx_pending_number",
+		Help: "Track the tx number broadcast but not committed on chain by the signer tx pipeline.",
+	}, []string{"scope"})
+	SignerTxBatchSizeHistogram = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "signer_tx_batch_size",
+		Help:    "Track the msg number in one tx broadcast by the signer tx pipeline.",
+		Buckets: []float64{1, 2, 4, 8, 16, 32, 64},
+	}, []string{"scope"})
+	SignerTxResubmitCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "signer_tx_resubmit_counter",
+		Help: "Track the dropped tx number resubmitted by the signer tx pipeline.",
+	}, []string{"scope"})
+)
+
+// piece scrubber metrics
+var (
+	ScrubPieceCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scrub_piece_counter",
+		Help: "Track the piece number verified by the background scrubber, labeled by the verify result.",
+	}, []string{"result"})
+	ScrubPieceSizeCounter = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Name: "scrub_piece_size_counter",
+		Help: "Track the piece bytes read from the piece store by the background scrubber.",
+	}, []string{"scope"})
+)
@@ -7,6 +7,7 @@ import (
 	"regexp"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	modelgateway "github.com/bnb-chain/greenfield-storage-provider/model/gateway"
@@ -39,11 +40,12 @@ type IPLimitConfig struct {
 }
 
 type RateLimiterConfig struct {
-	IPLimitCfg  IPLimitConfig
-	PathPattern []KeyToRateLimiterNameCell `comment:"optional"`
-	HostPattern []KeyToRateLimiterNameCell `comment:"optional"`
-	APILimits   []KeyToRateLimiterNameCell `comment:"optional"`
-	NameToLimit []MemoryLimiterConfig      `comment:"optional"`
+	IPLimitCfg     IPLimitConfig
+	TenantLimitCfg TenantLimitConfig
+	PathPattern    []KeyToRateLimiterNameCell `comment:"optional"`
+	HostPattern    []KeyToRateLimiterNameCell `comment:"optional"`
+	APILimits      []KeyToRateLimiterNameCell `comment:"optional"`
+	NameToLimit    []MemoryLimiterConfig      `comment:"optional"`
 }
 
 type MemoryLimiterConfig struct {
@@ -72,15 +74,38 @@ type apiLimiter struct {
 	cfg        APILimiterConfig
 }
 
-var limiter *apiLimiter
+var limiter atomic.Pointer[apiLimiter]
 
 func NewAPILimiter(cfg *APILimiterConfig) error {
 	localStore := smemory.NewStoreWithOptions(slimiter.StoreOptions{
 		Prefix:          "sp_api_rate_limiter",
 		CleanUpInterval: 5 * time.Second,
 	})
-	limiter = &apiLimiter{
-		store: localStore,
+	newLimiter, err := newAPILimiter(localStore, cfg)
+	if err != nil {
+		return err
+	}
+	limiter.Store(newLimiter)
+	return nil
+}
+
+// ReloadAPILimiter validates the cfg and returns the function to replace the running api limiter, the
+// counters in the store are kept, so the requests before reloading are still counted.
+func ReloadAPILimiter(cfg *APILimiterConfig) (func(), error) {
+	current := limiter.Load()
+	if current == nil {
+		return nil, fmt.Errorf("api limiter is not initialized")
+	}
+	newLimiter, err := newAPILimiter(current.store, cfg)
+	if err != nil {
+		return nil, err
+	}
+	return func() { limiter.Store(newLimiter) }, nil
+}
+
+func newAPILimiter(store slimiter.Store, cfg *APILimiterConfig) (*apiLimiter, error) {
+	l := &apiLimiter{
+		store: store,
 		cfg: APILimiterConfig{
 			APILimits:    make(map[string][]MemoryLimiterConfig),
 			PathPattern:  make(map[string][]MemoryLimiterConfig),
@@ -95,25 +120,25 @@ func NewAPILimiter(cfg *APILimiterConfig) error {
 	var rate slimiter.Rate
 
 	for k, v := range cfg.PathPattern {
-		limiter.cfg.PathPattern[strings.ToLower(k)] = v
+		l.cfg.PathPattern[strings.ToLower(k)] = v
 	}
 
 	for k, v := range cfg.HostPattern {
-		limiter.cfg.HostPattern[strings.ToLower(k)] = v
+		l.cfg.HostPattern[strings.ToLower(k)] = v
 	}
 
 	for k, vs := range cfg.APILimits {
 		for _, v := range vs {
 			rate, err = slimiter.NewRateFromFormatted(fmt.Sprintf("%d-%s", v.RateLimit, v.RatePeriod))
 			if err != nil {
-				return err
+				return nil, err
 			}
 
-			limiter.limiterMap.Store(strings.ToLower(k), slimiter.New(localStore, rate))
+			l.limiterMap.Store(strings.ToLower(k), slimiter.New(store, rate))
 		}
 	}
 
-	return nil
+	return l, nil
 }
 
 func (a *apiLimiter) findLimiter(host, path, key string, virtualHost bool, method string) []rateLimiterWithName {
@@ -243,11 +268,12 @@ func (t *apiLimiter) HTTPAllow(ctx context.Context, r *http.Request) bool {
 func Limit(domain string) func(http.Handler) http.Handler {
 	return func(next http.Handler) http.Handler {
 		return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-			if !limiter.Allow(context.Background(), r, domain) {
+			l := limiter.Load()
+			if !l.Allow(context.Background(), r, domain) {
 				modelgateway.MakeErrorResponse(w, ErrTooManyRequest)
 				return
 			}
-			if !limiter.HTTPAllow(context.Background(), r) {
+			if !l.HTTPAllow(context.Background(), r) {
 				modelgateway.MakeErrorResponse(w, ErrTooManyRequest)
 				return
 			}
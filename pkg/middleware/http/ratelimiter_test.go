@@ -0,0 +1,44 @@
+package http
+
+import (
+	"context"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestReloadAPILimiter(t *testing.T) {
+	cfg := &APILimiterConfig{
+		APILimits: map[string][]MemoryLimiterConfig{
+			"localhost-/mock": {{RateLimit: 1, RatePeriod: "S"}},
+		},
+	}
+	assert.Nil(t, NewAPILimiter(cfg))
+	current := limiter.Load()
+	r := httptest.NewRequest("GET", "/mock", nil)
+	r.Host = "localhost"
+	assert.True(t, current.Allow(context.Background(), r, "localhost"))
+	assert.False(t, current.Allow(context.Background(), r, "localhost"))
+
+	t.Log("Case description: invalid rate period is rejected and the running limiter is kept")
+	_, err := ReloadAPILimiter(&APILimiterConfig{
+		APILimits: map[string][]MemoryLimiterConfig{
+			"localhost-/mock": {{RateLimit: 1, RatePeriod: "invalid"}},
+		},
+	})
+	assert.NotNil(t, err)
+	assert.Equal(t, current, limiter.Load())
+
+	t.Log("Case description: the new limits apply and the store is kept")
+	apply, err := ReloadAPILimiter(&APILimiterConfig{
+		APILimits: map[string][]MemoryLimiterConfig{
+			"localhost-/mock": {{RateLimit: 100, RatePeriod: "S"}},
+		},
+	})
+	assert.Nil(t, err)
+	apply()
+	assert.NotEqual(t, current, limiter.Load())
+	assert.Equal(t, current.store, limiter.Load().store)
+	assert.True(t, limiter.Load().Allow(context.Background(), r, "localhost"))
+}
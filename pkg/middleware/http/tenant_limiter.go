@@ -0,0 +1,285 @@
+package http
+
+import (
+	"context"
+	"fmt"
+	"math"
+	"strings"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+)
+
+const (
+	// tenantLimitSweepInterval is the interval of sweeping the limits of the idle tenants.
+	tenantLimitSweepInterval = time.Minute
+	// tenantLimitIdleTimeout is the time after which the limit of an idle tenant is dropped, the tokens
+	// of its buckets have been refilled by then for any sensible tier.
+	tenantLimitIdleTimeout = 10 * time.Minute
+)
+
+// TenantKind is the kind of the tenant that a limit is keyed on.
+type TenantKind string
+
+const (
+	// TenantAccount is the tenant keyed on the authenticated greenfield account.
+	TenantAccount TenantKind = "account"
+	// TenantBucket is the tenant keyed on the bucket name.
+	TenantBucket TenantKind = "bucket"
+)
+
+// TenantLimitTier defines the request rate and the byte rate of a tier, the zero rate means unlimited.
+type TenantLimitTier struct {
+	Name         string
+	RequestRate  float64 // requests per second
+	RequestBurst int     // defaults to the ceil of RequestRate
+	ByteRate     uint64  // bytes per second
+	ByteBurst    uint64  // defaults to ByteRate
+}
+
+// TenantTierCell assigns the tier to the account or bucket of the Key.
+type TenantTierCell struct {
+	Key  string
+	Tier string
+}
+
+type TenantLimitConfig struct {
+	On                 bool              `comment:"optional"`
+	DefaultAccountTier string            `comment:"optional"`
+	DefaultBucketTier  string            `comment:"optional"`
+	Tiers              []TenantLimitTier `comment:"optional"`
+	AccountTiers       []TenantTierCell  `comment:"optional"`
+	BucketTiers        []TenantTierCell  `comment:"optional"`
+}
+
+// tenantLimit is the token buckets of a tenant, nil bucket means unlimited.
+type tenantLimit struct {
+	requests *rate.Limiter
+	bytes    *rate.Limiter
+	lastSeen time.Time
+}
+
+// TenantLimiter limits the request rate and shapes the byte rate per account and per bucket, the limits
+// of the tenants are assigned by tiers and can be reloaded at runtime without losing the tokens.
+type TenantLimiter struct {
+	mu                 sync.Mutex
+	on                 bool
+	tiers              map[string]*TenantLimitTier
+	accountTiers       map[string]string
+	bucketTiers        map[string]string
+	defaultAccountTier string
+	defaultBucketTier  string
+
+	accounts  map[string]*tenantLimit
+	buckets   map[string]*tenantLimit
+	lastSweep time.Time
+}
+
+// NewTenantLimiter returns a TenantLimiter by the cfg.
+func NewTenantLimiter(cfg TenantLimitConfig) (*TenantLimiter, error) {
+	l := &TenantLimiter{
+		accounts:  make(map[string]*tenantLimit),
+		buckets:   make(map[string]*tenantLimit),
+		lastSweep: time.Now(),
+	}
+	if err := l.Reload(cfg); err != nil {
+		return nil, err
+	}
+	return l, nil
+}
+
+// Reload validates the cfg and applies it to the tenants, the tokens that the tenants hold are kept.
+func (l *TenantLimiter) Reload(cfg TenantLimitConfig) error {
+	tiers := make(map[string]*TenantLimitTier, len(cfg.Tiers))
+	for i := range cfg.Tiers {
+		tier := cfg.Tiers[i]
+		if tier.Name == "" {
+			return fmt.Errorf("tenant limit tier name is empty")
+		}
+		if _, ok := tiers[tier.Name]; ok {
+			return fmt.Errorf("duplicate tenant limit tier %s", tier.Name)
+		}
+		if tier.RequestRate < 0 || math.IsNaN(tier.RequestRate) || math.IsInf(tier.RequestRate, 0) || tier.RequestBurst < 0 {
+			return fmt.Errorf("invalid request rate of tenant limit tier %s", tier.Name)
+		}
+		if tier.RequestRate > 0 && tier.RequestBurst == 0 {
+			tier.RequestBurst = int(math.Ceil(tier.RequestRate))
+		}
+		if tier.ByteRate > 0 && tier.ByteBurst == 0 {
+			tier.ByteBurst = tier.ByteRate
+		}
+		if tier.ByteBurst > math.MaxInt32 {
+			return fmt.Errorf("byte burst of tenant limit tier %s is too large", tier.Name)
+		}
+		tiers[tier.Name] = &tier
+	}
+	checkTier := func(name string) error {
+		if _, ok := tiers[name]; name != "" && !ok {
+			return fmt.Errorf("unknown tenant limit tier %s", name)
+		}
+		return nil
+	}
+	assign := func(cells []TenantTierCell) (map[string]string, error) {
+		assigned := make(map[string]string, len(cells))
+		for _, c := range cells {
+			if err := checkTier(c.Tier); err != nil {
+				return nil, err
+			}
+			assigned[strings.ToLower(c.Key)] = c.Tier
+		}
+		return assigned, nil
+	}
+	if err := checkTier(cfg.DefaultAccountTier); err != nil {
+		return err
+	}
+	if err := checkTier(cfg.DefaultBucketTier); err != nil {
+		return err
+	}
+	accountTiers, err := assign(cfg.AccountTiers)
+	if err != nil {
+		return err
+	}
+	bucketTiers, err := assign(cfg.BucketTiers)
+	if err != nil {
+		return err
+	}
+
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	l.on = cfg.On
+	l.tiers = tiers
+	l.accountTiers = accountTiers
+	l.bucketTiers = bucketTiers
+	l.defaultAccountTier = cfg.DefaultAccountTier
+	l.defaultBucketTier = cfg.DefaultBucketTier
+	for key, limit := range l.accounts {
+		limit.apply(l.tierOf(TenantAccount, key))
+	}
+	for key, limit := range l.buckets {
+		limit.apply(l.tierOf(TenantBucket, key))
+	}
+	return nil
+}
+
+// AllowRequest takes a request token of the account and the bucket, the empty account or bucket is
+// not limited. It returns the kind of the tenant that exceeds its request rate, or empty if allowed.
+func (l *TenantLimiter) AllowRequest(account, bucket string) TenantKind {
+	accountLimit, bucketLimit := l.limits(account, bucket)
+	if accountLimit != nil && accountLimit.requests != nil && !accountLimit.requests.Allow() {
+		return TenantAccount
+	}
+	if bucketLimit != nil && bucketLimit.requests != nil && !bucketLimit.requests.Allow() {
+		return TenantBucket
+	}
+	return ""
+}
+
+// WaitBytes blocks until n bytes are allowed by the byte rate of the account and the bucket. It returns
+// the kind of the tenant and the error if the wait is canceled or would exceed the deadline of ctx.
+func (l *TenantLimiter) WaitBytes(ctx context.Context, account, bucket string, n int) (TenantKind, error) {
+	accountLimit, bucketLimit := l.limits(account, bucket)
+	if accountLimit != nil && accountLimit.bytes != nil {
+		if err := waitN(ctx, accountLimit.bytes, n); err != nil {
+			return TenantAccount, err
+		}
+	}
+	if bucketLimit != nil && bucketLimit.bytes != nil {
+		if err := waitN(ctx, bucketLimit.bytes, n); err != nil {
+			return TenantBucket, err
+		}
+	}
+	return "", nil
+}
+
+// waitN waits for n tokens by the chunks of the burst, rate.Limiter rejects the waits beyond the burst.
+func waitN(ctx context.Context, limiter *rate.Limiter, n int) error {
+	for n > 0 {
+		chunk := n
+		if burst := limiter.Burst(); chunk > burst {
+			chunk = burst
+		}
+		if err := limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+		n -= chunk
+	}
+	return nil
+}
+
+// limits returns the snapshot of the limits of the account and the bucket, nil means unlimited.
+func (l *TenantLimiter) limits(account, bucket string) (accountLimit, bucketLimit *tenantLimit) {
+	l.mu.Lock()
+	defer l.mu.Unlock()
+	if !l.on {
+		return nil, nil
+	}
+	now := time.Now()
+	if now.Sub(l.lastSweep) > tenantLimitSweepInterval {
+		l.sweep(now)
+	}
+	if account != "" {
+		accountLimit = l.limitOf(l.accounts, TenantAccount, strings.ToLower(account), now)
+	}
+	if bucket != "" {
+		bucketLimit = l.limitOf(l.buckets, TenantBucket, strings.ToLower(bucket), now)
+	}
+	return accountLimit, bucketLimit
+}
+
+func (l *TenantLimiter) limitOf(limits map[string]*tenantLimit, kind TenantKind, key string, now time.Time) *tenantLimit {
+	limit, ok := limits[key]
+	if !ok {
+		limit = &tenantLimit{}
+		limit.apply(l.tierOf(kind, key))
+		limits[key] = limit
+	}
+	limit.lastSeen = now
+	// the copy is used out of the lock, the token buckets are safe for concurrent use
+	snapshot := *limit
+	return &snapshot
+}
+
+func (l *TenantLimiter) tierOf(kind TenantKind, key string) *TenantLimitTier {
+	assigned, name := l.accountTiers, l.defaultAccountTier
+	if kind == TenantBucket {
+		assigned, name = l.bucketTiers, l.defaultBucketTier
+	}
+	if tier, ok := assigned[key]; ok {
+		name = tier
+	}
+	return l.tiers[name]
+}
+
+func (l *TenantLimiter) sweep(now time.Time) {
+	for _, limits := range []map[string]*tenantLimit{l.accounts, l.buckets} {
+		for key, limit := range limits {
+			if now.Sub(limit.lastSeen) > tenantLimitIdleTimeout {
+				delete(limits, key)
+			}
+		}
+	}
+	l.lastSweep = now
+}
+
+// apply updates the token buckets by the tier in place, so the tokens are kept across the reloads.
+func (t *tenantLimit) apply(tier *TenantLimitTier) {
+	if tier == nil {
+		t.requests, t.bytes = nil, nil
+		return
+	}
+	t.requests = applyLimit(t.requests, rate.Limit(tier.RequestRate), tier.RequestBurst)
+	t.bytes = applyLimit(t.bytes, rate.Limit(tier.ByteRate), int(tier.ByteBurst))
+}
+
+func applyLimit(limiter *rate.Limiter, r rate.Limit, b int) *rate.Limiter {
+	if r == 0 {
+		return nil
+	}
+	if limiter == nil {
+		return rate.NewLimiter(r, b)
+	}
+	limiter.SetLimit(r)
+	limiter.SetBurst(b)
+	return limiter
+}
@@ -0,0 +1,116 @@
+package http
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func mockTenantLimitConfig() TenantLimitConfig {
+	return TenantLimitConfig{
+		On:                 true,
+		DefaultAccountTier: "free",
+		Tiers: []TenantLimitTier{
+			{Name: "free", RequestRate: 1, RequestBurst: 2, ByteRate: 10},
+			{Name: "paid", RequestRate: 100},
+		},
+		AccountTiers: []TenantTierCell{{Key: "0xPaid", Tier: "paid"}},
+		BucketTiers:  []TenantTierCell{{Key: "hot-bucket", Tier: "free"}},
+	}
+}
+
+func TestNewTenantLimiter(t *testing.T) {
+	cases := []struct {
+		name    string
+		cfg     TenantLimitConfig
+		wantErr bool
+	}{
+		{name: "empty", cfg: TenantLimitConfig{}},
+		{name: "valid", cfg: mockTenantLimitConfig()},
+		{name: "empty tier name", cfg: TenantLimitConfig{Tiers: []TenantLimitTier{{RequestRate: 1}}}, wantErr: true},
+		{name: "duplicate tier", cfg: TenantLimitConfig{Tiers: []TenantLimitTier{{Name: "a"}, {Name: "a"}}}, wantErr: true},
+		{name: "negative rate", cfg: TenantLimitConfig{Tiers: []TenantLimitTier{{Name: "a", RequestRate: -1}}}, wantErr: true},
+		{name: "unknown default tier", cfg: TenantLimitConfig{DefaultBucketTier: "a"}, wantErr: true},
+		{name: "unknown assigned tier", cfg: TenantLimitConfig{AccountTiers: []TenantTierCell{{Key: "0x", Tier: "a"}}}, wantErr: true},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := NewTenantLimiter(tt.cfg)
+			assert.Equal(t, tt.wantErr, err != nil)
+		})
+	}
+}
+
+func TestTenantLimiter_AllowRequest(t *testing.T) {
+	l, err := NewTenantLimiter(mockTenantLimitConfig())
+	assert.Nil(t, err)
+
+	// the burst of the free tier is 2
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xFree", "cold-bucket"))
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xfree", "cold-bucket"))
+	assert.Equal(t, TenantAccount, l.AllowRequest("0xFree", "cold-bucket"))
+	// the limits of the accounts are independent
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xOther", ""))
+	// the paid account is limited by the bucket tier
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xPaid", "hot-bucket"))
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xPaid", "hot-bucket"))
+	assert.Equal(t, TenantBucket, l.AllowRequest("0xPaid", "hot-bucket"))
+	// the anonymous requests to the unassigned buckets are not limited
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, TenantKind(""), l.AllowRequest("", "cold-bucket"))
+	}
+}
+
+func TestTenantLimiter_Reload(t *testing.T) {
+	l, err := NewTenantLimiter(mockTenantLimitConfig())
+	assert.Nil(t, err)
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xFree", ""))
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xFree", ""))
+	assert.Equal(t, TenantAccount, l.AllowRequest("0xFree", ""))
+
+	// the invalid config is rejected and the running one is kept
+	cfg := mockTenantLimitConfig()
+	cfg.DefaultAccountTier = "unknown"
+	assert.NotNil(t, l.Reload(cfg))
+	assert.Equal(t, TenantAccount, l.AllowRequest("0xFree", ""))
+
+	// the account is moved to the paid tier
+	cfg = mockTenantLimitConfig()
+	cfg.AccountTiers = append(cfg.AccountTiers, TenantTierCell{Key: "0xFree", Tier: "paid"})
+	assert.Nil(t, l.Reload(cfg))
+	time.Sleep(20 * time.Millisecond)
+	assert.Equal(t, TenantKind(""), l.AllowRequest("0xFree", ""))
+
+	// all limits are lifted if the limiter is turned off
+	cfg.On = false
+	assert.Nil(t, l.Reload(cfg))
+	for i := 0; i < 10; i++ {
+		assert.Equal(t, TenantKind(""), l.AllowRequest("0xNew", "hot-bucket"))
+	}
+}
+
+func TestTenantLimiter_WaitBytes(t *testing.T) {
+	l, err := NewTenantLimiter(mockTenantLimitConfig())
+	assert.Nil(t, err)
+
+	// the burst of the free tier is 10 bytes
+	kind, err := l.WaitBytes(context.Background(), "0xFree", "", 10)
+	assert.Nil(t, err)
+	assert.Equal(t, TenantKind(""), kind)
+
+	// waiting for the bytes beyond the deadline fails fast
+	ctx, cancel := context.WithTimeout(context.Background(), 100*time.Millisecond)
+	defer cancel()
+	start := time.Now()
+	kind, err = l.WaitBytes(ctx, "0xFree", "", 100)
+	assert.NotNil(t, err)
+	assert.Equal(t, TenantAccount, kind)
+	assert.True(t, time.Since(start) < 100*time.Millisecond)
+
+	// the unlimited tenants do not wait
+	kind, err = l.WaitBytes(ctx, "0xPaid", "cold-bucket", 1<<30)
+	assert.Nil(t, err)
+	assert.Equal(t, TenantKind(""), kind)
+}
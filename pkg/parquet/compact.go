@@ -0,0 +1,107 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+)
+
+// the element types of the thrift compact protocol
+const (
+	compactI32    byte = 5
+	compactI64    byte = 6
+	compactBinary byte = 8
+	compactList   byte = 9
+	compactStruct byte = 12
+)
+
+// compactEncoder encodes the parquet metadata by the thrift compact protocol, it supports the field types
+// used by the metadata written by Writer only.
+type compactEncoder struct {
+	buf bytes.Buffer
+	// lastFieldIDs is the last field id of the structs being encoded, the field ids are encoded as deltas.
+	lastFieldIDs []int16
+}
+
+func newCompactEncoder() *compactEncoder {
+	return &compactEncoder{lastFieldIDs: []int16{0}}
+}
+
+func (e *compactEncoder) bytes() []byte {
+	return e.buf.Bytes()
+}
+
+func (e *compactEncoder) fieldHeader(id int16, typ byte) {
+	last := &e.lastFieldIDs[len(e.lastFieldIDs)-1]
+	if delta := id - *last; delta > 0 && delta <= 15 {
+		e.buf.WriteByte(byte(delta)<<4 | typ)
+	} else {
+		e.buf.WriteByte(typ)
+		e.varint(zigzag(int64(id)))
+	}
+	*last = id
+}
+
+func (e *compactEncoder) i32Field(id int16, v int32) {
+	e.fieldHeader(id, compactI32)
+	e.i32(v)
+}
+
+func (e *compactEncoder) i64Field(id int16, v int64) {
+	e.fieldHeader(id, compactI64)
+	e.i64(v)
+}
+
+func (e *compactEncoder) stringField(id int16, v string) {
+	e.fieldHeader(id, compactBinary)
+	e.string(v)
+}
+
+// structField starts a struct field, the struct is ended by endStruct.
+func (e *compactEncoder) structField(id int16) {
+	e.fieldHeader(id, compactStruct)
+	e.beginStruct()
+}
+
+// listField starts a list field of size elements, the elements are written by the element methods.
+func (e *compactEncoder) listField(id int16, elemType byte, size int) {
+	e.fieldHeader(id, compactList)
+	if size < 15 {
+		e.buf.WriteByte(byte(size)<<4 | elemType)
+		return
+	}
+	e.buf.WriteByte(0xf0 | elemType)
+	e.varint(uint64(size))
+}
+
+// beginStruct starts a struct that is a list element or the top level struct.
+func (e *compactEncoder) beginStruct() {
+	e.lastFieldIDs = append(e.lastFieldIDs, 0)
+}
+
+func (e *compactEncoder) endStruct() {
+	e.buf.WriteByte(0)
+	e.lastFieldIDs = e.lastFieldIDs[:len(e.lastFieldIDs)-1]
+}
+
+func (e *compactEncoder) i32(v int32) {
+	e.varint(zigzag(int64(v)))
+}
+
+func (e *compactEncoder) i64(v int64) {
+	e.varint(zigzag(v))
+}
+
+func (e *compactEncoder) string(v string) {
+	e.varint(uint64(len(v)))
+	e.buf.WriteString(v)
+}
+
+func (e *compactEncoder) varint(v uint64) {
+	var b [binary.MaxVarintLen64]byte
+	n := binary.PutUvarint(b[:], v)
+	e.buf.Write(b[:n])
+}
+
+func zigzag(v int64) uint64 {
+	return uint64(v<<1) ^ uint64(v>>63)
+}
@@ -0,0 +1,275 @@
+// Package parquet writes flat tables as parquet files. It supports the required string and uint64 columns,
+// and writes every column of a row group as one uncompressed data page with the plain encoding, which is
+// enough for exporting the reports of the SP to the data tools.
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"fmt"
+	"io"
+)
+
+const (
+	// DefaultRowGroupSize defines the default number of rows buffered in memory before they are written
+	// as a row group.
+	DefaultRowGroupSize = 64 * 1024
+
+	// createdBy is written to the file metadata as the application that writes the file.
+	createdBy = "greenfield-storage-provider"
+)
+
+// magic is at the start and the end of a parquet file.
+var magic = []byte("PAR1")
+
+// the parquet metadata enums used by Writer, see parquet.thrift of the parquet format.
+const (
+	typeInt64          int32 = 2
+	typeByteArray      int32 = 6
+	repetitionRequired int32 = 0
+	convertedUTF8      int32 = 0
+	convertedUint64    int32 = 14
+	encodingPlain      int32 = 0
+	encodingRLE        int32 = 3
+	codecUncompressed  int32 = 0
+	pageTypeData       int32 = 0
+)
+
+var (
+	// ErrInvalidRow defines the error of the row that does not match the columns.
+	ErrInvalidRow = errors.New("row does not match the columns")
+	// ErrWriterClosed defines the error of writing to a closed writer.
+	ErrWriterClosed = errors.New("parquet writer is closed")
+)
+
+// ColumnType is the type of the values in a column.
+type ColumnType int
+
+const (
+	// StringColumn is a column of utf8 strings.
+	StringColumn ColumnType = iota
+	// Uint64Column is a column of unsigned 64 bits integers.
+	Uint64Column
+)
+
+// Column defines a column of the table.
+type Column struct {
+	Name string
+	Type ColumnType
+}
+
+// columnChunk is the metadata of a column chunk that is written.
+type columnChunk struct {
+	offset    int64
+	size      int64
+	numValues int64
+}
+
+// rowGroup is the metadata of a row group that is written.
+type rowGroup struct {
+	columns   []columnChunk
+	size      int64
+	numValues int64
+}
+
+// Writer writes the rows to a parquet file, the rows are buffered and written as a row group every
+// DefaultRowGroupSize rows, and the file metadata is written by Close.
+type Writer struct {
+	w         io.Writer
+	columns   []Column
+	offset    int64
+	buffers   []bytes.Buffer
+	rows      int64
+	numRows   int64
+	rowGroups []rowGroup
+	closed    bool
+}
+
+// NewWriter returns a writer writing a parquet file of the columns to w.
+func NewWriter(w io.Writer, columns []Column) *Writer {
+	return &Writer{w: w, columns: columns, buffers: make([]bytes.Buffer, len(columns))}
+}
+
+// Write appends a row, the values are string for StringColumn and uint64 for Uint64Column, in the order
+// of the columns.
+func (w *Writer) Write(row []interface{}) error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	if len(row) != len(w.columns) {
+		return ErrInvalidRow
+	}
+	for i, column := range w.columns {
+		switch column.Type {
+		case StringColumn:
+			if _, ok := row[i].(string); !ok {
+				return fmt.Errorf("%w: column %s is not a string", ErrInvalidRow, column.Name)
+			}
+		case Uint64Column:
+			if _, ok := row[i].(uint64); !ok {
+				return fmt.Errorf("%w: column %s is not an uint64", ErrInvalidRow, column.Name)
+			}
+		}
+	}
+	// the values are encoded after all of them are checked, so an invalid row is not partially written
+	var b [8]byte
+	for i, column := range w.columns {
+		switch column.Type {
+		case StringColumn:
+			v := row[i].(string)
+			binary.LittleEndian.PutUint32(b[:4], uint32(len(v)))
+			w.buffers[i].Write(b[:4])
+			w.buffers[i].WriteString(v)
+		case Uint64Column:
+			binary.LittleEndian.PutUint64(b[:], row[i].(uint64))
+			w.buffers[i].Write(b[:])
+		}
+	}
+	w.rows++
+	if w.rows >= DefaultRowGroupSize {
+		return w.flushRowGroup()
+	}
+	return nil
+}
+
+// Close writes the buffered rows and the file metadata, it does not close the underlying writer.
+func (w *Writer) Close() error {
+	if w.closed {
+		return ErrWriterClosed
+	}
+	w.closed = true
+	if err := w.flushRowGroup(); err != nil {
+		return err
+	}
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+	metadata := w.encodeFileMetadata()
+	var size [4]byte
+	binary.LittleEndian.PutUint32(size[:], uint32(len(metadata)))
+	if err := w.write(metadata); err != nil {
+		return err
+	}
+	if err := w.write(size[:]); err != nil {
+		return err
+	}
+	return w.write(magic)
+}
+
+// flushRowGroup writes the buffered rows as a row group, every column is written as a data page.
+func (w *Writer) flushRowGroup() error {
+	if w.rows == 0 {
+		return nil
+	}
+	if err := w.writeMagic(); err != nil {
+		return err
+	}
+	group := rowGroup{numValues: w.rows}
+	for i := range w.columns {
+		data := w.buffers[i].Bytes()
+		header := encodePageHeader(int32(len(data)), int32(w.rows))
+		chunk := columnChunk{offset: w.offset, size: int64(len(header) + len(data)), numValues: w.rows}
+		if err := w.write(header); err != nil {
+			return err
+		}
+		if err := w.write(data); err != nil {
+			return err
+		}
+		w.buffers[i].Reset()
+		group.columns = append(group.columns, chunk)
+		group.size += chunk.size
+	}
+	w.rowGroups = append(w.rowGroups, group)
+	w.numRows += w.rows
+	w.rows = 0
+	return nil
+}
+
+func (w *Writer) writeMagic() error {
+	if w.offset > 0 {
+		return nil
+	}
+	return w.write(magic)
+}
+
+func (w *Writer) write(p []byte) error {
+	n, err := w.w.Write(p)
+	w.offset += int64(n)
+	return err
+}
+
+// encodePageHeader encodes the PageHeader of a data page, the values of the required columns have no
+// repetition or definition levels.
+func encodePageHeader(size, numValues int32) []byte {
+	e := newCompactEncoder()
+	e.i32Field(1, pageTypeData)
+	e.i32Field(2, size)
+	e.i32Field(3, size)
+	e.structField(5)
+	e.i32Field(1, numValues)
+	e.i32Field(2, encodingPlain)
+	e.i32Field(3, encodingRLE)
+	e.i32Field(4, encodingRLE)
+	e.endStruct()
+	e.endStruct()
+	return e.bytes()
+}
+
+// encodeFileMetadata encodes the FileMetaData of the file.
+func (w *Writer) encodeFileMetadata() []byte {
+	e := newCompactEncoder()
+	e.i32Field(1, 1)
+	e.listField(2, compactStruct, len(w.columns)+1)
+	e.beginStruct()
+	e.stringField(4, "schema")
+	e.i32Field(5, int32(len(w.columns)))
+	e.endStruct()
+	for _, column := range w.columns {
+		physicalType, convertedType := columnTypes(column.Type)
+		e.beginStruct()
+		e.i32Field(1, physicalType)
+		e.i32Field(3, repetitionRequired)
+		e.stringField(4, column.Name)
+		e.i32Field(6, convertedType)
+		e.endStruct()
+	}
+	e.i64Field(3, w.numRows)
+	e.listField(4, compactStruct, len(w.rowGroups))
+	for _, group := range w.rowGroups {
+		e.beginStruct()
+		e.listField(1, compactStruct, len(group.columns))
+		for i, chunk := range group.columns {
+			physicalType, _ := columnTypes(w.columns[i].Type)
+			e.beginStruct()
+			e.i64Field(2, chunk.offset)
+			e.structField(3)
+			e.i32Field(1, physicalType)
+			e.listField(2, compactI32, 1)
+			e.i32(encodingPlain)
+			e.listField(3, compactBinary, 1)
+			e.string(w.columns[i].Name)
+			e.i32Field(4, codecUncompressed)
+			e.i64Field(5, chunk.numValues)
+			e.i64Field(6, chunk.size)
+			e.i64Field(7, chunk.size)
+			e.i64Field(9, chunk.offset)
+			e.endStruct()
+			e.endStruct()
+		}
+		e.i64Field(2, group.size)
+		e.i64Field(3, group.numValues)
+		e.endStruct()
+	}
+	e.stringField(6, createdBy)
+	e.endStruct()
+	return e.bytes()
+}
+
+// columnTypes returns the physical type and the converted type of the column type.
+func columnTypes(t ColumnType) (int32, int32) {
+	if t == Uint64Column {
+		return typeInt64, convertedUint64
+	}
+	return typeByteArray, convertedUTF8
+}
@@ -0,0 +1,157 @@
+package parquet
+
+import (
+	"bytes"
+	"encoding/binary"
+	"errors"
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+// compactDecoder decodes the thrift compact protocol into the maps of the field ids, it is used to check
+// the metadata written by Writer.
+type compactDecoder struct {
+	data []byte
+	pos  int
+}
+
+func (d *compactDecoder) varint() uint64 {
+	v, n := binary.Uvarint(d.data[d.pos:])
+	d.pos += n
+	return v
+}
+
+func (d *compactDecoder) value(typ byte) interface{} {
+	switch typ {
+	case compactI32, compactI64:
+		u := d.varint()
+		return int64(u>>1) ^ -int64(u&1)
+	case compactBinary:
+		n := int(d.varint())
+		v := string(d.data[d.pos : d.pos+n])
+		d.pos += n
+		return v
+	case compactList:
+		header := d.data[d.pos]
+		d.pos++
+		size, elemType := int(header>>4), header&0x0f
+		if size == 15 {
+			size = int(d.varint())
+		}
+		list := make([]interface{}, size)
+		for i := range list {
+			list[i] = d.value(elemType)
+		}
+		return list
+	case compactStruct:
+		fields := make(map[int16]interface{})
+		var last int16
+		for {
+			header := d.data[d.pos]
+			d.pos++
+			if header == 0 {
+				return fields
+			}
+			if header>>4 == 0 {
+				u := d.varint()
+				last = int16(int64(u>>1) ^ -int64(u&1))
+			} else {
+				last += int16(header >> 4)
+			}
+			fields[last] = d.value(header & 0x0f)
+		}
+	}
+	panic("unsupported type")
+}
+
+func decodeStruct(data []byte) (map[int16]interface{}, int) {
+	d := &compactDecoder{data: data}
+	return d.value(compactStruct).(map[int16]interface{}), d.pos
+}
+
+func TestWriter(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf, []Column{{Name: "day", Type: StringColumn}, {Name: "read_size", Type: Uint64Column}})
+	assert.Nil(t, w.Write([]interface{}{"2023-08-01", uint64(200)}))
+	assert.Nil(t, w.Write([]interface{}{"2023-08-02", uint64(1) << 40}))
+	assert.True(t, errors.Is(w.Write([]interface{}{"2023-08-03", 1}), ErrInvalidRow))
+	assert.Equal(t, ErrInvalidRow, w.Write([]interface{}{"2023-08-03"}))
+	assert.Nil(t, w.Close())
+	assert.Equal(t, ErrWriterClosed, w.Write([]interface{}{"2023-08-03", uint64(1)}))
+
+	data := buf.Bytes()
+	assert.Equal(t, magic, data[:4])
+	assert.Equal(t, magic, data[len(data)-4:])
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	metadata, n := decodeStruct(data[len(data)-8-size : len(data)-8])
+	assert.Equal(t, size, n)
+	assert.Equal(t, int64(1), metadata[1])
+	assert.Equal(t, int64(2), metadata[3])
+	assert.Equal(t, createdBy, metadata[6])
+
+	schema := metadata[2].([]interface{})
+	assert.Equal(t, 3, len(schema))
+	assert.Equal(t, int64(2), schema[0].(map[int16]interface{})[5])
+	assert.Equal(t, map[int16]interface{}{1: int64(typeByteArray), 3: int64(repetitionRequired), 4: "day",
+		6: int64(convertedUTF8)}, schema[1])
+	assert.Equal(t, map[int16]interface{}{1: int64(typeInt64), 3: int64(repetitionRequired), 4: "read_size",
+		6: int64(convertedUint64)}, schema[2])
+
+	groups := metadata[4].([]interface{})
+	assert.Equal(t, 1, len(groups))
+	group := groups[0].(map[int16]interface{})
+	assert.Equal(t, int64(2), group[3])
+	chunks := group[1].([]interface{})
+	assert.Equal(t, 2, len(chunks))
+	var values [][]byte
+	for i, name := range []string{"day", "read_size"} {
+		meta := chunks[i].(map[int16]interface{})[3].(map[int16]interface{})
+		assert.Equal(t, []interface{}{name}, meta[3])
+		assert.Equal(t, int64(2), meta[5])
+		offset := meta[9].(int64)
+		header, n := decodeStruct(data[offset:])
+		assert.Equal(t, meta[6], int64(n)+header[2].(int64))
+		assert.Equal(t, int64(2), header[5].(map[int16]interface{})[1])
+		values = append(values, data[int(offset)+n:int(offset)+n+int(header[2].(int64))])
+	}
+	assert.Equal(t, "\x0a\x00\x00\x002023-08-01\x0a\x00\x00\x002023-08-02", string(values[0]))
+	assert.Equal(t, uint64(200), binary.LittleEndian.Uint64(values[1][:8]))
+	assert.Equal(t, uint64(1)<<40, binary.LittleEndian.Uint64(values[1][8:]))
+}
+
+func TestWriter_Empty(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf, []Column{{Name: "day", Type: StringColumn}})
+	assert.Nil(t, w.Close())
+	data := buf.Bytes()
+	assert.Equal(t, magic, data[:4])
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	assert.Equal(t, len(data), 4+size+8)
+	metadata, _ := decodeStruct(data[4 : 4+size])
+	assert.Equal(t, int64(0), metadata[3])
+	assert.Equal(t, []interface{}{}, metadata[4])
+}
+
+func TestWriter_RowGroups(t *testing.T) {
+	buf := &bytes.Buffer{}
+	w := NewWriter(buf, []Column{{Name: "read_size", Type: Uint64Column}})
+	for i := 0; i <= DefaultRowGroupSize; i++ {
+		assert.Nil(t, w.Write([]interface{}{uint64(i)}))
+	}
+	assert.Nil(t, w.Close())
+	data := buf.Bytes()
+	size := int(binary.LittleEndian.Uint32(data[len(data)-8:]))
+	metadata, _ := decodeStruct(data[len(data)-8-size : len(data)-8])
+	assert.Equal(t, int64(DefaultRowGroupSize+1), metadata[3])
+	groups := metadata[4].([]interface{})
+	assert.Equal(t, 2, len(groups))
+	assert.Equal(t, int64(DefaultRowGroupSize), groups[0].(map[int16]interface{})[3])
+	assert.Equal(t, int64(1), groups[1].(map[int16]interface{})[3])
+
+	// the last value is in the page of the second row group
+	meta := groups[1].(map[int16]interface{})[1].([]interface{})[0].(map[int16]interface{})[3].(map[int16]interface{})
+	offset := meta[9].(int64)
+	_, n := decodeStruct(data[offset:])
+	assert.Equal(t, uint64(DefaultRowGroupSize), binary.LittleEndian.Uint64(data[int(offset)+n:]))
+}
@@ -0,0 +1,194 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracehttp"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+
+	coremodule "github.com/bnb-chain/greenfield-storage-provider/core/module"
+	corercmgr "github.com/bnb-chain/greenfield-storage-provider/core/rcmgr"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// ServiceName defines the service name of the spans exported by sp.
+	ServiceName = "greenfield-storage-provider"
+	// InstrumentationName defines the name of the tracer that starts the spans of sp.
+	InstrumentationName = "github.com/bnb-chain/greenfield-storage-provider"
+	// StdoutExporterPath defines the exporter path that writes the spans to the standard output.
+	StdoutExporterPath = "stdout"
+	// DefaultSampleRatio defines the default ratio of the traces started by sp that are sampled.
+	DefaultSampleRatio = 1.0
+)
+
+var (
+	TracingModularName = strings.ToLower("Tracing")
+)
+
+// The attribute keys of the spans started by sp.
+const (
+	TaskKey     = attribute.Key("gfsp.task.key")
+	PieceKey    = attribute.Key("gfsp.piece.key")
+	PieceSize   = attribute.Key("gfsp.piece.size")
+	Bucket      = attribute.Key("gfsp.bucket")
+	Object      = attribute.Key("gfsp.object")
+	SignScope   = attribute.Key("gfsp.sign.scope")
+	TxMsgs      = attribute.Key("gfsp.tx.msgs")
+	TxHash      = attribute.Key("gfsp.tx.hash")
+	TxBatchSize = attribute.Key("gfsp.tx.batch_size")
+)
+
+// Config defines the configuration of the distributed tracing.
+type Config struct {
+	// Enable enables the distributed tracing, at least one exporter is required if it is enabled
+	Enable bool `comment:"optional"`
+	// OTLPEndpoint is the OTLP/HTTP endpoint of the collector that the spans are exported to, e.g. localhost:4318
+	OTLPEndpoint string `comment:"optional"`
+	// OTLPInsecure exports the spans to the collector over HTTP instead of HTTPS
+	OTLPInsecure bool `comment:"optional"`
+	// FileExporterPath is the file that the spans are appended to in JSON, stdout writes the spans to the
+	// standard output
+	FileExporterPath string `comment:"optional"`
+	// SampleRatio is the ratio of the traces started by this sp that are sampled, the traces started by the
+	// callers follow the sampling decisions of the callers, default 1
+	SampleRatio float64 `comment:"optional"`
+	// TrustRemoteContext continues the traces of the HTTP requests carrying the W3C trace context headers,
+	// it should be enabled only if the gateway is behind a trusted proxy, otherwise the requests start new
+	// traces linked to the remote contexts, so the clients cannot join or force sampling the traces of sp
+	TrustRemoteContext bool `comment:"optional"`
+}
+
+var _ coremodule.Modular = &Tracing{}
+
+// Tracing owns the tracer provider that exports the spans of sp, the spans of the not sampled or the not
+// enabled tracing are no-op.
+type Tracing struct {
+	provider *sdktrace.TracerProvider
+	file     io.Closer
+}
+
+// NewTracing returns an instance of tracing, and installs the tracer provider and the W3C trace context
+// propagator globally.
+func NewTracing(cfg Config, instanceID string) (*Tracing, error) {
+	if cfg.SampleRatio < 0 || cfg.SampleRatio > 1 {
+		return nil, fmt.Errorf("invalid trace sample ratio %v, it should be in [0, 1]", cfg.SampleRatio)
+	}
+	if cfg.SampleRatio == 0 {
+		cfg.SampleRatio = DefaultSampleRatio
+	}
+	if cfg.OTLPEndpoint == "" && cfg.FileExporterPath == "" {
+		return nil, errors.New("no trace exporter is configured, OTLPEndpoint or FileExporterPath is required")
+	}
+	t := &Tracing{}
+	opts := []sdktrace.TracerProviderOption{
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(cfg.SampleRatio))),
+		sdktrace.WithResource(resource.NewWithAttributes(semconv.SchemaURL,
+			semconv.ServiceName(ServiceName), semconv.ServiceInstanceID(instanceID))),
+	}
+	if cfg.OTLPEndpoint != "" {
+		otlpOpts := []otlptracehttp.Option{otlptracehttp.WithEndpoint(cfg.OTLPEndpoint)}
+		if cfg.OTLPInsecure {
+			otlpOpts = append(otlpOpts, otlptracehttp.WithInsecure())
+		}
+		exporter, err := otlptracehttp.New(context.Background(), otlpOpts...)
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	if cfg.FileExporterPath != "" {
+		var w io.Writer = os.Stdout
+		if cfg.FileExporterPath != StdoutExporterPath {
+			f, err := os.OpenFile(cfg.FileExporterPath, os.O_CREATE|os.O_APPEND|os.O_WRONLY, 0644)
+			if err != nil {
+				return nil, err
+			}
+			w, t.file = f, f
+		}
+		exporter, err := stdouttrace.New(stdouttrace.WithWriter(w))
+		if err != nil {
+			return nil, err
+		}
+		opts = append(opts, sdktrace.WithBatcher(exporter))
+	}
+	t.provider = sdktrace.NewTracerProvider(opts...)
+	otel.SetTracerProvider(t.provider)
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+	trustRemoteContext.Store(cfg.TrustRemoteContext)
+	return t, nil
+}
+
+// Name describes tracing service name
+func (t *Tracing) Name() string {
+	return TracingModularName
+}
+
+// Start does nothing, the spans are exported in the background once the tracer provider is installed.
+func (t *Tracing) Start(ctx context.Context) error {
+	log.Infow("tracing startup")
+	return nil
+}
+
+// Stop flushes the ended spans and shuts down the exporters.
+func (t *Tracing) Stop(ctx context.Context) error {
+	var errs []error
+	if err := t.provider.Shutdown(ctx); err != nil {
+		errs = append(errs, err)
+	}
+	if t.file != nil {
+		if err := t.file.Close(); err != nil {
+			errs = append(errs, err)
+		}
+	}
+	if errs != nil {
+		return fmt.Errorf("%v", errs)
+	}
+	return nil
+}
+
+func (t *Tracing) ReserveResource(ctx context.Context, state *corercmgr.ScopeStat) (corercmgr.ResourceScopeSpan, error) {
+	return &corercmgr.NullScope{}, nil
+}
+
+func (t *Tracing) ReleaseResource(ctx context.Context, scope corercmgr.ResourceScopeSpan) {
+	scope.Done()
+}
+
+// Tracer returns the tracer of sp, the spans are no-op until the tracing is enabled.
+func Tracer() trace.Tracer {
+	return otel.Tracer(InstrumentationName)
+}
+
+// StartSpan starts an internal span as the child of the span in ctx.
+func StartSpan(ctx context.Context, name string, attrs ...attribute.KeyValue) (context.Context, trace.Span) {
+	return Tracer().Start(ctx, name, trace.WithAttributes(attrs...))
+}
+
+// EndSpan records the err to the span if it is not nil, and ends the span.
+func EndSpan(span trace.Span, err error) {
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, err.Error())
+	}
+	span.End()
+}
+
+// DetachedContext returns a context that carries the span of ctx but is not canceled with ctx, it continues
+// the trace in the work whose lifetime is not bound to ctx.
+func DetachedContext(ctx context.Context) context.Context {
+	return trace.ContextWithSpanContext(context.Background(), trace.SpanContextFromContext(ctx))
+}
@@ -0,0 +1,145 @@
+package tracing
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/gorilla/mux"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+)
+
+func setupTracingTest(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	return recorder
+}
+
+func TestNewTracing(t *testing.T) {
+	_, err := NewTracing(Config{Enable: true}, "gfsp")
+	assert.NotNil(t, err)
+	_, err = NewTracing(Config{Enable: true, FileExporterPath: StdoutExporterPath, SampleRatio: 2}, "gfsp")
+	assert.NotNil(t, err)
+
+	path := filepath.Join(t.TempDir(), "spans.json")
+	tr, err := NewTracing(Config{Enable: true, FileExporterPath: path}, "gfsp")
+	assert.Nil(t, err)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+	assert.Equal(t, TracingModularName, tr.Name())
+	_, span := StartSpan(context.Background(), "test", TaskKey.String("task"))
+	EndSpan(span, nil)
+	assert.Nil(t, tr.Stop(context.Background()))
+
+	data, err := os.ReadFile(path)
+	assert.Nil(t, err)
+	assert.Contains(t, string(data), `"Name":"test"`)
+	assert.Contains(t, string(data), "task")
+}
+
+func TestEndSpan(t *testing.T) {
+	recorder := setupTracingTest(t)
+	_, span := StartSpan(context.Background(), "failed")
+	EndSpan(span, errors.New("mock error"))
+	spans := recorder.Ended()
+	assert.Equal(t, 1, len(spans))
+	assert.Equal(t, codes.Error, spans[0].Status().Code)
+	assert.Equal(t, "mock error", spans[0].Status().Description)
+}
+
+func TestDetachedContext(t *testing.T) {
+	setupTracingTest(t)
+	ctx, span := StartSpan(context.Background(), "parent")
+	defer span.End()
+	ctx, cancel := context.WithCancel(ctx)
+	detached := DetachedContext(ctx)
+	cancel()
+	assert.Nil(t, detached.Err())
+	assert.Equal(t, span.SpanContext(), trace.SpanContextFromContext(detached))
+}
+
+func TestHTTPServerMiddlewareAndTransport(t *testing.T) {
+	recorder := setupTracingTest(t)
+	trustRemoteContext.Store(true)
+	defer trustRemoteContext.Store(false)
+	router := mux.NewRouter()
+	router.Use(HTTPServerMiddleware)
+	router.HandleFunc("/object", func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(http.StatusInternalServerError)
+	}).Name("getObject")
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx, span := StartSpan(context.Background(), "caller")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/object", nil)
+	assert.Nil(t, err)
+	resp, err := (&http.Client{Transport: NewTransport(nil)}).Do(req)
+	assert.Nil(t, err)
+	_ = resp.Body.Close()
+	span.End()
+
+	spans := recorder.Ended()
+	assert.Equal(t, 3, len(spans))
+	byName := make(map[string]sdktrace.ReadOnlySpan)
+	for _, s := range spans {
+		byName[s.Name()] = s
+	}
+	srvSpan, cliSpan := byName["getObject"], byName["HTTP GET"]
+	assert.NotNil(t, srvSpan)
+	assert.NotNil(t, cliSpan)
+	assert.Equal(t, span.SpanContext().TraceID(), srvSpan.SpanContext().TraceID())
+	assert.Equal(t, cliSpan.SpanContext().SpanID(), srvSpan.Parent().SpanID())
+	assert.Equal(t, span.SpanContext().SpanID(), cliSpan.Parent().SpanID())
+	assert.Equal(t, trace.SpanKindServer, srvSpan.SpanKind())
+	assert.Equal(t, codes.Error, srvSpan.Status().Code)
+}
+
+func TestHTTPServerMiddlewareUntrustedRemoteContext(t *testing.T) {
+	recorder := setupTracingTest(t)
+	router := mux.NewRouter()
+	router.Use(HTTPServerMiddleware)
+	router.HandleFunc("/object", func(w http.ResponseWriter, r *http.Request) {}).Name("getObject")
+	server := httptest.NewServer(router)
+	defer server.Close()
+
+	ctx, span := StartSpan(context.Background(), "caller")
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, server.URL+"/object", nil)
+	assert.Nil(t, err)
+	resp, err := (&http.Client{Transport: NewTransport(nil)}).Do(req)
+	assert.Nil(t, err)
+	_ = resp.Body.Close()
+	span.End()
+
+	var srvSpan, cliSpan sdktrace.ReadOnlySpan
+	for _, s := range recorder.Ended() {
+		switch s.Name() {
+		case "getObject":
+			srvSpan = s
+		case "HTTP GET":
+			cliSpan = s
+		}
+	}
+	assert.NotNil(t, srvSpan)
+	assert.NotNil(t, cliSpan)
+	// the request of the untrusted caller starts a new trace linked to the caller
+	assert.NotEqual(t, span.SpanContext().TraceID(), srvSpan.SpanContext().TraceID())
+	assert.False(t, srvSpan.Parent().IsValid())
+	assert.Equal(t, 1, len(srvSpan.Links()))
+	assert.Equal(t, cliSpan.SpanContext().SpanID(), srvSpan.Links()[0].SpanContext.SpanID())
+}
@@ -305,11 +305,12 @@ func (b *BsDBImpl) ListBucketsByVgfID(vgfIDs []uint32, startAfter common.Hash, l
 		}
 	}()
 
-	filters = append(filters, ObjectIDStartAfterFilter(startAfter), RemovedFilter(false), WithLimit(limit))
+	filters = append(filters, BucketIDStartAfterFilter(startAfter), RemovedFilter(false), WithLimit(limit))
 	err = b.db.Table((&Bucket{}).TableName()).
 		Select("*").
 		Where("global_virtual_group_family_id in (?)", vgfIDs).
 		Scopes(filters...).
+		Order("bucket_id").
 		Find(&buckets).Error
 	return buckets, err
 }
@@ -0,0 +1,76 @@
+package bsdb
+
+import (
+	"time"
+)
+
+// ListCacheInvalidations list the cache invalidations whose id is greater than startAfterID in the order of id
+func (b *BsDBImpl) ListCacheInvalidations(startAfterID uint64, limit int) ([]*CacheInvalidation, error) {
+	var (
+		invalidations []*CacheInvalidation
+		err           error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Table((&CacheInvalidation{}).TableName()).
+		Select("*").
+		Where("id > ?", startAfterID).
+		Order("id").
+		Limit(limit).
+		Find(&invalidations).Error
+	return invalidations, err
+}
+
+// GetLatestCacheInvalidationID get the id of the latest cache invalidation, 0 if there is none
+func (b *BsDBImpl) GetLatestCacheInvalidationID() (uint64, error) {
+	var (
+		invalidationID uint64
+		err            error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Table((&CacheInvalidation{}).TableName()).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&invalidationID).Error
+	return invalidationID, err
+}
+
+// DeleteCacheInvalidations delete at most limit cache invalidations up to the end id created before the time
+func (b *BsDBImpl) DeleteCacheInvalidations(endID uint64, createTimeBefore int64, limit int) (int64, error) {
+	var (
+		deleted int64
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	result := b.db.Table((&CacheInvalidation{}).TableName()).
+		Where("id <= ? AND create_time < ?", endID, createTimeBefore).
+		Limit(limit).
+		Delete(&CacheInvalidation{})
+	deleted, err = result.RowsAffected, result.Error
+	return deleted, err
+}
@@ -0,0 +1,40 @@
+package bsdb
+
+import (
+	"github.com/forbole/juno/v4/common"
+)
+
+// define the kinds of the cache invalidations
+const (
+	// CacheInvalidationBucket invalidates the bucket by the bucket name and the bucket id
+	CacheInvalidationBucket = "bucket"
+	// CacheInvalidationObject invalidates the object by the bucket name, the object name and the object id
+	CacheInvalidationObject = "object"
+	// CacheInvalidationGroupMember invalidates the membership of the account in the group, or all the memberships
+	// of the group if the account is empty
+	CacheInvalidationGroupMember = "group_member"
+	// CacheInvalidationPolicy invalidates the policies of the resource, or the policy of the policy id if the
+	// resource is empty
+	CacheInvalidationPolicy = "policy"
+)
+
+// CacheInvalidation is recorded as the block syncer commits the events that change the records cached by the
+// metadata service, the id increases in the order of the blocks
+type CacheInvalidation struct {
+	ID           uint64         `gorm:"column:id;primaryKey"`
+	Kind         string         `gorm:"column:kind;type:varchar(16)"`
+	BucketName   string         `gorm:"column:bucket_name;type:varchar(64)"`
+	ObjectName   string         `gorm:"column:object_name;type:varchar(1024)"`
+	ResourceType string         `gorm:"column:resource_type;type:varchar(32)"`
+	ResourceID   common.Hash    `gorm:"column:resource_id;type:BINARY(32)"` // the id of the bucket, object, group or the resource of the policy
+	PolicyID     common.Hash    `gorm:"column:policy_id;type:BINARY(32)"`
+	Account      common.Address `gorm:"column:account;type:BINARY(20)"`
+
+	CreateAt   int64 `gorm:"column:create_at"`
+	CreateTime int64 `gorm:"column:create_time"` // seconds
+}
+
+// TableName is used to set CacheInvalidation table name in database
+func (*CacheInvalidation) TableName() string {
+	return CacheInvalidationTableName
+}
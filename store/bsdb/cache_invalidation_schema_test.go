@@ -0,0 +1,13 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCacheInvalidation_TableName(t *testing.T) {
+	invalidation := CacheInvalidation{Kind: CacheInvalidationObject}
+	name := invalidation.TableName()
+	assert.Equal(t, CacheInvalidationTableName, name)
+}
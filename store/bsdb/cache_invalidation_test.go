@@ -0,0 +1,71 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockListCacheInvalidationsSQL       = "SELECT * FROM `cache_invalidations` WHERE id > ? ORDER BY id LIMIT 10"
+	mockGetLatestCacheInvalidationIDSQL = "SELECT COALESCE(MAX(id), 0) FROM `cache_invalidations`"
+	mockDeleteCacheInvalidationsSQL     = "DELETE FROM `cache_invalidations` WHERE id <= ? AND create_time < ? LIMIT 10"
+	mockCacheInvalidationBucketName     = "mock-bucket"
+	mockCacheInvalidationObjectName     = "mock-object"
+	mockCacheInvalidationStartAfterID   = uint64(100)
+	mockCacheInvalidationLatestID       = uint64(200)
+	mockCacheInvalidationListLimit      = 10
+)
+
+func TestBsDBImpl_ListCacheInvalidationsSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	objectID := common.HexToHash("0x01")
+	mock.ExpectQuery(mockListCacheInvalidationsSQL).WithArgs(mockCacheInvalidationStartAfterID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "kind", "bucket_name", "object_name", "resource_id"}).
+			AddRow(101, CacheInvalidationObject, mockCacheInvalidationBucketName, mockCacheInvalidationObjectName,
+				objectID.Bytes()))
+	invalidations, err := s.ListCacheInvalidations(mockCacheInvalidationStartAfterID, mockCacheInvalidationListLimit)
+	assert.Nil(t, err)
+	assert.Equal(t, []*CacheInvalidation{{ID: 101, Kind: CacheInvalidationObject, BucketName: mockCacheInvalidationBucketName,
+		ObjectName: mockCacheInvalidationObjectName, ResourceID: objectID}}, invalidations)
+}
+
+func TestBsDBImpl_ListCacheInvalidationsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListCacheInvalidationsSQL).WithArgs(mockCacheInvalidationStartAfterID).
+		WillReturnError(mockDBInternalError)
+	_, err := s.ListCacheInvalidations(mockCacheInvalidationStartAfterID, mockCacheInvalidationListLimit)
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestBsDBImpl_GetLatestCacheInvalidationID(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockGetLatestCacheInvalidationIDSQL).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockCacheInvalidationLatestID))
+	invalidationID, err := s.GetLatestCacheInvalidationID()
+	assert.Nil(t, err)
+	assert.Equal(t, mockCacheInvalidationLatestID, invalidationID)
+
+	mock.ExpectQuery(mockGetLatestCacheInvalidationIDSQL).WillReturnError(mockDBInternalError)
+	_, err = s.GetLatestCacheInvalidationID()
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestBsDBImpl_DeleteCacheInvalidations(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockDeleteCacheInvalidationsSQL).WithArgs(mockCacheInvalidationLatestID, int64(1000)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	deleted, err := s.DeleteCacheInvalidations(mockCacheInvalidationLatestID, 1000, mockCacheInvalidationListLimit)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockDeleteCacheInvalidationsSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	_, err = s.DeleteCacheInvalidations(mockCacheInvalidationLatestID, 1000, mockCacheInvalidationListLimit)
+	assert.Equal(t, mockDBInternalError, err)
+}
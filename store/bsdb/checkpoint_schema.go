@@ -0,0 +1,23 @@
+package bsdb
+
+// Checkpoint is the structure for the block syncer checkpoint, a checkpoint is a consistent copy of the block
+// syncer tables taken right after the block of the height is committed.
+type Checkpoint struct {
+	// ID defines db auto_increment id of checkpoint
+	ID uint64 `gorm:"column:id;primaryKey;autoIncrement"`
+	// Height defines the block height of the checkpoint
+	Height int64 `gorm:"column:height;uniqueIndex:idx_height"`
+	// BlockHash defines the hash of the block at the height, it is used to check the checkpoint against the chain
+	BlockHash string `gorm:"column:block_hash;type:varchar(66)"`
+	// SchemaName defines the name of the schema that the tables are copied into
+	SchemaName string `gorm:"column:schema_name;type:varchar(64)"`
+	// TableCount defines the number of the copied tables
+	TableCount int `gorm:"column:table_count"`
+	// CreateTime defines the timestamp when the checkpoint created
+	CreateTime int64 `gorm:"column:create_time"`
+}
+
+// TableName is used to set Checkpoint table name in database
+func (c *Checkpoint) TableName() string {
+	return CheckpointTableName
+}
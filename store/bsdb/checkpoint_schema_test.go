@@ -0,0 +1,13 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestCheckpoint_TableName(t *testing.T) {
+	checkpoint := Checkpoint{Height: 100}
+	name := checkpoint.TableName()
+	assert.Equal(t, CheckpointTableName, name)
+}
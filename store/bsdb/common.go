@@ -19,6 +19,13 @@ type ListObjectsResult struct {
 	*Object
 }
 
+// ObjectPrefixSummary represents the object count and the total payload size of the objects under a prefix.
+type ObjectPrefixSummary struct {
+	Prefix      string
+	ObjectCount uint64
+	TotalSize   uint64
+}
+
 // ActionTypeMap db action value is a bitmap, traverse this map to get the corresponding action list
 var ActionTypeMap = map[permtypes.ActionType]int{
 	permtypes.ACTION_TYPE_ALL:              0,
@@ -20,8 +20,29 @@ const (
 	LisPoliciesDefaultLimit = 50
 	// LisPoliciesLimitSize defines the max limit of policies response
 	LisPoliciesLimitSize = 1000
+	// ListNotificationEventsLimitSize defines the max limit of ListNotificationEvents response
+	ListNotificationEventsLimitSize = 1000
+	// ListCacheInvalidationsLimitSize defines the max limit of ListCacheInvalidations response
+	ListCacheInvalidationsLimitSize = 1000
 )
 
+// define the sort keys of listing objects
+const (
+	// ListObjectsSortByName sorts the objects by the object name
+	ListObjectsSortByName = "name"
+	// ListObjectsSortBySize sorts the objects by the payload size
+	ListObjectsSortBySize = "size"
+	// ListObjectsSortByCreateTime sorts the objects by the create time
+	ListObjectsSortByCreateTime = "create_time"
+)
+
+// ListObjectsSortColumns maps the sort keys of listing objects to the columns of the object tables
+var ListObjectsSortColumns = map[string]string{
+	ListObjectsSortByName:       "object_name",
+	ListObjectsSortBySize:       "payload_size",
+	ListObjectsSortByCreateTime: "create_time",
+}
+
 // define table name constant of block syncer db
 const (
 	// BucketTableName defines the name of bucket table
@@ -74,6 +95,12 @@ const (
 	StreamRecordTableName = "stream_records"
 	// PaymentAccountTableName defines payment account info
 	PaymentAccountTableName = "payment_accounts"
+	// NotificationEventTableName defines the name of notification event table
+	NotificationEventTableName = "notification_events"
+	// CacheInvalidationTableName defines the name of cache invalidation table
+	CacheInvalidationTableName = "cache_invalidations"
+	// CheckpointTableName defines the name of block syncer checkpoint table
+	CheckpointTableName = "block_syncer_checkpoints"
 )
 
 // define the list objects const
@@ -37,6 +37,10 @@ type Metadata interface {
 	GetGroupsByGroupIDAndAccount(groupIDList []common.Hash, account common.Address, includeRemoved bool) ([]*Group, error)
 	// ListObjectsByBucketName list objects info by a bucket name
 	ListObjectsByBucketName(bucketName, continuationToken, prefix, delimiter string, maxKeys int, includeRemoved bool) ([]*ListObjectsResult, error)
+	// ListObjectsWithFilters list the objects of a bucket that match the filters, sorted by the sort key
+	ListObjectsWithFilters(bucketName, sortBy string, sortDesc bool, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error)
+	// SummarizeObjectsByPrefix get the object count and the total payload size of the objects per common prefix
+	SummarizeObjectsByPrefix(bucketName, prefix, delimiter, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*ObjectPrefixSummary, error)
 	// ListDeletedObjectsByBlockNumberRange list deleted objects info by a block number range
 	ListDeletedObjectsByBlockNumberRange(startBlockNumber uint64, endBlockNumber uint64, includePrivate bool) ([]*Object, error)
 	// ListExpiredBucketsBySp list expired buckets by sp
@@ -147,6 +151,18 @@ type Metadata interface {
 	GetBsDBDataStatistics(blockHeight uint64) (*DataStat, error)
 	// GetObjectCount get object count by update_at
 	GetObjectCount(blockHeight int64, objectStatus string) ([]int64, error)
+	// ListNotificationEvents list the notification events after the start after id
+	ListNotificationEvents(startAfterID uint64, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*NotificationEvent, error)
+	// GetLatestNotificationEventID get the id of the latest notification event
+	GetLatestNotificationEventID() (uint64, error)
+	// DeleteNotificationEvents delete the notification events up to the end id created before the time
+	DeleteNotificationEvents(endID uint64, createTimeBefore int64, limit int) (int64, error)
+	// ListCacheInvalidations list the cache invalidations after the start after id
+	ListCacheInvalidations(startAfterID uint64, limit int) ([]*CacheInvalidation, error)
+	// GetLatestCacheInvalidationID get the id of the latest cache invalidation
+	GetLatestCacheInvalidationID() (uint64, error)
+	// DeleteCacheInvalidations delete the cache invalidations up to the end id created before the time
+	DeleteCacheInvalidations(endID uint64, createTimeBefore int64, limit int) (int64, error)
 }
 
 // BSDB contains all the methods required by block syncer database
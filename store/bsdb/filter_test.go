@@ -150,3 +150,67 @@ func TestWithLimit(t *testing.T) {
 	db.db.Table(ObjectTableName).Scopes(WithLimit(limit)).Find(&[]struct{}{})
 	assert.NoError(t, mock.ExpectationsWereMet())
 }
+
+func TestBucketNameFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `notification_events` WHERE bucket_name = ?"
+	mock.ExpectQuery(expectedSQL).WithArgs("bucket").WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(NotificationEventTableName).Scopes(BucketNameFilter("bucket")).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventTypesFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `notification_events` WHERE event_type IN (?,?)"
+	mock.ExpectQuery(expectedSQL).WithArgs(NotificationEventObjectCreated, NotificationEventObjectSealed).
+		WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(NotificationEventTableName).
+		Scopes(EventTypesFilter([]string{NotificationEventObjectCreated, NotificationEventObjectSealed})).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestObjectAttributeFilters(t *testing.T) {
+	db, mock := setupDB(t)
+	creator := common.HexToAddress("0x11")
+
+	expectedSQL := "SELECT * FROM `objects` WHERE payload_size <= ? AND create_time >= ? AND create_time <= ? AND " +
+		"content_type = ? AND status = ? AND visibility = ? AND creator = ?"
+	mock.ExpectQuery(expectedSQL).WithArgs(uint64(1024), int64(100), int64(200), "image/png", "OBJECT_STATUS_SEALED",
+		"VISIBILITY_TYPE_PRIVATE", creator).WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(ObjectTableName).Scopes(MaxPayloadSizeFilter(1024), CreateTimeStartFilter(100), CreateTimeEndFilter(200),
+		ContentTypeFilter("image/png"), ObjectStatusFilter("OBJECT_STATUS_SEALED"), VisibilityFilter("VISIBILITY_TYPE_PRIVATE"),
+		CreatorFilter(creator)).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestSortedContinuationTokenFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	mock.ExpectQuery("SELECT * FROM `objects` WHERE object_name >= ?").WithArgs("a.txt").
+		WillReturnRows(sqlmock.NewRows([]string{}))
+	db.db.Table(ObjectTableName).Scopes(SortedContinuationTokenFilter(ListObjectsSortByName, false, 0, "a.txt")).Find(&[]struct{}{})
+
+	mock.ExpectQuery("SELECT * FROM `objects` WHERE object_name <= ?").WithArgs("a.txt").
+		WillReturnRows(sqlmock.NewRows([]string{}))
+	db.db.Table(ObjectTableName).Scopes(SortedContinuationTokenFilter(ListObjectsSortByName, true, 0, "a.txt")).Find(&[]struct{}{})
+
+	mock.ExpectQuery("SELECT * FROM `objects` WHERE create_time > ? OR (create_time = ? AND object_name >= ?)").
+		WithArgs(uint64(100), uint64(100), "a.txt").WillReturnRows(sqlmock.NewRows([]string{}))
+	db.db.Table(ObjectTableName).Scopes(SortedContinuationTokenFilter(ListObjectsSortByCreateTime, false, 100, "a.txt")).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
+
+func TestEventIDUpToFilter(t *testing.T) {
+	db, mock := setupDB(t)
+
+	expectedSQL := "SELECT * FROM `notification_events` WHERE id <= ?"
+	mock.ExpectQuery(expectedSQL).WithArgs(uint64(100)).WillReturnRows(sqlmock.NewRows([]string{}))
+
+	db.db.Table(NotificationEventTableName).Scopes(EventIDUpToFilter(100)).Find(&[]struct{}{})
+	assert.NoError(t, mock.ExpectationsWereMet())
+}
@@ -1,6 +1,8 @@
 package bsdb
 
 import (
+	"fmt"
+
 	"github.com/forbole/juno/v4/common"
 	"gorm.io/gorm"
 )
@@ -91,3 +93,89 @@ func WithLimit(limit int) func(*gorm.DB) *gorm.DB {
 		return db.Limit(limit)
 	}
 }
+
+func BucketNameFilter(bucketName string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("bucket_name = ?", bucketName)
+	}
+}
+
+func EventTypesFilter(eventTypes []string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("event_type IN ?", eventTypes)
+	}
+}
+
+func MinPayloadSizeFilter(payloadSize uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("payload_size >= ?", payloadSize)
+	}
+}
+
+func MaxPayloadSizeFilter(payloadSize uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("payload_size <= ?", payloadSize)
+	}
+}
+
+func CreateTimeStartFilter(createTime int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("create_time >= ?", createTime)
+	}
+}
+
+func CreateTimeEndFilter(createTime int64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("create_time <= ?", createTime)
+	}
+}
+
+func ContentTypeFilter(contentType string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("content_type = ?", contentType)
+	}
+}
+
+func ObjectStatusFilter(status string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("status = ?", status)
+	}
+}
+
+func VisibilityFilter(visibility string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("visibility = ?", visibility)
+	}
+}
+
+func CreatorFilter(creator common.Address) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("creator = ?", creator)
+	}
+}
+
+// SortedContinuationTokenFilter continues listing the objects sorted by the sort key from the object of the sort
+// value and the object name, the objects of the same sort value are sorted by the object name in ascending order.
+func SortedContinuationTokenFilter(sortBy string, sortDesc bool, sortValue uint64, objectName string) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		column, ok := ListObjectsSortColumns[sortBy]
+		if !ok || sortBy == ListObjectsSortByName {
+			if sortDesc {
+				return db.Where("object_name <= ?", objectName)
+			}
+			return db.Where("object_name >= ?", objectName)
+		}
+		operator := ">"
+		if sortDesc {
+			operator = "<"
+		}
+		return db.Where(fmt.Sprintf("%s %s ? OR (%s = ? AND object_name >= ?)", column, operator, column),
+			sortValue, sortValue, objectName)
+	}
+}
+
+func EventIDUpToFilter(endID uint64) func(db *gorm.DB) *gorm.DB {
+	return func(db *gorm.DB) *gorm.DB {
+		return db.Where("id <= ?", endID)
+	}
+}
@@ -0,0 +1,80 @@
+package bsdb
+
+import (
+	"time"
+
+	"gorm.io/gorm"
+)
+
+// ListNotificationEvents list the notification events whose id is greater than startAfterID in the order of id
+func (b *BsDBImpl) ListNotificationEvents(startAfterID uint64, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*NotificationEvent, error) {
+	var (
+		events []*NotificationEvent
+		err    error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Table((&NotificationEvent{}).TableName()).
+		Select("*").
+		Where("id > ?", startAfterID).
+		Scopes(filters...).
+		Order("id").
+		Limit(limit).
+		Find(&events).Error
+	return events, err
+}
+
+// GetLatestNotificationEventID get the id of the latest notification event, 0 if there is none
+func (b *BsDBImpl) GetLatestNotificationEventID() (uint64, error) {
+	var (
+		eventID uint64
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	err = b.db.Table((&NotificationEvent{}).TableName()).
+		Select("COALESCE(MAX(id), 0)").
+		Scan(&eventID).Error
+	return eventID, err
+}
+
+// DeleteNotificationEvents delete at most limit notification events whose id is not greater than endID and that are
+// created before createTimeBefore, and return the number of the deleted events
+func (b *BsDBImpl) DeleteNotificationEvents(endID uint64, createTimeBefore int64, limit int) (int64, error) {
+	var (
+		deleted int64
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	result := b.db.Table((&NotificationEvent{}).TableName()).
+		Where("id <= ? AND create_time < ?", endID, createTimeBefore).
+		Limit(limit).
+		Delete(&NotificationEvent{})
+	deleted, err = result.RowsAffected, result.Error
+	return deleted, err
+}
@@ -0,0 +1,54 @@
+package bsdb
+
+import (
+	"github.com/forbole/juno/v4/common"
+)
+
+// define the event types of the notification events
+const (
+	// NotificationEventObjectCreated defines the event type of creating an object
+	NotificationEventObjectCreated = "ObjectCreated"
+	// NotificationEventObjectSealed defines the event type of sealing an object
+	NotificationEventObjectSealed = "ObjectSealed"
+	// NotificationEventObjectSealRejected defines the event type of rejecting to seal an object
+	NotificationEventObjectSealRejected = "ObjectSealRejected"
+	// NotificationEventObjectCreateCanceled defines the event type of canceling to create an object
+	NotificationEventObjectCreateCanceled = "ObjectCreateCanceled"
+	// NotificationEventObjectDeleted defines the event type of deleting an object
+	NotificationEventObjectDeleted = "ObjectDeleted"
+	// NotificationEventBucketMigrationStarted defines the event type of starting to migrate a bucket
+	NotificationEventBucketMigrationStarted = "BucketMigrationStarted"
+	// NotificationEventBucketMigrationCompleted defines the event type of completing to migrate a bucket
+	NotificationEventBucketMigrationCompleted = "BucketMigrationCompleted"
+)
+
+// NotificationEventTypes is the event types that can be subscribed
+var NotificationEventTypes = map[string]bool{
+	NotificationEventObjectCreated:            true,
+	NotificationEventObjectSealed:             true,
+	NotificationEventObjectSealRejected:       true,
+	NotificationEventObjectCreateCanceled:     true,
+	NotificationEventObjectDeleted:            true,
+	NotificationEventBucketMigrationStarted:   true,
+	NotificationEventBucketMigrationCompleted: true,
+}
+
+// NotificationEvent is the object lifecycle event recorded for the notification subscribers, the id increases
+// in the order of the blocks, the object fields are empty for the bucket events
+type NotificationEvent struct {
+	ID         uint64         `gorm:"column:id;primaryKey;index:idx_bucket_name_id,priority:2"`
+	EventType  string         `gorm:"column:event_type;type:varchar(32)"`
+	BucketName string         `gorm:"column:bucket_name;type:varchar(64);index:idx_bucket_name_id,priority:1"`
+	ObjectName string         `gorm:"column:object_name;type:varchar(1024)"`
+	ObjectID   common.Hash    `gorm:"column:object_id;type:BINARY(32)"`
+	Operator   common.Address `gorm:"column:operator;type:BINARY(20)"`
+
+	CreateAt     int64       `gorm:"column:create_at"`
+	CreateTxHash common.Hash `gorm:"column:create_tx_hash;type:BINARY(32);not null"`
+	CreateTime   int64       `gorm:"column:create_time"` // seconds
+}
+
+// TableName is used to set NotificationEvent table name in database
+func (*NotificationEvent) TableName() string {
+	return NotificationEventTableName
+}
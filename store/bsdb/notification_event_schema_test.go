@@ -0,0 +1,13 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationEvent_TableName(t *testing.T) {
+	event := NotificationEvent{EventType: NotificationEventObjectSealed}
+	name := event.TableName()
+	assert.Equal(t, NotificationEventTableName, name)
+}
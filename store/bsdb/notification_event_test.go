@@ -0,0 +1,87 @@
+package bsdb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/forbole/juno/v4/common"
+	"github.com/stretchr/testify/assert"
+)
+
+const (
+	mockListNotificationEventsSQL         = "SELECT * FROM `notification_events` WHERE id > ? ORDER BY id LIMIT 10"
+	mockListBucketNotificationEventsSQL   = "SELECT * FROM `notification_events` WHERE id > ? AND bucket_name = ? AND object_name LIKE ? AND event_type IN (?) ORDER BY id LIMIT 10"
+	mockGetLatestNotificationEventIDSQL   = "SELECT COALESCE(MAX(id), 0) FROM `notification_events`"
+	mockDeleteNotificationEventsSQL       = "DELETE FROM `notification_events` WHERE id <= ? AND create_time < ? LIMIT 10"
+	mockNotificationEventBucketName       = "mock-bucket"
+	mockNotificationEventObjectName       = "mock-object"
+	mockNotificationEventStartAfterID     = uint64(100)
+	mockNotificationEventCreateAt         = int64(1000)
+	mockNotificationEventLatestEventID    = uint64(200)
+	mockNotificationEventListLimit        = 10
+	mockNotificationEventObjectIDHexValue = "0x01"
+)
+
+func TestBsDBImpl_ListNotificationEventsSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	objectID := common.HexToHash(mockNotificationEventObjectIDHexValue)
+	mock.ExpectQuery(mockListNotificationEventsSQL).WithArgs(mockNotificationEventStartAfterID).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "event_type", "bucket_name", "object_name", "object_id", "create_at"}).
+			AddRow(101, NotificationEventObjectSealed, mockNotificationEventBucketName, mockNotificationEventObjectName,
+				objectID.Bytes(), mockNotificationEventCreateAt))
+	events, err := s.ListNotificationEvents(mockNotificationEventStartAfterID, mockNotificationEventListLimit)
+	assert.Nil(t, err)
+	assert.Equal(t, []*NotificationEvent{{ID: 101, EventType: NotificationEventObjectSealed,
+		BucketName: mockNotificationEventBucketName, ObjectName: mockNotificationEventObjectName, ObjectID: objectID,
+		CreateAt: mockNotificationEventCreateAt}}, events)
+}
+
+func TestBsDBImpl_ListNotificationEventsWithFilters(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListBucketNotificationEventsSQL).
+		WithArgs(mockNotificationEventStartAfterID, mockNotificationEventBucketName, "mock%", NotificationEventObjectSealed).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}))
+	events, err := s.ListNotificationEvents(mockNotificationEventStartAfterID, mockNotificationEventListLimit,
+		BucketNameFilter(mockNotificationEventBucketName), PrefixFilter("mock"),
+		EventTypesFilter([]string{NotificationEventObjectSealed}))
+	assert.Nil(t, err)
+	assert.Empty(t, events)
+}
+
+func TestBsDBImpl_ListNotificationEventsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListNotificationEventsSQL).WithArgs(mockNotificationEventStartAfterID).
+		WillReturnError(mockDBInternalError)
+	_, err := s.ListNotificationEvents(mockNotificationEventStartAfterID, mockNotificationEventListLimit)
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestBsDBImpl_GetLatestNotificationEventID(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockGetLatestNotificationEventIDSQL).
+		WillReturnRows(sqlmock.NewRows([]string{"id"}).AddRow(mockNotificationEventLatestEventID))
+	eventID, err := s.GetLatestNotificationEventID()
+	assert.Nil(t, err)
+	assert.Equal(t, mockNotificationEventLatestEventID, eventID)
+
+	mock.ExpectQuery(mockGetLatestNotificationEventIDSQL).WillReturnError(mockDBInternalError)
+	_, err = s.GetLatestNotificationEventID()
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestBsDBImpl_DeleteNotificationEvents(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockDeleteNotificationEventsSQL).WithArgs(mockNotificationEventLatestEventID, int64(1000)).
+		WillReturnResult(sqlmock.NewResult(0, 3))
+	mock.ExpectCommit()
+	deleted, err := s.DeleteNotificationEvents(mockNotificationEventLatestEventID, 1000, mockNotificationEventListLimit)
+	assert.Nil(t, err)
+	assert.Equal(t, int64(3), deleted)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockDeleteNotificationEventsSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	_, err = s.DeleteNotificationEvents(mockNotificationEventLatestEventID, 1000, mockNotificationEventListLimit)
+	assert.Equal(t, mockDBInternalError, err)
+}
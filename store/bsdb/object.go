@@ -4,7 +4,10 @@ import (
 	"errors"
 	"fmt"
 	"sort"
+	"strconv"
+	"strings"
 	"time"
+	"unicode/utf8"
 
 	"cosmossdk.io/math"
 	"github.com/forbole/juno/v4/common"
@@ -84,6 +87,128 @@ func (b *BsDBImpl) ListObjectsByBucketName(bucketName, continuationToken, prefix
 	return results, err
 }
 
+// ListObjectsWithFilters lists the objects of a bucket that match the filters, sorted by the sort key.
+// The objects of the same sort value are sorted by the object name in ascending order, so that the listing
+// can be continued from the sort value and the object name of the next object by SortedContinuationTokenFilter.
+func (b *BsDBImpl) ListObjectsWithFilters(bucketName, sortBy string, sortDesc bool, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*Object, error) {
+	var (
+		objects []*Object
+		order   string
+		err     error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	direction := "asc"
+	if sortDesc {
+		direction = "desc"
+	}
+	column, ok := ListObjectsSortColumns[sortBy]
+	if !ok || sortBy == ListObjectsSortByName {
+		order = "object_name " + direction
+	} else {
+		order = fmt.Sprintf("%s %s, object_name asc", column, direction)
+	}
+
+	err = b.db.Table(GetObjectsTableName(bucketName)).
+		Select("*").
+		Where("bucket_name = ?", bucketName).
+		Scopes(filters...).
+		Order(order).
+		Limit(limit).
+		Find(&objects).Error
+	return objects, err
+}
+
+// SummarizeObjectsByPrefix returns the object count and the total payload size of the objects that match the
+// filters, grouped by the common prefixes under the prefix. The objects are grouped by the part of their names
+// up to the first delimiter after the prefix, and the objects that have no delimiter after the prefix are
+// grouped by the prefix itself. All the objects are grouped by the prefix if the delimiter is empty.
+// The summaries are sorted by the prefix and start from the startAfter prefix inclusively, the objects before the
+// startAfter are skipped by the index of the object name since an object name is not less than its prefix.
+func (b *BsDBImpl) SummarizeObjectsByPrefix(bucketName, prefix, delimiter, startAfter string, limit int, filters ...func(*gorm.DB) *gorm.DB) ([]*ObjectPrefixSummary, error) {
+	var (
+		summaries []*ObjectPrefixSummary
+		selection string
+		args      []interface{}
+		err       error
+	)
+	startTime := time.Now()
+	methodName := currentFunction()
+	defer func() {
+		if err != nil {
+			MetadataDatabaseFailureMetrics(err, startTime, methodName)
+		} else {
+			MetadataDatabaseSuccessMetrics(startTime, methodName)
+		}
+	}()
+
+	if delimiter == "" {
+		selection = "? AS prefix"
+		args = []interface{}{prefix}
+	} else {
+		// the positions of LOCATE and SUBSTRING are counted in characters starting from 1
+		start := utf8.RuneCountInString(prefix) + 1
+		selection = "CASE WHEN LOCATE(?, object_name, ?) > 0 THEN SUBSTRING(object_name, 1, LOCATE(?, object_name, ?) + ? - 1) ELSE ? END AS prefix"
+		args = []interface{}{delimiter, start, delimiter, start, utf8.RuneCountInString(delimiter), prefix}
+	}
+	if prefix != "" {
+		filters = append(filters, PrefixFilter(prefix))
+	}
+	if startAfter != "" {
+		filters = append(filters, ContinuationTokenFilter(startAfter))
+	}
+
+	db := b.db.Table(GetObjectsTableName(bucketName)).
+		Select(selection+", COUNT(*) AS object_count, COALESCE(SUM(payload_size), 0) AS total_size", args...).
+		Where("bucket_name = ?", bucketName).
+		Scopes(filters...).
+		Group("prefix")
+	if startAfter != "" {
+		db = db.Having("prefix >= ?", startAfter)
+	}
+	err = db.Order("prefix").
+		Limit(limit).
+		Find(&summaries).Error
+	return summaries, err
+}
+
+// EncodeSortedContinuationToken returns the continuation token to list the objects sorted by the sort key from the object.
+func EncodeSortedContinuationToken(sortBy string, object *Object) string {
+	switch sortBy {
+	case ListObjectsSortBySize:
+		return fmt.Sprintf("%d:%s", object.PayloadSize, object.ObjectName)
+	case ListObjectsSortByCreateTime:
+		return fmt.Sprintf("%d:%s", object.CreateTime, object.ObjectName)
+	default:
+		return object.ObjectName
+	}
+}
+
+// DecodeSortedContinuationToken returns the sort value and the object name in the continuation token of the objects
+// sorted by the sort key.
+func DecodeSortedContinuationToken(sortBy, token string) (uint64, string, error) {
+	if sortBy != ListObjectsSortBySize && sortBy != ListObjectsSortByCreateTime {
+		return 0, token, nil
+	}
+	value, objectName, found := strings.Cut(token, ":")
+	if !found {
+		return 0, "", fmt.Errorf("invalid continuation token of objects sorted by %s: %s", sortBy, token)
+	}
+	sortValue, err := strconv.ParseUint(value, 10, 64)
+	if err != nil {
+		return 0, "", fmt.Errorf("invalid continuation token of objects sorted by %s: %v", sortBy, err)
+	}
+	return sortValue, objectName, nil
+}
+
 type ByUpdateAtAndObjectID []*Object
 
 func (a ByUpdateAtAndObjectID) Len() int { return len(a) }
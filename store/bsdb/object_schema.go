@@ -79,6 +79,23 @@ type Object struct {
 	Version int64 `gorm:"version"`
 }
 
+// ObjectIndex defines an index of the object shard tables
+type ObjectIndex struct {
+	// Name is the name of the index
+	Name string
+	// Columns is the column list of the index
+	Columns string
+}
+
+// ObjectListIndexes defines the indexes of the object shard tables that serve the filters and the sort keys of listing objects,
+// they are built online by the blocksyncer.index command instead of on starting the block syncer
+var ObjectListIndexes = []ObjectIndex{
+	{Name: "idx_bucket_name_payload_size", Columns: "bucket_name, payload_size"},
+	{Name: "idx_bucket_name_create_time", Columns: "bucket_name, create_time"},
+	{Name: "idx_bucket_name_content_type", Columns: "bucket_name, content_type(128)"},
+	{Name: "idx_bucket_name_creator", Columns: "bucket_name, creator"},
+}
+
 // TableName is used to set Object table name in database
 func (o *Object) TableName() string {
 	return ObjectTableName
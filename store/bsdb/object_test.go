@@ -3,6 +3,7 @@ package bsdb
 import (
 	"testing"
 
+	"github.com/DATA-DOG/go-sqlmock"
 	"github.com/stretchr/testify/assert"
 )
 
@@ -10,3 +11,61 @@ func Test_GetObjectsTableName(t *testing.T) {
 	objectTableName := GetObjectsTableName("ot005test-bucket")
 	assert.Equal(t, "objects_62", objectTableName)
 }
+
+func TestBsDBImpl_ListObjectsWithFilters(t *testing.T) {
+	s, mock := setupDB(t)
+	bucketName := "ot005test-bucket"
+	mock.ExpectQuery("SELECT * FROM `objects_62` WHERE bucket_name = ? AND removed = ? AND payload_size >= ? AND "+
+		"(payload_size < ? OR (payload_size = ? AND object_name >= ?)) ORDER BY payload_size desc, object_name asc LIMIT 11").
+		WithArgs(bucketName, false, uint64(100), uint64(500), uint64(500), "a.txt").
+		WillReturnRows(sqlmock.NewRows([]string{"object_name", "payload_size"}).AddRow("a.txt", 500).AddRow("b.txt", 200))
+	objects, err := s.ListObjectsWithFilters(bucketName, ListObjectsSortBySize, true, 11, RemovedFilter(false),
+		MinPayloadSizeFilter(100), SortedContinuationTokenFilter(ListObjectsSortBySize, true, 500, "a.txt"))
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objects))
+	assert.Equal(t, uint64(200), objects[1].PayloadSize)
+
+	mock.ExpectQuery("SELECT * FROM `objects_62` WHERE bucket_name = ? ORDER BY object_name desc LIMIT 11").
+		WithArgs(bucketName).WillReturnError(mockDBInternalError)
+	_, err = s.ListObjectsWithFilters(bucketName, ListObjectsSortByName, true, 11)
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestBsDBImpl_SummarizeObjectsByPrefix(t *testing.T) {
+	s, mock := setupDB(t)
+	bucketName := "ot005test-bucket"
+	mock.ExpectQuery("SELECT CASE WHEN LOCATE(?, object_name, ?) > 0 THEN SUBSTRING(object_name, 1, LOCATE(?, object_name, ?) + ? - 1) "+
+		"ELSE ? END AS prefix, COUNT(*) AS object_count, COALESCE(SUM(payload_size), 0) AS total_size FROM `objects_62` "+
+		"WHERE bucket_name = ? AND removed = ? AND object_name LIKE ? AND object_name >= ? GROUP BY `prefix` HAVING prefix >= ? ORDER BY prefix LIMIT 11").
+		WithArgs("/", 5, "/", 5, 1, "dir/", bucketName, false, "dir/%", "dir/b/", "dir/b/").
+		WillReturnRows(sqlmock.NewRows([]string{"prefix", "object_count", "total_size"}).AddRow("dir/b/", 2, 300))
+	summaries, err := s.SummarizeObjectsByPrefix(bucketName, "dir/", "/", "dir/b/", 11, RemovedFilter(false))
+	assert.Nil(t, err)
+	assert.Equal(t, []*ObjectPrefixSummary{{Prefix: "dir/b/", ObjectCount: 2, TotalSize: 300}}, summaries)
+
+	mock.ExpectQuery("SELECT ? AS prefix, COUNT(*) AS object_count, COALESCE(SUM(payload_size), 0) AS total_size FROM `objects_62` "+
+		"WHERE bucket_name = ? GROUP BY `prefix` ORDER BY prefix LIMIT 11").
+		WithArgs("", bucketName).WillReturnError(mockDBInternalError)
+	_, err = s.SummarizeObjectsByPrefix(bucketName, "", "", "", 11)
+	assert.Equal(t, mockDBInternalError, err)
+}
+
+func TestSortedContinuationToken(t *testing.T) {
+	object := &Object{ObjectName: "dir/a:b", PayloadSize: 100, CreateTime: 1700000000}
+	for _, sortBy := range []string{"", ListObjectsSortByName, ListObjectsSortBySize, ListObjectsSortByCreateTime} {
+		sortValue, objectName, err := DecodeSortedContinuationToken(sortBy, EncodeSortedContinuationToken(sortBy, object))
+		assert.Nil(t, err)
+		assert.Equal(t, object.ObjectName, objectName)
+		switch sortBy {
+		case ListObjectsSortBySize:
+			assert.Equal(t, object.PayloadSize, sortValue)
+		case ListObjectsSortByCreateTime:
+			assert.Equal(t, uint64(object.CreateTime), sortValue)
+		}
+	}
+
+	_, _, err := DecodeSortedContinuationToken(ListObjectsSortBySize, "a.txt")
+	assert.NotNil(t, err)
+	_, _, err = DecodeSortedContinuationToken(ListObjectsSortByCreateTime, "time:a.txt")
+	assert.NotNil(t, err)
+}
@@ -9,6 +9,7 @@ import (
 	corepiecestore "github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
 	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/piece"
 	"github.com/bnb-chain/greenfield-storage-provider/store/piecestore/storage"
 )
@@ -57,7 +58,10 @@ func NewStoreClient(pieceConfig *storage.PieceStoreConfig) (*StoreClient, error)
 // GetPiece gets piece data from piece store.
 func (client *StoreClient) GetPiece(ctx context.Context, key string, offset, limit int64) (data []byte, err error) {
 	startTime := time.Now()
+	ctx, span := tracing.StartSpan(ctx, "PieceStore.GetPiece", tracing.PieceKey.String(key))
 	defer func() {
+		span.SetAttributes(tracing.PieceSize.Int(len(data)))
+		tracing.EndSpan(span, err)
 		if err != nil {
 			metrics.PieceStoreCounter.WithLabelValues(PieceStoreFailureGet).Inc()
 			metrics.PieceStoreTime.WithLabelValues(PieceStoreFailureGet).Observe(
@@ -89,7 +93,10 @@ func (client *StoreClient) PutPiece(ctx context.Context, key string, value []byt
 		startTime = time.Now()
 		err       error
 	)
+	ctx, span := tracing.StartSpan(ctx, "PieceStore.PutPiece", tracing.PieceKey.String(key),
+		tracing.PieceSize.Int(len(value)))
 	defer func() {
+		tracing.EndSpan(span, err)
 		if err != nil {
 			metrics.PieceStoreCounter.WithLabelValues(PieceStoreFailurePut).Inc()
 			metrics.PieceStoreTime.WithLabelValues(PieceStoreFailurePut).Observe(
@@ -25,7 +25,7 @@ func NewPieceStore(pieceConfig *storage.PieceStoreConfig) (*PieceStore, error) {
 		return nil, err
 	}
 	log.Infow("piece store is running", "storage type", pieceConfig.Store.Storage,
-		"shards", pieceConfig.Shards)
+		"shards", pieceConfig.Shards, "cold storage type", pieceConfig.Tiering.ColdStore.Storage)
 
 	return &PieceStore{blob}, nil
 }
@@ -45,6 +45,34 @@ func checkConfig(cfg *storage.PieceStoreConfig) error {
 	if cfg.Store.MinRetryDelay < 0 {
 		return fmt.Errorf("MinRetryDelay should be equal or greater than zero")
 	}
+	if cfg.Resharding.PreviousShards > 256 {
+		return fmt.Errorf("too many previous shards: %d", cfg.Resharding.PreviousShards)
+	}
+	if cfg.Resharding.EnableRebalancer {
+		if cfg.Resharding.PreviousShards == 0 {
+			return fmt.Errorf("rebalancer is enabled without previous shards")
+		}
+		if cfg.Resharding.RebalanceProgressFile == "" {
+			return fmt.Errorf("rebalancer is enabled without progress file")
+		}
+	}
+	if cfg.Resharding.PreviousShards > 0 && cfg.Resharding.LockDSN == "" {
+		return fmt.Errorf("resharding is enabled without lock dsn")
+	}
+	if cfg.Tiering.ColdStore.Storage != "" {
+		if cfg.Tiering.ColdStore.IAMType != storage.AKSKIAMType && cfg.Tiering.ColdStore.IAMType != storage.SAIAMType {
+			return fmt.Errorf("invalid cold store iam type: %s", cfg.Tiering.ColdStore.IAMType)
+		}
+		if cfg.Tiering.DemoteAgeSecond < 0 || cfg.Tiering.MoveIntervalSecond < 0 {
+			return fmt.Errorf("DemoteAgeSecond and MoveIntervalSecond should be equal or greater than zero")
+		}
+		if cfg.Tiering.HotReadThreshold < 0 {
+			return fmt.Errorf("HotReadThreshold should be equal or greater than zero")
+		}
+		if cfg.Tiering.LockDSN == "" {
+			return fmt.Errorf("tiering is enabled without lock dsn")
+		}
+	}
 	if cfg.Store.Storage == storage.DiskFileStore {
 		if cfg.Store.BucketURL == "" {
 			cfg.Store.BucketURL = setDefaultFileStorePath()
@@ -71,7 +99,7 @@ func createStorage(cfg storage.PieceStoreConfig) (storage.ObjectStorage, error)
 		object storage.ObjectStorage
 		err    error
 	)
-	if cfg.Shards > 1 {
+	if cfg.Shards > 1 || cfg.Resharding.PreviousShards > 1 {
 		object, err = storage.NewSharded(cfg)
 	} else {
 		object, err = storage.NewObjectStorage(cfg.Store)
@@ -81,6 +109,13 @@ func createStorage(cfg storage.PieceStoreConfig) (storage.ObjectStorage, error)
 		return nil, err
 	}
 
+	if cfg.Tiering.ColdStore.Storage != "" {
+		if object, err = storage.NewTiered(object, cfg.Tiering); err != nil {
+			log.Errorw("failed to create tiered storage", "error", err)
+			return nil, err
+		}
+	}
+
 	if err = checkBucket(context.Background(), object); err != nil {
 		log.Errorw("failed to check bucket due to storage is not configured rightly ", "error", err,
 			"object", object)
@@ -34,6 +34,43 @@ func TestNewPieceStore(t *testing.T) {
 			},
 			wantedIsErr: false,
 		},
+		{
+			name: "Failed to new tiered piece store without lock dsn",
+			pieceConfig: &storage.PieceStoreConfig{
+				Shards: 0,
+				Store: storage.ObjectStorageConfig{
+					Storage:   storage.MemoryStore,
+					BucketURL: "mock",
+					IAMType:   storage.AKSKIAMType,
+				},
+				Tiering: storage.TieringConfig{
+					ColdStore: storage.ObjectStorageConfig{
+						Storage:   storage.MemoryStore,
+						BucketURL: "mock-cold",
+						IAMType:   storage.AKSKIAMType,
+					},
+				},
+			},
+			wantedIsErr: true,
+		},
+		{
+			name: "Failed to check cold store config",
+			pieceConfig: &storage.PieceStoreConfig{
+				Shards: 0,
+				Store: storage.ObjectStorageConfig{
+					Storage:   storage.MemoryStore,
+					BucketURL: "mock",
+					IAMType:   storage.AKSKIAMType,
+				},
+				Tiering: storage.TieringConfig{
+					ColdStore: storage.ObjectStorageConfig{
+						Storage:   storage.MemoryStore,
+						BucketURL: "mock-cold",
+					},
+				},
+			},
+			wantedIsErr: true,
+		},
 		{
 			name: "Failed to check config",
 			pieceConfig: &storage.PieceStoreConfig{
@@ -144,6 +181,33 @@ func Test_checkConfig(t *testing.T) {
 			},
 			wantedErr: errors.New("MinRetryDelay should be equal or greater than zero"),
 		},
+		{
+			name: "Wrong rebalancer config",
+			cfg: &storage.PieceStoreConfig{
+				Shards: 2,
+				Store: storage.ObjectStorageConfig{
+					IAMType: storage.AKSKIAMType,
+				},
+				Resharding: storage.ReshardingConfig{
+					PreviousShards:   1,
+					EnableRebalancer: true,
+				},
+			},
+			wantedErr: errors.New("rebalancer is enabled without progress file"),
+		},
+		{
+			name: "Wrong resharding config",
+			cfg: &storage.PieceStoreConfig{
+				Shards: 2,
+				Store: storage.ObjectStorageConfig{
+					IAMType: storage.AKSKIAMType,
+				},
+				Resharding: storage.ReshardingConfig{
+					PreviousShards: 1,
+				},
+			},
+			wantedErr: errors.New("resharding is enabled without lock dsn"),
+		},
 	}
 	for _, tt := range cases {
 		t.Run(tt.name, func(t *testing.T) {
@@ -18,6 +18,22 @@ const (
 	MemoryStore = "memory"
 )
 
+// define shard placement constants
+const (
+	// ModuloPlacement places the keys to the shards by the hash of key modulo the shard number
+	ModuloPlacement = "modulo"
+	// RendezvousPlacement places the keys to the shards by weighted rendezvous hashing
+	RendezvousPlacement = "rendezvous"
+)
+
+// define storage tier constants
+const (
+	// HotTier defines the tier that the new pieces are written to
+	HotTier = "hot"
+	// ColdTier defines the tier that the pieces are demoted to
+	ColdTier = "cold"
+)
+
 // piece store storage config and environment constants
 const (
 	// AKSKIAMType defines IAM type config which uses access key and secret key to access aws s3
@@ -219,6 +219,81 @@ func (d *diskFileStore) HeadObject(ctx context.Context, key string) (Object, err
 	}, nil
 }
 
+// ListObjects lists the files in the root directory in the order of key, the directories and the
+// temporary files of the writing pieces are skipped.
+func (d *diskFileStore) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	if delimiter != "" {
+		return nil, ErrUnsupportedDelimiter
+	}
+	objs := make([]Object, 0)
+	err := d.walk(prefix, marker, func(o Object) bool {
+		objs = append(objs, o)
+		return limit <= 0 || int64(len(objs)) < limit
+	})
+	if err != nil {
+		return nil, err
+	}
+	return objs, nil
+}
+
+// ListAllObjects lists the files in the root directory in one pass, which is cheaper than listing them
+// page by page since every page reads the whole directory.
+func (d *diskFileStore) ListAllObjects(ctx context.Context, prefix, marker string) (<-chan Object, error) {
+	if _, err := os.Stat(d.root); err != nil {
+		log.Errorw("failed to list all objects due to stat directory", "error", err)
+		return nil, err
+	}
+	objs := make(chan Object, 1000)
+	go func() {
+		defer close(objs)
+		err := d.walk(prefix, marker, func(o Object) bool {
+			select {
+			case objs <- o:
+				return true
+			case <-ctx.Done():
+				return false
+			}
+		})
+		if err != nil {
+			log.Errorw("failed to list all objects", "error", err)
+		}
+	}()
+	return objs, nil
+}
+
+// walk visits the files in the root directory after marker in the order of key until fn returns false.
+func (d *diskFileStore) walk(prefix, marker string, fn func(o Object) bool) error {
+	root := filepath.Clean(d.root)
+	err := filepath.WalkDir(root, func(path string, dirEntry fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if path == root {
+			return nil
+		}
+		entryName := dirEntry.Name()
+		if dirEntry.IsDir() {
+			return filepath.SkipDir
+		}
+		if strings.HasPrefix(entryName, ".") || !strings.HasPrefix(entryName, prefix) || entryName <= marker {
+			return nil
+		}
+		entryInfo, err := dirEntry.Info()
+		if err != nil {
+			// the file is deleted after reading the directory
+			return nil
+		}
+		if !fn(&object{key: entryName, size: entryInfo.Size(), modTime: entryInfo.ModTime()}) {
+			return fs.SkipAll
+		}
+		return nil
+	})
+	if err != nil {
+		log.Errorw("failed to walk objects due to read directory", "error", err)
+	}
+	return err
+}
+
 func (d *diskFileStore) path(key string) string {
 	return filepath.Join(d.root, key)
 }
@@ -316,15 +316,48 @@ func TestDiskFileStore_HeadDirSuccess(t *testing.T) {
 }
 
 func TestDiskFileStore_ListObjects(t *testing.T) {
-	store := setupDiskFileTest(t)
-	_, err := store.ListObjects(context.TODO(), emptyString, emptyString, emptyString, 0)
-	assert.Equal(t, ErrUnsupportedMethod, err)
+	store := &diskFileStore{root: t.TempDir()}
+	for _, key := range []string{"s2_s0", "s1_s1", "s1_s0", "e1_s0_e0"} {
+		assert.Nil(t, store.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+	assert.Nil(t, os.Mkdir(filepath.Join(store.root, "s1_dir"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(store.root, ".s1_s2.tmp1"), []byte("tmp"), 0644))
+
+	objs, err := store.ListObjects(context.TODO(), "s1", emptyString, emptyString, 0)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+	assert.Equal(t, "s1_s0", objs[0].Key())
+	assert.Equal(t, int64(len("s1_s0")), objs[0].Size())
+	assert.Equal(t, "s1_s1", objs[1].Key())
+
+	objs, err = store.ListObjects(context.TODO(), emptyString, "e1_s0_e0", emptyString, 2)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+	assert.Equal(t, "s1_s0", objs[0].Key())
+	assert.Equal(t, "s1_s1", objs[1].Key())
+
+	_, err = store.ListObjects(context.TODO(), emptyString, emptyString, "/", 0)
+	assert.Equal(t, ErrUnsupportedDelimiter, err)
 }
 
 func TestDiskFileStore_ListAllObjects(t *testing.T) {
-	store := setupDiskFileTest(t)
-	_, err := store.ListAllObjects(context.TODO(), emptyString, emptyString)
-	assert.Equal(t, ErrUnsupportedMethod, err)
+	store := &diskFileStore{root: t.TempDir()}
+	for _, key := range []string{"s2_s0", "s1_s1", "s1_s0"} {
+		assert.Nil(t, store.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+	assert.Nil(t, os.Mkdir(filepath.Join(store.root, "s1_dir"), 0755))
+	assert.Nil(t, os.WriteFile(filepath.Join(store.root, ".s1_s2.tmp1"), []byte("tmp"), 0644))
+
+	objs, err := store.ListAllObjects(context.TODO(), emptyString, "s1_s0")
+	assert.Nil(t, err)
+	var keys []string
+	for o := range objs {
+		keys = append(keys, o.Key())
+	}
+	assert.Equal(t, []string{"s1_s1", "s2_s0"}, keys)
+
+	_, err = (&diskFileStore{root: filepath.Join(store.root, "no_dir")}).ListAllObjects(context.TODO(), emptyString, emptyString)
+	assert.True(t, os.IsNotExist(err))
 }
 
 func TestDiskFileStore_path(t *testing.T) {
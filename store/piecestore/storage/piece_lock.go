@@ -0,0 +1,131 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"database/sql/driver"
+	"errors"
+	"fmt"
+	"hash/fnv"
+	"sync"
+	"time"
+
+	// register the mysql driver of the named locks
+	_ "github.com/go-sql-driver/mysql"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// DefaultPieceLockTimeoutSecond defines the default seconds to wait for the named lock of a piece.
+	DefaultPieceLockTimeoutSecond int64 = 30
+
+	// pieceLockNamePrefix is the prefix of the mysql named locks of the pieces.
+	pieceLockNamePrefix = "gfsp_piece_"
+	// rebalancerLockName is the mysql named lock held by the rebalancer, so only one process rebalances.
+	rebalancerLockName = "gfsp_shard_rebalancer"
+	// tierMoverLockName is the mysql named lock held by the tier mover, so only one process moves.
+	tierMoverLockName = "gfsp_tier_mover"
+)
+
+// ErrPieceLockTimeout defines the error of timeout to wait for the lock of a piece.
+var ErrPieceLockTimeout = errors.New("timeout to wait for the piece lock")
+
+// pieceLocker serializes the writes, the deletions and the moves of the same piece, the returned function
+// releases the lock.
+type pieceLocker interface {
+	lock(ctx context.Context, name string) (func(), error)
+}
+
+// stripedPieceLocker serializes the pieces in this process only, it is used if the pieces are written by
+// only one process.
+type stripedPieceLocker struct {
+	locks [shardKeyLockStripes]sync.Mutex
+	// rebalancer is held during the whole rebalance, so it does not share the stripes with the pieces
+	rebalancer sync.Mutex
+	// mover is held during the whole sweep of the tier mover
+	mover sync.Mutex
+}
+
+func (l *stripedPieceLocker) lock(ctx context.Context, name string) (func(), error) {
+	switch name {
+	case rebalancerLockName:
+		l.rebalancer.Lock()
+		return l.rebalancer.Unlock, nil
+	case tierMoverLockName:
+		l.mover.Lock()
+		return l.mover.Unlock, nil
+	}
+	h := fnv.New32a()
+	_, _ = h.Write([]byte(name))
+	mu := &l.locks[h.Sum32()%shardKeyLockStripes]
+	mu.Lock()
+	return mu.Unlock, nil
+}
+
+// mysqlPieceLocker serializes the pieces across the processes by the mysql named locks, a named lock is
+// owned by the session, so every held lock pins a connection until it is released.
+type mysqlPieceLocker struct {
+	db      *sql.DB
+	timeout int64
+}
+
+func newMySQLPieceLocker(dsn string, timeoutSecond int64) (*mysqlPieceLocker, error) {
+	db, err := sql.Open("mysql", dsn)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if err = db.PingContext(ctx); err != nil {
+		_ = db.Close()
+		return nil, err
+	}
+	if timeoutSecond <= 0 {
+		timeoutSecond = DefaultPieceLockTimeoutSecond
+	}
+	return &mysqlPieceLocker{db: db, timeout: timeoutSecond}, nil
+}
+
+func (l *mysqlPieceLocker) lock(ctx context.Context, name string) (func(), error) {
+	conn, err := l.db.Conn(ctx)
+	if err != nil {
+		return nil, err
+	}
+	name = mysqlLockName(name)
+	var acquired sql.NullInt64
+	if err = conn.QueryRowContext(ctx, "SELECT GET_LOCK(?, ?)", name, l.timeout).Scan(&acquired); err != nil {
+		discardConn(conn)
+		return nil, err
+	}
+	if !acquired.Valid || acquired.Int64 != 1 {
+		_ = conn.Close()
+		return nil, ErrPieceLockTimeout
+	}
+	return func() {
+		if _, releaseErr := conn.ExecContext(context.Background(), "SELECT RELEASE_LOCK(?)", name); releaseErr != nil {
+			log.Errorw("failed to release piece lock, drop the connection", "name", name, "error", releaseErr)
+			discardConn(conn)
+			return
+		}
+		_ = conn.Close()
+	}, nil
+}
+
+// mysqlLockName returns the name of the mysql named lock, the name is limited to 64 characters, so the
+// key is hashed.
+func mysqlLockName(name string) string {
+	if name == rebalancerLockName || name == tierMoverLockName {
+		return name
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(name))
+	return fmt.Sprintf("%s%016x", pieceLockNamePrefix, h.Sum64())
+}
+
+// discardConn closes the underlying connection instead of returning it to the pool, the named locks held
+// by the session are released by the server when the session ends.
+func discardConn(conn *sql.Conn) {
+	_ = conn.Raw(func(driverConn any) error { return driver.ErrBadConn })
+	_ = conn.Close()
+}
@@ -0,0 +1,48 @@
+package storage
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestStripedPieceLocker(t *testing.T) {
+	l := &stripedPieceLocker{}
+	unlockRebalancer, err := l.lock(context.TODO(), rebalancerLockName)
+	assert.Nil(t, err)
+	// the pieces are not blocked by the rebalancer lock
+	for i := 0; i < shardKeyLockStripes*2; i++ {
+		unlock, err := l.lock(context.TODO(), fmt.Sprintf("s%d_s0", i))
+		assert.Nil(t, err)
+		unlock()
+	}
+	unlockRebalancer()
+}
+
+func TestMySQLPieceLocker(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close()
+	l := &mysqlPieceLocker{db: db, timeout: 1}
+	name := mysqlLockName(mockKey)
+	assert.Len(t, name, len(pieceLockNamePrefix)+16)
+	assert.Equal(t, rebalancerLockName, mysqlLockName(rebalancerLockName))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, ?)")).WithArgs(name, int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(1))
+	mock.ExpectExec(regexp.QuoteMeta("SELECT RELEASE_LOCK(?)")).WithArgs(name).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	unlock, err := l.lock(context.TODO(), mockKey)
+	assert.Nil(t, err)
+	unlock()
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT GET_LOCK(?, ?)")).WithArgs(name, int64(1)).
+		WillReturnRows(sqlmock.NewRows([]string{"lock"}).AddRow(0))
+	_, err = l.lock(context.TODO(), mockKey)
+	assert.Equal(t, ErrPieceLockTimeout, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
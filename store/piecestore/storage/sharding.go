@@ -5,18 +5,31 @@ import (
 	"fmt"
 	"hash/fnv"
 	"io"
+	"math"
+	"sort"
 	"strings"
 
 	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
 )
 
 type sharded struct {
-	stores []ObjectStorage
+	stores   []ObjectStorage
+	topology *shardTopology
+	// previous is the topology before resharding, the reads fall back to the owners in it until the
+	// rebalancer moves all pieces to their owners in the current topology.
+	previous *shardTopology
+	// locker serializes the writes, the deletions and the moves of the same piece, it is shared by the
+	// processes during resharding since the rebalancer may move a piece that is written by another process.
+	locker pieceLocker
 	DefaultObjectStorage
 }
 
 func NewSharded(cfg PieceStoreConfig) (ObjectStorage, error) {
-	stores := make([]ObjectStorage, cfg.Shards)
+	shards := cfg.Shards
+	if cfg.Resharding.PreviousShards > shards {
+		shards = cfg.Resharding.PreviousShards
+	}
+	stores := make([]ObjectStorage, shards)
 	var err error
 	shardingURL := cfg.Store.BucketURL
 	for i := range stores {
@@ -30,7 +43,27 @@ func NewSharded(cfg PieceStoreConfig) (ObjectStorage, error) {
 			return nil, err
 		}
 	}
-	return &sharded{stores: stores}, nil
+	s := &sharded{stores: stores, locker: &stripedPieceLocker{}}
+	if s.topology, err = newShardTopology(cfg.Shards, cfg.ShardPlacement, cfg.ShardWeights); err != nil {
+		return nil, err
+	}
+	if cfg.Resharding.PreviousShards == 0 {
+		return s, nil
+	}
+	if s.previous, err = newShardTopology(cfg.Resharding.PreviousShards, cfg.Resharding.PreviousShardPlacement,
+		cfg.Resharding.PreviousShardWeights); err != nil {
+		return nil, err
+	}
+	if cfg.Resharding.LockDSN != "" {
+		if s.locker, err = newMySQLPieceLocker(cfg.Resharding.LockDSN, cfg.Resharding.LockTimeoutSecond); err != nil {
+			log.Errorw("failed to connect the piece lock db", "error", err)
+			return nil, err
+		}
+	}
+	if cfg.Resharding.EnableRebalancer {
+		go newShardRebalancer(s, cfg.Resharding).start()
+	}
+	return s, nil
 }
 
 func (s *sharded) String() string {
@@ -47,22 +80,57 @@ func (s *sharded) CreateBucket(ctx context.Context) error {
 }
 
 func (s *sharded) pick(key string) ObjectStorage {
-	h := fnv.New32a()
-	_, _ = h.Write([]byte(key))
-	i := h.Sum32() % uint32(len(s.stores))
-	return s.stores[i]
+	return s.stores[s.topology.owner(key)]
+}
+
+// pickPrevious returns the owner of the key in the previous topology if it is different from the current
+// owner, otherwise returns nil.
+func (s *sharded) pickPrevious(key string) ObjectStorage {
+	if s.previous == nil {
+		return nil
+	}
+	previous := s.previous.owner(key)
+	if previous == s.topology.owner(key) {
+		return nil
+	}
+	return s.stores[previous]
 }
 
 func (s *sharded) GetObject(ctx context.Context, key string, off, limit int64) (io.ReadCloser, error) {
-	return s.pick(key).GetObject(ctx, key, off, limit)
+	rc, err := s.pick(key).GetObject(ctx, key, off, limit)
+	if err == nil {
+		return rc, nil
+	}
+	if previous := s.pickPrevious(key); previous != nil {
+		if rc, previousErr := previous.GetObject(ctx, key, off, limit); previousErr == nil {
+			return rc, nil
+		}
+	}
+	return nil, err
 }
 
 func (s *sharded) PutObject(ctx context.Context, key string, body io.Reader) error {
+	unlock, err := s.locker.lock(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
 	return s.pick(key).PutObject(ctx, key, body)
 }
 
 func (s *sharded) DeleteObject(ctx context.Context, key string) error {
-	return s.pick(key).DeleteObject(ctx, key)
+	unlock, err := s.locker.lock(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err := s.pick(key).DeleteObject(ctx, key); err != nil {
+		return err
+	}
+	if previous := s.pickPrevious(key); previous != nil {
+		return previous.DeleteObject(ctx, key)
+	}
+	return nil
 }
 
 func (s *sharded) DeleteObjectsByPrefix(ctx context.Context, key string) (uint64, error) {
@@ -107,5 +175,153 @@ func (s *sharded) HeadBucket(ctx context.Context) error {
 }
 
 func (s *sharded) HeadObject(ctx context.Context, key string) (Object, error) {
-	return s.pick(key).HeadObject(ctx, key)
+	o, err := s.pick(key).HeadObject(ctx, key)
+	if err == nil {
+		return o, nil
+	}
+	if previous := s.pickPrevious(key); previous != nil {
+		if o, previousErr := previous.HeadObject(ctx, key); previousErr == nil {
+			return o, nil
+		}
+	}
+	return nil, err
+}
+
+// ListObjects merges the objects listed from every shard in the order of key, the pieces which are not
+// moved by the rebalancer yet may be listed from both owners, and only one of them is returned.
+func (s *sharded) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	if delimiter != "" {
+		return nil, ErrUnsupportedDelimiter
+	}
+	var objs []Object
+	for _, o := range s.stores {
+		shardObjs, err := o.ListObjects(ctx, prefix, marker, delimiter, limit)
+		if err != nil {
+			return nil, err
+		}
+		objs = append(objs, shardObjs...)
+	}
+	sort.SliceStable(objs, func(i, j int) bool { return objs[i].Key() < objs[j].Key() })
+	merged := make([]Object, 0, len(objs))
+	for _, o := range objs {
+		if limit > 0 && int64(len(merged)) >= limit {
+			break
+		}
+		if len(merged) > 0 && merged[len(merged)-1].Key() == o.Key() {
+			continue
+		}
+		merged = append(merged, o)
+	}
+	return merged, nil
+}
+
+// ListAllObjects lists all objects of the shards one by one, the objects are not in the order of key.
+func (s *sharded) ListAllObjects(ctx context.Context, prefix, marker string) (<-chan Object, error) {
+	// the listings of the shards are canceled if any of them fails or the listing is finished
+	ctx, cancel := context.WithCancel(ctx)
+	shardObjs := make([]<-chan Object, len(s.stores))
+	for i, o := range s.stores {
+		ch, err := o.ListAllObjects(ctx, prefix, marker)
+		if err != nil {
+			cancel()
+			return nil, err
+		}
+		shardObjs[i] = ch
+	}
+	objs := make(chan Object, 1000)
+	go func() {
+		defer close(objs)
+		defer cancel()
+		for _, ch := range shardObjs {
+			for o := range ch {
+				select {
+				case objs <- o:
+				case <-ctx.Done():
+					return
+				}
+			}
+		}
+	}()
+	return objs, nil
+}
+
+// shardTopology places the keys to the shards.
+type shardTopology struct {
+	placement string
+	weights   []float64
+}
+
+func newShardTopology(shards int, placement string, weights []uint32) (*shardTopology, error) {
+	placement = strings.ToLower(placement)
+	if placement == "" {
+		placement = ModuloPlacement
+	}
+	if placement != ModuloPlacement && placement != RendezvousPlacement {
+		return nil, fmt.Errorf("invalid shard placement: %s", placement)
+	}
+	if shards <= 0 {
+		return nil, fmt.Errorf("invalid shard number: %d", shards)
+	}
+	if len(weights) != 0 && placement != RendezvousPlacement {
+		return nil, fmt.Errorf("shard weights are only supported by %s placement", RendezvousPlacement)
+	}
+	if len(weights) != 0 && len(weights) != shards {
+		return nil, fmt.Errorf("mismatched shard weights number: %d, shard number: %d", len(weights), shards)
+	}
+	t := &shardTopology{placement: placement, weights: make([]float64, shards)}
+	var totalWeight float64
+	for i := range t.weights {
+		t.weights[i] = 1
+		if len(weights) != 0 {
+			t.weights[i] = float64(weights[i])
+		}
+		totalWeight += t.weights[i]
+	}
+	if totalWeight == 0 {
+		return nil, fmt.Errorf("all shard weights are zero")
+	}
+	return t, nil
+}
+
+// owner returns the index of the shard that owns the key. The rendezvous placement scores every shard by
+// its weight and an independent hash of the key and the shard, and picks the shard with the highest score,
+// so adding, removing or reweighting a shard only moves the keys from or to that shard.
+func (t *shardTopology) owner(key string) int {
+	if t.placement == ModuloPlacement {
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		return int(h.Sum32() % uint32(len(t.weights)))
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(key))
+	var (
+		keyHash   = h.Sum64()
+		owner     int
+		bestScore = math.Inf(-1)
+	)
+	for i, weight := range t.weights {
+		if weight == 0 {
+			continue
+		}
+		// map the hash to a uniform value in (0, 1)
+		u := (float64(mix64(keyHash^mix64(uint64(i)+1))>>11) + 0.5) / (1 << 53)
+		if score := weight / -math.Log(u); score > bestScore {
+			owner, bestScore = i, score
+		}
+	}
+	return owner
+}
+
+func (t *shardTopology) String() string {
+	return fmt.Sprintf("%s%v", t.placement, t.weights)
+}
+
+// mix64 is the finalizer of splitmix64 that avalanches the bits of x.
+func mix64(x uint64) uint64 {
+	x ^= x >> 30
+	x *= 0xbf58476d1ce4e5b9
+	x ^= x >> 27
+	x *= 0x94d049bb133111eb
+	x ^= x >> 31
+	return x
 }
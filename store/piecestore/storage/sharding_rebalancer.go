@@ -0,0 +1,187 @@
+package storage
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+	"time"
+
+	"golang.org/x/time/rate"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// DefaultRebalanceBandwidthLimitBytes defines the default max bytes per second moved by the rebalancer.
+	DefaultRebalanceBandwidthLimitBytes int64 = 32 * 1024 * 1024
+
+	// shardKeyLockStripes is the number of locks that serialize the writes, the deletions and the moves of
+	// the same piece.
+	shardKeyLockStripes = 256
+	// rebalanceListBatchSize is the number of pieces listed from a shard in one request.
+	rebalanceListBatchSize int64 = 1000
+	// rebalanceRetryInterval is the interval to retry the rebalance after it fails.
+	rebalanceRetryInterval = time.Minute
+)
+
+// rebalanceProgress is the resumable progress of the rebalancer, it is persisted after every batch.
+type rebalanceProgress struct {
+	// Topology identifies the resharding, the progress of another resharding is discarded.
+	Topology   string `json:"topology"`
+	ShardIndex int    `json:"shard_index"`
+	Marker     string `json:"marker"`
+	Moved      uint64 `json:"moved"`
+	Done       bool   `json:"done"`
+}
+
+// shardRebalancer sweeps all shards and moves the pieces that are not placed on their owners in the current
+// topology to their owners.
+type shardRebalancer struct {
+	sharded      *sharded
+	limiter      *rate.Limiter
+	progressFile string
+}
+
+func newShardRebalancer(s *sharded, cfg ReshardingConfig) *shardRebalancer {
+	bandwidth := cfg.RebalanceBandwidthLimitBytes
+	if bandwidth <= 0 {
+		bandwidth = DefaultRebalanceBandwidthLimitBytes
+	}
+	return &shardRebalancer{
+		sharded:      s,
+		limiter:      rate.NewLimiter(rate.Limit(bandwidth), int(bandwidth)),
+		progressFile: cfg.RebalanceProgressFile,
+	}
+}
+
+func (r *shardRebalancer) topology() string {
+	return fmt.Sprintf("%s->%s", r.sharded.previous, r.sharded.topology)
+}
+
+func (r *shardRebalancer) start() {
+	log.Infow("shard rebalancer startup", "topology", r.topology(), "progress_file", r.progressFile)
+	for {
+		err := r.lockAndRebalance(context.Background())
+		if err == nil {
+			return
+		}
+		log.Errorw("failed to rebalance shards, retry later", "error", err)
+		time.Sleep(rebalanceRetryInterval)
+	}
+}
+
+// lockAndRebalance rebalances the shards while holding the rebalancer lock, so the rebalancers enabled in
+// several processes do not move the pieces concurrently.
+func (r *shardRebalancer) lockAndRebalance(ctx context.Context) error {
+	unlock, err := r.sharded.locker.lock(ctx, rebalancerLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return r.rebalance(ctx)
+}
+
+func (r *shardRebalancer) rebalance(ctx context.Context) error {
+	progress, err := r.loadProgress()
+	if err != nil {
+		return err
+	}
+	for ; !progress.Done && progress.ShardIndex < len(r.sharded.stores); progress.ShardIndex++ {
+		for {
+			objs, err := r.sharded.stores[progress.ShardIndex].ListObjects(ctx, "", progress.Marker, "", rebalanceListBatchSize)
+			if err != nil {
+				return err
+			}
+			for _, o := range objs {
+				if owner := r.sharded.topology.owner(o.Key()); owner != progress.ShardIndex {
+					if err = r.move(ctx, progress.ShardIndex, owner, o); err != nil {
+						metrics.PieceStoreRebalanceCounter.WithLabelValues("failure").Inc()
+						return err
+					}
+					metrics.PieceStoreRebalanceCounter.WithLabelValues("success").Inc()
+					progress.Moved++
+				}
+				progress.Marker = o.Key()
+			}
+			if int64(len(objs)) < rebalanceListBatchSize {
+				break
+			}
+			if err = r.saveProgress(progress); err != nil {
+				return err
+			}
+		}
+		progress.Marker = ""
+	}
+	progress.Done = true
+	if err = r.saveProgress(progress); err != nil {
+		return err
+	}
+	log.Infow("finished to rebalance shards, the resharding config can be removed", "topology", progress.Topology,
+		"moved", progress.Moved)
+	return nil
+}
+
+// move copies the piece to its owner and deletes it from the source shard, if the piece has been written
+// to its owner after resharding, the copy in the source shard is stale and only deleted.
+func (r *shardRebalancer) move(ctx context.Context, from, to int, o Object) error {
+	unlock, err := r.sharded.locker.lock(ctx, o.Key())
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	src, dst := r.sharded.stores[from], r.sharded.stores[to]
+	if _, err := dst.HeadObject(ctx, o.Key()); err == nil {
+		return src.DeleteObject(ctx, o.Key())
+	}
+	for n := int(o.Size()); n > 0; n -= r.limiter.Burst() {
+		chunk := n
+		if chunk > r.limiter.Burst() {
+			chunk = r.limiter.Burst()
+		}
+		if err := r.limiter.WaitN(ctx, chunk); err != nil {
+			return err
+		}
+	}
+	rc, err := src.GetObject(ctx, o.Key(), 0, -1)
+	if err != nil {
+		return err
+	}
+	defer rc.Close()
+	if err = dst.PutObject(ctx, o.Key(), rc); err != nil {
+		return err
+	}
+	return src.DeleteObject(ctx, o.Key())
+}
+
+func (r *shardRebalancer) loadProgress() (*rebalanceProgress, error) {
+	progress := &rebalanceProgress{}
+	data, err := os.ReadFile(r.progressFile)
+	if err != nil && !errors.Is(err, os.ErrNotExist) {
+		return nil, err
+	}
+	if err == nil {
+		if err = json.Unmarshal(data, progress); err != nil {
+			return nil, err
+		}
+	}
+	if progress.Topology != r.topology() {
+		progress = &rebalanceProgress{Topology: r.topology()}
+	}
+	return progress, nil
+}
+
+func (r *shardRebalancer) saveProgress(progress *rebalanceProgress) error {
+	data, err := json.Marshal(progress)
+	if err != nil {
+		return err
+	}
+	tmp := filepath.Join(filepath.Dir(r.progressFile), "."+filepath.Base(r.progressFile)+".tmp")
+	if err = os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, r.progressFile)
+}
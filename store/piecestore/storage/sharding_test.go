@@ -3,8 +3,11 @@ package storage
 import (
 	"context"
 	"errors"
+	"fmt"
+	"hash/fnv"
 	"io"
 	"os"
+	"path/filepath"
 	"strings"
 	"testing"
 
@@ -307,3 +310,170 @@ func TestSharded_HeadObject(t *testing.T) {
 		})
 	}
 }
+
+func TestSharded_ListObjects(t *testing.T) {
+	s, err := NewSharded(PieceStoreConfig{
+		Shards: 4,
+		Store:  ObjectStorageConfig{Storage: MemoryStore, BucketURL: "list%d", IAMType: AKSKIAMType},
+	})
+	assert.Nil(t, err)
+	keys := []string{"s1_s0", "s1_s1", "s2_s0", "s3_s0", "s4_s0"}
+	for _, key := range keys {
+		assert.Nil(t, s.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+	// a piece not moved by the rebalancer yet is listed only once
+	other := s.(*sharded).stores[(s.(*sharded).topology.owner("s1_s0")+1)%4]
+	assert.Nil(t, other.PutObject(context.TODO(), "s1_s0", strings.NewReader("s1_s0")))
+
+	var listed []string
+	marker := ""
+	for {
+		objs, err := s.ListObjects(context.TODO(), "", marker, "", 2)
+		assert.Nil(t, err)
+		for _, o := range objs {
+			listed = append(listed, o.Key())
+			marker = o.Key()
+		}
+		if len(objs) < 2 {
+			break
+		}
+	}
+	assert.Equal(t, keys, listed)
+
+	_, err = s.ListObjects(context.TODO(), "", "", "/", 0)
+	assert.Equal(t, ErrUnsupportedDelimiter, err)
+}
+
+func TestNewShardTopology(t *testing.T) {
+	cases := []struct {
+		name      string
+		shards    int
+		placement string
+		weights   []uint32
+		wantedErr error
+	}{
+		{name: "default placement", shards: 2},
+		{name: "weighted rendezvous placement", shards: 2, placement: "Rendezvous", weights: []uint32{1, 0}},
+		{name: "invalid placement", shards: 2, placement: "unknown",
+			wantedErr: errors.New("invalid shard placement: unknown")},
+		{name: "weighted modulo placement", shards: 2, weights: []uint32{1, 2},
+			wantedErr: errors.New("shard weights are only supported by rendezvous placement")},
+		{name: "mismatched weights", shards: 2, placement: RendezvousPlacement, weights: []uint32{1},
+			wantedErr: errors.New("mismatched shard weights number: 1, shard number: 2")},
+		{name: "zero weights", shards: 2, placement: RendezvousPlacement, weights: []uint32{0, 0},
+			wantedErr: errors.New("all shard weights are zero")},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := newShardTopology(tt.shards, tt.placement, tt.weights)
+			assert.Equal(t, tt.wantedErr, err)
+		})
+	}
+}
+
+func TestShardTopology_Owner(t *testing.T) {
+	modulo, err := newShardTopology(5, "", nil)
+	assert.Nil(t, err)
+	rendezvous, err := newShardTopology(4, RendezvousPlacement, nil)
+	assert.Nil(t, err)
+	grown, err := newShardTopology(5, RendezvousPlacement, nil)
+	assert.Nil(t, err)
+	weighted, err := newShardTopology(2, RendezvousPlacement, []uint32{1, 3})
+	assert.Nil(t, err)
+
+	var (
+		keys          = 10000
+		counts        = make([]int, 4)
+		weightedCount = make([]int, 2)
+		moved         int
+	)
+	for i := 0; i < keys; i++ {
+		key := fmt.Sprintf("s%d_s0", i)
+		// the modulo placement keeps compatible with the placement before rendezvous is supported
+		h := fnv.New32a()
+		_, _ = h.Write([]byte(key))
+		assert.Equal(t, int(h.Sum32()%5), modulo.owner(key))
+
+		owner := rendezvous.owner(key)
+		counts[owner]++
+		if grownOwner := grown.owner(key); grownOwner != owner {
+			// the keys are only moved to the new shard
+			assert.Equal(t, 4, grownOwner)
+			moved++
+		}
+		weightedCount[weighted.owner(key)]++
+	}
+	for _, count := range counts {
+		assert.InDelta(t, keys/4, count, float64(keys)/40)
+	}
+	assert.InDelta(t, keys/5, moved, float64(keys)/40)
+	assert.InDelta(t, keys*3/4, weightedCount[1], float64(keys)/40)
+}
+
+func setupReshardingTest(t *testing.T, keys []string) *sharded {
+	previous, err := NewSharded(PieceStoreConfig{
+		Shards: 2,
+		Store:  ObjectStorageConfig{Storage: MemoryStore, BucketURL: "test%d"},
+	})
+	assert.Nil(t, err)
+	for _, key := range keys {
+		assert.Nil(t, previous.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+	s := previous.(*sharded)
+	s.stores = append(s.stores, &memoryStore{name: "test2", objects: make(map[string]*memoryObject)})
+	s.previous = s.topology
+	s.topology, err = newShardTopology(3, RendezvousPlacement, nil)
+	assert.Nil(t, err)
+	return s
+}
+
+func TestSharded_DualRead(t *testing.T) {
+	keys := []string{"s1_s0", "s2_s0", "s3_s0", "s4_s0", "s5_s0", "s6_s0"}
+	s := setupReshardingTest(t, keys)
+	for _, key := range keys {
+		rc, err := s.GetObject(context.TODO(), key, 0, -1)
+		assert.Nil(t, err)
+		data, err := io.ReadAll(rc)
+		assert.Nil(t, err)
+		assert.Equal(t, key, string(data))
+		_, err = s.HeadObject(context.TODO(), key)
+		assert.Nil(t, err)
+	}
+	for _, key := range keys {
+		assert.Nil(t, s.DeleteObject(context.TODO(), key))
+		_, err := s.GetObject(context.TODO(), key, 0, -1)
+		assert.Equal(t, ErrNoSuchObject, err)
+	}
+}
+
+func TestShardRebalancer_Rebalance(t *testing.T) {
+	keys := make([]string, 0, 100)
+	for i := 0; i < 100; i++ {
+		keys = append(keys, fmt.Sprintf("s%d_s0", i))
+	}
+	s := setupReshardingTest(t, keys)
+	cfg := ReshardingConfig{RebalanceProgressFile: filepath.Join(t.TempDir(), "rebalance.json")}
+	r := newShardRebalancer(s, cfg)
+	assert.Nil(t, r.lockAndRebalance(context.TODO()))
+
+	for _, key := range keys {
+		owner := s.topology.owner(key)
+		for i, store := range s.stores {
+			_, err := store.HeadObject(context.TODO(), key)
+			assert.Equal(t, i == owner, err == nil)
+		}
+	}
+	progress, err := r.loadProgress()
+	assert.Nil(t, err)
+	assert.True(t, progress.Done)
+	assert.Greater(t, progress.Moved, uint64(0))
+
+	// the finished rebalance is not repeated, and the progress of another resharding is discarded
+	assert.Nil(t, r.rebalance(context.TODO()))
+	s.previous, err = newShardTopology(3, RendezvousPlacement, []uint32{1, 1, 2})
+	assert.Nil(t, err)
+	progress, err = r.loadProgress()
+	assert.Nil(t, err)
+	assert.False(t, progress.Done)
+	assert.Equal(t, uint64(0), progress.Moved)
+}
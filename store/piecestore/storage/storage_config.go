@@ -4,8 +4,67 @@ package storage
 type PieceStoreConfig struct {
 	// Shards store the blocks into N buckets by hash of key
 	Shards int `comment:"required"`
+	// ShardPlacement the placement of keys to the shards, supports modulo(default) and rendezvous, only the
+	// rendezvous placement keeps the most keys in place when the shards are added, removed or reweighted
+	ShardPlacement string `comment:"optional"`
+	// ShardWeights the weights of the shards for the rendezvous placement, the shards are equally weighted if
+	// it is empty, a shard with zero weight owns no keys and can be drained
+	ShardWeights []uint32 `comment:"optional"`
+	// Resharding config of moving the pieces from the previous shard topology
+	Resharding ReshardingConfig
 	// Store config of object storage
 	Store ObjectStorageConfig
+	// Tiering config of placing pieces across a hot and a cold tier
+	Tiering TieringConfig
+}
+
+// ReshardingConfig resharding config, during resharding the pieces are written to their owners in the current
+// topology and the reads fall back to their owners in the previous topology, until the rebalancer moves all
+// pieces to their current owners.
+type ReshardingConfig struct {
+	// PreviousShards the shard number of the previous topology, the resharding is disabled if it is zero
+	PreviousShards int `comment:"optional"`
+	// PreviousShardPlacement the shard placement of the previous topology
+	PreviousShardPlacement string `comment:"optional"`
+	// PreviousShardWeights the shard weights of the previous topology
+	PreviousShardWeights []uint32 `comment:"optional"`
+	// EnableRebalancer starts the rebalancer in this process, the rebalancers hold a lock named by LockDSN, so
+	// only one of the processes rebalances at a time
+	EnableRebalancer bool `comment:"optional"`
+	// RebalanceBandwidthLimitBytes the max bytes per second moved by the rebalancer
+	RebalanceBandwidthLimitBytes int64 `comment:"optional"`
+	// RebalanceProgressFile the file that the rebalance progress is persisted to, the rebalancer resumes
+	// from it after restarting
+	RebalanceProgressFile string `comment:"optional"`
+	// LockDSN the mysql dsn of the named locks that serialize the writes, the deletions and the moves of the
+	// same piece across the processes, it is required during resharding
+	LockDSN string `comment:"optional"`
+	// LockTimeoutSecond the seconds to wait for the lock of a piece
+	LockTimeoutSecond int64 `comment:"optional"`
+}
+
+// TieringConfig tiered piece store config, the Store is used as the hot tier and the new pieces are
+// written to it, the background mover demotes the pieces to the cold tier by their age and access
+// frequency, and the reads fall back to the cold tier if the piece is not in the hot tier.
+type TieringConfig struct {
+	// ColdStore config of the cold tier object storage, the tiering is disabled if its Storage is empty
+	ColdStore ObjectStorageConfig
+	// DemoteAgeSecond the pieces written to the hot tier longer than it are demoted to the cold tier
+	DemoteAgeSecond int64 `comment:"optional"`
+	// HotReadThreshold the pieces read by downloads at least this number of times in the last DemoteAgeSecond
+	// are kept in the hot tier, zero means DefaultHotReadThreshold
+	HotReadThreshold int64 `comment:"optional"`
+	// MoveIntervalSecond the interval of the background mover sweeping the tiers
+	MoveIntervalSecond int64 `comment:"optional"`
+	// EnableMover starts the background mover in this process, the movers hold a lock named by LockDSN, so
+	// only one of the processes moves at a time
+	EnableMover bool `comment:"optional"`
+	// LockDSN the mysql dsn of the named locks that serialize the writes, the deletions and the demotions of
+	// the same piece across the processes, the download reads counted by all processes are shared with the
+	// mover in the same database, it is required by the tiering
+	LockDSN string `comment:"optional"`
+	// LockTimeoutSecond the seconds to wait for the lock of a piece
+	LockTimeoutSecond int64 `comment:"optional"`
 }
 
 // ObjectStorageConfig object storage config
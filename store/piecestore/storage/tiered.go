@@ -0,0 +1,384 @@
+package storage
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"sync"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/metrics"
+)
+
+const (
+	// DefaultDemoteAgeSecond defines the default age of the pieces to be demoted to the cold tier.
+	DefaultDemoteAgeSecond int64 = 7 * 24 * 60 * 60
+	// DefaultHotReadThreshold defines the default download reads in the last DemoteAgeSecond that keep a
+	// piece in the hot tier.
+	DefaultHotReadThreshold int64 = 3
+	// DefaultTierMoveIntervalSecond defines the default interval of the background mover.
+	DefaultTierMoveIntervalSecond int64 = 10 * 60
+
+	// tierListBatchSize is the number of pieces listed from a tier in one request.
+	tierListBatchSize int64 = 1000
+	// maxTrackedPieceAccesses is the max number of pieces whose accesses are tracked, the expired records are
+	// dropped when it is exceeded, and all records are dropped if it is still exceeded to avoid leaking memory.
+	maxTrackedPieceAccesses = 1 << 20
+)
+
+type tiered struct {
+	hot  ObjectStorage
+	cold ObjectStorage
+	cfg  TieringConfig
+	// accesses counts the download reads of the pieces, it is shared by the processes if LockDSN is set,
+	// since the reads are served by the downloader while the mover may run in another process.
+	accesses pieceAccessStore
+	// locker serializes the writes, the deletions and the demotions of the same piece, it is shared by the
+	// processes if LockDSN is set since the mover may demote a piece that is written by another process.
+	locker pieceLocker
+}
+
+// NewTiered returns an object storage that places the pieces across the hot tier and the cold tier
+// configured by cfg, and starts the background mover demoting the pieces if it is enabled.
+func NewTiered(hot ObjectStorage, cfg TieringConfig) (ObjectStorage, error) {
+	cold, err := NewObjectStorage(cfg.ColdStore)
+	if err != nil {
+		return nil, err
+	}
+	t := newTiered(hot, cold, cfg)
+	if t.cfg.LockDSN != "" {
+		locker, err := newMySQLPieceLocker(t.cfg.LockDSN, t.cfg.LockTimeoutSecond)
+		if err != nil {
+			log.Errorw("failed to connect the piece lock db", "error", err)
+			return nil, err
+		}
+		accesses, err := newMySQLPieceAccessStore(locker.db, time.Duration(t.cfg.DemoteAgeSecond)*time.Second)
+		if err != nil {
+			log.Errorw("failed to prepare the piece access table", "error", err)
+			return nil, err
+		}
+		t.locker, t.accesses = locker, accesses
+		go accesses.startFlusher()
+	}
+	if t.cfg.EnableMover {
+		go t.startMover()
+	}
+	return t, nil
+}
+
+func newTiered(hot, cold ObjectStorage, cfg TieringConfig) *tiered {
+	if cfg.DemoteAgeSecond <= 0 {
+		cfg.DemoteAgeSecond = DefaultDemoteAgeSecond
+	}
+	if cfg.MoveIntervalSecond <= 0 {
+		cfg.MoveIntervalSecond = DefaultTierMoveIntervalSecond
+	}
+	if cfg.HotReadThreshold <= 0 {
+		cfg.HotReadThreshold = DefaultHotReadThreshold
+	}
+	return &tiered{
+		hot:      hot,
+		cold:     cold,
+		cfg:      cfg,
+		accesses: &localPieceAccessStore{tracker: newPieceAccessTracker(time.Duration(cfg.DemoteAgeSecond) * time.Second)},
+		locker:   &stripedPieceLocker{},
+	}
+}
+
+func (t *tiered) String() string {
+	return fmt.Sprintf("tiered://%s|%s", t.hot, t.cold)
+}
+
+func (t *tiered) CreateBucket(ctx context.Context) error {
+	if err := t.hot.CreateBucket(ctx); err != nil {
+		return err
+	}
+	return t.cold.CreateBucket(ctx)
+}
+
+func (t *tiered) GetObject(ctx context.Context, key string, offset, limit int64) (io.ReadCloser, error) {
+	rc, err := t.hot.GetObject(ctx, key, offset, limit)
+	if err == nil {
+		if piecestore.IsDownloadRead(ctx) {
+			t.accesses.record(key, time.Now())
+		}
+		metrics.PieceStoreTierReadCounter.WithLabelValues(HotTier).Inc()
+		return rc, nil
+	}
+	rc, err = t.cold.GetObject(ctx, key, offset, limit)
+	if err != nil {
+		return nil, err
+	}
+	metrics.PieceStoreTierReadCounter.WithLabelValues(ColdTier).Inc()
+	return rc, nil
+}
+
+func (t *tiered) PutObject(ctx context.Context, key string, reader io.Reader) error {
+	unlock, err := t.locker.lock(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	return t.hot.PutObject(ctx, key, reader)
+}
+
+func (t *tiered) DeleteObject(ctx context.Context, key string) error {
+	unlock, err := t.locker.lock(ctx, key)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	if err = t.accesses.remove(ctx, key); err != nil {
+		log.CtxWarnw(ctx, "failed to remove piece accesses", "key", key, "error", err)
+	}
+	if err = t.hot.DeleteObject(ctx, key); err != nil {
+		return err
+	}
+	return t.cold.DeleteObject(ctx, key)
+}
+
+func (t *tiered) DeleteObjectsByPrefix(ctx context.Context, key string) (uint64, error) {
+	hotSize, err := t.hot.DeleteObjectsByPrefix(ctx, key)
+	if err != nil {
+		return hotSize, err
+	}
+	coldSize, err := t.cold.DeleteObjectsByPrefix(ctx, key)
+	return hotSize + coldSize, err
+}
+
+func (t *tiered) HeadBucket(ctx context.Context) error {
+	if err := t.hot.HeadBucket(ctx); err != nil {
+		return err
+	}
+	return t.cold.HeadBucket(ctx)
+}
+
+func (t *tiered) HeadObject(ctx context.Context, key string) (Object, error) {
+	if o, err := t.hot.HeadObject(ctx, key); err == nil {
+		return o, nil
+	}
+	return t.cold.HeadObject(ctx, key)
+}
+
+func (t *tiered) ListObjects(ctx context.Context, prefix, marker, delimiter string, limit int64) ([]Object, error) {
+	return nil, ErrUnsupportedMethod
+}
+
+func (t *tiered) ListAllObjects(ctx context.Context, prefix, marker string) (<-chan Object, error) {
+	return nil, ErrUnsupportedMethod
+}
+
+func (t *tiered) startMover() {
+	log.Infow("tiered piece store mover startup", "hot", t.hot, "cold", t.cold, "demote_age_second",
+		t.cfg.DemoteAgeSecond, "hot_read_threshold", t.cfg.HotReadThreshold)
+	ticker := time.NewTicker(time.Duration(t.cfg.MoveIntervalSecond) * time.Second)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := t.lockAndMove(context.Background(), time.Now()); err != nil {
+			log.Warnw("failed to lock tier mover, retry later", "error", err)
+		}
+	}
+}
+
+// lockAndMove sweeps the hot tier while holding the mover lock, so the movers enabled in several processes
+// do not demote the pieces concurrently.
+func (t *tiered) lockAndMove(ctx context.Context, now time.Time) error {
+	unlock, err := t.locker.lock(ctx, tierMoverLockName)
+	if err != nil {
+		return err
+	}
+	defer unlock()
+	t.move(ctx, now)
+	return nil
+}
+
+// move sweeps the hot tier and demotes the pieces that are old enough and not read frequently, then
+// updates the usage metrics of the hot tier, the cold tier is not listed since it is usually much larger,
+// and the demoted bytes are counted instead.
+func (t *tiered) move(ctx context.Context, now time.Time) {
+	var (
+		demoteAge    = time.Duration(t.cfg.DemoteAgeSecond) * time.Second
+		hotUsage     int64
+		demoted      int
+		demotedBytes int64
+	)
+	err := t.walk(ctx, t.hot, func(o Object) {
+		if now.Sub(o.ModTime()) < demoteAge {
+			hotUsage += o.Size()
+			return
+		}
+		hot, err := t.accesses.isHot(ctx, o.Key(), now, t.cfg.HotReadThreshold)
+		if err != nil {
+			log.Errorw("failed to get piece accesses, keep it in hot tier", "key", o.Key(), "error", err)
+		}
+		if err != nil || hot {
+			hotUsage += o.Size()
+			return
+		}
+		demotedPiece, err := t.demote(ctx, o)
+		if err != nil {
+			log.Errorw("failed to demote piece to cold tier", "key", o.Key(), "error", err)
+			metrics.PieceStoreTierMoveCounter.WithLabelValues("failure").Inc()
+			hotUsage += o.Size()
+			return
+		}
+		if !demotedPiece {
+			hotUsage += o.Size()
+			return
+		}
+		metrics.PieceStoreTierMoveCounter.WithLabelValues("success").Inc()
+		metrics.PieceStoreTierDemotedBytesCounter.Add(float64(o.Size()))
+		demoted++
+		demotedBytes += o.Size()
+	})
+	if err != nil {
+		log.Errorw("failed to sweep hot tier", "hot", t.hot, "error", err)
+		return
+	}
+	metrics.PieceStoreTierUsageGauge.WithLabelValues(HotTier).Set(float64(hotUsage))
+	if err = t.accesses.expire(ctx, now); err != nil {
+		log.Errorw("failed to expire piece accesses", "error", err)
+	}
+	log.Infow("finished to move pieces across tiers", "demoted", demoted, "demoted_bytes", demotedBytes,
+		"hot_usage", hotUsage)
+}
+
+// walk lists all pieces in the tier in one pass if it is supported, otherwise page by page.
+func (t *tiered) walk(ctx context.Context, tier ObjectStorage, fn func(o Object)) error {
+	ctx, cancel := context.WithCancel(ctx)
+	defer cancel()
+	objs, err := tier.ListAllObjects(ctx, "", "")
+	if err == nil {
+		for o := range objs {
+			fn(o)
+		}
+		return nil
+	}
+	if !errors.Is(err, ErrUnsupportedMethod) {
+		return err
+	}
+	marker := ""
+	for {
+		objs, err := tier.ListObjects(ctx, "", marker, "", tierListBatchSize)
+		if err != nil {
+			return err
+		}
+		for _, o := range objs {
+			fn(o)
+			marker = o.Key()
+		}
+		if int64(len(objs)) < tierListBatchSize {
+			return nil
+		}
+	}
+}
+
+// demote copies the piece to the cold tier and deletes it from the hot tier, the reads during the copy
+// are served by the hot tier and the reads after the deletion fall back to the cold tier. The piece is
+// compared with the listed one under the lock, and it is kept in the hot tier and false is returned if it
+// is deleted or rewritten since listed.
+func (t *tiered) demote(ctx context.Context, listed Object) (bool, error) {
+	key := listed.Key()
+	unlock, err := t.locker.lock(ctx, key)
+	if err != nil {
+		return false, err
+	}
+	defer unlock()
+	current, err := t.hot.HeadObject(ctx, key)
+	if err != nil {
+		log.Infow("piece is deleted since listed, skip demoting", "key", key, "error", err)
+		return false, nil
+	}
+	if current.Size() != listed.Size() || !current.ModTime().Equal(listed.ModTime()) {
+		log.Infow("piece is rewritten since listed, skip demoting", "key", key)
+		return false, nil
+	}
+	rc, err := t.hot.GetObject(ctx, key, 0, -1)
+	if err != nil {
+		return false, err
+	}
+	defer rc.Close()
+	if err = t.cold.PutObject(ctx, key, rc); err != nil {
+		return false, err
+	}
+	if err = t.accesses.remove(ctx, key); err != nil {
+		log.Warnw("failed to remove piece accesses", "key", key, "error", err)
+	}
+	return true, t.hot.DeleteObject(ctx, key)
+}
+
+// pieceAccess is the download reads of a piece in the window starting from since.
+type pieceAccess struct {
+	count int64
+	since time.Time
+}
+
+// pieceAccessTracker tracks the download reads of the pieces in a sliding window in memory.
+type pieceAccessTracker struct {
+	mu       sync.Mutex
+	window   time.Duration
+	accesses map[string]*pieceAccess
+}
+
+func newPieceAccessTracker(window time.Duration) *pieceAccessTracker {
+	return &pieceAccessTracker{window: window, accesses: make(map[string]*pieceAccess)}
+}
+
+func (p *pieceAccessTracker) record(key string, now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	access, ok := p.accesses[key]
+	if !ok || now.Sub(access.since) >= p.window {
+		if !ok && len(p.accesses) >= maxTrackedPieceAccesses {
+			p.expireLocked(now)
+			if len(p.accesses) >= maxTrackedPieceAccesses {
+				p.accesses = make(map[string]*pieceAccess)
+			}
+		}
+		p.accesses[key] = &pieceAccess{count: 1, since: now}
+		return
+	}
+	access.count++
+}
+
+func (p *pieceAccessTracker) isHot(key string, now time.Time, threshold int64) bool {
+	if threshold <= 0 {
+		return false
+	}
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	access, ok := p.accesses[key]
+	return ok && now.Sub(access.since) < p.window && access.count >= threshold
+}
+
+func (p *pieceAccessTracker) remove(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.accesses, key)
+}
+
+func (p *pieceAccessTracker) expire(now time.Time) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	p.expireLocked(now)
+}
+
+func (p *pieceAccessTracker) expireLocked(now time.Time) {
+	for key, access := range p.accesses {
+		if now.Sub(access.since) >= p.window {
+			delete(p.accesses, key)
+		}
+	}
+}
+
+// drain returns the tracked accesses and resets the tracker.
+func (p *pieceAccessTracker) drain() map[string]*pieceAccess {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	accesses := p.accesses
+	p.accesses = make(map[string]*pieceAccess)
+	return accesses
+}
@@ -0,0 +1,148 @@
+package storage
+
+import (
+	"context"
+	"database/sql"
+	"errors"
+	"strings"
+	"time"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/log"
+)
+
+const (
+	// pieceAccessTableName is the table of the download reads shared by the processes.
+	pieceAccessTableName = "piece_tier_accesses"
+	// pieceAccessFlushInterval is the interval of flushing the download reads counted by this process.
+	pieceAccessFlushInterval = time.Minute
+	// pieceAccessFlushBatchSize is the number of pieces whose reads are flushed in one statement.
+	pieceAccessFlushBatchSize = 500
+)
+
+// pieceAccessStore counts the download reads of the pieces in a sliding window, the mover keeps the pieces
+// read at least the threshold times in the window in the hot tier.
+type pieceAccessStore interface {
+	record(key string, now time.Time)
+	isHot(ctx context.Context, key string, now time.Time, threshold int64) (bool, error)
+	remove(ctx context.Context, key string) error
+	expire(ctx context.Context, now time.Time) error
+}
+
+// localPieceAccessStore counts the reads in memory, it is used if the reads are served by the same
+// process as the mover.
+type localPieceAccessStore struct {
+	tracker *pieceAccessTracker
+}
+
+func (l *localPieceAccessStore) record(key string, now time.Time) {
+	l.tracker.record(key, now)
+}
+
+func (l *localPieceAccessStore) isHot(ctx context.Context, key string, now time.Time, threshold int64) (bool, error) {
+	return l.tracker.isHot(key, now, threshold), nil
+}
+
+func (l *localPieceAccessStore) remove(ctx context.Context, key string) error {
+	l.tracker.remove(key)
+	return nil
+}
+
+func (l *localPieceAccessStore) expire(ctx context.Context, now time.Time) error {
+	l.tracker.expire(now)
+	return nil
+}
+
+// mysqlPieceAccessStore shares the reads across the processes by a mysql table, the reads are counted in
+// memory and flushed to the table periodically, so the reads of the last flush interval are not seen by
+// the mover yet.
+type mysqlPieceAccessStore struct {
+	db      *sql.DB
+	window  time.Duration
+	pending *pieceAccessTracker
+}
+
+func newMySQLPieceAccessStore(db *sql.DB, window time.Duration) (*mysqlPieceAccessStore, error) {
+	ctx, cancel := context.WithTimeout(context.Background(), 10*time.Second)
+	defer cancel()
+	if _, err := db.ExecContext(ctx, "CREATE TABLE IF NOT EXISTS "+pieceAccessTableName+
+		" (piece_key VARCHAR(255) NOT NULL PRIMARY KEY, read_count BIGINT NOT NULL, since BIGINT NOT NULL, INDEX idx_since (since))"); err != nil {
+		return nil, err
+	}
+	return &mysqlPieceAccessStore{db: db, window: window, pending: newPieceAccessTracker(window)}, nil
+}
+
+func (m *mysqlPieceAccessStore) startFlusher() {
+	ticker := time.NewTicker(pieceAccessFlushInterval)
+	defer ticker.Stop()
+	for range ticker.C {
+		if err := m.flush(context.Background()); err != nil {
+			log.Errorw("failed to flush piece accesses", "error", err)
+		}
+	}
+}
+
+// flush adds the reads counted since the last flush to the table, the count of a piece is reset if the
+// window of its record is expired.
+func (m *mysqlPieceAccessStore) flush(ctx context.Context) error {
+	accesses := m.pending.drain()
+	if len(accesses) == 0 {
+		return nil
+	}
+	window := int64(m.window / time.Second)
+	values := make([]string, 0, pieceAccessFlushBatchSize)
+	args := make([]interface{}, 0, 3*pieceAccessFlushBatchSize+2)
+	exec := func() error {
+		args = append(args, window, window)
+		_, err := m.db.ExecContext(ctx, "INSERT INTO "+pieceAccessTableName+" (piece_key, read_count, since) VALUES "+
+			strings.Join(values, ", ")+" ON DUPLICATE KEY UPDATE "+
+			"read_count = IF(since <= VALUES(since) - ?, VALUES(read_count), read_count + VALUES(read_count)), "+
+			"since = IF(since <= VALUES(since) - ?, VALUES(since), since)", args...)
+		values, args = values[:0], args[:0]
+		return err
+	}
+	for key, access := range accesses {
+		values = append(values, "(?, ?, ?)")
+		args = append(args, key, access.count, access.since.Unix())
+		if len(values) == pieceAccessFlushBatchSize {
+			if err := exec(); err != nil {
+				return err
+			}
+		}
+	}
+	if len(values) == 0 {
+		return nil
+	}
+	return exec()
+}
+
+func (m *mysqlPieceAccessStore) record(key string, now time.Time) {
+	m.pending.record(key, now)
+}
+
+func (m *mysqlPieceAccessStore) isHot(ctx context.Context, key string, now time.Time, threshold int64) (bool, error) {
+	if threshold <= 0 {
+		return false, nil
+	}
+	var count int64
+	err := m.db.QueryRowContext(ctx, "SELECT read_count FROM "+pieceAccessTableName+" WHERE piece_key = ? AND since > ?",
+		key, now.Add(-m.window).Unix()).Scan(&count)
+	if errors.Is(err, sql.ErrNoRows) {
+		return false, nil
+	}
+	if err != nil {
+		return false, err
+	}
+	return count >= threshold, nil
+}
+
+func (m *mysqlPieceAccessStore) remove(ctx context.Context, key string) error {
+	m.pending.remove(key)
+	_, err := m.db.ExecContext(ctx, "DELETE FROM "+pieceAccessTableName+" WHERE piece_key = ?", key)
+	return err
+}
+
+func (m *mysqlPieceAccessStore) expire(ctx context.Context, now time.Time) error {
+	m.pending.expire(now)
+	_, err := m.db.ExecContext(ctx, "DELETE FROM "+pieceAccessTableName+" WHERE since <= ?", now.Add(-m.window).Unix())
+	return err
+}
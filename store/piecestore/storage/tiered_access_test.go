@@ -0,0 +1,54 @@
+package storage
+
+import (
+	"context"
+	"regexp"
+	"testing"
+	"time"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+)
+
+func TestMySQLPieceAccessStore(t *testing.T) {
+	db, mock, err := sqlmock.New()
+	assert.Nil(t, err)
+	defer db.Close()
+	mock.ExpectExec(regexp.QuoteMeta("CREATE TABLE IF NOT EXISTS piece_tier_accesses")).
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	store, err := newMySQLPieceAccessStore(db, time.Hour)
+	assert.Nil(t, err)
+
+	now := time.Now()
+	store.record(mockKey, now)
+	store.record(mockKey, now.Add(time.Second))
+	mock.ExpectExec(regexp.QuoteMeta("INSERT INTO piece_tier_accesses (piece_key, read_count, since) VALUES (?, ?, ?) "+
+		"ON DUPLICATE KEY UPDATE read_count = IF(since <= VALUES(since) - ?, VALUES(read_count), read_count + VALUES(read_count)), "+
+		"since = IF(since <= VALUES(since) - ?, VALUES(since), since)")).
+		WithArgs(mockKey, int64(2), now.Unix(), int64(3600), int64(3600)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, store.flush(context.TODO()))
+	// nothing is flushed if no reads are counted
+	assert.Nil(t, store.flush(context.TODO()))
+
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT read_count FROM piece_tier_accesses WHERE piece_key = ? AND since > ?")).
+		WithArgs(mockKey, now.Add(-time.Hour).Unix()).
+		WillReturnRows(sqlmock.NewRows([]string{"read_count"}).AddRow(2))
+	hot, err := store.isHot(context.TODO(), mockKey, now, 2)
+	assert.Nil(t, err)
+	assert.True(t, hot)
+	mock.ExpectQuery(regexp.QuoteMeta("SELECT read_count FROM piece_tier_accesses WHERE piece_key = ? AND since > ?")).
+		WithArgs("no_key", now.Add(-time.Hour).Unix()).
+		WillReturnRows(sqlmock.NewRows([]string{"read_count"}))
+	hot, err = store.isHot(context.TODO(), "no_key", now, 2)
+	assert.Nil(t, err)
+	assert.False(t, hot)
+
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM piece_tier_accesses WHERE piece_key = ?")).WithArgs(mockKey).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, store.remove(context.TODO(), mockKey))
+	mock.ExpectExec(regexp.QuoteMeta("DELETE FROM piece_tier_accesses WHERE since <= ?")).
+		WithArgs(now.Add(-time.Hour).Unix()).WillReturnResult(sqlmock.NewResult(0, 1))
+	assert.Nil(t, store.expire(context.TODO(), now))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
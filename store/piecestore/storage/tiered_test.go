@@ -0,0 +1,170 @@
+package storage
+
+import (
+	"context"
+	"io"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/piecestore"
+)
+
+func setupTieredTest(t *testing.T, cfg TieringConfig) *tiered {
+	hot, err := newMemoryStore(ObjectStorageConfig{BucketURL: "hot"})
+	assert.Nil(t, err)
+	cold, err := newMemoryStore(ObjectStorageConfig{BucketURL: "cold"})
+	assert.Nil(t, err)
+	return newTiered(hot, cold, cfg)
+}
+
+func readTieredObject(t *testing.T, ctx context.Context, store ObjectStorage, key string) string {
+	rc, err := store.GetObject(ctx, key, 0, -1)
+	assert.Nil(t, err)
+	data, err := io.ReadAll(rc)
+	assert.Nil(t, err)
+	return string(data)
+}
+
+func TestNewTiered(t *testing.T) {
+	hot, err := newMemoryStore(ObjectStorageConfig{BucketURL: "hot"})
+	assert.Nil(t, err)
+	store, err := NewTiered(hot, TieringConfig{ColdStore: ObjectStorageConfig{Storage: MemoryStore, BucketURL: "cold"}})
+	assert.Nil(t, err)
+	assert.Equal(t, "tiered://memory://hot/|memory://cold/", store.String())
+
+	_, err = NewTiered(hot, TieringConfig{ColdStore: ObjectStorageConfig{Storage: "unknown"}})
+	assert.NotNil(t, err)
+}
+
+func TestTiered_ReadFallback(t *testing.T) {
+	store := setupTieredTest(t, TieringConfig{})
+	assert.Nil(t, store.PutObject(context.TODO(), "hot_key", strings.NewReader("hot")))
+	assert.Nil(t, store.cold.PutObject(context.TODO(), "cold_key", strings.NewReader("cold")))
+
+	assert.Equal(t, "hot", readTieredObject(t, context.TODO(), store, "hot_key"))
+	assert.Equal(t, "cold", readTieredObject(t, context.TODO(), store, "cold_key"))
+	_, err := store.GetObject(context.TODO(), "no_key", 0, -1)
+	assert.Equal(t, ErrNoSuchObject, err)
+
+	o, err := store.HeadObject(context.TODO(), "cold_key")
+	assert.Nil(t, err)
+	assert.Equal(t, int64(len("cold")), o.Size())
+
+	assert.Nil(t, store.DeleteObject(context.TODO(), "cold_key"))
+	_, err = store.GetObject(context.TODO(), "cold_key", 0, -1)
+	assert.Equal(t, ErrNoSuchObject, err)
+}
+
+func TestTiered_Move(t *testing.T) {
+	store := setupTieredTest(t, TieringConfig{DemoteAgeSecond: 3600, HotReadThreshold: 2})
+	for _, key := range []string{"s1_s0", "s2_s0", "s3_s0"} {
+		assert.Nil(t, store.PutObject(context.TODO(), key, strings.NewReader(key)))
+	}
+	now := time.Now()
+	// only the reads serving downloads are tracked
+	downloadCtx := piecestore.ContextWithDownloadRead(context.TODO())
+	readTieredObject(t, downloadCtx, store, "s1_s0")
+	readTieredObject(t, downloadCtx, store, "s1_s0")
+	readTieredObject(t, context.TODO(), store, "s2_s0")
+	readTieredObject(t, context.TODO(), store, "s2_s0")
+	hot, err := store.accesses.isHot(context.TODO(), "s1_s0", now, 2)
+	assert.Nil(t, err)
+	assert.True(t, hot)
+	hot, err = store.accesses.isHot(context.TODO(), "s2_s0", now, 1)
+	assert.Nil(t, err)
+	assert.False(t, hot)
+
+	// the pieces are too young to be demoted
+	store.move(context.TODO(), now)
+	objs, err := store.cold.ListObjects(context.TODO(), "", "", "", tierListBatchSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+
+	// s1_s0 is read frequently in the last hour
+	assert.Nil(t, store.accesses.remove(context.TODO(), "s1_s0"))
+	store.accesses.record("s1_s0", now.Add(50*time.Minute))
+	store.accesses.record("s1_s0", now.Add(55*time.Minute))
+	assert.Nil(t, store.lockAndMove(context.TODO(), now.Add(90*time.Minute)))
+	objs, err = store.cold.ListObjects(context.TODO(), "", "", "", tierListBatchSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 2, len(objs))
+	assert.Equal(t, "s2_s0", objs[0].Key())
+	assert.Equal(t, "s3_s0", objs[1].Key())
+	_, err = store.hot.HeadObject(context.TODO(), "s2_s0")
+	assert.NotNil(t, err)
+	assert.Equal(t, "s2_s0", readTieredObject(t, context.TODO(), store, "s2_s0"))
+
+	// the reads of s1_s0 are out of the window
+	store.move(context.TODO(), now.Add(3*time.Hour))
+	objs, err = store.hot.ListObjects(context.TODO(), "", "", "", tierListBatchSize)
+	assert.Nil(t, err)
+	assert.Equal(t, 0, len(objs))
+	assert.Equal(t, "s1_s0", readTieredObject(t, downloadCtx, store, "s1_s0"))
+}
+
+func TestTiered_DefaultHotReadThreshold(t *testing.T) {
+	store := setupTieredTest(t, TieringConfig{})
+	assert.Equal(t, DefaultHotReadThreshold, store.cfg.HotReadThreshold)
+	assert.Equal(t, DefaultDemoteAgeSecond, store.cfg.DemoteAgeSecond)
+}
+
+func TestTiered_DemoteChangedPiece(t *testing.T) {
+	store := setupTieredTest(t, TieringConfig{})
+	assert.Nil(t, store.PutObject(context.TODO(), "s1_s0", strings.NewReader("old")))
+	listed, err := store.hot.HeadObject(context.TODO(), "s1_s0")
+	assert.Nil(t, err)
+
+	// the piece is rewritten by another process after it is listed by the mover
+	time.Sleep(time.Millisecond)
+	assert.Nil(t, store.PutObject(context.TODO(), "s1_s0", strings.NewReader("new")))
+	demoted, err := store.demote(context.TODO(), listed)
+	assert.Nil(t, err)
+	assert.False(t, demoted)
+	_, err = store.cold.HeadObject(context.TODO(), "s1_s0")
+	assert.NotNil(t, err)
+
+	// the piece is deleted by another process after it is listed by the mover
+	listed, err = store.hot.HeadObject(context.TODO(), "s1_s0")
+	assert.Nil(t, err)
+	assert.Nil(t, store.DeleteObject(context.TODO(), "s1_s0"))
+	demoted, err = store.demote(context.TODO(), listed)
+	assert.Nil(t, err)
+	assert.False(t, demoted)
+	_, err = store.cold.HeadObject(context.TODO(), "s1_s0")
+	assert.NotNil(t, err)
+
+	assert.Nil(t, store.PutObject(context.TODO(), "s1_s0", strings.NewReader("new")))
+	listed, err = store.hot.HeadObject(context.TODO(), "s1_s0")
+	assert.Nil(t, err)
+	demoted, err = store.demote(context.TODO(), listed)
+	assert.Nil(t, err)
+	assert.True(t, demoted)
+	assert.Equal(t, "new", readTieredObject(t, context.TODO(), store.cold, "s1_s0"))
+}
+
+func TestPieceAccessTracker(t *testing.T) {
+	now := time.Now()
+	tracker := newPieceAccessTracker(time.Minute)
+	assert.False(t, tracker.isHot("key", now, 1))
+	tracker.record("key", now)
+	assert.True(t, tracker.isHot("key", now, 1))
+	assert.False(t, tracker.isHot("key", now, 2))
+	assert.False(t, tracker.isHot("key", now, 0))
+	tracker.record("key", now.Add(time.Second))
+	assert.True(t, tracker.isHot("key", now.Add(time.Second), 2))
+	assert.False(t, tracker.isHot("key", now.Add(time.Minute), 1))
+
+	tracker.record("key", now.Add(2*time.Minute))
+	assert.True(t, tracker.isHot("key", now.Add(2*time.Minute), 1))
+	assert.False(t, tracker.isHot("key", now.Add(2*time.Minute), 2))
+	tracker.expire(now.Add(3 * time.Minute))
+	assert.Equal(t, 0, len(tracker.accesses))
+
+	tracker.record("key", now)
+	accesses := tracker.drain()
+	assert.Equal(t, int64(1), accesses["key"].count)
+	assert.Equal(t, 0, len(tracker.accesses))
+}
@@ -20,6 +20,12 @@ const (
 	BucketTrafficTableName = "bucket_traffic"
 	// ReadRecordTableName defines the read record table name.
 	ReadRecordTableName = "read_record"
+	// ReadQuotaReservationTableName defines the read quota reservation table name, which is used for recording the
+	// quota reserved by the read records that have not been settled.
+	ReadQuotaReservationTableName = "read_quota_reservation"
+	// ReadRecordAuditTableName defines the read record audit table name, which is used for recording the quota changes
+	// of the read records.
+	ReadRecordAuditTableName = "read_record_audit"
 	// ServiceConfigTableName defines the SP configuration table name.
 	ServiceConfigTableName = "service_config"
 	// OffChainAuthKeyTableName defines the off chain auth key table name.
@@ -54,6 +60,14 @@ const (
 	RecoverFailedObjectTableName = "recover_failed_object"
 	// MigrateBucketProgressTableName defines the progress of migrate bucket.
 	MigrateBucketProgressTableName = "migrate_bucket_progress"
+	// ScrubCursorTableName defines the sweep cursor of the background piece scrubber.
+	ScrubCursorTableName = "scrub_cursor"
+	// UsageRollupTableName defines the daily usage rollups of the buckets metered by the SP.
+	UsageRollupTableName = "usage_rollup"
+	// NotificationSubscriptionTableName defines the notification subscriptions of the buckets.
+	NotificationSubscriptionTableName = "notification_subscription"
+	// NotificationDeadLetterTableName defines the notifications that fail to be delivered after retrying.
+	NotificationDeadLetterTableName = "notification_dead_letter"
 )
 
 // define error name constant.
@@ -7,4 +7,6 @@ import (
 var (
 	// ErrCheckQuotaEnough defines check quota is enough
 	ErrCheckQuotaEnough = errors.New("quota is not enough")
+	// ErrReadQuotaReservationNotFound defines the read quota reservation is not found, it has been settled or expired
+	ErrReadQuotaReservationNotFound = errors.New("read quota reservation is not found")
 )
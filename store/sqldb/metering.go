@@ -0,0 +1,130 @@
+package sqldb
+
+import (
+	"database/sql"
+	"fmt"
+
+	"gorm.io/gorm"
+	"gorm.io/gorm/clause"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// AggregateReadUsage aggregates the read records in [startTimestampUs, endTimestampUs) by bucket, the read records
+// whose quota is still reserved are aggregated by the reserved size.
+func (s *SpDBImpl) AggregateReadUsage(startTimestampUs, endTimestampUs int64) ([]*spdb.ReadUsage, error) {
+	var queryReturns []struct {
+		BucketID   uint64
+		BucketName string
+		ReadCount  uint64
+		ReadSize   uint64
+	}
+	if err := s.db.Model(&ReadRecordTable{}).
+		Select("bucket_id, MAX(bucket_name) AS bucket_name, COUNT(*) AS read_count, SUM(read_size) AS read_size").
+		Where("read_timestamp_us >= ? AND read_timestamp_us < ?", startTimestampUs, endTimestampUs).
+		Group("bucket_id").
+		Scan(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to aggregate read record table: %s", err)
+	}
+	usages := make([]*spdb.ReadUsage, 0, len(queryReturns))
+	for _, queryReturn := range queryReturns {
+		usages = append(usages, &spdb.ReadUsage{
+			BucketID:   queryReturn.BucketID,
+			BucketName: queryReturn.BucketName,
+			ReadCount:  queryReturn.ReadCount,
+			ReadSize:   queryReturn.ReadSize,
+		})
+	}
+	return usages, nil
+}
+
+// usageRollupBatchSize defines the number of the usage rollups inserted by one statement.
+const usageRollupBatchSize = 500
+
+// UpdateUsageRollups inserts the usage rollups or overwrites the ones of the same buckets and days in one
+// transaction, so the rollups of a day are either all written or none of them.
+func (s *SpDBImpl) UpdateUsageRollups(rollups []*spdb.UsageRollup) error {
+	if len(rollups) == 0 {
+		return nil
+	}
+	tables := make([]*UsageRollupTable, 0, len(rollups))
+	for _, rollup := range rollups {
+		tables = append(tables, &UsageRollupTable{
+			BucketID:       rollup.BucketID,
+			Day:            rollup.Day,
+			BucketName:     rollup.BucketName,
+			PaymentAddress: rollup.PaymentAddress,
+			ReadCount:      rollup.ReadCount,
+			ReadSize:       rollup.ReadSize,
+			StoredSize:     rollup.StoredSize,
+			UpdateTime:     rollup.UpdateTime,
+		})
+	}
+	err := s.db.Transaction(func(tx *gorm.DB) error {
+		for start := 0; start < len(tables); start += usageRollupBatchSize {
+			end := start + usageRollupBatchSize
+			if end > len(tables) {
+				end = len(tables)
+			}
+			batch := tables[start:end]
+			if err := tx.Clauses(clause.OnConflict{
+				Columns: []clause.Column{{Name: "bucket_id"}, {Name: "day"}},
+				DoUpdates: clause.AssignmentColumns([]string{"bucket_name", "payment_address", "read_count", "read_size",
+					"stored_size", "update_time"}),
+			}).Create(&batch).Error; err != nil {
+				return err
+			}
+		}
+		return nil
+	})
+	if err != nil {
+		return fmt.Errorf("failed to update usage rollups: %s", err)
+	}
+	return nil
+}
+
+// ListUsageRollups lists the usage rollups matching the filter, ordered by day and bucket id.
+func (s *SpDBImpl) ListUsageRollups(filter *spdb.UsageRollupFilter, offset, limit int) ([]*spdb.UsageRollup, error) {
+	var queryReturns []UsageRollupTable
+	db := s.db.Model(&UsageRollupTable{})
+	if filter != nil {
+		if filter.StartDay != "" {
+			db = db.Where("day >= ?", filter.StartDay)
+		}
+		if filter.EndDay != "" {
+			db = db.Where("day <= ?", filter.EndDay)
+		}
+		if filter.BucketID != 0 {
+			db = db.Where("bucket_id = ?", filter.BucketID)
+		}
+		if filter.PaymentAddress != "" {
+			db = db.Where("payment_address = ?", filter.PaymentAddress)
+		}
+	}
+	if err := db.Order("day, bucket_id").Offset(offset).Limit(limit).Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to query usage rollup table: %s", err)
+	}
+	rollups := make([]*spdb.UsageRollup, 0, len(queryReturns))
+	for _, queryReturn := range queryReturns {
+		rollups = append(rollups, &spdb.UsageRollup{
+			BucketID:       queryReturn.BucketID,
+			Day:            queryReturn.Day,
+			BucketName:     queryReturn.BucketName,
+			PaymentAddress: queryReturn.PaymentAddress,
+			ReadCount:      queryReturn.ReadCount,
+			ReadSize:       queryReturn.ReadSize,
+			StoredSize:     queryReturn.StoredSize,
+			UpdateTime:     queryReturn.UpdateTime,
+		})
+	}
+	return rollups, nil
+}
+
+// GetLatestUsageRollupDay returns the latest day that has been rolled up, empty if there is none.
+func (s *SpDBImpl) GetLatestUsageRollupDay() (string, error) {
+	var day sql.NullString
+	if err := s.db.Model(&UsageRollupTable{}).Select("MAX(day)").Scan(&day).Error; err != nil {
+		return "", fmt.Errorf("failed to query usage rollup table: %s", err)
+	}
+	return day.String, nil
+}
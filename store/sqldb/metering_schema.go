@@ -0,0 +1,18 @@
+package sqldb
+
+// UsageRollupTable table schema
+type UsageRollupTable struct {
+	BucketID       uint64 `gorm:"primary_key;autoIncrement:false"`
+	Day            string `gorm:"primary_key;type:varchar(10);index:day_to_usage_rollup"`
+	BucketName     string
+	PaymentAddress string `gorm:"type:varchar(64);index:payment_to_usage_rollup"`
+	ReadCount      uint64
+	ReadSize       uint64
+	StoredSize     uint64
+	UpdateTime     int64
+}
+
+// TableName is used to set UsageRollupTable Schema's table name in database
+func (UsageRollupTable) TableName() string {
+	return UsageRollupTableName
+}
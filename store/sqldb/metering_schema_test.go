@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestUsageRollupTable_TableName(t *testing.T) {
+	table := UsageRollupTable{BucketID: 1}
+	result := table.TableName()
+	assert.Equal(t, UsageRollupTableName, result)
+}
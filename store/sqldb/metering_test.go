@@ -0,0 +1,121 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockAggregateReadUsageSQL = "SELECT bucket_id, MAX(bucket_name) AS bucket_name, COUNT(*) AS read_count, SUM(read_size) AS read_size FROM `read_record` WHERE read_timestamp_us >= ? AND read_timestamp_us < ? GROUP BY `bucket_id`"
+	mockUsageRollupUpsertSQL  = "INSERT INTO `usage_rollup` (`bucket_id`,`day`,`bucket_name`,`payment_address`,`read_count`,`read_size`,`stored_size`,`update_time`) VALUES (?,?,?,?,?,?,?,?),(?,?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `bucket_name`=VALUES(`bucket_name`),`payment_address`=VALUES(`payment_address`),`read_count`=VALUES(`read_count`),`read_size`=VALUES(`read_size`),`stored_size`=VALUES(`stored_size`),`update_time`=VALUES(`update_time`)"
+	mockListUsageRollupSQL    = "SELECT * FROM `usage_rollup` WHERE day >= ? AND day <= ? AND payment_address = ? ORDER BY day, bucket_id LIMIT 10 OFFSET 10"
+	mockListAllUsageRollupSQL = "SELECT * FROM `usage_rollup` ORDER BY day, bucket_id LIMIT 10"
+	mockLatestUsageRollupSQL  = "SELECT MAX(day) FROM `usage_rollup`"
+)
+
+var usageRollupColumns = []string{"bucket_id", "day", "bucket_name", "payment_address", "read_count", "read_size",
+	"stored_size", "update_time"}
+
+func TestSpDBImpl_AggregateReadUsageSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockAggregateReadUsageSQL).WithArgs(int64(100), int64(200)).
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "bucket_name", "read_count", "read_size"}).
+			AddRow(1, "mock-bucket-1", 3, 300).AddRow(2, "mock-bucket-2", 1, 10))
+	result, err := s.AggregateReadUsage(100, 200)
+	assert.Nil(t, err)
+	assert.Equal(t, []*spdb.ReadUsage{
+		{BucketID: 1, BucketName: "mock-bucket-1", ReadCount: 3, ReadSize: 300},
+		{BucketID: 2, BucketName: "mock-bucket-2", ReadCount: 1, ReadSize: 10},
+	}, result)
+}
+
+func TestSpDBImpl_AggregateReadUsageFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockAggregateReadUsageSQL).WithArgs(int64(100), int64(200)).WillReturnError(mockDBInternalError)
+	result, err := s.AggregateReadUsage(100, 200)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+	assert.Nil(t, result)
+}
+
+func TestSpDBImpl_UpdateUsageRollupsSuccess(t *testing.T) {
+	rollups := []*spdb.UsageRollup{
+		{BucketID: 1, Day: "2023-08-01", BucketName: "mock-bucket-1", PaymentAddress: "0x01", ReadCount: 3,
+			ReadSize: 300, StoredSize: 1000, UpdateTime: 1690934400},
+		{BucketID: 2, Day: "2023-08-01", BucketName: "mock-bucket-2", PaymentAddress: "0x02", StoredSize: 10,
+			UpdateTime: 1690934400},
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockUsageRollupUpsertSQL).
+		WithArgs(uint64(1), "2023-08-01", "mock-bucket-1", "0x01", uint64(3), uint64(300), uint64(1000), int64(1690934400),
+			uint64(2), "2023-08-01", "mock-bucket-2", "0x02", uint64(0), uint64(0), uint64(10), int64(1690934400)).
+		WillReturnResult(sqlmock.NewResult(1, 2))
+	mock.ExpectCommit()
+	err := s.UpdateUsageRollups(rollups)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateUsageRollupsEmpty(t *testing.T) {
+	s, _ := setupDB(t)
+	err := s.UpdateUsageRollups(nil)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateUsageRollupsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockUsageRollupUpsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.UpdateUsageRollups([]*spdb.UsageRollup{{BucketID: 1, Day: "2023-08-01"}, {BucketID: 2, Day: "2023-08-01"}})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListUsageRollupsSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListUsageRollupSQL).WithArgs("2023-08-01", "2023-08-31", "0x01").
+		WillReturnRows(sqlmock.NewRows(usageRollupColumns).
+			AddRow(1, "2023-08-02", "mock-bucket-1", "0x01", 3, 300, 1000, 1690934400))
+	result, err := s.ListUsageRollups(&spdb.UsageRollupFilter{StartDay: "2023-08-01", EndDay: "2023-08-31",
+		PaymentAddress: "0x01"}, 10, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*spdb.UsageRollup{{BucketID: 1, Day: "2023-08-02", BucketName: "mock-bucket-1",
+		PaymentAddress: "0x01", ReadCount: 3, ReadSize: 300, StoredSize: 1000, UpdateTime: 1690934400}}, result)
+}
+
+func TestSpDBImpl_ListUsageRollupsWithoutFilter(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListAllUsageRollupSQL).WillReturnRows(sqlmock.NewRows(usageRollupColumns))
+	result, err := s.ListUsageRollups(nil, 0, 10)
+	assert.Nil(t, err)
+	assert.Empty(t, result)
+}
+
+func TestSpDBImpl_ListUsageRollupsFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockListAllUsageRollupSQL).WillReturnError(mockDBInternalError)
+	result, err := s.ListUsageRollups(&spdb.UsageRollupFilter{}, 0, 10)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+	assert.Nil(t, result)
+}
+
+func TestSpDBImpl_GetLatestUsageRollupDay(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockLatestUsageRollupSQL).WillReturnRows(sqlmock.NewRows([]string{"MAX(day)"}).AddRow("2023-08-01"))
+	day, err := s.GetLatestUsageRollupDay()
+	assert.Nil(t, err)
+	assert.Equal(t, "2023-08-01", day)
+
+	mock.ExpectQuery(mockLatestUsageRollupSQL).WillReturnRows(sqlmock.NewRows([]string{"MAX(day)"}).AddRow(nil))
+	day, err = s.GetLatestUsageRollupDay()
+	assert.Nil(t, err)
+	assert.Equal(t, "", day)
+
+	mock.ExpectQuery(mockLatestUsageRollupSQL).WillReturnError(mockDBInternalError)
+	_, err = s.GetLatestUsageRollupDay()
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
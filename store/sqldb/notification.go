@@ -0,0 +1,146 @@
+package sqldb
+
+import (
+	"fmt"
+	"strings"
+
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// InsertNotificationSubscription inserts a new notification subscription and returns its id.
+func (s *SpDBImpl) InsertNotificationSubscription(subscription *spdb.NotificationSubscription) (uint64, error) {
+	table := &NotificationSubscriptionTable{
+		BucketName: subscription.BucketName,
+		EventTypes: strings.Join(subscription.EventTypes, ","),
+		Prefix:     subscription.Prefix,
+		WebhookURL: subscription.WebhookURL,
+		Secret:     subscription.Secret,
+		Creator:    subscription.Creator,
+		CreateTime: subscription.CreateTime,
+
+		DeliveredEventID: subscription.DeliveredEventID,
+	}
+	if err := s.db.Create(table).Error; err != nil {
+		return 0, fmt.Errorf("failed to insert notification subscription: %s", err)
+	}
+	return table.ID, nil
+}
+
+// ListNotificationSubscriptions lists the notification subscriptions of the buckets, ordered by id.
+func (s *SpDBImpl) ListNotificationSubscriptions(bucketNames []string) ([]*spdb.NotificationSubscription, error) {
+	if len(bucketNames) == 0 {
+		return nil, nil
+	}
+	var queryReturns []NotificationSubscriptionTable
+	if err := s.db.Model(&NotificationSubscriptionTable{}).
+		Where("bucket_name IN ?", bucketNames).
+		Order("id").
+		Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to query notification subscription table: %s", err)
+	}
+	return toNotificationSubscriptions(queryReturns), nil
+}
+
+// ListAllNotificationSubscriptions lists the notification subscriptions of all the buckets, ordered by id.
+func (s *SpDBImpl) ListAllNotificationSubscriptions() ([]*spdb.NotificationSubscription, error) {
+	var queryReturns []NotificationSubscriptionTable
+	if err := s.db.Model(&NotificationSubscriptionTable{}).Order("id").Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to query notification subscription table: %s", err)
+	}
+	return toNotificationSubscriptions(queryReturns), nil
+}
+
+func toNotificationSubscriptions(queryReturns []NotificationSubscriptionTable) []*spdb.NotificationSubscription {
+	subscriptions := make([]*spdb.NotificationSubscription, 0, len(queryReturns))
+	for _, queryReturn := range queryReturns {
+		var eventTypes []string
+		if queryReturn.EventTypes != "" {
+			eventTypes = strings.Split(queryReturn.EventTypes, ",")
+		}
+		subscriptions = append(subscriptions, &spdb.NotificationSubscription{
+			ID:               queryReturn.ID,
+			BucketName:       queryReturn.BucketName,
+			EventTypes:       eventTypes,
+			Prefix:           queryReturn.Prefix,
+			WebhookURL:       queryReturn.WebhookURL,
+			Secret:           queryReturn.Secret,
+			Creator:          queryReturn.Creator,
+			CreateTime:       queryReturn.CreateTime,
+			DeliveredEventID: queryReturn.DeliveredEventID,
+		})
+	}
+	return subscriptions
+}
+
+// DeleteNotificationSubscription deletes the notification subscription of the bucket.
+func (s *SpDBImpl) DeleteNotificationSubscription(bucketName string, subscriptionID uint64) error {
+	result := s.db.Where("id = ? AND bucket_name = ?", subscriptionID, bucketName).
+		Delete(&NotificationSubscriptionTable{})
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete notification subscription: %s", result.Error)
+	}
+	if result.RowsAffected == 0 {
+		return gorm.ErrRecordNotFound
+	}
+	return nil
+}
+
+// UpdateNotificationCursor updates the id of the last notification event that has been delivered to the
+// subscription, nothing is updated if the subscription has been deleted.
+func (s *SpDBImpl) UpdateNotificationCursor(subscriptionID, eventID uint64) error {
+	err := s.db.Model(&NotificationSubscriptionTable{}).
+		Where("id = ?", subscriptionID).
+		Update("delivered_event_id", eventID).Error
+	if err != nil {
+		return fmt.Errorf("failed to update notification cursor: %s", err)
+	}
+	return nil
+}
+
+// InsertNotificationDeadLetter inserts the notification that fails to be delivered after retrying.
+func (s *SpDBImpl) InsertNotificationDeadLetter(letter *spdb.NotificationDeadLetter) error {
+	err := s.db.Create(&NotificationDeadLetterTable{
+		SubscriptionID: letter.SubscriptionID,
+		EventID:        letter.EventID,
+		BucketName:     letter.BucketName,
+		WebhookURL:     letter.WebhookURL,
+		Payload:        letter.Payload,
+		Attempts:       letter.Attempts,
+		LastError:      letter.LastError,
+		CreateTime:     letter.CreateTime,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to insert notification dead letter: %s", err)
+	}
+	return nil
+}
+
+// ListNotificationDeadLetters lists the dead letters of the bucket ordered by id, all the dead letters
+// are listed if the bucket name is empty.
+func (s *SpDBImpl) ListNotificationDeadLetters(bucketName string, offset, limit int) ([]*spdb.NotificationDeadLetter, error) {
+	var queryReturns []NotificationDeadLetterTable
+	db := s.db.Model(&NotificationDeadLetterTable{})
+	if bucketName != "" {
+		db = db.Where("bucket_name = ?", bucketName)
+	}
+	if err := db.Order("id").Offset(offset).Limit(limit).Find(&queryReturns).Error; err != nil {
+		return nil, fmt.Errorf("failed to query notification dead letter table: %s", err)
+	}
+	letters := make([]*spdb.NotificationDeadLetter, 0, len(queryReturns))
+	for _, queryReturn := range queryReturns {
+		letters = append(letters, &spdb.NotificationDeadLetter{
+			ID:             queryReturn.ID,
+			SubscriptionID: queryReturn.SubscriptionID,
+			EventID:        queryReturn.EventID,
+			BucketName:     queryReturn.BucketName,
+			WebhookURL:     queryReturn.WebhookURL,
+			Payload:        queryReturn.Payload,
+			Attempts:       queryReturn.Attempts,
+			LastError:      queryReturn.LastError,
+			CreateTime:     queryReturn.CreateTime,
+		})
+	}
+	return letters, nil
+}
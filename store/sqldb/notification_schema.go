@@ -0,0 +1,39 @@
+package sqldb
+
+// NotificationSubscriptionTable table schema
+type NotificationSubscriptionTable struct {
+	ID         uint64 `gorm:"primary_key;autoIncrement"`
+	BucketName string `gorm:"type:varchar(64);index:bucket_to_notification_subscription"`
+	// EventTypes is the comma separated subscribed event types.
+	EventTypes string `gorm:"type:varchar(256)"`
+	Prefix     string `gorm:"type:varchar(1024)"`
+	WebhookURL string `gorm:"type:varchar(1024)"`
+	Secret     string `gorm:"type:varchar(256)"`
+	Creator    string `gorm:"type:varchar(64)"`
+	CreateTime int64
+	// DeliveredEventID is the id of the last notification event that has been delivered to the subscription.
+	DeliveredEventID uint64
+}
+
+// TableName is used to set NotificationSubscriptionTable Schema's table name in database
+func (NotificationSubscriptionTable) TableName() string {
+	return NotificationSubscriptionTableName
+}
+
+// NotificationDeadLetterTable table schema
+type NotificationDeadLetterTable struct {
+	ID             uint64 `gorm:"primary_key;autoIncrement"`
+	SubscriptionID uint64 `gorm:"index:subscription_to_notification_dead_letter"`
+	EventID        uint64
+	BucketName     string `gorm:"type:varchar(64);index:bucket_to_notification_dead_letter"`
+	WebhookURL     string `gorm:"type:varchar(1024)"`
+	Payload        string `gorm:"type:text"`
+	Attempts       uint32
+	LastError      string `gorm:"type:text"`
+	CreateTime     int64
+}
+
+// TableName is used to set NotificationDeadLetterTable Schema's table name in database
+func (NotificationDeadLetterTable) TableName() string {
+	return NotificationDeadLetterTableName
+}
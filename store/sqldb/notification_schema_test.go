@@ -0,0 +1,19 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestNotificationSubscriptionTable_TableName(t *testing.T) {
+	table := NotificationSubscriptionTable{}
+	name := table.TableName()
+	assert.Equal(t, NotificationSubscriptionTableName, name)
+}
+
+func TestNotificationDeadLetterTable_TableName(t *testing.T) {
+	table := NotificationDeadLetterTable{}
+	name := table.TableName()
+	assert.Equal(t, NotificationDeadLetterTableName, name)
+}
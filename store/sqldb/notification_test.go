@@ -0,0 +1,171 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockNotificationSubscriptionInsertSQL = "INSERT INTO `notification_subscription` (`bucket_name`,`event_types`,`prefix`,`webhook_url`,`secret`,`creator`,`create_time`,`delivered_event_id`) VALUES (?,?,?,?,?,?,?,?)"
+	mockNotificationSubscriptionListSQL   = "SELECT * FROM `notification_subscription` WHERE bucket_name IN (?,?) ORDER BY id"
+	mockNotificationSubscriptionDeleteSQL = "DELETE FROM `notification_subscription` WHERE id = ? AND bucket_name = ?"
+	mockNotificationSubscriptionAllSQL    = "SELECT * FROM `notification_subscription` ORDER BY id"
+	mockNotificationCursorUpdateSQL       = "UPDATE `notification_subscription` SET `delivered_event_id`=? WHERE id = ?"
+	mockNotificationDeadLetterInsertSQL   = "INSERT INTO `notification_dead_letter` (`subscription_id`,`event_id`,`bucket_name`,`webhook_url`,`payload`,`attempts`,`last_error`,`create_time`) VALUES (?,?,?,?,?,?,?,?)"
+	mockNotificationDeadLetterListSQL     = "SELECT * FROM `notification_dead_letter` WHERE bucket_name = ? ORDER BY id LIMIT 10 OFFSET 10"
+)
+
+func TestSpDBImpl_InsertNotificationSubscriptionSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationSubscriptionInsertSQL).
+		WithArgs("mock-bucket", "ObjectCreated,ObjectSealed", "photos/", "https://example.com/hook", "secret",
+			"0x01", int64(1), uint64(100)).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectCommit()
+	id, err := s.InsertNotificationSubscription(&spdb.NotificationSubscription{
+		BucketName: "mock-bucket",
+		EventTypes: []string{"ObjectCreated", "ObjectSealed"},
+		Prefix:     "photos/",
+		WebhookURL: "https://example.com/hook",
+		Secret:     "secret",
+		Creator:    "0x01",
+		CreateTime: 1,
+
+		DeliveredEventID: 100,
+	})
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), id)
+}
+
+func TestSpDBImpl_InsertNotificationSubscriptionFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationSubscriptionInsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	_, err := s.InsertNotificationSubscription(&spdb.NotificationSubscription{BucketName: "mock-bucket"})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListNotificationSubscriptions(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockNotificationSubscriptionListSQL).WithArgs("mock-bucket-1", "mock-bucket-2").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bucket_name", "event_types", "prefix", "webhook_url", "secret",
+			"creator", "create_time"}).
+			AddRow(1, "mock-bucket-1", "ObjectSealed", "", "https://example.com/hook", "secret", "0x01", 1).
+			AddRow(2, "mock-bucket-2", "", "photos/", "https://example.com/hook", "", "0x02", 2))
+	subscriptions, err := s.ListNotificationSubscriptions([]string{"mock-bucket-1", "mock-bucket-2"})
+	assert.Nil(t, err)
+	assert.Equal(t, []*spdb.NotificationSubscription{
+		{ID: 1, BucketName: "mock-bucket-1", EventTypes: []string{"ObjectSealed"}, WebhookURL: "https://example.com/hook",
+			Secret: "secret", Creator: "0x01", CreateTime: 1},
+		{ID: 2, BucketName: "mock-bucket-2", Prefix: "photos/", WebhookURL: "https://example.com/hook", Creator: "0x02",
+			CreateTime: 2},
+	}, subscriptions)
+
+	subscriptions, err = s.ListNotificationSubscriptions(nil)
+	assert.Nil(t, err)
+	assert.Empty(t, subscriptions)
+
+	mock.ExpectQuery(mockNotificationSubscriptionListSQL).WillReturnError(mockDBInternalError)
+	_, err = s.ListNotificationSubscriptions([]string{"mock-bucket-1", "mock-bucket-2"})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_DeleteNotificationSubscription(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationSubscriptionDeleteSQL).WithArgs(uint64(1), "mock-bucket").
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err := s.DeleteNotificationSubscription("mock-bucket", 1)
+	assert.Nil(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationSubscriptionDeleteSQL).WithArgs(uint64(2), "mock-bucket").
+		WillReturnResult(sqlmock.NewResult(0, 0))
+	mock.ExpectCommit()
+	err = s.DeleteNotificationSubscription("mock-bucket", 2)
+	assert.Equal(t, gorm.ErrRecordNotFound, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationSubscriptionDeleteSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	err = s.DeleteNotificationSubscription("mock-bucket", 3)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListAllNotificationSubscriptions(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockNotificationSubscriptionAllSQL).
+		WillReturnRows(sqlmock.NewRows([]string{"id", "bucket_name", "event_types", "webhook_url", "delivered_event_id"}).
+			AddRow(1, "mock-bucket-1", "ObjectSealed", "https://example.com/hook", 10).
+			AddRow(2, "mock-bucket-2", "", "https://example.com/hook", 20))
+	subscriptions, err := s.ListAllNotificationSubscriptions()
+	assert.Nil(t, err)
+	assert.Equal(t, []*spdb.NotificationSubscription{
+		{ID: 1, BucketName: "mock-bucket-1", EventTypes: []string{"ObjectSealed"}, WebhookURL: "https://example.com/hook",
+			DeliveredEventID: 10},
+		{ID: 2, BucketName: "mock-bucket-2", WebhookURL: "https://example.com/hook", DeliveredEventID: 20},
+	}, subscriptions)
+
+	mock.ExpectQuery(mockNotificationSubscriptionAllSQL).WillReturnError(mockDBInternalError)
+	_, err = s.ListAllNotificationSubscriptions()
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_UpdateNotificationCursor(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationCursorUpdateSQL).WithArgs(uint64(100), uint64(1)).
+		WillReturnResult(sqlmock.NewResult(0, 1))
+	mock.ExpectCommit()
+	err := s.UpdateNotificationCursor(1, 100)
+	assert.Nil(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationCursorUpdateSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	err = s.UpdateNotificationCursor(1, 100)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_InsertNotificationDeadLetter(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationDeadLetterInsertSQL).
+		WithArgs(uint64(1), uint64(100), "mock-bucket", "https://example.com/hook", "{}", uint32(3), "timeout", int64(1)).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.InsertNotificationDeadLetter(&spdb.NotificationDeadLetter{SubscriptionID: 1, EventID: 100,
+		BucketName: "mock-bucket", WebhookURL: "https://example.com/hook", Payload: "{}", Attempts: 3,
+		LastError: "timeout", CreateTime: 1})
+	assert.Nil(t, err)
+
+	mock.ExpectBegin()
+	mock.ExpectExec(mockNotificationDeadLetterInsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	err = s.InsertNotificationDeadLetter(&spdb.NotificationDeadLetter{})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_ListNotificationDeadLetters(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockNotificationDeadLetterListSQL).WithArgs("mock-bucket").
+		WillReturnRows(sqlmock.NewRows([]string{"id", "subscription_id", "event_id", "bucket_name", "webhook_url",
+			"payload", "attempts", "last_error", "create_time"}).
+			AddRow(1, 1, 100, "mock-bucket", "https://example.com/hook", "{}", 3, "timeout", 1))
+	letters, err := s.ListNotificationDeadLetters("mock-bucket", 10, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, []*spdb.NotificationDeadLetter{{ID: 1, SubscriptionID: 1, EventID: 100, BucketName: "mock-bucket",
+		WebhookURL: "https://example.com/hook", Payload: "{}", Attempts: 3, LastError: "timeout", CreateTime: 1}}, letters)
+
+	mock.ExpectQuery("SELECT * FROM `notification_dead_letter` ORDER BY id LIMIT 10").WillReturnError(mockDBInternalError)
+	_, err = s.ListNotificationDeadLetters("", 0, 10)
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
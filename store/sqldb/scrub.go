@@ -0,0 +1,54 @@
+package sqldb
+
+import (
+	"fmt"
+
+	"gorm.io/gorm/clause"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+// GetScrubCursor returns the scrub cursor of the gvg and redundancy index.
+func (s *SpDBImpl) GetScrubCursor(gvgID uint32, redundancyIndex int32) (*spdb.ScrubCursor, error) {
+	var queryReturn ScrubCursorTable
+	if err := s.db.Model(&ScrubCursorTable{}).
+		Where("virtual_group_id = ? AND redundancy_index = ?", gvgID, redundancyIndex).
+		First(&queryReturn).Error; err != nil {
+		return nil, err
+	}
+	return &spdb.ScrubCursor{
+		VirtualGroupID:  queryReturn.VirtualGroupID,
+		RedundancyIndex: queryReturn.RedundancyIndex,
+		StartAfter:      queryReturn.StartAfter,
+		Round:           queryReturn.Round,
+		ScrubbedObjects: queryReturn.ScrubbedObjects,
+		CorruptedPieces: queryReturn.CorruptedPieces,
+		UpdateTime:      queryReturn.UpdateTime,
+	}, nil
+}
+
+// UpdateScrubCursor inserts the scrub cursor or updates the progress of it.
+func (s *SpDBImpl) UpdateScrubCursor(cursor *spdb.ScrubCursor) error {
+	err := s.db.Clauses(clause.OnConflict{
+		Columns:   []clause.Column{{Name: "virtual_group_id"}, {Name: "redundancy_index"}},
+		DoUpdates: clause.AssignmentColumns([]string{"start_after", "round", "scrubbed_objects", "corrupted_pieces", "update_time"}),
+	}).Create(&ScrubCursorTable{
+		VirtualGroupID:  cursor.VirtualGroupID,
+		RedundancyIndex: cursor.RedundancyIndex,
+		StartAfter:      cursor.StartAfter,
+		Round:           cursor.Round,
+		ScrubbedObjects: cursor.ScrubbedObjects,
+		CorruptedPieces: cursor.CorruptedPieces,
+		UpdateTime:      cursor.UpdateTime,
+	}).Error
+	if err != nil {
+		return fmt.Errorf("failed to update scrub cursor: %s", err)
+	}
+	return nil
+}
+
+// DeleteScrubCursor deletes the scrub cursor of the gvg and redundancy index.
+func (s *SpDBImpl) DeleteScrubCursor(gvgID uint32, redundancyIndex int32) error {
+	return s.db.Where("virtual_group_id = ? AND redundancy_index = ?", gvgID, redundancyIndex).
+		Delete(&ScrubCursorTable{}).Error
+}
@@ -0,0 +1,17 @@
+package sqldb
+
+// ScrubCursorTable table schema
+type ScrubCursorTable struct {
+	VirtualGroupID  uint32 `gorm:"primary_key;autoIncrement:false"`
+	RedundancyIndex int32  `gorm:"primary_key;autoIncrement:false"`
+	StartAfter      uint64
+	Round           uint64
+	ScrubbedObjects uint64
+	CorruptedPieces uint64
+	UpdateTime      int64
+}
+
+// TableName is used to set ScrubCursorTable Schema's table name in database
+func (ScrubCursorTable) TableName() string {
+	return ScrubCursorTableName
+}
@@ -0,0 +1,13 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+)
+
+func TestScrubCursorTable_TableName(t *testing.T) {
+	table := ScrubCursorTable{VirtualGroupID: 1}
+	result := table.TableName()
+	assert.Equal(t, ScrubCursorTableName, result)
+}
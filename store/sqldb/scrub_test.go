@@ -0,0 +1,81 @@
+package sqldb
+
+import (
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/core/spdb"
+)
+
+const (
+	mockScrubCursorQuerySQL  = "SELECT * FROM `scrub_cursor` WHERE virtual_group_id = ? AND redundancy_index = ? ORDER BY `scrub_cursor`.`virtual_group_id` LIMIT 1"
+	mockScrubCursorUpsertSQL = "INSERT INTO `scrub_cursor` (`virtual_group_id`,`redundancy_index`,`start_after`,`round`,`scrubbed_objects`,`corrupted_pieces`,`update_time`) VALUES (?,?,?,?,?,?,?) ON DUPLICATE KEY UPDATE `start_after`=VALUES(`start_after`),`round`=VALUES(`round`),`scrubbed_objects`=VALUES(`scrubbed_objects`),`corrupted_pieces`=VALUES(`corrupted_pieces`),`update_time`=VALUES(`update_time`)"
+	mockScrubCursorDeleteSQL = "DELETE FROM `scrub_cursor` WHERE virtual_group_id = ? AND redundancy_index = ?"
+)
+
+func TestSpDBImpl_GetScrubCursorSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockScrubCursorQuerySQL).WithArgs(uint32(1), int32(-1)).
+		WillReturnRows(sqlmock.NewRows([]string{"virtual_group_id", "redundancy_index", "start_after", "round",
+			"scrubbed_objects", "corrupted_pieces", "update_time"}).AddRow(1, -1, 100, 2, 300, 1, 1690000000))
+	result, err := s.GetScrubCursor(1, -1)
+	assert.Nil(t, err)
+	assert.Equal(t, &spdb.ScrubCursor{
+		VirtualGroupID:  1,
+		RedundancyIndex: -1,
+		StartAfter:      100,
+		Round:           2,
+		ScrubbedObjects: 300,
+		CorruptedPieces: 1,
+		UpdateTime:      1690000000,
+	}, result)
+}
+
+func TestSpDBImpl_GetScrubCursorNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery(mockScrubCursorQuerySQL).WithArgs(uint32(1), int32(0)).WillReturnError(gorm.ErrRecordNotFound)
+	result, err := s.GetScrubCursor(1, 0)
+	assert.ErrorIs(t, err, gorm.ErrRecordNotFound)
+	assert.Nil(t, result)
+}
+
+func TestSpDBImpl_UpdateScrubCursorSuccess(t *testing.T) {
+	cursor := &spdb.ScrubCursor{
+		VirtualGroupID:  1,
+		RedundancyIndex: 2,
+		StartAfter:      100,
+		Round:           1,
+		ScrubbedObjects: 100,
+		UpdateTime:      1690000000,
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockScrubCursorUpsertSQL).
+		WithArgs(cursor.VirtualGroupID, cursor.RedundancyIndex, cursor.StartAfter, cursor.Round, cursor.ScrubbedObjects,
+			cursor.CorruptedPieces, cursor.UpdateTime).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.UpdateScrubCursor(cursor)
+	assert.Nil(t, err)
+}
+
+func TestSpDBImpl_UpdateScrubCursorFailure(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockScrubCursorUpsertSQL).WillReturnError(mockDBInternalError)
+	mock.ExpectRollback()
+	mock.ExpectCommit()
+	err := s.UpdateScrubCursor(&spdb.ScrubCursor{VirtualGroupID: 1})
+	assert.Contains(t, err.Error(), mockDBInternalError.Error())
+}
+
+func TestSpDBImpl_DeleteScrubCursorSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectExec(mockScrubCursorDeleteSQL).WithArgs(uint32(1), int32(-1)).WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	err := s.DeleteScrubCursor(1, -1)
+	assert.Nil(t, err)
+}
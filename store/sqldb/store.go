@@ -1,12 +1,14 @@
 package sqldb
 
 import (
+	"context"
 	"fmt"
 	"os"
 	"time"
 
 	"github.com/prometheus/client_golang/prometheus"
 	"github.com/prometheus/client_golang/prometheus/collectors"
+	"go.opentelemetry.io/otel/trace"
 	"gorm.io/driver/mysql"
 	"gorm.io/gorm"
 
@@ -37,7 +39,10 @@ const (
 	DefaultEnableTracePutEvent = true
 )
 
-var _ corespdb.SPDB = &SpDBImpl{}
+var (
+	_ corespdb.SPDB        = &SpDBImpl{}
+	_ corespdb.ContextSPDB = &SpDBImpl{}
+)
 
 // SpDBImpl storage provider database, implements SPDB interface
 type SpDBImpl struct {
@@ -56,6 +61,14 @@ func NewSpDB(config *config.SQLDBConfig) (*SpDBImpl, error) {
 	return &SpDBImpl{db: db, enableTracePutEvent: config.EnableTracePutEvent}, err
 }
 
+// WithContext returns a SpDBImpl whose statements are traced as the children of the span in ctx, only the
+// span is kept, so the statements are not canceled with ctx and the tasks done in the background after ctx
+// is canceled can still update the db.
+func (s *SpDBImpl) WithContext(ctx context.Context) corespdb.SPDB {
+	spanCtx := trace.ContextWithSpan(context.Background(), trace.SpanFromContext(ctx))
+	return &SpDBImpl{db: s.db.WithContext(spanCtx), enableTracePutEvent: s.enableTracePutEvent}
+}
+
 // RegisterStdDBStats registers std lib sql DB
 func (s *SpDBImpl) RegisterStdDBStats() (prometheus.Collector, error) {
 	db, err := s.db.DB()
@@ -76,6 +89,10 @@ func InitDB(config *config.SQLDBConfig) (*gorm.DB, error) {
 		log.Errorw("gorm failed to open db", "error", err)
 		return nil, err
 	}
+	if err = registerTracingCallbacks(db); err != nil {
+		log.Errorw("gorm failed to register tracing callbacks", "error", err)
+		return nil, err
+	}
 	sqlDB, err := db.DB()
 	if err != nil {
 		log.Errorw("gorm failed to set db params", "error", err)
@@ -149,6 +166,14 @@ func InitDB(config *config.SQLDBConfig) (*gorm.DB, error) {
 		log.Errorw("failed to create read record table", "error", err)
 		return nil, err
 	}
+	if err = db.AutoMigrate(&ReadQuotaReservationTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create read quota reservation table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&ReadRecordAuditTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create read record audit table", "error", err)
+		return nil, err
+	}
 	if err = db.AutoMigrate(&OffChainAuthKeyTable{}); err != nil && !isAlreadyExists(err) {
 		log.Errorw("failed to create off-chain authKey table", "error", err)
 		return nil, err
@@ -184,6 +209,22 @@ func InitDB(config *config.SQLDBConfig) (*gorm.DB, error) {
 		log.Errorw("failed to create shadow integrity meta table", "error", err)
 		return nil, err
 	}
+	if err = db.AutoMigrate(&ScrubCursorTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create scrub cursor table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&UsageRollupTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create usage rollup table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&NotificationSubscriptionTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create notification subscription table", "error", err)
+		return nil, err
+	}
+	if err = db.AutoMigrate(&NotificationDeadLetterTable{}); err != nil && !isAlreadyExists(err) {
+		log.Errorw("failed to create notification dead letter table", "error", err)
+		return nil, err
+	}
 	return db, nil
 }
 
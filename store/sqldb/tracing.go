@@ -0,0 +1,80 @@
+package sqldb
+
+import (
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
+)
+
+// tracingSpanKey is the key of the span that is stored in the gorm db instance by the before callback.
+const tracingSpanKey = "gfsp:tracing_span"
+
+// registerTracingCallbacks registers the gorm callbacks that start a span for every sql statement, the spans
+// are children of the span in the statement context, and are not started if the statement context has no
+// span, e.g. the SPDB is not bound to a context by WithContext, so the statements do not start root traces.
+func registerTracingCallbacks(db *gorm.DB) error {
+	cb := db.Callback()
+	if err := cb.Create().Before("gorm:create").Register("gfsp:tracing_before_create", tracingBefore("create")); err != nil {
+		return err
+	}
+	if err := cb.Create().After("gorm:create").Register("gfsp:tracing_after_create", tracingAfter); err != nil {
+		return err
+	}
+	if err := cb.Query().Before("gorm:query").Register("gfsp:tracing_before_query", tracingBefore("query")); err != nil {
+		return err
+	}
+	if err := cb.Query().After("gorm:query").Register("gfsp:tracing_after_query", tracingAfter); err != nil {
+		return err
+	}
+	if err := cb.Update().Before("gorm:update").Register("gfsp:tracing_before_update", tracingBefore("update")); err != nil {
+		return err
+	}
+	if err := cb.Update().After("gorm:update").Register("gfsp:tracing_after_update", tracingAfter); err != nil {
+		return err
+	}
+	if err := cb.Delete().Before("gorm:delete").Register("gfsp:tracing_before_delete", tracingBefore("delete")); err != nil {
+		return err
+	}
+	if err := cb.Delete().After("gorm:delete").Register("gfsp:tracing_after_delete", tracingAfter); err != nil {
+		return err
+	}
+	if err := cb.Row().Before("gorm:row").Register("gfsp:tracing_before_row", tracingBefore("row")); err != nil {
+		return err
+	}
+	if err := cb.Row().After("gorm:row").Register("gfsp:tracing_after_row", tracingAfter); err != nil {
+		return err
+	}
+	if err := cb.Raw().Before("gorm:raw").Register("gfsp:tracing_before_raw", tracingBefore("raw")); err != nil {
+		return err
+	}
+	return cb.Raw().After("gorm:raw").Register("gfsp:tracing_after_raw", tracingAfter)
+}
+
+func tracingBefore(operation string) func(*gorm.DB) {
+	return func(db *gorm.DB) {
+		if !trace.SpanFromContext(db.Statement.Context).SpanContext().IsValid() {
+			return
+		}
+		ctx, span := tracing.StartSpan(db.Statement.Context, "SPDB."+operation, semconv.DBSystemMySQL,
+			semconv.DBOperation(operation))
+		db.Statement.Context = ctx
+		db.InstanceSet(tracingSpanKey, span)
+	}
+}
+
+func tracingAfter(db *gorm.DB) {
+	v, ok := db.InstanceGet(tracingSpanKey)
+	if !ok {
+		return
+	}
+	span, ok := v.(trace.Span)
+	if !ok {
+		return
+	}
+	if span.IsRecording() {
+		span.SetAttributes(semconv.DBSQLTable(db.Statement.Table), semconv.DBStatement(db.Statement.SQL.String()))
+	}
+	tracing.EndSpan(span, db.Error)
+}
@@ -0,0 +1,57 @@
+package sqldb
+
+import (
+	"context"
+	"testing"
+
+	"github.com/DATA-DOG/go-sqlmock"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"gorm.io/gorm"
+)
+
+func TestRegisterTracingCallbacks(t *testing.T) {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	defer otel.SetTracerProvider(trace.NewNoopTracerProvider())
+
+	s, mock := setupDB(t)
+	assert.Nil(t, registerTracingCallbacks(s.db))
+	mock.ExpectQuery(mockScrubCursorQuerySQL).WithArgs(uint32(1), int32(-1)).
+		WillReturnRows(sqlmock.NewRows([]string{"virtual_group_id", "redundancy_index"}).AddRow(1, -1))
+	// the statements without a parent span do not start root traces
+	_, err := s.GetScrubCursor(1, -1)
+	assert.Nil(t, err)
+	assert.Nil(t, provider.ForceFlush(context.Background()))
+	assert.Equal(t, 0, len(recorder.Ended()))
+
+	ctx, parent := provider.Tracer("test").Start(context.Background(), "parent")
+	ctx, cancel := context.WithCancel(ctx)
+	// the statements are not canceled with the bound context
+	cancel()
+	db := s.WithContext(ctx)
+	mock.ExpectQuery(mockScrubCursorQuerySQL).WithArgs(uint32(1), int32(-1)).
+		WillReturnRows(sqlmock.NewRows([]string{"virtual_group_id", "redundancy_index"}).AddRow(1, -1))
+	mock.ExpectExec(mockScrubCursorDeleteSQL).WithArgs(uint32(1), int32(-1)).WillReturnError(gorm.ErrInvalidDB)
+	_, err = db.GetScrubCursor(1, -1)
+	assert.Nil(t, err)
+	assert.NotNil(t, db.DeleteScrubCursor(1, -1))
+	parent.End()
+	assert.Nil(t, provider.ForceFlush(context.Background()))
+
+	spans := recorder.Ended()
+	assert.Equal(t, 3, len(spans))
+	assert.Equal(t, "SPDB.query", spans[0].Name())
+	assert.Equal(t, parent.SpanContext().SpanID(), spans[0].Parent().SpanID())
+	assert.Equal(t, parent.SpanContext().TraceID(), spans[1].SpanContext().TraceID())
+	assert.Contains(t, spans[0].Attributes(), semconv.DBSQLTable(ScrubCursorTableName))
+	assert.Contains(t, spans[0].Attributes(), semconv.DBStatement(mockScrubCursorQuerySQL))
+	assert.Equal(t, "SPDB.delete", spans[1].Name())
+	assert.Equal(t, codes.Error, spans[1].Status().Code)
+}
@@ -19,6 +19,14 @@ const (
 	SPDBSuccessCheckQuotaAndAddReadRecord = "check_and_add_read_record_success"
 	// SPDBFailureCheckQuotaAndAddReadRecord defines the metrics label of unsuccessfully check and add read record
 	SPDBFailureCheckQuotaAndAddReadRecord = "check_and_add_read_record_failure"
+	// SPDBSuccessReserveReadQuota defines the metrics label of successfully reserve read quota
+	SPDBSuccessReserveReadQuota = "reserve_read_quota_success"
+	// SPDBFailureReserveReadQuota defines the metrics label of unsuccessfully reserve read quota
+	SPDBFailureReserveReadQuota = "reserve_read_quota_failure"
+	// SPDBSuccessSettleReadQuota defines the metrics label of successfully settle read quota
+	SPDBSuccessSettleReadQuota = "settle_read_quota_success"
+	// SPDBFailureSettleReadQuota defines the metrics label of unsuccessfully settle read quota
+	SPDBFailureSettleReadQuota = "settle_read_quota_failure"
 	// SPDBSuccessGetBucketTraffic defines the metrics label of successfully get bucket traffic
 	SPDBSuccessGetBucketTraffic = "get_bucket_traffic_success"
 	// SPDBFailureGetBucketTraffic defines the metrics label of unsuccessfully get bucket traffic
@@ -82,6 +90,189 @@ func (s *SpDBImpl) CheckQuotaAndAddReadRecord(record *corespdb.ReadRecord, quota
 	return nil
 }
 
+// ReserveReadQuota check current quota, deduct the read size and add the read record with the reservation
+func (s *SpDBImpl) ReserveReadQuota(record *corespdb.ReadRecord, quota *corespdb.BucketQuota, expireTimeUs int64) (readRecordID uint64, err error) {
+	startTime := time.Now()
+	defer func() {
+		if err != nil {
+			metrics.SPDBCounter.WithLabelValues(SPDBFailureReserveReadQuota).Inc()
+			metrics.SPDBTime.WithLabelValues(SPDBFailureReserveReadQuota).Observe(
+				time.Since(startTime).Seconds())
+			return
+		}
+		metrics.SPDBCounter.WithLabelValues(SPDBSuccessReserveReadQuota).Inc()
+		metrics.SPDBTime.WithLabelValues(SPDBSuccessReserveReadQuota).Observe(
+			time.Since(startTime).Seconds())
+	}()
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		if err := deductConsumedQuota(tx, record, quota); err != nil {
+			return err
+		}
+		insertReadRecord := &ReadRecordTable{
+			BucketID:        record.BucketID,
+			ObjectID:        record.ObjectID,
+			UserAddress:     record.UserAddress,
+			ReadTimestampUs: record.ReadTimestampUs,
+			BucketName:      record.BucketName,
+			ObjectName:      record.ObjectName,
+			ReadSize:        record.ReadSize,
+		}
+		result := tx.Create(insertReadRecord)
+		if result.Error != nil || result.RowsAffected != 1 {
+			return fmt.Errorf("failed to insert read record table: %s", result.Error)
+		}
+		result = tx.Create(&ReadQuotaReservationTable{
+			ReadRecordID: insertReadRecord.ReadRecordID,
+			BucketID:     record.BucketID,
+			Month:        TimestampYearMonth(record.ReadTimestampUs),
+			ReservedSize: record.ReadSize,
+			ExpireTimeUs: expireTimeUs,
+		})
+		if result.Error != nil || result.RowsAffected != 1 {
+			return fmt.Errorf("failed to insert read quota reservation table: %s", result.Error)
+		}
+		readRecordID = insertReadRecord.ReadRecordID
+		return addReadRecordAudit(tx, readRecordID, corespdb.ReadQuotaActionReserve, record.ReadSize)
+	})
+	if err != nil {
+		log.Errorw("failed to reserve read quota", "bucket_id", record.BucketID, "object_id", record.ObjectID,
+			"read_size", record.ReadSize, "error", err)
+	}
+	return readRecordID, err
+}
+
+// CommitReadQuota settle the reservation by the consumed size, and refund the remained quota
+func (s *SpDBImpl) CommitReadQuota(readRecordID, consumedSize uint64) error {
+	return s.settleReadQuota(readRecordID, consumedSize, corespdb.ReadQuotaActionRefund)
+}
+
+// ReleaseReadQuota settle the reservation without consuming, and refund all the reserved quota
+func (s *SpDBImpl) ReleaseReadQuota(readRecordID uint64) error {
+	return s.settleReadQuota(readRecordID, 0, corespdb.ReadQuotaActionRelease)
+}
+
+// ExpireReadQuota refund the reservations that expire before ts(ts is UnixMicro)
+func (s *SpDBImpl) ExpireReadQuota(ts int64, limit int) (int, error) {
+	var reservations []ReadQuotaReservationTable
+	result := s.db.Where("expire_time_us < ?", ts).Limit(limit).Find(&reservations)
+	if result.Error != nil {
+		return 0, fmt.Errorf("failed to query read quota reservation table: %s", result.Error)
+	}
+	expired := 0
+	for _, reservation := range reservations {
+		err := s.settleReadQuota(reservation.ReadRecordID, 0, corespdb.ReadQuotaActionExpire)
+		if errors.Is(err, ErrReadQuotaReservationNotFound) {
+			// it has been settled by the downloader in the meantime
+			continue
+		}
+		if err != nil {
+			return expired, err
+		}
+		expired++
+	}
+	return expired, nil
+}
+
+// settleReadQuota consumes the consumedSize of the reservation, refunds the remained quota by the refundAction,
+// updates the read size of the read record to the consumed size and deletes the reservation.
+func (s *SpDBImpl) settleReadQuota(readRecordID, consumedSize uint64, refundAction string) (err error) {
+	startTime := time.Now()
+	defer func() {
+		if err != nil {
+			metrics.SPDBCounter.WithLabelValues(SPDBFailureSettleReadQuota).Inc()
+			metrics.SPDBTime.WithLabelValues(SPDBFailureSettleReadQuota).Observe(
+				time.Since(startTime).Seconds())
+			return
+		}
+		metrics.SPDBCounter.WithLabelValues(SPDBSuccessSettleReadQuota).Inc()
+		metrics.SPDBTime.WithLabelValues(SPDBSuccessSettleReadQuota).Observe(
+			time.Since(startTime).Seconds())
+	}()
+
+	err = s.db.Transaction(func(tx *gorm.DB) error {
+		var reservation ReadQuotaReservationTable
+		if err := tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("read_record_id = ?", readRecordID).
+			First(&reservation).Error; err != nil {
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				return ErrReadQuotaReservationNotFound
+			}
+			return fmt.Errorf("failed to query read quota reservation table: %v", err)
+		}
+		if consumedSize > reservation.ReservedSize {
+			consumedSize = reservation.ReservedSize
+		}
+		refundSize := reservation.ReservedSize - consumedSize
+
+		if refundSize > 0 {
+			// the quota of the month before the last month can not be refunded, it only happens if the reservation
+			// is not settled for a long time, just drop the refund.
+			yearMonthOfNow := TimestampYearMonth(GetCurrentTimestampUs())
+			if yearMonthOfNow == reservation.Month || IsNextMonth(yearMonthOfNow, reservation.Month) {
+				if err := recoupConsumedQuota(tx, reservation.BucketID, refundSize, reservation.Month); err != nil {
+					return err
+				}
+			} else {
+				log.Warnw("drop the refund of the outdated read quota reservation", "read_record_id", readRecordID,
+					"month", reservation.Month, "refund_size", refundSize)
+			}
+			if err := tx.Model(&ReadRecordTable{}).Where("read_record_id = ?", readRecordID).
+				Update("read_size", consumedSize).Error; err != nil {
+				return fmt.Errorf("failed to update read record table: %v", err)
+			}
+		}
+		if err := tx.Delete(&reservation).Error; err != nil {
+			return fmt.Errorf("failed to delete read quota reservation table: %v", err)
+		}
+		if consumedSize > 0 {
+			if err := addReadRecordAudit(tx, readRecordID, corespdb.ReadQuotaActionCommit, consumedSize); err != nil {
+				return err
+			}
+		}
+		if refundSize > 0 {
+			return addReadRecordAudit(tx, readRecordID, refundAction, refundSize)
+		}
+		return nil
+	})
+	if err != nil && !errors.Is(err, ErrReadQuotaReservationNotFound) {
+		log.Errorw("failed to settle read quota", "read_record_id", readRecordID, "consumed_size", consumedSize,
+			"action", refundAction, "error", err)
+	}
+	return err
+}
+
+func addReadRecordAudit(tx *gorm.DB, readRecordID uint64, action string, size uint64) error {
+	result := tx.Create(&ReadRecordAuditTable{
+		ReadRecordID: readRecordID,
+		Action:       action,
+		Size:         size,
+		TimestampUs:  GetCurrentTimestampUs(),
+	})
+	if result.Error != nil || result.RowsAffected != 1 {
+		return fmt.Errorf("failed to insert read record audit table: %s", result.Error)
+	}
+	return nil
+}
+
+// GetReadRecordAudit return the quota changes of the read record in order
+func (s *SpDBImpl) GetReadRecordAudit(readRecordID uint64) ([]*corespdb.ReadRecordAudit, error) {
+	var queryReturns []ReadRecordAuditTable
+	result := s.db.Where("read_record_id = ?", readRecordID).Order("audit_id").Find(&queryReturns)
+	if result.Error != nil {
+		return nil, fmt.Errorf("failed to query read record audit table: %s", result.Error)
+	}
+	audits := make([]*corespdb.ReadRecordAudit, 0, len(queryReturns))
+	for _, audit := range queryReturns {
+		audits = append(audits, &corespdb.ReadRecordAudit{
+			ReadRecordID: audit.ReadRecordID,
+			Action:       audit.Action,
+			Size:         audit.Size,
+			TimestampUs:  audit.TimestampUs,
+		})
+	}
+	return audits, nil
+}
+
 // getUpdatedConsumedQuotaV2 compute the updated quota of traffic table by the incoming read cost and the newest record.
 // it returns the updated consumed free quota,consumed charged quota and remained free quota
 func getUpdatedConsumedQuotaV2(recordQuotaCost, freeQuotaRemain, consumeFreeQuota, consumeChargedQuota, chargedQuota, monthlyFreeQuotaRemain, consumeMonthlyFreeQuota uint64) (uint64, uint64, uint64, uint64, uint64, error) {
@@ -120,61 +311,66 @@ func getUpdatedConsumedQuotaV2(recordQuotaCost, freeQuotaRemain, consumeFreeQuot
 
 // updateConsumedQuota update the consumed quota of BucketTraffic table in the transaction way
 func (s *SpDBImpl) updateConsumedQuota(record *corespdb.ReadRecord, quota *corespdb.BucketQuota) error {
-	yearMonth := TimeToYearMonth(TimestampUsToTime(record.ReadTimestampUs))
 	err := s.db.Transaction(func(tx *gorm.DB) error {
-		var bucketTraffic BucketTrafficTable
-		var err error
-		if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", record.BucketID, yearMonth).First(&bucketTraffic).Error; err != nil {
-			return fmt.Errorf("failed to query bucket traffic table: %v", err)
-		}
+		return deductConsumedQuota(tx, record, quota)
+	})
 
-		// if charged quota changed, update the new value
-		if bucketTraffic.ChargedQuotaSize != quota.ChargedQuotaSize {
-			result := tx.Model(&bucketTraffic).
-				Updates(BucketTrafficTable{
-					ChargedQuotaSize: quota.ChargedQuotaSize,
-					ModifiedTime:     time.Now(),
-				})
+	if err != nil {
+		log.CtxErrorw(context.Background(), "updated quota transaction fail", "error", err)
+	}
+	return err
+}
 
-			if result.Error != nil {
-				return fmt.Errorf("failed to update bucket traffic table: %s", result.Error)
-			}
-			if result.RowsAffected != 1 {
-				return fmt.Errorf("update traffic of %s has affected more than one rows %d, "+
-					"update charged quota %d", bucketTraffic.BucketName, result.RowsAffected, quota.ChargedQuotaSize)
-			}
-			log.CtxDebugw(context.Background(), "updated quota", "charged quota", quota.ChargedQuotaSize)
-		}
+// deductConsumedQuota deducts the read size of the record from the quota of BucketTraffic table in the tx
+func deductConsumedQuota(tx *gorm.DB, record *corespdb.ReadRecord, quota *corespdb.BucketQuota) error {
+	yearMonth := TimeToYearMonth(TimestampUsToTime(record.ReadTimestampUs))
+	var bucketTraffic BucketTrafficTable
+	var err error
+	if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", record.BucketID, yearMonth).First(&bucketTraffic).Error; err != nil {
+		return fmt.Errorf("failed to query bucket traffic table: %v", err)
+	}
 
-		// compute the new consumed quota size to be updated by the newest record and the read cost size
-		updatedConsumedFreeQuota, updatedConsumedChargedQuota, updatedConsumedMonthlyFreeQuota, updatedRemainedFreeQuota, updatedRemainedMonthlyFreeQuota, err := getUpdatedConsumedQuotaV2(record.ReadSize,
-			bucketTraffic.FreeQuotaSize, bucketTraffic.FreeQuotaConsumedSize,
-			bucketTraffic.ReadConsumedSize, quota.ChargedQuotaSize, bucketTraffic.MonthlyQuotaSize, bucketTraffic.MonthlyFreeQuotaConsumedSize)
-		if err != nil {
-			return err
-		}
+	// if charged quota changed, update the new value
+	if bucketTraffic.ChargedQuotaSize != quota.ChargedQuotaSize {
+		result := tx.Model(&bucketTraffic).
+			Updates(BucketTrafficTable{
+				ChargedQuotaSize: quota.ChargedQuotaSize,
+				ModifiedTime:     time.Now(),
+			})
 
-		// it is needed to add select items if you need to update a value to zero in gorm db
-		err = tx.Model(&bucketTraffic).
-			Select("read_consumed_size", "free_quota_consumed_size", "free_quota_size", "monthly_free_quota_consumed_size", "monthly_quota_size", "modified_time").Updates(BucketTrafficTable{
-			ReadConsumedSize:             updatedConsumedChargedQuota,
-			FreeQuotaConsumedSize:        updatedConsumedFreeQuota,
-			FreeQuotaSize:                updatedRemainedFreeQuota,
-			MonthlyFreeQuotaConsumedSize: updatedConsumedMonthlyFreeQuota,
-			MonthlyQuotaSize:             updatedRemainedMonthlyFreeQuota,
-			ModifiedTime:                 time.Now(),
-		}).Error
-		if err != nil {
-			return fmt.Errorf("failed to update bucket traffic table: %v", err)
+		if result.Error != nil {
+			return fmt.Errorf("failed to update bucket traffic table: %s", result.Error)
 		}
+		if result.RowsAffected != 1 {
+			return fmt.Errorf("update traffic of %s has affected more than one rows %d, "+
+				"update charged quota %d", bucketTraffic.BucketName, result.RowsAffected, quota.ChargedQuotaSize)
+		}
+		log.CtxDebugw(context.Background(), "updated quota", "charged quota", quota.ChargedQuotaSize)
+	}
 
-		return nil
-	})
+	// compute the new consumed quota size to be updated by the newest record and the read cost size
+	updatedConsumedFreeQuota, updatedConsumedChargedQuota, updatedConsumedMonthlyFreeQuota, updatedRemainedFreeQuota, updatedRemainedMonthlyFreeQuota, err := getUpdatedConsumedQuotaV2(record.ReadSize,
+		bucketTraffic.FreeQuotaSize, bucketTraffic.FreeQuotaConsumedSize,
+		bucketTraffic.ReadConsumedSize, quota.ChargedQuotaSize, bucketTraffic.MonthlyQuotaSize, bucketTraffic.MonthlyFreeQuotaConsumedSize)
+	if err != nil {
+		return err
+	}
 
+	// it is needed to add select items if you need to update a value to zero in gorm db
+	err = tx.Model(&bucketTraffic).
+		Select("read_consumed_size", "free_quota_consumed_size", "free_quota_size", "monthly_free_quota_consumed_size", "monthly_quota_size", "modified_time").Updates(BucketTrafficTable{
+		ReadConsumedSize:             updatedConsumedChargedQuota,
+		FreeQuotaConsumedSize:        updatedConsumedFreeQuota,
+		FreeQuotaSize:                updatedRemainedFreeQuota,
+		MonthlyFreeQuotaConsumedSize: updatedConsumedMonthlyFreeQuota,
+		MonthlyQuotaSize:             updatedRemainedMonthlyFreeQuota,
+		ModifiedTime:                 time.Now(),
+	}).Error
 	if err != nil {
-		log.CtxErrorw(context.Background(), "updated quota transaction fail", "error", err)
+		return fmt.Errorf("failed to update bucket traffic table: %v", err)
 	}
-	return err
+
+	return nil
 }
 
 // InitBucketTraffic init the bucket traffic table
@@ -365,101 +561,112 @@ func (s *SpDBImpl) GetBucketTrafficCount(yearMonth string) (count int64, err err
 func (s *SpDBImpl) UpdateExtraQuota(bucketID, extraQuota uint64, yearMonth string) error {
 	log.CtxErrorw(context.Background(), "begin to update extra quota for traffic db", "extra quota", extraQuota)
 	err := s.db.Transaction(func(tx *gorm.DB) error {
-		var bucketTraffic BucketTrafficTable
-		var err error
-		yearMonthOfNow := TimestampYearMonth(GetCurrentTimestampUs())
-		var extraUpdateOnNextMonth bool
-
-		// In most cases, the month of extra quota compensation should be the same as the current month,
-		// if not, the current month is the next month of the compensation month
-		if IsNextMonth(yearMonthOfNow, yearMonth) {
-			err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", bucketID, yearMonthOfNow).First(&bucketTraffic).Error
-			if err != nil {
-				// if it is a new month but the record has not been inserted, just update the extra quota to the old month
-				// the new month will init by the newest old month record.
-				if errors.Is(err, gorm.ErrRecordNotFound) {
-					extraUpdateOnNextMonth = false
-				}
-			} else {
-				extraUpdateOnNextMonth = true
+		return recoupConsumedQuota(tx, bucketID, extraQuota, yearMonth)
+	})
+
+	if err != nil {
+		log.CtxErrorw(context.Background(), "failed to update the table by extra quota ", "bucket id", bucketID, "error", err)
+	}
+
+	return err
+}
+
+// recoupConsumedQuota refunds the extra quota to the consumed quota of BucketTraffic table in the tx
+func recoupConsumedQuota(tx *gorm.DB, bucketID, extraQuota uint64, yearMonth string) error {
+	var bucketTraffic BucketTrafficTable
+	var err error
+	yearMonthOfNow := TimestampYearMonth(GetCurrentTimestampUs())
+	var extraUpdateOnNextMonth bool
+
+	// In most cases, the month of extra quota compensation should be the same as the current month,
+	// if not, the current month is the next month of the compensation month
+	if IsNextMonth(yearMonthOfNow, yearMonth) {
+		err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", bucketID, yearMonthOfNow).First(&bucketTraffic).Error
+		if err != nil {
+			// if it is a new month but the record has not been inserted, just update the extra quota to the old month
+			// the new month will init by the newest old month record.
+			if errors.Is(err, gorm.ErrRecordNotFound) {
+				extraUpdateOnNextMonth = false
 			}
 		} else {
-			if yearMonthOfNow != yearMonth {
-				return fmt.Errorf("the month of record of traffic table is invalid %s", yearMonth)
-			}
-			if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", bucketID, yearMonth).First(&bucketTraffic).Error; err != nil {
-				return fmt.Errorf("failed to query bucket traffic table: %v", err)
-			}
+			extraUpdateOnNextMonth = true
 		}
+	} else {
+		if yearMonthOfNow != yearMonth {
+			return fmt.Errorf("the month of record of traffic table is invalid %s", yearMonth)
+		}
+		if err = tx.Clauses(clause.Locking{Strength: "UPDATE"}).Where("bucket_id = ? and month = ?", bucketID, yearMonth).First(&bucketTraffic).Error; err != nil {
+			return fmt.Errorf("failed to query bucket traffic table: %v", err)
+		}
+	}
 
-		consumedFreeQuota := bucketTraffic.FreeQuotaConsumedSize
-		remainedFreeQuota := bucketTraffic.FreeQuotaSize
-		log.CtxDebugw(context.Background(), "updated read consumed quota with extra quota:", "month", yearMonth, "consumed:", bucketTraffic.ReadConsumedSize,
-			"remained free quota", remainedFreeQuota, "extra", extraQuota)
-
-		if extraUpdateOnNextMonth {
-			// if the extra quota generate on the different month, needed to add the extra quota to free quota
-			// for example, the extra quota was generated at the last second on August 31, and the action of replenishing the quota began on September 1.
-			// At this time, the latest quota record of traffic table is in September instead of August.
-			// This situation is very unlikely to happen, in this case, the quota will be restored to the free quota.
-			err = tx.Model(&bucketTraffic).
-				Updates(BucketTrafficTable{
-					FreeQuotaSize: bucketTraffic.FreeQuotaSize + extraQuota,
-					ModifiedTime:  time.Now(),
-				}).Error
-		} else {
-			consumedChargeQuota := bucketTraffic.ReadConsumedSize
-			consumedMonthlyFreeQuota := bucketTraffic.MonthlyFreeQuotaConsumedSize
-			monthlyFreeQuotaRemain := bucketTraffic.MonthlyQuotaSize
-			log.Infow("quota info", "consumedFreeQuota", consumedFreeQuota, "remainedFreeQuota", remainedFreeQuota, "consumedChargeQuota", consumedChargeQuota, "consumedMonthlyFreeQuota", consumedMonthlyFreeQuota, "monthlyFreeQuotaRemain", monthlyFreeQuotaRemain)
-			// The priority of compensation is chargeQuota > monthlyFreeQuota > freeQuota
-			// ChargeQuota
-			if consumedChargeQuota >= extraQuota {
-				consumedChargeQuota -= extraQuota
-				extraQuota = 0
-			} else if consumedChargeQuota > 0 {
-				extraQuota -= consumedChargeQuota
-				consumedChargeQuota = 0
-			}
-			// MonthlyFreeQuota
-			if extraQuota > 0 && consumedMonthlyFreeQuota >= extraQuota {
-				consumedMonthlyFreeQuota -= extraQuota
-				monthlyFreeQuotaRemain += extraQuota
-				extraQuota = 0
-			} else if extraQuota > 0 && consumedMonthlyFreeQuota > 0 {
-				extraQuota -= consumedMonthlyFreeQuota
-				monthlyFreeQuotaRemain += consumedMonthlyFreeQuota
-				consumedMonthlyFreeQuota = 0
-			}
-			// FreeQuota
-			if extraQuota > 0 {
-				consumedFreeQuota -= extraQuota
-				remainedFreeQuota += extraQuota
-			}
-			log.Infow("quota info", "consumedFreeQuota", consumedFreeQuota, "remainedFreeQuota", remainedFreeQuota, "consumedChargeQuota", consumedChargeQuota, "consumedMonthlyFreeQuota", consumedMonthlyFreeQuota, "monthlyFreeQuotaRemain", monthlyFreeQuotaRemain)
-			err = tx.Model(&bucketTraffic).
-				Select("read_consumed_size", "free_quota_consumed_size", "monthly_free_quota_consumed_size", "free_quota_size", "monthly_quota_size", "modified_time").Updates(BucketTrafficTable{
-				ReadConsumedSize:             consumedChargeQuota,
-				FreeQuotaConsumedSize:        consumedFreeQuota,
-				MonthlyFreeQuotaConsumedSize: consumedMonthlyFreeQuota,
-				FreeQuotaSize:                remainedFreeQuota,
-				MonthlyQuotaSize:             monthlyFreeQuotaRemain,
-				ModifiedTime:                 time.Now(),
+	consumedFreeQuota := bucketTraffic.FreeQuotaConsumedSize
+	remainedFreeQuota := bucketTraffic.FreeQuotaSize
+	log.CtxDebugw(context.Background(), "updated read consumed quota with extra quota:", "month", yearMonth, "consumed:", bucketTraffic.ReadConsumedSize,
+		"remained free quota", remainedFreeQuota, "extra", extraQuota)
+
+	if extraUpdateOnNextMonth {
+		// if the extra quota generate on the different month, needed to add the extra quota to free quota
+		// for example, the extra quota was generated at the last second on August 31, and the action of replenishing the quota began on September 1.
+		// At this time, the latest quota record of traffic table is in September instead of August.
+		// This situation is very unlikely to happen, in this case, the quota will be restored to the free quota.
+		err = tx.Model(&bucketTraffic).
+			Updates(BucketTrafficTable{
+				FreeQuotaSize: bucketTraffic.FreeQuotaSize + extraQuota,
+				ModifiedTime:  time.Now(),
 			}).Error
+	} else {
+		consumedChargeQuota := bucketTraffic.ReadConsumedSize
+		consumedMonthlyFreeQuota := bucketTraffic.MonthlyFreeQuotaConsumedSize
+		monthlyFreeQuotaRemain := bucketTraffic.MonthlyQuotaSize
+		log.Infow("quota info", "consumedFreeQuota", consumedFreeQuota, "remainedFreeQuota", remainedFreeQuota, "consumedChargeQuota", consumedChargeQuota, "consumedMonthlyFreeQuota", consumedMonthlyFreeQuota, "monthlyFreeQuotaRemain", monthlyFreeQuotaRemain)
+		// The priority of compensation is chargeQuota > monthlyFreeQuota > freeQuota
+		// ChargeQuota
+		if consumedChargeQuota >= extraQuota {
+			consumedChargeQuota -= extraQuota
+			extraQuota = 0
+		} else if consumedChargeQuota > 0 {
+			extraQuota -= consumedChargeQuota
+			consumedChargeQuota = 0
 		}
-
-		if err != nil {
-			return fmt.Errorf("failed to update bucket traffic table: %v", err)
+		// MonthlyFreeQuota
+		if extraQuota > 0 && consumedMonthlyFreeQuota >= extraQuota {
+			consumedMonthlyFreeQuota -= extraQuota
+			monthlyFreeQuotaRemain += extraQuota
+			extraQuota = 0
+		} else if extraQuota > 0 && consumedMonthlyFreeQuota > 0 {
+			extraQuota -= consumedMonthlyFreeQuota
+			monthlyFreeQuotaRemain += consumedMonthlyFreeQuota
+			consumedMonthlyFreeQuota = 0
 		}
-
-		return nil
-	})
+		// FreeQuota
+		if extraQuota > 0 {
+			if consumedFreeQuota < extraQuota {
+				// the quota that is not consumed can not be refunded, e.g. it has been refunded already
+				log.Warnw("drop the extra quota exceeding the consumed quota", "bucket_id", bucketID,
+					"month", yearMonth, "consumed_free_quota", consumedFreeQuota, "extra", extraQuota)
+				extraQuota = consumedFreeQuota
+			}
+			consumedFreeQuota -= extraQuota
+			remainedFreeQuota += extraQuota
+		}
+		log.Infow("quota info", "consumedFreeQuota", consumedFreeQuota, "remainedFreeQuota", remainedFreeQuota, "consumedChargeQuota", consumedChargeQuota, "consumedMonthlyFreeQuota", consumedMonthlyFreeQuota, "monthlyFreeQuotaRemain", monthlyFreeQuotaRemain)
+		err = tx.Model(&bucketTraffic).
+			Select("read_consumed_size", "free_quota_consumed_size", "monthly_free_quota_consumed_size", "free_quota_size", "monthly_quota_size", "modified_time").Updates(BucketTrafficTable{
+			ReadConsumedSize:             consumedChargeQuota,
+			FreeQuotaConsumedSize:        consumedFreeQuota,
+			MonthlyFreeQuotaConsumedSize: consumedMonthlyFreeQuota,
+			FreeQuotaSize:                remainedFreeQuota,
+			MonthlyQuotaSize:             monthlyFreeQuotaRemain,
+			ModifiedTime:                 time.Now(),
+		}).Error
+	}
 
 	if err != nil {
-		log.CtxErrorw(context.Background(), "failed to update the table by extra quota ", "bucket id", bucketID, "error", err)
+		return fmt.Errorf("failed to update bucket traffic table: %v", err)
 	}
 
-	return err
+	return nil
 }
 
 // GetLatestBucketTraffic return the latest bucket traffic info of the bucket
@@ -744,5 +951,10 @@ func (s *SpDBImpl) DeleteExpiredReadRecord(ts, limit uint64) (err error) {
 	if result.Error != nil {
 		return fmt.Errorf("failed to delete read record in read record table: %s, ts:%d", result.Error, ts)
 	}
+	var audits []ReadRecordAuditTable
+	result = s.db.Where("timestamp_us < ?", ts).Limit(int(limit)).Find(&audits).Delete(&audits)
+	if result.Error != nil {
+		return fmt.Errorf("failed to delete read record audit in read record audit table: %s, ts:%d", result.Error, ts)
+	}
 	return nil
 }
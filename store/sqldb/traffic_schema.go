@@ -39,3 +39,31 @@ type ReadRecordTable struct {
 func (ReadRecordTable) TableName() string {
 	return ReadRecordTableName
 }
+
+// ReadQuotaReservationTable table schema, a row is deleted once the reservation is settled
+type ReadQuotaReservationTable struct {
+	ReadRecordID uint64 `gorm:"primary_key"`
+	BucketID     uint64
+	Month        string // the month of the bucket traffic that the quota is deducted from
+	ReservedSize uint64
+	ExpireTimeUs int64 `gorm:"index:expire_time_to_reservation"` // microsecond timestamp
+}
+
+// TableName is used to set ReadQuotaReservation Schema's table name in database
+func (ReadQuotaReservationTable) TableName() string {
+	return ReadQuotaReservationTableName
+}
+
+// ReadRecordAuditTable table schema
+type ReadRecordAuditTable struct {
+	AuditID      uint64 `gorm:"primary_key;autoIncrement"`
+	ReadRecordID uint64 `gorm:"index:read_record_to_audit"`
+	Action       string
+	Size         uint64
+	TimestampUs  int64 `gorm:"index:time_to_audit"` // microsecond timestamp
+}
+
+// TableName is used to set ReadRecordAudit Schema's table name in database
+func (ReadRecordAuditTable) TableName() string {
+	return ReadRecordAuditTableName
+}
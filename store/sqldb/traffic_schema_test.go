@@ -17,3 +17,15 @@ func TestReadRecordTable_TableName(t *testing.T) {
 	result := table.TableName()
 	assert.Equal(t, ReadRecordTableName, result)
 }
+
+func TestReadQuotaReservationTable_TableName(t *testing.T) {
+	table := ReadQuotaReservationTable{ReadRecordID: 1}
+	result := table.TableName()
+	assert.Equal(t, ReadQuotaReservationTableName, result)
+}
+
+func TestReadRecordAuditTable_TableName(t *testing.T) {
+	table := ReadRecordAuditTable{AuditID: 1}
+	result := table.TableName()
+	assert.Equal(t, ReadRecordAuditTableName, result)
+}
@@ -1005,3 +1005,229 @@ func TestSpDBImpl_GetUserReadRecordFailure(t *testing.T) {
 	assert.Contains(t, err.Error(), mockDBInternalError.Error())
 	assert.Equal(t, []*corespdb.ReadRecord(nil), result)
 }
+
+func TestSpDBImpl_ReserveReadQuotaSuccess(t *testing.T) {
+	record := &corespdb.ReadRecord{
+		BucketID:        2,
+		ObjectID:        3,
+		UserAddress:     "mockUserAddress",
+		BucketName:      "mockBucketName",
+		ObjectName:      "mockObjectName",
+		ReadSize:        8,
+		ReadTimestampUs: GetCurrentTimestampUs(),
+	}
+	b := BucketTrafficTable{
+		BucketID:         2,
+		BucketName:       "mockBucketName",
+		ChargedQuotaSize: 20,
+		MonthlyQuotaSize: 20,
+		ModifiedTime:     time.Now(),
+	}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "year_month", "bucket_name", "read_consumed_size", "free_quota_consumed_size",
+		"free_quota_size", "charged_quota_size", "modified_time", "monthly_free_quota_consumed_size", "monthly_quota_size"}).AddRow(b.BucketID, GetCurrentYearMonth(), b.BucketName, b.ReadConsumedSize,
+		b.FreeQuotaConsumedSize, b.FreeQuotaSize, b.ChargedQuotaSize, b.ModifiedTime, b.MonthlyFreeQuotaConsumedSize, b.MonthlyQuotaSize))
+	mock.ExpectExec("UPDATE `bucket_traffic` SET `read_consumed_size`=?,`free_quota_consumed_size`=?,`monthly_free_quota_consumed_size`=?,`free_quota_size`=?,`monthly_quota_size`=?,`modified_time`=? WHERE `bucket_id` = ? ").
+		WithArgs(8, 0, 0, 0, 20, sqlmock.AnyArg(), b.BucketID).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record` (`bucket_id`,`object_id`,`user_address`,`read_timestamp_us`,`bucket_name`,`object_name`,`read_size`) VALUES (?,?,?,?,?,?,?)").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("INSERT INTO `read_quota_reservation` (`bucket_id`,`month`,`reserved_size`,`expire_time_us`,`read_record_id`) VALUES (?,?,?,?,?)").
+		WithArgs(b.BucketID, GetCurrentYearMonth(), 8, 100, 5).
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionReserve, 8, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	readRecordID, err := s.ReserveReadQuota(record, &corespdb.BucketQuota{ChargedQuotaSize: 20}, 100)
+	assert.Nil(t, err)
+	assert.Equal(t, uint64(5), readRecordID)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_ReserveReadQuotaFailure(t *testing.T) {
+	record := &corespdb.ReadRecord{BucketID: 2, ReadSize: 8, ReadTimestampUs: GetCurrentTimestampUs()}
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "month", "charged_quota_size"}).
+		AddRow(2, GetCurrentYearMonth(), 0))
+	mock.ExpectRollback()
+
+	_, err := s.ReserveReadQuota(record, &corespdb.BucketQuota{}, 100)
+	assert.ErrorIs(t, err, ErrCheckQuotaEnough)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func expectSettleReadQuota(mock sqlmock.Sqlmock, reservedSize uint64) {
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `read_quota_reservation` WHERE read_record_id = ? ORDER BY `read_quota_reservation`.`read_record_id` LIMIT 1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"read_record_id", "bucket_id", "month", "reserved_size", "expire_time_us"}).
+			AddRow(5, 2, GetCurrentYearMonth(), reservedSize, 100))
+}
+
+func TestSpDBImpl_CommitReadQuotaSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	expectSettleReadQuota(mock, 8)
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "month", "read_consumed_size", "charged_quota_size", "monthly_quota_size"}).
+		AddRow(2, GetCurrentYearMonth(), 8, 20, 20))
+	mock.ExpectExec("UPDATE `bucket_traffic` SET `read_consumed_size`=?,`free_quota_consumed_size`=?,`monthly_free_quota_consumed_size`=?,`free_quota_size`=?,`monthly_quota_size`=?,`modified_time`=? WHERE `bucket_id` = ? AND `month` = ?").
+		WithArgs(3, 0, 0, 0, 20, sqlmock.AnyArg(), 2, GetCurrentYearMonth()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `read_record` SET `read_size`=? WHERE read_record_id = ?").
+		WithArgs(3, 5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM `read_quota_reservation` WHERE `read_quota_reservation`.`read_record_id` = ?").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionCommit, 3, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionRefund, 5, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(3, 1))
+	mock.ExpectCommit()
+
+	err := s.CommitReadQuota(5, 3)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_CommitReadQuotaWithoutRefund(t *testing.T) {
+	s, mock := setupDB(t)
+	expectSettleReadQuota(mock, 8)
+	mock.ExpectExec("DELETE FROM `read_quota_reservation` WHERE `read_quota_reservation`.`read_record_id` = ?").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionCommit, 8, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	// the consumed size can not exceed the reserved size
+	err := s.CommitReadQuota(5, 10)
+	assert.Nil(t, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_ReleaseReadQuotaNotFound(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `read_quota_reservation` WHERE read_record_id = ? ORDER BY `read_quota_reservation`.`read_record_id` LIMIT 1 FOR UPDATE").
+		WithArgs(5).
+		WillReturnError(gorm.ErrRecordNotFound)
+	mock.ExpectRollback()
+
+	err := s.ReleaseReadQuota(5)
+	assert.Equal(t, ErrReadQuotaReservationNotFound, err)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_ExpireReadQuotaSuccess(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `read_quota_reservation` WHERE expire_time_us < ? LIMIT 10").
+		WithArgs(200).
+		WillReturnRows(sqlmock.NewRows([]string{"read_record_id", "bucket_id", "month", "reserved_size", "expire_time_us"}).
+			AddRow(5, 2, GetCurrentYearMonth(), 8, 100))
+	expectSettleReadQuota(mock, 8)
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "month", "read_consumed_size", "charged_quota_size", "monthly_quota_size"}).
+		AddRow(2, GetCurrentYearMonth(), 8, 20, 20))
+	mock.ExpectExec("UPDATE `bucket_traffic` SET `read_consumed_size`=?,`free_quota_consumed_size`=?,`monthly_free_quota_consumed_size`=?,`free_quota_size`=?,`monthly_quota_size`=?,`modified_time`=? WHERE `bucket_id` = ? AND `month` = ?").
+		WithArgs(0, 0, 0, 0, 20, sqlmock.AnyArg(), 2, GetCurrentYearMonth()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `read_record` SET `read_size`=? WHERE read_record_id = ?").
+		WithArgs(0, 5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM `read_quota_reservation` WHERE `read_quota_reservation`.`read_record_id` = ?").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionExpire, 8, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+
+	expired, err := s.ExpireReadQuota(200, 10)
+	assert.Nil(t, err)
+	assert.Equal(t, 1, expired)
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_GetReadRecordAudit(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectQuery("SELECT * FROM `read_record_audit` WHERE read_record_id = ? ORDER BY audit_id").
+		WithArgs(5).
+		WillReturnRows(sqlmock.NewRows([]string{"audit_id", "read_record_id", "action", "size", "timestamp_us"}).
+			AddRow(1, 5, corespdb.ReadQuotaActionReserve, 8, 1).
+			AddRow(2, 5, corespdb.ReadQuotaActionRelease, 8, 2))
+
+	audits, err := s.GetReadRecordAudit(5)
+	assert.Nil(t, err)
+	assert.Equal(t, []*corespdb.ReadRecordAudit{
+		{ReadRecordID: 5, Action: corespdb.ReadQuotaActionReserve, Size: 8, TimestampUs: 1},
+		{ReadRecordID: 5, Action: corespdb.ReadQuotaActionRelease, Size: 8, TimestampUs: 2},
+	}, audits)
+}
+
+func TestSpDBImpl_ReleaseReadQuotaRestoresQuota(t *testing.T) {
+	record := &corespdb.ReadRecord{BucketID: 2, ReadSize: 12, ReadTimestampUs: GetCurrentTimestampUs()}
+	s, mock := setupDB(t)
+	trafficColumns := []string{"bucket_id", "month", "read_consumed_size", "free_quota_consumed_size", "free_quota_size",
+		"charged_quota_size", "monthly_free_quota_consumed_size", "monthly_quota_size"}
+	updateTraffic := "UPDATE `bucket_traffic` SET `read_consumed_size`=?,`free_quota_consumed_size`=?,`monthly_free_quota_consumed_size`=?,`free_quota_size`=?,`monthly_quota_size`=?,`modified_time`=? WHERE `bucket_id` = ? AND `month` = ?"
+
+	// the reservation consumes the charged, the monthly free and the free quota in order
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows(trafficColumns).AddRow(2, GetCurrentYearMonth(), 0, 0, 10, 4, 0, 2))
+	mock.ExpectExec(updateTraffic).
+		WithArgs(4, 6, 2, 4, 0, sqlmock.AnyArg(), 2, GetCurrentYearMonth()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record` (`bucket_id`,`object_id`,`user_address`,`read_timestamp_us`,`bucket_name`,`object_name`,`read_size`) VALUES (?,?,?,?,?,?,?)").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("INSERT INTO `read_quota_reservation` (`bucket_id`,`month`,`reserved_size`,`expire_time_us`,`read_record_id`) VALUES (?,?,?,?,?)").
+		WillReturnResult(sqlmock.NewResult(5, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionReserve, 12, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+	readRecordID, err := s.ReserveReadQuota(record, &corespdb.BucketQuota{ChargedQuotaSize: 4}, 100)
+	assert.Nil(t, err)
+
+	// the download is aborted before any data is sent, the quota returns to its value before the download
+	expectSettleReadQuota(mock, 12)
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows(trafficColumns).AddRow(2, GetCurrentYearMonth(), 4, 6, 4, 4, 2, 0))
+	mock.ExpectExec(updateTraffic).
+		WithArgs(0, 0, 0, 10, 2, sqlmock.AnyArg(), 2, GetCurrentYearMonth()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("UPDATE `read_record` SET `read_size`=? WHERE read_record_id = ?").
+		WithArgs(0, 5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("DELETE FROM `read_quota_reservation` WHERE `read_quota_reservation`.`read_record_id` = ?").
+		WithArgs(5).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectExec("INSERT INTO `read_record_audit` (`read_record_id`,`action`,`size`,`timestamp_us`) VALUES (?,?,?,?)").
+		WithArgs(5, corespdb.ReadQuotaActionRelease, 12, sqlmock.AnyArg()).
+		WillReturnResult(sqlmock.NewResult(2, 1))
+	mock.ExpectCommit()
+	assert.Nil(t, s.ReleaseReadQuota(readRecordID))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
+
+func TestSpDBImpl_UpdateExtraQuotaExceedsConsumedQuota(t *testing.T) {
+	s, mock := setupDB(t)
+	mock.ExpectBegin()
+	mock.ExpectQuery("SELECT * FROM `bucket_traffic` WHERE bucket_id = ?  and month = ? ORDER BY `bucket_traffic`.`bucket_id` LIMIT 1 FOR UPDATE").
+		WillReturnRows(sqlmock.NewRows([]string{"bucket_id", "month", "read_consumed_size", "free_quota_consumed_size", "free_quota_size"}).
+			AddRow(2, GetCurrentYearMonth(), 0, 5, 5))
+	// only the consumed quota is refunded, the consumed quota does not underflow
+	mock.ExpectExec("UPDATE `bucket_traffic` SET `read_consumed_size`=?,`free_quota_consumed_size`=?,`monthly_free_quota_consumed_size`=?,`free_quota_size`=?,`monthly_quota_size`=?,`modified_time`=? WHERE `bucket_id` = ? AND `month` = ?").
+		WithArgs(0, 0, 0, 10, 0, sqlmock.AnyArg(), 2, GetCurrentYearMonth()).
+		WillReturnResult(sqlmock.NewResult(1, 1))
+	mock.ExpectCommit()
+
+	assert.Nil(t, s.UpdateExtraQuota(2, 20, GetCurrentYearMonth()))
+	assert.Nil(t, mock.ExpectationsWereMet())
+}
@@ -0,0 +1,251 @@
+package grpc
+
+import (
+	"context"
+	"errors"
+	"io"
+	"reflect"
+	"strings"
+	"sync"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	coretask "github.com/bnb-chain/greenfield-storage-provider/core/task"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
+)
+
+// taskKeySearchDepth is the depth of the nested messages that the task key is searched in, the tasks are
+// carried by the request directly or by its oneof field.
+const taskKeySearchDepth = 2
+
+// GetTracingServerInterceptor returns the gRPC server interceptor that continues the traces of the callers,
+// the spans are no-op if the tracing is not enabled.
+func GetTracingServerInterceptor() []grpc.ServerOption {
+	options := []grpc.ServerOption{}
+	options = append(options, grpc.ChainUnaryInterceptor(tracingUnaryServerInterceptor))
+	options = append(options, grpc.ChainStreamInterceptor(tracingStreamServerInterceptor))
+	return options
+}
+
+// GetTracingClientInterceptor returns the gRPC client interceptor that propagates the traces to the callees,
+// the spans are no-op if the tracing is not enabled.
+func GetTracingClientInterceptor() []grpc.DialOption {
+	options := []grpc.DialOption{}
+	options = append(options, grpc.WithChainUnaryInterceptor(tracingUnaryClientInterceptor))
+	options = append(options, grpc.WithChainStreamInterceptor(tracingStreamClientInterceptor))
+	return options
+}
+
+func tracingUnaryServerInterceptor(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo,
+	handler grpc.UnaryHandler) (interface{}, error) {
+	ctx, span := startRPCSpan(extractMetadata(ctx), info.FullMethod, trace.SpanKindServer)
+	setTaskKey(span, req)
+	resp, err := handler(ctx, req)
+	endRPCSpan(span, err)
+	return resp, err
+}
+
+func tracingStreamServerInterceptor(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo,
+	handler grpc.StreamHandler) error {
+	ctx, span := startRPCSpan(extractMetadata(ss.Context()), info.FullMethod, trace.SpanKindServer)
+	err := handler(srv, &tracingServerStream{ServerStream: ss, ctx: ctx, span: span})
+	endRPCSpan(span, err)
+	return err
+}
+
+func tracingUnaryClientInterceptor(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+	invoker grpc.UnaryInvoker, opts ...grpc.CallOption) error {
+	ctx, span := startRPCSpan(ctx, method, trace.SpanKindClient)
+	setTaskKey(span, req)
+	err := invoker(injectMetadata(ctx), method, req, reply, cc, opts...)
+	endRPCSpan(span, err)
+	return err
+}
+
+func tracingStreamClientInterceptor(ctx context.Context, desc *grpc.StreamDesc, cc *grpc.ClientConn, method string,
+	streamer grpc.Streamer, opts ...grpc.CallOption) (grpc.ClientStream, error) {
+	ctx, span := startRPCSpan(ctx, method, trace.SpanKindClient)
+	cs, err := streamer(injectMetadata(ctx), desc, cc, method, opts...)
+	if err != nil {
+		endRPCSpan(span, err)
+		return nil, err
+	}
+	return &tracingClientStream{ClientStream: cs, desc: desc, span: span}, nil
+}
+
+// tracingServerStream carries the context with the server span to the stream handler.
+type tracingServerStream struct {
+	grpc.ServerStream
+	ctx      context.Context
+	span     trace.Span
+	received bool
+}
+
+func (s *tracingServerStream) Context() context.Context {
+	return s.ctx
+}
+
+func (s *tracingServerStream) RecvMsg(m interface{}) error {
+	err := s.ServerStream.RecvMsg(m)
+	if err == nil && !s.received {
+		s.received = true
+		setTaskKey(s.span, m)
+	}
+	return err
+}
+
+// tracingClientStream ends the client span once the stream is finished.
+type tracingClientStream struct {
+	grpc.ClientStream
+	desc    *grpc.StreamDesc
+	span    trace.Span
+	sent    bool
+	endOnce sync.Once
+}
+
+func (s *tracingClientStream) SendMsg(m interface{}) error {
+	if !s.sent {
+		s.sent = true
+		setTaskKey(s.span, m)
+	}
+	err := s.ClientStream.SendMsg(m)
+	if err != nil && !errors.Is(err, io.EOF) {
+		s.end(err)
+	}
+	return err
+}
+
+func (s *tracingClientStream) RecvMsg(m interface{}) error {
+	err := s.ClientStream.RecvMsg(m)
+	switch {
+	case errors.Is(err, io.EOF):
+		s.end(nil)
+	case err != nil:
+		s.end(err)
+	case !s.desc.ServerStreams:
+		s.end(nil)
+	}
+	return err
+}
+
+func (s *tracingClientStream) end(err error) {
+	s.endOnce.Do(func() {
+		endRPCSpan(s.span, err)
+	})
+}
+
+func startRPCSpan(ctx context.Context, fullMethod string, kind trace.SpanKind) (context.Context, trace.Span) {
+	attrs := []attribute.KeyValue{semconv.RPCSystemGRPC}
+	if service, method, ok := strings.Cut(strings.TrimPrefix(fullMethod, "/"), "/"); ok {
+		attrs = append(attrs, semconv.RPCService(service), semconv.RPCMethod(method))
+	}
+	return tracing.Tracer().Start(ctx, strings.TrimPrefix(fullMethod, "/"), trace.WithSpanKind(kind),
+		trace.WithAttributes(attrs...))
+}
+
+func endRPCSpan(span trace.Span, err error) {
+	s, _ := status.FromError(err)
+	span.SetAttributes(semconv.RPCGRPCStatusCodeKey.Int(int(s.Code())))
+	if err != nil {
+		span.RecordError(err)
+		span.SetStatus(codes.Error, s.Message())
+	}
+	span.End()
+}
+
+// setTaskKey records the key of the task carried by the msg to the span.
+func setTaskKey(span trace.Span, msg interface{}) {
+	if !span.IsRecording() {
+		return
+	}
+	if key := taskKeyOf(msg); key != "" {
+		span.SetAttributes(tracing.TaskKey.String(key))
+	}
+}
+
+func taskKeyOf(msg interface{}) (key string) {
+	// the key of a malformed task may panic, the task key is only informative
+	defer func() {
+		if recover() != nil {
+			key = ""
+		}
+	}()
+	if task, ok := msg.(interface{ Key() coretask.TKey }); ok {
+		return string(task.Key())
+	}
+	return taskKeyOfValue(reflect.ValueOf(msg), taskKeySearchDepth)
+}
+
+func taskKeyOfValue(v reflect.Value, depth int) string {
+	for v.Kind() == reflect.Ptr || v.Kind() == reflect.Interface {
+		if v.IsNil() {
+			return ""
+		}
+		v = v.Elem()
+	}
+	if v.Kind() != reflect.Struct || depth == 0 {
+		return ""
+	}
+	for i := 0; i < v.NumField(); i++ {
+		f := v.Field(i)
+		if !v.Type().Field(i).IsExported() || (f.Kind() != reflect.Ptr && f.Kind() != reflect.Interface) || f.IsNil() {
+			continue
+		}
+		if task, ok := f.Interface().(interface{ Key() coretask.TKey }); ok {
+			return string(task.Key())
+		}
+		if key := taskKeyOfValue(f, depth-1); key != "" {
+			return key
+		}
+	}
+	return ""
+}
+
+// metadataCarrier adapts the gRPC metadata to the propagation.TextMapCarrier.
+type metadataCarrier metadata.MD
+
+func (c metadataCarrier) Get(key string) string {
+	values := metadata.MD(c).Get(key)
+	if len(values) == 0 {
+		return ""
+	}
+	return values[0]
+}
+
+func (c metadataCarrier) Set(key, value string) {
+	metadata.MD(c).Set(key, value)
+}
+
+func (c metadataCarrier) Keys() []string {
+	keys := make([]string, 0, len(c))
+	for k := range c {
+		keys = append(keys, k)
+	}
+	return keys
+}
+
+func extractMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ctx
+	}
+	return otel.GetTextMapPropagator().Extract(ctx, metadataCarrier(md))
+}
+
+func injectMetadata(ctx context.Context) context.Context {
+	md, ok := metadata.FromOutgoingContext(ctx)
+	if ok {
+		md = md.Copy()
+	} else {
+		md = metadata.MD{}
+	}
+	otel.GetTextMapPropagator().Inject(ctx, metadataCarrier(md))
+	return metadata.NewOutgoingContext(ctx, md)
+}
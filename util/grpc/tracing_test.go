@@ -0,0 +1,96 @@
+package grpc
+
+import (
+	"context"
+	"testing"
+
+	"cosmossdk.io/math"
+	"github.com/stretchr/testify/assert"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfspserver"
+	"github.com/bnb-chain/greenfield-storage-provider/base/types/gfsptask"
+	"github.com/bnb-chain/greenfield-storage-provider/pkg/tracing"
+	storagetypes "github.com/bnb-chain/greenfield/x/storage/types"
+)
+
+func setupTracingTest(t *testing.T) *tracetest.SpanRecorder {
+	recorder := tracetest.NewSpanRecorder()
+	provider := sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder))
+	otel.SetTracerProvider(provider)
+	otel.SetTextMapPropagator(propagation.TraceContext{})
+	t.Cleanup(func() {
+		_ = provider.Shutdown(context.Background())
+		otel.SetTracerProvider(trace.NewNoopTracerProvider())
+		otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator())
+	})
+	return recorder
+}
+
+func TestGetTracingInterceptor(t *testing.T) {
+	assert.Equal(t, 2, len(GetTracingServerInterceptor()))
+	assert.Equal(t, 2, len(GetTracingClientInterceptor()))
+}
+
+func TestTaskKeyOf(t *testing.T) {
+	task := &gfsptask.GfSpUploadObjectTask{ObjectInfo: &storagetypes.ObjectInfo{
+		BucketName: "bucket", ObjectName: "object", Id: math.NewUint(1)}}
+	cases := []struct {
+		name    string
+		msg     interface{}
+		wantKey string
+	}{
+		{"task", task, string(task.Key())},
+		{"task field", &gfspserver.GfSpUploadObjectRequest{UploadObjectTask: task}, string(task.Key())},
+		{"oneof task", &gfspserver.GfSpReportTaskRequest{
+			Request: &gfspserver.GfSpReportTaskRequest_UploadObjectTask{UploadObjectTask: task}}, string(task.Key())},
+		{"malformed task", &gfspserver.GfSpUploadObjectRequest{UploadObjectTask: &gfsptask.GfSpUploadObjectTask{}}, ""},
+		{"no task", &gfspserver.GfSpReportTaskRequest{}, ""},
+		{"nil", nil, ""},
+	}
+	for _, tt := range cases {
+		t.Run(tt.name, func(t *testing.T) {
+			assert.Equal(t, tt.wantKey, taskKeyOf(tt.msg))
+		})
+	}
+}
+
+func TestTracingUnaryInterceptor(t *testing.T) {
+	recorder := setupTracingTest(t)
+	task := &gfsptask.GfSpUploadObjectTask{ObjectInfo: &storagetypes.ObjectInfo{
+		BucketName: "bucket", ObjectName: "object", Id: math.NewUint(1)}}
+	req := &gfspserver.GfSpUploadObjectRequest{UploadObjectTask: task}
+	method := "/base.types.gfspserver.GfSpUploadService/GfSpUploadObject"
+
+	var serverCtx context.Context
+	invoker := func(ctx context.Context, method string, req, reply interface{}, cc *grpc.ClientConn,
+		opts ...grpc.CallOption) error {
+		md, _ := metadata.FromOutgoingContext(ctx)
+		_, err := tracingUnaryServerInterceptor(metadata.NewIncomingContext(context.Background(), md), req,
+			&grpc.UnaryServerInfo{FullMethod: method}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				serverCtx = ctx
+				return nil, nil
+			})
+		return err
+	}
+	err := tracingUnaryClientInterceptor(context.Background(), method, req, nil, nil, invoker)
+	assert.Nil(t, err)
+
+	spans := recorder.Ended()
+	assert.Equal(t, 2, len(spans))
+	server, client := spans[0], spans[1]
+	assert.Equal(t, trace.SpanKindServer, server.SpanKind())
+	assert.Equal(t, trace.SpanKindClient, client.SpanKind())
+	assert.Equal(t, "base.types.gfspserver.GfSpUploadService/GfSpUploadObject", client.Name())
+	assert.Equal(t, client.SpanContext().SpanID(), server.Parent().SpanID())
+	assert.Equal(t, server.SpanContext(), trace.SpanContextFromContext(serverCtx))
+	for _, s := range spans {
+		assert.Contains(t, s.Attributes(), tracing.TaskKey.String(string(task.Key())))
+	}
+}